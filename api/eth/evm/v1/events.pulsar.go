@@ -2334,6 +2334,1896 @@ func (x *fastReflection_EventFunTokenCreated) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var (
+	md_EventFunTokenMetadataSynced                        protoreflect.MessageDescriptor
+	fd_EventFunTokenMetadataSynced_bank_denom             protoreflect.FieldDescriptor
+	fd_EventFunTokenMetadataSynced_erc20_contract_address protoreflect.FieldDescriptor
+	fd_EventFunTokenMetadataSynced_sender                 protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventFunTokenMetadataSynced = File_eth_evm_v1_events_proto.Messages().ByName("EventFunTokenMetadataSynced")
+	fd_EventFunTokenMetadataSynced_bank_denom = md_EventFunTokenMetadataSynced.Fields().ByName("bank_denom")
+	fd_EventFunTokenMetadataSynced_erc20_contract_address = md_EventFunTokenMetadataSynced.Fields().ByName("erc20_contract_address")
+	fd_EventFunTokenMetadataSynced_sender = md_EventFunTokenMetadataSynced.Fields().ByName("sender")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventFunTokenMetadataSynced)(nil)
+
+type fastReflection_EventFunTokenMetadataSynced EventFunTokenMetadataSynced
+
+func (x *EventFunTokenMetadataSynced) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventFunTokenMetadataSynced)(x)
+}
+
+func (x *EventFunTokenMetadataSynced) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventFunTokenMetadataSynced_messageType fastReflection_EventFunTokenMetadataSynced_messageType
+var _ protoreflect.MessageType = fastReflection_EventFunTokenMetadataSynced_messageType{}
+
+type fastReflection_EventFunTokenMetadataSynced_messageType struct{}
+
+func (x fastReflection_EventFunTokenMetadataSynced_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventFunTokenMetadataSynced)(nil)
+}
+func (x fastReflection_EventFunTokenMetadataSynced_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventFunTokenMetadataSynced)
+}
+func (x fastReflection_EventFunTokenMetadataSynced_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunTokenMetadataSynced
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventFunTokenMetadataSynced) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunTokenMetadataSynced
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventFunTokenMetadataSynced) Type() protoreflect.MessageType {
+	return _fastReflection_EventFunTokenMetadataSynced_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventFunTokenMetadataSynced) New() protoreflect.Message {
+	return new(fastReflection_EventFunTokenMetadataSynced)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventFunTokenMetadataSynced) Interface() protoreflect.ProtoMessage {
+	return (*EventFunTokenMetadataSynced)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventFunTokenMetadataSynced) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BankDenom != "" {
+		value := protoreflect.ValueOfString(x.BankDenom)
+		if !f(fd_EventFunTokenMetadataSynced_bank_denom, value) {
+			return
+		}
+	}
+	if x.Erc20ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.Erc20ContractAddress)
+		if !f(fd_EventFunTokenMetadataSynced_erc20_contract_address, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventFunTokenMetadataSynced_sender, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventFunTokenMetadataSynced) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenMetadataSynced.bank_denom":
+		return x.BankDenom != ""
+	case "eth.evm.v1.EventFunTokenMetadataSynced.erc20_contract_address":
+		return x.Erc20ContractAddress != ""
+	case "eth.evm.v1.EventFunTokenMetadataSynced.sender":
+		return x.Sender != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenMetadataSynced"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenMetadataSynced does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenMetadataSynced) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenMetadataSynced.bank_denom":
+		x.BankDenom = ""
+	case "eth.evm.v1.EventFunTokenMetadataSynced.erc20_contract_address":
+		x.Erc20ContractAddress = ""
+	case "eth.evm.v1.EventFunTokenMetadataSynced.sender":
+		x.Sender = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenMetadataSynced"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenMetadataSynced does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventFunTokenMetadataSynced) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventFunTokenMetadataSynced.bank_denom":
+		value := x.BankDenom
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenMetadataSynced.erc20_contract_address":
+		value := x.Erc20ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenMetadataSynced.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenMetadataSynced"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenMetadataSynced does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenMetadataSynced) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenMetadataSynced.bank_denom":
+		x.BankDenom = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenMetadataSynced.erc20_contract_address":
+		x.Erc20ContractAddress = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenMetadataSynced.sender":
+		x.Sender = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenMetadataSynced"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenMetadataSynced does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenMetadataSynced) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenMetadataSynced.bank_denom":
+		panic(fmt.Errorf("field bank_denom of message eth.evm.v1.EventFunTokenMetadataSynced is not mutable"))
+	case "eth.evm.v1.EventFunTokenMetadataSynced.erc20_contract_address":
+		panic(fmt.Errorf("field erc20_contract_address of message eth.evm.v1.EventFunTokenMetadataSynced is not mutable"))
+	case "eth.evm.v1.EventFunTokenMetadataSynced.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventFunTokenMetadataSynced is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenMetadataSynced"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenMetadataSynced does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventFunTokenMetadataSynced) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenMetadataSynced.bank_denom":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenMetadataSynced.erc20_contract_address":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenMetadataSynced.sender":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenMetadataSynced"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenMetadataSynced does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventFunTokenMetadataSynced) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventFunTokenMetadataSynced", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventFunTokenMetadataSynced) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenMetadataSynced) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventFunTokenMetadataSynced) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventFunTokenMetadataSynced) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventFunTokenMetadataSynced)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BankDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc20ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventFunTokenMetadataSynced)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Erc20ContractAddress) > 0 {
+			i -= len(x.Erc20ContractAddress)
+			copy(dAtA[i:], x.Erc20ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BankDenom) > 0 {
+			i -= len(x.BankDenom)
+			copy(dAtA[i:], x.BankDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventFunTokenMetadataSynced)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunTokenMetadataSynced: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunTokenMetadataSynced: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventFunTokenDisabledSet                        protoreflect.MessageDescriptor
+	fd_EventFunTokenDisabledSet_bank_denom             protoreflect.FieldDescriptor
+	fd_EventFunTokenDisabledSet_erc20_contract_address protoreflect.FieldDescriptor
+	fd_EventFunTokenDisabledSet_sender                 protoreflect.FieldDescriptor
+	fd_EventFunTokenDisabledSet_disabled               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventFunTokenDisabledSet = File_eth_evm_v1_events_proto.Messages().ByName("EventFunTokenDisabledSet")
+	fd_EventFunTokenDisabledSet_bank_denom = md_EventFunTokenDisabledSet.Fields().ByName("bank_denom")
+	fd_EventFunTokenDisabledSet_erc20_contract_address = md_EventFunTokenDisabledSet.Fields().ByName("erc20_contract_address")
+	fd_EventFunTokenDisabledSet_sender = md_EventFunTokenDisabledSet.Fields().ByName("sender")
+	fd_EventFunTokenDisabledSet_disabled = md_EventFunTokenDisabledSet.Fields().ByName("disabled")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventFunTokenDisabledSet)(nil)
+
+type fastReflection_EventFunTokenDisabledSet EventFunTokenDisabledSet
+
+func (x *EventFunTokenDisabledSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventFunTokenDisabledSet)(x)
+}
+
+func (x *EventFunTokenDisabledSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventFunTokenDisabledSet_messageType fastReflection_EventFunTokenDisabledSet_messageType
+var _ protoreflect.MessageType = fastReflection_EventFunTokenDisabledSet_messageType{}
+
+type fastReflection_EventFunTokenDisabledSet_messageType struct{}
+
+func (x fastReflection_EventFunTokenDisabledSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventFunTokenDisabledSet)(nil)
+}
+func (x fastReflection_EventFunTokenDisabledSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventFunTokenDisabledSet)
+}
+func (x fastReflection_EventFunTokenDisabledSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunTokenDisabledSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventFunTokenDisabledSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunTokenDisabledSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventFunTokenDisabledSet) Type() protoreflect.MessageType {
+	return _fastReflection_EventFunTokenDisabledSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventFunTokenDisabledSet) New() protoreflect.Message {
+	return new(fastReflection_EventFunTokenDisabledSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventFunTokenDisabledSet) Interface() protoreflect.ProtoMessage {
+	return (*EventFunTokenDisabledSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventFunTokenDisabledSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BankDenom != "" {
+		value := protoreflect.ValueOfString(x.BankDenom)
+		if !f(fd_EventFunTokenDisabledSet_bank_denom, value) {
+			return
+		}
+	}
+	if x.Erc20ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.Erc20ContractAddress)
+		if !f(fd_EventFunTokenDisabledSet_erc20_contract_address, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventFunTokenDisabledSet_sender, value) {
+			return
+		}
+	}
+	if x.Disabled != false {
+		value := protoreflect.ValueOfBool(x.Disabled)
+		if !f(fd_EventFunTokenDisabledSet_disabled, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventFunTokenDisabledSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenDisabledSet.bank_denom":
+		return x.BankDenom != ""
+	case "eth.evm.v1.EventFunTokenDisabledSet.erc20_contract_address":
+		return x.Erc20ContractAddress != ""
+	case "eth.evm.v1.EventFunTokenDisabledSet.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.EventFunTokenDisabledSet.disabled":
+		return x.Disabled != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenDisabledSet"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenDisabledSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenDisabledSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenDisabledSet.bank_denom":
+		x.BankDenom = ""
+	case "eth.evm.v1.EventFunTokenDisabledSet.erc20_contract_address":
+		x.Erc20ContractAddress = ""
+	case "eth.evm.v1.EventFunTokenDisabledSet.sender":
+		x.Sender = ""
+	case "eth.evm.v1.EventFunTokenDisabledSet.disabled":
+		x.Disabled = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenDisabledSet"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenDisabledSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventFunTokenDisabledSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventFunTokenDisabledSet.bank_denom":
+		value := x.BankDenom
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenDisabledSet.erc20_contract_address":
+		value := x.Erc20ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenDisabledSet.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenDisabledSet.disabled":
+		value := x.Disabled
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenDisabledSet"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenDisabledSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenDisabledSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenDisabledSet.bank_denom":
+		x.BankDenom = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenDisabledSet.erc20_contract_address":
+		x.Erc20ContractAddress = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenDisabledSet.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenDisabledSet.disabled":
+		x.Disabled = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenDisabledSet"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenDisabledSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenDisabledSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenDisabledSet.bank_denom":
+		panic(fmt.Errorf("field bank_denom of message eth.evm.v1.EventFunTokenDisabledSet is not mutable"))
+	case "eth.evm.v1.EventFunTokenDisabledSet.erc20_contract_address":
+		panic(fmt.Errorf("field erc20_contract_address of message eth.evm.v1.EventFunTokenDisabledSet is not mutable"))
+	case "eth.evm.v1.EventFunTokenDisabledSet.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventFunTokenDisabledSet is not mutable"))
+	case "eth.evm.v1.EventFunTokenDisabledSet.disabled":
+		panic(fmt.Errorf("field disabled of message eth.evm.v1.EventFunTokenDisabledSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenDisabledSet"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenDisabledSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventFunTokenDisabledSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenDisabledSet.bank_denom":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenDisabledSet.erc20_contract_address":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenDisabledSet.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenDisabledSet.disabled":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenDisabledSet"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenDisabledSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventFunTokenDisabledSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventFunTokenDisabledSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventFunTokenDisabledSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenDisabledSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventFunTokenDisabledSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventFunTokenDisabledSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventFunTokenDisabledSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BankDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc20ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Disabled {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventFunTokenDisabledSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Disabled {
+			i--
+			if x.Disabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Erc20ContractAddress) > 0 {
+			i -= len(x.Erc20ContractAddress)
+			copy(dAtA[i:], x.Erc20ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BankDenom) > 0 {
+			i -= len(x.BankDenom)
+			copy(dAtA[i:], x.BankDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventFunTokenDisabledSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunTokenDisabledSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunTokenDisabledSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Disabled = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventFunTokenMigrated                            protoreflect.MessageDescriptor
+	fd_EventFunTokenMigrated_bank_denom                 protoreflect.FieldDescriptor
+	fd_EventFunTokenMigrated_old_erc20_contract_address protoreflect.FieldDescriptor
+	fd_EventFunTokenMigrated_new_erc20_contract_address protoreflect.FieldDescriptor
+	fd_EventFunTokenMigrated_sender                     protoreflect.FieldDescriptor
+	fd_EventFunTokenMigrated_old_erc20_recipient        protoreflect.FieldDescriptor
+	fd_EventFunTokenMigrated_old_erc20_amount           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventFunTokenMigrated = File_eth_evm_v1_events_proto.Messages().ByName("EventFunTokenMigrated")
+	fd_EventFunTokenMigrated_bank_denom = md_EventFunTokenMigrated.Fields().ByName("bank_denom")
+	fd_EventFunTokenMigrated_old_erc20_contract_address = md_EventFunTokenMigrated.Fields().ByName("old_erc20_contract_address")
+	fd_EventFunTokenMigrated_new_erc20_contract_address = md_EventFunTokenMigrated.Fields().ByName("new_erc20_contract_address")
+	fd_EventFunTokenMigrated_sender = md_EventFunTokenMigrated.Fields().ByName("sender")
+	fd_EventFunTokenMigrated_old_erc20_recipient = md_EventFunTokenMigrated.Fields().ByName("old_erc20_recipient")
+	fd_EventFunTokenMigrated_old_erc20_amount = md_EventFunTokenMigrated.Fields().ByName("old_erc20_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventFunTokenMigrated)(nil)
+
+type fastReflection_EventFunTokenMigrated EventFunTokenMigrated
+
+func (x *EventFunTokenMigrated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventFunTokenMigrated)(x)
+}
+
+func (x *EventFunTokenMigrated) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventFunTokenMigrated_messageType fastReflection_EventFunTokenMigrated_messageType
+var _ protoreflect.MessageType = fastReflection_EventFunTokenMigrated_messageType{}
+
+type fastReflection_EventFunTokenMigrated_messageType struct{}
+
+func (x fastReflection_EventFunTokenMigrated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventFunTokenMigrated)(nil)
+}
+func (x fastReflection_EventFunTokenMigrated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventFunTokenMigrated)
+}
+func (x fastReflection_EventFunTokenMigrated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunTokenMigrated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventFunTokenMigrated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunTokenMigrated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventFunTokenMigrated) Type() protoreflect.MessageType {
+	return _fastReflection_EventFunTokenMigrated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventFunTokenMigrated) New() protoreflect.Message {
+	return new(fastReflection_EventFunTokenMigrated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventFunTokenMigrated) Interface() protoreflect.ProtoMessage {
+	return (*EventFunTokenMigrated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventFunTokenMigrated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BankDenom != "" {
+		value := protoreflect.ValueOfString(x.BankDenom)
+		if !f(fd_EventFunTokenMigrated_bank_denom, value) {
+			return
+		}
+	}
+	if x.OldErc20ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.OldErc20ContractAddress)
+		if !f(fd_EventFunTokenMigrated_old_erc20_contract_address, value) {
+			return
+		}
+	}
+	if x.NewErc20ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.NewErc20ContractAddress)
+		if !f(fd_EventFunTokenMigrated_new_erc20_contract_address, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventFunTokenMigrated_sender, value) {
+			return
+		}
+	}
+	if x.OldErc20Recipient != "" {
+		value := protoreflect.ValueOfString(x.OldErc20Recipient)
+		if !f(fd_EventFunTokenMigrated_old_erc20_recipient, value) {
+			return
+		}
+	}
+	if x.OldErc20Amount != "" {
+		value := protoreflect.ValueOfString(x.OldErc20Amount)
+		if !f(fd_EventFunTokenMigrated_old_erc20_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventFunTokenMigrated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenMigrated.bank_denom":
+		return x.BankDenom != ""
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_contract_address":
+		return x.OldErc20ContractAddress != ""
+	case "eth.evm.v1.EventFunTokenMigrated.new_erc20_contract_address":
+		return x.NewErc20ContractAddress != ""
+	case "eth.evm.v1.EventFunTokenMigrated.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_recipient":
+		return x.OldErc20Recipient != ""
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_amount":
+		return x.OldErc20Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenMigrated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenMigrated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenMigrated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenMigrated.bank_denom":
+		x.BankDenom = ""
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_contract_address":
+		x.OldErc20ContractAddress = ""
+	case "eth.evm.v1.EventFunTokenMigrated.new_erc20_contract_address":
+		x.NewErc20ContractAddress = ""
+	case "eth.evm.v1.EventFunTokenMigrated.sender":
+		x.Sender = ""
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_recipient":
+		x.OldErc20Recipient = ""
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_amount":
+		x.OldErc20Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenMigrated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenMigrated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventFunTokenMigrated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventFunTokenMigrated.bank_denom":
+		value := x.BankDenom
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_contract_address":
+		value := x.OldErc20ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenMigrated.new_erc20_contract_address":
+		value := x.NewErc20ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenMigrated.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_recipient":
+		value := x.OldErc20Recipient
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_amount":
+		value := x.OldErc20Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenMigrated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenMigrated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenMigrated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenMigrated.bank_denom":
+		x.BankDenom = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_contract_address":
+		x.OldErc20ContractAddress = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenMigrated.new_erc20_contract_address":
+		x.NewErc20ContractAddress = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenMigrated.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_recipient":
+		x.OldErc20Recipient = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_amount":
+		x.OldErc20Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenMigrated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenMigrated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenMigrated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenMigrated.bank_denom":
+		panic(fmt.Errorf("field bank_denom of message eth.evm.v1.EventFunTokenMigrated is not mutable"))
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_contract_address":
+		panic(fmt.Errorf("field old_erc20_contract_address of message eth.evm.v1.EventFunTokenMigrated is not mutable"))
+	case "eth.evm.v1.EventFunTokenMigrated.new_erc20_contract_address":
+		panic(fmt.Errorf("field new_erc20_contract_address of message eth.evm.v1.EventFunTokenMigrated is not mutable"))
+	case "eth.evm.v1.EventFunTokenMigrated.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventFunTokenMigrated is not mutable"))
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_recipient":
+		panic(fmt.Errorf("field old_erc20_recipient of message eth.evm.v1.EventFunTokenMigrated is not mutable"))
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_amount":
+		panic(fmt.Errorf("field old_erc20_amount of message eth.evm.v1.EventFunTokenMigrated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenMigrated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenMigrated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventFunTokenMigrated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenMigrated.bank_denom":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_contract_address":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenMigrated.new_erc20_contract_address":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenMigrated.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_recipient":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenMigrated.old_erc20_amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenMigrated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenMigrated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventFunTokenMigrated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventFunTokenMigrated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventFunTokenMigrated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenMigrated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventFunTokenMigrated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventFunTokenMigrated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventFunTokenMigrated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BankDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OldErc20ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewErc20ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OldErc20Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OldErc20Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventFunTokenMigrated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OldErc20Amount) > 0 {
+			i -= len(x.OldErc20Amount)
+			copy(dAtA[i:], x.OldErc20Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldErc20Amount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.OldErc20Recipient) > 0 {
+			i -= len(x.OldErc20Recipient)
+			copy(dAtA[i:], x.OldErc20Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldErc20Recipient)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NewErc20ContractAddress) > 0 {
+			i -= len(x.NewErc20ContractAddress)
+			copy(dAtA[i:], x.NewErc20ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewErc20ContractAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.OldErc20ContractAddress) > 0 {
+			i -= len(x.OldErc20ContractAddress)
+			copy(dAtA[i:], x.OldErc20ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldErc20ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BankDenom) > 0 {
+			i -= len(x.BankDenom)
+			copy(dAtA[i:], x.BankDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventFunTokenMigrated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunTokenMigrated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunTokenMigrated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldErc20ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldErc20ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewErc20ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewErc20ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldErc20Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldErc20Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldErc20Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldErc20Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
 	md_EventConvertCoinToEvm                        protoreflect.MessageDescriptor
 	fd_EventConvertCoinToEvm_sender                 protoreflect.FieldDescriptor
@@ -2344,23 +4234,650 @@ var (
 
 func init() {
 	file_eth_evm_v1_events_proto_init()
-	md_EventConvertCoinToEvm = File_eth_evm_v1_events_proto.Messages().ByName("EventConvertCoinToEvm")
-	fd_EventConvertCoinToEvm_sender = md_EventConvertCoinToEvm.Fields().ByName("sender")
-	fd_EventConvertCoinToEvm_erc20_contract_address = md_EventConvertCoinToEvm.Fields().ByName("erc20_contract_address")
-	fd_EventConvertCoinToEvm_to_eth_addr = md_EventConvertCoinToEvm.Fields().ByName("to_eth_addr")
-	fd_EventConvertCoinToEvm_bank_coin = md_EventConvertCoinToEvm.Fields().ByName("bank_coin")
+	md_EventConvertCoinToEvm = File_eth_evm_v1_events_proto.Messages().ByName("EventConvertCoinToEvm")
+	fd_EventConvertCoinToEvm_sender = md_EventConvertCoinToEvm.Fields().ByName("sender")
+	fd_EventConvertCoinToEvm_erc20_contract_address = md_EventConvertCoinToEvm.Fields().ByName("erc20_contract_address")
+	fd_EventConvertCoinToEvm_to_eth_addr = md_EventConvertCoinToEvm.Fields().ByName("to_eth_addr")
+	fd_EventConvertCoinToEvm_bank_coin = md_EventConvertCoinToEvm.Fields().ByName("bank_coin")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventConvertCoinToEvm)(nil)
+
+type fastReflection_EventConvertCoinToEvm EventConvertCoinToEvm
+
+func (x *EventConvertCoinToEvm) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventConvertCoinToEvm)(x)
+}
+
+func (x *EventConvertCoinToEvm) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventConvertCoinToEvm_messageType fastReflection_EventConvertCoinToEvm_messageType
+var _ protoreflect.MessageType = fastReflection_EventConvertCoinToEvm_messageType{}
+
+type fastReflection_EventConvertCoinToEvm_messageType struct{}
+
+func (x fastReflection_EventConvertCoinToEvm_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventConvertCoinToEvm)(nil)
+}
+func (x fastReflection_EventConvertCoinToEvm_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventConvertCoinToEvm)
+}
+func (x fastReflection_EventConvertCoinToEvm_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventConvertCoinToEvm
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventConvertCoinToEvm) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventConvertCoinToEvm
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventConvertCoinToEvm) Type() protoreflect.MessageType {
+	return _fastReflection_EventConvertCoinToEvm_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventConvertCoinToEvm) New() protoreflect.Message {
+	return new(fastReflection_EventConvertCoinToEvm)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventConvertCoinToEvm) Interface() protoreflect.ProtoMessage {
+	return (*EventConvertCoinToEvm)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventConvertCoinToEvm) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventConvertCoinToEvm_sender, value) {
+			return
+		}
+	}
+	if x.Erc20ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.Erc20ContractAddress)
+		if !f(fd_EventConvertCoinToEvm_erc20_contract_address, value) {
+			return
+		}
+	}
+	if x.ToEthAddr != "" {
+		value := protoreflect.ValueOfString(x.ToEthAddr)
+		if !f(fd_EventConvertCoinToEvm_to_eth_addr, value) {
+			return
+		}
+	}
+	if x.BankCoin != nil {
+		value := protoreflect.ValueOfMessage(x.BankCoin.ProtoReflect())
+		if !f(fd_EventConvertCoinToEvm_bank_coin, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventConvertCoinToEvm) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertCoinToEvm.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.EventConvertCoinToEvm.erc20_contract_address":
+		return x.Erc20ContractAddress != ""
+	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
+		return x.ToEthAddr != ""
+	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
+		return x.BankCoin != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertCoinToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertCoinToEvm) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertCoinToEvm.sender":
+		x.Sender = ""
+	case "eth.evm.v1.EventConvertCoinToEvm.erc20_contract_address":
+		x.Erc20ContractAddress = ""
+	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
+		x.ToEthAddr = ""
+	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
+		x.BankCoin = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertCoinToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventConvertCoinToEvm) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventConvertCoinToEvm.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertCoinToEvm.erc20_contract_address":
+		value := x.Erc20ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
+		value := x.ToEthAddr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
+		value := x.BankCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertCoinToEvm does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertCoinToEvm) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertCoinToEvm.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.EventConvertCoinToEvm.erc20_contract_address":
+		x.Erc20ContractAddress = value.Interface().(string)
+	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
+		x.ToEthAddr = value.Interface().(string)
+	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
+		x.BankCoin = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertCoinToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertCoinToEvm) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
+		if x.BankCoin == nil {
+			x.BankCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.BankCoin.ProtoReflect())
+	case "eth.evm.v1.EventConvertCoinToEvm.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventConvertCoinToEvm is not mutable"))
+	case "eth.evm.v1.EventConvertCoinToEvm.erc20_contract_address":
+		panic(fmt.Errorf("field erc20_contract_address of message eth.evm.v1.EventConvertCoinToEvm is not mutable"))
+	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
+		panic(fmt.Errorf("field to_eth_addr of message eth.evm.v1.EventConvertCoinToEvm is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertCoinToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventConvertCoinToEvm) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertCoinToEvm.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertCoinToEvm.erc20_contract_address":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertCoinToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventConvertCoinToEvm) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventConvertCoinToEvm", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventConvertCoinToEvm) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertCoinToEvm) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventConvertCoinToEvm) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventConvertCoinToEvm)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc20ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToEthAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BankCoin != nil {
+			l = options.Size(x.BankCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventConvertCoinToEvm)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BankCoin != nil {
+			encoded, err := options.Marshal(x.BankCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ToEthAddr) > 0 {
+			i -= len(x.ToEthAddr)
+			copy(dAtA[i:], x.ToEthAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToEthAddr)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Erc20ContractAddress) > 0 {
+			i -= len(x.Erc20ContractAddress)
+			copy(dAtA[i:], x.Erc20ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventConvertCoinToEvm)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertCoinToEvm: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertCoinToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToEthAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BankCoin == nil {
+					x.BankCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BankCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventConvertEvmToCoin                        protoreflect.MessageDescriptor
+	fd_EventConvertEvmToCoin_sender                 protoreflect.FieldDescriptor
+	fd_EventConvertEvmToCoin_erc20_contract_address protoreflect.FieldDescriptor
+	fd_EventConvertEvmToCoin_to_addr                protoreflect.FieldDescriptor
+	fd_EventConvertEvmToCoin_bank_coin              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventConvertEvmToCoin = File_eth_evm_v1_events_proto.Messages().ByName("EventConvertEvmToCoin")
+	fd_EventConvertEvmToCoin_sender = md_EventConvertEvmToCoin.Fields().ByName("sender")
+	fd_EventConvertEvmToCoin_erc20_contract_address = md_EventConvertEvmToCoin.Fields().ByName("erc20_contract_address")
+	fd_EventConvertEvmToCoin_to_addr = md_EventConvertEvmToCoin.Fields().ByName("to_addr")
+	fd_EventConvertEvmToCoin_bank_coin = md_EventConvertEvmToCoin.Fields().ByName("bank_coin")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventConvertCoinToEvm)(nil)
+var _ protoreflect.Message = (*fastReflection_EventConvertEvmToCoin)(nil)
 
-type fastReflection_EventConvertCoinToEvm EventConvertCoinToEvm
+type fastReflection_EventConvertEvmToCoin EventConvertEvmToCoin
 
-func (x *EventConvertCoinToEvm) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventConvertCoinToEvm)(x)
+func (x *EventConvertEvmToCoin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventConvertEvmToCoin)(x)
 }
 
-func (x *EventConvertCoinToEvm) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_events_proto_msgTypes[4]
+func (x *EventConvertEvmToCoin) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2371,43 +4888,43 @@ func (x *EventConvertCoinToEvm) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventConvertCoinToEvm_messageType fastReflection_EventConvertCoinToEvm_messageType
-var _ protoreflect.MessageType = fastReflection_EventConvertCoinToEvm_messageType{}
+var _fastReflection_EventConvertEvmToCoin_messageType fastReflection_EventConvertEvmToCoin_messageType
+var _ protoreflect.MessageType = fastReflection_EventConvertEvmToCoin_messageType{}
 
-type fastReflection_EventConvertCoinToEvm_messageType struct{}
+type fastReflection_EventConvertEvmToCoin_messageType struct{}
 
-func (x fastReflection_EventConvertCoinToEvm_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventConvertCoinToEvm)(nil)
+func (x fastReflection_EventConvertEvmToCoin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventConvertEvmToCoin)(nil)
 }
-func (x fastReflection_EventConvertCoinToEvm_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventConvertCoinToEvm)
+func (x fastReflection_EventConvertEvmToCoin_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventConvertEvmToCoin)
 }
-func (x fastReflection_EventConvertCoinToEvm_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventConvertCoinToEvm
+func (x fastReflection_EventConvertEvmToCoin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventConvertEvmToCoin
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventConvertCoinToEvm) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventConvertCoinToEvm
+func (x *fastReflection_EventConvertEvmToCoin) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventConvertEvmToCoin
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventConvertCoinToEvm) Type() protoreflect.MessageType {
-	return _fastReflection_EventConvertCoinToEvm_messageType
+func (x *fastReflection_EventConvertEvmToCoin) Type() protoreflect.MessageType {
+	return _fastReflection_EventConvertEvmToCoin_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventConvertCoinToEvm) New() protoreflect.Message {
-	return new(fastReflection_EventConvertCoinToEvm)
+func (x *fastReflection_EventConvertEvmToCoin) New() protoreflect.Message {
+	return new(fastReflection_EventConvertEvmToCoin)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventConvertCoinToEvm) Interface() protoreflect.ProtoMessage {
-	return (*EventConvertCoinToEvm)(x)
+func (x *fastReflection_EventConvertEvmToCoin) Interface() protoreflect.ProtoMessage {
+	return (*EventConvertEvmToCoin)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2415,28 +4932,28 @@ func (x *fastReflection_EventConvertCoinToEvm) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventConvertCoinToEvm) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventConvertEvmToCoin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Sender != "" {
 		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_EventConvertCoinToEvm_sender, value) {
+		if !f(fd_EventConvertEvmToCoin_sender, value) {
 			return
 		}
 	}
 	if x.Erc20ContractAddress != "" {
 		value := protoreflect.ValueOfString(x.Erc20ContractAddress)
-		if !f(fd_EventConvertCoinToEvm_erc20_contract_address, value) {
+		if !f(fd_EventConvertEvmToCoin_erc20_contract_address, value) {
 			return
 		}
 	}
-	if x.ToEthAddr != "" {
-		value := protoreflect.ValueOfString(x.ToEthAddr)
-		if !f(fd_EventConvertCoinToEvm_to_eth_addr, value) {
+	if x.ToAddr != "" {
+		value := protoreflect.ValueOfString(x.ToAddr)
+		if !f(fd_EventConvertEvmToCoin_to_addr, value) {
 			return
 		}
 	}
 	if x.BankCoin != nil {
 		value := protoreflect.ValueOfMessage(x.BankCoin.ProtoReflect())
-		if !f(fd_EventConvertCoinToEvm_bank_coin, value) {
+		if !f(fd_EventConvertEvmToCoin_bank_coin, value) {
 			return
 		}
 	}
@@ -2453,21 +4970,21 @@ func (x *fastReflection_EventConvertCoinToEvm) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventConvertCoinToEvm) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventConvertEvmToCoin) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventConvertCoinToEvm.sender":
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
 		return x.Sender != ""
-	case "eth.evm.v1.EventConvertCoinToEvm.erc20_contract_address":
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
 		return x.Erc20ContractAddress != ""
-	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
-		return x.ToEthAddr != ""
-	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
+	case "eth.evm.v1.EventConvertEvmToCoin.to_addr":
+		return x.ToAddr != ""
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
 		return x.BankCoin != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventConvertCoinToEvm does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2477,21 +4994,21 @@ func (x *fastReflection_EventConvertCoinToEvm) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventConvertCoinToEvm) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventConvertEvmToCoin) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventConvertCoinToEvm.sender":
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
 		x.Sender = ""
-	case "eth.evm.v1.EventConvertCoinToEvm.erc20_contract_address":
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
 		x.Erc20ContractAddress = ""
-	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
-		x.ToEthAddr = ""
-	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
+	case "eth.evm.v1.EventConvertEvmToCoin.to_addr":
+		x.ToAddr = ""
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
 		x.BankCoin = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventConvertCoinToEvm does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2501,25 +5018,25 @@ func (x *fastReflection_EventConvertCoinToEvm) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventConvertCoinToEvm) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventConvertEvmToCoin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.EventConvertCoinToEvm.sender":
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
 		value := x.Sender
 		return protoreflect.ValueOfString(value)
-	case "eth.evm.v1.EventConvertCoinToEvm.erc20_contract_address":
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
 		value := x.Erc20ContractAddress
 		return protoreflect.ValueOfString(value)
-	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
-		value := x.ToEthAddr
+	case "eth.evm.v1.EventConvertEvmToCoin.to_addr":
+		value := x.ToAddr
 		return protoreflect.ValueOfString(value)
-	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
 		value := x.BankCoin
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventConvertCoinToEvm does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2533,21 +5050,21 @@ func (x *fastReflection_EventConvertCoinToEvm) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventConvertCoinToEvm) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventConvertEvmToCoin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventConvertCoinToEvm.sender":
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
 		x.Sender = value.Interface().(string)
-	case "eth.evm.v1.EventConvertCoinToEvm.erc20_contract_address":
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
 		x.Erc20ContractAddress = value.Interface().(string)
-	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
-		x.ToEthAddr = value.Interface().(string)
-	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
+	case "eth.evm.v1.EventConvertEvmToCoin.to_addr":
+		x.ToAddr = value.Interface().(string)
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
 		x.BankCoin = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventConvertCoinToEvm does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2561,56 +5078,56 @@ func (x *fastReflection_EventConvertCoinToEvm) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventConvertCoinToEvm) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventConvertEvmToCoin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
 		if x.BankCoin == nil {
 			x.BankCoin = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.BankCoin.ProtoReflect())
-	case "eth.evm.v1.EventConvertCoinToEvm.sender":
-		panic(fmt.Errorf("field sender of message eth.evm.v1.EventConvertCoinToEvm is not mutable"))
-	case "eth.evm.v1.EventConvertCoinToEvm.erc20_contract_address":
-		panic(fmt.Errorf("field erc20_contract_address of message eth.evm.v1.EventConvertCoinToEvm is not mutable"))
-	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
-		panic(fmt.Errorf("field to_eth_addr of message eth.evm.v1.EventConvertCoinToEvm is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
+		panic(fmt.Errorf("field erc20_contract_address of message eth.evm.v1.EventConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToCoin.to_addr":
+		panic(fmt.Errorf("field to_addr of message eth.evm.v1.EventConvertEvmToCoin is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventConvertCoinToEvm does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventConvertCoinToEvm) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventConvertEvmToCoin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventConvertCoinToEvm.sender":
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
 		return protoreflect.ValueOfString("")
-	case "eth.evm.v1.EventConvertCoinToEvm.erc20_contract_address":
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
 		return protoreflect.ValueOfString("")
-	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
+	case "eth.evm.v1.EventConvertEvmToCoin.to_addr":
 		return protoreflect.ValueOfString("")
-	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventConvertCoinToEvm does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventConvertCoinToEvm) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventConvertEvmToCoin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventConvertCoinToEvm", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventConvertEvmToCoin", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2618,7 +5135,7 @@ func (x *fastReflection_EventConvertCoinToEvm) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventConvertCoinToEvm) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventConvertEvmToCoin) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2629,7 +5146,7 @@ func (x *fastReflection_EventConvertCoinToEvm) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventConvertCoinToEvm) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventConvertEvmToCoin) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2641,7 +5158,7 @@ func (x *fastReflection_EventConvertCoinToEvm) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventConvertCoinToEvm) IsValid() bool {
+func (x *fastReflection_EventConvertEvmToCoin) IsValid() bool {
 	return x != nil
 }
 
@@ -2651,9 +5168,9 @@ func (x *fastReflection_EventConvertCoinToEvm) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventConvertEvmToCoin) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventConvertCoinToEvm)
+		x := input.Message.Interface().(*EventConvertEvmToCoin)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2673,7 +5190,7 @@ func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Method
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ToEthAddr)
+		l = len(x.ToAddr)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2691,7 +5208,7 @@ func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventConvertCoinToEvm)
+		x := input.Message.Interface().(*EventConvertEvmToCoin)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2724,10 +5241,10 @@ func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Method
 			i--
 			dAtA[i] = 0x22
 		}
-		if len(x.ToEthAddr) > 0 {
-			i -= len(x.ToEthAddr)
-			copy(dAtA[i:], x.ToEthAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToEthAddr)))
+		if len(x.ToAddr) > 0 {
+			i -= len(x.ToAddr)
+			copy(dAtA[i:], x.ToAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddr)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -2756,7 +5273,7 @@ func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventConvertCoinToEvm)
+		x := input.Message.Interface().(*EventConvertEvmToCoin)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2788,10 +5305,10 @@ func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertCoinToEvm: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertEvmToCoin: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertCoinToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2860,7 +5377,7 @@ func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Method
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2888,7 +5405,7 @@ func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ToEthAddr = string(dAtA[iNdEx:postIndex])
+				x.ToAddr = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
@@ -2985,7 +5502,7 @@ func (x *EventTransfer) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventTransfer) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_events_proto_msgTypes[5]
+	mi := &file_eth_evm_v1_events_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3531,7 +6048,7 @@ func (x *EventContractDeployed) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventContractDeployed) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_events_proto_msgTypes[6]
+	mi := &file_eth_evm_v1_events_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4015,7 +6532,7 @@ func (x *EventContractExecuted) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventContractExecuted) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_events_proto_msgTypes[7]
+	mi := &file_eth_evm_v1_events_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4717,6 +7234,197 @@ func (x *EventFunTokenCreated) GetIsMadeFromCoin() bool {
 	return false
 }
 
+// EventFunTokenMetadataSynced defines a refresh of the metadata of a fun
+// token mapping from its source.
+type EventFunTokenMetadataSynced struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BankDenom            string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	Erc20ContractAddress string `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	Sender               string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *EventFunTokenMetadataSynced) Reset() {
+	*x = EventFunTokenMetadataSynced{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFunTokenMetadataSynced) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFunTokenMetadataSynced) ProtoMessage() {}
+
+// Deprecated: Use EventFunTokenMetadataSynced.ProtoReflect.Descriptor instead.
+func (*EventFunTokenMetadataSynced) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventFunTokenMetadataSynced) GetBankDenom() string {
+	if x != nil {
+		return x.BankDenom
+	}
+	return ""
+}
+
+func (x *EventFunTokenMetadataSynced) GetErc20ContractAddress() string {
+	if x != nil {
+		return x.Erc20ContractAddress
+	}
+	return ""
+}
+
+func (x *EventFunTokenMetadataSynced) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+// EventFunTokenDisabledSet defines a fun token event that pauses or resumes
+// its conversions.
+type EventFunTokenDisabledSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BankDenom            string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	Erc20ContractAddress string `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	Sender               string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Disabled             bool   `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *EventFunTokenDisabledSet) Reset() {
+	*x = EventFunTokenDisabledSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFunTokenDisabledSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFunTokenDisabledSet) ProtoMessage() {}
+
+// Deprecated: Use EventFunTokenDisabledSet.ProtoReflect.Descriptor instead.
+func (*EventFunTokenDisabledSet) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventFunTokenDisabledSet) GetBankDenom() string {
+	if x != nil {
+		return x.BankDenom
+	}
+	return ""
+}
+
+func (x *EventFunTokenDisabledSet) GetErc20ContractAddress() string {
+	if x != nil {
+		return x.Erc20ContractAddress
+	}
+	return ""
+}
+
+func (x *EventFunTokenDisabledSet) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventFunTokenDisabledSet) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+// EventFunTokenMigrated defines a fun token event that moves the mapping to a
+// new ERC20 contract.
+type EventFunTokenMigrated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BankDenom               string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	OldErc20ContractAddress string `protobuf:"bytes,2,opt,name=old_erc20_contract_address,json=oldErc20ContractAddress,proto3" json:"old_erc20_contract_address,omitempty"`
+	NewErc20ContractAddress string `protobuf:"bytes,3,opt,name=new_erc20_contract_address,json=newErc20ContractAddress,proto3" json:"new_erc20_contract_address,omitempty"`
+	Sender                  string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	OldErc20Recipient       string `protobuf:"bytes,5,opt,name=old_erc20_recipient,json=oldErc20Recipient,proto3" json:"old_erc20_recipient,omitempty"`
+	OldErc20Amount          string `protobuf:"bytes,6,opt,name=old_erc20_amount,json=oldErc20Amount,proto3" json:"old_erc20_amount,omitempty"`
+}
+
+func (x *EventFunTokenMigrated) Reset() {
+	*x = EventFunTokenMigrated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFunTokenMigrated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFunTokenMigrated) ProtoMessage() {}
+
+// Deprecated: Use EventFunTokenMigrated.ProtoReflect.Descriptor instead.
+func (*EventFunTokenMigrated) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventFunTokenMigrated) GetBankDenom() string {
+	if x != nil {
+		return x.BankDenom
+	}
+	return ""
+}
+
+func (x *EventFunTokenMigrated) GetOldErc20ContractAddress() string {
+	if x != nil {
+		return x.OldErc20ContractAddress
+	}
+	return ""
+}
+
+func (x *EventFunTokenMigrated) GetNewErc20ContractAddress() string {
+	if x != nil {
+		return x.NewErc20ContractAddress
+	}
+	return ""
+}
+
+func (x *EventFunTokenMigrated) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventFunTokenMigrated) GetOldErc20Recipient() string {
+	if x != nil {
+		return x.OldErc20Recipient
+	}
+	return ""
+}
+
+func (x *EventFunTokenMigrated) GetOldErc20Amount() string {
+	if x != nil {
+		return x.OldErc20Amount
+	}
+	return ""
+}
+
 // ConvertCoinToEvm defines sending fun token to erc20 event.
 type EventConvertCoinToEvm struct {
 	state         protoimpl.MessageState
@@ -4732,7 +7440,7 @@ type EventConvertCoinToEvm struct {
 func (x *EventConvertCoinToEvm) Reset() {
 	*x = EventConvertCoinToEvm{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[4]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4746,7 +7454,7 @@ func (*EventConvertCoinToEvm) ProtoMessage() {}
 
 // Deprecated: Use EventConvertCoinToEvm.ProtoReflect.Descriptor instead.
 func (*EventConvertCoinToEvm) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{4}
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EventConvertCoinToEvm) GetSender() string {
@@ -4777,6 +7485,67 @@ func (x *EventConvertCoinToEvm) GetBankCoin() *v1beta1.Coin {
 	return nil
 }
 
+// EventConvertEvmToCoin defines the conversion of ERC20 tokens of a FunToken
+// to bank coins with MsgConvertEvmToCoin.
+type EventConvertEvmToCoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender               string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc20ContractAddress string        `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	ToAddr               string        `protobuf:"bytes,3,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	BankCoin             *v1beta1.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
+}
+
+func (x *EventConvertEvmToCoin) Reset() {
+	*x = EventConvertEvmToCoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventConvertEvmToCoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventConvertEvmToCoin) ProtoMessage() {}
+
+// Deprecated: Use EventConvertEvmToCoin.ProtoReflect.Descriptor instead.
+func (*EventConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventConvertEvmToCoin) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventConvertEvmToCoin) GetErc20ContractAddress() string {
+	if x != nil {
+		return x.Erc20ContractAddress
+	}
+	return ""
+}
+
+func (x *EventConvertEvmToCoin) GetToAddr() string {
+	if x != nil {
+		return x.ToAddr
+	}
+	return ""
+}
+
+func (x *EventConvertEvmToCoin) GetBankCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.BankCoin
+	}
+	return nil
+}
+
 // EventTransfer defines event for EVM transfer
 type EventTransfer struct {
 	state         protoimpl.MessageState
@@ -4791,7 +7560,7 @@ type EventTransfer struct {
 func (x *EventTransfer) Reset() {
 	*x = EventTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[5]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4805,7 +7574,7 @@ func (*EventTransfer) ProtoMessage() {}
 
 // Deprecated: Use EventTransfer.ProtoReflect.Descriptor instead.
 func (*EventTransfer) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{5}
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EventTransfer) GetSender() string {
@@ -4842,7 +7611,7 @@ type EventContractDeployed struct {
 func (x *EventContractDeployed) Reset() {
 	*x = EventContractDeployed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[6]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4856,7 +7625,7 @@ func (*EventContractDeployed) ProtoMessage() {}
 
 // Deprecated: Use EventContractDeployed.ProtoReflect.Descriptor instead.
 func (*EventContractDeployed) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{6}
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *EventContractDeployed) GetSender() string {
@@ -4886,7 +7655,7 @@ type EventContractExecuted struct {
 func (x *EventContractExecuted) Reset() {
 	*x = EventContractExecuted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[7]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4900,7 +7669,7 @@ func (*EventContractExecuted) ProtoMessage() {}
 
 // Deprecated: Use EventContractExecuted.ProtoReflect.Descriptor instead.
 func (*EventContractExecuted) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{7}
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EventContractExecuted) GetSender() string {
@@ -4956,47 +7725,97 @@ var file_eth_evm_v1_events_proto_rawDesc = []byte{
 	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
 	0x12, 0x29, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x6d, 0x61, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
 	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x4d,
-	0x61, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0xd7, 0x01, 0x0a, 0x15,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x69, 0x6e,
-	0x54, 0x6f, 0x45, 0x76, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a,
-	0x16, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65,
-	0x72, 0x63, 0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x45, 0x74, 0x68, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x50, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52, 0x08, 0x62, 0x61, 0x6e,
-	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0x5d, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x61, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x1b,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x72,
+	0x63, 0x32, 0x30, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x72, 0x63, 0x32,
+	0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xa3, 0x01, 0x0a, 0x18, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x53, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x72, 0x63, 0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xa2,
+	0x02, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61,
+	0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x3b, 0x0a, 0x1a, 0x6f, 0x6c, 0x64, 0x5f, 0x65,
+	0x72, 0x63, 0x32, 0x30, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x6f, 0x6c, 0x64,
+	0x45, 0x72, 0x63, 0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x72, 0x63, 0x32,
+	0x30, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x6e, 0x65, 0x77, 0x45, 0x72, 0x63,
+	0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x6c, 0x64,
+	0x5f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x6c, 0x64, 0x45, 0x72, 0x63, 0x32, 0x30,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6c, 0x64,
+	0x5f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x45, 0x72, 0x63, 0x32, 0x30, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x12, 0x16, 0x0a,
 	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x54, 0x0a, 0x15, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x42, 0x8a, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b,
-	0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa, 0x02, 0x0a, 0x45, 0x74,
-	0x68, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x5c, 0x45,
-	0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0c, 0x45, 0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x72, 0x63, 0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x74,
+	0x6f, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x6f, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x12, 0x50, 0x0a, 0x09, 0x62,
+	0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f,
+	0x69, 0x6e, 0x22, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0xd0, 0x01,
+	0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76,
+	0x6d, 0x54, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x34, 0x0a, 0x16, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x65, 0x72, 0x63, 0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x12, 0x50,
+	0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x18, 0xc8, 0xde,
+	0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x6e, 0x6b,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x69, 0x6e,
+	0x22, 0x5d, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x54, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x54, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x42, 0x8a, 0x01, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0b,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x2e, 0x45, 0x76, 0x6d,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x16, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x45, 0x74, 0x68, 0x3a,
+	0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5011,27 +7830,32 @@ func file_eth_evm_v1_events_proto_rawDescGZIP() []byte {
 	return file_eth_evm_v1_events_proto_rawDescData
 }
 
-var file_eth_evm_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_eth_evm_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_eth_evm_v1_events_proto_goTypes = []interface{}{
-	(*EventEthereumTx)(nil),       // 0: eth.evm.v1.EventEthereumTx
-	(*EventTxLog)(nil),            // 1: eth.evm.v1.EventTxLog
-	(*EventBlockBloom)(nil),       // 2: eth.evm.v1.EventBlockBloom
-	(*EventFunTokenCreated)(nil),  // 3: eth.evm.v1.EventFunTokenCreated
-	(*EventConvertCoinToEvm)(nil), // 4: eth.evm.v1.EventConvertCoinToEvm
-	(*EventTransfer)(nil),         // 5: eth.evm.v1.EventTransfer
-	(*EventContractDeployed)(nil), // 6: eth.evm.v1.EventContractDeployed
-	(*EventContractExecuted)(nil), // 7: eth.evm.v1.EventContractExecuted
-	(*Log)(nil),                   // 8: eth.evm.v1.Log
-	(*v1beta1.Coin)(nil),          // 9: cosmos.base.v1beta1.Coin
+	(*EventEthereumTx)(nil),             // 0: eth.evm.v1.EventEthereumTx
+	(*EventTxLog)(nil),                  // 1: eth.evm.v1.EventTxLog
+	(*EventBlockBloom)(nil),             // 2: eth.evm.v1.EventBlockBloom
+	(*EventFunTokenCreated)(nil),        // 3: eth.evm.v1.EventFunTokenCreated
+	(*EventFunTokenMetadataSynced)(nil), // 4: eth.evm.v1.EventFunTokenMetadataSynced
+	(*EventFunTokenDisabledSet)(nil),    // 5: eth.evm.v1.EventFunTokenDisabledSet
+	(*EventFunTokenMigrated)(nil),       // 6: eth.evm.v1.EventFunTokenMigrated
+	(*EventConvertCoinToEvm)(nil),       // 7: eth.evm.v1.EventConvertCoinToEvm
+	(*EventConvertEvmToCoin)(nil),       // 8: eth.evm.v1.EventConvertEvmToCoin
+	(*EventTransfer)(nil),               // 9: eth.evm.v1.EventTransfer
+	(*EventContractDeployed)(nil),       // 10: eth.evm.v1.EventContractDeployed
+	(*EventContractExecuted)(nil),       // 11: eth.evm.v1.EventContractExecuted
+	(*Log)(nil),                         // 12: eth.evm.v1.Log
+	(*v1beta1.Coin)(nil),                // 13: cosmos.base.v1beta1.Coin
 }
 var file_eth_evm_v1_events_proto_depIdxs = []int32{
-	8, // 0: eth.evm.v1.EventTxLog.logs:type_name -> eth.evm.v1.Log
-	9, // 1: eth.evm.v1.EventConvertCoinToEvm.bank_coin:type_name -> cosmos.base.v1beta1.Coin
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	12, // 0: eth.evm.v1.EventTxLog.logs:type_name -> eth.evm.v1.Log
+	13, // 1: eth.evm.v1.EventConvertCoinToEvm.bank_coin:type_name -> cosmos.base.v1beta1.Coin
+	13, // 2: eth.evm.v1.EventConvertEvmToCoin.bank_coin:type_name -> cosmos.base.v1beta1.Coin
+	3,  // [3:3] is the sub-list for method output_type
+	3,  // [3:3] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_eth_evm_v1_events_proto_init() }
@@ -5090,7 +7914,7 @@ func file_eth_evm_v1_events_proto_init() {
 			}
 		}
 		file_eth_evm_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventConvertCoinToEvm); i {
+			switch v := v.(*EventFunTokenMetadataSynced); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5102,7 +7926,7 @@ func file_eth_evm_v1_events_proto_init() {
 			}
 		}
 		file_eth_evm_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTransfer); i {
+			switch v := v.(*EventFunTokenDisabledSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5114,7 +7938,7 @@ func file_eth_evm_v1_events_proto_init() {
 			}
 		}
 		file_eth_evm_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventContractDeployed); i {
+			switch v := v.(*EventFunTokenMigrated); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5126,6 +7950,54 @@ func file_eth_evm_v1_events_proto_init() {
 			}
 		}
 		file_eth_evm_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventConvertCoinToEvm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventConvertEvmToCoin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventContractDeployed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventContractExecuted); i {
 			case 0:
 				return &v.state
@@ -5144,7 +8016,7 @@ func file_eth_evm_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_eth_evm_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
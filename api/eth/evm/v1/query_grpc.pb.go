@@ -51,6 +51,15 @@ type QueryClient interface {
 	// Similar to feemarket module's method
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(ctx context.Context, in *QueryFunTokenMappingRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingResponse, error)
+	// FunTokenMappings lists the FunToken mappings with pagination, optionally
+	// filtered by the side the mapping originated from.
+	FunTokenMappings(ctx context.Context, in *QueryFunTokenMappingsRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingsResponse, error)
+	// FunTokenReserves reports the supply on each side of a FunToken mapping and
+	// the amount the EVM module holds in escrow to back it.
+	FunTokenReserves(ctx context.Context, in *QueryFunTokenReservesRequest, opts ...grpc.CallOption) (*QueryFunTokenReservesResponse, error)
+	// FunTokenAudit checks the reserves of every FunToken mapping and reports the
+	// mappings that are under-collateralized.
+	FunTokenAudit(ctx context.Context, in *QueryFunTokenAuditRequest, opts ...grpc.CallOption) (*QueryFunTokenAuditResponse, error)
 }
 
 type queryClient struct {
@@ -178,6 +187,33 @@ func (c *queryClient) FunTokenMapping(ctx context.Context, in *QueryFunTokenMapp
 	return out, nil
 }
 
+func (c *queryClient) FunTokenMappings(ctx context.Context, in *QueryFunTokenMappingsRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingsResponse, error) {
+	out := new(QueryFunTokenMappingsResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FunTokenReserves(ctx context.Context, in *QueryFunTokenReservesRequest, opts ...grpc.CallOption) (*QueryFunTokenReservesResponse, error) {
+	out := new(QueryFunTokenReservesResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenReserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FunTokenAudit(ctx context.Context, in *QueryFunTokenAuditRequest, opts ...grpc.CallOption) (*QueryFunTokenAuditResponse, error) {
+	out := new(QueryFunTokenAuditResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -211,6 +247,15 @@ type QueryServer interface {
 	// Similar to feemarket module's method
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(context.Context, *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error)
+	// FunTokenMappings lists the FunToken mappings with pagination, optionally
+	// filtered by the side the mapping originated from.
+	FunTokenMappings(context.Context, *QueryFunTokenMappingsRequest) (*QueryFunTokenMappingsResponse, error)
+	// FunTokenReserves reports the supply on each side of a FunToken mapping and
+	// the amount the EVM module holds in escrow to back it.
+	FunTokenReserves(context.Context, *QueryFunTokenReservesRequest) (*QueryFunTokenReservesResponse, error)
+	// FunTokenAudit checks the reserves of every FunToken mapping and reports the
+	// mappings that are under-collateralized.
+	FunTokenAudit(context.Context, *QueryFunTokenAuditRequest) (*QueryFunTokenAuditResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -257,6 +302,15 @@ func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (
 func (UnimplementedQueryServer) FunTokenMapping(context.Context, *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMapping not implemented")
 }
+func (UnimplementedQueryServer) FunTokenMappings(context.Context, *QueryFunTokenMappingsRequest) (*QueryFunTokenMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMappings not implemented")
+}
+func (UnimplementedQueryServer) FunTokenReserves(context.Context, *QueryFunTokenReservesRequest) (*QueryFunTokenReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenReserves not implemented")
+}
+func (UnimplementedQueryServer) FunTokenAudit(context.Context, *QueryFunTokenAuditRequest) (*QueryFunTokenAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenAudit not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -504,6 +558,60 @@ func _Query_FunTokenMapping_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FunTokenMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenMappings(ctx, req.(*QueryFunTokenMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FunTokenReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenReservesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenReserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenReserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenReserves(ctx, req.(*QueryFunTokenReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FunTokenAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenAudit(ctx, req.(*QueryFunTokenAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -563,6 +671,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FunTokenMapping",
 			Handler:    _Query_FunTokenMapping_Handler,
 		},
+		{
+			MethodName: "FunTokenMappings",
+			Handler:    _Query_FunTokenMappings_Handler,
+		},
+		{
+			MethodName: "FunTokenReserves",
+			Handler:    _Query_FunTokenReserves_Handler,
+		},
+		{
+			MethodName: "FunTokenAudit",
+			Handler:    _Query_FunTokenAudit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/query.proto",
@@ -2,6 +2,7 @@
 package evmv1
 
 import (
+	v1beta11 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	_ "cosmossdk.io/api/cosmos/msg/v1"
 	binary "encoding/binary"
@@ -7665,6 +7666,4056 @@ func (x *fastReflection_MsgConvertCoinToEvmResponse) ProtoMethods() *protoiface.
 	}
 }
 
+var (
+	md_MsgConvertEvmToCoin            protoreflect.MessageDescriptor
+	fd_MsgConvertEvmToCoin_sender     protoreflect.FieldDescriptor
+	fd_MsgConvertEvmToCoin_erc20_addr protoreflect.FieldDescriptor
+	fd_MsgConvertEvmToCoin_amount     protoreflect.FieldDescriptor
+	fd_MsgConvertEvmToCoin_to_addr    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgConvertEvmToCoin = File_eth_evm_v1_tx_proto.Messages().ByName("MsgConvertEvmToCoin")
+	fd_MsgConvertEvmToCoin_sender = md_MsgConvertEvmToCoin.Fields().ByName("sender")
+	fd_MsgConvertEvmToCoin_erc20_addr = md_MsgConvertEvmToCoin.Fields().ByName("erc20_addr")
+	fd_MsgConvertEvmToCoin_amount = md_MsgConvertEvmToCoin.Fields().ByName("amount")
+	fd_MsgConvertEvmToCoin_to_addr = md_MsgConvertEvmToCoin.Fields().ByName("to_addr")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgConvertEvmToCoin)(nil)
+
+type fastReflection_MsgConvertEvmToCoin MsgConvertEvmToCoin
+
+func (x *MsgConvertEvmToCoin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgConvertEvmToCoin)(x)
+}
+
+func (x *MsgConvertEvmToCoin) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgConvertEvmToCoin_messageType fastReflection_MsgConvertEvmToCoin_messageType
+var _ protoreflect.MessageType = fastReflection_MsgConvertEvmToCoin_messageType{}
+
+type fastReflection_MsgConvertEvmToCoin_messageType struct{}
+
+func (x fastReflection_MsgConvertEvmToCoin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgConvertEvmToCoin)(nil)
+}
+func (x fastReflection_MsgConvertEvmToCoin_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertEvmToCoin)
+}
+func (x fastReflection_MsgConvertEvmToCoin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertEvmToCoin
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgConvertEvmToCoin) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertEvmToCoin
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgConvertEvmToCoin) Type() protoreflect.MessageType {
+	return _fastReflection_MsgConvertEvmToCoin_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgConvertEvmToCoin) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertEvmToCoin)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgConvertEvmToCoin) Interface() protoreflect.ProtoMessage {
+	return (*MsgConvertEvmToCoin)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgConvertEvmToCoin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgConvertEvmToCoin_sender, value) {
+			return
+		}
+	}
+	if x.Erc20Addr != "" {
+		value := protoreflect.ValueOfString(x.Erc20Addr)
+		if !f(fd_MsgConvertEvmToCoin_erc20_addr, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgConvertEvmToCoin_amount, value) {
+			return
+		}
+	}
+	if x.ToAddr != "" {
+		value := protoreflect.ValueOfString(x.ToAddr)
+		if !f(fd_MsgConvertEvmToCoin_to_addr, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgConvertEvmToCoin) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoin.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.MsgConvertEvmToCoin.erc20_addr":
+		return x.Erc20Addr != ""
+	case "eth.evm.v1.MsgConvertEvmToCoin.amount":
+		return x.Amount != ""
+	case "eth.evm.v1.MsgConvertEvmToCoin.to_addr":
+		return x.ToAddr != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoin) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoin.sender":
+		x.Sender = ""
+	case "eth.evm.v1.MsgConvertEvmToCoin.erc20_addr":
+		x.Erc20Addr = ""
+	case "eth.evm.v1.MsgConvertEvmToCoin.amount":
+		x.Amount = ""
+	case "eth.evm.v1.MsgConvertEvmToCoin.to_addr":
+		x.ToAddr = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgConvertEvmToCoin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoin.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertEvmToCoin.erc20_addr":
+		value := x.Erc20Addr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertEvmToCoin.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertEvmToCoin.to_addr":
+		value := x.ToAddr
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoin does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoin.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertEvmToCoin.erc20_addr":
+		x.Erc20Addr = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertEvmToCoin.amount":
+		x.Amount = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertEvmToCoin.to_addr":
+		x.ToAddr = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoin.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.MsgConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.MsgConvertEvmToCoin.erc20_addr":
+		panic(fmt.Errorf("field erc20_addr of message eth.evm.v1.MsgConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.MsgConvertEvmToCoin.amount":
+		panic(fmt.Errorf("field amount of message eth.evm.v1.MsgConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.MsgConvertEvmToCoin.to_addr":
+		panic(fmt.Errorf("field to_addr of message eth.evm.v1.MsgConvertEvmToCoin is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgConvertEvmToCoin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoin.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertEvmToCoin.erc20_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertEvmToCoin.amount":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertEvmToCoin.to_addr":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgConvertEvmToCoin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgConvertEvmToCoin", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgConvertEvmToCoin) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoin) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgConvertEvmToCoin) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgConvertEvmToCoin) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgConvertEvmToCoin)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc20Addr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertEvmToCoin)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ToAddr) > 0 {
+			i -= len(x.ToAddr)
+			copy(dAtA[i:], x.ToAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddr)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Erc20Addr) > 0 {
+			i -= len(x.Erc20Addr)
+			copy(dAtA[i:], x.Erc20Addr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20Addr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertEvmToCoin)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertEvmToCoin: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20Addr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgConvertEvmToCoinResponse           protoreflect.MessageDescriptor
+	fd_MsgConvertEvmToCoinResponse_bank_coin protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgConvertEvmToCoinResponse = File_eth_evm_v1_tx_proto.Messages().ByName("MsgConvertEvmToCoinResponse")
+	fd_MsgConvertEvmToCoinResponse_bank_coin = md_MsgConvertEvmToCoinResponse.Fields().ByName("bank_coin")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgConvertEvmToCoinResponse)(nil)
+
+type fastReflection_MsgConvertEvmToCoinResponse MsgConvertEvmToCoinResponse
+
+func (x *MsgConvertEvmToCoinResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgConvertEvmToCoinResponse)(x)
+}
+
+func (x *MsgConvertEvmToCoinResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgConvertEvmToCoinResponse_messageType fastReflection_MsgConvertEvmToCoinResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgConvertEvmToCoinResponse_messageType{}
+
+type fastReflection_MsgConvertEvmToCoinResponse_messageType struct{}
+
+func (x fastReflection_MsgConvertEvmToCoinResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgConvertEvmToCoinResponse)(nil)
+}
+func (x fastReflection_MsgConvertEvmToCoinResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertEvmToCoinResponse)
+}
+func (x fastReflection_MsgConvertEvmToCoinResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertEvmToCoinResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertEvmToCoinResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgConvertEvmToCoinResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertEvmToCoinResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgConvertEvmToCoinResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BankCoin != nil {
+		value := protoreflect.ValueOfMessage(x.BankCoin.ProtoReflect())
+		if !f(fd_MsgConvertEvmToCoinResponse_bank_coin, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin":
+		return x.BankCoin != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoinResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoinResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin":
+		x.BankCoin = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoinResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoinResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin":
+		value := x.BankCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoinResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoinResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin":
+		x.BankCoin = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoinResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoinResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin":
+		if x.BankCoin == nil {
+			x.BankCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.BankCoin.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoinResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoinResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoinResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoinResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgConvertEvmToCoinResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgConvertEvmToCoinResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BankCoin != nil {
+			l = options.Size(x.BankCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertEvmToCoinResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BankCoin != nil {
+			encoded, err := options.Marshal(x.BankCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertEvmToCoinResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertEvmToCoinResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertEvmToCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BankCoin == nil {
+					x.BankCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BankCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSyncFunTokenMetadata            protoreflect.MessageDescriptor
+	fd_MsgSyncFunTokenMetadata_sender     protoreflect.FieldDescriptor
+	fd_MsgSyncFunTokenMetadata_erc20_addr protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgSyncFunTokenMetadata = File_eth_evm_v1_tx_proto.Messages().ByName("MsgSyncFunTokenMetadata")
+	fd_MsgSyncFunTokenMetadata_sender = md_MsgSyncFunTokenMetadata.Fields().ByName("sender")
+	fd_MsgSyncFunTokenMetadata_erc20_addr = md_MsgSyncFunTokenMetadata.Fields().ByName("erc20_addr")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSyncFunTokenMetadata)(nil)
+
+type fastReflection_MsgSyncFunTokenMetadata MsgSyncFunTokenMetadata
+
+func (x *MsgSyncFunTokenMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSyncFunTokenMetadata)(x)
+}
+
+func (x *MsgSyncFunTokenMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSyncFunTokenMetadata_messageType fastReflection_MsgSyncFunTokenMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSyncFunTokenMetadata_messageType{}
+
+type fastReflection_MsgSyncFunTokenMetadata_messageType struct{}
+
+func (x fastReflection_MsgSyncFunTokenMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSyncFunTokenMetadata)(nil)
+}
+func (x fastReflection_MsgSyncFunTokenMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSyncFunTokenMetadata)
+}
+func (x fastReflection_MsgSyncFunTokenMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSyncFunTokenMetadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSyncFunTokenMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSyncFunTokenMetadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSyncFunTokenMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSyncFunTokenMetadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSyncFunTokenMetadata) New() protoreflect.Message {
+	return new(fastReflection_MsgSyncFunTokenMetadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSyncFunTokenMetadata) Interface() protoreflect.ProtoMessage {
+	return (*MsgSyncFunTokenMetadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSyncFunTokenMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgSyncFunTokenMetadata_sender, value) {
+			return
+		}
+	}
+	if x.Erc20Addr != "" {
+		value := protoreflect.ValueOfString(x.Erc20Addr)
+		if !f(fd_MsgSyncFunTokenMetadata_erc20_addr, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSyncFunTokenMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSyncFunTokenMetadata.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.MsgSyncFunTokenMetadata.erc20_addr":
+		return x.Erc20Addr != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSyncFunTokenMetadata"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSyncFunTokenMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSyncFunTokenMetadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSyncFunTokenMetadata.sender":
+		x.Sender = ""
+	case "eth.evm.v1.MsgSyncFunTokenMetadata.erc20_addr":
+		x.Erc20Addr = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSyncFunTokenMetadata"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSyncFunTokenMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSyncFunTokenMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgSyncFunTokenMetadata.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgSyncFunTokenMetadata.erc20_addr":
+		value := x.Erc20Addr
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSyncFunTokenMetadata"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSyncFunTokenMetadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSyncFunTokenMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSyncFunTokenMetadata.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.MsgSyncFunTokenMetadata.erc20_addr":
+		x.Erc20Addr = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSyncFunTokenMetadata"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSyncFunTokenMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSyncFunTokenMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSyncFunTokenMetadata.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.MsgSyncFunTokenMetadata is not mutable"))
+	case "eth.evm.v1.MsgSyncFunTokenMetadata.erc20_addr":
+		panic(fmt.Errorf("field erc20_addr of message eth.evm.v1.MsgSyncFunTokenMetadata is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSyncFunTokenMetadata"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSyncFunTokenMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSyncFunTokenMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSyncFunTokenMetadata.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgSyncFunTokenMetadata.erc20_addr":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSyncFunTokenMetadata"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSyncFunTokenMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSyncFunTokenMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgSyncFunTokenMetadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSyncFunTokenMetadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSyncFunTokenMetadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSyncFunTokenMetadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSyncFunTokenMetadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSyncFunTokenMetadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc20Addr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSyncFunTokenMetadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Erc20Addr) > 0 {
+			i -= len(x.Erc20Addr)
+			copy(dAtA[i:], x.Erc20Addr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20Addr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSyncFunTokenMetadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSyncFunTokenMetadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSyncFunTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20Addr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSyncFunTokenMetadataResponse               protoreflect.MessageDescriptor
+	fd_MsgSyncFunTokenMetadataResponse_bank_metadata protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgSyncFunTokenMetadataResponse = File_eth_evm_v1_tx_proto.Messages().ByName("MsgSyncFunTokenMetadataResponse")
+	fd_MsgSyncFunTokenMetadataResponse_bank_metadata = md_MsgSyncFunTokenMetadataResponse.Fields().ByName("bank_metadata")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSyncFunTokenMetadataResponse)(nil)
+
+type fastReflection_MsgSyncFunTokenMetadataResponse MsgSyncFunTokenMetadataResponse
+
+func (x *MsgSyncFunTokenMetadataResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSyncFunTokenMetadataResponse)(x)
+}
+
+func (x *MsgSyncFunTokenMetadataResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSyncFunTokenMetadataResponse_messageType fastReflection_MsgSyncFunTokenMetadataResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSyncFunTokenMetadataResponse_messageType{}
+
+type fastReflection_MsgSyncFunTokenMetadataResponse_messageType struct{}
+
+func (x fastReflection_MsgSyncFunTokenMetadataResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSyncFunTokenMetadataResponse)(nil)
+}
+func (x fastReflection_MsgSyncFunTokenMetadataResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSyncFunTokenMetadataResponse)
+}
+func (x fastReflection_MsgSyncFunTokenMetadataResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSyncFunTokenMetadataResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSyncFunTokenMetadataResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSyncFunTokenMetadataResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSyncFunTokenMetadataResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSyncFunTokenMetadataResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BankMetadata != nil {
+		value := protoreflect.ValueOfMessage(x.BankMetadata.ProtoReflect())
+		if !f(fd_MsgSyncFunTokenMetadataResponse_bank_metadata, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSyncFunTokenMetadataResponse.bank_metadata":
+		return x.BankMetadata != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSyncFunTokenMetadataResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSyncFunTokenMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSyncFunTokenMetadataResponse.bank_metadata":
+		x.BankMetadata = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSyncFunTokenMetadataResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSyncFunTokenMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgSyncFunTokenMetadataResponse.bank_metadata":
+		value := x.BankMetadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSyncFunTokenMetadataResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSyncFunTokenMetadataResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSyncFunTokenMetadataResponse.bank_metadata":
+		x.BankMetadata = value.Message().Interface().(*v1beta11.Metadata)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSyncFunTokenMetadataResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSyncFunTokenMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSyncFunTokenMetadataResponse.bank_metadata":
+		if x.BankMetadata == nil {
+			x.BankMetadata = new(v1beta11.Metadata)
+		}
+		return protoreflect.ValueOfMessage(x.BankMetadata.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSyncFunTokenMetadataResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSyncFunTokenMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSyncFunTokenMetadataResponse.bank_metadata":
+		m := new(v1beta11.Metadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSyncFunTokenMetadataResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSyncFunTokenMetadataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgSyncFunTokenMetadataResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSyncFunTokenMetadataResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSyncFunTokenMetadataResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BankMetadata != nil {
+			l = options.Size(x.BankMetadata)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSyncFunTokenMetadataResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BankMetadata != nil {
+			encoded, err := options.Marshal(x.BankMetadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSyncFunTokenMetadataResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSyncFunTokenMetadataResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSyncFunTokenMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankMetadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BankMetadata == nil {
+					x.BankMetadata = &v1beta11.Metadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BankMetadata); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetFunTokenDisabled            protoreflect.MessageDescriptor
+	fd_MsgSetFunTokenDisabled_sender     protoreflect.FieldDescriptor
+	fd_MsgSetFunTokenDisabled_erc20_addr protoreflect.FieldDescriptor
+	fd_MsgSetFunTokenDisabled_disabled   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgSetFunTokenDisabled = File_eth_evm_v1_tx_proto.Messages().ByName("MsgSetFunTokenDisabled")
+	fd_MsgSetFunTokenDisabled_sender = md_MsgSetFunTokenDisabled.Fields().ByName("sender")
+	fd_MsgSetFunTokenDisabled_erc20_addr = md_MsgSetFunTokenDisabled.Fields().ByName("erc20_addr")
+	fd_MsgSetFunTokenDisabled_disabled = md_MsgSetFunTokenDisabled.Fields().ByName("disabled")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetFunTokenDisabled)(nil)
+
+type fastReflection_MsgSetFunTokenDisabled MsgSetFunTokenDisabled
+
+func (x *MsgSetFunTokenDisabled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetFunTokenDisabled)(x)
+}
+
+func (x *MsgSetFunTokenDisabled) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetFunTokenDisabled_messageType fastReflection_MsgSetFunTokenDisabled_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetFunTokenDisabled_messageType{}
+
+type fastReflection_MsgSetFunTokenDisabled_messageType struct{}
+
+func (x fastReflection_MsgSetFunTokenDisabled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetFunTokenDisabled)(nil)
+}
+func (x fastReflection_MsgSetFunTokenDisabled_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetFunTokenDisabled)
+}
+func (x fastReflection_MsgSetFunTokenDisabled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetFunTokenDisabled
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetFunTokenDisabled) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetFunTokenDisabled
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetFunTokenDisabled) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetFunTokenDisabled_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetFunTokenDisabled) New() protoreflect.Message {
+	return new(fastReflection_MsgSetFunTokenDisabled)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetFunTokenDisabled) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetFunTokenDisabled)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetFunTokenDisabled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgSetFunTokenDisabled_sender, value) {
+			return
+		}
+	}
+	if x.Erc20Addr != "" {
+		value := protoreflect.ValueOfString(x.Erc20Addr)
+		if !f(fd_MsgSetFunTokenDisabled_erc20_addr, value) {
+			return
+		}
+	}
+	if x.Disabled != false {
+		value := protoreflect.ValueOfBool(x.Disabled)
+		if !f(fd_MsgSetFunTokenDisabled_disabled, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetFunTokenDisabled) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSetFunTokenDisabled.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.MsgSetFunTokenDisabled.erc20_addr":
+		return x.Erc20Addr != ""
+	case "eth.evm.v1.MsgSetFunTokenDisabled.disabled":
+		return x.Disabled != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSetFunTokenDisabled"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSetFunTokenDisabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFunTokenDisabled) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSetFunTokenDisabled.sender":
+		x.Sender = ""
+	case "eth.evm.v1.MsgSetFunTokenDisabled.erc20_addr":
+		x.Erc20Addr = ""
+	case "eth.evm.v1.MsgSetFunTokenDisabled.disabled":
+		x.Disabled = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSetFunTokenDisabled"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSetFunTokenDisabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetFunTokenDisabled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgSetFunTokenDisabled.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgSetFunTokenDisabled.erc20_addr":
+		value := x.Erc20Addr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgSetFunTokenDisabled.disabled":
+		value := x.Disabled
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSetFunTokenDisabled"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSetFunTokenDisabled does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFunTokenDisabled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSetFunTokenDisabled.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.MsgSetFunTokenDisabled.erc20_addr":
+		x.Erc20Addr = value.Interface().(string)
+	case "eth.evm.v1.MsgSetFunTokenDisabled.disabled":
+		x.Disabled = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSetFunTokenDisabled"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSetFunTokenDisabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFunTokenDisabled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSetFunTokenDisabled.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.MsgSetFunTokenDisabled is not mutable"))
+	case "eth.evm.v1.MsgSetFunTokenDisabled.erc20_addr":
+		panic(fmt.Errorf("field erc20_addr of message eth.evm.v1.MsgSetFunTokenDisabled is not mutable"))
+	case "eth.evm.v1.MsgSetFunTokenDisabled.disabled":
+		panic(fmt.Errorf("field disabled of message eth.evm.v1.MsgSetFunTokenDisabled is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSetFunTokenDisabled"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSetFunTokenDisabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetFunTokenDisabled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSetFunTokenDisabled.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgSetFunTokenDisabled.erc20_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgSetFunTokenDisabled.disabled":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSetFunTokenDisabled"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSetFunTokenDisabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetFunTokenDisabled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgSetFunTokenDisabled", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetFunTokenDisabled) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFunTokenDisabled) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetFunTokenDisabled) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetFunTokenDisabled) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetFunTokenDisabled)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc20Addr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Disabled {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetFunTokenDisabled)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Disabled {
+			i--
+			if x.Disabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Erc20Addr) > 0 {
+			i -= len(x.Erc20Addr)
+			copy(dAtA[i:], x.Erc20Addr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20Addr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetFunTokenDisabled)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetFunTokenDisabled: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetFunTokenDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20Addr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Disabled = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetFunTokenDisabledResponse                  protoreflect.MessageDescriptor
+	fd_MsgSetFunTokenDisabledResponse_funtoken_mapping protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgSetFunTokenDisabledResponse = File_eth_evm_v1_tx_proto.Messages().ByName("MsgSetFunTokenDisabledResponse")
+	fd_MsgSetFunTokenDisabledResponse_funtoken_mapping = md_MsgSetFunTokenDisabledResponse.Fields().ByName("funtoken_mapping")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetFunTokenDisabledResponse)(nil)
+
+type fastReflection_MsgSetFunTokenDisabledResponse MsgSetFunTokenDisabledResponse
+
+func (x *MsgSetFunTokenDisabledResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetFunTokenDisabledResponse)(x)
+}
+
+func (x *MsgSetFunTokenDisabledResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetFunTokenDisabledResponse_messageType fastReflection_MsgSetFunTokenDisabledResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetFunTokenDisabledResponse_messageType{}
+
+type fastReflection_MsgSetFunTokenDisabledResponse_messageType struct{}
+
+func (x fastReflection_MsgSetFunTokenDisabledResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetFunTokenDisabledResponse)(nil)
+}
+func (x fastReflection_MsgSetFunTokenDisabledResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetFunTokenDisabledResponse)
+}
+func (x fastReflection_MsgSetFunTokenDisabledResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetFunTokenDisabledResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetFunTokenDisabledResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetFunTokenDisabledResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetFunTokenDisabledResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetFunTokenDisabledResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FuntokenMapping != nil {
+		value := protoreflect.ValueOfMessage(x.FuntokenMapping.ProtoReflect())
+		if !f(fd_MsgSetFunTokenDisabledResponse_funtoken_mapping, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSetFunTokenDisabledResponse.funtoken_mapping":
+		return x.FuntokenMapping != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSetFunTokenDisabledResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSetFunTokenDisabledResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSetFunTokenDisabledResponse.funtoken_mapping":
+		x.FuntokenMapping = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSetFunTokenDisabledResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSetFunTokenDisabledResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgSetFunTokenDisabledResponse.funtoken_mapping":
+		value := x.FuntokenMapping
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSetFunTokenDisabledResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSetFunTokenDisabledResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSetFunTokenDisabledResponse.funtoken_mapping":
+		x.FuntokenMapping = value.Message().Interface().(*FunToken)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSetFunTokenDisabledResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSetFunTokenDisabledResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSetFunTokenDisabledResponse.funtoken_mapping":
+		if x.FuntokenMapping == nil {
+			x.FuntokenMapping = new(FunToken)
+		}
+		return protoreflect.ValueOfMessage(x.FuntokenMapping.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSetFunTokenDisabledResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSetFunTokenDisabledResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgSetFunTokenDisabledResponse.funtoken_mapping":
+		m := new(FunToken)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgSetFunTokenDisabledResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgSetFunTokenDisabledResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgSetFunTokenDisabledResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetFunTokenDisabledResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetFunTokenDisabledResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FuntokenMapping != nil {
+			l = options.Size(x.FuntokenMapping)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetFunTokenDisabledResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FuntokenMapping != nil {
+			encoded, err := options.Marshal(x.FuntokenMapping)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetFunTokenDisabledResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetFunTokenDisabledResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetFunTokenDisabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FuntokenMapping", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FuntokenMapping == nil {
+					x.FuntokenMapping = &FunToken{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FuntokenMapping); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgMigrateFunToken                     protoreflect.MessageDescriptor
+	fd_MsgMigrateFunToken_sender              protoreflect.FieldDescriptor
+	fd_MsgMigrateFunToken_erc20_addr          protoreflect.FieldDescriptor
+	fd_MsgMigrateFunToken_new_erc20_addr      protoreflect.FieldDescriptor
+	fd_MsgMigrateFunToken_old_erc20_recipient protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgMigrateFunToken = File_eth_evm_v1_tx_proto.Messages().ByName("MsgMigrateFunToken")
+	fd_MsgMigrateFunToken_sender = md_MsgMigrateFunToken.Fields().ByName("sender")
+	fd_MsgMigrateFunToken_erc20_addr = md_MsgMigrateFunToken.Fields().ByName("erc20_addr")
+	fd_MsgMigrateFunToken_new_erc20_addr = md_MsgMigrateFunToken.Fields().ByName("new_erc20_addr")
+	fd_MsgMigrateFunToken_old_erc20_recipient = md_MsgMigrateFunToken.Fields().ByName("old_erc20_recipient")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgMigrateFunToken)(nil)
+
+type fastReflection_MsgMigrateFunToken MsgMigrateFunToken
+
+func (x *MsgMigrateFunToken) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgMigrateFunToken)(x)
+}
+
+func (x *MsgMigrateFunToken) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgMigrateFunToken_messageType fastReflection_MsgMigrateFunToken_messageType
+var _ protoreflect.MessageType = fastReflection_MsgMigrateFunToken_messageType{}
+
+type fastReflection_MsgMigrateFunToken_messageType struct{}
+
+func (x fastReflection_MsgMigrateFunToken_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgMigrateFunToken)(nil)
+}
+func (x fastReflection_MsgMigrateFunToken_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgMigrateFunToken)
+}
+func (x fastReflection_MsgMigrateFunToken_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMigrateFunToken
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgMigrateFunToken) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMigrateFunToken
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgMigrateFunToken) Type() protoreflect.MessageType {
+	return _fastReflection_MsgMigrateFunToken_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgMigrateFunToken) New() protoreflect.Message {
+	return new(fastReflection_MsgMigrateFunToken)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgMigrateFunToken) Interface() protoreflect.ProtoMessage {
+	return (*MsgMigrateFunToken)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgMigrateFunToken) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgMigrateFunToken_sender, value) {
+			return
+		}
+	}
+	if x.Erc20Addr != "" {
+		value := protoreflect.ValueOfString(x.Erc20Addr)
+		if !f(fd_MsgMigrateFunToken_erc20_addr, value) {
+			return
+		}
+	}
+	if x.NewErc20Addr != "" {
+		value := protoreflect.ValueOfString(x.NewErc20Addr)
+		if !f(fd_MsgMigrateFunToken_new_erc20_addr, value) {
+			return
+		}
+	}
+	if x.OldErc20Recipient != "" {
+		value := protoreflect.ValueOfString(x.OldErc20Recipient)
+		if !f(fd_MsgMigrateFunToken_old_erc20_recipient, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgMigrateFunToken) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgMigrateFunToken.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.MsgMigrateFunToken.erc20_addr":
+		return x.Erc20Addr != ""
+	case "eth.evm.v1.MsgMigrateFunToken.new_erc20_addr":
+		return x.NewErc20Addr != ""
+	case "eth.evm.v1.MsgMigrateFunToken.old_erc20_recipient":
+		return x.OldErc20Recipient != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgMigrateFunToken"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgMigrateFunToken does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateFunToken) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgMigrateFunToken.sender":
+		x.Sender = ""
+	case "eth.evm.v1.MsgMigrateFunToken.erc20_addr":
+		x.Erc20Addr = ""
+	case "eth.evm.v1.MsgMigrateFunToken.new_erc20_addr":
+		x.NewErc20Addr = ""
+	case "eth.evm.v1.MsgMigrateFunToken.old_erc20_recipient":
+		x.OldErc20Recipient = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgMigrateFunToken"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgMigrateFunToken does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgMigrateFunToken) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgMigrateFunToken.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgMigrateFunToken.erc20_addr":
+		value := x.Erc20Addr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgMigrateFunToken.new_erc20_addr":
+		value := x.NewErc20Addr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgMigrateFunToken.old_erc20_recipient":
+		value := x.OldErc20Recipient
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgMigrateFunToken"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgMigrateFunToken does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateFunToken) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgMigrateFunToken.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.MsgMigrateFunToken.erc20_addr":
+		x.Erc20Addr = value.Interface().(string)
+	case "eth.evm.v1.MsgMigrateFunToken.new_erc20_addr":
+		x.NewErc20Addr = value.Interface().(string)
+	case "eth.evm.v1.MsgMigrateFunToken.old_erc20_recipient":
+		x.OldErc20Recipient = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgMigrateFunToken"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgMigrateFunToken does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateFunToken) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgMigrateFunToken.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.MsgMigrateFunToken is not mutable"))
+	case "eth.evm.v1.MsgMigrateFunToken.erc20_addr":
+		panic(fmt.Errorf("field erc20_addr of message eth.evm.v1.MsgMigrateFunToken is not mutable"))
+	case "eth.evm.v1.MsgMigrateFunToken.new_erc20_addr":
+		panic(fmt.Errorf("field new_erc20_addr of message eth.evm.v1.MsgMigrateFunToken is not mutable"))
+	case "eth.evm.v1.MsgMigrateFunToken.old_erc20_recipient":
+		panic(fmt.Errorf("field old_erc20_recipient of message eth.evm.v1.MsgMigrateFunToken is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgMigrateFunToken"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgMigrateFunToken does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgMigrateFunToken) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgMigrateFunToken.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgMigrateFunToken.erc20_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgMigrateFunToken.new_erc20_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgMigrateFunToken.old_erc20_recipient":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgMigrateFunToken"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgMigrateFunToken does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgMigrateFunToken) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgMigrateFunToken", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgMigrateFunToken) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateFunToken) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgMigrateFunToken) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgMigrateFunToken) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgMigrateFunToken)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc20Addr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewErc20Addr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OldErc20Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgMigrateFunToken)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OldErc20Recipient) > 0 {
+			i -= len(x.OldErc20Recipient)
+			copy(dAtA[i:], x.OldErc20Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldErc20Recipient)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NewErc20Addr) > 0 {
+			i -= len(x.NewErc20Addr)
+			copy(dAtA[i:], x.NewErc20Addr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewErc20Addr)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Erc20Addr) > 0 {
+			i -= len(x.Erc20Addr)
+			copy(dAtA[i:], x.Erc20Addr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20Addr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgMigrateFunToken)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMigrateFunToken: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMigrateFunToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20Addr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewErc20Addr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewErc20Addr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldErc20Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldErc20Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgMigrateFunTokenResponse                  protoreflect.MessageDescriptor
+	fd_MsgMigrateFunTokenResponse_funtoken_mapping protoreflect.FieldDescriptor
+	fd_MsgMigrateFunTokenResponse_old_erc20_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgMigrateFunTokenResponse = File_eth_evm_v1_tx_proto.Messages().ByName("MsgMigrateFunTokenResponse")
+	fd_MsgMigrateFunTokenResponse_funtoken_mapping = md_MsgMigrateFunTokenResponse.Fields().ByName("funtoken_mapping")
+	fd_MsgMigrateFunTokenResponse_old_erc20_amount = md_MsgMigrateFunTokenResponse.Fields().ByName("old_erc20_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgMigrateFunTokenResponse)(nil)
+
+type fastReflection_MsgMigrateFunTokenResponse MsgMigrateFunTokenResponse
+
+func (x *MsgMigrateFunTokenResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgMigrateFunTokenResponse)(x)
+}
+
+func (x *MsgMigrateFunTokenResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgMigrateFunTokenResponse_messageType fastReflection_MsgMigrateFunTokenResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgMigrateFunTokenResponse_messageType{}
+
+type fastReflection_MsgMigrateFunTokenResponse_messageType struct{}
+
+func (x fastReflection_MsgMigrateFunTokenResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgMigrateFunTokenResponse)(nil)
+}
+func (x fastReflection_MsgMigrateFunTokenResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgMigrateFunTokenResponse)
+}
+func (x fastReflection_MsgMigrateFunTokenResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMigrateFunTokenResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgMigrateFunTokenResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMigrateFunTokenResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgMigrateFunTokenResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgMigrateFunTokenResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgMigrateFunTokenResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgMigrateFunTokenResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgMigrateFunTokenResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgMigrateFunTokenResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgMigrateFunTokenResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FuntokenMapping != nil {
+		value := protoreflect.ValueOfMessage(x.FuntokenMapping.ProtoReflect())
+		if !f(fd_MsgMigrateFunTokenResponse_funtoken_mapping, value) {
+			return
+		}
+	}
+	if x.OldErc20Amount != "" {
+		value := protoreflect.ValueOfString(x.OldErc20Amount)
+		if !f(fd_MsgMigrateFunTokenResponse_old_erc20_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgMigrateFunTokenResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgMigrateFunTokenResponse.funtoken_mapping":
+		return x.FuntokenMapping != nil
+	case "eth.evm.v1.MsgMigrateFunTokenResponse.old_erc20_amount":
+		return x.OldErc20Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgMigrateFunTokenResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgMigrateFunTokenResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateFunTokenResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgMigrateFunTokenResponse.funtoken_mapping":
+		x.FuntokenMapping = nil
+	case "eth.evm.v1.MsgMigrateFunTokenResponse.old_erc20_amount":
+		x.OldErc20Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgMigrateFunTokenResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgMigrateFunTokenResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgMigrateFunTokenResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgMigrateFunTokenResponse.funtoken_mapping":
+		value := x.FuntokenMapping
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.MsgMigrateFunTokenResponse.old_erc20_amount":
+		value := x.OldErc20Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgMigrateFunTokenResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgMigrateFunTokenResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateFunTokenResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgMigrateFunTokenResponse.funtoken_mapping":
+		x.FuntokenMapping = value.Message().Interface().(*FunToken)
+	case "eth.evm.v1.MsgMigrateFunTokenResponse.old_erc20_amount":
+		x.OldErc20Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgMigrateFunTokenResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgMigrateFunTokenResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateFunTokenResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgMigrateFunTokenResponse.funtoken_mapping":
+		if x.FuntokenMapping == nil {
+			x.FuntokenMapping = new(FunToken)
+		}
+		return protoreflect.ValueOfMessage(x.FuntokenMapping.ProtoReflect())
+	case "eth.evm.v1.MsgMigrateFunTokenResponse.old_erc20_amount":
+		panic(fmt.Errorf("field old_erc20_amount of message eth.evm.v1.MsgMigrateFunTokenResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgMigrateFunTokenResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgMigrateFunTokenResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgMigrateFunTokenResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgMigrateFunTokenResponse.funtoken_mapping":
+		m := new(FunToken)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.MsgMigrateFunTokenResponse.old_erc20_amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgMigrateFunTokenResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgMigrateFunTokenResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgMigrateFunTokenResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgMigrateFunTokenResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgMigrateFunTokenResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMigrateFunTokenResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgMigrateFunTokenResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgMigrateFunTokenResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgMigrateFunTokenResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FuntokenMapping != nil {
+			l = options.Size(x.FuntokenMapping)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OldErc20Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgMigrateFunTokenResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OldErc20Amount) > 0 {
+			i -= len(x.OldErc20Amount)
+			copy(dAtA[i:], x.OldErc20Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldErc20Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.FuntokenMapping != nil {
+			encoded, err := options.Marshal(x.FuntokenMapping)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgMigrateFunTokenResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMigrateFunTokenResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMigrateFunTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FuntokenMapping", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FuntokenMapping == nil {
+					x.FuntokenMapping = &FunToken{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FuntokenMapping); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldErc20Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldErc20Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Copyright (c) 2023-2024 Nibi, Inc.
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
@@ -7680,829 +11731,1231 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// MsgEthereumTx encapsulates an Ethereum transaction as an SDK message.
-type MsgEthereumTx struct {
+// MsgEthereumTx encapsulates an Ethereum transaction as an SDK message.
+type MsgEthereumTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data is inner transaction data of the Ethereum transaction
+	Data *anypb.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// size is the encoded storage size of the transaction (DEPRECATED)
+	Size float64 `protobuf:"fixed64,2,opt,name=size,proto3" json:"size,omitempty"`
+	// hash of the transaction in hex format
+	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// from is the ethereum signer address in hex format. This address value is
+	// checked against the address derived from the signature (V, R, S) using the
+	// secp256k1 elliptic curve
+	From string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (x *MsgEthereumTx) Reset() {
+	*x = MsgEthereumTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgEthereumTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgEthereumTx) ProtoMessage() {}
+
+// Deprecated: Use MsgEthereumTx.ProtoReflect.Descriptor instead.
+func (*MsgEthereumTx) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgEthereumTx) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MsgEthereumTx) GetSize() float64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MsgEthereumTx) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *MsgEthereumTx) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+// LegacyTx is the transaction data of regular Ethereum transactions.
+//
+// Note that setting "evm.Params.AllowUnprotectedTxs" to false will cause all
+// non-EIP155 signed transactions to fail, as they'll lack replay protection.
+//
+// LegacyTx is a custom implementation of "LegacyTx" from
+// "github.com/ethereum/go-ethereum/core/types".
+type LegacyTx struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// data is inner transaction data of the Ethereum transaction
-	Data *anypb.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// size is the encoded storage size of the transaction (DEPRECATED)
-	Size float64 `protobuf:"fixed64,2,opt,name=size,proto3" json:"size,omitempty"`
-	// hash of the transaction in hex format
-	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
-	// from is the ethereum signer address in hex format. This address value is
-	// checked against the address derived from the signature (V, R, S) using the
-	// secp256k1 elliptic curve
-	From string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// nonce corresponds to the account nonce (transaction sequence).
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// gas_price defines the value for each gas unit
+	GasPrice string `protobuf:"bytes,2,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// gas defines the gas limit defined for the transaction.
+	Gas uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	// to is the hex formatted address of the recipient
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// value defines the unsigned integer value of the transaction amount.
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// data is the data payload bytes of the transaction.
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// v defines the recovery id as the "v" signature value from the elliptic
+	// curve digital signatute algorithm (ECDSA). It indicates which of two
+	// possible solutions should be used to reconstruct the public key from the
+	// signature. In Ethereum, "v" takes the value 27 or 28 for transactions that
+	// are not relay-protected.
+	V []byte `protobuf:"bytes,7,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the x-coordinate of a point on the elliptic curve in the elliptic
+	// curve digital signatute algorithm (ECDSA). It's crucial in ensuring
+	// uniqueness of the signature.
+	R []byte `protobuf:"bytes,8,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value derived from the private key, message hash,
+	// and the value of "r". It ensures that the signature is tied to both the
+	// message and the private key of the sender.
+	S []byte `protobuf:"bytes,9,opt,name=s,proto3" json:"s,omitempty"`
 }
 
-func (x *MsgEthereumTx) Reset() {
-	*x = MsgEthereumTx{}
+func (x *LegacyTx) Reset() {
+	*x = LegacyTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[0]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgEthereumTx) String() string {
+func (x *LegacyTx) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgEthereumTx) ProtoMessage() {}
+func (*LegacyTx) ProtoMessage() {}
 
-// Deprecated: Use MsgEthereumTx.ProtoReflect.Descriptor instead.
-func (*MsgEthereumTx) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use LegacyTx.ProtoReflect.Descriptor instead.
+func (*LegacyTx) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *MsgEthereumTx) GetData() *anypb.Any {
+func (x *LegacyTx) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *LegacyTx) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *LegacyTx) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *LegacyTx) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *LegacyTx) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *LegacyTx) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *MsgEthereumTx) GetSize() float64 {
+func (x *LegacyTx) GetV() []byte {
 	if x != nil {
-		return x.Size
+		return x.V
+	}
+	return nil
+}
+
+func (x *LegacyTx) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *LegacyTx) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+// AccessListTx is the data of EIP-2930 access list transactions.
+// It is a custom implementation of "AccessListTx" from
+// "github.com/ethereum/go-ethereum/core/types".
+type AccessListTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// chain_id of the destination EVM chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// nonce corresponds to the account nonce (transaction sequence).
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// gas_price defines the value for each gas unit
+	GasPrice string `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// gas defines the gas limit defined for the transaction.
+	Gas uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
+	// to is the recipient address in hex format
+	To string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// value defines the unsigned integer value of the transaction amount.
+	Value string `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	// data is the data payload bytes of the transaction.
+	Data []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// accesses is an array of access tuples
+	Accesses []*AccessTuple `protobuf:"bytes,8,rep,name=accesses,proto3" json:"accesses,omitempty"`
+	// v defines the recovery id and "v" signature value from the elliptic curve
+	// digital signatute algorithm (ECDSA). It indicates which of two possible
+	// solutions should be used to reconstruct the public key from the signature.
+	// In Ethereum, "v" takes the value 27 or 28 for transactions that are not
+	// relay-protected.
+	V []byte `protobuf:"bytes,9,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the x-coordinate of a point on the elliptic curve in the elliptic
+	// curve digital signatute algorithm (ECDSA). It's crucial in ensuring
+	// uniqueness of the signature.
+	R []byte `protobuf:"bytes,10,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value derived from the private key, message hash,
+	// and the value of "r". It ensures that the signature is tied to both the
+	// message and the private key of the sender.
+	S []byte `protobuf:"bytes,11,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (x *AccessListTx) Reset() {
+	*x = AccessListTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessListTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessListTx) ProtoMessage() {}
+
+// Deprecated: Use AccessListTx.ProtoReflect.Descriptor instead.
+func (*AccessListTx) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccessListTx) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *AccessListTx) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
 	}
 	return 0
 }
 
-func (x *MsgEthereumTx) GetHash() string {
+func (x *AccessListTx) GetGasPrice() string {
 	if x != nil {
-		return x.Hash
+		return x.GasPrice
 	}
 	return ""
 }
 
-func (x *MsgEthereumTx) GetFrom() string {
+func (x *AccessListTx) GetGas() uint64 {
 	if x != nil {
-		return x.From
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *AccessListTx) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *AccessListTx) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
 
-// LegacyTx is the transaction data of regular Ethereum transactions.
-//
-// Note that setting "evm.Params.AllowUnprotectedTxs" to false will cause all
-// non-EIP155 signed transactions to fail, as they'll lack replay protection.
-//
-// LegacyTx is a custom implementation of "LegacyTx" from
+func (x *AccessListTx) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AccessListTx) GetAccesses() []*AccessTuple {
+	if x != nil {
+		return x.Accesses
+	}
+	return nil
+}
+
+func (x *AccessListTx) GetV() []byte {
+	if x != nil {
+		return x.V
+	}
+	return nil
+}
+
+func (x *AccessListTx) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *AccessListTx) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+// DynamicFeeTx is the data of EIP-1559 dynamic fee transactions. It is a custom
+// implementation of "DynamicFeeTx" from
 // "github.com/ethereum/go-ethereum/core/types".
-type LegacyTx struct {
+type DynamicFeeTx struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// chain_id of the destination EVM chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	// nonce corresponds to the account nonce (transaction sequence).
-	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// gas_price defines the value for each gas unit
-	GasPrice string `protobuf:"bytes,2,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// gas_tip_cap defines the max value for the gas tip
+	GasTipCap string `protobuf:"bytes,3,opt,name=gas_tip_cap,json=gasTipCap,proto3" json:"gas_tip_cap,omitempty"`
+	// gas_fee_cap defines the max value for the gas fee
+	GasFeeCap string `protobuf:"bytes,4,opt,name=gas_fee_cap,json=gasFeeCap,proto3" json:"gas_fee_cap,omitempty"`
 	// gas defines the gas limit defined for the transaction.
-	Gas uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	Gas uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
 	// to is the hex formatted address of the recipient
-	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
-	// value defines the unsigned integer value of the transaction amount.
-	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	To string `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	// value defines the the transaction amount.
+	Value string `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
 	// data is the data payload bytes of the transaction.
-	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
-	// v defines the recovery id as the "v" signature value from the elliptic
-	// curve digital signatute algorithm (ECDSA). It indicates which of two
-	// possible solutions should be used to reconstruct the public key from the
-	// signature. In Ethereum, "v" takes the value 27 or 28 for transactions that
-	// are not relay-protected.
-	V []byte `protobuf:"bytes,7,opt,name=v,proto3" json:"v,omitempty"`
+	Data []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	// accesses is an array of access tuples
+	Accesses []*AccessTuple `protobuf:"bytes,9,rep,name=accesses,proto3" json:"accesses,omitempty"`
+	// v defines the recovery id and "v" signature value from the elliptic curve
+	// digital signatute algorithm (ECDSA). It indicates which of two possible
+	// solutions should be used to reconstruct the public key from the signature.
+	// In Ethereum, "v" takes the value 27 or 28 for transactions that are not
+	// relay-protected.
+	V []byte `protobuf:"bytes,10,opt,name=v,proto3" json:"v,omitempty"`
 	// r defines the x-coordinate of a point on the elliptic curve in the elliptic
 	// curve digital signatute algorithm (ECDSA). It's crucial in ensuring
 	// uniqueness of the signature.
-	R []byte `protobuf:"bytes,8,opt,name=r,proto3" json:"r,omitempty"`
+	R []byte `protobuf:"bytes,11,opt,name=r,proto3" json:"r,omitempty"`
 	// s define the signature value derived from the private key, message hash,
 	// and the value of "r". It ensures that the signature is tied to both the
 	// message and the private key of the sender.
-	S []byte `protobuf:"bytes,9,opt,name=s,proto3" json:"s,omitempty"`
+	S []byte `protobuf:"bytes,12,opt,name=s,proto3" json:"s,omitempty"`
 }
 
-func (x *LegacyTx) Reset() {
-	*x = LegacyTx{}
+func (x *DynamicFeeTx) Reset() {
+	*x = DynamicFeeTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[1]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LegacyTx) String() string {
+func (x *DynamicFeeTx) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LegacyTx) ProtoMessage() {}
+func (*DynamicFeeTx) ProtoMessage() {}
 
-// Deprecated: Use LegacyTx.ProtoReflect.Descriptor instead.
-func (*LegacyTx) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use DynamicFeeTx.ProtoReflect.Descriptor instead.
+func (*DynamicFeeTx) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LegacyTx) GetNonce() uint64 {
+func (x *DynamicFeeTx) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *DynamicFeeTx) GetNonce() uint64 {
 	if x != nil {
 		return x.Nonce
 	}
 	return 0
 }
 
-func (x *LegacyTx) GetGasPrice() string {
+func (x *DynamicFeeTx) GetGasTipCap() string {
 	if x != nil {
-		return x.GasPrice
+		return x.GasTipCap
 	}
 	return ""
 }
 
-func (x *LegacyTx) GetGas() uint64 {
+func (x *DynamicFeeTx) GetGasFeeCap() string {
+	if x != nil {
+		return x.GasFeeCap
+	}
+	return ""
+}
+
+func (x *DynamicFeeTx) GetGas() uint64 {
 	if x != nil {
 		return x.Gas
 	}
 	return 0
 }
 
-func (x *LegacyTx) GetTo() string {
+func (x *DynamicFeeTx) GetTo() string {
 	if x != nil {
 		return x.To
 	}
 	return ""
 }
 
-func (x *LegacyTx) GetValue() string {
+func (x *DynamicFeeTx) GetValue() string {
 	if x != nil {
 		return x.Value
 	}
 	return ""
 }
 
-func (x *LegacyTx) GetData() []byte {
+func (x *DynamicFeeTx) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *LegacyTx) GetV() []byte {
+func (x *DynamicFeeTx) GetAccesses() []*AccessTuple {
+	if x != nil {
+		return x.Accesses
+	}
+	return nil
+}
+
+func (x *DynamicFeeTx) GetV() []byte {
 	if x != nil {
 		return x.V
 	}
 	return nil
 }
 
-func (x *LegacyTx) GetR() []byte {
+func (x *DynamicFeeTx) GetR() []byte {
 	if x != nil {
 		return x.R
 	}
 	return nil
 }
 
-func (x *LegacyTx) GetS() []byte {
+func (x *DynamicFeeTx) GetS() []byte {
 	if x != nil {
 		return x.S
 	}
 	return nil
 }
 
-// AccessListTx is the data of EIP-2930 access list transactions.
-// It is a custom implementation of "AccessListTx" from
-// "github.com/ethereum/go-ethereum/core/types".
-type AccessListTx struct {
+// ExtensionOptionsEthereumTx is an extension option for ethereum transactions
+type ExtensionOptionsEthereumTx struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// chain_id of the destination EVM chain
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// nonce corresponds to the account nonce (transaction sequence).
-	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// gas_price defines the value for each gas unit
-	GasPrice string `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	// gas defines the gas limit defined for the transaction.
-	Gas uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
-	// to is the recipient address in hex format
-	To string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
-	// value defines the unsigned integer value of the transaction amount.
-	Value string `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
-	// data is the data payload bytes of the transaction.
-	Data []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
-	// accesses is an array of access tuples
-	Accesses []*AccessTuple `protobuf:"bytes,8,rep,name=accesses,proto3" json:"accesses,omitempty"`
-	// v defines the recovery id and "v" signature value from the elliptic curve
-	// digital signatute algorithm (ECDSA). It indicates which of two possible
-	// solutions should be used to reconstruct the public key from the signature.
-	// In Ethereum, "v" takes the value 27 or 28 for transactions that are not
-	// relay-protected.
-	V []byte `protobuf:"bytes,9,opt,name=v,proto3" json:"v,omitempty"`
-	// r defines the x-coordinate of a point on the elliptic curve in the elliptic
-	// curve digital signatute algorithm (ECDSA). It's crucial in ensuring
-	// uniqueness of the signature.
-	R []byte `protobuf:"bytes,10,opt,name=r,proto3" json:"r,omitempty"`
-	// s define the signature value derived from the private key, message hash,
-	// and the value of "r". It ensures that the signature is tied to both the
-	// message and the private key of the sender.
-	S []byte `protobuf:"bytes,11,opt,name=s,proto3" json:"s,omitempty"`
+func (x *ExtensionOptionsEthereumTx) Reset() {
+	*x = ExtensionOptionsEthereumTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *AccessListTx) Reset() {
-	*x = AccessListTx{}
+func (x *ExtensionOptionsEthereumTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionOptionsEthereumTx) ProtoMessage() {}
+
+// Deprecated: Use ExtensionOptionsEthereumTx.ProtoReflect.Descriptor instead.
+func (*ExtensionOptionsEthereumTx) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+// MsgEthereumTxResponse defines the Msg/EthereumTx response type.
+type MsgEthereumTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hash of the ethereum transaction in hex format. This hash differs from the
+	// Tendermint sha256 hash of the transaction bytes. See
+	// https://github.com/tendermint/tendermint/issues/6539 for reference
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// logs contains the transaction hash and the proto-compatible ethereum
+	// logs.
+	Logs []*Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+	// ret is the returned data from evm function (result or data supplied with
+	// revert opcode)
+	Ret []byte `protobuf:"bytes,3,opt,name=ret,proto3" json:"ret,omitempty"`
+	// vm_error is the error returned by vm execution
+	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+	// gas_used specifies how much gas was consumed by the transaction
+	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (x *MsgEthereumTxResponse) Reset() {
+	*x = MsgEthereumTxResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[2]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AccessListTx) String() string {
+func (x *MsgEthereumTxResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessListTx) ProtoMessage() {}
+func (*MsgEthereumTxResponse) ProtoMessage() {}
 
-// Deprecated: Use AccessListTx.ProtoReflect.Descriptor instead.
-func (*AccessListTx) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgEthereumTxResponse.ProtoReflect.Descriptor instead.
+func (*MsgEthereumTxResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AccessListTx) GetChainId() string {
+func (x *MsgEthereumTxResponse) GetHash() string {
 	if x != nil {
-		return x.ChainId
+		return x.Hash
 	}
 	return ""
 }
 
-func (x *AccessListTx) GetNonce() uint64 {
+func (x *MsgEthereumTxResponse) GetLogs() []*Log {
 	if x != nil {
-		return x.Nonce
+		return x.Logs
 	}
-	return 0
+	return nil
 }
 
-func (x *AccessListTx) GetGasPrice() string {
+func (x *MsgEthereumTxResponse) GetRet() []byte {
 	if x != nil {
-		return x.GasPrice
+		return x.Ret
+	}
+	return nil
+}
+
+func (x *MsgEthereumTxResponse) GetVmError() string {
+	if x != nil {
+		return x.VmError
 	}
 	return ""
 }
 
-func (x *AccessListTx) GetGas() uint64 {
+func (x *MsgEthereumTxResponse) GetGasUsed() uint64 {
 	if x != nil {
-		return x.Gas
+		return x.GasUsed
 	}
 	return 0
 }
 
-func (x *AccessListTx) GetTo() string {
-	if x != nil {
-		return x.To
+// MsgUpdateParams defines a Msg for updating the x/evm module parameters.
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/evm parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AccessListTx) GetValue() string {
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
 	if x != nil {
-		return x.Value
+		return x.Authority
 	}
 	return ""
 }
 
-func (x *AccessListTx) GetData() []byte {
+func (x *MsgUpdateParams) GetParams() *Params {
 	if x != nil {
-		return x.Data
+		return x.Params
 	}
 	return nil
 }
 
-func (x *AccessListTx) GetAccesses() []*AccessTuple {
-	if x != nil {
-		return x.Accesses
-	}
-	return nil
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AccessListTx) GetV() []byte {
-	if x != nil {
-		return x.V
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *AccessListTx) GetR() []byte {
-	if x != nil {
-		return x.R
-	}
-	return nil
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AccessListTx) GetS() []byte {
-	if x != nil {
-		return x.S
-	}
-	return nil
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{7}
 }
 
-// DynamicFeeTx is the data of EIP-1559 dynamic fee transactions. It is a custom
-// implementation of "DynamicFeeTx" from
-// "github.com/ethereum/go-ethereum/core/types".
-type DynamicFeeTx struct {
+// MsgCreateFunToken: Arguments to create a "FunToken" mapping. Either the ERC20
+// contract address can be given to create the mapping to a Bank Coin, or the
+// denomination for a Bank Coin can be given to create the mapping to an ERC20.
+type MsgCreateFunToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// chain_id of the destination EVM chain
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// nonce corresponds to the account nonce (transaction sequence).
-	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// gas_tip_cap defines the max value for the gas tip
-	GasTipCap string `protobuf:"bytes,3,opt,name=gas_tip_cap,json=gasTipCap,proto3" json:"gas_tip_cap,omitempty"`
-	// gas_fee_cap defines the max value for the gas fee
-	GasFeeCap string `protobuf:"bytes,4,opt,name=gas_fee_cap,json=gasFeeCap,proto3" json:"gas_fee_cap,omitempty"`
-	// gas defines the gas limit defined for the transaction.
-	Gas uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
-	// to is the hex formatted address of the recipient
-	To string `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
-	// value defines the the transaction amount.
-	Value string `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
-	// data is the data payload bytes of the transaction.
-	Data []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
-	// accesses is an array of access tuples
-	Accesses []*AccessTuple `protobuf:"bytes,9,rep,name=accesses,proto3" json:"accesses,omitempty"`
-	// v defines the recovery id and "v" signature value from the elliptic curve
-	// digital signatute algorithm (ECDSA). It indicates which of two possible
-	// solutions should be used to reconstruct the public key from the signature.
-	// In Ethereum, "v" takes the value 27 or 28 for transactions that are not
-	// relay-protected.
-	V []byte `protobuf:"bytes,10,opt,name=v,proto3" json:"v,omitempty"`
-	// r defines the x-coordinate of a point on the elliptic curve in the elliptic
-	// curve digital signatute algorithm (ECDSA). It's crucial in ensuring
-	// uniqueness of the signature.
-	R []byte `protobuf:"bytes,11,opt,name=r,proto3" json:"r,omitempty"`
-	// s define the signature value derived from the private key, message hash,
-	// and the value of "r". It ensures that the signature is tied to both the
-	// message and the private key of the sender.
-	S []byte `protobuf:"bytes,12,opt,name=s,proto3" json:"s,omitempty"`
+	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
+	FromErc20 string `protobuf:"bytes,1,opt,name=from_erc20,json=fromErc20,proto3" json:"from_erc20,omitempty"`
+	// Coin denomination in the Bank Module.
+	FromBankDenom string `protobuf:"bytes,2,opt,name=from_bank_denom,json=fromBankDenom,proto3" json:"from_bank_denom,omitempty"`
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (x *DynamicFeeTx) Reset() {
-	*x = DynamicFeeTx{}
+func (x *MsgCreateFunToken) Reset() {
+	*x = MsgCreateFunToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[3]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DynamicFeeTx) String() string {
+func (x *MsgCreateFunToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DynamicFeeTx) ProtoMessage() {}
+func (*MsgCreateFunToken) ProtoMessage() {}
 
-// Deprecated: Use DynamicFeeTx.ProtoReflect.Descriptor instead.
-func (*DynamicFeeTx) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgCreateFunToken.ProtoReflect.Descriptor instead.
+func (*MsgCreateFunToken) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DynamicFeeTx) GetChainId() string {
+func (x *MsgCreateFunToken) GetFromErc20() string {
 	if x != nil {
-		return x.ChainId
+		return x.FromErc20
 	}
 	return ""
 }
 
-func (x *DynamicFeeTx) GetNonce() uint64 {
+func (x *MsgCreateFunToken) GetFromBankDenom() string {
 	if x != nil {
-		return x.Nonce
+		return x.FromBankDenom
 	}
-	return 0
+	return ""
 }
 
-func (x *DynamicFeeTx) GetGasTipCap() string {
+func (x *MsgCreateFunToken) GetSender() string {
 	if x != nil {
-		return x.GasTipCap
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *DynamicFeeTx) GetGasFeeCap() string {
-	if x != nil {
-		return x.GasFeeCap
-	}
-	return ""
+type MsgCreateFunTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fungible token mapping corresponding to ERC20 tokens.
+	FuntokenMapping *FunToken `protobuf:"bytes,1,opt,name=funtoken_mapping,json=funtokenMapping,proto3" json:"funtoken_mapping,omitempty"`
 }
 
-func (x *DynamicFeeTx) GetGas() uint64 {
-	if x != nil {
-		return x.Gas
+func (x *MsgCreateFunTokenResponse) Reset() {
+	*x = MsgCreateFunTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *DynamicFeeTx) GetTo() string {
-	if x != nil {
-		return x.To
-	}
-	return ""
+func (x *MsgCreateFunTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DynamicFeeTx) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
+func (*MsgCreateFunTokenResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateFunTokenResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateFunTokenResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DynamicFeeTx) GetData() []byte {
+func (x *MsgCreateFunTokenResponse) GetFuntokenMapping() *FunToken {
 	if x != nil {
-		return x.Data
+		return x.FuntokenMapping
 	}
 	return nil
 }
 
-func (x *DynamicFeeTx) GetAccesses() []*AccessTuple {
-	if x != nil {
-		return x.Accesses
+// MsgConvertCoinToEvm: Arguments to send a Bank Coin to ERC-20 representation
+type MsgConvertCoinToEvm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
+	ToEthAddr string `protobuf:"bytes,1,opt,name=to_eth_addr,json=toEthAddr,proto3" json:"to_eth_addr,omitempty"`
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Bank Coin to get converted to ERC20
+	BankCoin *v1beta1.Coin `protobuf:"bytes,3,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
+}
+
+func (x *MsgConvertCoinToEvm) Reset() {
+	*x = MsgConvertCoinToEvm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *DynamicFeeTx) GetV() []byte {
+func (x *MsgConvertCoinToEvm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgConvertCoinToEvm) ProtoMessage() {}
+
+// Deprecated: Use MsgConvertCoinToEvm.ProtoReflect.Descriptor instead.
+func (*MsgConvertCoinToEvm) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgConvertCoinToEvm) GetToEthAddr() string {
 	if x != nil {
-		return x.V
+		return x.ToEthAddr
 	}
-	return nil
+	return ""
 }
 
-func (x *DynamicFeeTx) GetR() []byte {
+func (x *MsgConvertCoinToEvm) GetSender() string {
 	if x != nil {
-		return x.R
+		return x.Sender
 	}
-	return nil
+	return ""
 }
 
-func (x *DynamicFeeTx) GetS() []byte {
+func (x *MsgConvertCoinToEvm) GetBankCoin() *v1beta1.Coin {
 	if x != nil {
-		return x.S
+		return x.BankCoin
 	}
 	return nil
 }
 
-// ExtensionOptionsEthereumTx is an extension option for ethereum transactions
-type ExtensionOptionsEthereumTx struct {
+type MsgConvertCoinToEvmResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ExtensionOptionsEthereumTx) Reset() {
-	*x = ExtensionOptionsEthereumTx{}
+func (x *MsgConvertCoinToEvmResponse) Reset() {
+	*x = MsgConvertCoinToEvmResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[4]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExtensionOptionsEthereumTx) String() string {
+func (x *MsgConvertCoinToEvmResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExtensionOptionsEthereumTx) ProtoMessage() {}
+func (*MsgConvertCoinToEvmResponse) ProtoMessage() {}
 
-// Deprecated: Use ExtensionOptionsEthereumTx.ProtoReflect.Descriptor instead.
-func (*ExtensionOptionsEthereumTx) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgConvertCoinToEvmResponse.ProtoReflect.Descriptor instead.
+func (*MsgConvertCoinToEvmResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{11}
 }
 
-// MsgEthereumTxResponse defines the Msg/EthereumTx response type.
-type MsgEthereumTxResponse struct {
+// MsgConvertEvmToCoin: Arguments to send ERC20 tokens to their Bank Coin
+// representation
+type MsgConvertEvmToCoin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// hash of the ethereum transaction in hex format. This hash differs from the
-	// Tendermint sha256 hash of the transaction bytes. See
-	// https://github.com/tendermint/tendermint/issues/6539 for reference
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// logs contains the transaction hash and the proto-compatible ethereum
-	// logs.
-	Logs []*Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
-	// ret is the returned data from evm function (result or data supplied with
-	// revert opcode)
-	Ret []byte `protobuf:"bytes,3,opt,name=ret,proto3" json:"ret,omitempty"`
-	// vm_error is the error returned by vm execution
-	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
-	// gas_used specifies how much gas was consumed by the transaction
-	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// Sender: Address for the signer of the transaction. The ERC20 tokens are
+	// taken from the EVM address of the sender.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
+	Erc20Addr string `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3" json:"erc20_addr,omitempty"`
+	// Amount of ERC20 tokens to convert, in the smallest unit of the ERC20
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Recipient of the Bank Coins as a bech32 "nibi" address or a hexadecimal
+	// Ethereum address
+	ToAddr string `protobuf:"bytes,4,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
 }
-
-func (x *MsgEthereumTxResponse) Reset() {
-	*x = MsgEthereumTxResponse{}
+
+func (x *MsgConvertEvmToCoin) Reset() {
+	*x = MsgConvertEvmToCoin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[5]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgEthereumTxResponse) String() string {
+func (x *MsgConvertEvmToCoin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgEthereumTxResponse) ProtoMessage() {}
+func (*MsgConvertEvmToCoin) ProtoMessage() {}
 
-// Deprecated: Use MsgEthereumTxResponse.ProtoReflect.Descriptor instead.
-func (*MsgEthereumTxResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgConvertEvmToCoin.ProtoReflect.Descriptor instead.
+func (*MsgConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MsgEthereumTxResponse) GetHash() string {
+func (x *MsgConvertEvmToCoin) GetSender() string {
 	if x != nil {
-		return x.Hash
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *MsgEthereumTxResponse) GetLogs() []*Log {
+func (x *MsgConvertEvmToCoin) GetErc20Addr() string {
 	if x != nil {
-		return x.Logs
+		return x.Erc20Addr
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgEthereumTxResponse) GetRet() []byte {
+func (x *MsgConvertEvmToCoin) GetAmount() string {
 	if x != nil {
-		return x.Ret
+		return x.Amount
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgEthereumTxResponse) GetVmError() string {
+func (x *MsgConvertEvmToCoin) GetToAddr() string {
 	if x != nil {
-		return x.VmError
+		return x.ToAddr
 	}
 	return ""
 }
 
-func (x *MsgEthereumTxResponse) GetGasUsed() uint64 {
+type MsgConvertEvmToCoinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bank Coin received by the recipient. The amount can be less than the
+	// requested amount for ERC20 tokens with a fee on transfer.
+	BankCoin *v1beta1.Coin `protobuf:"bytes,1,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
+}
+
+func (x *MsgConvertEvmToCoinResponse) Reset() {
+	*x = MsgConvertEvmToCoinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgConvertEvmToCoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgConvertEvmToCoinResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgConvertEvmToCoinResponse.ProtoReflect.Descriptor instead.
+func (*MsgConvertEvmToCoinResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MsgConvertEvmToCoinResponse) GetBankCoin() *v1beta1.Coin {
 	if x != nil {
-		return x.GasUsed
+		return x.BankCoin
 	}
-	return 0
+	return nil
 }
 
-// MsgUpdateParams defines a Msg for updating the x/evm module parameters.
-type MsgUpdateParams struct {
+// MsgSyncFunTokenMetadata: Arguments to refresh the metadata of a "FunToken"
+// mapping from its source.
+type MsgSyncFunTokenMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/evm parameters to update.
-	// NOTE: All parameters must be supplied.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// Sender: Address for the signer of the transaction. Must be the
+	// governance module account or a sudoer.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
+	Erc20Addr string `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3" json:"erc20_addr,omitempty"`
 }
 
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
+func (x *MsgSyncFunTokenMetadata) Reset() {
+	*x = MsgSyncFunTokenMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[6]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParams) String() string {
+func (x *MsgSyncFunTokenMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParams) ProtoMessage() {}
+func (*MsgSyncFunTokenMetadata) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgSyncFunTokenMetadata.ProtoReflect.Descriptor instead.
+func (*MsgSyncFunTokenMetadata) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
+func (x *MsgSyncFunTokenMetadata) GetSender() string {
 	if x != nil {
-		return x.Authority
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *MsgUpdateParams) GetParams() *Params {
+func (x *MsgSyncFunTokenMetadata) GetErc20Addr() string {
 	if x != nil {
-		return x.Params
+		return x.Erc20Addr
 	}
-	return nil
+	return ""
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+type MsgSyncFunTokenMetadataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Bank Coin metadata of the mapping after the sync.
+	BankMetadata *v1beta11.Metadata `protobuf:"bytes,1,opt,name=bank_metadata,json=bankMetadata,proto3" json:"bank_metadata,omitempty"`
 }
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
+func (x *MsgSyncFunTokenMetadataResponse) Reset() {
+	*x = MsgSyncFunTokenMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[7]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParamsResponse) String() string {
+func (x *MsgSyncFunTokenMetadataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
+func (*MsgSyncFunTokenMetadataResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgSyncFunTokenMetadataResponse.ProtoReflect.Descriptor instead.
+func (*MsgSyncFunTokenMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{15}
 }
 
-// MsgCreateFunToken: Arguments to create a "FunToken" mapping. Either the ERC20
-// contract address can be given to create the mapping to a Bank Coin, or the
-// denomination for a Bank Coin can be given to create the mapping to an ERC20.
-type MsgCreateFunToken struct {
+func (x *MsgSyncFunTokenMetadataResponse) GetBankMetadata() *v1beta11.Metadata {
+	if x != nil {
+		return x.BankMetadata
+	}
+	return nil
+}
+
+// MsgSetFunTokenDisabled: Arguments to pause or resume the conversions of a
+// "FunToken" mapping.
+type MsgSetFunTokenDisabled struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Sender: Address for the signer of the transaction. Must be the
+	// governance module account or a sudoer.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
-	FromErc20 string `protobuf:"bytes,1,opt,name=from_erc20,json=fromErc20,proto3" json:"from_erc20,omitempty"`
-	// Coin denomination in the Bank Module.
-	FromBankDenom string `protobuf:"bytes,2,opt,name=from_bank_denom,json=fromBankDenom,proto3" json:"from_bank_denom,omitempty"`
-	// Sender: Address for the signer of the transaction.
-	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc20Addr string `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3" json:"erc20_addr,omitempty"`
+	// True to pause the conversions, false to resume them.
+	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
 }
 
-func (x *MsgCreateFunToken) Reset() {
-	*x = MsgCreateFunToken{}
+func (x *MsgSetFunTokenDisabled) Reset() {
+	*x = MsgSetFunTokenDisabled{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[8]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateFunToken) String() string {
+func (x *MsgSetFunTokenDisabled) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateFunToken) ProtoMessage() {}
+func (*MsgSetFunTokenDisabled) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateFunToken.ProtoReflect.Descriptor instead.
-func (*MsgCreateFunToken) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgSetFunTokenDisabled.ProtoReflect.Descriptor instead.
+func (*MsgSetFunTokenDisabled) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgCreateFunToken) GetFromErc20() string {
+func (x *MsgSetFunTokenDisabled) GetSender() string {
 	if x != nil {
-		return x.FromErc20
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *MsgCreateFunToken) GetFromBankDenom() string {
+func (x *MsgSetFunTokenDisabled) GetErc20Addr() string {
 	if x != nil {
-		return x.FromBankDenom
+		return x.Erc20Addr
 	}
 	return ""
 }
 
-func (x *MsgCreateFunToken) GetSender() string {
+func (x *MsgSetFunTokenDisabled) GetDisabled() bool {
 	if x != nil {
-		return x.Sender
+		return x.Disabled
 	}
-	return ""
+	return false
 }
 
-type MsgCreateFunTokenResponse struct {
+type MsgSetFunTokenDisabledResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Fungible token mapping corresponding to ERC20 tokens.
+	// Fungible token mapping after the update.
 	FuntokenMapping *FunToken `protobuf:"bytes,1,opt,name=funtoken_mapping,json=funtokenMapping,proto3" json:"funtoken_mapping,omitempty"`
 }
 
-func (x *MsgCreateFunTokenResponse) Reset() {
-	*x = MsgCreateFunTokenResponse{}
+func (x *MsgSetFunTokenDisabledResponse) Reset() {
+	*x = MsgSetFunTokenDisabledResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[9]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateFunTokenResponse) String() string {
+func (x *MsgSetFunTokenDisabledResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateFunTokenResponse) ProtoMessage() {}
+func (*MsgSetFunTokenDisabledResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateFunTokenResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateFunTokenResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgSetFunTokenDisabledResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetFunTokenDisabledResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MsgCreateFunTokenResponse) GetFuntokenMapping() *FunToken {
+func (x *MsgSetFunTokenDisabledResponse) GetFuntokenMapping() *FunToken {
 	if x != nil {
 		return x.FuntokenMapping
 	}
 	return nil
 }
 
-// MsgConvertCoinToEvm: Arguments to send a Bank Coin to ERC-20 representation
-type MsgConvertCoinToEvm struct {
+// MsgMigrateFunToken: Arguments to move a "FunToken" mapping created from an
+// ERC20 to a new ERC20 contract.
+//
+// The Bank Coins in circulation are backed by the ERC20 tokens that the EVM
+// module holds in escrow. The balance migration plan is:
+//  1. Before the migration, the EVM module must hold at least the Bank Coin
+//     supply in the new ERC20, for example from a transfer by its issuer.
+//  2. The escrowed balance of the old ERC20 goes to "old_erc20_recipient".
+//  3. The mapping points to the new ERC20, and the Bank Coin metadata is
+//     rebuilt from it.
+type MsgMigrateFunToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Sender: Address for the signer of the transaction. Must be the
+	// governance module account or a sudoer.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
-	ToEthAddr string `protobuf:"bytes,1,opt,name=to_eth_addr,json=toEthAddr,proto3" json:"to_eth_addr,omitempty"`
-	// Sender: Address for the signer of the transaction.
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Bank Coin to get converted to ERC20
-	BankCoin *v1beta1.Coin `protobuf:"bytes,3,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
+	Erc20Addr string `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3" json:"erc20_addr,omitempty"`
+	// Hexadecimal address of the ERC20 token that replaces "erc20_addr". It
+	// must have the same decimals.
+	NewErc20Addr string `protobuf:"bytes,3,opt,name=new_erc20_addr,json=newErc20Addr,proto3" json:"new_erc20_addr,omitempty"`
+	// Recipient of the escrowed balance of the old ERC20 as a bech32 "nibi"
+	// address or a hexadecimal Ethereum address
+	OldErc20Recipient string `protobuf:"bytes,4,opt,name=old_erc20_recipient,json=oldErc20Recipient,proto3" json:"old_erc20_recipient,omitempty"`
 }
 
-func (x *MsgConvertCoinToEvm) Reset() {
-	*x = MsgConvertCoinToEvm{}
+func (x *MsgMigrateFunToken) Reset() {
+	*x = MsgMigrateFunToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[10]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgConvertCoinToEvm) String() string {
+func (x *MsgMigrateFunToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgConvertCoinToEvm) ProtoMessage() {}
+func (*MsgMigrateFunToken) ProtoMessage() {}
 
-// Deprecated: Use MsgConvertCoinToEvm.ProtoReflect.Descriptor instead.
-func (*MsgConvertCoinToEvm) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgMigrateFunToken.ProtoReflect.Descriptor instead.
+func (*MsgMigrateFunToken) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgConvertCoinToEvm) GetToEthAddr() string {
+func (x *MsgMigrateFunToken) GetSender() string {
 	if x != nil {
-		return x.ToEthAddr
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *MsgConvertCoinToEvm) GetSender() string {
+func (x *MsgMigrateFunToken) GetErc20Addr() string {
 	if x != nil {
-		return x.Sender
+		return x.Erc20Addr
 	}
 	return ""
 }
 
-func (x *MsgConvertCoinToEvm) GetBankCoin() *v1beta1.Coin {
+func (x *MsgMigrateFunToken) GetNewErc20Addr() string {
 	if x != nil {
-		return x.BankCoin
+		return x.NewErc20Addr
 	}
-	return nil
+	return ""
 }
 
-type MsgConvertCoinToEvmResponse struct {
+func (x *MsgMigrateFunToken) GetOldErc20Recipient() string {
+	if x != nil {
+		return x.OldErc20Recipient
+	}
+	return ""
+}
+
+type MsgMigrateFunTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Fungible token mapping after the migration.
+	FuntokenMapping *FunToken `protobuf:"bytes,1,opt,name=funtoken_mapping,json=funtokenMapping,proto3" json:"funtoken_mapping,omitempty"`
+	// Amount of the old ERC20 sent to "old_erc20_recipient".
+	OldErc20Amount string `protobuf:"bytes,2,opt,name=old_erc20_amount,json=oldErc20Amount,proto3" json:"old_erc20_amount,omitempty"`
 }
 
-func (x *MsgConvertCoinToEvmResponse) Reset() {
-	*x = MsgConvertCoinToEvmResponse{}
+func (x *MsgMigrateFunTokenResponse) Reset() {
+	*x = MsgMigrateFunTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[11]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgConvertCoinToEvmResponse) String() string {
+func (x *MsgMigrateFunTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgConvertCoinToEvmResponse) ProtoMessage() {}
+func (*MsgMigrateFunTokenResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgConvertCoinToEvmResponse.ProtoReflect.Descriptor instead.
-func (*MsgConvertCoinToEvmResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgMigrateFunTokenResponse.ProtoReflect.Descriptor instead.
+func (*MsgMigrateFunTokenResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MsgMigrateFunTokenResponse) GetFuntokenMapping() *FunToken {
+	if x != nil {
+		return x.FuntokenMapping
+	}
+	return nil
+}
+
+func (x *MsgMigrateFunTokenResponse) GetOldErc20Amount() string {
+	if x != nil {
+		return x.OldErc20Amount
+	}
+	return ""
 }
 
 var File_eth_evm_v1_tx_proto protoreflect.FileDescriptor
@@ -8512,6 +12965,8 @@ var file_eth_evm_v1_tx_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
 	0x31, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31,
 	0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
 	0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
@@ -8663,40 +13118,144 @@ var file_eth_evm_v1_tx_proto_rawDesc = []byte{
 	0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6b, 0x43,
 	0x6f, 0x69, 0x6e, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
 	0x74, 0x43, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0xfd, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x6e, 0x0a, 0x0a, 0x45, 0x74,
-	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x12, 0x19, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
-	0x6d, 0x54, 0x78, 0x1a, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x1a,
-	0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65,
-	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5f, 0x74, 0x78, 0x12, 0x50, 0x0a, 0x0c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x74, 0x68,
-	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d,
-	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x25, 0x2e,
-	0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43,
-	0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
-	0x43, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x1a, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x2e,
-	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x74, 0x43, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x86, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76,
-	0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x2e,
-	0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x45,
-	0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x45, 0x76, 0x6d, 0x54, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x55, 0x0a, 0x0a, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32,
+	0x2f, 0x65, 0x74, 0x68, 0x2e, 0x45, 0x49, 0x50, 0x35, 0x35, 0x41, 0x64, 0x64, 0x72, 0x52, 0x09,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x22, 0x5b, 0x0a, 0x1b, 0x4d, 0x73, 0x67,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x6f, 0x43, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6b,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x62, 0x61,
+	0x6e, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x53, 0x79,
+	0x6e, 0x63, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x0a, 0x65, 0x72,
+	0x63, 0x32, 0x30, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x74, 0x68, 0x2e, 0x45, 0x49, 0x50,
+	0x35, 0x35, 0x41, 0x64, 0x64, 0x72, 0x52, 0x09, 0x65, 0x72, 0x63, 0x32, 0x30, 0x41, 0x64, 0x64,
+	0x72, 0x22, 0x6b, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x75, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0c, 0x62, 0x61, 0x6e, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa3,
+	0x01, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x55, 0x0a, 0x0a, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f,
+	0x65, 0x74, 0x68, 0x2e, 0x45, 0x49, 0x50, 0x35, 0x35, 0x41, 0x64, 0x64, 0x72, 0x52, 0x09, 0x65,
+	0x72, 0x63, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0x67, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x46, 0x75,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x66, 0x75, 0x6e, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x66, 0x75,
+	0x6e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x91, 0x02,
+	0x0a, 0x12, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x0a,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x74, 0x68, 0x2e, 0x45,
+	0x49, 0x50, 0x35, 0x35, 0x41, 0x64, 0x64, 0x72, 0x52, 0x09, 0x65, 0x72, 0x63, 0x32, 0x30, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x5c, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x74, 0x68, 0x2e, 0x45, 0x49, 0x50, 0x35, 0x35, 0x41,
+	0x64, 0x64, 0x72, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x45, 0x72, 0x63, 0x32, 0x30, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x6f, 0x6c, 0x64, 0x45, 0x72, 0x63, 0x32, 0x30, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x22, 0xac, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x10, 0x66, 0x75, 0x6e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x66, 0x75, 0x6e, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x47, 0x0a, 0x10, 0x6f, 0x6c, 0x64, 0x5f, 0x65,
+	0x72, 0x63, 0x32, 0x30, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x45, 0x72, 0x63, 0x32, 0x30, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x32, 0x87, 0x06, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x6e, 0x0a, 0x0a, 0x45, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x12, 0x19, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54,
+	0x78, 0x1a, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x1a, 0x2f, 0x6e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x5f, 0x74, 0x78, 0x12, 0x50, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x25, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x69,
+	0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f,
+	0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x1a, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43,
+	0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5c, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x6f,
+	0x43, 0x6f, 0x69, 0x6e, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76, 0x6d, 0x54,
+	0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x1a, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76, 0x6d,
+	0x54, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68,
+	0x0a, 0x14, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x75, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x2b, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x79, 0x6e, 0x63,
+	0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x46,
+	0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x22, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x1a, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x0f, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1e, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x1a, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x86, 0x01, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54,
+	0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65,
+	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45,
+	0x58, 0xaa, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0a, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74,
+	0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x45, 0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8711,48 +13270,69 @@ func file_eth_evm_v1_tx_proto_rawDescGZIP() []byte {
 	return file_eth_evm_v1_tx_proto_rawDescData
 }
 
-var file_eth_evm_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_eth_evm_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_eth_evm_v1_tx_proto_goTypes = []interface{}{
-	(*MsgEthereumTx)(nil),               // 0: eth.evm.v1.MsgEthereumTx
-	(*LegacyTx)(nil),                    // 1: eth.evm.v1.LegacyTx
-	(*AccessListTx)(nil),                // 2: eth.evm.v1.AccessListTx
-	(*DynamicFeeTx)(nil),                // 3: eth.evm.v1.DynamicFeeTx
-	(*ExtensionOptionsEthereumTx)(nil),  // 4: eth.evm.v1.ExtensionOptionsEthereumTx
-	(*MsgEthereumTxResponse)(nil),       // 5: eth.evm.v1.MsgEthereumTxResponse
-	(*MsgUpdateParams)(nil),             // 6: eth.evm.v1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),     // 7: eth.evm.v1.MsgUpdateParamsResponse
-	(*MsgCreateFunToken)(nil),           // 8: eth.evm.v1.MsgCreateFunToken
-	(*MsgCreateFunTokenResponse)(nil),   // 9: eth.evm.v1.MsgCreateFunTokenResponse
-	(*MsgConvertCoinToEvm)(nil),         // 10: eth.evm.v1.MsgConvertCoinToEvm
-	(*MsgConvertCoinToEvmResponse)(nil), // 11: eth.evm.v1.MsgConvertCoinToEvmResponse
-	(*anypb.Any)(nil),                   // 12: google.protobuf.Any
-	(*AccessTuple)(nil),                 // 13: eth.evm.v1.AccessTuple
-	(*Log)(nil),                         // 14: eth.evm.v1.Log
-	(*Params)(nil),                      // 15: eth.evm.v1.Params
-	(*FunToken)(nil),                    // 16: eth.evm.v1.FunToken
-	(*v1beta1.Coin)(nil),                // 17: cosmos.base.v1beta1.Coin
+	(*MsgEthereumTx)(nil),                   // 0: eth.evm.v1.MsgEthereumTx
+	(*LegacyTx)(nil),                        // 1: eth.evm.v1.LegacyTx
+	(*AccessListTx)(nil),                    // 2: eth.evm.v1.AccessListTx
+	(*DynamicFeeTx)(nil),                    // 3: eth.evm.v1.DynamicFeeTx
+	(*ExtensionOptionsEthereumTx)(nil),      // 4: eth.evm.v1.ExtensionOptionsEthereumTx
+	(*MsgEthereumTxResponse)(nil),           // 5: eth.evm.v1.MsgEthereumTxResponse
+	(*MsgUpdateParams)(nil),                 // 6: eth.evm.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),         // 7: eth.evm.v1.MsgUpdateParamsResponse
+	(*MsgCreateFunToken)(nil),               // 8: eth.evm.v1.MsgCreateFunToken
+	(*MsgCreateFunTokenResponse)(nil),       // 9: eth.evm.v1.MsgCreateFunTokenResponse
+	(*MsgConvertCoinToEvm)(nil),             // 10: eth.evm.v1.MsgConvertCoinToEvm
+	(*MsgConvertCoinToEvmResponse)(nil),     // 11: eth.evm.v1.MsgConvertCoinToEvmResponse
+	(*MsgConvertEvmToCoin)(nil),             // 12: eth.evm.v1.MsgConvertEvmToCoin
+	(*MsgConvertEvmToCoinResponse)(nil),     // 13: eth.evm.v1.MsgConvertEvmToCoinResponse
+	(*MsgSyncFunTokenMetadata)(nil),         // 14: eth.evm.v1.MsgSyncFunTokenMetadata
+	(*MsgSyncFunTokenMetadataResponse)(nil), // 15: eth.evm.v1.MsgSyncFunTokenMetadataResponse
+	(*MsgSetFunTokenDisabled)(nil),          // 16: eth.evm.v1.MsgSetFunTokenDisabled
+	(*MsgSetFunTokenDisabledResponse)(nil),  // 17: eth.evm.v1.MsgSetFunTokenDisabledResponse
+	(*MsgMigrateFunToken)(nil),              // 18: eth.evm.v1.MsgMigrateFunToken
+	(*MsgMigrateFunTokenResponse)(nil),      // 19: eth.evm.v1.MsgMigrateFunTokenResponse
+	(*anypb.Any)(nil),                       // 20: google.protobuf.Any
+	(*AccessTuple)(nil),                     // 21: eth.evm.v1.AccessTuple
+	(*Log)(nil),                             // 22: eth.evm.v1.Log
+	(*Params)(nil),                          // 23: eth.evm.v1.Params
+	(*FunToken)(nil),                        // 24: eth.evm.v1.FunToken
+	(*v1beta1.Coin)(nil),                    // 25: cosmos.base.v1beta1.Coin
+	(*v1beta11.Metadata)(nil),               // 26: cosmos.bank.v1beta1.Metadata
 }
 var file_eth_evm_v1_tx_proto_depIdxs = []int32{
-	12, // 0: eth.evm.v1.MsgEthereumTx.data:type_name -> google.protobuf.Any
-	13, // 1: eth.evm.v1.AccessListTx.accesses:type_name -> eth.evm.v1.AccessTuple
-	13, // 2: eth.evm.v1.DynamicFeeTx.accesses:type_name -> eth.evm.v1.AccessTuple
-	14, // 3: eth.evm.v1.MsgEthereumTxResponse.logs:type_name -> eth.evm.v1.Log
-	15, // 4: eth.evm.v1.MsgUpdateParams.params:type_name -> eth.evm.v1.Params
-	16, // 5: eth.evm.v1.MsgCreateFunTokenResponse.funtoken_mapping:type_name -> eth.evm.v1.FunToken
-	17, // 6: eth.evm.v1.MsgConvertCoinToEvm.bank_coin:type_name -> cosmos.base.v1beta1.Coin
-	0,  // 7: eth.evm.v1.Msg.EthereumTx:input_type -> eth.evm.v1.MsgEthereumTx
-	6,  // 8: eth.evm.v1.Msg.UpdateParams:input_type -> eth.evm.v1.MsgUpdateParams
-	8,  // 9: eth.evm.v1.Msg.CreateFunToken:input_type -> eth.evm.v1.MsgCreateFunToken
-	10, // 10: eth.evm.v1.Msg.ConvertCoinToEvm:input_type -> eth.evm.v1.MsgConvertCoinToEvm
-	5,  // 11: eth.evm.v1.Msg.EthereumTx:output_type -> eth.evm.v1.MsgEthereumTxResponse
-	7,  // 12: eth.evm.v1.Msg.UpdateParams:output_type -> eth.evm.v1.MsgUpdateParamsResponse
-	9,  // 13: eth.evm.v1.Msg.CreateFunToken:output_type -> eth.evm.v1.MsgCreateFunTokenResponse
-	11, // 14: eth.evm.v1.Msg.ConvertCoinToEvm:output_type -> eth.evm.v1.MsgConvertCoinToEvmResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	20, // 0: eth.evm.v1.MsgEthereumTx.data:type_name -> google.protobuf.Any
+	21, // 1: eth.evm.v1.AccessListTx.accesses:type_name -> eth.evm.v1.AccessTuple
+	21, // 2: eth.evm.v1.DynamicFeeTx.accesses:type_name -> eth.evm.v1.AccessTuple
+	22, // 3: eth.evm.v1.MsgEthereumTxResponse.logs:type_name -> eth.evm.v1.Log
+	23, // 4: eth.evm.v1.MsgUpdateParams.params:type_name -> eth.evm.v1.Params
+	24, // 5: eth.evm.v1.MsgCreateFunTokenResponse.funtoken_mapping:type_name -> eth.evm.v1.FunToken
+	25, // 6: eth.evm.v1.MsgConvertCoinToEvm.bank_coin:type_name -> cosmos.base.v1beta1.Coin
+	25, // 7: eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin:type_name -> cosmos.base.v1beta1.Coin
+	26, // 8: eth.evm.v1.MsgSyncFunTokenMetadataResponse.bank_metadata:type_name -> cosmos.bank.v1beta1.Metadata
+	24, // 9: eth.evm.v1.MsgSetFunTokenDisabledResponse.funtoken_mapping:type_name -> eth.evm.v1.FunToken
+	24, // 10: eth.evm.v1.MsgMigrateFunTokenResponse.funtoken_mapping:type_name -> eth.evm.v1.FunToken
+	0,  // 11: eth.evm.v1.Msg.EthereumTx:input_type -> eth.evm.v1.MsgEthereumTx
+	6,  // 12: eth.evm.v1.Msg.UpdateParams:input_type -> eth.evm.v1.MsgUpdateParams
+	8,  // 13: eth.evm.v1.Msg.CreateFunToken:input_type -> eth.evm.v1.MsgCreateFunToken
+	10, // 14: eth.evm.v1.Msg.ConvertCoinToEvm:input_type -> eth.evm.v1.MsgConvertCoinToEvm
+	12, // 15: eth.evm.v1.Msg.ConvertEvmToCoin:input_type -> eth.evm.v1.MsgConvertEvmToCoin
+	14, // 16: eth.evm.v1.Msg.SyncFunTokenMetadata:input_type -> eth.evm.v1.MsgSyncFunTokenMetadata
+	16, // 17: eth.evm.v1.Msg.SetFunTokenDisabled:input_type -> eth.evm.v1.MsgSetFunTokenDisabled
+	18, // 18: eth.evm.v1.Msg.MigrateFunToken:input_type -> eth.evm.v1.MsgMigrateFunToken
+	5,  // 19: eth.evm.v1.Msg.EthereumTx:output_type -> eth.evm.v1.MsgEthereumTxResponse
+	7,  // 20: eth.evm.v1.Msg.UpdateParams:output_type -> eth.evm.v1.MsgUpdateParamsResponse
+	9,  // 21: eth.evm.v1.Msg.CreateFunToken:output_type -> eth.evm.v1.MsgCreateFunTokenResponse
+	11, // 22: eth.evm.v1.Msg.ConvertCoinToEvm:output_type -> eth.evm.v1.MsgConvertCoinToEvmResponse
+	13, // 23: eth.evm.v1.Msg.ConvertEvmToCoin:output_type -> eth.evm.v1.MsgConvertEvmToCoinResponse
+	15, // 24: eth.evm.v1.Msg.SyncFunTokenMetadata:output_type -> eth.evm.v1.MsgSyncFunTokenMetadataResponse
+	17, // 25: eth.evm.v1.Msg.SetFunTokenDisabled:output_type -> eth.evm.v1.MsgSetFunTokenDisabledResponse
+	19, // 26: eth.evm.v1.Msg.MigrateFunToken:output_type -> eth.evm.v1.MsgMigrateFunTokenResponse
+	19, // [19:27] is the sub-list for method output_type
+	11, // [11:19] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_eth_evm_v1_tx_proto_init() }
@@ -8906,6 +13486,102 @@ func file_eth_evm_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_eth_evm_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgConvertEvmToCoin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgConvertEvmToCoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSyncFunTokenMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSyncFunTokenMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetFunTokenDisabled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetFunTokenDisabledResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgMigrateFunToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgMigrateFunTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8913,7 +13589,7 @@ func file_eth_evm_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_eth_evm_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,6 +36,23 @@ type MsgClient interface {
 	// given recipient address ("to_eth_addr") in the corresponding ERC20
 	// representation.
 	ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEvm, opts ...grpc.CallOption) (*MsgConvertCoinToEvmResponse, error)
+	// ConvertEvmToCoin: Sends ERC20 tokens with a valid "FunToken" mapping from
+	// the EVM address of the sender to the given recipient address ("to_addr")
+	// in the corresponding Bank Coin representation. This is the Cosmos-signed
+	// counterpart of "sendToBank" in the FunToken precompile.
+	ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error)
+	// SyncFunTokenMetadata: [SUDO] Refreshes the metadata of a "FunToken"
+	// mapping from its source. The Bank Coin metadata is rebuilt from the ERC20
+	// for mappings created from an ERC20, and the name, symbol, and decimals of
+	// the ERC20 deployed by the module are set from the Bank Coin metadata for
+	// mappings created from a coin.
+	SyncFunTokenMetadata(ctx context.Context, in *MsgSyncFunTokenMetadata, opts ...grpc.CallOption) (*MsgSyncFunTokenMetadataResponse, error)
+	// SetFunTokenDisabled: [SUDO] Pauses or resumes the conversions between
+	// the Bank Coin and the ERC20 of a "FunToken" mapping.
+	SetFunTokenDisabled(ctx context.Context, in *MsgSetFunTokenDisabled, opts ...grpc.CallOption) (*MsgSetFunTokenDisabledResponse, error)
+	// MigrateFunToken: [SUDO] Moves a "FunToken" mapping created from an ERC20
+	// to a new ERC20 contract. The Bank Coin keeps its denomination.
+	MigrateFunToken(ctx context.Context, in *MsgMigrateFunToken, opts ...grpc.CallOption) (*MsgMigrateFunTokenResponse, error)
 }
 
 type msgClient struct {
@@ -82,6 +99,42 @@ func (c *msgClient) ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEv
 	return out, nil
 }
 
+func (c *msgClient) ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error) {
+	out := new(MsgConvertEvmToCoinResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertEvmToCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SyncFunTokenMetadata(ctx context.Context, in *MsgSyncFunTokenMetadata, opts ...grpc.CallOption) (*MsgSyncFunTokenMetadataResponse, error) {
+	out := new(MsgSyncFunTokenMetadataResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/SyncFunTokenMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFunTokenDisabled(ctx context.Context, in *MsgSetFunTokenDisabled, opts ...grpc.CallOption) (*MsgSetFunTokenDisabledResponse, error) {
+	out := new(MsgSetFunTokenDisabledResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/SetFunTokenDisabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateFunToken(ctx context.Context, in *MsgMigrateFunToken, opts ...grpc.CallOption) (*MsgMigrateFunTokenResponse, error) {
+	out := new(MsgMigrateFunTokenResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/MigrateFunToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -100,6 +153,23 @@ type MsgServer interface {
 	// given recipient address ("to_eth_addr") in the corresponding ERC20
 	// representation.
 	ConvertCoinToEvm(context.Context, *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error)
+	// ConvertEvmToCoin: Sends ERC20 tokens with a valid "FunToken" mapping from
+	// the EVM address of the sender to the given recipient address ("to_addr")
+	// in the corresponding Bank Coin representation. This is the Cosmos-signed
+	// counterpart of "sendToBank" in the FunToken precompile.
+	ConvertEvmToCoin(context.Context, *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error)
+	// SyncFunTokenMetadata: [SUDO] Refreshes the metadata of a "FunToken"
+	// mapping from its source. The Bank Coin metadata is rebuilt from the ERC20
+	// for mappings created from an ERC20, and the name, symbol, and decimals of
+	// the ERC20 deployed by the module are set from the Bank Coin metadata for
+	// mappings created from a coin.
+	SyncFunTokenMetadata(context.Context, *MsgSyncFunTokenMetadata) (*MsgSyncFunTokenMetadataResponse, error)
+	// SetFunTokenDisabled: [SUDO] Pauses or resumes the conversions between
+	// the Bank Coin and the ERC20 of a "FunToken" mapping.
+	SetFunTokenDisabled(context.Context, *MsgSetFunTokenDisabled) (*MsgSetFunTokenDisabledResponse, error)
+	// MigrateFunToken: [SUDO] Moves a "FunToken" mapping created from an ERC20
+	// to a new ERC20 contract. The Bank Coin keeps its denomination.
+	MigrateFunToken(context.Context, *MsgMigrateFunToken) (*MsgMigrateFunTokenResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -119,6 +189,18 @@ func (UnimplementedMsgServer) CreateFunToken(context.Context, *MsgCreateFunToken
 func (UnimplementedMsgServer) ConvertCoinToEvm(context.Context, *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinToEvm not implemented")
 }
+func (UnimplementedMsgServer) ConvertEvmToCoin(context.Context, *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertEvmToCoin not implemented")
+}
+func (UnimplementedMsgServer) SyncFunTokenMetadata(context.Context, *MsgSyncFunTokenMetadata) (*MsgSyncFunTokenMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncFunTokenMetadata not implemented")
+}
+func (UnimplementedMsgServer) SetFunTokenDisabled(context.Context, *MsgSetFunTokenDisabled) (*MsgSetFunTokenDisabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFunTokenDisabled not implemented")
+}
+func (UnimplementedMsgServer) MigrateFunToken(context.Context, *MsgMigrateFunToken) (*MsgMigrateFunTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateFunToken not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -204,6 +286,78 @@ func _Msg_ConvertCoinToEvm_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConvertEvmToCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertEvmToCoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertEvmToCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ConvertEvmToCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertEvmToCoin(ctx, req.(*MsgConvertEvmToCoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SyncFunTokenMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSyncFunTokenMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SyncFunTokenMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/SyncFunTokenMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SyncFunTokenMetadata(ctx, req.(*MsgSyncFunTokenMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFunTokenDisabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFunTokenDisabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFunTokenDisabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/SetFunTokenDisabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFunTokenDisabled(ctx, req.(*MsgSetFunTokenDisabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateFunToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateFunToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateFunToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/MigrateFunToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateFunToken(ctx, req.(*MsgMigrateFunToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -227,6 +381,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertCoinToEvm",
 			Handler:    _Msg_ConvertCoinToEvm_Handler,
 		},
+		{
+			MethodName: "ConvertEvmToCoin",
+			Handler:    _Msg_ConvertEvmToCoin_Handler,
+		},
+		{
+			MethodName: "SyncFunTokenMetadata",
+			Handler:    _Msg_SyncFunTokenMetadata_Handler,
+		},
+		{
+			MethodName: "SetFunTokenDisabled",
+			Handler:    _Msg_SetFunTokenDisabled_Handler,
+		},
+		{
+			MethodName: "MigrateFunToken",
+			Handler:    _Msg_MigrateFunToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/tx.proto",
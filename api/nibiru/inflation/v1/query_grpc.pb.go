@@ -33,6 +33,9 @@ type QueryClient interface {
 	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
+	// InflationProjections retrieves the projected mint amounts per epoch and
+	// per period for the next periods, split by the inflation distribution.
+	InflationProjections(ctx context.Context, in *QueryInflationProjectionsRequest, opts ...grpc.CallOption) (*QueryInflationProjectionsResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -90,6 +93,15 @@ func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateR
 	return out, nil
 }
 
+func (c *queryClient) InflationProjections(ctx context.Context, in *QueryInflationProjectionsRequest, opts ...grpc.CallOption) (*QueryInflationProjectionsResponse, error) {
+	out := new(QueryInflationProjectionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/InflationProjections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/Params", in, out, opts...)
@@ -114,6 +126,9 @@ type QueryServer interface {
 	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
+	// InflationProjections retrieves the projected mint amounts per epoch and
+	// per period for the next periods, split by the inflation distribution.
+	InflationProjections(context.Context, *QueryInflationProjectionsRequest) (*QueryInflationProjectionsResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -138,6 +153,9 @@ func (UnimplementedQueryServer) CirculatingSupply(context.Context, *QueryCircula
 func (UnimplementedQueryServer) InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InflationRate not implemented")
 }
+func (UnimplementedQueryServer) InflationProjections(context.Context, *QueryInflationProjectionsRequest) (*QueryInflationProjectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationProjections not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -244,6 +262,24 @@ func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InflationProjections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationProjectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationProjections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.inflation.v1.Query/InflationProjections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationProjections(ctx, req.(*QueryInflationProjectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -289,6 +325,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InflationRate",
 			Handler:    _Query_InflationRate_Handler,
 		},
+		{
+			MethodName: "InflationProjections",
+			Handler:    _Query_InflationProjections_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -62,6 +62,57 @@ func (x *_Params_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_12_list)(nil)
+
+type _Params_12_list struct {
+	list *[]*durationpb.Duration
+}
+
+func (x *_Params_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*durationpb.Duration)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*durationpb.Duration)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_12_list) AppendMutable() protoreflect.Value {
+	v := new(durationpb.Duration)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_12_list) NewElement() protoreflect.Value {
+	v := new(durationpb.Duration)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                      protoreflect.MessageDescriptor
 	fd_Params_vote_period          protoreflect.FieldDescriptor
@@ -75,6 +126,7 @@ var (
 	fd_Params_min_voters           protoreflect.FieldDescriptor
 	fd_Params_validator_fee_ratio  protoreflect.FieldDescriptor
 	fd_Params_expiration_blocks    protoreflect.FieldDescriptor
+	fd_Params_ema_half_lives       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -91,6 +143,7 @@ func init() {
 	fd_Params_min_voters = md_Params.Fields().ByName("min_voters")
 	fd_Params_validator_fee_ratio = md_Params.Fields().ByName("validator_fee_ratio")
 	fd_Params_expiration_blocks = md_Params.Fields().ByName("expiration_blocks")
+	fd_Params_ema_half_lives = md_Params.Fields().ByName("ema_half_lives")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -224,6 +277,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.EmaHalfLives) != 0 {
+		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.EmaHalfLives})
+		if !f(fd_Params_ema_half_lives, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -261,6 +320,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ValidatorFeeRatio != ""
 	case "nibiru.oracle.v1.Params.expiration_blocks":
 		return x.ExpirationBlocks != uint64(0)
+	case "nibiru.oracle.v1.Params.ema_half_lives":
+		return len(x.EmaHalfLives) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.Params"))
@@ -299,6 +360,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.ValidatorFeeRatio = ""
 	case "nibiru.oracle.v1.Params.expiration_blocks":
 		x.ExpirationBlocks = uint64(0)
+	case "nibiru.oracle.v1.Params.ema_half_lives":
+		x.EmaHalfLives = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.Params"))
@@ -351,6 +414,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "nibiru.oracle.v1.Params.expiration_blocks":
 		value := x.ExpirationBlocks
 		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.Params.ema_half_lives":
+		if len(x.EmaHalfLives) == 0 {
+			return protoreflect.ValueOfList(&_Params_12_list{})
+		}
+		listValue := &_Params_12_list{list: &x.EmaHalfLives}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.Params"))
@@ -395,6 +464,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.ValidatorFeeRatio = value.Interface().(string)
 	case "nibiru.oracle.v1.Params.expiration_blocks":
 		x.ExpirationBlocks = value.Uint()
+	case "nibiru.oracle.v1.Params.ema_half_lives":
+		lv := value.List()
+		clv := lv.(*_Params_12_list)
+		x.EmaHalfLives = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.Params"))
@@ -426,6 +499,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.TwapLookbackWindow = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.TwapLookbackWindow.ProtoReflect())
+	case "nibiru.oracle.v1.Params.ema_half_lives":
+		if x.EmaHalfLives == nil {
+			x.EmaHalfLives = []*durationpb.Duration{}
+		}
+		value := &_Params_12_list{list: &x.EmaHalfLives}
+		return protoreflect.ValueOfList(value)
 	case "nibiru.oracle.v1.Params.vote_period":
 		panic(fmt.Errorf("field vote_period of message nibiru.oracle.v1.Params is not mutable"))
 	case "nibiru.oracle.v1.Params.vote_threshold":
@@ -481,6 +560,9 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "nibiru.oracle.v1.Params.expiration_blocks":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.Params.ema_half_lives":
+		list := []*durationpb.Duration{}
+		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.Params"))
@@ -592,6 +674,12 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.ExpirationBlocks != 0 {
 			n += 1 + runtime.Sov(uint64(x.ExpirationBlocks))
 		}
+		if len(x.EmaHalfLives) > 0 {
+			for _, e := range x.EmaHalfLives {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -621,6 +709,22 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.EmaHalfLives) > 0 {
+			for iNdEx := len(x.EmaHalfLives) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EmaHalfLives[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
 		if x.ExpirationBlocks != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpirationBlocks))
 			i--
@@ -1052,6 +1156,40 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmaHalfLives", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EmaHalfLives = append(x.EmaHalfLives, &durationpb.Duration{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EmaHalfLives[len(x.EmaHalfLives)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3767,160 +3905,1052 @@ func (x *fastReflection_Rewards) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: nibiru/oracle/v1/oracle.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_PairAggregationConfig                protoreflect.MessageDescriptor
+	fd_PairAggregationConfig_pair           protoreflect.FieldDescriptor
+	fd_PairAggregationConfig_method         protoreflect.FieldDescriptor
+	fd_PairAggregationConfig_min_voters     protoreflect.FieldDescriptor
+	fd_PairAggregationConfig_reward_band    protoreflect.FieldDescriptor
+	fd_PairAggregationConfig_vote_threshold protoreflect.FieldDescriptor
+	fd_PairAggregationConfig_max_deviation  protoreflect.FieldDescriptor
+	fd_PairAggregationConfig_max_age_blocks protoreflect.FieldDescriptor
+	fd_PairAggregationConfig_trim_fraction  protoreflect.FieldDescriptor
 )
 
-// Params defines the module parameters for the x/oracle module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_nibiru_oracle_v1_oracle_proto_init()
+	md_PairAggregationConfig = File_nibiru_oracle_v1_oracle_proto.Messages().ByName("PairAggregationConfig")
+	fd_PairAggregationConfig_pair = md_PairAggregationConfig.Fields().ByName("pair")
+	fd_PairAggregationConfig_method = md_PairAggregationConfig.Fields().ByName("method")
+	fd_PairAggregationConfig_min_voters = md_PairAggregationConfig.Fields().ByName("min_voters")
+	fd_PairAggregationConfig_reward_band = md_PairAggregationConfig.Fields().ByName("reward_band")
+	fd_PairAggregationConfig_vote_threshold = md_PairAggregationConfig.Fields().ByName("vote_threshold")
+	fd_PairAggregationConfig_max_deviation = md_PairAggregationConfig.Fields().ByName("max_deviation")
+	fd_PairAggregationConfig_max_age_blocks = md_PairAggregationConfig.Fields().ByName("max_age_blocks")
+	fd_PairAggregationConfig_trim_fraction = md_PairAggregationConfig.Fields().ByName("trim_fraction")
+}
 
-	// VotePeriod defines the number of blocks during which voting takes place.
-	VotePeriod uint64 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
-	// VoteThreshold specifies the minimum proportion of votes that must be
-	// received for a ballot to pass.
-	VoteThreshold string `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3" json:"vote_threshold,omitempty"`
-	// RewardBand defines a maxium divergence that a price vote can have from the
-	// weighted median in the ballot. If a vote lies within the valid range
-	// defined by:
-	//
-	//	μ := weightedMedian,
-	//	validRange := μ ± (μ * rewardBand / 2),
-	//
-	// then rewards are added to the validator performance.
-	// Note that if the reward band is smaller than 1 standard
-	// deviation, the band is taken to be 1 standard deviation.a price
-	RewardBand string `protobuf:"bytes,3,opt,name=reward_band,json=rewardBand,proto3" json:"reward_band,omitempty"`
-	// The set of whitelisted markets, or asset pairs, for the module.
-	// Ex. '["unibi:uusd","ubtc:uusd"]'
-	Whitelist []string `protobuf:"bytes,4,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
-	// SlashFraction returns the proportion of an oracle's stake that gets
-	// slashed in the event of slashing. `SlashFraction` specifies the exact
-	// penalty for failing a voting period.
-	SlashFraction string `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
-	// SlashWindow returns the number of voting periods that specify a
-	// "slash window". After each slash window, all oracles that have missed more
-	// than the penalty threshold are slashed. Missing the penalty threshold is
-	// synonymous with submitting fewer valid votes than `MinValidPerWindow`.
-	SlashWindow       uint64 `protobuf:"varint,6,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty"`
-	MinValidPerWindow string `protobuf:"bytes,7,opt,name=min_valid_per_window,json=minValidPerWindow,proto3" json:"min_valid_per_window,omitempty"`
-	// Amount of time to look back for TWAP calculations.
-	// Ex: "900.000000069s" corresponds to 900 seconds and 69 nanoseconds in JSON.
-	TwapLookbackWindow *durationpb.Duration `protobuf:"bytes,8,opt,name=twap_lookback_window,json=twapLookbackWindow,proto3" json:"twap_lookback_window,omitempty"`
-	// The minimum number of voters (i.e. oracle validators) per pair for it to be
-	// considered a passing ballot. Recommended at least 4.
-	MinVoters uint64 `protobuf:"varint,9,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty"`
-	// The validator fee ratio that is given to validators every epoch.
-	ValidatorFeeRatio string `protobuf:"bytes,10,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3" json:"validator_fee_ratio,omitempty"`
-	ExpirationBlocks  uint64 `protobuf:"varint,11,opt,name=expiration_blocks,json=expirationBlocks,proto3" json:"expiration_blocks,omitempty"`
+var _ protoreflect.Message = (*fastReflection_PairAggregationConfig)(nil)
+
+type fastReflection_PairAggregationConfig PairAggregationConfig
+
+func (x *PairAggregationConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PairAggregationConfig)(x)
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[0]
+func (x *PairAggregationConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_PairAggregationConfig_messageType fastReflection_PairAggregationConfig_messageType
+var _ protoreflect.MessageType = fastReflection_PairAggregationConfig_messageType{}
 
-func (*Params) ProtoMessage() {}
+type fastReflection_PairAggregationConfig_messageType struct{}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_PairAggregationConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PairAggregationConfig)(nil)
 }
-
-func (x *Params) GetVotePeriod() uint64 {
-	if x != nil {
-		return x.VotePeriod
-	}
-	return 0
+func (x fastReflection_PairAggregationConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_PairAggregationConfig)
 }
-
-func (x *Params) GetVoteThreshold() string {
-	if x != nil {
-		return x.VoteThreshold
-	}
-	return ""
+func (x fastReflection_PairAggregationConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairAggregationConfig
 }
 
-func (x *Params) GetRewardBand() string {
-	if x != nil {
-		return x.RewardBand
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PairAggregationConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairAggregationConfig
 }
 
-func (x *Params) GetWhitelist() []string {
-	if x != nil {
-		return x.Whitelist
-	}
-	return nil
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PairAggregationConfig) Type() protoreflect.MessageType {
+	return _fastReflection_PairAggregationConfig_messageType
 }
 
-func (x *Params) GetSlashFraction() string {
-	if x != nil {
-		return x.SlashFraction
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PairAggregationConfig) New() protoreflect.Message {
+	return new(fastReflection_PairAggregationConfig)
 }
 
-func (x *Params) GetSlashWindow() uint64 {
-	if x != nil {
-		return x.SlashWindow
-	}
-	return 0
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PairAggregationConfig) Interface() protoreflect.ProtoMessage {
+	return (*PairAggregationConfig)(x)
 }
 
-func (x *Params) GetMinValidPerWindow() string {
-	if x != nil {
-		return x.MinValidPerWindow
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PairAggregationConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pair != "" {
+		value := protoreflect.ValueOfString(x.Pair)
+		if !f(fd_PairAggregationConfig_pair, value) {
+			return
+		}
 	}
-	return ""
-}
-
-func (x *Params) GetTwapLookbackWindow() *durationpb.Duration {
-	if x != nil {
-		return x.TwapLookbackWindow
+	if x.Method != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Method))
+		if !f(fd_PairAggregationConfig_method, value) {
+			return
+		}
 	}
-	return nil
-}
-
-func (x *Params) GetMinVoters() uint64 {
-	if x != nil {
-		return x.MinVoters
+	if x.MinVoters != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinVoters)
+		if !f(fd_PairAggregationConfig_min_voters, value) {
+			return
+		}
 	}
-	return 0
-}
-
-func (x *Params) GetValidatorFeeRatio() string {
-	if x != nil {
-		return x.ValidatorFeeRatio
+	if x.RewardBand != "" {
+		value := protoreflect.ValueOfString(x.RewardBand)
+		if !f(fd_PairAggregationConfig_reward_band, value) {
+			return
+		}
 	}
-	return ""
-}
-
-func (x *Params) GetExpirationBlocks() uint64 {
-	if x != nil {
-		return x.ExpirationBlocks
+	if x.VoteThreshold != "" {
+		value := protoreflect.ValueOfString(x.VoteThreshold)
+		if !f(fd_PairAggregationConfig_vote_threshold, value) {
+			return
+		}
 	}
-	return 0
+	if x.MaxDeviation != "" {
+		value := protoreflect.ValueOfString(x.MaxDeviation)
+		if !f(fd_PairAggregationConfig_max_deviation, value) {
+			return
+		}
+	}
+	if x.MaxAgeBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAgeBlocks)
+		if !f(fd_PairAggregationConfig_max_age_blocks, value) {
+			return
+		}
+	}
+	if x.TrimFraction != "" {
+		value := protoreflect.ValueOfString(x.TrimFraction)
+		if !f(fd_PairAggregationConfig_trim_fraction, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PairAggregationConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.PairAggregationConfig.pair":
+		return x.Pair != ""
+	case "nibiru.oracle.v1.PairAggregationConfig.method":
+		return x.Method != 0
+	case "nibiru.oracle.v1.PairAggregationConfig.min_voters":
+		return x.MinVoters != uint64(0)
+	case "nibiru.oracle.v1.PairAggregationConfig.reward_band":
+		return x.RewardBand != ""
+	case "nibiru.oracle.v1.PairAggregationConfig.vote_threshold":
+		return x.VoteThreshold != ""
+	case "nibiru.oracle.v1.PairAggregationConfig.max_deviation":
+		return x.MaxDeviation != ""
+	case "nibiru.oracle.v1.PairAggregationConfig.max_age_blocks":
+		return x.MaxAgeBlocks != uint64(0)
+	case "nibiru.oracle.v1.PairAggregationConfig.trim_fraction":
+		return x.TrimFraction != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairAggregationConfig"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairAggregationConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairAggregationConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.PairAggregationConfig.pair":
+		x.Pair = ""
+	case "nibiru.oracle.v1.PairAggregationConfig.method":
+		x.Method = 0
+	case "nibiru.oracle.v1.PairAggregationConfig.min_voters":
+		x.MinVoters = uint64(0)
+	case "nibiru.oracle.v1.PairAggregationConfig.reward_band":
+		x.RewardBand = ""
+	case "nibiru.oracle.v1.PairAggregationConfig.vote_threshold":
+		x.VoteThreshold = ""
+	case "nibiru.oracle.v1.PairAggregationConfig.max_deviation":
+		x.MaxDeviation = ""
+	case "nibiru.oracle.v1.PairAggregationConfig.max_age_blocks":
+		x.MaxAgeBlocks = uint64(0)
+	case "nibiru.oracle.v1.PairAggregationConfig.trim_fraction":
+		x.TrimFraction = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairAggregationConfig"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairAggregationConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PairAggregationConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.oracle.v1.PairAggregationConfig.pair":
+		value := x.Pair
+		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.PairAggregationConfig.method":
+		value := x.Method
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nibiru.oracle.v1.PairAggregationConfig.min_voters":
+		value := x.MinVoters
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.PairAggregationConfig.reward_band":
+		value := x.RewardBand
+		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.PairAggregationConfig.vote_threshold":
+		value := x.VoteThreshold
+		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.PairAggregationConfig.max_deviation":
+		value := x.MaxDeviation
+		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.PairAggregationConfig.max_age_blocks":
+		value := x.MaxAgeBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.PairAggregationConfig.trim_fraction":
+		value := x.TrimFraction
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairAggregationConfig"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairAggregationConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairAggregationConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.PairAggregationConfig.pair":
+		x.Pair = value.Interface().(string)
+	case "nibiru.oracle.v1.PairAggregationConfig.method":
+		x.Method = (AggregationMethod)(value.Enum())
+	case "nibiru.oracle.v1.PairAggregationConfig.min_voters":
+		x.MinVoters = value.Uint()
+	case "nibiru.oracle.v1.PairAggregationConfig.reward_band":
+		x.RewardBand = value.Interface().(string)
+	case "nibiru.oracle.v1.PairAggregationConfig.vote_threshold":
+		x.VoteThreshold = value.Interface().(string)
+	case "nibiru.oracle.v1.PairAggregationConfig.max_deviation":
+		x.MaxDeviation = value.Interface().(string)
+	case "nibiru.oracle.v1.PairAggregationConfig.max_age_blocks":
+		x.MaxAgeBlocks = value.Uint()
+	case "nibiru.oracle.v1.PairAggregationConfig.trim_fraction":
+		x.TrimFraction = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairAggregationConfig"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairAggregationConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairAggregationConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.PairAggregationConfig.pair":
+		panic(fmt.Errorf("field pair of message nibiru.oracle.v1.PairAggregationConfig is not mutable"))
+	case "nibiru.oracle.v1.PairAggregationConfig.method":
+		panic(fmt.Errorf("field method of message nibiru.oracle.v1.PairAggregationConfig is not mutable"))
+	case "nibiru.oracle.v1.PairAggregationConfig.min_voters":
+		panic(fmt.Errorf("field min_voters of message nibiru.oracle.v1.PairAggregationConfig is not mutable"))
+	case "nibiru.oracle.v1.PairAggregationConfig.reward_band":
+		panic(fmt.Errorf("field reward_band of message nibiru.oracle.v1.PairAggregationConfig is not mutable"))
+	case "nibiru.oracle.v1.PairAggregationConfig.vote_threshold":
+		panic(fmt.Errorf("field vote_threshold of message nibiru.oracle.v1.PairAggregationConfig is not mutable"))
+	case "nibiru.oracle.v1.PairAggregationConfig.max_deviation":
+		panic(fmt.Errorf("field max_deviation of message nibiru.oracle.v1.PairAggregationConfig is not mutable"))
+	case "nibiru.oracle.v1.PairAggregationConfig.max_age_blocks":
+		panic(fmt.Errorf("field max_age_blocks of message nibiru.oracle.v1.PairAggregationConfig is not mutable"))
+	case "nibiru.oracle.v1.PairAggregationConfig.trim_fraction":
+		panic(fmt.Errorf("field trim_fraction of message nibiru.oracle.v1.PairAggregationConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairAggregationConfig"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairAggregationConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PairAggregationConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.PairAggregationConfig.pair":
+		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.PairAggregationConfig.method":
+		return protoreflect.ValueOfEnum(0)
+	case "nibiru.oracle.v1.PairAggregationConfig.min_voters":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.PairAggregationConfig.reward_band":
+		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.PairAggregationConfig.vote_threshold":
+		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.PairAggregationConfig.max_deviation":
+		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.PairAggregationConfig.max_age_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.PairAggregationConfig.trim_fraction":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairAggregationConfig"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairAggregationConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PairAggregationConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.PairAggregationConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PairAggregationConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairAggregationConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PairAggregationConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PairAggregationConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PairAggregationConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Pair)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Method != 0 {
+			n += 1 + runtime.Sov(uint64(x.Method))
+		}
+		if x.MinVoters != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinVoters))
+		}
+		l = len(x.RewardBand)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VoteThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxDeviation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxAgeBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAgeBlocks))
+		}
+		l = len(x.TrimFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PairAggregationConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TrimFraction) > 0 {
+			i -= len(x.TrimFraction)
+			copy(dAtA[i:], x.TrimFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TrimFraction)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.MaxAgeBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAgeBlocks))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.MaxDeviation) > 0 {
+			i -= len(x.MaxDeviation)
+			copy(dAtA[i:], x.MaxDeviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxDeviation)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.VoteThreshold) > 0 {
+			i -= len(x.VoteThreshold)
+			copy(dAtA[i:], x.VoteThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VoteThreshold)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.RewardBand) > 0 {
+			i -= len(x.RewardBand)
+			copy(dAtA[i:], x.RewardBand)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardBand)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.MinVoters != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinVoters))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Method != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Method))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Pair) > 0 {
+			i -= len(x.Pair)
+			copy(dAtA[i:], x.Pair)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pair)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PairAggregationConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairAggregationConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairAggregationConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pair = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				}
+				x.Method = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Method |= AggregationMethod(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+				}
+				x.MinVoters = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinVoters |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardBand = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VoteThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxDeviation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAgeBlocks", wireType)
+				}
+				x.MaxAgeBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAgeBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrimFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TrimFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: nibiru/oracle/v1/oracle.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AggregationMethod defines how the votes of a ballot are combined into a
+// single exchange rate.
+type AggregationMethod int32
+
+const (
+	// AGGREGATION_METHOD_WEIGHTED_MEDIAN: median of the votes weighted by the
+	// voting power of each validator. This is the default.
+	AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEDIAN AggregationMethod = 0
+	// AGGREGATION_METHOD_WEIGHTED_MEAN: mean of the votes weighted by the voting
+	// power of each validator.
+	AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEAN AggregationMethod = 1
+	// AGGREGATION_METHOD_MEDIAN: median of the votes where each validator counts
+	// once, regardless of its voting power.
+	AggregationMethod_AGGREGATION_METHOD_MEDIAN AggregationMethod = 2
+	// AGGREGATION_METHOD_TRIMMED_MEAN: mean of the votes weighted by the voting
+	// power of each validator, after discarding the "trim_fraction" of the
+	// voting power with the lowest votes and the same fraction with the highest
+	// votes.
+	AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN AggregationMethod = 3
+)
+
+// Enum value maps for AggregationMethod.
+var (
+	AggregationMethod_name = map[int32]string{
+		0: "AGGREGATION_METHOD_WEIGHTED_MEDIAN",
+		1: "AGGREGATION_METHOD_WEIGHTED_MEAN",
+		2: "AGGREGATION_METHOD_MEDIAN",
+		3: "AGGREGATION_METHOD_TRIMMED_MEAN",
+	}
+	AggregationMethod_value = map[string]int32{
+		"AGGREGATION_METHOD_WEIGHTED_MEDIAN": 0,
+		"AGGREGATION_METHOD_WEIGHTED_MEAN":   1,
+		"AGGREGATION_METHOD_MEDIAN":          2,
+		"AGGREGATION_METHOD_TRIMMED_MEAN":    3,
+	}
+)
+
+func (x AggregationMethod) Enum() *AggregationMethod {
+	p := new(AggregationMethod)
+	*p = x
+	return p
+}
+
+func (x AggregationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_nibiru_oracle_v1_oracle_proto_enumTypes[0].Descriptor()
+}
+
+func (AggregationMethod) Type() protoreflect.EnumType {
+	return &file_nibiru_oracle_v1_oracle_proto_enumTypes[0]
+}
+
+func (x AggregationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregationMethod.Descriptor instead.
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+}
+
+// Params defines the module parameters for the x/oracle module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VotePeriod defines the number of blocks during which voting takes place.
+	VotePeriod uint64 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
+	// VoteThreshold specifies the minimum proportion of votes that must be
+	// received for a ballot to pass.
+	VoteThreshold string `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3" json:"vote_threshold,omitempty"`
+	// RewardBand defines a maximum divergence that a price vote can have from the
+	// weighted median in the ballot. If a vote lies within the valid range
+	// defined by:
+	//	μ := weightedMedian,
+	//	validRange := μ ± (μ * rewardBand / 2),
+	// then rewards are added to the validator performance.
+	// Note that if the reward band is smaller than 1 standard
+	// deviation, the band is taken to be 1 standard deviation.a price
+	RewardBand string `protobuf:"bytes,3,opt,name=reward_band,json=rewardBand,proto3" json:"reward_band,omitempty"`
+	// The set of whitelisted markets, or asset pairs, for the module.
+	// Ex. '["unibi:uusd","ubtc:uusd"]'
+	Whitelist []string `protobuf:"bytes,4,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
+	// SlashFraction returns the proportion of an oracle's stake that gets
+	// slashed in the event of slashing. `SlashFraction` specifies the exact
+	// penalty for failing a voting period.
+	SlashFraction string `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	// SlashWindow returns the number of voting periods that specify a
+	// "slash window". After each slash window, all oracles that have missed more
+	// than the penalty threshold are slashed. Missing the penalty threshold is
+	// synonymous with submitting fewer valid votes than `MinValidPerWindow`.
+	SlashWindow       uint64 `protobuf:"varint,6,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty"`
+	MinValidPerWindow string `protobuf:"bytes,7,opt,name=min_valid_per_window,json=minValidPerWindow,proto3" json:"min_valid_per_window,omitempty"`
+	// Amount of time to look back for TWAP calculations.
+	// Ex: "900.000000069s" corresponds to 900 seconds and 69 nanoseconds in JSON.
+	TwapLookbackWindow *durationpb.Duration `protobuf:"bytes,8,opt,name=twap_lookback_window,json=twapLookbackWindow,proto3" json:"twap_lookback_window,omitempty"`
+	// The minimum number of voters (i.e. oracle validators) per pair for it to be
+	// considered a passing ballot. Recommended at least 4.
+	MinVoters uint64 `protobuf:"varint,9,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty"`
+	// The validator fee ratio that is given to validators every epoch.
+	ValidatorFeeRatio string `protobuf:"bytes,10,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3" json:"validator_fee_ratio,omitempty"`
+	ExpirationBlocks  uint64 `protobuf:"varint,11,opt,name=expiration_blocks,json=expirationBlocks,proto3" json:"expiration_blocks,omitempty"`
+	// Half-lives of the exponentially weighted moving averages (EMA) maintained
+	// for every pair. Each EMA halves the weight of past prices every half-life.
+	EmaHalfLives []*durationpb.Duration `protobuf:"bytes,12,rep,name=ema_half_lives,json=emaHalfLives,proto3" json:"ema_half_lives,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetVotePeriod() uint64 {
+	if x != nil {
+		return x.VotePeriod
+	}
+	return 0
+}
+
+func (x *Params) GetVoteThreshold() string {
+	if x != nil {
+		return x.VoteThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetRewardBand() string {
+	if x != nil {
+		return x.RewardBand
+	}
+	return ""
+}
+
+func (x *Params) GetWhitelist() []string {
+	if x != nil {
+		return x.Whitelist
+	}
+	return nil
+}
+
+func (x *Params) GetSlashFraction() string {
+	if x != nil {
+		return x.SlashFraction
+	}
+	return ""
+}
+
+func (x *Params) GetSlashWindow() uint64 {
+	if x != nil {
+		return x.SlashWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMinValidPerWindow() string {
+	if x != nil {
+		return x.MinValidPerWindow
+	}
+	return ""
+}
+
+func (x *Params) GetTwapLookbackWindow() *durationpb.Duration {
+	if x != nil {
+		return x.TwapLookbackWindow
+	}
+	return nil
+}
+
+func (x *Params) GetMinVoters() uint64 {
+	if x != nil {
+		return x.MinVoters
+	}
+	return 0
+}
+
+func (x *Params) GetValidatorFeeRatio() string {
+	if x != nil {
+		return x.ValidatorFeeRatio
+	}
+	return ""
+}
+
+func (x *Params) GetExpirationBlocks() uint64 {
+	if x != nil {
+		return x.ExpirationBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetEmaHalfLives() []*durationpb.Duration {
+	if x != nil {
+		return x.EmaHalfLives
+	}
+	return nil
 }
 
 // Struct for aggregate prevoting on the ExchangeRateVote.
@@ -4178,6 +5208,111 @@ func (x *Rewards) GetCoins() []*v1beta1.Coin {
 	return nil
 }
 
+// PairAggregationConfig overrides how the ballot of a single pair is tallied.
+// Unset overrides fall back to the module params.
+type PairAggregationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// Method used to aggregate the votes of the pair.
+	Method AggregationMethod `protobuf:"varint,2,opt,name=method,proto3,enum=nibiru.oracle.v1.AggregationMethod" json:"method,omitempty"`
+	// Overrides Params.MinVoters for the pair when non-zero.
+	MinVoters uint64 `protobuf:"varint,3,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty"`
+	// Overrides Params.RewardBand for the pair when set.
+	RewardBand string `protobuf:"bytes,4,opt,name=reward_band,json=rewardBand,proto3" json:"reward_band,omitempty"`
+	// Overrides Params.VoteThreshold for the pair when set.
+	VoteThreshold string `protobuf:"bytes,5,opt,name=vote_threshold,json=voteThreshold,proto3" json:"vote_threshold,omitempty"`
+	// Maximum relative change between the last good price of the pair and a new
+	// tally, e.g. "0.1" for 10%. A tally beyond this bound halts the pair. Unset
+	// disables the circuit breaker.
+	MaxDeviation string `protobuf:"bytes,6,opt,name=max_deviation,json=maxDeviation,proto3" json:"max_deviation,omitempty"`
+	// Number of blocks after which the exchange rate of the pair is considered
+	// stale. Zero disables the staleness check.
+	MaxAgeBlocks uint64 `protobuf:"varint,7,opt,name=max_age_blocks,json=maxAgeBlocks,proto3" json:"max_age_blocks,omitempty"`
+	// Fraction of the voting power trimmed from each end of the sorted votes
+	// with AGGREGATION_METHOD_TRIMMED_MEAN, in [0, 0.5). Required by that
+	// method and not allowed with the others.
+	TrimFraction string `protobuf:"bytes,8,opt,name=trim_fraction,json=trimFraction,proto3" json:"trim_fraction,omitempty"`
+}
+
+func (x *PairAggregationConfig) Reset() {
+	*x = PairAggregationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairAggregationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairAggregationConfig) ProtoMessage() {}
+
+// Deprecated: Use PairAggregationConfig.ProtoReflect.Descriptor instead.
+func (*PairAggregationConfig) Descriptor() ([]byte, []int) {
+	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PairAggregationConfig) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *PairAggregationConfig) GetMethod() AggregationMethod {
+	if x != nil {
+		return x.Method
+	}
+	return AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEDIAN
+}
+
+func (x *PairAggregationConfig) GetMinVoters() uint64 {
+	if x != nil {
+		return x.MinVoters
+	}
+	return 0
+}
+
+func (x *PairAggregationConfig) GetRewardBand() string {
+	if x != nil {
+		return x.RewardBand
+	}
+	return ""
+}
+
+func (x *PairAggregationConfig) GetVoteThreshold() string {
+	if x != nil {
+		return x.VoteThreshold
+	}
+	return ""
+}
+
+func (x *PairAggregationConfig) GetMaxDeviation() string {
+	if x != nil {
+		return x.MaxDeviation
+	}
+	return ""
+}
+
+func (x *PairAggregationConfig) GetMaxAgeBlocks() uint64 {
+	if x != nil {
+		return x.MaxAgeBlocks
+	}
+	return 0
+}
+
+func (x *PairAggregationConfig) GetTrimFraction() string {
+	if x != nil {
+		return x.TrimFraction
+	}
+	return ""
+}
+
 var File_nibiru_oracle_v1_oracle_proto protoreflect.FileDescriptor
 
 var file_nibiru_oracle_v1_oracle_proto_rawDesc = []byte{
@@ -4191,7 +5326,7 @@ var file_nibiru_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
 	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xde, 0x08, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x37, 0x0a,
+	0x74, 0x6f, 0x22, 0xde, 0x09, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x37, 0x0a,
 	0x0b, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x04, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f,
 	0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65,
@@ -4260,7 +5395,15 @@ var file_nibiru_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xf2,
 	0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x52, 0x10, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x04, 0xe8,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x7e, 0x0a,
+	0x0e, 0x65, 0x6d, 0x61, 0x5f, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x3d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x18, 0x65, 0x6d, 0x61, 0x5f, 0x68, 0x61,
+	0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x6d, 0x61, 0x5f,
+	0x68, 0x61, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x73, 0x22, 0x98, 0xdf, 0x1f, 0x01, 0x52,
+	0x0c, 0x65, 0x6d, 0x61, 0x48, 0x61, 0x6c, 0x66, 0x4c, 0x69, 0x76, 0x65, 0x73, 0x3a, 0x04, 0xe8,
 	0xa0, 0x1f, 0x01, 0x22, 0xb1, 0x01, 0x0a, 0x1c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
 	0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65,
 	0x76, 0x6f, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
@@ -4326,18 +5469,77 @@ var file_nibiru_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x63,
-	0x6f, 0x69, 0x6e, 0x73, 0x42, 0xb1, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62,
-	0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x4f, 0x58, 0xaa, 0x02,
-	0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x4f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x4f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x69, 0x6e, 0x73, 0x22, 0x82, 0x06, 0x0a, 0x15, 0x50, 0x61, 0x69, 0x72, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5f,
+	0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x69, 0x72, 0x22, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12,
+	0x4e, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x34, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d,
+	0x69, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x22, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x56,
+	0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x68, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x62, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x47, 0xc8, 0xde, 0x1f, 0x01,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2,
+	0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x62, 0x61, 0x6e, 0x64, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x12,
+	0x71, 0x0a, 0x0e, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0xc8, 0xde, 0x1f, 0x01, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x15,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x6f, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x6e, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x01, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde,
+	0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19, 0xf2, 0xde, 0x1f, 0x15,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x22, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x6e, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x66, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x01,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2,
+	0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x66, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x6d, 0x46, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x2a, 0xa5, 0x01, 0x0a, 0x11, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x26, 0x0a, 0x22, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f, 0x4d,
+	0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x47, 0x47, 0x52, 0x45,
+	0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x57, 0x45,
+	0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x1d, 0x0a,
+	0x19, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54,
+	0x48, 0x4f, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f,
+	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x54, 0x52, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10,
+	0x03, 0x42, 0xb1, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x4e, 0x69,
+	0x62, 0x69, 0x72, 0x75, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x1c, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x12, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4352,26 +5554,31 @@ func file_nibiru_oracle_v1_oracle_proto_rawDescGZIP() []byte {
 	return file_nibiru_oracle_v1_oracle_proto_rawDescData
 }
 
-var file_nibiru_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_nibiru_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_nibiru_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_nibiru_oracle_v1_oracle_proto_goTypes = []interface{}{
-	(*Params)(nil),                       // 0: nibiru.oracle.v1.Params
-	(*AggregateExchangeRatePrevote)(nil), // 1: nibiru.oracle.v1.AggregateExchangeRatePrevote
-	(*AggregateExchangeRateVote)(nil),    // 2: nibiru.oracle.v1.AggregateExchangeRateVote
-	(*ExchangeRateTuple)(nil),            // 3: nibiru.oracle.v1.ExchangeRateTuple
-	(*ExchangeRateAtBlock)(nil),          // 4: nibiru.oracle.v1.ExchangeRateAtBlock
-	(*Rewards)(nil),                      // 5: nibiru.oracle.v1.Rewards
-	(*durationpb.Duration)(nil),          // 6: google.protobuf.Duration
-	(*v1beta1.Coin)(nil),                 // 7: cosmos.base.v1beta1.Coin
+	(AggregationMethod)(0),               // 0: nibiru.oracle.v1.AggregationMethod
+	(*Params)(nil),                       // 1: nibiru.oracle.v1.Params
+	(*AggregateExchangeRatePrevote)(nil), // 2: nibiru.oracle.v1.AggregateExchangeRatePrevote
+	(*AggregateExchangeRateVote)(nil),    // 3: nibiru.oracle.v1.AggregateExchangeRateVote
+	(*ExchangeRateTuple)(nil),            // 4: nibiru.oracle.v1.ExchangeRateTuple
+	(*ExchangeRateAtBlock)(nil),          // 5: nibiru.oracle.v1.ExchangeRateAtBlock
+	(*Rewards)(nil),                      // 6: nibiru.oracle.v1.Rewards
+	(*PairAggregationConfig)(nil),        // 7: nibiru.oracle.v1.PairAggregationConfig
+	(*durationpb.Duration)(nil),          // 8: google.protobuf.Duration
+	(*v1beta1.Coin)(nil),                 // 9: cosmos.base.v1beta1.Coin
 }
 var file_nibiru_oracle_v1_oracle_proto_depIdxs = []int32{
-	6, // 0: nibiru.oracle.v1.Params.twap_lookback_window:type_name -> google.protobuf.Duration
-	3, // 1: nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples:type_name -> nibiru.oracle.v1.ExchangeRateTuple
-	7, // 2: nibiru.oracle.v1.Rewards.coins:type_name -> cosmos.base.v1beta1.Coin
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8, // 0: nibiru.oracle.v1.Params.twap_lookback_window:type_name -> google.protobuf.Duration
+	8, // 1: nibiru.oracle.v1.Params.ema_half_lives:type_name -> google.protobuf.Duration
+	4, // 2: nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples:type_name -> nibiru.oracle.v1.ExchangeRateTuple
+	9, // 3: nibiru.oracle.v1.Rewards.coins:type_name -> cosmos.base.v1beta1.Coin
+	0, // 4: nibiru.oracle.v1.PairAggregationConfig.method:type_name -> nibiru.oracle.v1.AggregationMethod
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_nibiru_oracle_v1_oracle_proto_init() }
@@ -4452,19 +5659,32 @@ func file_nibiru_oracle_v1_oracle_proto_init() {
 				return nil
 			}
 		}
+		file_nibiru_oracle_v1_oracle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairAggregationConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nibiru_oracle_v1_oracle_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_nibiru_oracle_v1_oracle_proto_goTypes,
 		DependencyIndexes: file_nibiru_oracle_v1_oracle_proto_depIdxs,
+		EnumInfos:         file_nibiru_oracle_v1_oracle_proto_enumTypes,
 		MessageInfos:      file_nibiru_oracle_v1_oracle_proto_msgTypes,
 	}.Build()
 	File_nibiru_oracle_v1_oracle_proto = out.File
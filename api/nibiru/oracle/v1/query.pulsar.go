@@ -441,6 +441,9 @@ var (
 	fd_QueryExchangeRateResponse_exchange_rate      protoreflect.FieldDescriptor
 	fd_QueryExchangeRateResponse_block_timestamp_ms protoreflect.FieldDescriptor
 	fd_QueryExchangeRateResponse_block_height       protoreflect.FieldDescriptor
+	fd_QueryExchangeRateResponse_age_blocks         protoreflect.FieldDescriptor
+	fd_QueryExchangeRateResponse_stale              protoreflect.FieldDescriptor
+	fd_QueryExchangeRateResponse_halted             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -449,6 +452,9 @@ func init() {
 	fd_QueryExchangeRateResponse_exchange_rate = md_QueryExchangeRateResponse.Fields().ByName("exchange_rate")
 	fd_QueryExchangeRateResponse_block_timestamp_ms = md_QueryExchangeRateResponse.Fields().ByName("block_timestamp_ms")
 	fd_QueryExchangeRateResponse_block_height = md_QueryExchangeRateResponse.Fields().ByName("block_height")
+	fd_QueryExchangeRateResponse_age_blocks = md_QueryExchangeRateResponse.Fields().ByName("age_blocks")
+	fd_QueryExchangeRateResponse_stale = md_QueryExchangeRateResponse.Fields().ByName("stale")
+	fd_QueryExchangeRateResponse_halted = md_QueryExchangeRateResponse.Fields().ByName("halted")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryExchangeRateResponse)(nil)
@@ -534,6 +540,24 @@ func (x *fastReflection_QueryExchangeRateResponse) Range(f func(protoreflect.Fie
 			return
 		}
 	}
+	if x.AgeBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AgeBlocks)
+		if !f(fd_QueryExchangeRateResponse_age_blocks, value) {
+			return
+		}
+	}
+	if x.Stale != false {
+		value := protoreflect.ValueOfBool(x.Stale)
+		if !f(fd_QueryExchangeRateResponse_stale, value) {
+			return
+		}
+	}
+	if x.Halted != false {
+		value := protoreflect.ValueOfBool(x.Halted)
+		if !f(fd_QueryExchangeRateResponse_halted, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -555,6 +579,12 @@ func (x *fastReflection_QueryExchangeRateResponse) Has(fd protoreflect.FieldDesc
 		return x.BlockTimestampMs != int64(0)
 	case "nibiru.oracle.v1.QueryExchangeRateResponse.block_height":
 		return x.BlockHeight != uint64(0)
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.age_blocks":
+		return x.AgeBlocks != uint64(0)
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.stale":
+		return x.Stale != false
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.halted":
+		return x.Halted != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateResponse"))
@@ -577,6 +607,12 @@ func (x *fastReflection_QueryExchangeRateResponse) Clear(fd protoreflect.FieldDe
 		x.BlockTimestampMs = int64(0)
 	case "nibiru.oracle.v1.QueryExchangeRateResponse.block_height":
 		x.BlockHeight = uint64(0)
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.age_blocks":
+		x.AgeBlocks = uint64(0)
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.stale":
+		x.Stale = false
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.halted":
+		x.Halted = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateResponse"))
@@ -602,6 +638,15 @@ func (x *fastReflection_QueryExchangeRateResponse) Get(descriptor protoreflect.F
 	case "nibiru.oracle.v1.QueryExchangeRateResponse.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.age_blocks":
+		value := x.AgeBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.stale":
+		value := x.Stale
+		return protoreflect.ValueOfBool(value)
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.halted":
+		value := x.Halted
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateResponse"))
@@ -628,6 +673,12 @@ func (x *fastReflection_QueryExchangeRateResponse) Set(fd protoreflect.FieldDesc
 		x.BlockTimestampMs = value.Int()
 	case "nibiru.oracle.v1.QueryExchangeRateResponse.block_height":
 		x.BlockHeight = value.Uint()
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.age_blocks":
+		x.AgeBlocks = value.Uint()
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.stale":
+		x.Stale = value.Bool()
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.halted":
+		x.Halted = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateResponse"))
@@ -654,6 +705,12 @@ func (x *fastReflection_QueryExchangeRateResponse) Mutable(fd protoreflect.Field
 		panic(fmt.Errorf("field block_timestamp_ms of message nibiru.oracle.v1.QueryExchangeRateResponse is not mutable"))
 	case "nibiru.oracle.v1.QueryExchangeRateResponse.block_height":
 		panic(fmt.Errorf("field block_height of message nibiru.oracle.v1.QueryExchangeRateResponse is not mutable"))
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.age_blocks":
+		panic(fmt.Errorf("field age_blocks of message nibiru.oracle.v1.QueryExchangeRateResponse is not mutable"))
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.stale":
+		panic(fmt.Errorf("field stale of message nibiru.oracle.v1.QueryExchangeRateResponse is not mutable"))
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.halted":
+		panic(fmt.Errorf("field halted of message nibiru.oracle.v1.QueryExchangeRateResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateResponse"))
@@ -673,6 +730,12 @@ func (x *fastReflection_QueryExchangeRateResponse) NewField(fd protoreflect.Fiel
 		return protoreflect.ValueOfInt64(int64(0))
 	case "nibiru.oracle.v1.QueryExchangeRateResponse.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.age_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.stale":
+		return protoreflect.ValueOfBool(false)
+	case "nibiru.oracle.v1.QueryExchangeRateResponse.halted":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateResponse"))
@@ -752,6 +815,15 @@ func (x *fastReflection_QueryExchangeRateResponse) ProtoMethods() *protoiface.Me
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
+		if x.AgeBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.AgeBlocks))
+		}
+		if x.Stale {
+			n += 2
+		}
+		if x.Halted {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -781,6 +853,31 @@ func (x *fastReflection_QueryExchangeRateResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Halted {
+			i--
+			if x.Halted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Stale {
+			i--
+			if x.Stale {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AgeBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AgeBlocks))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
@@ -917,6 +1014,65 @@ func (x *fastReflection_QueryExchangeRateResponse) ProtoMethods() *protoiface.Me
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgeBlocks", wireType)
+				}
+				x.AgeBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AgeBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Stale = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Halted = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -953,23 +1109,25 @@ func (x *fastReflection_QueryExchangeRateResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_QueryExchangeRatesRequest protoreflect.MessageDescriptor
+	md_QueryExchangeRateEmaRequest      protoreflect.MessageDescriptor
+	fd_QueryExchangeRateEmaRequest_pair protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryExchangeRatesRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryExchangeRatesRequest")
+	md_QueryExchangeRateEmaRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryExchangeRateEmaRequest")
+	fd_QueryExchangeRateEmaRequest_pair = md_QueryExchangeRateEmaRequest.Fields().ByName("pair")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryExchangeRatesRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryExchangeRateEmaRequest)(nil)
 
-type fastReflection_QueryExchangeRatesRequest QueryExchangeRatesRequest
+type fastReflection_QueryExchangeRateEmaRequest QueryExchangeRateEmaRequest
 
-func (x *QueryExchangeRatesRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryExchangeRatesRequest)(x)
+func (x *QueryExchangeRateEmaRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryExchangeRateEmaRequest)(x)
 }
 
-func (x *QueryExchangeRatesRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryExchangeRateEmaRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -981,43 +1139,43 @@ func (x *QueryExchangeRatesRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryExchangeRatesRequest_messageType fastReflection_QueryExchangeRatesRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryExchangeRatesRequest_messageType{}
+var _fastReflection_QueryExchangeRateEmaRequest_messageType fastReflection_QueryExchangeRateEmaRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryExchangeRateEmaRequest_messageType{}
 
-type fastReflection_QueryExchangeRatesRequest_messageType struct{}
+type fastReflection_QueryExchangeRateEmaRequest_messageType struct{}
 
-func (x fastReflection_QueryExchangeRatesRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryExchangeRatesRequest)(nil)
+func (x fastReflection_QueryExchangeRateEmaRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryExchangeRateEmaRequest)(nil)
 }
-func (x fastReflection_QueryExchangeRatesRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryExchangeRatesRequest)
+func (x fastReflection_QueryExchangeRateEmaRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryExchangeRateEmaRequest)
 }
-func (x fastReflection_QueryExchangeRatesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryExchangeRatesRequest
+func (x fastReflection_QueryExchangeRateEmaRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExchangeRateEmaRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryExchangeRatesRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryExchangeRatesRequest
+func (x *fastReflection_QueryExchangeRateEmaRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExchangeRateEmaRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryExchangeRatesRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryExchangeRatesRequest_messageType
+func (x *fastReflection_QueryExchangeRateEmaRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryExchangeRateEmaRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryExchangeRatesRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryExchangeRatesRequest)
+func (x *fastReflection_QueryExchangeRateEmaRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryExchangeRateEmaRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryExchangeRatesRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryExchangeRatesRequest)(x)
+func (x *fastReflection_QueryExchangeRateEmaRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryExchangeRateEmaRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1025,7 +1183,13 @@ func (x *fastReflection_QueryExchangeRatesRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryExchangeRatesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryExchangeRateEmaRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pair != "" {
+		value := protoreflect.ValueOfString(x.Pair)
+		if !f(fd_QueryExchangeRateEmaRequest_pair, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1039,13 +1203,15 @@ func (x *fastReflection_QueryExchangeRatesRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryExchangeRatesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryExchangeRateEmaRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryExchangeRateEmaRequest.pair":
+		return x.Pair != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateEmaRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRateEmaRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1055,13 +1221,15 @@ func (x *fastReflection_QueryExchangeRatesRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryExchangeRatesRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryExchangeRateEmaRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryExchangeRateEmaRequest.pair":
+		x.Pair = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateEmaRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRateEmaRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1071,13 +1239,16 @@ func (x *fastReflection_QueryExchangeRatesRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryExchangeRatesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExchangeRateEmaRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "nibiru.oracle.v1.QueryExchangeRateEmaRequest.pair":
+		value := x.Pair
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateEmaRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRateEmaRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1091,13 +1262,15 @@ func (x *fastReflection_QueryExchangeRatesRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryExchangeRatesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryExchangeRateEmaRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryExchangeRateEmaRequest.pair":
+		x.Pair = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateEmaRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRateEmaRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1111,36 +1284,40 @@ func (x *fastReflection_QueryExchangeRatesRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryExchangeRatesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExchangeRateEmaRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryExchangeRateEmaRequest.pair":
+		panic(fmt.Errorf("field pair of message nibiru.oracle.v1.QueryExchangeRateEmaRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateEmaRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRateEmaRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryExchangeRatesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExchangeRateEmaRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryExchangeRateEmaRequest.pair":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateEmaRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRateEmaRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryExchangeRatesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryExchangeRateEmaRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryExchangeRatesRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryExchangeRateEmaRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1148,7 +1325,7 @@ func (x *fastReflection_QueryExchangeRatesRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryExchangeRatesRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryExchangeRateEmaRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1159,7 +1336,7 @@ func (x *fastReflection_QueryExchangeRatesRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryExchangeRatesRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryExchangeRateEmaRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1171,7 +1348,7 @@ func (x *fastReflection_QueryExchangeRatesRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryExchangeRatesRequest) IsValid() bool {
+func (x *fastReflection_QueryExchangeRateEmaRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1181,9 +1358,9 @@ func (x *fastReflection_QueryExchangeRatesRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryExchangeRatesRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryExchangeRateEmaRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryExchangeRatesRequest)
+		x := input.Message.Interface().(*QueryExchangeRateEmaRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1195,6 +1372,10 @@ func (x *fastReflection_QueryExchangeRatesRequest) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
+		l = len(x.Pair)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1205,7 +1386,7 @@ func (x *fastReflection_QueryExchangeRatesRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryExchangeRatesRequest)
+		x := input.Message.Interface().(*QueryExchangeRateEmaRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1224,6 +1405,13 @@ func (x *fastReflection_QueryExchangeRatesRequest) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Pair) > 0 {
+			i -= len(x.Pair)
+			copy(dAtA[i:], x.Pair)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pair)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1235,7 +1423,7 @@ func (x *fastReflection_QueryExchangeRatesRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryExchangeRatesRequest)
+		x := input.Message.Interface().(*QueryExchangeRateEmaRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1267,12 +1455,44 @@ func (x *fastReflection_QueryExchangeRatesRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExchangeRateEmaRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExchangeRateEmaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pair = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1308,77 +1528,26 @@ func (x *fastReflection_QueryExchangeRatesRequest) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_QueryExchangeRatesResponse_1_list)(nil)
-
-type _QueryExchangeRatesResponse_1_list struct {
-	list *[]*ExchangeRateTuple
-}
-
-func (x *_QueryExchangeRatesResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryExchangeRatesResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryExchangeRatesResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ExchangeRateTuple)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryExchangeRatesResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ExchangeRateTuple)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryExchangeRatesResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(ExchangeRateTuple)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryExchangeRatesResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryExchangeRatesResponse_1_list) NewElement() protoreflect.Value {
-	v := new(ExchangeRateTuple)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryExchangeRatesResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryExchangeRatesResponse                protoreflect.MessageDescriptor
-	fd_QueryExchangeRatesResponse_exchange_rates protoreflect.FieldDescriptor
+	md_QueryExchangeRateEmaResponse                   protoreflect.MessageDescriptor
+	fd_QueryExchangeRateEmaResponse_exchange_rate_ema protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryExchangeRatesResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryExchangeRatesResponse")
-	fd_QueryExchangeRatesResponse_exchange_rates = md_QueryExchangeRatesResponse.Fields().ByName("exchange_rates")
+	md_QueryExchangeRateEmaResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryExchangeRateEmaResponse")
+	fd_QueryExchangeRateEmaResponse_exchange_rate_ema = md_QueryExchangeRateEmaResponse.Fields().ByName("exchange_rate_ema")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryExchangeRatesResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryExchangeRateEmaResponse)(nil)
 
-type fastReflection_QueryExchangeRatesResponse QueryExchangeRatesResponse
+type fastReflection_QueryExchangeRateEmaResponse QueryExchangeRateEmaResponse
 
-func (x *QueryExchangeRatesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryExchangeRatesResponse)(x)
+func (x *QueryExchangeRateEmaResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryExchangeRateEmaResponse)(x)
 }
 
-func (x *QueryExchangeRatesResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryExchangeRateEmaResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1390,43 +1559,43 @@ func (x *QueryExchangeRatesResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryExchangeRatesResponse_messageType fastReflection_QueryExchangeRatesResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryExchangeRatesResponse_messageType{}
+var _fastReflection_QueryExchangeRateEmaResponse_messageType fastReflection_QueryExchangeRateEmaResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryExchangeRateEmaResponse_messageType{}
 
-type fastReflection_QueryExchangeRatesResponse_messageType struct{}
+type fastReflection_QueryExchangeRateEmaResponse_messageType struct{}
 
-func (x fastReflection_QueryExchangeRatesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryExchangeRatesResponse)(nil)
+func (x fastReflection_QueryExchangeRateEmaResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryExchangeRateEmaResponse)(nil)
 }
-func (x fastReflection_QueryExchangeRatesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryExchangeRatesResponse)
+func (x fastReflection_QueryExchangeRateEmaResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryExchangeRateEmaResponse)
 }
-func (x fastReflection_QueryExchangeRatesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryExchangeRatesResponse
+func (x fastReflection_QueryExchangeRateEmaResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExchangeRateEmaResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryExchangeRatesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryExchangeRatesResponse
+func (x *fastReflection_QueryExchangeRateEmaResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExchangeRateEmaResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryExchangeRatesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryExchangeRatesResponse_messageType
+func (x *fastReflection_QueryExchangeRateEmaResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryExchangeRateEmaResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryExchangeRatesResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryExchangeRatesResponse)
+func (x *fastReflection_QueryExchangeRateEmaResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryExchangeRateEmaResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryExchangeRatesResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryExchangeRatesResponse)(x)
+func (x *fastReflection_QueryExchangeRateEmaResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryExchangeRateEmaResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1434,10 +1603,10 @@ func (x *fastReflection_QueryExchangeRatesResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryExchangeRatesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ExchangeRates) != 0 {
-		value := protoreflect.ValueOfList(&_QueryExchangeRatesResponse_1_list{list: &x.ExchangeRates})
-		if !f(fd_QueryExchangeRatesResponse_exchange_rates, value) {
+func (x *fastReflection_QueryExchangeRateEmaResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExchangeRateEma != nil {
+		value := protoreflect.ValueOfMessage(x.ExchangeRateEma.ProtoReflect())
+		if !f(fd_QueryExchangeRateEmaResponse_exchange_rate_ema, value) {
 			return
 		}
 	}
@@ -1454,15 +1623,15 @@ func (x *fastReflection_QueryExchangeRatesResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryExchangeRatesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryExchangeRateEmaResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates":
-		return len(x.ExchangeRates) != 0
+	case "nibiru.oracle.v1.QueryExchangeRateEmaResponse.exchange_rate_ema":
+		return x.ExchangeRateEma != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateEmaResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRateEmaResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1472,15 +1641,15 @@ func (x *fastReflection_QueryExchangeRatesResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryExchangeRatesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryExchangeRateEmaResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates":
-		x.ExchangeRates = nil
+	case "nibiru.oracle.v1.QueryExchangeRateEmaResponse.exchange_rate_ema":
+		x.ExchangeRateEma = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateEmaResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRateEmaResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1490,19 +1659,16 @@ func (x *fastReflection_QueryExchangeRatesResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryExchangeRatesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExchangeRateEmaResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates":
-		if len(x.ExchangeRates) == 0 {
-			return protoreflect.ValueOfList(&_QueryExchangeRatesResponse_1_list{})
-		}
-		listValue := &_QueryExchangeRatesResponse_1_list{list: &x.ExchangeRates}
-		return protoreflect.ValueOfList(listValue)
+	case "nibiru.oracle.v1.QueryExchangeRateEmaResponse.exchange_rate_ema":
+		value := x.ExchangeRateEma
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateEmaResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRateEmaResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1516,17 +1682,15 @@ func (x *fastReflection_QueryExchangeRatesResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryExchangeRatesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryExchangeRateEmaResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates":
-		lv := value.List()
-		clv := lv.(*_QueryExchangeRatesResponse_1_list)
-		x.ExchangeRates = *clv.list
+	case "nibiru.oracle.v1.QueryExchangeRateEmaResponse.exchange_rate_ema":
+		x.ExchangeRateEma = value.Message().Interface().(*ExchangeRateEma)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateEmaResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRateEmaResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1540,45 +1704,44 @@ func (x *fastReflection_QueryExchangeRatesResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryExchangeRatesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExchangeRateEmaResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates":
-		if x.ExchangeRates == nil {
-			x.ExchangeRates = []*ExchangeRateTuple{}
+	case "nibiru.oracle.v1.QueryExchangeRateEmaResponse.exchange_rate_ema":
+		if x.ExchangeRateEma == nil {
+			x.ExchangeRateEma = new(ExchangeRateEma)
 		}
-		value := &_QueryExchangeRatesResponse_1_list{list: &x.ExchangeRates}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.ExchangeRateEma.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateEmaResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRateEmaResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryExchangeRatesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExchangeRateEmaResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates":
-		list := []*ExchangeRateTuple{}
-		return protoreflect.ValueOfList(&_QueryExchangeRatesResponse_1_list{list: &list})
+	case "nibiru.oracle.v1.QueryExchangeRateEmaResponse.exchange_rate_ema":
+		m := new(ExchangeRateEma)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRateEmaResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRateEmaResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryExchangeRatesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryExchangeRateEmaResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryExchangeRatesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryExchangeRateEmaResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1586,7 +1749,7 @@ func (x *fastReflection_QueryExchangeRatesResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryExchangeRatesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryExchangeRateEmaResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1597,7 +1760,7 @@ func (x *fastReflection_QueryExchangeRatesResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryExchangeRatesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryExchangeRateEmaResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1609,7 +1772,7 @@ func (x *fastReflection_QueryExchangeRatesResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryExchangeRatesResponse) IsValid() bool {
+func (x *fastReflection_QueryExchangeRateEmaResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1619,9 +1782,9 @@ func (x *fastReflection_QueryExchangeRatesResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryExchangeRatesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryExchangeRateEmaResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryExchangeRatesResponse)
+		x := input.Message.Interface().(*QueryExchangeRateEmaResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1633,11 +1796,9 @@ func (x *fastReflection_QueryExchangeRatesResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if len(x.ExchangeRates) > 0 {
-			for _, e := range x.ExchangeRates {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.ExchangeRateEma != nil {
+			l = options.Size(x.ExchangeRateEma)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1649,7 +1810,7 @@ func (x *fastReflection_QueryExchangeRatesResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryExchangeRatesResponse)
+		x := input.Message.Interface().(*QueryExchangeRateEmaResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1668,21 +1829,19 @@ func (x *fastReflection_QueryExchangeRatesResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ExchangeRates) > 0 {
-			for iNdEx := len(x.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ExchangeRates[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.ExchangeRateEma != nil {
+			encoded, err := options.Marshal(x.ExchangeRateEma)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1695,7 +1854,7 @@ func (x *fastReflection_QueryExchangeRatesResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryExchangeRatesResponse)
+		x := input.Message.Interface().(*QueryExchangeRateEmaResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1727,15 +1886,15 @@ func (x *fastReflection_QueryExchangeRatesResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExchangeRateEmaResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExchangeRateEmaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateEma", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1762,8 +1921,10 @@ func (x *fastReflection_QueryExchangeRatesResponse) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExchangeRates = append(x.ExchangeRates, &ExchangeRateTuple{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRates[len(x.ExchangeRates)-1]); err != nil {
+				if x.ExchangeRateEma == nil {
+					x.ExchangeRateEma = &ExchangeRateEma{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRateEma); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1803,23 +1964,23 @@ func (x *fastReflection_QueryExchangeRatesResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryActivesRequest protoreflect.MessageDescriptor
+	md_QueryExchangeRatesRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryActivesRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryActivesRequest")
+	md_QueryExchangeRatesRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryExchangeRatesRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActivesRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryExchangeRatesRequest)(nil)
 
-type fastReflection_QueryActivesRequest QueryActivesRequest
+type fastReflection_QueryExchangeRatesRequest QueryExchangeRatesRequest
 
-func (x *QueryActivesRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActivesRequest)(x)
+func (x *QueryExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryExchangeRatesRequest)(x)
 }
 
-func (x *QueryActivesRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryExchangeRatesRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1831,43 +1992,43 @@ func (x *QueryActivesRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActivesRequest_messageType fastReflection_QueryActivesRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActivesRequest_messageType{}
+var _fastReflection_QueryExchangeRatesRequest_messageType fastReflection_QueryExchangeRatesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryExchangeRatesRequest_messageType{}
 
-type fastReflection_QueryActivesRequest_messageType struct{}
+type fastReflection_QueryExchangeRatesRequest_messageType struct{}
 
-func (x fastReflection_QueryActivesRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActivesRequest)(nil)
+func (x fastReflection_QueryExchangeRatesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryExchangeRatesRequest)(nil)
 }
-func (x fastReflection_QueryActivesRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActivesRequest)
+func (x fastReflection_QueryExchangeRatesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryExchangeRatesRequest)
 }
-func (x fastReflection_QueryActivesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActivesRequest
+func (x fastReflection_QueryExchangeRatesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExchangeRatesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActivesRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActivesRequest
+func (x *fastReflection_QueryExchangeRatesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExchangeRatesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActivesRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActivesRequest_messageType
+func (x *fastReflection_QueryExchangeRatesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryExchangeRatesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActivesRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryActivesRequest)
+func (x *fastReflection_QueryExchangeRatesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryExchangeRatesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActivesRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryActivesRequest)(x)
+func (x *fastReflection_QueryExchangeRatesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryExchangeRatesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1875,7 +2036,7 @@ func (x *fastReflection_QueryActivesRequest) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActivesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryExchangeRatesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -1889,13 +2050,13 @@ func (x *fastReflection_QueryActivesRequest) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActivesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryExchangeRatesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1905,13 +2066,13 @@ func (x *fastReflection_QueryActivesRequest) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActivesRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryExchangeRatesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1921,13 +2082,13 @@ func (x *fastReflection_QueryActivesRequest) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActivesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExchangeRatesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1941,13 +2102,13 @@ func (x *fastReflection_QueryActivesRequest) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActivesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryExchangeRatesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1961,36 +2122,36 @@ func (x *fastReflection_QueryActivesRequest) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActivesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExchangeRatesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActivesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExchangeRatesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActivesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryExchangeRatesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryActivesRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryExchangeRatesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1998,7 +2159,7 @@ func (x *fastReflection_QueryActivesRequest) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActivesRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryExchangeRatesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2009,7 +2170,7 @@ func (x *fastReflection_QueryActivesRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActivesRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryExchangeRatesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2021,7 +2182,7 @@ func (x *fastReflection_QueryActivesRequest) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActivesRequest) IsValid() bool {
+func (x *fastReflection_QueryExchangeRatesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2031,9 +2192,9 @@ func (x *fastReflection_QueryActivesRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActivesRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryExchangeRatesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActivesRequest)
+		x := input.Message.Interface().(*QueryExchangeRatesRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2055,7 +2216,7 @@ func (x *fastReflection_QueryActivesRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActivesRequest)
+		x := input.Message.Interface().(*QueryExchangeRatesRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2085,7 +2246,7 @@ func (x *fastReflection_QueryActivesRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActivesRequest)
+		x := input.Message.Interface().(*QueryExchangeRatesRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2117,10 +2278,10 @@ func (x *fastReflection_QueryActivesRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -2158,72 +2319,77 @@ func (x *fastReflection_QueryActivesRequest) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_QueryActivesResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryExchangeRatesResponse_1_list)(nil)
 
-type _QueryActivesResponse_1_list struct {
-	list *[]string
+type _QueryExchangeRatesResponse_1_list struct {
+	list *[]*ExchangeRateTuple
 }
 
-func (x *_QueryActivesResponse_1_list) Len() int {
+func (x *_QueryExchangeRatesResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryActivesResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_QueryExchangeRatesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryActivesResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_QueryExchangeRatesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExchangeRateTuple)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryActivesResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_QueryExchangeRatesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExchangeRateTuple)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryActivesResponse_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryActivesResponse at list field Actives as it is not of Message kind"))
+func (x *_QueryExchangeRatesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ExchangeRateTuple)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryActivesResponse_1_list) Truncate(n int) {
+func (x *_QueryExchangeRatesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryActivesResponse_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_QueryExchangeRatesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ExchangeRateTuple)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryActivesResponse_1_list) IsValid() bool {
+func (x *_QueryExchangeRatesResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryActivesResponse         protoreflect.MessageDescriptor
-	fd_QueryActivesResponse_actives protoreflect.FieldDescriptor
+	md_QueryExchangeRatesResponse                protoreflect.MessageDescriptor
+	fd_QueryExchangeRatesResponse_exchange_rates protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryActivesResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryActivesResponse")
-	fd_QueryActivesResponse_actives = md_QueryActivesResponse.Fields().ByName("actives")
+	md_QueryExchangeRatesResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryExchangeRatesResponse")
+	fd_QueryExchangeRatesResponse_exchange_rates = md_QueryExchangeRatesResponse.Fields().ByName("exchange_rates")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActivesResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryExchangeRatesResponse)(nil)
 
-type fastReflection_QueryActivesResponse QueryActivesResponse
+type fastReflection_QueryExchangeRatesResponse QueryExchangeRatesResponse
 
-func (x *QueryActivesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActivesResponse)(x)
+func (x *QueryExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryExchangeRatesResponse)(x)
 }
 
-func (x *QueryActivesResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryExchangeRatesResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2235,43 +2401,43 @@ func (x *QueryActivesResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActivesResponse_messageType fastReflection_QueryActivesResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActivesResponse_messageType{}
+var _fastReflection_QueryExchangeRatesResponse_messageType fastReflection_QueryExchangeRatesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryExchangeRatesResponse_messageType{}
 
-type fastReflection_QueryActivesResponse_messageType struct{}
+type fastReflection_QueryExchangeRatesResponse_messageType struct{}
 
-func (x fastReflection_QueryActivesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActivesResponse)(nil)
+func (x fastReflection_QueryExchangeRatesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryExchangeRatesResponse)(nil)
 }
-func (x fastReflection_QueryActivesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActivesResponse)
+func (x fastReflection_QueryExchangeRatesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryExchangeRatesResponse)
 }
-func (x fastReflection_QueryActivesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActivesResponse
+func (x fastReflection_QueryExchangeRatesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExchangeRatesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActivesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActivesResponse
+func (x *fastReflection_QueryExchangeRatesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryExchangeRatesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActivesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActivesResponse_messageType
+func (x *fastReflection_QueryExchangeRatesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryExchangeRatesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActivesResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryActivesResponse)
+func (x *fastReflection_QueryExchangeRatesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryExchangeRatesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActivesResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryActivesResponse)(x)
+func (x *fastReflection_QueryExchangeRatesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryExchangeRatesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2279,10 +2445,10 @@ func (x *fastReflection_QueryActivesResponse) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActivesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Actives) != 0 {
-		value := protoreflect.ValueOfList(&_QueryActivesResponse_1_list{list: &x.Actives})
-		if !f(fd_QueryActivesResponse_actives, value) {
+func (x *fastReflection_QueryExchangeRatesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ExchangeRates) != 0 {
+		value := protoreflect.ValueOfList(&_QueryExchangeRatesResponse_1_list{list: &x.ExchangeRates})
+		if !f(fd_QueryExchangeRatesResponse_exchange_rates, value) {
 			return
 		}
 	}
@@ -2299,15 +2465,15 @@ func (x *fastReflection_QueryActivesResponse) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActivesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryExchangeRatesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryActivesResponse.actives":
-		return len(x.Actives) != 0
+	case "nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates":
+		return len(x.ExchangeRates) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2317,15 +2483,15 @@ func (x *fastReflection_QueryActivesResponse) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActivesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryExchangeRatesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryActivesResponse.actives":
-		x.Actives = nil
+	case "nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates":
+		x.ExchangeRates = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2335,19 +2501,19 @@ func (x *fastReflection_QueryActivesResponse) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActivesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExchangeRatesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryActivesResponse.actives":
-		if len(x.Actives) == 0 {
-			return protoreflect.ValueOfList(&_QueryActivesResponse_1_list{})
+	case "nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates":
+		if len(x.ExchangeRates) == 0 {
+			return protoreflect.ValueOfList(&_QueryExchangeRatesResponse_1_list{})
 		}
-		listValue := &_QueryActivesResponse_1_list{list: &x.Actives}
+		listValue := &_QueryExchangeRatesResponse_1_list{list: &x.ExchangeRates}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2361,17 +2527,17 @@ func (x *fastReflection_QueryActivesResponse) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActivesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryExchangeRatesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryActivesResponse.actives":
+	case "nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates":
 		lv := value.List()
-		clv := lv.(*_QueryActivesResponse_1_list)
-		x.Actives = *clv.list
+		clv := lv.(*_QueryExchangeRatesResponse_1_list)
+		x.ExchangeRates = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2385,45 +2551,45 @@ func (x *fastReflection_QueryActivesResponse) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActivesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExchangeRatesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryActivesResponse.actives":
-		if x.Actives == nil {
-			x.Actives = []string{}
+	case "nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates":
+		if x.ExchangeRates == nil {
+			x.ExchangeRates = []*ExchangeRateTuple{}
 		}
-		value := &_QueryActivesResponse_1_list{list: &x.Actives}
+		value := &_QueryExchangeRatesResponse_1_list{list: &x.ExchangeRates}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActivesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryExchangeRatesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryActivesResponse.actives":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryActivesResponse_1_list{list: &list})
+	case "nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates":
+		list := []*ExchangeRateTuple{}
+		return protoreflect.ValueOfList(&_QueryExchangeRatesResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryExchangeRatesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryExchangeRatesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActivesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryExchangeRatesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryActivesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryExchangeRatesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2431,7 +2597,7 @@ func (x *fastReflection_QueryActivesResponse) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActivesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryExchangeRatesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2442,7 +2608,7 @@ func (x *fastReflection_QueryActivesResponse) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActivesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryExchangeRatesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2454,7 +2620,7 @@ func (x *fastReflection_QueryActivesResponse) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActivesResponse) IsValid() bool {
+func (x *fastReflection_QueryExchangeRatesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2464,9 +2630,9 @@ func (x *fastReflection_QueryActivesResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActivesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryExchangeRatesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActivesResponse)
+		x := input.Message.Interface().(*QueryExchangeRatesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2478,9 +2644,9 @@ func (x *fastReflection_QueryActivesResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.Actives) > 0 {
-			for _, s := range x.Actives {
-				l = len(s)
+		if len(x.ExchangeRates) > 0 {
+			for _, e := range x.ExchangeRates {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
@@ -2494,7 +2660,7 @@ func (x *fastReflection_QueryActivesResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActivesResponse)
+		x := input.Message.Interface().(*QueryExchangeRatesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2513,11 +2679,18 @@ func (x *fastReflection_QueryActivesResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Actives) > 0 {
-			for iNdEx := len(x.Actives) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Actives[iNdEx])
-				copy(dAtA[i:], x.Actives[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Actives[iNdEx])))
+		if len(x.ExchangeRates) > 0 {
+			for iNdEx := len(x.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExchangeRates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
 				dAtA[i] = 0xa
 			}
@@ -2533,7 +2706,7 @@ func (x *fastReflection_QueryActivesResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActivesResponse)
+		x := input.Message.Interface().(*QueryExchangeRatesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2565,17 +2738,17 @@ func (x *fastReflection_QueryActivesResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2585,23 +2758,25 @@ func (x *fastReflection_QueryActivesResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Actives = append(x.Actives, string(dAtA[iNdEx:postIndex]))
+				x.ExchangeRates = append(x.ExchangeRates, &ExchangeRateTuple{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRates[len(x.ExchangeRates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2639,23 +2814,23 @@ func (x *fastReflection_QueryActivesResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryVoteTargetsRequest protoreflect.MessageDescriptor
+	md_QueryActivesRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryVoteTargetsRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryVoteTargetsRequest")
+	md_QueryActivesRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryActivesRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVoteTargetsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryActivesRequest)(nil)
 
-type fastReflection_QueryVoteTargetsRequest QueryVoteTargetsRequest
+type fastReflection_QueryActivesRequest QueryActivesRequest
 
-func (x *QueryVoteTargetsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVoteTargetsRequest)(x)
+func (x *QueryActivesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActivesRequest)(x)
 }
 
-func (x *QueryVoteTargetsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryActivesRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2667,43 +2842,43 @@ func (x *QueryVoteTargetsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVoteTargetsRequest_messageType fastReflection_QueryVoteTargetsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVoteTargetsRequest_messageType{}
+var _fastReflection_QueryActivesRequest_messageType fastReflection_QueryActivesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActivesRequest_messageType{}
 
-type fastReflection_QueryVoteTargetsRequest_messageType struct{}
+type fastReflection_QueryActivesRequest_messageType struct{}
 
-func (x fastReflection_QueryVoteTargetsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVoteTargetsRequest)(nil)
+func (x fastReflection_QueryActivesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActivesRequest)(nil)
 }
-func (x fastReflection_QueryVoteTargetsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteTargetsRequest)
+func (x fastReflection_QueryActivesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActivesRequest)
 }
-func (x fastReflection_QueryVoteTargetsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteTargetsRequest
+func (x fastReflection_QueryActivesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActivesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVoteTargetsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteTargetsRequest
+func (x *fastReflection_QueryActivesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActivesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVoteTargetsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVoteTargetsRequest_messageType
+func (x *fastReflection_QueryActivesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActivesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVoteTargetsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteTargetsRequest)
+func (x *fastReflection_QueryActivesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryActivesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVoteTargetsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryVoteTargetsRequest)(x)
+func (x *fastReflection_QueryActivesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryActivesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2711,7 +2886,7 @@ func (x *fastReflection_QueryVoteTargetsRequest) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVoteTargetsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryActivesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -2725,13 +2900,13 @@ func (x *fastReflection_QueryVoteTargetsRequest) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVoteTargetsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryActivesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2741,13 +2916,13 @@ func (x *fastReflection_QueryVoteTargetsRequest) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteTargetsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryActivesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2757,13 +2932,13 @@ func (x *fastReflection_QueryVoteTargetsRequest) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVoteTargetsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActivesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2777,13 +2952,13 @@ func (x *fastReflection_QueryVoteTargetsRequest) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteTargetsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryActivesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2797,36 +2972,36 @@ func (x *fastReflection_QueryVoteTargetsRequest) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteTargetsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActivesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVoteTargetsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActivesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVoteTargetsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryActivesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryVoteTargetsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryActivesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2834,7 +3009,7 @@ func (x *fastReflection_QueryVoteTargetsRequest) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVoteTargetsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryActivesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2845,7 +3020,7 @@ func (x *fastReflection_QueryVoteTargetsRequest) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteTargetsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryActivesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2857,7 +3032,7 @@ func (x *fastReflection_QueryVoteTargetsRequest) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVoteTargetsRequest) IsValid() bool {
+func (x *fastReflection_QueryActivesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2867,9 +3042,9 @@ func (x *fastReflection_QueryVoteTargetsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVoteTargetsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryActivesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVoteTargetsRequest)
+		x := input.Message.Interface().(*QueryActivesRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2891,7 +3066,7 @@ func (x *fastReflection_QueryVoteTargetsRequest) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteTargetsRequest)
+		x := input.Message.Interface().(*QueryActivesRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2921,7 +3096,7 @@ func (x *fastReflection_QueryVoteTargetsRequest) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteTargetsRequest)
+		x := input.Message.Interface().(*QueryActivesRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2953,10 +3128,10 @@ func (x *fastReflection_QueryVoteTargetsRequest) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -2994,72 +3169,72 @@ func (x *fastReflection_QueryVoteTargetsRequest) ProtoMethods() *protoiface.Meth
 	}
 }
 
-var _ protoreflect.List = (*_QueryVoteTargetsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryActivesResponse_1_list)(nil)
 
-type _QueryVoteTargetsResponse_1_list struct {
+type _QueryActivesResponse_1_list struct {
 	list *[]string
 }
 
-func (x *_QueryVoteTargetsResponse_1_list) Len() int {
+func (x *_QueryActivesResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryVoteTargetsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryActivesResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_QueryVoteTargetsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryActivesResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryVoteTargetsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryActivesResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryVoteTargetsResponse_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryVoteTargetsResponse at list field VoteTargets as it is not of Message kind"))
+func (x *_QueryActivesResponse_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryActivesResponse at list field Actives as it is not of Message kind"))
 }
 
-func (x *_QueryVoteTargetsResponse_1_list) Truncate(n int) {
+func (x *_QueryActivesResponse_1_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryVoteTargetsResponse_1_list) NewElement() protoreflect.Value {
+func (x *_QueryActivesResponse_1_list) NewElement() protoreflect.Value {
 	v := ""
 	return protoreflect.ValueOfString(v)
 }
 
-func (x *_QueryVoteTargetsResponse_1_list) IsValid() bool {
+func (x *_QueryActivesResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryVoteTargetsResponse              protoreflect.MessageDescriptor
-	fd_QueryVoteTargetsResponse_vote_targets protoreflect.FieldDescriptor
+	md_QueryActivesResponse         protoreflect.MessageDescriptor
+	fd_QueryActivesResponse_actives protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryVoteTargetsResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryVoteTargetsResponse")
-	fd_QueryVoteTargetsResponse_vote_targets = md_QueryVoteTargetsResponse.Fields().ByName("vote_targets")
+	md_QueryActivesResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryActivesResponse")
+	fd_QueryActivesResponse_actives = md_QueryActivesResponse.Fields().ByName("actives")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVoteTargetsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryActivesResponse)(nil)
 
-type fastReflection_QueryVoteTargetsResponse QueryVoteTargetsResponse
+type fastReflection_QueryActivesResponse QueryActivesResponse
 
-func (x *QueryVoteTargetsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVoteTargetsResponse)(x)
+func (x *QueryActivesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActivesResponse)(x)
 }
 
-func (x *QueryVoteTargetsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryActivesResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3071,43 +3246,43 @@ func (x *QueryVoteTargetsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVoteTargetsResponse_messageType fastReflection_QueryVoteTargetsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVoteTargetsResponse_messageType{}
+var _fastReflection_QueryActivesResponse_messageType fastReflection_QueryActivesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActivesResponse_messageType{}
 
-type fastReflection_QueryVoteTargetsResponse_messageType struct{}
+type fastReflection_QueryActivesResponse_messageType struct{}
 
-func (x fastReflection_QueryVoteTargetsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVoteTargetsResponse)(nil)
+func (x fastReflection_QueryActivesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActivesResponse)(nil)
 }
-func (x fastReflection_QueryVoteTargetsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteTargetsResponse)
+func (x fastReflection_QueryActivesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActivesResponse)
 }
-func (x fastReflection_QueryVoteTargetsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteTargetsResponse
+func (x fastReflection_QueryActivesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActivesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVoteTargetsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteTargetsResponse
+func (x *fastReflection_QueryActivesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActivesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVoteTargetsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVoteTargetsResponse_messageType
+func (x *fastReflection_QueryActivesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActivesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVoteTargetsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteTargetsResponse)
+func (x *fastReflection_QueryActivesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryActivesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVoteTargetsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryVoteTargetsResponse)(x)
+func (x *fastReflection_QueryActivesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryActivesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3115,10 +3290,10 @@ func (x *fastReflection_QueryVoteTargetsResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVoteTargetsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.VoteTargets) != 0 {
-		value := protoreflect.ValueOfList(&_QueryVoteTargetsResponse_1_list{list: &x.VoteTargets})
-		if !f(fd_QueryVoteTargetsResponse_vote_targets, value) {
+func (x *fastReflection_QueryActivesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Actives) != 0 {
+		value := protoreflect.ValueOfList(&_QueryActivesResponse_1_list{list: &x.Actives})
+		if !f(fd_QueryActivesResponse_actives, value) {
 			return
 		}
 	}
@@ -3135,15 +3310,15 @@ func (x *fastReflection_QueryVoteTargetsResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVoteTargetsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryActivesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryVoteTargetsResponse.vote_targets":
-		return len(x.VoteTargets) != 0
+	case "nibiru.oracle.v1.QueryActivesResponse.actives":
+		return len(x.Actives) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3153,15 +3328,15 @@ func (x *fastReflection_QueryVoteTargetsResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteTargetsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryActivesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryVoteTargetsResponse.vote_targets":
-		x.VoteTargets = nil
+	case "nibiru.oracle.v1.QueryActivesResponse.actives":
+		x.Actives = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3171,19 +3346,19 @@ func (x *fastReflection_QueryVoteTargetsResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVoteTargetsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActivesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryVoteTargetsResponse.vote_targets":
-		if len(x.VoteTargets) == 0 {
-			return protoreflect.ValueOfList(&_QueryVoteTargetsResponse_1_list{})
+	case "nibiru.oracle.v1.QueryActivesResponse.actives":
+		if len(x.Actives) == 0 {
+			return protoreflect.ValueOfList(&_QueryActivesResponse_1_list{})
 		}
-		listValue := &_QueryVoteTargetsResponse_1_list{list: &x.VoteTargets}
+		listValue := &_QueryActivesResponse_1_list{list: &x.Actives}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3197,17 +3372,17 @@ func (x *fastReflection_QueryVoteTargetsResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteTargetsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryActivesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryVoteTargetsResponse.vote_targets":
+	case "nibiru.oracle.v1.QueryActivesResponse.actives":
 		lv := value.List()
-		clv := lv.(*_QueryVoteTargetsResponse_1_list)
-		x.VoteTargets = *clv.list
+		clv := lv.(*_QueryActivesResponse_1_list)
+		x.Actives = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3221,45 +3396,45 @@ func (x *fastReflection_QueryVoteTargetsResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteTargetsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActivesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryVoteTargetsResponse.vote_targets":
-		if x.VoteTargets == nil {
-			x.VoteTargets = []string{}
+	case "nibiru.oracle.v1.QueryActivesResponse.actives":
+		if x.Actives == nil {
+			x.Actives = []string{}
 		}
-		value := &_QueryVoteTargetsResponse_1_list{list: &x.VoteTargets}
+		value := &_QueryActivesResponse_1_list{list: &x.Actives}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVoteTargetsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActivesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryVoteTargetsResponse.vote_targets":
+	case "nibiru.oracle.v1.QueryActivesResponse.actives":
 		list := []string{}
-		return protoreflect.ValueOfList(&_QueryVoteTargetsResponse_1_list{list: &list})
+		return protoreflect.ValueOfList(&_QueryActivesResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryActivesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryActivesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVoteTargetsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryActivesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryVoteTargetsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryActivesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3267,7 +3442,7 @@ func (x *fastReflection_QueryVoteTargetsResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVoteTargetsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryActivesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3278,7 +3453,7 @@ func (x *fastReflection_QueryVoteTargetsResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteTargetsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryActivesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3290,7 +3465,7 @@ func (x *fastReflection_QueryVoteTargetsResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVoteTargetsResponse) IsValid() bool {
+func (x *fastReflection_QueryActivesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3300,9 +3475,9 @@ func (x *fastReflection_QueryVoteTargetsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVoteTargetsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryActivesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVoteTargetsResponse)
+		x := input.Message.Interface().(*QueryActivesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3314,8 +3489,8 @@ func (x *fastReflection_QueryVoteTargetsResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if len(x.VoteTargets) > 0 {
-			for _, s := range x.VoteTargets {
+		if len(x.Actives) > 0 {
+			for _, s := range x.Actives {
 				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -3330,7 +3505,7 @@ func (x *fastReflection_QueryVoteTargetsResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteTargetsResponse)
+		x := input.Message.Interface().(*QueryActivesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3349,11 +3524,11 @@ func (x *fastReflection_QueryVoteTargetsResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.VoteTargets) > 0 {
-			for iNdEx := len(x.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.VoteTargets[iNdEx])
-				copy(dAtA[i:], x.VoteTargets[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VoteTargets[iNdEx])))
+		if len(x.Actives) > 0 {
+			for iNdEx := len(x.Actives) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Actives[iNdEx])
+				copy(dAtA[i:], x.Actives[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Actives[iNdEx])))
 				i--
 				dAtA[i] = 0xa
 			}
@@ -3369,7 +3544,7 @@ func (x *fastReflection_QueryVoteTargetsResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteTargetsResponse)
+		x := input.Message.Interface().(*QueryActivesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3401,15 +3576,15 @@ func (x *fastReflection_QueryVoteTargetsResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3437,7 +3612,7 @@ func (x *fastReflection_QueryVoteTargetsResponse) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VoteTargets = append(x.VoteTargets, string(dAtA[iNdEx:postIndex]))
+				x.Actives = append(x.Actives, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3475,25 +3650,23 @@ func (x *fastReflection_QueryVoteTargetsResponse) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_QueryFeederDelegationRequest                protoreflect.MessageDescriptor
-	fd_QueryFeederDelegationRequest_validator_addr protoreflect.FieldDescriptor
+	md_QueryVoteTargetsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryFeederDelegationRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryFeederDelegationRequest")
-	fd_QueryFeederDelegationRequest_validator_addr = md_QueryFeederDelegationRequest.Fields().ByName("validator_addr")
+	md_QueryVoteTargetsRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryVoteTargetsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryFeederDelegationRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVoteTargetsRequest)(nil)
 
-type fastReflection_QueryFeederDelegationRequest QueryFeederDelegationRequest
+type fastReflection_QueryVoteTargetsRequest QueryVoteTargetsRequest
 
-func (x *QueryFeederDelegationRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryFeederDelegationRequest)(x)
+func (x *QueryVoteTargetsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteTargetsRequest)(x)
 }
 
-func (x *QueryFeederDelegationRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryVoteTargetsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3505,43 +3678,43 @@ func (x *QueryFeederDelegationRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryFeederDelegationRequest_messageType fastReflection_QueryFeederDelegationRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryFeederDelegationRequest_messageType{}
+var _fastReflection_QueryVoteTargetsRequest_messageType fastReflection_QueryVoteTargetsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteTargetsRequest_messageType{}
 
-type fastReflection_QueryFeederDelegationRequest_messageType struct{}
+type fastReflection_QueryVoteTargetsRequest_messageType struct{}
 
-func (x fastReflection_QueryFeederDelegationRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryFeederDelegationRequest)(nil)
+func (x fastReflection_QueryVoteTargetsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteTargetsRequest)(nil)
 }
-func (x fastReflection_QueryFeederDelegationRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryFeederDelegationRequest)
+func (x fastReflection_QueryVoteTargetsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteTargetsRequest)
 }
-func (x fastReflection_QueryFeederDelegationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFeederDelegationRequest
+func (x fastReflection_QueryVoteTargetsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteTargetsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryFeederDelegationRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFeederDelegationRequest
+func (x *fastReflection_QueryVoteTargetsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteTargetsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryFeederDelegationRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryFeederDelegationRequest_messageType
+func (x *fastReflection_QueryVoteTargetsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteTargetsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryFeederDelegationRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryFeederDelegationRequest)
+func (x *fastReflection_QueryVoteTargetsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteTargetsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryFeederDelegationRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryFeederDelegationRequest)(x)
+func (x *fastReflection_QueryVoteTargetsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteTargetsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3549,13 +3722,7 @@ func (x *fastReflection_QueryFeederDelegationRequest) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryFeederDelegationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ValidatorAddr != "" {
-		value := protoreflect.ValueOfString(x.ValidatorAddr)
-		if !f(fd_QueryFeederDelegationRequest_validator_addr, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryVoteTargetsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3569,15 +3736,13 @@ func (x *fastReflection_QueryFeederDelegationRequest) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryFeederDelegationRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVoteTargetsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryFeederDelegationRequest.validator_addr":
-		return x.ValidatorAddr != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3587,15 +3752,13 @@ func (x *fastReflection_QueryFeederDelegationRequest) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFeederDelegationRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVoteTargetsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryFeederDelegationRequest.validator_addr":
-		x.ValidatorAddr = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3605,16 +3768,13 @@ func (x *fastReflection_QueryFeederDelegationRequest) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryFeederDelegationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteTargetsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryFeederDelegationRequest.validator_addr":
-		value := x.ValidatorAddr
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3628,15 +3788,13 @@ func (x *fastReflection_QueryFeederDelegationRequest) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFeederDelegationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVoteTargetsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryFeederDelegationRequest.validator_addr":
-		x.ValidatorAddr = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3650,40 +3808,36 @@ func (x *fastReflection_QueryFeederDelegationRequest) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFeederDelegationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteTargetsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryFeederDelegationRequest.validator_addr":
-		panic(fmt.Errorf("field validator_addr of message nibiru.oracle.v1.QueryFeederDelegationRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryFeederDelegationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteTargetsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryFeederDelegationRequest.validator_addr":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryFeederDelegationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVoteTargetsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryFeederDelegationRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryVoteTargetsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3691,7 +3845,7 @@ func (x *fastReflection_QueryFeederDelegationRequest) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryFeederDelegationRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVoteTargetsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3702,7 +3856,7 @@ func (x *fastReflection_QueryFeederDelegationRequest) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFeederDelegationRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVoteTargetsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3714,7 +3868,7 @@ func (x *fastReflection_QueryFeederDelegationRequest) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryFeederDelegationRequest) IsValid() bool {
+func (x *fastReflection_QueryVoteTargetsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3724,9 +3878,9 @@ func (x *fastReflection_QueryFeederDelegationRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryFeederDelegationRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVoteTargetsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryFeederDelegationRequest)
+		x := input.Message.Interface().(*QueryVoteTargetsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3738,10 +3892,6 @@ func (x *fastReflection_QueryFeederDelegationRequest) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		l = len(x.ValidatorAddr)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3752,7 +3902,7 @@ func (x *fastReflection_QueryFeederDelegationRequest) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFeederDelegationRequest)
+		x := input.Message.Interface().(*QueryVoteTargetsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3771,13 +3921,6 @@ func (x *fastReflection_QueryFeederDelegationRequest) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorAddr) > 0 {
-			i -= len(x.ValidatorAddr)
-			copy(dAtA[i:], x.ValidatorAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddr)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3789,7 +3932,7 @@ func (x *fastReflection_QueryFeederDelegationRequest) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFeederDelegationRequest)
+		x := input.Message.Interface().(*QueryVoteTargetsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3821,44 +3964,12 @@ func (x *fastReflection_QueryFeederDelegationRequest) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3894,26 +4005,72 @@ func (x *fastReflection_QueryFeederDelegationRequest) ProtoMethods() *protoiface
 	}
 }
 
+var _ protoreflect.List = (*_QueryVoteTargetsResponse_1_list)(nil)
+
+type _QueryVoteTargetsResponse_1_list struct {
+	list *[]string
+}
+
+func (x *_QueryVoteTargetsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryVoteTargetsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryVoteTargetsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryVoteTargetsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryVoteTargetsResponse_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryVoteTargetsResponse at list field VoteTargets as it is not of Message kind"))
+}
+
+func (x *_QueryVoteTargetsResponse_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryVoteTargetsResponse_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryVoteTargetsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryFeederDelegationResponse             protoreflect.MessageDescriptor
-	fd_QueryFeederDelegationResponse_feeder_addr protoreflect.FieldDescriptor
+	md_QueryVoteTargetsResponse              protoreflect.MessageDescriptor
+	fd_QueryVoteTargetsResponse_vote_targets protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryFeederDelegationResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryFeederDelegationResponse")
-	fd_QueryFeederDelegationResponse_feeder_addr = md_QueryFeederDelegationResponse.Fields().ByName("feeder_addr")
+	md_QueryVoteTargetsResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryVoteTargetsResponse")
+	fd_QueryVoteTargetsResponse_vote_targets = md_QueryVoteTargetsResponse.Fields().ByName("vote_targets")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryFeederDelegationResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVoteTargetsResponse)(nil)
 
-type fastReflection_QueryFeederDelegationResponse QueryFeederDelegationResponse
+type fastReflection_QueryVoteTargetsResponse QueryVoteTargetsResponse
 
-func (x *QueryFeederDelegationResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryFeederDelegationResponse)(x)
+func (x *QueryVoteTargetsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteTargetsResponse)(x)
 }
 
-func (x *QueryFeederDelegationResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryVoteTargetsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3925,43 +4082,43 @@ func (x *QueryFeederDelegationResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryFeederDelegationResponse_messageType fastReflection_QueryFeederDelegationResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryFeederDelegationResponse_messageType{}
+var _fastReflection_QueryVoteTargetsResponse_messageType fastReflection_QueryVoteTargetsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteTargetsResponse_messageType{}
 
-type fastReflection_QueryFeederDelegationResponse_messageType struct{}
+type fastReflection_QueryVoteTargetsResponse_messageType struct{}
 
-func (x fastReflection_QueryFeederDelegationResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryFeederDelegationResponse)(nil)
+func (x fastReflection_QueryVoteTargetsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteTargetsResponse)(nil)
 }
-func (x fastReflection_QueryFeederDelegationResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryFeederDelegationResponse)
+func (x fastReflection_QueryVoteTargetsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteTargetsResponse)
 }
-func (x fastReflection_QueryFeederDelegationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFeederDelegationResponse
+func (x fastReflection_QueryVoteTargetsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteTargetsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryFeederDelegationResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFeederDelegationResponse
+func (x *fastReflection_QueryVoteTargetsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteTargetsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryFeederDelegationResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryFeederDelegationResponse_messageType
+func (x *fastReflection_QueryVoteTargetsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteTargetsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryFeederDelegationResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryFeederDelegationResponse)
+func (x *fastReflection_QueryVoteTargetsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteTargetsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryFeederDelegationResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryFeederDelegationResponse)(x)
+func (x *fastReflection_QueryVoteTargetsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteTargetsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3969,10 +4126,10 @@ func (x *fastReflection_QueryFeederDelegationResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryFeederDelegationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.FeederAddr != "" {
-		value := protoreflect.ValueOfString(x.FeederAddr)
-		if !f(fd_QueryFeederDelegationResponse_feeder_addr, value) {
+func (x *fastReflection_QueryVoteTargetsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.VoteTargets) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVoteTargetsResponse_1_list{list: &x.VoteTargets})
+		if !f(fd_QueryVoteTargetsResponse_vote_targets, value) {
 			return
 		}
 	}
@@ -3989,15 +4146,15 @@ func (x *fastReflection_QueryFeederDelegationResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryFeederDelegationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVoteTargetsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryFeederDelegationResponse.feeder_addr":
-		return x.FeederAddr != ""
+	case "nibiru.oracle.v1.QueryVoteTargetsResponse.vote_targets":
+		return len(x.VoteTargets) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4007,15 +4164,15 @@ func (x *fastReflection_QueryFeederDelegationResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFeederDelegationResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVoteTargetsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryFeederDelegationResponse.feeder_addr":
-		x.FeederAddr = ""
+	case "nibiru.oracle.v1.QueryVoteTargetsResponse.vote_targets":
+		x.VoteTargets = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4025,16 +4182,19 @@ func (x *fastReflection_QueryFeederDelegationResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryFeederDelegationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteTargetsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryFeederDelegationResponse.feeder_addr":
-		value := x.FeederAddr
-		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.QueryVoteTargetsResponse.vote_targets":
+		if len(x.VoteTargets) == 0 {
+			return protoreflect.ValueOfList(&_QueryVoteTargetsResponse_1_list{})
+		}
+		listValue := &_QueryVoteTargetsResponse_1_list{list: &x.VoteTargets}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4048,15 +4208,17 @@ func (x *fastReflection_QueryFeederDelegationResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFeederDelegationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVoteTargetsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryFeederDelegationResponse.feeder_addr":
-		x.FeederAddr = value.Interface().(string)
+	case "nibiru.oracle.v1.QueryVoteTargetsResponse.vote_targets":
+		lv := value.List()
+		clv := lv.(*_QueryVoteTargetsResponse_1_list)
+		x.VoteTargets = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4070,40 +4232,45 @@ func (x *fastReflection_QueryFeederDelegationResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFeederDelegationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteTargetsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryFeederDelegationResponse.feeder_addr":
-		panic(fmt.Errorf("field feeder_addr of message nibiru.oracle.v1.QueryFeederDelegationResponse is not mutable"))
+	case "nibiru.oracle.v1.QueryVoteTargetsResponse.vote_targets":
+		if x.VoteTargets == nil {
+			x.VoteTargets = []string{}
+		}
+		value := &_QueryVoteTargetsResponse_1_list{list: &x.VoteTargets}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryFeederDelegationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteTargetsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryFeederDelegationResponse.feeder_addr":
-		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.QueryVoteTargetsResponse.vote_targets":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryVoteTargetsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryVoteTargetsResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryVoteTargetsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryFeederDelegationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVoteTargetsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryFeederDelegationResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryVoteTargetsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4111,7 +4278,7 @@ func (x *fastReflection_QueryFeederDelegationResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryFeederDelegationResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVoteTargetsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4122,7 +4289,7 @@ func (x *fastReflection_QueryFeederDelegationResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFeederDelegationResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVoteTargetsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4134,7 +4301,7 @@ func (x *fastReflection_QueryFeederDelegationResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryFeederDelegationResponse) IsValid() bool {
+func (x *fastReflection_QueryVoteTargetsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4144,9 +4311,9 @@ func (x *fastReflection_QueryFeederDelegationResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryFeederDelegationResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVoteTargetsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryFeederDelegationResponse)
+		x := input.Message.Interface().(*QueryVoteTargetsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4158,9 +4325,11 @@ func (x *fastReflection_QueryFeederDelegationResponse) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		l = len(x.FeederAddr)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.VoteTargets) > 0 {
+			for _, s := range x.VoteTargets {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4172,7 +4341,7 @@ func (x *fastReflection_QueryFeederDelegationResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFeederDelegationResponse)
+		x := input.Message.Interface().(*QueryVoteTargetsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4191,12 +4360,14 @@ func (x *fastReflection_QueryFeederDelegationResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.FeederAddr) > 0 {
-			i -= len(x.FeederAddr)
-			copy(dAtA[i:], x.FeederAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeederAddr)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.VoteTargets) > 0 {
+			for iNdEx := len(x.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.VoteTargets[iNdEx])
+				copy(dAtA[i:], x.VoteTargets[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VoteTargets[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4209,7 +4380,7 @@ func (x *fastReflection_QueryFeederDelegationResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFeederDelegationResponse)
+		x := input.Message.Interface().(*QueryVoteTargetsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4241,15 +4412,15 @@ func (x *fastReflection_QueryFeederDelegationResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4277,7 +4448,7 @@ func (x *fastReflection_QueryFeederDelegationResponse) ProtoMethods() *protoifac
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FeederAddr = string(dAtA[iNdEx:postIndex])
+				x.VoteTargets = append(x.VoteTargets, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4315,25 +4486,25 @@ func (x *fastReflection_QueryFeederDelegationResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryMissCounterRequest                protoreflect.MessageDescriptor
-	fd_QueryMissCounterRequest_validator_addr protoreflect.FieldDescriptor
+	md_QueryFeederDelegationRequest                protoreflect.MessageDescriptor
+	fd_QueryFeederDelegationRequest_validator_addr protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryMissCounterRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryMissCounterRequest")
-	fd_QueryMissCounterRequest_validator_addr = md_QueryMissCounterRequest.Fields().ByName("validator_addr")
+	md_QueryFeederDelegationRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryFeederDelegationRequest")
+	fd_QueryFeederDelegationRequest_validator_addr = md_QueryFeederDelegationRequest.Fields().ByName("validator_addr")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryMissCounterRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryFeederDelegationRequest)(nil)
 
-type fastReflection_QueryMissCounterRequest QueryMissCounterRequest
+type fastReflection_QueryFeederDelegationRequest QueryFeederDelegationRequest
 
-func (x *QueryMissCounterRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryMissCounterRequest)(x)
+func (x *QueryFeederDelegationRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFeederDelegationRequest)(x)
 }
 
-func (x *QueryMissCounterRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryFeederDelegationRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4345,43 +4516,43 @@ func (x *QueryMissCounterRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryMissCounterRequest_messageType fastReflection_QueryMissCounterRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryMissCounterRequest_messageType{}
+var _fastReflection_QueryFeederDelegationRequest_messageType fastReflection_QueryFeederDelegationRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFeederDelegationRequest_messageType{}
 
-type fastReflection_QueryMissCounterRequest_messageType struct{}
+type fastReflection_QueryFeederDelegationRequest_messageType struct{}
 
-func (x fastReflection_QueryMissCounterRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryMissCounterRequest)(nil)
+func (x fastReflection_QueryFeederDelegationRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFeederDelegationRequest)(nil)
 }
-func (x fastReflection_QueryMissCounterRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryMissCounterRequest)
+func (x fastReflection_QueryFeederDelegationRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFeederDelegationRequest)
 }
-func (x fastReflection_QueryMissCounterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryMissCounterRequest
+func (x fastReflection_QueryFeederDelegationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFeederDelegationRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryMissCounterRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryMissCounterRequest
+func (x *fastReflection_QueryFeederDelegationRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFeederDelegationRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryMissCounterRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryMissCounterRequest_messageType
+func (x *fastReflection_QueryFeederDelegationRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFeederDelegationRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryMissCounterRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryMissCounterRequest)
+func (x *fastReflection_QueryFeederDelegationRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryFeederDelegationRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryMissCounterRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryMissCounterRequest)(x)
+func (x *fastReflection_QueryFeederDelegationRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryFeederDelegationRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4389,10 +4560,10 @@ func (x *fastReflection_QueryMissCounterRequest) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryMissCounterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryFeederDelegationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ValidatorAddr != "" {
 		value := protoreflect.ValueOfString(x.ValidatorAddr)
-		if !f(fd_QueryMissCounterRequest_validator_addr, value) {
+		if !f(fd_QueryFeederDelegationRequest_validator_addr, value) {
 			return
 		}
 	}
@@ -4409,15 +4580,15 @@ func (x *fastReflection_QueryMissCounterRequest) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryMissCounterRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryFeederDelegationRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryMissCounterRequest.validator_addr":
+	case "nibiru.oracle.v1.QueryFeederDelegationRequest.validator_addr":
 		return x.ValidatorAddr != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4427,15 +4598,15 @@ func (x *fastReflection_QueryMissCounterRequest) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryMissCounterRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryFeederDelegationRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryMissCounterRequest.validator_addr":
+	case "nibiru.oracle.v1.QueryFeederDelegationRequest.validator_addr":
 		x.ValidatorAddr = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4445,16 +4616,16 @@ func (x *fastReflection_QueryMissCounterRequest) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryMissCounterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFeederDelegationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryMissCounterRequest.validator_addr":
+	case "nibiru.oracle.v1.QueryFeederDelegationRequest.validator_addr":
 		value := x.ValidatorAddr
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4468,15 +4639,15 @@ func (x *fastReflection_QueryMissCounterRequest) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryMissCounterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryFeederDelegationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryMissCounterRequest.validator_addr":
+	case "nibiru.oracle.v1.QueryFeederDelegationRequest.validator_addr":
 		x.ValidatorAddr = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4490,40 +4661,40 @@ func (x *fastReflection_QueryMissCounterRequest) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryMissCounterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFeederDelegationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryMissCounterRequest.validator_addr":
-		panic(fmt.Errorf("field validator_addr of message nibiru.oracle.v1.QueryMissCounterRequest is not mutable"))
+	case "nibiru.oracle.v1.QueryFeederDelegationRequest.validator_addr":
+		panic(fmt.Errorf("field validator_addr of message nibiru.oracle.v1.QueryFeederDelegationRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryMissCounterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFeederDelegationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryMissCounterRequest.validator_addr":
+	case "nibiru.oracle.v1.QueryFeederDelegationRequest.validator_addr":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryMissCounterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryFeederDelegationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryMissCounterRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryFeederDelegationRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4531,7 +4702,7 @@ func (x *fastReflection_QueryMissCounterRequest) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryMissCounterRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryFeederDelegationRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4542,7 +4713,7 @@ func (x *fastReflection_QueryMissCounterRequest) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryMissCounterRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryFeederDelegationRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4554,7 +4725,7 @@ func (x *fastReflection_QueryMissCounterRequest) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryMissCounterRequest) IsValid() bool {
+func (x *fastReflection_QueryFeederDelegationRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4564,9 +4735,9 @@ func (x *fastReflection_QueryMissCounterRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryMissCounterRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryFeederDelegationRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryMissCounterRequest)
+		x := input.Message.Interface().(*QueryFeederDelegationRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4592,7 +4763,7 @@ func (x *fastReflection_QueryMissCounterRequest) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryMissCounterRequest)
+		x := input.Message.Interface().(*QueryFeederDelegationRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4629,7 +4800,7 @@ func (x *fastReflection_QueryMissCounterRequest) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryMissCounterRequest)
+		x := input.Message.Interface().(*QueryFeederDelegationRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4661,10 +4832,10 @@ func (x *fastReflection_QueryMissCounterRequest) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4735,25 +4906,25 @@ func (x *fastReflection_QueryMissCounterRequest) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryMissCounterResponse              protoreflect.MessageDescriptor
-	fd_QueryMissCounterResponse_miss_counter protoreflect.FieldDescriptor
+	md_QueryFeederDelegationResponse             protoreflect.MessageDescriptor
+	fd_QueryFeederDelegationResponse_feeder_addr protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryMissCounterResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryMissCounterResponse")
-	fd_QueryMissCounterResponse_miss_counter = md_QueryMissCounterResponse.Fields().ByName("miss_counter")
+	md_QueryFeederDelegationResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryFeederDelegationResponse")
+	fd_QueryFeederDelegationResponse_feeder_addr = md_QueryFeederDelegationResponse.Fields().ByName("feeder_addr")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryMissCounterResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryFeederDelegationResponse)(nil)
 
-type fastReflection_QueryMissCounterResponse QueryMissCounterResponse
+type fastReflection_QueryFeederDelegationResponse QueryFeederDelegationResponse
 
-func (x *QueryMissCounterResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryMissCounterResponse)(x)
+func (x *QueryFeederDelegationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFeederDelegationResponse)(x)
 }
 
-func (x *QueryMissCounterResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryFeederDelegationResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4765,43 +4936,43 @@ func (x *QueryMissCounterResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryMissCounterResponse_messageType fastReflection_QueryMissCounterResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryMissCounterResponse_messageType{}
-
-type fastReflection_QueryMissCounterResponse_messageType struct{}
+var _fastReflection_QueryFeederDelegationResponse_messageType fastReflection_QueryFeederDelegationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFeederDelegationResponse_messageType{}
 
-func (x fastReflection_QueryMissCounterResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryMissCounterResponse)(nil)
+type fastReflection_QueryFeederDelegationResponse_messageType struct{}
+
+func (x fastReflection_QueryFeederDelegationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFeederDelegationResponse)(nil)
 }
-func (x fastReflection_QueryMissCounterResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryMissCounterResponse)
+func (x fastReflection_QueryFeederDelegationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFeederDelegationResponse)
 }
-func (x fastReflection_QueryMissCounterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryMissCounterResponse
+func (x fastReflection_QueryFeederDelegationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFeederDelegationResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryMissCounterResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryMissCounterResponse
+func (x *fastReflection_QueryFeederDelegationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFeederDelegationResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryMissCounterResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryMissCounterResponse_messageType
+func (x *fastReflection_QueryFeederDelegationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFeederDelegationResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryMissCounterResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryMissCounterResponse)
+func (x *fastReflection_QueryFeederDelegationResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryFeederDelegationResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryMissCounterResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryMissCounterResponse)(x)
+func (x *fastReflection_QueryFeederDelegationResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryFeederDelegationResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4809,10 +4980,10 @@ func (x *fastReflection_QueryMissCounterResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryMissCounterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MissCounter != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MissCounter)
-		if !f(fd_QueryMissCounterResponse_miss_counter, value) {
+func (x *fastReflection_QueryFeederDelegationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FeederAddr != "" {
+		value := protoreflect.ValueOfString(x.FeederAddr)
+		if !f(fd_QueryFeederDelegationResponse_feeder_addr, value) {
 			return
 		}
 	}
@@ -4829,15 +5000,15 @@ func (x *fastReflection_QueryMissCounterResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryMissCounterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryFeederDelegationResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryMissCounterResponse.miss_counter":
-		return x.MissCounter != uint64(0)
+	case "nibiru.oracle.v1.QueryFeederDelegationResponse.feeder_addr":
+		return x.FeederAddr != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4847,15 +5018,15 @@ func (x *fastReflection_QueryMissCounterResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryMissCounterResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryFeederDelegationResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryMissCounterResponse.miss_counter":
-		x.MissCounter = uint64(0)
+	case "nibiru.oracle.v1.QueryFeederDelegationResponse.feeder_addr":
+		x.FeederAddr = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4865,16 +5036,16 @@ func (x *fastReflection_QueryMissCounterResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryMissCounterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFeederDelegationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryMissCounterResponse.miss_counter":
-		value := x.MissCounter
-		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.QueryFeederDelegationResponse.feeder_addr":
+		value := x.FeederAddr
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4888,15 +5059,15 @@ func (x *fastReflection_QueryMissCounterResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryMissCounterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryFeederDelegationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryMissCounterResponse.miss_counter":
-		x.MissCounter = value.Uint()
+	case "nibiru.oracle.v1.QueryFeederDelegationResponse.feeder_addr":
+		x.FeederAddr = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4910,40 +5081,40 @@ func (x *fastReflection_QueryMissCounterResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryMissCounterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFeederDelegationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryMissCounterResponse.miss_counter":
-		panic(fmt.Errorf("field miss_counter of message nibiru.oracle.v1.QueryMissCounterResponse is not mutable"))
+	case "nibiru.oracle.v1.QueryFeederDelegationResponse.feeder_addr":
+		panic(fmt.Errorf("field feeder_addr of message nibiru.oracle.v1.QueryFeederDelegationResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryMissCounterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFeederDelegationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryMissCounterResponse.miss_counter":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.QueryFeederDelegationResponse.feeder_addr":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryFeederDelegationResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryFeederDelegationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryMissCounterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryFeederDelegationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryMissCounterResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryFeederDelegationResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4951,7 +5122,7 @@ func (x *fastReflection_QueryMissCounterResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryMissCounterResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryFeederDelegationResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4962,7 +5133,7 @@ func (x *fastReflection_QueryMissCounterResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryMissCounterResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryFeederDelegationResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4974,7 +5145,7 @@ func (x *fastReflection_QueryMissCounterResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryMissCounterResponse) IsValid() bool {
+func (x *fastReflection_QueryFeederDelegationResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4984,9 +5155,9 @@ func (x *fastReflection_QueryMissCounterResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryMissCounterResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryFeederDelegationResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryMissCounterResponse)
+		x := input.Message.Interface().(*QueryFeederDelegationResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4998,8 +5169,9 @@ func (x *fastReflection_QueryMissCounterResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if x.MissCounter != 0 {
-			n += 1 + runtime.Sov(uint64(x.MissCounter))
+		l = len(x.FeederAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5011,7 +5183,7 @@ func (x *fastReflection_QueryMissCounterResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryMissCounterResponse)
+		x := input.Message.Interface().(*QueryFeederDelegationResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5030,10 +5202,12 @@ func (x *fastReflection_QueryMissCounterResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MissCounter != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissCounter))
+		if len(x.FeederAddr) > 0 {
+			i -= len(x.FeederAddr)
+			copy(dAtA[i:], x.FeederAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeederAddr)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5046,7 +5220,7 @@ func (x *fastReflection_QueryMissCounterResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryMissCounterResponse)
+		x := input.Message.Interface().(*QueryFeederDelegationResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5078,17 +5252,17 @@ func (x *fastReflection_QueryMissCounterResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
 				}
-				x.MissCounter = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5098,11 +5272,24 @@ func (x *fastReflection_QueryMissCounterResponse) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MissCounter |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeederAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5139,25 +5326,25 @@ func (x *fastReflection_QueryMissCounterResponse) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_QueryAggregatePrevoteRequest                protoreflect.MessageDescriptor
-	fd_QueryAggregatePrevoteRequest_validator_addr protoreflect.FieldDescriptor
+	md_QueryMissCounterRequest                protoreflect.MessageDescriptor
+	fd_QueryMissCounterRequest_validator_addr protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryAggregatePrevoteRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregatePrevoteRequest")
-	fd_QueryAggregatePrevoteRequest_validator_addr = md_QueryAggregatePrevoteRequest.Fields().ByName("validator_addr")
+	md_QueryMissCounterRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryMissCounterRequest")
+	fd_QueryMissCounterRequest_validator_addr = md_QueryMissCounterRequest.Fields().ByName("validator_addr")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAggregatePrevoteRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryMissCounterRequest)(nil)
 
-type fastReflection_QueryAggregatePrevoteRequest QueryAggregatePrevoteRequest
+type fastReflection_QueryMissCounterRequest QueryMissCounterRequest
 
-func (x *QueryAggregatePrevoteRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAggregatePrevoteRequest)(x)
+func (x *QueryMissCounterRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryMissCounterRequest)(x)
 }
 
-func (x *QueryAggregatePrevoteRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryMissCounterRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5169,43 +5356,43 @@ func (x *QueryAggregatePrevoteRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAggregatePrevoteRequest_messageType fastReflection_QueryAggregatePrevoteRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAggregatePrevoteRequest_messageType{}
+var _fastReflection_QueryMissCounterRequest_messageType fastReflection_QueryMissCounterRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryMissCounterRequest_messageType{}
 
-type fastReflection_QueryAggregatePrevoteRequest_messageType struct{}
+type fastReflection_QueryMissCounterRequest_messageType struct{}
 
-func (x fastReflection_QueryAggregatePrevoteRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAggregatePrevoteRequest)(nil)
+func (x fastReflection_QueryMissCounterRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryMissCounterRequest)(nil)
 }
-func (x fastReflection_QueryAggregatePrevoteRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregatePrevoteRequest)
+func (x fastReflection_QueryMissCounterRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryMissCounterRequest)
 }
-func (x fastReflection_QueryAggregatePrevoteRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregatePrevoteRequest
+func (x fastReflection_QueryMissCounterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMissCounterRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAggregatePrevoteRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregatePrevoteRequest
+func (x *fastReflection_QueryMissCounterRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMissCounterRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAggregatePrevoteRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAggregatePrevoteRequest_messageType
+func (x *fastReflection_QueryMissCounterRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryMissCounterRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAggregatePrevoteRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregatePrevoteRequest)
+func (x *fastReflection_QueryMissCounterRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryMissCounterRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAggregatePrevoteRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAggregatePrevoteRequest)(x)
+func (x *fastReflection_QueryMissCounterRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryMissCounterRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5213,10 +5400,10 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAggregatePrevoteRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryMissCounterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ValidatorAddr != "" {
 		value := protoreflect.ValueOfString(x.ValidatorAddr)
-		if !f(fd_QueryAggregatePrevoteRequest_validator_addr, value) {
+		if !f(fd_QueryMissCounterRequest_validator_addr, value) {
 			return
 		}
 	}
@@ -5233,15 +5420,15 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAggregatePrevoteRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryMissCounterRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevoteRequest.validator_addr":
+	case "nibiru.oracle.v1.QueryMissCounterRequest.validator_addr":
 		return x.ValidatorAddr != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5251,15 +5438,15 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevoteRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryMissCounterRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevoteRequest.validator_addr":
+	case "nibiru.oracle.v1.QueryMissCounterRequest.validator_addr":
 		x.ValidatorAddr = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5269,16 +5456,16 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAggregatePrevoteRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryMissCounterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevoteRequest.validator_addr":
+	case "nibiru.oracle.v1.QueryMissCounterRequest.validator_addr":
 		value := x.ValidatorAddr
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5292,15 +5479,15 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevoteRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryMissCounterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevoteRequest.validator_addr":
+	case "nibiru.oracle.v1.QueryMissCounterRequest.validator_addr":
 		x.ValidatorAddr = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5314,40 +5501,40 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevoteRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryMissCounterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevoteRequest.validator_addr":
-		panic(fmt.Errorf("field validator_addr of message nibiru.oracle.v1.QueryAggregatePrevoteRequest is not mutable"))
+	case "nibiru.oracle.v1.QueryMissCounterRequest.validator_addr":
+		panic(fmt.Errorf("field validator_addr of message nibiru.oracle.v1.QueryMissCounterRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAggregatePrevoteRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryMissCounterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevoteRequest.validator_addr":
+	case "nibiru.oracle.v1.QueryMissCounterRequest.validator_addr":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAggregatePrevoteRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryMissCounterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregatePrevoteRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryMissCounterRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5355,7 +5542,7 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAggregatePrevoteRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryMissCounterRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5366,7 +5553,7 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevoteRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryMissCounterRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5378,7 +5565,7 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAggregatePrevoteRequest) IsValid() bool {
+func (x *fastReflection_QueryMissCounterRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5388,9 +5575,9 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAggregatePrevoteRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryMissCounterRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAggregatePrevoteRequest)
+		x := input.Message.Interface().(*QueryMissCounterRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5416,7 +5603,7 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregatePrevoteRequest)
+		x := input.Message.Interface().(*QueryMissCounterRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5453,7 +5640,7 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregatePrevoteRequest)
+		x := input.Message.Interface().(*QueryMissCounterRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5485,10 +5672,10 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5559,25 +5746,25 @@ func (x *fastReflection_QueryAggregatePrevoteRequest) ProtoMethods() *protoiface
 }
 
 var (
-	md_QueryAggregatePrevoteResponse                   protoreflect.MessageDescriptor
-	fd_QueryAggregatePrevoteResponse_aggregate_prevote protoreflect.FieldDescriptor
+	md_QueryMissCounterResponse              protoreflect.MessageDescriptor
+	fd_QueryMissCounterResponse_miss_counter protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryAggregatePrevoteResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregatePrevoteResponse")
-	fd_QueryAggregatePrevoteResponse_aggregate_prevote = md_QueryAggregatePrevoteResponse.Fields().ByName("aggregate_prevote")
+	md_QueryMissCounterResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryMissCounterResponse")
+	fd_QueryMissCounterResponse_miss_counter = md_QueryMissCounterResponse.Fields().ByName("miss_counter")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAggregatePrevoteResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryMissCounterResponse)(nil)
 
-type fastReflection_QueryAggregatePrevoteResponse QueryAggregatePrevoteResponse
+type fastReflection_QueryMissCounterResponse QueryMissCounterResponse
 
-func (x *QueryAggregatePrevoteResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAggregatePrevoteResponse)(x)
+func (x *QueryMissCounterResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryMissCounterResponse)(x)
 }
 
-func (x *QueryAggregatePrevoteResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryMissCounterResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5589,43 +5776,43 @@ func (x *QueryAggregatePrevoteResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAggregatePrevoteResponse_messageType fastReflection_QueryAggregatePrevoteResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAggregatePrevoteResponse_messageType{}
+var _fastReflection_QueryMissCounterResponse_messageType fastReflection_QueryMissCounterResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryMissCounterResponse_messageType{}
 
-type fastReflection_QueryAggregatePrevoteResponse_messageType struct{}
+type fastReflection_QueryMissCounterResponse_messageType struct{}
 
-func (x fastReflection_QueryAggregatePrevoteResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAggregatePrevoteResponse)(nil)
+func (x fastReflection_QueryMissCounterResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryMissCounterResponse)(nil)
 }
-func (x fastReflection_QueryAggregatePrevoteResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregatePrevoteResponse)
+func (x fastReflection_QueryMissCounterResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryMissCounterResponse)
 }
-func (x fastReflection_QueryAggregatePrevoteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregatePrevoteResponse
+func (x fastReflection_QueryMissCounterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMissCounterResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAggregatePrevoteResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregatePrevoteResponse
+func (x *fastReflection_QueryMissCounterResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMissCounterResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAggregatePrevoteResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAggregatePrevoteResponse_messageType
+func (x *fastReflection_QueryMissCounterResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryMissCounterResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAggregatePrevoteResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregatePrevoteResponse)
+func (x *fastReflection_QueryMissCounterResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryMissCounterResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAggregatePrevoteResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAggregatePrevoteResponse)(x)
+func (x *fastReflection_QueryMissCounterResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryMissCounterResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5633,10 +5820,10 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAggregatePrevoteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AggregatePrevote != nil {
-		value := protoreflect.ValueOfMessage(x.AggregatePrevote.ProtoReflect())
-		if !f(fd_QueryAggregatePrevoteResponse_aggregate_prevote, value) {
+func (x *fastReflection_QueryMissCounterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MissCounter != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissCounter)
+		if !f(fd_QueryMissCounterResponse_miss_counter, value) {
 			return
 		}
 	}
@@ -5653,15 +5840,15 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAggregatePrevoteResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryMissCounterResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote":
-		return x.AggregatePrevote != nil
+	case "nibiru.oracle.v1.QueryMissCounterResponse.miss_counter":
+		return x.MissCounter != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5671,15 +5858,15 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevoteResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryMissCounterResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote":
-		x.AggregatePrevote = nil
+	case "nibiru.oracle.v1.QueryMissCounterResponse.miss_counter":
+		x.MissCounter = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5689,16 +5876,16 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAggregatePrevoteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryMissCounterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote":
-		value := x.AggregatePrevote
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.oracle.v1.QueryMissCounterResponse.miss_counter":
+		value := x.MissCounter
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5712,15 +5899,15 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevoteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryMissCounterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote":
-		x.AggregatePrevote = value.Message().Interface().(*AggregateExchangeRatePrevote)
+	case "nibiru.oracle.v1.QueryMissCounterResponse.miss_counter":
+		x.MissCounter = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5734,44 +5921,40 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevoteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryMissCounterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote":
-		if x.AggregatePrevote == nil {
-			x.AggregatePrevote = new(AggregateExchangeRatePrevote)
-		}
-		return protoreflect.ValueOfMessage(x.AggregatePrevote.ProtoReflect())
+	case "nibiru.oracle.v1.QueryMissCounterResponse.miss_counter":
+		panic(fmt.Errorf("field miss_counter of message nibiru.oracle.v1.QueryMissCounterResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAggregatePrevoteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryMissCounterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote":
-		m := new(AggregateExchangeRatePrevote)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.oracle.v1.QueryMissCounterResponse.miss_counter":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryMissCounterResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryMissCounterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAggregatePrevoteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryMissCounterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregatePrevoteResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryMissCounterResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5779,7 +5962,7 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAggregatePrevoteResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryMissCounterResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5790,7 +5973,7 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevoteResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryMissCounterResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5802,7 +5985,7 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAggregatePrevoteResponse) IsValid() bool {
+func (x *fastReflection_QueryMissCounterResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5812,9 +5995,9 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAggregatePrevoteResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryMissCounterResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAggregatePrevoteResponse)
+		x := input.Message.Interface().(*QueryMissCounterResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5826,9 +6009,8 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		if x.AggregatePrevote != nil {
-			l = options.Size(x.AggregatePrevote)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.MissCounter != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissCounter))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5840,7 +6022,7 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregatePrevoteResponse)
+		x := input.Message.Interface().(*QueryMissCounterResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5859,19 +6041,10 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AggregatePrevote != nil {
-			encoded, err := options.Marshal(x.AggregatePrevote)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.MissCounter != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissCounter))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5884,7 +6057,7 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregatePrevoteResponse)
+		x := input.Message.Interface().(*QueryMissCounterResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5916,17 +6089,17 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
 				}
-				var msglen int
+				x.MissCounter = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5936,28 +6109,11 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.MissCounter |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.AggregatePrevote == nil {
-					x.AggregatePrevote = &AggregateExchangeRatePrevote{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AggregatePrevote); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5994,23 +6150,25 @@ func (x *fastReflection_QueryAggregatePrevoteResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryAggregatePrevotesRequest protoreflect.MessageDescriptor
+	md_QueryAggregatePrevoteRequest                protoreflect.MessageDescriptor
+	fd_QueryAggregatePrevoteRequest_validator_addr protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryAggregatePrevotesRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregatePrevotesRequest")
+	md_QueryAggregatePrevoteRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregatePrevoteRequest")
+	fd_QueryAggregatePrevoteRequest_validator_addr = md_QueryAggregatePrevoteRequest.Fields().ByName("validator_addr")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAggregatePrevotesRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAggregatePrevoteRequest)(nil)
 
-type fastReflection_QueryAggregatePrevotesRequest QueryAggregatePrevotesRequest
+type fastReflection_QueryAggregatePrevoteRequest QueryAggregatePrevoteRequest
 
-func (x *QueryAggregatePrevotesRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAggregatePrevotesRequest)(x)
+func (x *QueryAggregatePrevoteRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAggregatePrevoteRequest)(x)
 }
 
-func (x *QueryAggregatePrevotesRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryAggregatePrevoteRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6022,43 +6180,43 @@ func (x *QueryAggregatePrevotesRequest) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAggregatePrevotesRequest_messageType fastReflection_QueryAggregatePrevotesRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAggregatePrevotesRequest_messageType{}
+var _fastReflection_QueryAggregatePrevoteRequest_messageType fastReflection_QueryAggregatePrevoteRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAggregatePrevoteRequest_messageType{}
 
-type fastReflection_QueryAggregatePrevotesRequest_messageType struct{}
+type fastReflection_QueryAggregatePrevoteRequest_messageType struct{}
 
-func (x fastReflection_QueryAggregatePrevotesRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAggregatePrevotesRequest)(nil)
+func (x fastReflection_QueryAggregatePrevoteRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAggregatePrevoteRequest)(nil)
 }
-func (x fastReflection_QueryAggregatePrevotesRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregatePrevotesRequest)
+func (x fastReflection_QueryAggregatePrevoteRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregatePrevoteRequest)
 }
-func (x fastReflection_QueryAggregatePrevotesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregatePrevotesRequest
+func (x fastReflection_QueryAggregatePrevoteRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregatePrevoteRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAggregatePrevotesRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregatePrevotesRequest
+func (x *fastReflection_QueryAggregatePrevoteRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregatePrevoteRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAggregatePrevotesRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAggregatePrevotesRequest_messageType
+func (x *fastReflection_QueryAggregatePrevoteRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAggregatePrevoteRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAggregatePrevotesRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregatePrevotesRequest)
+func (x *fastReflection_QueryAggregatePrevoteRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregatePrevoteRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAggregatePrevotesRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAggregatePrevotesRequest)(x)
+func (x *fastReflection_QueryAggregatePrevoteRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAggregatePrevoteRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6066,7 +6224,13 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAggregatePrevotesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryAggregatePrevoteRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddr != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddr)
+		if !f(fd_QueryAggregatePrevoteRequest_validator_addr, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -6080,13 +6244,15 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAggregatePrevotesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAggregatePrevoteRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregatePrevoteRequest.validator_addr":
+		return x.ValidatorAddr != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6096,13 +6262,15 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevotesRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAggregatePrevoteRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregatePrevoteRequest.validator_addr":
+		x.ValidatorAddr = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6112,13 +6280,16 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAggregatePrevotesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAggregatePrevoteRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "nibiru.oracle.v1.QueryAggregatePrevoteRequest.validator_addr":
+		value := x.ValidatorAddr
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6132,13 +6303,15 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevotesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAggregatePrevoteRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregatePrevoteRequest.validator_addr":
+		x.ValidatorAddr = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6152,36 +6325,40 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevotesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAggregatePrevoteRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregatePrevoteRequest.validator_addr":
+		panic(fmt.Errorf("field validator_addr of message nibiru.oracle.v1.QueryAggregatePrevoteRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAggregatePrevotesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAggregatePrevoteRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregatePrevoteRequest.validator_addr":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAggregatePrevotesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAggregatePrevoteRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregatePrevotesRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregatePrevoteRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6189,7 +6366,7 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAggregatePrevotesRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAggregatePrevoteRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6200,7 +6377,7 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevotesRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAggregatePrevoteRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6212,7 +6389,7 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAggregatePrevotesRequest) IsValid() bool {
+func (x *fastReflection_QueryAggregatePrevoteRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6222,9 +6399,9 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAggregatePrevotesRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAggregatePrevoteRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAggregatePrevotesRequest)
+		x := input.Message.Interface().(*QueryAggregatePrevoteRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6236,6 +6413,10 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
+		l = len(x.ValidatorAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6246,7 +6427,7 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregatePrevotesRequest)
+		x := input.Message.Interface().(*QueryAggregatePrevoteRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6265,6 +6446,13 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ValidatorAddr) > 0 {
+			i -= len(x.ValidatorAddr)
+			copy(dAtA[i:], x.ValidatorAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddr)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -6276,7 +6464,7 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregatePrevotesRequest)
+		x := input.Message.Interface().(*QueryAggregatePrevoteRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6308,12 +6496,44 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevotesRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6349,77 +6569,26 @@ func (x *fastReflection_QueryAggregatePrevotesRequest) ProtoMethods() *protoifac
 	}
 }
 
-var _ protoreflect.List = (*_QueryAggregatePrevotesResponse_1_list)(nil)
-
-type _QueryAggregatePrevotesResponse_1_list struct {
-	list *[]*AggregateExchangeRatePrevote
-}
-
-func (x *_QueryAggregatePrevotesResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryAggregatePrevotesResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryAggregatePrevotesResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*AggregateExchangeRatePrevote)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryAggregatePrevotesResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*AggregateExchangeRatePrevote)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryAggregatePrevotesResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(AggregateExchangeRatePrevote)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAggregatePrevotesResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryAggregatePrevotesResponse_1_list) NewElement() protoreflect.Value {
-	v := new(AggregateExchangeRatePrevote)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAggregatePrevotesResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryAggregatePrevotesResponse                    protoreflect.MessageDescriptor
-	fd_QueryAggregatePrevotesResponse_aggregate_prevotes protoreflect.FieldDescriptor
+	md_QueryAggregatePrevoteResponse                   protoreflect.MessageDescriptor
+	fd_QueryAggregatePrevoteResponse_aggregate_prevote protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryAggregatePrevotesResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregatePrevotesResponse")
-	fd_QueryAggregatePrevotesResponse_aggregate_prevotes = md_QueryAggregatePrevotesResponse.Fields().ByName("aggregate_prevotes")
+	md_QueryAggregatePrevoteResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregatePrevoteResponse")
+	fd_QueryAggregatePrevoteResponse_aggregate_prevote = md_QueryAggregatePrevoteResponse.Fields().ByName("aggregate_prevote")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAggregatePrevotesResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAggregatePrevoteResponse)(nil)
 
-type fastReflection_QueryAggregatePrevotesResponse QueryAggregatePrevotesResponse
+type fastReflection_QueryAggregatePrevoteResponse QueryAggregatePrevoteResponse
 
-func (x *QueryAggregatePrevotesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAggregatePrevotesResponse)(x)
+func (x *QueryAggregatePrevoteResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAggregatePrevoteResponse)(x)
 }
 
-func (x *QueryAggregatePrevotesResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryAggregatePrevoteResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6431,43 +6600,43 @@ func (x *QueryAggregatePrevotesResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAggregatePrevotesResponse_messageType fastReflection_QueryAggregatePrevotesResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAggregatePrevotesResponse_messageType{}
+var _fastReflection_QueryAggregatePrevoteResponse_messageType fastReflection_QueryAggregatePrevoteResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAggregatePrevoteResponse_messageType{}
 
-type fastReflection_QueryAggregatePrevotesResponse_messageType struct{}
+type fastReflection_QueryAggregatePrevoteResponse_messageType struct{}
 
-func (x fastReflection_QueryAggregatePrevotesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAggregatePrevotesResponse)(nil)
+func (x fastReflection_QueryAggregatePrevoteResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAggregatePrevoteResponse)(nil)
 }
-func (x fastReflection_QueryAggregatePrevotesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregatePrevotesResponse)
+func (x fastReflection_QueryAggregatePrevoteResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregatePrevoteResponse)
 }
-func (x fastReflection_QueryAggregatePrevotesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregatePrevotesResponse
+func (x fastReflection_QueryAggregatePrevoteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregatePrevoteResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAggregatePrevotesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregatePrevotesResponse
+func (x *fastReflection_QueryAggregatePrevoteResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregatePrevoteResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAggregatePrevotesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAggregatePrevotesResponse_messageType
+func (x *fastReflection_QueryAggregatePrevoteResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAggregatePrevoteResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAggregatePrevotesResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregatePrevotesResponse)
+func (x *fastReflection_QueryAggregatePrevoteResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregatePrevoteResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAggregatePrevotesResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAggregatePrevotesResponse)(x)
+func (x *fastReflection_QueryAggregatePrevoteResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAggregatePrevoteResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6475,10 +6644,10 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAggregatePrevotesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.AggregatePrevotes) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAggregatePrevotesResponse_1_list{list: &x.AggregatePrevotes})
-		if !f(fd_QueryAggregatePrevotesResponse_aggregate_prevotes, value) {
+func (x *fastReflection_QueryAggregatePrevoteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AggregatePrevote != nil {
+		value := protoreflect.ValueOfMessage(x.AggregatePrevote.ProtoReflect())
+		if !f(fd_QueryAggregatePrevoteResponse_aggregate_prevote, value) {
 			return
 		}
 	}
@@ -6495,15 +6664,15 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAggregatePrevotesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAggregatePrevoteResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes":
-		return len(x.AggregatePrevotes) != 0
-	default:
+	case "nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote":
+		return x.AggregatePrevote != nil
+	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6513,15 +6682,15 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevotesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAggregatePrevoteResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes":
-		x.AggregatePrevotes = nil
+	case "nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote":
+		x.AggregatePrevote = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6531,19 +6700,16 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAggregatePrevotesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAggregatePrevoteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes":
-		if len(x.AggregatePrevotes) == 0 {
-			return protoreflect.ValueOfList(&_QueryAggregatePrevotesResponse_1_list{})
-		}
-		listValue := &_QueryAggregatePrevotesResponse_1_list{list: &x.AggregatePrevotes}
-		return protoreflect.ValueOfList(listValue)
+	case "nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote":
+		value := x.AggregatePrevote
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6557,17 +6723,15 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevotesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAggregatePrevoteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes":
-		lv := value.List()
-		clv := lv.(*_QueryAggregatePrevotesResponse_1_list)
-		x.AggregatePrevotes = *clv.list
+	case "nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote":
+		x.AggregatePrevote = value.Message().Interface().(*AggregateExchangeRatePrevote)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6581,45 +6745,44 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevotesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAggregatePrevoteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes":
-		if x.AggregatePrevotes == nil {
-			x.AggregatePrevotes = []*AggregateExchangeRatePrevote{}
+	case "nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote":
+		if x.AggregatePrevote == nil {
+			x.AggregatePrevote = new(AggregateExchangeRatePrevote)
 		}
-		value := &_QueryAggregatePrevotesResponse_1_list{list: &x.AggregatePrevotes}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.AggregatePrevote.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAggregatePrevotesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAggregatePrevoteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes":
-		list := []*AggregateExchangeRatePrevote{}
-		return protoreflect.ValueOfList(&_QueryAggregatePrevotesResponse_1_list{list: &list})
+	case "nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote":
+		m := new(AggregateExchangeRatePrevote)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevoteResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAggregatePrevotesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAggregatePrevoteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregatePrevotesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregatePrevoteResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6627,7 +6790,7 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAggregatePrevotesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAggregatePrevoteResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6638,7 +6801,7 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregatePrevotesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAggregatePrevoteResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6650,7 +6813,7 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAggregatePrevotesResponse) IsValid() bool {
+func (x *fastReflection_QueryAggregatePrevoteResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6660,9 +6823,9 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAggregatePrevotesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAggregatePrevoteResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAggregatePrevotesResponse)
+		x := input.Message.Interface().(*QueryAggregatePrevoteResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6674,11 +6837,9 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
-		if len(x.AggregatePrevotes) > 0 {
-			for _, e := range x.AggregatePrevotes {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.AggregatePrevote != nil {
+			l = options.Size(x.AggregatePrevote)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6690,7 +6851,7 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregatePrevotesResponse)
+		x := input.Message.Interface().(*QueryAggregatePrevoteResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6709,21 +6870,19 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AggregatePrevotes) > 0 {
-			for iNdEx := len(x.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.AggregatePrevotes[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.AggregatePrevote != nil {
+			encoded, err := options.Marshal(x.AggregatePrevote)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6736,7 +6895,7 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregatePrevotesResponse)
+		x := input.Message.Interface().(*QueryAggregatePrevoteResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6768,15 +6927,15 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6803,8 +6962,10 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) ProtoMethods() *protoifa
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AggregatePrevotes = append(x.AggregatePrevotes, &AggregateExchangeRatePrevote{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AggregatePrevotes[len(x.AggregatePrevotes)-1]); err != nil {
+				if x.AggregatePrevote == nil {
+					x.AggregatePrevote = &AggregateExchangeRatePrevote{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AggregatePrevote); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6844,25 +7005,23 @@ func (x *fastReflection_QueryAggregatePrevotesResponse) ProtoMethods() *protoifa
 }
 
 var (
-	md_QueryAggregateVoteRequest                protoreflect.MessageDescriptor
-	fd_QueryAggregateVoteRequest_validator_addr protoreflect.FieldDescriptor
+	md_QueryAggregatePrevotesRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryAggregateVoteRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregateVoteRequest")
-	fd_QueryAggregateVoteRequest_validator_addr = md_QueryAggregateVoteRequest.Fields().ByName("validator_addr")
+	md_QueryAggregatePrevotesRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregatePrevotesRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAggregateVoteRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAggregatePrevotesRequest)(nil)
 
-type fastReflection_QueryAggregateVoteRequest QueryAggregateVoteRequest
+type fastReflection_QueryAggregatePrevotesRequest QueryAggregatePrevotesRequest
 
-func (x *QueryAggregateVoteRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAggregateVoteRequest)(x)
+func (x *QueryAggregatePrevotesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAggregatePrevotesRequest)(x)
 }
 
-func (x *QueryAggregateVoteRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryAggregatePrevotesRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6874,43 +7033,43 @@ func (x *QueryAggregateVoteRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAggregateVoteRequest_messageType fastReflection_QueryAggregateVoteRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAggregateVoteRequest_messageType{}
+var _fastReflection_QueryAggregatePrevotesRequest_messageType fastReflection_QueryAggregatePrevotesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAggregatePrevotesRequest_messageType{}
 
-type fastReflection_QueryAggregateVoteRequest_messageType struct{}
+type fastReflection_QueryAggregatePrevotesRequest_messageType struct{}
 
-func (x fastReflection_QueryAggregateVoteRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAggregateVoteRequest)(nil)
+func (x fastReflection_QueryAggregatePrevotesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAggregatePrevotesRequest)(nil)
 }
-func (x fastReflection_QueryAggregateVoteRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregateVoteRequest)
+func (x fastReflection_QueryAggregatePrevotesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregatePrevotesRequest)
 }
-func (x fastReflection_QueryAggregateVoteRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregateVoteRequest
+func (x fastReflection_QueryAggregatePrevotesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregatePrevotesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAggregateVoteRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregateVoteRequest
+func (x *fastReflection_QueryAggregatePrevotesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregatePrevotesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAggregateVoteRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAggregateVoteRequest_messageType
+func (x *fastReflection_QueryAggregatePrevotesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAggregatePrevotesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAggregateVoteRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregateVoteRequest)
+func (x *fastReflection_QueryAggregatePrevotesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregatePrevotesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAggregateVoteRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAggregateVoteRequest)(x)
+func (x *fastReflection_QueryAggregatePrevotesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAggregatePrevotesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6918,13 +7077,7 @@ func (x *fastReflection_QueryAggregateVoteRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAggregateVoteRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ValidatorAddr != "" {
-		value := protoreflect.ValueOfString(x.ValidatorAddr)
-		if !f(fd_QueryAggregateVoteRequest_validator_addr, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryAggregatePrevotesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -6938,15 +7091,13 @@ func (x *fastReflection_QueryAggregateVoteRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAggregateVoteRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAggregatePrevotesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVoteRequest.validator_addr":
-		return x.ValidatorAddr != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6956,15 +7107,13 @@ func (x *fastReflection_QueryAggregateVoteRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVoteRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAggregatePrevotesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVoteRequest.validator_addr":
-		x.ValidatorAddr = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6974,16 +7123,13 @@ func (x *fastReflection_QueryAggregateVoteRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAggregateVoteRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAggregatePrevotesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVoteRequest.validator_addr":
-		value := x.ValidatorAddr
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6997,15 +7143,13 @@ func (x *fastReflection_QueryAggregateVoteRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVoteRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAggregatePrevotesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVoteRequest.validator_addr":
-		x.ValidatorAddr = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7019,40 +7163,36 @@ func (x *fastReflection_QueryAggregateVoteRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVoteRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAggregatePrevotesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVoteRequest.validator_addr":
-		panic(fmt.Errorf("field validator_addr of message nibiru.oracle.v1.QueryAggregateVoteRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAggregateVoteRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAggregatePrevotesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVoteRequest.validator_addr":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAggregateVoteRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAggregatePrevotesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregateVoteRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregatePrevotesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7060,7 +7200,7 @@ func (x *fastReflection_QueryAggregateVoteRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAggregateVoteRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAggregatePrevotesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7071,7 +7211,7 @@ func (x *fastReflection_QueryAggregateVoteRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVoteRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAggregatePrevotesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7083,7 +7223,7 @@ func (x *fastReflection_QueryAggregateVoteRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAggregateVoteRequest) IsValid() bool {
+func (x *fastReflection_QueryAggregatePrevotesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7093,9 +7233,880 @@ func (x *fastReflection_QueryAggregateVoteRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAggregateVoteRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAggregatePrevotesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAggregateVoteRequest)
+		x := input.Message.Interface().(*QueryAggregatePrevotesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAggregatePrevotesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAggregatePrevotesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevotesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAggregatePrevotesResponse_1_list)(nil)
+
+type _QueryAggregatePrevotesResponse_1_list struct {
+	list *[]*AggregateExchangeRatePrevote
+}
+
+func (x *_QueryAggregatePrevotesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAggregatePrevotesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAggregatePrevotesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AggregateExchangeRatePrevote)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAggregatePrevotesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AggregateExchangeRatePrevote)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAggregatePrevotesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(AggregateExchangeRatePrevote)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAggregatePrevotesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAggregatePrevotesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(AggregateExchangeRatePrevote)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAggregatePrevotesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAggregatePrevotesResponse                    protoreflect.MessageDescriptor
+	fd_QueryAggregatePrevotesResponse_aggregate_prevotes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_oracle_v1_query_proto_init()
+	md_QueryAggregatePrevotesResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregatePrevotesResponse")
+	fd_QueryAggregatePrevotesResponse_aggregate_prevotes = md_QueryAggregatePrevotesResponse.Fields().ByName("aggregate_prevotes")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAggregatePrevotesResponse)(nil)
+
+type fastReflection_QueryAggregatePrevotesResponse QueryAggregatePrevotesResponse
+
+func (x *QueryAggregatePrevotesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAggregatePrevotesResponse)(x)
+}
+
+func (x *QueryAggregatePrevotesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAggregatePrevotesResponse_messageType fastReflection_QueryAggregatePrevotesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAggregatePrevotesResponse_messageType{}
+
+type fastReflection_QueryAggregatePrevotesResponse_messageType struct{}
+
+func (x fastReflection_QueryAggregatePrevotesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAggregatePrevotesResponse)(nil)
+}
+func (x fastReflection_QueryAggregatePrevotesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregatePrevotesResponse)
+}
+func (x fastReflection_QueryAggregatePrevotesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregatePrevotesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAggregatePrevotesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregatePrevotesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAggregatePrevotesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAggregatePrevotesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAggregatePrevotesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregatePrevotesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAggregatePrevotesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAggregatePrevotesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAggregatePrevotesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AggregatePrevotes) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAggregatePrevotesResponse_1_list{list: &x.AggregatePrevotes})
+		if !f(fd_QueryAggregatePrevotesResponse_aggregate_prevotes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAggregatePrevotesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes":
+		return len(x.AggregatePrevotes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregatePrevotesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes":
+		x.AggregatePrevotes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAggregatePrevotesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes":
+		if len(x.AggregatePrevotes) == 0 {
+			return protoreflect.ValueOfList(&_QueryAggregatePrevotesResponse_1_list{})
+		}
+		listValue := &_QueryAggregatePrevotesResponse_1_list{list: &x.AggregatePrevotes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregatePrevotesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes":
+		lv := value.List()
+		clv := lv.(*_QueryAggregatePrevotesResponse_1_list)
+		x.AggregatePrevotes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregatePrevotesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes":
+		if x.AggregatePrevotes == nil {
+			x.AggregatePrevotes = []*AggregateExchangeRatePrevote{}
+		}
+		value := &_QueryAggregatePrevotesResponse_1_list{list: &x.AggregatePrevotes}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAggregatePrevotesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes":
+		list := []*AggregateExchangeRatePrevote{}
+		return protoreflect.ValueOfList(&_QueryAggregatePrevotesResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregatePrevotesResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregatePrevotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAggregatePrevotesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregatePrevotesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAggregatePrevotesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregatePrevotesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAggregatePrevotesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAggregatePrevotesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAggregatePrevotesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.AggregatePrevotes) > 0 {
+			for _, e := range x.AggregatePrevotes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAggregatePrevotesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AggregatePrevotes) > 0 {
+			for iNdEx := len(x.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AggregatePrevotes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAggregatePrevotesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AggregatePrevotes = append(x.AggregatePrevotes, &AggregateExchangeRatePrevote{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AggregatePrevotes[len(x.AggregatePrevotes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAggregateVoteRequest                protoreflect.MessageDescriptor
+	fd_QueryAggregateVoteRequest_validator_addr protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_oracle_v1_query_proto_init()
+	md_QueryAggregateVoteRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregateVoteRequest")
+	fd_QueryAggregateVoteRequest_validator_addr = md_QueryAggregateVoteRequest.Fields().ByName("validator_addr")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAggregateVoteRequest)(nil)
+
+type fastReflection_QueryAggregateVoteRequest QueryAggregateVoteRequest
+
+func (x *QueryAggregateVoteRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAggregateVoteRequest)(x)
+}
+
+func (x *QueryAggregateVoteRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAggregateVoteRequest_messageType fastReflection_QueryAggregateVoteRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAggregateVoteRequest_messageType{}
+
+type fastReflection_QueryAggregateVoteRequest_messageType struct{}
+
+func (x fastReflection_QueryAggregateVoteRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAggregateVoteRequest)(nil)
+}
+func (x fastReflection_QueryAggregateVoteRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregateVoteRequest)
+}
+func (x fastReflection_QueryAggregateVoteRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregateVoteRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAggregateVoteRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregateVoteRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAggregateVoteRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAggregateVoteRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAggregateVoteRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregateVoteRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAggregateVoteRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAggregateVoteRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAggregateVoteRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddr != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddr)
+		if !f(fd_QueryAggregateVoteRequest_validator_addr, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAggregateVoteRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVoteRequest.validator_addr":
+		return x.ValidatorAddr != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVoteRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVoteRequest.validator_addr":
+		x.ValidatorAddr = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAggregateVoteRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVoteRequest.validator_addr":
+		value := x.ValidatorAddr
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVoteRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVoteRequest.validator_addr":
+		x.ValidatorAddr = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVoteRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVoteRequest.validator_addr":
+		panic(fmt.Errorf("field validator_addr of message nibiru.oracle.v1.QueryAggregateVoteRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAggregateVoteRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVoteRequest.validator_addr":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAggregateVoteRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregateVoteRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAggregateVoteRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVoteRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAggregateVoteRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAggregateVoteRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAggregateVoteRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7121,7 +8132,1281 @@ func (x *fastReflection_QueryAggregateVoteRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregateVoteRequest)
+		x := input.Message.Interface().(*QueryAggregateVoteRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorAddr) > 0 {
+			i -= len(x.ValidatorAddr)
+			copy(dAtA[i:], x.ValidatorAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddr)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAggregateVoteRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVoteRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAggregateVoteResponse                protoreflect.MessageDescriptor
+	fd_QueryAggregateVoteResponse_aggregate_vote protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_oracle_v1_query_proto_init()
+	md_QueryAggregateVoteResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregateVoteResponse")
+	fd_QueryAggregateVoteResponse_aggregate_vote = md_QueryAggregateVoteResponse.Fields().ByName("aggregate_vote")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAggregateVoteResponse)(nil)
+
+type fastReflection_QueryAggregateVoteResponse QueryAggregateVoteResponse
+
+func (x *QueryAggregateVoteResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAggregateVoteResponse)(x)
+}
+
+func (x *QueryAggregateVoteResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAggregateVoteResponse_messageType fastReflection_QueryAggregateVoteResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAggregateVoteResponse_messageType{}
+
+type fastReflection_QueryAggregateVoteResponse_messageType struct{}
+
+func (x fastReflection_QueryAggregateVoteResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAggregateVoteResponse)(nil)
+}
+func (x fastReflection_QueryAggregateVoteResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregateVoteResponse)
+}
+func (x fastReflection_QueryAggregateVoteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregateVoteResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAggregateVoteResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregateVoteResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAggregateVoteResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAggregateVoteResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAggregateVoteResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregateVoteResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAggregateVoteResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAggregateVoteResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAggregateVoteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AggregateVote != nil {
+		value := protoreflect.ValueOfMessage(x.AggregateVote.ProtoReflect())
+		if !f(fd_QueryAggregateVoteResponse_aggregate_vote, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAggregateVoteResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote":
+		return x.AggregateVote != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVoteResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote":
+		x.AggregateVote = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAggregateVoteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote":
+		value := x.AggregateVote
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVoteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote":
+		x.AggregateVote = value.Message().Interface().(*AggregateExchangeRateVote)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVoteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote":
+		if x.AggregateVote == nil {
+			x.AggregateVote = new(AggregateExchangeRateVote)
+		}
+		return protoreflect.ValueOfMessage(x.AggregateVote.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAggregateVoteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote":
+		m := new(AggregateExchangeRateVote)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAggregateVoteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregateVoteResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAggregateVoteResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVoteResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAggregateVoteResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAggregateVoteResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAggregateVoteResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AggregateVote != nil {
+			l = options.Size(x.AggregateVote)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAggregateVoteResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AggregateVote != nil {
+			encoded, err := options.Marshal(x.AggregateVote)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAggregateVoteResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AggregateVote == nil {
+					x.AggregateVote = &AggregateExchangeRateVote{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AggregateVote); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAggregateVotesRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nibiru_oracle_v1_query_proto_init()
+	md_QueryAggregateVotesRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregateVotesRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAggregateVotesRequest)(nil)
+
+type fastReflection_QueryAggregateVotesRequest QueryAggregateVotesRequest
+
+func (x *QueryAggregateVotesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAggregateVotesRequest)(x)
+}
+
+func (x *QueryAggregateVotesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAggregateVotesRequest_messageType fastReflection_QueryAggregateVotesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAggregateVotesRequest_messageType{}
+
+type fastReflection_QueryAggregateVotesRequest_messageType struct{}
+
+func (x fastReflection_QueryAggregateVotesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAggregateVotesRequest)(nil)
+}
+func (x fastReflection_QueryAggregateVotesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregateVotesRequest)
+}
+func (x fastReflection_QueryAggregateVotesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregateVotesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAggregateVotesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregateVotesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAggregateVotesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAggregateVotesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAggregateVotesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregateVotesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAggregateVotesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAggregateVotesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAggregateVotesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAggregateVotesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVotesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAggregateVotesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVotesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVotesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAggregateVotesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAggregateVotesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregateVotesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAggregateVotesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVotesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAggregateVotesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAggregateVotesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAggregateVotesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAggregateVotesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAggregateVotesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVotesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAggregateVotesResponse_1_list)(nil)
+
+type _QueryAggregateVotesResponse_1_list struct {
+	list *[]*AggregateExchangeRateVote
+}
+
+func (x *_QueryAggregateVotesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAggregateVotesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAggregateVotesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AggregateExchangeRateVote)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAggregateVotesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AggregateExchangeRateVote)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAggregateVotesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(AggregateExchangeRateVote)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAggregateVotesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAggregateVotesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(AggregateExchangeRateVote)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAggregateVotesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAggregateVotesResponse                 protoreflect.MessageDescriptor
+	fd_QueryAggregateVotesResponse_aggregate_votes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_oracle_v1_query_proto_init()
+	md_QueryAggregateVotesResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregateVotesResponse")
+	fd_QueryAggregateVotesResponse_aggregate_votes = md_QueryAggregateVotesResponse.Fields().ByName("aggregate_votes")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAggregateVotesResponse)(nil)
+
+type fastReflection_QueryAggregateVotesResponse QueryAggregateVotesResponse
+
+func (x *QueryAggregateVotesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAggregateVotesResponse)(x)
+}
+
+func (x *QueryAggregateVotesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAggregateVotesResponse_messageType fastReflection_QueryAggregateVotesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAggregateVotesResponse_messageType{}
+
+type fastReflection_QueryAggregateVotesResponse_messageType struct{}
+
+func (x fastReflection_QueryAggregateVotesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAggregateVotesResponse)(nil)
+}
+func (x fastReflection_QueryAggregateVotesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregateVotesResponse)
+}
+func (x fastReflection_QueryAggregateVotesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregateVotesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAggregateVotesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAggregateVotesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAggregateVotesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAggregateVotesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAggregateVotesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAggregateVotesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAggregateVotesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAggregateVotesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAggregateVotesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AggregateVotes) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAggregateVotesResponse_1_list{list: &x.AggregateVotes})
+		if !f(fd_QueryAggregateVotesResponse_aggregate_votes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAggregateVotesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes":
+		return len(x.AggregateVotes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVotesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes":
+		x.AggregateVotes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAggregateVotesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes":
+		if len(x.AggregateVotes) == 0 {
+			return protoreflect.ValueOfList(&_QueryAggregateVotesResponse_1_list{})
+		}
+		listValue := &_QueryAggregateVotesResponse_1_list{list: &x.AggregateVotes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVotesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes":
+		lv := value.List()
+		clv := lv.(*_QueryAggregateVotesResponse_1_list)
+		x.AggregateVotes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVotesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes":
+		if x.AggregateVotes == nil {
+			x.AggregateVotes = []*AggregateExchangeRateVote{}
+		}
+		value := &_QueryAggregateVotesResponse_1_list{list: &x.AggregateVotes}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAggregateVotesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes":
+		list := []*AggregateExchangeRateVote{}
+		return protoreflect.ValueOfList(&_QueryAggregateVotesResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAggregateVotesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregateVotesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAggregateVotesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAggregateVotesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAggregateVotesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAggregateVotesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAggregateVotesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.AggregateVotes) > 0 {
+			for _, e := range x.AggregateVotes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAggregateVotesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7140,12 +9425,21 @@ func (x *fastReflection_QueryAggregateVoteRequest) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorAddr) > 0 {
-			i -= len(x.ValidatorAddr)
-			copy(dAtA[i:], x.ValidatorAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddr)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.AggregateVotes) > 0 {
+			for iNdEx := len(x.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AggregateVotes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7158,7 +9452,7 @@ func (x *fastReflection_QueryAggregateVoteRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregateVoteRequest)
+		x := input.Message.Interface().(*QueryAggregateVotesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7190,17 +9484,17 @@ func (x *fastReflection_QueryAggregateVoteRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVoteRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7210,23 +9504,25 @@ func (x *fastReflection_QueryAggregateVoteRequest) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+				x.AggregateVotes = append(x.AggregateVotes, &AggregateExchangeRateVote{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AggregateVotes[len(x.AggregateVotes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7264,26 +9560,24 @@ func (x *fastReflection_QueryAggregateVoteRequest) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_QueryAggregateVoteResponse                protoreflect.MessageDescriptor
-	fd_QueryAggregateVoteResponse_aggregate_vote protoreflect.FieldDescriptor
+	md_QueryParamsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryAggregateVoteResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregateVoteResponse")
-	fd_QueryAggregateVoteResponse_aggregate_vote = md_QueryAggregateVoteResponse.Fields().ByName("aggregate_vote")
+	md_QueryParamsRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryParamsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAggregateVoteResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
 
-type fastReflection_QueryAggregateVoteResponse QueryAggregateVoteResponse
+type fastReflection_QueryParamsRequest QueryParamsRequest
 
-func (x *QueryAggregateVoteResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAggregateVoteResponse)(x)
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(x)
 }
 
-func (x *QueryAggregateVoteResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[17]
+func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7294,43 +9588,43 @@ func (x *QueryAggregateVoteResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAggregateVoteResponse_messageType fastReflection_QueryAggregateVoteResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAggregateVoteResponse_messageType{}
+var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
 
-type fastReflection_QueryAggregateVoteResponse_messageType struct{}
+type fastReflection_QueryParamsRequest_messageType struct{}
 
-func (x fastReflection_QueryAggregateVoteResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAggregateVoteResponse)(nil)
+func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(nil)
 }
-func (x fastReflection_QueryAggregateVoteResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregateVoteResponse)
+func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
-func (x fastReflection_QueryAggregateVoteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregateVoteResponse
+func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAggregateVoteResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregateVoteResponse
+func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAggregateVoteResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAggregateVoteResponse_messageType
+func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAggregateVoteResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregateVoteResponse)
+func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAggregateVoteResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAggregateVoteResponse)(x)
+func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7338,13 +9632,7 @@ func (x *fastReflection_QueryAggregateVoteResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAggregateVoteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AggregateVote != nil {
-		value := protoreflect.ValueOfMessage(x.AggregateVote.ProtoReflect())
-		if !f(fd_QueryAggregateVoteResponse_aggregate_vote, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -7358,15 +9646,13 @@ func (x *fastReflection_QueryAggregateVoteResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAggregateVoteResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote":
-		return x.AggregateVote != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7376,15 +9662,13 @@ func (x *fastReflection_QueryAggregateVoteResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVoteResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote":
-		x.AggregateVote = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7394,16 +9678,13 @@ func (x *fastReflection_QueryAggregateVoteResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAggregateVoteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote":
-		value := x.AggregateVote
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7417,15 +9698,13 @@ func (x *fastReflection_QueryAggregateVoteResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVoteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote":
-		x.AggregateVote = value.Message().Interface().(*AggregateExchangeRateVote)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7439,44 +9718,36 @@ func (x *fastReflection_QueryAggregateVoteResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVoteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote":
-		if x.AggregateVote == nil {
-			x.AggregateVote = new(AggregateExchangeRateVote)
-		}
-		return protoreflect.ValueOfMessage(x.AggregateVote.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAggregateVoteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote":
-		m := new(AggregateExchangeRateVote)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAggregateVoteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregateVoteResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7484,7 +9755,7 @@ func (x *fastReflection_QueryAggregateVoteResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAggregateVoteResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7495,7 +9766,7 @@ func (x *fastReflection_QueryAggregateVoteResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVoteResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7507,7 +9778,7 @@ func (x *fastReflection_QueryAggregateVoteResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAggregateVoteResponse) IsValid() bool {
+func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7517,9 +9788,9 @@ func (x *fastReflection_QueryAggregateVoteResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAggregateVoteResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAggregateVoteResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7531,10 +9802,6 @@ func (x *fastReflection_QueryAggregateVoteResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if x.AggregateVote != nil {
-			l = options.Size(x.AggregateVote)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7545,7 +9812,7 @@ func (x *fastReflection_QueryAggregateVoteResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregateVoteResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7564,20 +9831,6 @@ func (x *fastReflection_QueryAggregateVoteResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AggregateVote != nil {
-			encoded, err := options.Marshal(x.AggregateVote)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -7589,7 +9842,7 @@ func (x *fastReflection_QueryAggregateVoteResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregateVoteResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7617,52 +9870,16 @@ func (x *fastReflection_QueryAggregateVoteResponse) ProtoMethods() *protoiface.M
 				if b < 0x80 {
 					break
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.AggregateVote == nil {
-					x.AggregateVote = &AggregateExchangeRateVote{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AggregateVote); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7699,24 +9916,26 @@ func (x *fastReflection_QueryAggregateVoteResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryAggregateVotesRequest protoreflect.MessageDescriptor
+	md_QueryParamsResponse        protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryAggregateVotesRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregateVotesRequest")
+	md_QueryParamsResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryParamsResponse")
+	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAggregateVotesRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
 
-type fastReflection_QueryAggregateVotesRequest QueryAggregateVotesRequest
+type fastReflection_QueryParamsResponse QueryParamsResponse
 
-func (x *QueryAggregateVotesRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAggregateVotesRequest)(x)
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(x)
 }
 
-func (x *QueryAggregateVotesRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[18]
+func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7727,43 +9946,43 @@ func (x *QueryAggregateVotesRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAggregateVotesRequest_messageType fastReflection_QueryAggregateVotesRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAggregateVotesRequest_messageType{}
+var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
 
-type fastReflection_QueryAggregateVotesRequest_messageType struct{}
+type fastReflection_QueryParamsResponse_messageType struct{}
 
-func (x fastReflection_QueryAggregateVotesRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAggregateVotesRequest)(nil)
+func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(nil)
 }
-func (x fastReflection_QueryAggregateVotesRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregateVotesRequest)
+func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
-func (x fastReflection_QueryAggregateVotesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregateVotesRequest
+func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAggregateVotesRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregateVotesRequest
+func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAggregateVotesRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAggregateVotesRequest_messageType
+func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAggregateVotesRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregateVotesRequest)
+func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAggregateVotesRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAggregateVotesRequest)(x)
+func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7771,7 +9990,13 @@ func (x *fastReflection_QueryAggregateVotesRequest) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAggregateVotesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryParamsResponse_params, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7785,13 +10010,15 @@ func (x *fastReflection_QueryAggregateVotesRequest) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAggregateVotesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryParamsResponse.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7801,13 +10028,15 @@ func (x *fastReflection_QueryAggregateVotesRequest) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVotesRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryParamsResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7817,13 +10046,16 @@ func (x *fastReflection_QueryAggregateVotesRequest) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAggregateVotesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "nibiru.oracle.v1.QueryParamsResponse.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7837,13 +10069,15 @@ func (x *fastReflection_QueryAggregateVotesRequest) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVotesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7857,36 +10091,44 @@ func (x *fastReflection_QueryAggregateVotesRequest) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVotesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAggregateVotesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryParamsResponse.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAggregateVotesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregateVotesRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7894,7 +10136,7 @@ func (x *fastReflection_QueryAggregateVotesRequest) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAggregateVotesRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7905,7 +10147,7 @@ func (x *fastReflection_QueryAggregateVotesRequest) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVotesRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7917,7 +10159,7 @@ func (x *fastReflection_QueryAggregateVotesRequest) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAggregateVotesRequest) IsValid() bool {
+func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7927,9 +10169,9 @@ func (x *fastReflection_QueryAggregateVotesRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAggregateVotesRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAggregateVotesRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7941,6 +10183,10 @@ func (x *fastReflection_QueryAggregateVotesRequest) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7951,7 +10197,7 @@ func (x *fastReflection_QueryAggregateVotesRequest) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregateVotesRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7970,6 +10216,20 @@ func (x *fastReflection_QueryAggregateVotesRequest) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -7981,7 +10241,7 @@ func (x *fastReflection_QueryAggregateVotesRequest) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregateVotesRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8013,12 +10273,48 @@ func (x *fastReflection_QueryAggregateVotesRequest) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVotesRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8046,86 +10342,33 @@ func (x *fastReflection_QueryAggregateVotesRequest) ProtoMethods() *protoiface.M
 	return &protoiface.Methods{
 		NoUnkeyedLiterals: struct{}{},
 		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_QueryAggregateVotesResponse_1_list)(nil)
-
-type _QueryAggregateVotesResponse_1_list struct {
-	list *[]*AggregateExchangeRateVote
-}
-
-func (x *_QueryAggregateVotesResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryAggregateVotesResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryAggregateVotesResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*AggregateExchangeRateVote)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryAggregateVotesResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*AggregateExchangeRateVote)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryAggregateVotesResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(AggregateExchangeRateVote)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAggregateVotesResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryAggregateVotesResponse_1_list) NewElement() protoreflect.Value {
-	v := new(AggregateExchangeRateVote)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAggregateVotesResponse_1_list) IsValid() bool {
-	return x.list != nil
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
 var (
-	md_QueryAggregateVotesResponse                 protoreflect.MessageDescriptor
-	fd_QueryAggregateVotesResponse_aggregate_votes protoreflect.FieldDescriptor
+	md_QueryPairStatusesRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryAggregateVotesResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryAggregateVotesResponse")
-	fd_QueryAggregateVotesResponse_aggregate_votes = md_QueryAggregateVotesResponse.Fields().ByName("aggregate_votes")
+	md_QueryPairStatusesRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryPairStatusesRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAggregateVotesResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPairStatusesRequest)(nil)
 
-type fastReflection_QueryAggregateVotesResponse QueryAggregateVotesResponse
+type fastReflection_QueryPairStatusesRequest QueryPairStatusesRequest
 
-func (x *QueryAggregateVotesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAggregateVotesResponse)(x)
+func (x *QueryPairStatusesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPairStatusesRequest)(x)
 }
 
-func (x *QueryAggregateVotesResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[19]
+func (x *QueryPairStatusesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8136,43 +10379,43 @@ func (x *QueryAggregateVotesResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAggregateVotesResponse_messageType fastReflection_QueryAggregateVotesResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAggregateVotesResponse_messageType{}
+var _fastReflection_QueryPairStatusesRequest_messageType fastReflection_QueryPairStatusesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPairStatusesRequest_messageType{}
 
-type fastReflection_QueryAggregateVotesResponse_messageType struct{}
+type fastReflection_QueryPairStatusesRequest_messageType struct{}
 
-func (x fastReflection_QueryAggregateVotesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAggregateVotesResponse)(nil)
+func (x fastReflection_QueryPairStatusesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPairStatusesRequest)(nil)
 }
-func (x fastReflection_QueryAggregateVotesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregateVotesResponse)
+func (x fastReflection_QueryPairStatusesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPairStatusesRequest)
 }
-func (x fastReflection_QueryAggregateVotesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregateVotesResponse
+func (x fastReflection_QueryPairStatusesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPairStatusesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAggregateVotesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAggregateVotesResponse
+func (x *fastReflection_QueryPairStatusesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPairStatusesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAggregateVotesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAggregateVotesResponse_messageType
+func (x *fastReflection_QueryPairStatusesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPairStatusesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAggregateVotesResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAggregateVotesResponse)
+func (x *fastReflection_QueryPairStatusesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPairStatusesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAggregateVotesResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAggregateVotesResponse)(x)
+func (x *fastReflection_QueryPairStatusesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPairStatusesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8180,13 +10423,7 @@ func (x *fastReflection_QueryAggregateVotesResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAggregateVotesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.AggregateVotes) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAggregateVotesResponse_1_list{list: &x.AggregateVotes})
-		if !f(fd_QueryAggregateVotesResponse_aggregate_votes, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryPairStatusesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -8200,15 +10437,13 @@ func (x *fastReflection_QueryAggregateVotesResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAggregateVotesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPairStatusesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes":
-		return len(x.AggregateVotes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryPairStatusesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryPairStatusesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8218,15 +10453,13 @@ func (x *fastReflection_QueryAggregateVotesResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVotesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPairStatusesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes":
-		x.AggregateVotes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryPairStatusesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryPairStatusesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8236,19 +10469,13 @@ func (x *fastReflection_QueryAggregateVotesResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAggregateVotesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPairStatusesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes":
-		if len(x.AggregateVotes) == 0 {
-			return protoreflect.ValueOfList(&_QueryAggregateVotesResponse_1_list{})
-		}
-		listValue := &_QueryAggregateVotesResponse_1_list{list: &x.AggregateVotes}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryPairStatusesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryPairStatusesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8262,17 +10489,13 @@ func (x *fastReflection_QueryAggregateVotesResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVotesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPairStatusesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes":
-		lv := value.List()
-		clv := lv.(*_QueryAggregateVotesResponse_1_list)
-		x.AggregateVotes = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryPairStatusesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryPairStatusesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8286,45 +10509,36 @@ func (x *fastReflection_QueryAggregateVotesResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVotesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPairStatusesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes":
-		if x.AggregateVotes == nil {
-			x.AggregateVotes = []*AggregateExchangeRateVote{}
-		}
-		value := &_QueryAggregateVotesResponse_1_list{list: &x.AggregateVotes}
-		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryPairStatusesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryPairStatusesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAggregateVotesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPairStatusesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes":
-		list := []*AggregateExchangeRateVote{}
-		return protoreflect.ValueOfList(&_QueryAggregateVotesResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryAggregateVotesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryPairStatusesRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryAggregateVotesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryPairStatusesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAggregateVotesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPairStatusesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryAggregateVotesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryPairStatusesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8332,7 +10546,7 @@ func (x *fastReflection_QueryAggregateVotesResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAggregateVotesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPairStatusesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8343,7 +10557,7 @@ func (x *fastReflection_QueryAggregateVotesResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAggregateVotesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPairStatusesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8355,7 +10569,7 @@ func (x *fastReflection_QueryAggregateVotesResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAggregateVotesResponse) IsValid() bool {
+func (x *fastReflection_QueryPairStatusesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8365,9 +10579,9 @@ func (x *fastReflection_QueryAggregateVotesResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAggregateVotesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPairStatusesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAggregateVotesResponse)
+		x := input.Message.Interface().(*QueryPairStatusesRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8379,12 +10593,6 @@ func (x *fastReflection_QueryAggregateVotesResponse) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		if len(x.AggregateVotes) > 0 {
-			for _, e := range x.AggregateVotes {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8395,7 +10603,7 @@ func (x *fastReflection_QueryAggregateVotesResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregateVotesResponse)
+		x := input.Message.Interface().(*QueryPairStatusesRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8414,22 +10622,6 @@ func (x *fastReflection_QueryAggregateVotesResponse) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AggregateVotes) > 0 {
-			for iNdEx := len(x.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.AggregateVotes[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -8441,7 +10633,7 @@ func (x *fastReflection_QueryAggregateVotesResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAggregateVotesResponse)
+		x := input.Message.Interface().(*QueryPairStatusesRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8473,46 +10665,12 @@ func (x *fastReflection_QueryAggregateVotesResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPairStatusesRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPairStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.AggregateVotes = append(x.AggregateVotes, &AggregateExchangeRateVote{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AggregateVotes[len(x.AggregateVotes)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8548,25 +10706,78 @@ func (x *fastReflection_QueryAggregateVotesResponse) ProtoMethods() *protoiface.
 	}
 }
 
+var _ protoreflect.List = (*_QueryPairStatusesResponse_1_list)(nil)
+
+type _QueryPairStatusesResponse_1_list struct {
+	list *[]*PairStatusResult
+}
+
+func (x *_QueryPairStatusesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPairStatusesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPairStatusesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PairStatusResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPairStatusesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PairStatusResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPairStatusesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(PairStatusResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPairStatusesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPairStatusesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(PairStatusResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPairStatusesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryParamsRequest protoreflect.MessageDescriptor
+	md_QueryPairStatusesResponse          protoreflect.MessageDescriptor
+	fd_QueryPairStatusesResponse_statuses protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryParamsRequest = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryParamsRequest")
+	md_QueryPairStatusesResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryPairStatusesResponse")
+	fd_QueryPairStatusesResponse_statuses = md_QueryPairStatusesResponse.Fields().ByName("statuses")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPairStatusesResponse)(nil)
 
-type fastReflection_QueryParamsRequest QueryParamsRequest
+type fastReflection_QueryPairStatusesResponse QueryPairStatusesResponse
 
-func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(x)
+func (x *QueryPairStatusesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPairStatusesResponse)(x)
 }
 
-func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[20]
+func (x *QueryPairStatusesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8577,43 +10788,43 @@ func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+var _fastReflection_QueryPairStatusesResponse_messageType fastReflection_QueryPairStatusesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPairStatusesResponse_messageType{}
 
-type fastReflection_QueryParamsRequest_messageType struct{}
+type fastReflection_QueryPairStatusesResponse_messageType struct{}
 
-func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(nil)
+func (x fastReflection_QueryPairStatusesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPairStatusesResponse)(nil)
 }
-func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x fastReflection_QueryPairStatusesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPairStatusesResponse)
 }
-func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x fastReflection_QueryPairStatusesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPairStatusesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x *fastReflection_QueryPairStatusesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPairStatusesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsRequest_messageType
+func (x *fastReflection_QueryPairStatusesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPairStatusesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x *fastReflection_QueryPairStatusesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPairStatusesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsRequest)(x)
+func (x *fastReflection_QueryPairStatusesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPairStatusesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8621,7 +10832,13 @@ func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryPairStatusesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Statuses) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPairStatusesResponse_1_list{list: &x.Statuses})
+		if !f(fd_QueryPairStatusesResponse_statuses, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -8635,13 +10852,15 @@ func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPairStatusesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryPairStatusesResponse.statuses":
+		return len(x.Statuses) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryPairStatusesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryPairStatusesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8651,13 +10870,15 @@ func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPairStatusesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryPairStatusesResponse.statuses":
+		x.Statuses = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryPairStatusesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryPairStatusesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8667,13 +10888,19 @@ func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPairStatusesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "nibiru.oracle.v1.QueryPairStatusesResponse.statuses":
+		if len(x.Statuses) == 0 {
+			return protoreflect.ValueOfList(&_QueryPairStatusesResponse_1_list{})
+		}
+		listValue := &_QueryPairStatusesResponse_1_list{list: &x.Statuses}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryPairStatusesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryPairStatusesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8687,13 +10914,17 @@ func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPairStatusesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryPairStatusesResponse.statuses":
+		lv := value.List()
+		clv := lv.(*_QueryPairStatusesResponse_1_list)
+		x.Statuses = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryPairStatusesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryPairStatusesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8707,36 +10938,45 @@ func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPairStatusesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryPairStatusesResponse.statuses":
+		if x.Statuses == nil {
+			x.Statuses = []*PairStatusResult{}
+		}
+		value := &_QueryPairStatusesResponse_1_list{list: &x.Statuses}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryPairStatusesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryPairStatusesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPairStatusesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nibiru.oracle.v1.QueryPairStatusesResponse.statuses":
+		list := []*PairStatusResult{}
+		return protoreflect.ValueOfList(&_QueryPairStatusesResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryPairStatusesResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.QueryPairStatusesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPairStatusesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryParamsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryPairStatusesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8744,7 +10984,7 @@ func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPairStatusesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8755,7 +10995,7 @@ func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPairStatusesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8767,7 +11007,7 @@ func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+func (x *fastReflection_QueryPairStatusesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8777,9 +11017,9 @@ func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPairStatusesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryPairStatusesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8791,6 +11031,12 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if len(x.Statuses) > 0 {
+			for _, e := range x.Statuses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8801,7 +11047,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryPairStatusesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8820,6 +11066,22 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Statuses) > 0 {
+			for iNdEx := len(x.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Statuses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -8831,7 +11093,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryPairStatusesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8863,12 +11125,46 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPairStatusesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPairStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Statuses = append(x.Statuses, &PairStatusResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Statuses[len(x.Statuses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8905,26 +11201,30 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryParamsResponse        protoreflect.MessageDescriptor
-	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
+	md_PairStatusResult            protoreflect.MessageDescriptor
+	fd_PairStatusResult_status     protoreflect.FieldDescriptor
+	fd_PairStatusResult_age_blocks protoreflect.FieldDescriptor
+	fd_PairStatusResult_stale      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_query_proto_init()
-	md_QueryParamsResponse = File_nibiru_oracle_v1_query_proto.Messages().ByName("QueryParamsResponse")
-	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
+	md_PairStatusResult = File_nibiru_oracle_v1_query_proto.Messages().ByName("PairStatusResult")
+	fd_PairStatusResult_status = md_PairStatusResult.Fields().ByName("status")
+	fd_PairStatusResult_age_blocks = md_PairStatusResult.Fields().ByName("age_blocks")
+	fd_PairStatusResult_stale = md_PairStatusResult.Fields().ByName("stale")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_PairStatusResult)(nil)
 
-type fastReflection_QueryParamsResponse QueryParamsResponse
+type fastReflection_PairStatusResult PairStatusResult
 
-func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(x)
+func (x *PairStatusResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PairStatusResult)(x)
 }
 
-func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[21]
+func (x *PairStatusResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_query_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8935,43 +11235,43 @@ func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+var _fastReflection_PairStatusResult_messageType fastReflection_PairStatusResult_messageType
+var _ protoreflect.MessageType = fastReflection_PairStatusResult_messageType{}
 
-type fastReflection_QueryParamsResponse_messageType struct{}
+type fastReflection_PairStatusResult_messageType struct{}
 
-func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(nil)
+func (x fastReflection_PairStatusResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PairStatusResult)(nil)
 }
-func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x fastReflection_PairStatusResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_PairStatusResult)
 }
-func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x fastReflection_PairStatusResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairStatusResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x *fastReflection_PairStatusResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairStatusResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsResponse_messageType
+func (x *fastReflection_PairStatusResult) Type() protoreflect.MessageType {
+	return _fastReflection_PairStatusResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x *fastReflection_PairStatusResult) New() protoreflect.Message {
+	return new(fastReflection_PairStatusResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsResponse)(x)
+func (x *fastReflection_PairStatusResult) Interface() protoreflect.ProtoMessage {
+	return (*PairStatusResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8979,10 +11279,22 @@ func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_QueryParamsResponse_params, value) {
+func (x *fastReflection_PairStatusResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != nil {
+		value := protoreflect.ValueOfMessage(x.Status.ProtoReflect())
+		if !f(fd_PairStatusResult_status, value) {
+			return
+		}
+	}
+	if x.AgeBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AgeBlocks)
+		if !f(fd_PairStatusResult_age_blocks, value) {
+			return
+		}
+	}
+	if x.Stale != false {
+		value := protoreflect.ValueOfBool(x.Stale)
+		if !f(fd_PairStatusResult_stale, value) {
 			return
 		}
 	}
@@ -8999,15 +11311,19 @@ func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PairStatusResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryParamsResponse.params":
-		return x.Params != nil
+	case "nibiru.oracle.v1.PairStatusResult.status":
+		return x.Status != nil
+	case "nibiru.oracle.v1.PairStatusResult.age_blocks":
+		return x.AgeBlocks != uint64(0)
+	case "nibiru.oracle.v1.PairStatusResult.stale":
+		return x.Stale != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairStatusResult"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairStatusResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9017,15 +11333,19 @@ func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PairStatusResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryParamsResponse.params":
-		x.Params = nil
+	case "nibiru.oracle.v1.PairStatusResult.status":
+		x.Status = nil
+	case "nibiru.oracle.v1.PairStatusResult.age_blocks":
+		x.AgeBlocks = uint64(0)
+	case "nibiru.oracle.v1.PairStatusResult.stale":
+		x.Stale = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairStatusResult"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairStatusResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9035,16 +11355,22 @@ func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PairStatusResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.QueryParamsResponse.params":
-		value := x.Params
+	case "nibiru.oracle.v1.PairStatusResult.status":
+		value := x.Status
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.oracle.v1.PairStatusResult.age_blocks":
+		value := x.AgeBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.PairStatusResult.stale":
+		value := x.Stale
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairStatusResult"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairStatusResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9058,15 +11384,19 @@ func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PairStatusResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryParamsResponse.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "nibiru.oracle.v1.PairStatusResult.status":
+		x.Status = value.Message().Interface().(*PairStatus)
+	case "nibiru.oracle.v1.PairStatusResult.age_blocks":
+		x.AgeBlocks = value.Uint()
+	case "nibiru.oracle.v1.PairStatusResult.stale":
+		x.Stale = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairStatusResult"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairStatusResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9080,44 +11410,52 @@ func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PairStatusResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryParamsResponse.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "nibiru.oracle.v1.PairStatusResult.status":
+		if x.Status == nil {
+			x.Status = new(PairStatus)
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Status.ProtoReflect())
+	case "nibiru.oracle.v1.PairStatusResult.age_blocks":
+		panic(fmt.Errorf("field age_blocks of message nibiru.oracle.v1.PairStatusResult is not mutable"))
+	case "nibiru.oracle.v1.PairStatusResult.stale":
+		panic(fmt.Errorf("field stale of message nibiru.oracle.v1.PairStatusResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairStatusResult"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairStatusResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PairStatusResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.QueryParamsResponse.params":
-		m := new(Params)
+	case "nibiru.oracle.v1.PairStatusResult.status":
+		m := new(PairStatus)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.oracle.v1.PairStatusResult.age_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.PairStatusResult.stale":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairStatusResult"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairStatusResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PairStatusResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.QueryParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.PairStatusResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9125,7 +11463,7 @@ func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PairStatusResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9136,7 +11474,7 @@ func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PairStatusResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9148,7 +11486,7 @@ func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+func (x *fastReflection_PairStatusResult) IsValid() bool {
 	return x != nil
 }
 
@@ -9158,9 +11496,9 @@ func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PairStatusResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*PairStatusResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9172,10 +11510,16 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		if x.Status != nil {
+			l = options.Size(x.Status)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.AgeBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.AgeBlocks))
+		}
+		if x.Stale {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9186,7 +11530,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*PairStatusResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9205,8 +11549,23 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
+		if x.Stale {
+			i--
+			if x.Stale {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.AgeBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AgeBlocks))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Status != nil {
+			encoded, err := options.Marshal(x.Status)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9230,7 +11589,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*PairStatusResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9262,15 +11621,15 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairStatusResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairStatusResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9297,13 +11656,52 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				if x.Status == nil {
+					x.Status = &PairStatus{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Status); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgeBlocks", wireType)
+				}
+				x.AgeBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AgeBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Stale = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9405,6 +11803,12 @@ type QueryExchangeRateResponse struct {
 	BlockTimestampMs int64 `protobuf:"varint,2,opt,name=block_timestamp_ms,json=blockTimestampMs,proto3" json:"block_timestamp_ms,omitempty"`
 	// Block height when the oracle came to consensus for this price.
 	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Number of blocks since the oracle came to consensus for this price.
+	AgeBlocks uint64 `protobuf:"varint,4,opt,name=age_blocks,json=ageBlocks,proto3" json:"age_blocks,omitempty"`
+	// Whether the price is older than the max age of the pair.
+	Stale bool `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
+	// Whether the pair is halted by its circuit breaker.
+	Halted bool `protobuf:"varint,6,opt,name=halted,proto3" json:"halted,omitempty"`
 }
 
 func (x *QueryExchangeRateResponse) Reset() {
@@ -9448,6 +11852,101 @@ func (x *QueryExchangeRateResponse) GetBlockHeight() uint64 {
 	return 0
 }
 
+func (x *QueryExchangeRateResponse) GetAgeBlocks() uint64 {
+	if x != nil {
+		return x.AgeBlocks
+	}
+	return 0
+}
+
+func (x *QueryExchangeRateResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *QueryExchangeRateResponse) GetHalted() bool {
+	if x != nil {
+		return x.Halted
+	}
+	return false
+}
+
+// QueryExchangeRateEmaRequest is the request type for the Query/ExchangeRateEma
+// RPC method.
+type QueryExchangeRateEmaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+}
+
+func (x *QueryExchangeRateEmaRequest) Reset() {
+	*x = QueryExchangeRateEmaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryExchangeRateEmaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExchangeRateEmaRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryExchangeRateEmaRequest.ProtoReflect.Descriptor instead.
+func (*QueryExchangeRateEmaRequest) Descriptor() ([]byte, []int) {
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryExchangeRateEmaRequest) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+// QueryExchangeRateEmaResponse is the response type for the
+// Query/ExchangeRateEma RPC method.
+type QueryExchangeRateEmaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExchangeRateEma *ExchangeRateEma `protobuf:"bytes,1,opt,name=exchange_rate_ema,json=exchangeRateEma,proto3" json:"exchange_rate_ema,omitempty"`
+}
+
+func (x *QueryExchangeRateEmaResponse) Reset() {
+	*x = QueryExchangeRateEmaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryExchangeRateEmaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExchangeRateEmaResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryExchangeRateEmaResponse.ProtoReflect.Descriptor instead.
+func (*QueryExchangeRateEmaResponse) Descriptor() ([]byte, []int) {
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryExchangeRateEmaResponse) GetExchangeRateEma() *ExchangeRateEma {
+	if x != nil {
+		return x.ExchangeRateEma
+	}
+	return nil
+}
+
 // QueryExchangeRatesRequest is the request type for the Query/ExchangeRates RPC
 // method.
 type QueryExchangeRatesRequest struct {
@@ -9459,7 +11958,7 @@ type QueryExchangeRatesRequest struct {
 func (x *QueryExchangeRatesRequest) Reset() {
 	*x = QueryExchangeRatesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[2]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9473,7 +11972,7 @@ func (*QueryExchangeRatesRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryExchangeRatesRequest.ProtoReflect.Descriptor instead.
 func (*QueryExchangeRatesRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{2}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{4}
 }
 
 // QueryExchangeRatesResponse is response type for the
@@ -9491,7 +11990,7 @@ type QueryExchangeRatesResponse struct {
 func (x *QueryExchangeRatesResponse) Reset() {
 	*x = QueryExchangeRatesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[3]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9505,7 +12004,7 @@ func (*QueryExchangeRatesResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryExchangeRatesResponse.ProtoReflect.Descriptor instead.
 func (*QueryExchangeRatesResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{3}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *QueryExchangeRatesResponse) GetExchangeRates() []*ExchangeRateTuple {
@@ -9525,7 +12024,7 @@ type QueryActivesRequest struct {
 func (x *QueryActivesRequest) Reset() {
 	*x = QueryActivesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[4]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9539,7 +12038,7 @@ func (*QueryActivesRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryActivesRequest.ProtoReflect.Descriptor instead.
 func (*QueryActivesRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{4}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{6}
 }
 
 // QueryActivesResponse is response type for the
@@ -9556,7 +12055,7 @@ type QueryActivesResponse struct {
 func (x *QueryActivesResponse) Reset() {
 	*x = QueryActivesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[5]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9570,7 +12069,7 @@ func (*QueryActivesResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryActivesResponse.ProtoReflect.Descriptor instead.
 func (*QueryActivesResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{5}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *QueryActivesResponse) GetActives() []string {
@@ -9591,7 +12090,7 @@ type QueryVoteTargetsRequest struct {
 func (x *QueryVoteTargetsRequest) Reset() {
 	*x = QueryVoteTargetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[6]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9605,7 +12104,7 @@ func (*QueryVoteTargetsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryVoteTargetsRequest.ProtoReflect.Descriptor instead.
 func (*QueryVoteTargetsRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
 // QueryVoteTargetsResponse is response type for the
@@ -9623,7 +12122,7 @@ type QueryVoteTargetsResponse struct {
 func (x *QueryVoteTargetsResponse) Reset() {
 	*x = QueryVoteTargetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[7]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9637,7 +12136,7 @@ func (*QueryVoteTargetsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryVoteTargetsResponse.ProtoReflect.Descriptor instead.
 func (*QueryVoteTargetsResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueryVoteTargetsResponse) GetVoteTargets() []string {
@@ -9661,7 +12160,7 @@ type QueryFeederDelegationRequest struct {
 func (x *QueryFeederDelegationRequest) Reset() {
 	*x = QueryFeederDelegationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[8]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9675,7 +12174,7 @@ func (*QueryFeederDelegationRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryFeederDelegationRequest.ProtoReflect.Descriptor instead.
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *QueryFeederDelegationRequest) GetValidatorAddr() string {
@@ -9699,7 +12198,7 @@ type QueryFeederDelegationResponse struct {
 func (x *QueryFeederDelegationResponse) Reset() {
 	*x = QueryFeederDelegationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[9]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9713,7 +12212,7 @@ func (*QueryFeederDelegationResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryFeederDelegationResponse.ProtoReflect.Descriptor instead.
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryFeederDelegationResponse) GetFeederAddr() string {
@@ -9737,7 +12236,7 @@ type QueryMissCounterRequest struct {
 func (x *QueryMissCounterRequest) Reset() {
 	*x = QueryMissCounterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[10]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9751,7 +12250,7 @@ func (*QueryMissCounterRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryMissCounterRequest.ProtoReflect.Descriptor instead.
 func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QueryMissCounterRequest) GetValidatorAddr() string {
@@ -9775,7 +12274,7 @@ type QueryMissCounterResponse struct {
 func (x *QueryMissCounterResponse) Reset() {
 	*x = QueryMissCounterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[11]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9789,7 +12288,7 @@ func (*QueryMissCounterResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryMissCounterResponse.ProtoReflect.Descriptor instead.
 func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QueryMissCounterResponse) GetMissCounter() uint64 {
@@ -9813,7 +12312,7 @@ type QueryAggregatePrevoteRequest struct {
 func (x *QueryAggregatePrevoteRequest) Reset() {
 	*x = QueryAggregatePrevoteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[12]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9827,7 +12326,7 @@ func (*QueryAggregatePrevoteRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryAggregatePrevoteRequest.ProtoReflect.Descriptor instead.
 func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QueryAggregatePrevoteRequest) GetValidatorAddr() string {
@@ -9852,7 +12351,7 @@ type QueryAggregatePrevoteResponse struct {
 func (x *QueryAggregatePrevoteResponse) Reset() {
 	*x = QueryAggregatePrevoteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[13]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9866,7 +12365,7 @@ func (*QueryAggregatePrevoteResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryAggregatePrevoteResponse.ProtoReflect.Descriptor instead.
 func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryAggregatePrevoteResponse) GetAggregatePrevote() *AggregateExchangeRatePrevote {
@@ -9887,7 +12386,7 @@ type QueryAggregatePrevotesRequest struct {
 func (x *QueryAggregatePrevotesRequest) Reset() {
 	*x = QueryAggregatePrevotesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[14]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9901,7 +12400,7 @@ func (*QueryAggregatePrevotesRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryAggregatePrevotesRequest.ProtoReflect.Descriptor instead.
 func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 // QueryAggregatePrevotesResponse is response type for the
@@ -9919,7 +12418,7 @@ type QueryAggregatePrevotesResponse struct {
 func (x *QueryAggregatePrevotesResponse) Reset() {
 	*x = QueryAggregatePrevotesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[15]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9933,7 +12432,7 @@ func (*QueryAggregatePrevotesResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryAggregatePrevotesResponse.ProtoReflect.Descriptor instead.
 func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryAggregatePrevotesResponse) GetAggregatePrevotes() []*AggregateExchangeRatePrevote {
@@ -9957,7 +12456,7 @@ type QueryAggregateVoteRequest struct {
 func (x *QueryAggregateVoteRequest) Reset() {
 	*x = QueryAggregateVoteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[16]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9971,7 +12470,7 @@ func (*QueryAggregateVoteRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryAggregateVoteRequest.ProtoReflect.Descriptor instead.
 func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QueryAggregateVoteRequest) GetValidatorAddr() string {
@@ -9996,7 +12495,7 @@ type QueryAggregateVoteResponse struct {
 func (x *QueryAggregateVoteResponse) Reset() {
 	*x = QueryAggregateVoteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[17]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10010,7 +12509,7 @@ func (*QueryAggregateVoteResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryAggregateVoteResponse.ProtoReflect.Descriptor instead.
 func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryAggregateVoteResponse) GetAggregateVote() *AggregateExchangeRateVote {
@@ -10031,7 +12530,7 @@ type QueryAggregateVotesRequest struct {
 func (x *QueryAggregateVotesRequest) Reset() {
 	*x = QueryAggregateVotesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[18]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10045,7 +12544,7 @@ func (*QueryAggregateVotesRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryAggregateVotesRequest.ProtoReflect.Descriptor instead.
 func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{18}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
 // QueryAggregateVotesResponse is response type for the
@@ -10063,7 +12562,7 @@ type QueryAggregateVotesResponse struct {
 func (x *QueryAggregateVotesResponse) Reset() {
 	*x = QueryAggregateVotesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[19]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10077,7 +12576,7 @@ func (*QueryAggregateVotesResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryAggregateVotesResponse.ProtoReflect.Descriptor instead.
 func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{19}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QueryAggregateVotesResponse) GetAggregateVotes() []*AggregateExchangeRateVote {
@@ -10097,7 +12596,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[20]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10111,7 +12610,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{20}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
 // QueryParamsResponse is the response type for the Query/Params RPC method.
@@ -10127,7 +12626,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[21]
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10141,7 +12640,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{21}
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -10151,6 +12650,127 @@ func (x *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryPairStatusesRequest is the request type for the Query/PairStatuses RPC
+// method.
+type QueryPairStatusesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryPairStatusesRequest) Reset() {
+	*x = QueryPairStatusesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPairStatusesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPairStatusesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPairStatusesRequest.ProtoReflect.Descriptor instead.
+func (*QueryPairStatusesRequest) Descriptor() ([]byte, []int) {
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{24}
+}
+
+// QueryPairStatusesResponse is the response type for the Query/PairStatuses
+// RPC method.
+type QueryPairStatusesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statuses []*PairStatusResult `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (x *QueryPairStatusesResponse) Reset() {
+	*x = QueryPairStatusesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPairStatusesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPairStatusesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPairStatusesResponse.ProtoReflect.Descriptor instead.
+func (*QueryPairStatusesResponse) Descriptor() ([]byte, []int) {
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *QueryPairStatusesResponse) GetStatuses() []*PairStatusResult {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// PairStatusResult is the circuit breaker status of a pair along with the
+// staleness of its current exchange rate.
+type PairStatusResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *PairStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Number of blocks since the current exchange rate was posted.
+	AgeBlocks uint64 `protobuf:"varint,2,opt,name=age_blocks,json=ageBlocks,proto3" json:"age_blocks,omitempty"`
+	// Whether the pair has no exchange rate or it is older than the max age of
+	// the pair.
+	Stale bool `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (x *PairStatusResult) Reset() {
+	*x = PairStatusResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_oracle_v1_query_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairStatusResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairStatusResult) ProtoMessage() {}
+
+// Deprecated: Use PairStatusResult.ProtoReflect.Descriptor instead.
+func (*PairStatusResult) Descriptor() ([]byte, []int) {
+	return file_nibiru_oracle_v1_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PairStatusResult) GetStatus() *PairStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *PairStatusResult) GetAgeBlocks() uint64 {
+	if x != nil {
+		return x.AgeBlocks
+	}
+	return 0
+}
+
+func (x *PairStatusResult) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
 var File_nibiru_oracle_v1_query_proto protoreflect.FileDescriptor
 
 var file_nibiru_oracle_v1_query_proto_rawDesc = []byte{
@@ -10162,265 +12782,322 @@ var file_nibiru_oracle_v1_query_proto_rawDesc = []byte{
 	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61,
 	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x76,
-	0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x04, 0x70, 0x61,
-	0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62,
-	0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f,
-	0x76, 0x32, 0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x3a, 0x08, 0x88, 0xa0,
-	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xc4, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f,
-	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
-	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f,
-	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x1b, 0x0a,
-	0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
-	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x1a, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0e, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
-	0x65, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x42, 0x1a, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x12,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x54, 0x75, 0x70, 0x6c,
-	0x65, 0x73, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x73, 0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x56, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x78, 0x2f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52,
-	0x07, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5f, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x34, 0x67,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x76, 0x0a, 0x18,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x34,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32,
+	0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
+	0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x91, 0x02, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x67, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x61, 0x67, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x22, 0x6f, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x34, 0x67,
 	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75,
 	0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f,
 	0x78, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x50,
-	0x61, 0x69, 0x72, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
-	0x22, 0x4f, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x44,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f,
-	0x00, 0x22, 0x40, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72,
-	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x22, 0x4a, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x73, 0x73,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22,
-	0x3d, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
-	0x69, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x4f,
-	0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22,
-	0x82, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x61, 0x0a, 0x11, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x70,
-	0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x10, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65,
-	0x76, 0x6f, 0x74, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x61, 0x67, 0x67, 0x72,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65,
-	0x76, 0x6f, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x61, 0x67, 0x67, 0x72,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x4c, 0x0a,
-	0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56,
-	0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x76, 0x0a, 0x1a, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x61, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56,
-	0x6f, 0x74, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x79, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5a, 0x0a, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x6f,
-	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x69, 0x62, 0x69,
-	0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
-	0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x61, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x14, 0x0a, 0x12,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x4d, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x69, 0x62, 0x69,
-	0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x32, 0xc2, 0x0f, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x95, 0x01, 0x0a, 0x0c,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x22, 0x73, 0x0a, 0x1c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x45, 0x6d,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x11, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x6d, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x22, 0x1b,
+	0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x1a,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f,
-	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x54, 0x77, 0x61, 0x70, 0x12, 0x2a, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6e, 0x69, 0x62, 0x69,
-	0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f,
-	0x74, 0x77, 0x61, 0x70, 0x12, 0x9f, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x6e, 0x69, 0x62, 0x69,
-	0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x73, 0x12, 0x25, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x69, 0x62, 0x69,
-	0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0e, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x42, 0x1a, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x12, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x54, 0x75, 0x70,
+	0x6c, 0x65, 0x73, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a, 0x14, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x6e, 0x69, 0x62, 0x69,
-	0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12,
-	0x97, 0x01, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12,
-	0x29, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6e, 0x69, 0x62,
+	0x65, 0x12, 0x56, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x34, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x78, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74,
+	0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5f, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x34,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32,
+	0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x73, 0x22, 0x4f, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0,
+	0x1f, 0x00, 0x22, 0x40, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x64, 0x65,
+	0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x22, 0x4a, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x73,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
+	0x22, 0x3d, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x69, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22,
+	0x4f, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
+	0x22, 0x82, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x61, 0x0a, 0x11, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f,
+	0x70, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x10, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x65, 0x76, 0x6f, 0x74, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x65, 0x76, 0x6f, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x4c,
+	0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x76, 0x0a, 0x1a,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x56, 0x6f, 0x74, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x79, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x14, 0x0a,
+	0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x61,
+	0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x67, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x32, 0xff, 0x11, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x95, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x2a, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x10, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x54, 0x77, 0x61, 0x70, 0x12, 0x2a,
+	0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6e, 0x69, 0x62,
 	0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29,
-	0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x76, 0x6f, 0x74,
-	0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0xb6, 0x01, 0x0a, 0x10, 0x46, 0x65,
-	0x65, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e,
+	0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12,
+	0x29, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x77, 0x61, 0x70, 0x12, 0xa2, 0x01, 0x0a, 0x0f, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x12, 0x2d,
 	0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x45, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x6d, 0x61, 0x12,
+	0x9f, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x2b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
 	0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
-	0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x66, 0x65, 0x65, 0x64,
-	0x65, 0x72, 0x12, 0xa5, 0x01, 0x0a, 0x0b, 0x4d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x65, 0x72, 0x12, 0x29, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x73, 0x73, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x69,
+	0x72, 0x73, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x86, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x25, 0x2e,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x69,
+	0x72, 0x73, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x0b, 0x56,
+	0x6f, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x6e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f,
+	0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x12, 0xb6, 0x01, 0x0a, 0x10, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3b, 0x12, 0x39, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x12, 0xa5, 0x01,
+	0x0a, 0x0b, 0x4d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x29, 0x2e,
 	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
 	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x39, 0x12, 0x37, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x6d, 0x69, 0x73, 0x73, 0x12, 0xc1, 0x01, 0x0a, 0x10, 0x41,
-	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12,
-	0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x6e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f,
+	0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d,
+	0x2f, 0x6d, 0x69, 0x73, 0x73, 0x12, 0xc1, 0x01, 0x0a, 0x10, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x2e, 0x2e, 0x6e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x46, 0x12, 0x44, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0xb4, 0x01, 0x0a, 0x11, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12,
 	0x2f, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
 	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46, 0x12, 0x44, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x61, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0xb4,
-	0x01, 0x0a, 0x11, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76,
-	0x6f, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12,
-	0x34, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x2f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x65,
-	0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0xb5, 0x01, 0x0a, 0x0d, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x41, 0x2f, 0x6e, 0x69, 0x62,
-	0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x64, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x61,
-	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x12, 0xa8, 0x01,
-	0x0a, 0x0e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73,
-	0x12, 0x2c, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x30, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
 	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
-	0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f,
+	0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x6e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0xb5, 0x01, 0x0a, 0x0d, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x2b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x41, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f,
 	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x7c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x24, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
-	0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xb0, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42,
-	0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31,
-	0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x4f, 0x58, 0xaa,
-	0x02, 0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6c, 0x64, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x0e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x6e, 0x69,
+	0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33,
+	0x12, 0x31, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x2f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x6f,
+	0x74, 0x65, 0x73, 0x12, 0x7c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x2e,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x95, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x12, 0x2a, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x69, 0x72,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x42, 0xb0, 0x01, 0x0a, 0x14, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e,
+	0x4f, 0x58, 0xaa, 0x02, 0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x4e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75,
+	0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10435,71 +13112,85 @@ func file_nibiru_oracle_v1_query_proto_rawDescGZIP() []byte {
 	return file_nibiru_oracle_v1_query_proto_rawDescData
 }
 
-var file_nibiru_oracle_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_nibiru_oracle_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_nibiru_oracle_v1_query_proto_goTypes = []interface{}{
 	(*QueryExchangeRateRequest)(nil),       // 0: nibiru.oracle.v1.QueryExchangeRateRequest
 	(*QueryExchangeRateResponse)(nil),      // 1: nibiru.oracle.v1.QueryExchangeRateResponse
-	(*QueryExchangeRatesRequest)(nil),      // 2: nibiru.oracle.v1.QueryExchangeRatesRequest
-	(*QueryExchangeRatesResponse)(nil),     // 3: nibiru.oracle.v1.QueryExchangeRatesResponse
-	(*QueryActivesRequest)(nil),            // 4: nibiru.oracle.v1.QueryActivesRequest
-	(*QueryActivesResponse)(nil),           // 5: nibiru.oracle.v1.QueryActivesResponse
-	(*QueryVoteTargetsRequest)(nil),        // 6: nibiru.oracle.v1.QueryVoteTargetsRequest
-	(*QueryVoteTargetsResponse)(nil),       // 7: nibiru.oracle.v1.QueryVoteTargetsResponse
-	(*QueryFeederDelegationRequest)(nil),   // 8: nibiru.oracle.v1.QueryFeederDelegationRequest
-	(*QueryFeederDelegationResponse)(nil),  // 9: nibiru.oracle.v1.QueryFeederDelegationResponse
-	(*QueryMissCounterRequest)(nil),        // 10: nibiru.oracle.v1.QueryMissCounterRequest
-	(*QueryMissCounterResponse)(nil),       // 11: nibiru.oracle.v1.QueryMissCounterResponse
-	(*QueryAggregatePrevoteRequest)(nil),   // 12: nibiru.oracle.v1.QueryAggregatePrevoteRequest
-	(*QueryAggregatePrevoteResponse)(nil),  // 13: nibiru.oracle.v1.QueryAggregatePrevoteResponse
-	(*QueryAggregatePrevotesRequest)(nil),  // 14: nibiru.oracle.v1.QueryAggregatePrevotesRequest
-	(*QueryAggregatePrevotesResponse)(nil), // 15: nibiru.oracle.v1.QueryAggregatePrevotesResponse
-	(*QueryAggregateVoteRequest)(nil),      // 16: nibiru.oracle.v1.QueryAggregateVoteRequest
-	(*QueryAggregateVoteResponse)(nil),     // 17: nibiru.oracle.v1.QueryAggregateVoteResponse
-	(*QueryAggregateVotesRequest)(nil),     // 18: nibiru.oracle.v1.QueryAggregateVotesRequest
-	(*QueryAggregateVotesResponse)(nil),    // 19: nibiru.oracle.v1.QueryAggregateVotesResponse
-	(*QueryParamsRequest)(nil),             // 20: nibiru.oracle.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),            // 21: nibiru.oracle.v1.QueryParamsResponse
-	(*ExchangeRateTuple)(nil),              // 22: nibiru.oracle.v1.ExchangeRateTuple
-	(*AggregateExchangeRatePrevote)(nil),   // 23: nibiru.oracle.v1.AggregateExchangeRatePrevote
-	(*AggregateExchangeRateVote)(nil),      // 24: nibiru.oracle.v1.AggregateExchangeRateVote
-	(*Params)(nil),                         // 25: nibiru.oracle.v1.Params
+	(*QueryExchangeRateEmaRequest)(nil),    // 2: nibiru.oracle.v1.QueryExchangeRateEmaRequest
+	(*QueryExchangeRateEmaResponse)(nil),   // 3: nibiru.oracle.v1.QueryExchangeRateEmaResponse
+	(*QueryExchangeRatesRequest)(nil),      // 4: nibiru.oracle.v1.QueryExchangeRatesRequest
+	(*QueryExchangeRatesResponse)(nil),     // 5: nibiru.oracle.v1.QueryExchangeRatesResponse
+	(*QueryActivesRequest)(nil),            // 6: nibiru.oracle.v1.QueryActivesRequest
+	(*QueryActivesResponse)(nil),           // 7: nibiru.oracle.v1.QueryActivesResponse
+	(*QueryVoteTargetsRequest)(nil),        // 8: nibiru.oracle.v1.QueryVoteTargetsRequest
+	(*QueryVoteTargetsResponse)(nil),       // 9: nibiru.oracle.v1.QueryVoteTargetsResponse
+	(*QueryFeederDelegationRequest)(nil),   // 10: nibiru.oracle.v1.QueryFeederDelegationRequest
+	(*QueryFeederDelegationResponse)(nil),  // 11: nibiru.oracle.v1.QueryFeederDelegationResponse
+	(*QueryMissCounterRequest)(nil),        // 12: nibiru.oracle.v1.QueryMissCounterRequest
+	(*QueryMissCounterResponse)(nil),       // 13: nibiru.oracle.v1.QueryMissCounterResponse
+	(*QueryAggregatePrevoteRequest)(nil),   // 14: nibiru.oracle.v1.QueryAggregatePrevoteRequest
+	(*QueryAggregatePrevoteResponse)(nil),  // 15: nibiru.oracle.v1.QueryAggregatePrevoteResponse
+	(*QueryAggregatePrevotesRequest)(nil),  // 16: nibiru.oracle.v1.QueryAggregatePrevotesRequest
+	(*QueryAggregatePrevotesResponse)(nil), // 17: nibiru.oracle.v1.QueryAggregatePrevotesResponse
+	(*QueryAggregateVoteRequest)(nil),      // 18: nibiru.oracle.v1.QueryAggregateVoteRequest
+	(*QueryAggregateVoteResponse)(nil),     // 19: nibiru.oracle.v1.QueryAggregateVoteResponse
+	(*QueryAggregateVotesRequest)(nil),     // 20: nibiru.oracle.v1.QueryAggregateVotesRequest
+	(*QueryAggregateVotesResponse)(nil),    // 21: nibiru.oracle.v1.QueryAggregateVotesResponse
+	(*QueryParamsRequest)(nil),             // 22: nibiru.oracle.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),            // 23: nibiru.oracle.v1.QueryParamsResponse
+	(*QueryPairStatusesRequest)(nil),       // 24: nibiru.oracle.v1.QueryPairStatusesRequest
+	(*QueryPairStatusesResponse)(nil),      // 25: nibiru.oracle.v1.QueryPairStatusesResponse
+	(*PairStatusResult)(nil),               // 26: nibiru.oracle.v1.PairStatusResult
+	(*ExchangeRateEma)(nil),                // 27: nibiru.oracle.v1.ExchangeRateEma
+	(*ExchangeRateTuple)(nil),              // 28: nibiru.oracle.v1.ExchangeRateTuple
+	(*AggregateExchangeRatePrevote)(nil),   // 29: nibiru.oracle.v1.AggregateExchangeRatePrevote
+	(*AggregateExchangeRateVote)(nil),      // 30: nibiru.oracle.v1.AggregateExchangeRateVote
+	(*Params)(nil),                         // 31: nibiru.oracle.v1.Params
+	(*PairStatus)(nil),                     // 32: nibiru.oracle.v1.PairStatus
 }
 var file_nibiru_oracle_v1_query_proto_depIdxs = []int32{
-	22, // 0: nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates:type_name -> nibiru.oracle.v1.ExchangeRateTuple
-	23, // 1: nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote:type_name -> nibiru.oracle.v1.AggregateExchangeRatePrevote
-	23, // 2: nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes:type_name -> nibiru.oracle.v1.AggregateExchangeRatePrevote
-	24, // 3: nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote:type_name -> nibiru.oracle.v1.AggregateExchangeRateVote
-	24, // 4: nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes:type_name -> nibiru.oracle.v1.AggregateExchangeRateVote
-	25, // 5: nibiru.oracle.v1.QueryParamsResponse.params:type_name -> nibiru.oracle.v1.Params
-	0,  // 6: nibiru.oracle.v1.Query.ExchangeRate:input_type -> nibiru.oracle.v1.QueryExchangeRateRequest
-	0,  // 7: nibiru.oracle.v1.Query.ExchangeRateTwap:input_type -> nibiru.oracle.v1.QueryExchangeRateRequest
-	2,  // 8: nibiru.oracle.v1.Query.ExchangeRates:input_type -> nibiru.oracle.v1.QueryExchangeRatesRequest
-	4,  // 9: nibiru.oracle.v1.Query.Actives:input_type -> nibiru.oracle.v1.QueryActivesRequest
-	6,  // 10: nibiru.oracle.v1.Query.VoteTargets:input_type -> nibiru.oracle.v1.QueryVoteTargetsRequest
-	8,  // 11: nibiru.oracle.v1.Query.FeederDelegation:input_type -> nibiru.oracle.v1.QueryFeederDelegationRequest
-	10, // 12: nibiru.oracle.v1.Query.MissCounter:input_type -> nibiru.oracle.v1.QueryMissCounterRequest
-	12, // 13: nibiru.oracle.v1.Query.AggregatePrevote:input_type -> nibiru.oracle.v1.QueryAggregatePrevoteRequest
-	14, // 14: nibiru.oracle.v1.Query.AggregatePrevotes:input_type -> nibiru.oracle.v1.QueryAggregatePrevotesRequest
-	16, // 15: nibiru.oracle.v1.Query.AggregateVote:input_type -> nibiru.oracle.v1.QueryAggregateVoteRequest
-	18, // 16: nibiru.oracle.v1.Query.AggregateVotes:input_type -> nibiru.oracle.v1.QueryAggregateVotesRequest
-	20, // 17: nibiru.oracle.v1.Query.Params:input_type -> nibiru.oracle.v1.QueryParamsRequest
-	1,  // 18: nibiru.oracle.v1.Query.ExchangeRate:output_type -> nibiru.oracle.v1.QueryExchangeRateResponse
-	1,  // 19: nibiru.oracle.v1.Query.ExchangeRateTwap:output_type -> nibiru.oracle.v1.QueryExchangeRateResponse
-	3,  // 20: nibiru.oracle.v1.Query.ExchangeRates:output_type -> nibiru.oracle.v1.QueryExchangeRatesResponse
-	5,  // 21: nibiru.oracle.v1.Query.Actives:output_type -> nibiru.oracle.v1.QueryActivesResponse
-	7,  // 22: nibiru.oracle.v1.Query.VoteTargets:output_type -> nibiru.oracle.v1.QueryVoteTargetsResponse
-	9,  // 23: nibiru.oracle.v1.Query.FeederDelegation:output_type -> nibiru.oracle.v1.QueryFeederDelegationResponse
-	11, // 24: nibiru.oracle.v1.Query.MissCounter:output_type -> nibiru.oracle.v1.QueryMissCounterResponse
-	13, // 25: nibiru.oracle.v1.Query.AggregatePrevote:output_type -> nibiru.oracle.v1.QueryAggregatePrevoteResponse
-	15, // 26: nibiru.oracle.v1.Query.AggregatePrevotes:output_type -> nibiru.oracle.v1.QueryAggregatePrevotesResponse
-	17, // 27: nibiru.oracle.v1.Query.AggregateVote:output_type -> nibiru.oracle.v1.QueryAggregateVoteResponse
-	19, // 28: nibiru.oracle.v1.Query.AggregateVotes:output_type -> nibiru.oracle.v1.QueryAggregateVotesResponse
-	21, // 29: nibiru.oracle.v1.Query.Params:output_type -> nibiru.oracle.v1.QueryParamsResponse
-	18, // [18:30] is the sub-list for method output_type
-	6,  // [6:18] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	27, // 0: nibiru.oracle.v1.QueryExchangeRateEmaResponse.exchange_rate_ema:type_name -> nibiru.oracle.v1.ExchangeRateEma
+	28, // 1: nibiru.oracle.v1.QueryExchangeRatesResponse.exchange_rates:type_name -> nibiru.oracle.v1.ExchangeRateTuple
+	29, // 2: nibiru.oracle.v1.QueryAggregatePrevoteResponse.aggregate_prevote:type_name -> nibiru.oracle.v1.AggregateExchangeRatePrevote
+	29, // 3: nibiru.oracle.v1.QueryAggregatePrevotesResponse.aggregate_prevotes:type_name -> nibiru.oracle.v1.AggregateExchangeRatePrevote
+	30, // 4: nibiru.oracle.v1.QueryAggregateVoteResponse.aggregate_vote:type_name -> nibiru.oracle.v1.AggregateExchangeRateVote
+	30, // 5: nibiru.oracle.v1.QueryAggregateVotesResponse.aggregate_votes:type_name -> nibiru.oracle.v1.AggregateExchangeRateVote
+	31, // 6: nibiru.oracle.v1.QueryParamsResponse.params:type_name -> nibiru.oracle.v1.Params
+	26, // 7: nibiru.oracle.v1.QueryPairStatusesResponse.statuses:type_name -> nibiru.oracle.v1.PairStatusResult
+	32, // 8: nibiru.oracle.v1.PairStatusResult.status:type_name -> nibiru.oracle.v1.PairStatus
+	0,  // 9: nibiru.oracle.v1.Query.ExchangeRate:input_type -> nibiru.oracle.v1.QueryExchangeRateRequest
+	0,  // 10: nibiru.oracle.v1.Query.ExchangeRateTwap:input_type -> nibiru.oracle.v1.QueryExchangeRateRequest
+	2,  // 11: nibiru.oracle.v1.Query.ExchangeRateEma:input_type -> nibiru.oracle.v1.QueryExchangeRateEmaRequest
+	4,  // 12: nibiru.oracle.v1.Query.ExchangeRates:input_type -> nibiru.oracle.v1.QueryExchangeRatesRequest
+	6,  // 13: nibiru.oracle.v1.Query.Actives:input_type -> nibiru.oracle.v1.QueryActivesRequest
+	8,  // 14: nibiru.oracle.v1.Query.VoteTargets:input_type -> nibiru.oracle.v1.QueryVoteTargetsRequest
+	10, // 15: nibiru.oracle.v1.Query.FeederDelegation:input_type -> nibiru.oracle.v1.QueryFeederDelegationRequest
+	12, // 16: nibiru.oracle.v1.Query.MissCounter:input_type -> nibiru.oracle.v1.QueryMissCounterRequest
+	14, // 17: nibiru.oracle.v1.Query.AggregatePrevote:input_type -> nibiru.oracle.v1.QueryAggregatePrevoteRequest
+	16, // 18: nibiru.oracle.v1.Query.AggregatePrevotes:input_type -> nibiru.oracle.v1.QueryAggregatePrevotesRequest
+	18, // 19: nibiru.oracle.v1.Query.AggregateVote:input_type -> nibiru.oracle.v1.QueryAggregateVoteRequest
+	20, // 20: nibiru.oracle.v1.Query.AggregateVotes:input_type -> nibiru.oracle.v1.QueryAggregateVotesRequest
+	22, // 21: nibiru.oracle.v1.Query.Params:input_type -> nibiru.oracle.v1.QueryParamsRequest
+	24, // 22: nibiru.oracle.v1.Query.PairStatuses:input_type -> nibiru.oracle.v1.QueryPairStatusesRequest
+	1,  // 23: nibiru.oracle.v1.Query.ExchangeRate:output_type -> nibiru.oracle.v1.QueryExchangeRateResponse
+	1,  // 24: nibiru.oracle.v1.Query.ExchangeRateTwap:output_type -> nibiru.oracle.v1.QueryExchangeRateResponse
+	3,  // 25: nibiru.oracle.v1.Query.ExchangeRateEma:output_type -> nibiru.oracle.v1.QueryExchangeRateEmaResponse
+	5,  // 26: nibiru.oracle.v1.Query.ExchangeRates:output_type -> nibiru.oracle.v1.QueryExchangeRatesResponse
+	7,  // 27: nibiru.oracle.v1.Query.Actives:output_type -> nibiru.oracle.v1.QueryActivesResponse
+	9,  // 28: nibiru.oracle.v1.Query.VoteTargets:output_type -> nibiru.oracle.v1.QueryVoteTargetsResponse
+	11, // 29: nibiru.oracle.v1.Query.FeederDelegation:output_type -> nibiru.oracle.v1.QueryFeederDelegationResponse
+	13, // 30: nibiru.oracle.v1.Query.MissCounter:output_type -> nibiru.oracle.v1.QueryMissCounterResponse
+	15, // 31: nibiru.oracle.v1.Query.AggregatePrevote:output_type -> nibiru.oracle.v1.QueryAggregatePrevoteResponse
+	17, // 32: nibiru.oracle.v1.Query.AggregatePrevotes:output_type -> nibiru.oracle.v1.QueryAggregatePrevotesResponse
+	19, // 33: nibiru.oracle.v1.Query.AggregateVote:output_type -> nibiru.oracle.v1.QueryAggregateVoteResponse
+	21, // 34: nibiru.oracle.v1.Query.AggregateVotes:output_type -> nibiru.oracle.v1.QueryAggregateVotesResponse
+	23, // 35: nibiru.oracle.v1.Query.Params:output_type -> nibiru.oracle.v1.QueryParamsResponse
+	25, // 36: nibiru.oracle.v1.Query.PairStatuses:output_type -> nibiru.oracle.v1.QueryPairStatusesResponse
+	23, // [23:37] is the sub-list for method output_type
+	9,  // [9:23] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_nibiru_oracle_v1_query_proto_init() }
@@ -10508,6 +13199,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 		return
 	}
 	file_nibiru_oracle_v1_oracle_proto_init()
+	file_nibiru_oracle_v1_state_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_nibiru_oracle_v1_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryExchangeRateRequest); i {
@@ -10534,7 +13226,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryExchangeRatesRequest); i {
+			switch v := v.(*QueryExchangeRateEmaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10546,7 +13238,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryExchangeRatesResponse); i {
+			switch v := v.(*QueryExchangeRateEmaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10558,7 +13250,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActivesRequest); i {
+			switch v := v.(*QueryExchangeRatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10570,7 +13262,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActivesResponse); i {
+			switch v := v.(*QueryExchangeRatesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10582,7 +13274,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVoteTargetsRequest); i {
+			switch v := v.(*QueryActivesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10594,7 +13286,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVoteTargetsResponse); i {
+			switch v := v.(*QueryActivesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10606,7 +13298,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFeederDelegationRequest); i {
+			switch v := v.(*QueryVoteTargetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10618,7 +13310,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFeederDelegationResponse); i {
+			switch v := v.(*QueryVoteTargetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10630,7 +13322,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryMissCounterRequest); i {
+			switch v := v.(*QueryFeederDelegationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10642,7 +13334,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryMissCounterResponse); i {
+			switch v := v.(*QueryFeederDelegationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10654,7 +13346,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAggregatePrevoteRequest); i {
+			switch v := v.(*QueryMissCounterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10666,7 +13358,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAggregatePrevoteResponse); i {
+			switch v := v.(*QueryMissCounterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10678,7 +13370,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAggregatePrevotesRequest); i {
+			switch v := v.(*QueryAggregatePrevoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10690,7 +13382,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAggregatePrevotesResponse); i {
+			switch v := v.(*QueryAggregatePrevoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10702,7 +13394,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAggregateVoteRequest); i {
+			switch v := v.(*QueryAggregatePrevotesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10714,7 +13406,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAggregateVoteResponse); i {
+			switch v := v.(*QueryAggregatePrevotesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10726,7 +13418,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAggregateVotesRequest); i {
+			switch v := v.(*QueryAggregateVoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10738,7 +13430,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAggregateVotesResponse); i {
+			switch v := v.(*QueryAggregateVoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10750,7 +13442,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryAggregateVotesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10762,6 +13454,30 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAggregateVotesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_oracle_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_oracle_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
@@ -10773,6 +13489,42 @@ func file_nibiru_oracle_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_nibiru_oracle_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPairStatusesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_oracle_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPairStatusesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_oracle_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairStatusResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -10780,7 +13532,7 @@ func file_nibiru_oracle_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nibiru_oracle_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
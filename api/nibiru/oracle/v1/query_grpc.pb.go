@@ -27,6 +27,9 @@ type QueryClient interface {
 	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
 	// ExchangeRateTwap returns twap exchange rate of a pair
 	ExchangeRateTwap(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// ExchangeRateEma returns the exponentially weighted moving averages of the
+	// exchange rate of a pair, one for each half-life in the params.
+	ExchangeRateEma(ctx context.Context, in *QueryExchangeRateEmaRequest, opts ...grpc.CallOption) (*QueryExchangeRateEmaResponse, error)
 	// ExchangeRates returns exchange rates of all pairs
 	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
 	// Actives returns all active pairs
@@ -47,6 +50,9 @@ type QueryClient interface {
 	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PairStatuses returns the circuit breaker status, last good price, and
+	// staleness of every whitelisted pair.
+	PairStatuses(ctx context.Context, in *QueryPairStatusesRequest, opts ...grpc.CallOption) (*QueryPairStatusesResponse, error)
 }
 
 type queryClient struct {
@@ -75,6 +81,15 @@ func (c *queryClient) ExchangeRateTwap(ctx context.Context, in *QueryExchangeRat
 	return out, nil
 }
 
+func (c *queryClient) ExchangeRateEma(ctx context.Context, in *QueryExchangeRateEmaRequest, opts ...grpc.CallOption) (*QueryExchangeRateEmaResponse, error) {
+	out := new(QueryExchangeRateEmaResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ExchangeRateEma", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
 	out := new(QueryExchangeRatesResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ExchangeRates", in, out, opts...)
@@ -165,6 +180,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PairStatuses(ctx context.Context, in *QueryPairStatusesRequest, opts ...grpc.CallOption) (*QueryPairStatusesResponse, error) {
+	out := new(QueryPairStatusesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/PairStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -174,6 +198,9 @@ type QueryServer interface {
 	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
 	// ExchangeRateTwap returns twap exchange rate of a pair
 	ExchangeRateTwap(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// ExchangeRateEma returns the exponentially weighted moving averages of the
+	// exchange rate of a pair, one for each half-life in the params.
+	ExchangeRateEma(context.Context, *QueryExchangeRateEmaRequest) (*QueryExchangeRateEmaResponse, error)
 	// ExchangeRates returns exchange rates of all pairs
 	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
 	// Actives returns all active pairs
@@ -194,6 +221,9 @@ type QueryServer interface {
 	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PairStatuses returns the circuit breaker status, last good price, and
+	// staleness of every whitelisted pair.
+	PairStatuses(context.Context, *QueryPairStatusesRequest) (*QueryPairStatusesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -207,6 +237,9 @@ func (UnimplementedQueryServer) ExchangeRate(context.Context, *QueryExchangeRate
 func (UnimplementedQueryServer) ExchangeRateTwap(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateTwap not implemented")
 }
+func (UnimplementedQueryServer) ExchangeRateEma(context.Context, *QueryExchangeRateEmaRequest) (*QueryExchangeRateEmaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateEma not implemented")
+}
 func (UnimplementedQueryServer) ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
 }
@@ -237,6 +270,9 @@ func (UnimplementedQueryServer) AggregateVotes(context.Context, *QueryAggregateV
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) PairStatuses(context.Context, *QueryPairStatusesRequest) (*QueryPairStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairStatuses not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -286,6 +322,24 @@ func _Query_ExchangeRateTwap_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExchangeRateEma_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateEmaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateEma(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/ExchangeRateEma",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateEma(ctx, req.(*QueryExchangeRateEmaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryExchangeRatesRequest)
 	if err := dec(in); err != nil {
@@ -466,6 +520,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PairStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPairStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PairStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/PairStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PairStatuses(ctx, req.(*QueryPairStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -481,6 +553,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExchangeRateTwap",
 			Handler:    _Query_ExchangeRateTwap_Handler,
 		},
+		{
+			MethodName: "ExchangeRateEma",
+			Handler:    _Query_ExchangeRateEma_Handler,
+		},
 		{
 			MethodName: "ExchangeRates",
 			Handler:    _Query_ExchangeRates_Handler,
@@ -521,6 +597,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PairStatuses",
+			Handler:    _Query_PairStatuses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/oracle/v1/query.proto",
@@ -33,6 +33,10 @@ type MsgClient interface {
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(ctx context.Context, in *MsgEditOracleParams, opts ...grpc.CallOption) (*MsgEditOracleParamsResponse, error)
+	// ResumePair lifts the circuit breaker of a halted pair and accepts its
+	// current exchange rate as the new last good price.
+	// [SUDO] Only callable by sudoers.
+	ResumePair(ctx context.Context, in *MsgResumePair, opts ...grpc.CallOption) (*MsgResumePairResponse, error)
 }
 
 type msgClient struct {
@@ -79,6 +83,15 @@ func (c *msgClient) EditOracleParams(ctx context.Context, in *MsgEditOracleParam
 	return out, nil
 }
 
+func (c *msgClient) ResumePair(ctx context.Context, in *MsgResumePair, opts ...grpc.CallOption) (*MsgResumePairResponse, error) {
+	out := new(MsgResumePairResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/ResumePair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -94,6 +107,10 @@ type MsgServer interface {
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(context.Context, *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error)
+	// ResumePair lifts the circuit breaker of a halted pair and accepts its
+	// current exchange rate as the new last good price.
+	// [SUDO] Only callable by sudoers.
+	ResumePair(context.Context, *MsgResumePair) (*MsgResumePairResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -113,6 +130,9 @@ func (UnimplementedMsgServer) DelegateFeedConsent(context.Context, *MsgDelegateF
 func (UnimplementedMsgServer) EditOracleParams(context.Context, *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditOracleParams not implemented")
 }
+func (UnimplementedMsgServer) ResumePair(context.Context, *MsgResumePair) (*MsgResumePairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumePair not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -198,6 +218,24 @@ func _Msg_EditOracleParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ResumePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumePair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumePair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Msg/ResumePair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumePair(ctx, req.(*MsgResumePair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,6 +259,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditOracleParams",
 			Handler:    _Msg_EditOracleParams_Handler,
 		},
+		{
+			MethodName: "ResumePair",
+			Handler:    _Msg_ResumePair_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/oracle/v1/tx.proto",
@@ -23,6 +23,14 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
 	QuerySudoers(ctx context.Context, in *QuerySudoersRequest, opts ...grpc.CallOption) (*QuerySudoersResponse, error)
+	// QueryRootChanges returns the root change policy and any pending root
+	// changes.
+	QueryRootChanges(ctx context.Context, in *QueryRootChangesRequest, opts ...grpc.CallOption) (*QueryRootChangesResponse, error)
+	// QueryScheduledSudoActions returns the scheduled sudo policy and the sudo
+	// actions waiting for their execution height.
+	QueryScheduledSudoActions(ctx context.Context, in *QueryScheduledSudoActionsRequest, opts ...grpc.CallOption) (*QueryScheduledSudoActionsResponse, error)
+	// QueryCronJobs returns the registered cron jobs.
+	QueryCronJobs(ctx context.Context, in *QueryCronJobsRequest, opts ...grpc.CallOption) (*QueryCronJobsResponse, error)
 }
 
 type queryClient struct {
@@ -42,11 +50,46 @@ func (c *queryClient) QuerySudoers(ctx context.Context, in *QuerySudoersRequest,
 	return out, nil
 }
 
+func (c *queryClient) QueryRootChanges(ctx context.Context, in *QueryRootChangesRequest, opts ...grpc.CallOption) (*QueryRootChangesResponse, error) {
+	out := new(QueryRootChangesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryRootChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryScheduledSudoActions(ctx context.Context, in *QueryScheduledSudoActionsRequest, opts ...grpc.CallOption) (*QueryScheduledSudoActionsResponse, error) {
+	out := new(QueryScheduledSudoActionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryScheduledSudoActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCronJobs(ctx context.Context, in *QueryCronJobsRequest, opts ...grpc.CallOption) (*QueryCronJobsResponse, error) {
+	out := new(QueryCronJobsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryCronJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	QuerySudoers(context.Context, *QuerySudoersRequest) (*QuerySudoersResponse, error)
+	// QueryRootChanges returns the root change policy and any pending root
+	// changes.
+	QueryRootChanges(context.Context, *QueryRootChangesRequest) (*QueryRootChangesResponse, error)
+	// QueryScheduledSudoActions returns the scheduled sudo policy and the sudo
+	// actions waiting for their execution height.
+	QueryScheduledSudoActions(context.Context, *QueryScheduledSudoActionsRequest) (*QueryScheduledSudoActionsResponse, error)
+	// QueryCronJobs returns the registered cron jobs.
+	QueryCronJobs(context.Context, *QueryCronJobsRequest) (*QueryCronJobsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -57,6 +100,15 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) QuerySudoers(context.Context, *QuerySudoersRequest) (*QuerySudoersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuerySudoers not implemented")
 }
+func (UnimplementedQueryServer) QueryRootChanges(context.Context, *QueryRootChangesRequest) (*QueryRootChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRootChanges not implemented")
+}
+func (UnimplementedQueryServer) QueryScheduledSudoActions(context.Context, *QueryScheduledSudoActionsRequest) (*QueryScheduledSudoActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledSudoActions not implemented")
+}
+func (UnimplementedQueryServer) QueryCronJobs(context.Context, *QueryCronJobsRequest) (*QueryCronJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCronJobs not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +140,60 @@ func _Query_QuerySudoers_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryRootChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRootChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRootChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryRootChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRootChanges(ctx, req.(*QueryRootChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScheduledSudoActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledSudoActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScheduledSudoActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryScheduledSudoActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScheduledSudoActions(ctx, req.(*QueryScheduledSudoActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCronJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCronJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCronJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryCronJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCronJobs(ctx, req.(*QueryCronJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,6 +205,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QuerySudoers",
 			Handler:    _Query_QuerySudoers_Handler,
 		},
+		{
+			MethodName: "QueryRootChanges",
+			Handler:    _Query_QueryRootChanges_Handler,
+		},
+		{
+			MethodName: "QueryScheduledSudoActions",
+			Handler:    _Query_QueryScheduledSudoActions_Handler,
+		},
+		{
+			MethodName: "QueryCronJobs",
+			Handler:    _Query_QueryCronJobs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/sudo/v1/query.proto",
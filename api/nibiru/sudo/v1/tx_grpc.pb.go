@@ -25,6 +25,24 @@ type MsgClient interface {
 	// EditSudoers updates the "Sudoers" state
 	EditSudoers(ctx context.Context, in *MsgEditSudoers, opts ...grpc.CallOption) (*MsgEditSudoersResponse, error)
 	ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...grpc.CallOption) (*MsgChangeRootResponse, error)
+	// ProposeRoot starts a timelocked change of the root user.
+	ProposeRoot(ctx context.Context, in *MsgProposeRoot, opts ...grpc.CallOption) (*MsgProposeRootResponse, error)
+	// ApproveRoot records a co-signer approval for a pending root change.
+	ApproveRoot(ctx context.Context, in *MsgApproveRoot, opts ...grpc.CallOption) (*MsgApproveRootResponse, error)
+	// CancelRootChange discards a pending root change.
+	CancelRootChange(ctx context.Context, in *MsgCancelRootChange, opts ...grpc.CallOption) (*MsgCancelRootChangeResponse, error)
+	// ScheduleSudo queues sudo messages for execution at a future block height.
+	ScheduleSudo(ctx context.Context, in *MsgScheduleSudo, opts ...grpc.CallOption) (*MsgScheduleSudoResponse, error)
+	// CancelScheduledSudo discards a scheduled sudo action.
+	CancelScheduledSudo(ctx context.Context, in *MsgCancelScheduledSudo, opts ...grpc.CallOption) (*MsgCancelScheduledSudoResponse, error)
+	// UpdateScheduledSudoPolicy replaces the safeguards of scheduled sudo
+	// actions. Only governance can execute it.
+	UpdateScheduledSudoPolicy(ctx context.Context, in *MsgUpdateScheduledSudoPolicy, opts ...grpc.CallOption) (*MsgUpdateScheduledSudoPolicyResponse, error)
+	// RegisterCronJob registers messages and EVM and Wasm calls to run at the end of
+	// every epoch of an epoch identifier.
+	RegisterCronJob(ctx context.Context, in *MsgRegisterCronJob, opts ...grpc.CallOption) (*MsgRegisterCronJobResponse, error)
+	// DeleteCronJob removes a cron job.
+	DeleteCronJob(ctx context.Context, in *MsgDeleteCronJob, opts ...grpc.CallOption) (*MsgDeleteCronJobResponse, error)
 }
 
 type msgClient struct {
@@ -53,6 +71,78 @@ func (c *msgClient) ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ProposeRoot(ctx context.Context, in *MsgProposeRoot, opts ...grpc.CallOption) (*MsgProposeRootResponse, error) {
+	out := new(MsgProposeRootResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/ProposeRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveRoot(ctx context.Context, in *MsgApproveRoot, opts ...grpc.CallOption) (*MsgApproveRootResponse, error) {
+	out := new(MsgApproveRootResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/ApproveRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRootChange(ctx context.Context, in *MsgCancelRootChange, opts ...grpc.CallOption) (*MsgCancelRootChangeResponse, error) {
+	out := new(MsgCancelRootChangeResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/CancelRootChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleSudo(ctx context.Context, in *MsgScheduleSudo, opts ...grpc.CallOption) (*MsgScheduleSudoResponse, error) {
+	out := new(MsgScheduleSudoResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/ScheduleSudo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledSudo(ctx context.Context, in *MsgCancelScheduledSudo, opts ...grpc.CallOption) (*MsgCancelScheduledSudoResponse, error) {
+	out := new(MsgCancelScheduledSudoResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/CancelScheduledSudo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateScheduledSudoPolicy(ctx context.Context, in *MsgUpdateScheduledSudoPolicy, opts ...grpc.CallOption) (*MsgUpdateScheduledSudoPolicyResponse, error) {
+	out := new(MsgUpdateScheduledSudoPolicyResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/UpdateScheduledSudoPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterCronJob(ctx context.Context, in *MsgRegisterCronJob, opts ...grpc.CallOption) (*MsgRegisterCronJobResponse, error) {
+	out := new(MsgRegisterCronJobResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/RegisterCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteCronJob(ctx context.Context, in *MsgDeleteCronJob, opts ...grpc.CallOption) (*MsgDeleteCronJobResponse, error) {
+	out := new(MsgDeleteCronJobResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/DeleteCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -60,6 +150,24 @@ type MsgServer interface {
 	// EditSudoers updates the "Sudoers" state
 	EditSudoers(context.Context, *MsgEditSudoers) (*MsgEditSudoersResponse, error)
 	ChangeRoot(context.Context, *MsgChangeRoot) (*MsgChangeRootResponse, error)
+	// ProposeRoot starts a timelocked change of the root user.
+	ProposeRoot(context.Context, *MsgProposeRoot) (*MsgProposeRootResponse, error)
+	// ApproveRoot records a co-signer approval for a pending root change.
+	ApproveRoot(context.Context, *MsgApproveRoot) (*MsgApproveRootResponse, error)
+	// CancelRootChange discards a pending root change.
+	CancelRootChange(context.Context, *MsgCancelRootChange) (*MsgCancelRootChangeResponse, error)
+	// ScheduleSudo queues sudo messages for execution at a future block height.
+	ScheduleSudo(context.Context, *MsgScheduleSudo) (*MsgScheduleSudoResponse, error)
+	// CancelScheduledSudo discards a scheduled sudo action.
+	CancelScheduledSudo(context.Context, *MsgCancelScheduledSudo) (*MsgCancelScheduledSudoResponse, error)
+	// UpdateScheduledSudoPolicy replaces the safeguards of scheduled sudo
+	// actions. Only governance can execute it.
+	UpdateScheduledSudoPolicy(context.Context, *MsgUpdateScheduledSudoPolicy) (*MsgUpdateScheduledSudoPolicyResponse, error)
+	// RegisterCronJob registers messages and EVM and Wasm calls to run at the end of
+	// every epoch of an epoch identifier.
+	RegisterCronJob(context.Context, *MsgRegisterCronJob) (*MsgRegisterCronJobResponse, error)
+	// DeleteCronJob removes a cron job.
+	DeleteCronJob(context.Context, *MsgDeleteCronJob) (*MsgDeleteCronJobResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -73,6 +181,30 @@ func (UnimplementedMsgServer) EditSudoers(context.Context, *MsgEditSudoers) (*Ms
 func (UnimplementedMsgServer) ChangeRoot(context.Context, *MsgChangeRoot) (*MsgChangeRootResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeRoot not implemented")
 }
+func (UnimplementedMsgServer) ProposeRoot(context.Context, *MsgProposeRoot) (*MsgProposeRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeRoot not implemented")
+}
+func (UnimplementedMsgServer) ApproveRoot(context.Context, *MsgApproveRoot) (*MsgApproveRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRoot not implemented")
+}
+func (UnimplementedMsgServer) CancelRootChange(context.Context, *MsgCancelRootChange) (*MsgCancelRootChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRootChange not implemented")
+}
+func (UnimplementedMsgServer) ScheduleSudo(context.Context, *MsgScheduleSudo) (*MsgScheduleSudoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSudo not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledSudo(context.Context, *MsgCancelScheduledSudo) (*MsgCancelScheduledSudoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledSudo not implemented")
+}
+func (UnimplementedMsgServer) UpdateScheduledSudoPolicy(context.Context, *MsgUpdateScheduledSudoPolicy) (*MsgUpdateScheduledSudoPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledSudoPolicy not implemented")
+}
+func (UnimplementedMsgServer) RegisterCronJob(context.Context, *MsgRegisterCronJob) (*MsgRegisterCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCronJob not implemented")
+}
+func (UnimplementedMsgServer) DeleteCronJob(context.Context, *MsgDeleteCronJob) (*MsgDeleteCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCronJob not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -122,6 +254,150 @@ func _Msg_ChangeRoot_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ProposeRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/ProposeRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeRoot(ctx, req.(*MsgProposeRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/ApproveRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveRoot(ctx, req.(*MsgApproveRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRootChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRootChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRootChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/CancelRootChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRootChange(ctx, req.(*MsgCancelRootChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleSudo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleSudo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleSudo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/ScheduleSudo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleSudo(ctx, req.(*MsgScheduleSudo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledSudo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledSudo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledSudo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/CancelScheduledSudo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledSudo(ctx, req.(*MsgCancelScheduledSudo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateScheduledSudoPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateScheduledSudoPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateScheduledSudoPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/UpdateScheduledSudoPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateScheduledSudoPolicy(ctx, req.(*MsgUpdateScheduledSudoPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/RegisterCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCronJob(ctx, req.(*MsgRegisterCronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteCronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/DeleteCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteCronJob(ctx, req.(*MsgDeleteCronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -137,6 +413,38 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeRoot",
 			Handler:    _Msg_ChangeRoot_Handler,
 		},
+		{
+			MethodName: "ProposeRoot",
+			Handler:    _Msg_ProposeRoot_Handler,
+		},
+		{
+			MethodName: "ApproveRoot",
+			Handler:    _Msg_ApproveRoot_Handler,
+		},
+		{
+			MethodName: "CancelRootChange",
+			Handler:    _Msg_CancelRootChange_Handler,
+		},
+		{
+			MethodName: "ScheduleSudo",
+			Handler:    _Msg_ScheduleSudo_Handler,
+		},
+		{
+			MethodName: "CancelScheduledSudo",
+			Handler:    _Msg_CancelScheduledSudo_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledSudoPolicy",
+			Handler:    _Msg_UpdateScheduledSudoPolicy_Handler,
+		},
+		{
+			MethodName: "RegisterCronJob",
+			Handler:    _Msg_RegisterCronJob_Handler,
+		},
+		{
+			MethodName: "DeleteCronJob",
+			Handler:    _Msg_DeleteCronJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/sudo/v1/tx.proto",
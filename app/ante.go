@@ -37,6 +37,9 @@ func NewAnteHandler(
 				case "/eth.evm.v1.ExtensionOptionsEthereumTx":
 					// handle as *evmtypes.MsgEthereumTx
 					anteHandler = evmante.NewAnteHandlerEVM(options)
+				case "/eth.types.v1.ExtensionOptionsWeb3Tx":
+					// handle as a Cosmos tx signed with EIP-712 typed data
+					anteHandler = NewAnteHandlerEip712(options)
 				default:
 					return ctx, fmt.Errorf(
 						"rejecting tx with unsupported extension option: %s", typeURL)
@@ -61,7 +64,7 @@ func NewAnteHandlerNonEVM(
 	opts ante.AnteHandlerOptions,
 ) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
-		ante.AnteDecoratorPreventEtheruemTxMsgs{}, // reject MsgEthereumTxs
+		ante.AnteDecoratorPreventEthereumTxMsgs{}, // reject MsgEthereumTxs
 		ante.AnteDecoratorAuthzGuard{},            // disable certain messages in authz grant "generic"
 		authante.NewSetUpContextDecorator(),
 		wasmkeeper.NewLimitSimulationGasDecorator(opts.WasmConfig.SimulationGasLimit),
@@ -93,3 +96,39 @@ func NewAnteHandlerNonEVM(
 		ante.AnteDecoratorGasWanted{},
 	)
 }
+
+// NewAnteHandlerEip712: Ante handler for Cosmos transactions with an
+// ExtensionOptionsWeb3Tx, which are signed by an Ethereum wallet with
+// "eth_signTypedData_v4" instead of a Cosmos wallet. It matches the non-EVM
+// ante handler except for signature verification, so that MetaMask users can
+// delegate, vote, and send IBC transfers without a Cosmos wallet.
+func NewAnteHandlerEip712(
+	opts ante.AnteHandlerOptions,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		ante.AnteDecoratorPreventEthereumTxMsgs{}, // reject MsgEthereumTxs
+		ante.AnteDecoratorAuthzGuard{},            // disable certain messages in authz grant "generic"
+		authante.NewSetUpContextDecorator(),
+		wasmkeeper.NewLimitSimulationGasDecorator(opts.WasmConfig.SimulationGasLimit),
+		wasmkeeper.NewCountTXDecorator(opts.TxCounterStoreKey),
+		authante.NewValidateBasicDecorator(),
+		authante.NewTxTimeoutHeightDecorator(),
+		authante.NewValidateMemoDecorator(opts.AccountKeeper),
+		ante.AnteDecoratorEnsureSinglePostPriceMessage{},
+		ante.AnteDecoratorStakingCommission{},
+		// ----------- Ante Handlers: Gas
+		authante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
+		authante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, opts.TxFeeChecker),
+		// ----------- Ante Handlers:  devgas
+		devgasante.NewDevGasPayoutDecorator(opts.DevGasBankKeeper, opts.DevGasKeeper),
+		// ----------- Ante Handlers:  Keys and signatures
+		// NOTE: SetPubKeyDecorator must be called before all signature verification decorators
+		authante.NewSetPubKeyDecorator(opts.AccountKeeper),
+		authante.NewValidateSigCountDecorator(opts.AccountKeeper),
+		authante.NewSigGasConsumeDecorator(opts.AccountKeeper, ante.SigVerificationGasConsumer),
+		ante.NewAnteDecEip712SigVerification(opts.AccountKeeper, opts.EvmKeeper),
+		authante.NewIncrementSequenceDecorator(opts.AccountKeeper),
+		ibcante.NewRedundantRelayDecorator(opts.IBCKeeper),
+		ante.AnteDecoratorGasWanted{},
+	)
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package ante
+
+import (
+	"bytes"
+
+	sdkioerrors "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/ethsecp256k1"
+	"github.com/NibiruChain/nibiru/v2/eth/eip712"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+// AnteDecEip712SigVerification verifies the signature of a Cosmos tx with an
+// ExtensionOptionsWeb3Tx. Instead of signing the SDK sign bytes, the signer
+// used "eth_signTypedData_v4" from an Ethereum wallet, so the signature is
+// checked against the EIP-712 hash of the Amino JSON sign doc. It takes the
+// place of the SDK SigVerificationDecorator in the EIP-712 ante chain.
+type AnteDecEip712SigVerification struct {
+	accountKeeper sdkante.AccountKeeper
+	evmKeeper     *evmkeeper.Keeper
+}
+
+// NewAnteDecEip712SigVerification creates a new AnteDecEip712SigVerification
+func NewAnteDecEip712SigVerification(
+	ak sdkante.AccountKeeper,
+	evmKeeper *evmkeeper.Keeper,
+) AnteDecEip712SigVerification {
+	return AnteDecEip712SigVerification{
+		accountKeeper: ak,
+		evmKeeper:     evmKeeper,
+	}
+}
+
+// AnteHandle checks that the tx has a single signer with an ethsecp256k1 key,
+// that the EIP-712 domain uses the EVM chain ID, and that the signature
+// recovers to the key of the signer. Like the SDK decorator, the key type and
+// the signature are not checked in simulation mode.
+func (svd AnteDecEip712SigVerification) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkioerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	web3Opt, err := getExtensionOptionsWeb3Tx(tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	chainID := svd.evmKeeper.EthChainID(ctx)
+	if !chainID.IsUint64() || web3Opt.TypedDataChainID != chainID.Uint64() {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrInvalidChainID,
+			"EIP-712 typed data chain ID %d does not match the EVM chain ID %s",
+			web3Opt.TypedDataChainID, chainID,
+		)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	signers := sigTx.GetSigners()
+	if len(sigs) != 1 || len(signers) != 1 {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"EIP-712 txs must have exactly one signer and signature, got %d signers and %d signatures",
+			len(signers), len(sigs),
+		)
+	}
+	signer, sig := signers[0], sigs[0]
+
+	if web3Opt.FeePayer != "" && web3Opt.FeePayer != signer.String() {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"fee delegation is not supported for EIP-712 txs: fee payer %s is not the signer %s",
+			web3Opt.FeePayer, signer,
+		)
+	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		if !feeTx.FeePayer().Equals(signer) || feeTx.FeeGranter() != nil {
+			return ctx, sdkioerrors.Wrapf(
+				sdkerrors.ErrUnauthorized,
+				"fee delegation is not supported for EIP-712 txs: fees must be paid by the signer %s",
+				signer,
+			)
+		}
+	}
+
+	acc, err := sdkante.GetSignerAcc(ctx, svd.accountKeeper, signer)
+	if err != nil {
+		return ctx, err
+	}
+	if sig.Sequence != acc.GetSequence() {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrWrongSequence,
+			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+		)
+	}
+
+	sigData, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return ctx, sdkioerrors.Wrap(sdkerrors.ErrNotSupported, "EIP-712 txs do not support multisig")
+	}
+	if sigData.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrNotSupported,
+			"EIP-712 txs must use sign mode %s, got %s",
+			signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, sigData.SignMode,
+		)
+	}
+	if len(web3Opt.FeePayerSig) > 0 && !bytes.Equal(web3Opt.FeePayerSig, sigData.Signature) {
+		return ctx, sdkioerrors.Wrap(
+			sdkerrors.ErrUnauthorized, "fee payer signature does not match the signature of the signer",
+		)
+	}
+
+	// Simulations skip the key type check because the SetPubKeyDecorator gives
+	// fresh accounts a simulated secp256k1 key.
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	pubKey, ok := acc.GetPubKey().(*ethsecp256k1.PubKey)
+	if !ok {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrInvalidPubKey,
+			"EIP-712 txs must be signed with an %s key, got %T", ethsecp256k1.KeyType, acc.GetPubKey(),
+		)
+	}
+
+	// The account number is zero for txs in the genesis block.
+	accNum := acc.GetAccountNumber()
+	if ctx.BlockHeight() == 0 {
+		accNum = 0
+	}
+	signBytes, err := Eip712SignBytes(ctx.ChainID(), accNum, acc.GetSequence(), tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if err := VerifyEip712Signature(pubKey, web3Opt.TypedDataChainID, signBytes, sigData.Signature); err != nil {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"signature verification failed for account number (%d) and chain-id (%s): %s",
+			accNum, ctx.ChainID(), err,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// Eip712SignBytes returns the Amino JSON sign doc of the tx that is wrapped into
+// EIP-712 typed data for signing. The SDK LEGACY_AMINO_JSON sign mode handler
+// can't be used because it rejects txs with extension options. Fees are always
+// paid by the signer, so the fee payer and granter are left out.
+func Eip712SignBytes(chainID string, accNum, sequence uint64, tx sdk.Tx) ([]byte, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+	}
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrTxDecode, "tx must be a TxWithMemo")
+	}
+	var timeoutHeight uint64
+	if timeoutTx, ok := tx.(sdkante.TxWithTimeoutHeight); ok {
+		timeoutHeight = timeoutTx.GetTimeoutHeight()
+	}
+	if tipTx, ok := tx.(authsigning.Tx); ok && tipTx.GetTip() != nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrNotSupported, "EIP-712 txs do not support tips")
+	}
+
+	return legacytx.StdSignBytes(
+		chainID, accNum, sequence, timeoutHeight,
+		legacytx.NewStdFee(feeTx.GetGas(), feeTx.GetFee()),
+		tx.GetMsgs(), memoTx.GetMemo(), nil,
+	), nil
+}
+
+// getExtensionOptionsWeb3Tx returns the ExtensionOptionsWeb3Tx of the tx, which
+// must be its only extension option.
+func getExtensionOptionsWeb3Tx(tx sdk.Tx) (*eth.ExtensionOptionsWeb3Tx, error) {
+	txWithExtensions, ok := tx.(sdkante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrUnknownExtensionOptions, "tx has no extension options")
+	}
+	opts := txWithExtensions.GetExtensionOptions()
+	if len(opts) != 1 {
+		return nil, sdkioerrors.Wrapf(
+			sdkerrors.ErrUnknownExtensionOptions,
+			"EIP-712 txs must have exactly one extension option, got %d", len(opts),
+		)
+	}
+	web3Opt, ok := opts[0].GetCachedValue().(*eth.ExtensionOptionsWeb3Tx)
+	if !ok {
+		return nil, sdkioerrors.Wrapf(
+			sdkerrors.ErrUnknownExtensionOptions,
+			"expected %T, got %s", (*eth.ExtensionOptionsWeb3Tx)(nil), opts[0].GetTypeUrl(),
+		)
+	}
+	return web3Opt, nil
+}
+
+// VerifyEip712Signature checks that an "eth_signTypedData_v4" signature over the
+// EIP-712 typed data of the Amino JSON sign bytes was produced by the key. The
+// signature is in the [R || S || V] format of Ethereum wallets, where V is
+// either 0/1 or 27/28.
+func VerifyEip712Signature(
+	pubKey *ethsecp256k1.PubKey, chainID uint64, signBytes, sig []byte,
+) error {
+	typedData, err := eip712.WrapTxToTypedData(chainID, signBytes)
+	if err != nil {
+		return sdkioerrors.Wrap(err, "failed to create EIP-712 typed data from tx")
+	}
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return sdkioerrors.Wrap(err, "failed to hash EIP-712 typed data")
+	}
+
+	if len(sig) != crypto.SignatureLength {
+		return sdkioerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"invalid signature length %d, expected %d", len(sig), crypto.SignatureLength,
+		)
+	}
+	sig = bytes.Clone(sig)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	recovered, err := crypto.SigToPub(sigHash, sig)
+	if err != nil {
+		return sdkioerrors.Wrap(err, "failed to recover public key from signature")
+	}
+	if !bytes.Equal(crypto.CompressPubkey(recovered), pubKey.Key) {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidPubKey, "signature was not produced by the signer")
+	}
+	if !crypto.VerifySignature(pubKey.Key, sigHash, sig[:crypto.RecoveryIDOffset]) {
+		return sdkioerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signature")
+	}
+	return nil
+}
+
+// SigVerificationGasConsumer consumes gas for signature verification like the
+// SDK default, and additionally supports ethsecp256k1 keys, which the default
+// rejects. Verifying an ethsecp256k1 signature costs as much as a secp256k1
+// signature.
+func SigVerificationGasConsumer(
+	meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params,
+) error {
+	if _, ok := sig.PubKey.(*ethsecp256k1.PubKey); ok {
+		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: eth_secp256k1")
+		return nil
+	}
+	return sdkante.DefaultSigVerificationGasConsumer(meter, sig, params)
+}
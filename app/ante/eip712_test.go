@@ -0,0 +1,225 @@
+package ante_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/app"
+	"github.com/NibiruChain/nibiru/v2/app/ante"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/eip712"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+)
+
+// buildEip712Tx builds a bank send from the sender signed with
+// "eth_signTypedData_v4" over the EIP-712 typed data of its Amino JSON sign
+// doc, the way an Ethereum wallet would.
+func buildEip712Tx(
+	t *testing.T,
+	deps evmtest.TestDeps,
+	web3Opt *eth.ExtensionOptionsWeb3Tx,
+	signMode signing.SignMode,
+	memo string,
+) sdk.Tx {
+	sender := deps.Sender
+	acc := deps.App.AccountKeeper.GetAccount(deps.Ctx, sender.NibiruAddr)
+	txBuilder := deps.App.GetTxConfig().NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(
+		sender.NibiruAddr, testutil.AccAddress(), sdk.NewCoins(sdk.NewInt64Coin("unibi", 1)),
+	)))
+	txBuilder.SetGasLimit(200_000)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)))
+
+	anyOpt, err := codectypes.NewAnyWithValue(web3Opt)
+	require.NoError(t, err)
+	txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(anyOpt)
+
+	sigV2 := signing.SignatureV2{
+		PubKey:   sender.PrivKey.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: acc.GetSequence(),
+	}
+	require.NoError(t, txBuilder.SetSignatures(sigV2))
+
+	txBuilder.SetMemo(memo)
+	signBytes, err := ante.Eip712SignBytes(
+		deps.Ctx.ChainID(), acc.GetAccountNumber(), acc.GetSequence(), txBuilder.GetTx(),
+	)
+	require.NoError(t, err)
+	txBuilder.SetMemo("")
+
+	typedData, err := eip712.WrapTxToTypedData(web3Opt.TypedDataChainID, signBytes)
+	require.NoError(t, err)
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+	privKey, err := sender.PrivKey.ToECDSA()
+	require.NoError(t, err)
+	sig, err := crypto.Sign(sigHash, privKey)
+	require.NoError(t, err)
+	sig[crypto.RecoveryIDOffset] += 27 // wallets return V as 27/28
+
+	sigV2.Data = &signing.SingleSignatureData{SignMode: signMode, Signature: sig}
+	require.NoError(t, txBuilder.SetSignatures(sigV2))
+	return txBuilder.GetTx()
+}
+
+func TestAnteDecEip712SigVerification(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		web3Opt  func(deps evmtest.TestDeps) *eth.ExtensionOptionsWeb3Tx
+		signMode signing.SignMode
+		// memo is only part of the signed payload, which makes the signature
+		// invalid when set.
+		memo    string
+		wantErr string
+	}{
+		{
+			name: "happy: EIP-712 signature from the signer",
+			web3Opt: func(deps evmtest.TestDeps) *eth.ExtensionOptionsWeb3Tx {
+				return &eth.ExtensionOptionsWeb3Tx{
+					TypedDataChainID: deps.App.EvmKeeper.EthChainID(deps.Ctx).Uint64(),
+					FeePayer:         deps.Sender.NibiruAddr.String(),
+				}
+			},
+			signMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		},
+		{
+			name: "sad: typed data for another chain",
+			web3Opt: func(deps evmtest.TestDeps) *eth.ExtensionOptionsWeb3Tx {
+				return &eth.ExtensionOptionsWeb3Tx{TypedDataChainID: 1}
+			},
+			signMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			wantErr:  "does not match the EVM chain ID",
+		},
+		{
+			name: "sad: fee delegation",
+			web3Opt: func(deps evmtest.TestDeps) *eth.ExtensionOptionsWeb3Tx {
+				return &eth.ExtensionOptionsWeb3Tx{
+					TypedDataChainID: deps.App.EvmKeeper.EthChainID(deps.Ctx).Uint64(),
+					FeePayer:         testutil.AccAddress().String(),
+				}
+			},
+			signMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			wantErr:  "fee delegation is not supported",
+		},
+		{
+			name: "sad: direct sign mode",
+			web3Opt: func(deps evmtest.TestDeps) *eth.ExtensionOptionsWeb3Tx {
+				return &eth.ExtensionOptionsWeb3Tx{
+					TypedDataChainID: deps.App.EvmKeeper.EthChainID(deps.Ctx).Uint64(),
+				}
+			},
+			signMode: signing.SignMode_SIGN_MODE_DIRECT,
+			wantErr:  "must use sign mode",
+		},
+		{
+			name: "sad: signature over a different payload",
+			web3Opt: func(deps evmtest.TestDeps) *eth.ExtensionOptionsWeb3Tx {
+				return &eth.ExtensionOptionsWeb3Tx{
+					TypedDataChainID: deps.App.EvmKeeper.EthChainID(deps.Ctx).Uint64(),
+				}
+			},
+			signMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			memo:     "tampered",
+			wantErr:  "signature verification failed",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := evmtest.NewTestDeps()
+			deps.Ctx = deps.Ctx.WithBlockHeight(1)
+			acc := deps.App.AccountKeeper.NewAccountWithAddress(deps.Ctx, deps.Sender.NibiruAddr)
+			deps.App.AccountKeeper.SetAccount(deps.Ctx, acc)
+
+			tx := buildEip712Tx(t, deps, tc.web3Opt(deps), tc.signMode, tc.memo)
+			anteHandler := sdk.ChainAnteDecorators(
+				authante.NewSetPubKeyDecorator(deps.App.AccountKeeper),
+				authante.NewSigGasConsumeDecorator(deps.App.AccountKeeper, ante.SigVerificationGasConsumer),
+				ante.NewAnteDecEip712SigVerification(deps.App.AccountKeeper, deps.App.EvmKeeper),
+			)
+			_, err := anteHandler(deps.Ctx, tx, false)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestAnteHandlerEip712_Simulate: Gas estimation for the first EIP-712 tx of an
+// account goes through the app ante handler even though the SetPubKeyDecorator
+// gives the account a simulated secp256k1 key.
+func TestAnteHandlerEip712_Simulate(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+	deps.Ctx = deps.Ctx.WithBlockHeight(1).WithConsensusParams(&tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: 10_000_000},
+	})
+	sender := deps.Sender.NibiruAddr
+	fees := sdk.NewCoins(sdk.NewInt64Coin("unibi", 100))
+	require.NoError(t, testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx, sender, fees.Add(sdk.NewInt64Coin("unibi", 1)),
+	))
+	acc := deps.App.AccountKeeper.GetAccount(deps.Ctx, sender)
+	require.Nil(t, acc.GetPubKey())
+
+	// Wallets estimate gas without a public key or a signature.
+	txBuilder := deps.App.GetTxConfig().NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(
+		sender, testutil.AccAddress(), sdk.NewCoins(sdk.NewInt64Coin("unibi", 1)),
+	)))
+	txBuilder.SetGasLimit(200_000)
+	txBuilder.SetFeeAmount(fees)
+	anyOpt, err := codectypes.NewAnyWithValue(&eth.ExtensionOptionsWeb3Tx{
+		TypedDataChainID: deps.App.EvmKeeper.EthChainID(deps.Ctx).Uint64(),
+	})
+	require.NoError(t, err)
+	txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(anyOpt)
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		Data: &signing.SingleSignatureData{
+			SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		},
+		Sequence: acc.GetSequence(),
+	}))
+
+	wasmConfig := wasmtypes.DefaultWasmConfig()
+	anteHandler := app.NewAnteHandler(deps.App.AppKeepers, ante.AnteHandlerOptions{
+		HandlerOptions: authante.HandlerOptions{
+			AccountKeeper:          deps.App.AccountKeeper,
+			BankKeeper:             deps.App.BankKeeper,
+			FeegrantKeeper:         deps.App.FeeGrantKeeper,
+			SignModeHandler:        deps.App.GetTxConfig().SignModeHandler(),
+			SigGasConsumer:         authante.DefaultSigVerificationGasConsumer,
+			ExtensionOptionChecker: func(*codectypes.Any) bool { return true },
+		},
+		IBCKeeper:         deps.App.GetIBCKeeper(),
+		TxCounterStoreKey: deps.App.GetKey(wasmtypes.StoreKey),
+		WasmConfig:        &wasmConfig,
+		DevGasKeeper:      &deps.App.DevGasKeeper,
+		DevGasBankKeeper:  deps.App.BankKeeper,
+		MaxTxGasWanted:    app.DefaultMaxTxGasWanted,
+		EvmKeeper:         deps.App.EvmKeeper,
+		AccountKeeper:     deps.App.AccountKeeper,
+	})
+
+	simCtx, _ := deps.Ctx.CacheContext()
+	_, err = anteHandler(simCtx, txBuilder.GetTx(), true)
+	require.NoError(t, err)
+
+	t.Log("Outside of simulations, the tx still needs an eth_secp256k1 signature")
+	_, err = anteHandler(deps.Ctx, txBuilder.GetTx(), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+}
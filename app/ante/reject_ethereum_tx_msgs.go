@@ -15,7 +15,7 @@ type AnteDecoratorPreventEthereumTxMsgs struct{}
 // AnteHandle rejects messages that requires ethereum-specific authentication.
 // For example `MsgEthereumTx` requires fee to be deducted in the antehandler in
 // order to perform the refund.
-func (rmd AnteDecoratorPreventEtheruemTxMsgs) AnteHandle(
+func (rmd AnteDecoratorPreventEthereumTxMsgs) AnteHandle(
 	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
 ) (newCtx sdk.Context, err error) {
 	for _, msg := range tx.GetMsgs() {
@@ -24,7 +24,7 @@ type CanTransferDecorator struct {
 func (ctd CanTransferDecorator) AnteHandle(
 	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
 ) (sdk.Context, error) {
-	ethCfg := evm.EthereumConfig(ctd.EVMKeeper.EthChainID(ctx))
+	ethCfg := ctd.EVMKeeper.GetParams(ctx).EthereumConfig(ctd.EVMKeeper.EthChainID(ctx))
 	signer := gethcore.MakeSigner(
 		ethCfg,
 		big.NewInt(ctx.BlockHeight()),
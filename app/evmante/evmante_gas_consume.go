@@ -48,6 +48,7 @@ func NewAnteDecEthGasConsume(
 //   - transaction or block gas meter runs out of gas
 //   - sets the gas meter limit
 //   - gas limit is greater than the block gas meter limit
+//   - gas limit of a message is greater than the "max_tx_gas" of the EVM params
 func (anteDec AnteDecEthGasConsume) AnteHandle(
 	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
 ) (sdk.Context, error) {
@@ -69,6 +70,8 @@ func (anteDec AnteDecEthGasConsume) AnteHandle(
 	// Use the lowest priority of all the messages as the final one.
 	minPriority := int64(math.MaxInt64)
 	baseFeeMicronibiPerGas := anteDec.evmKeeper.BaseFeeMicronibiPerGas(ctx)
+	evmParams := anteDec.evmKeeper.GetParams(ctx)
+	ethCfg := evmParams.EthereumConfig(anteDec.evmKeeper.EthChainID(ctx))
 
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evm.MsgEthereumTx)
@@ -85,6 +88,14 @@ func (anteDec AnteDecEthGasConsume) AnteHandle(
 		if err != nil {
 			return ctx, sdkioerrors.Wrap(err, "failed to unpack tx data")
 		}
+		if evmParams.MaxTxGas != 0 && txData.GetGas() > evmParams.MaxTxGas {
+			return ctx, sdkioerrors.Wrapf(
+				sdkerrors.ErrOutOfGas,
+				"tx gas (%d) exceeds max tx gas (%d)",
+				txData.GetGas(),
+				evmParams.MaxTxGas,
+			)
+		}
 
 		if ctx.IsCheckTx() && anteDec.maxGasWanted != 0 {
 			// We can't trust the tx gas limit, because we'll refund the unused gas.
@@ -100,6 +111,7 @@ func (anteDec AnteDecEthGasConsume) AnteHandle(
 		fees, err := keeper.VerifyFee(
 			txData,
 			baseFeeMicronibiPerGas,
+			ethCfg,
 			ctx,
 		)
 		if err != nil {
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/NibiruChain/nibiru/v2/app/evmante"
 	"github.com/NibiruChain/nibiru/v2/eth"
@@ -54,6 +55,22 @@ func (s *TestSuite) TestAnteDecEthGasConsume() {
 			gasMeter:     eth.NewInfiniteGasMeterWithLimit(0),
 			maxGasWanted: 0,
 		},
+		{
+			name: "sad: exceeds max tx gas",
+			beforeTxSetup: func(deps *evmtest.TestDeps, sdb *statedb.StateDB) {
+				gasLimit := happyGasLimit()
+				balance := evm.NativeToWei(new(big.Int).Add(gasLimit, big.NewInt(100)))
+				sdb.AddBalanceSigned(deps.Sender.EthAddr, balance)
+
+				params := deps.EvmKeeper.GetParams(deps.Ctx)
+				params.MaxTxGas = gethparams.TxGas
+				s.Require().NoError(deps.EvmKeeper.SetParams(deps.Ctx, params))
+			},
+			txSetup:      evmtest.HappyCreateContractTx,
+			wantErr:      "exceeds max tx gas (21000)",
+			gasMeter:     eth.NewInfiniteGasMeterWithLimit(happyGasLimit().Uint64()),
+			maxGasWanted: 0,
+		},
 	}
 
 	for _, tc := range testCases {
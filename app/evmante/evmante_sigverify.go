@@ -26,15 +26,18 @@ func NewEthSigVerificationDecorator(k *EVMKeeper) EthSigVerificationDecorator {
 
 // AnteHandle validates checks that the registered chain id is the same as the
 // one on the message, and that the signer address matches the one defined on the
-// message. It's not skipped for RecheckTx, because it set `From` address which
-// is critical from other ante handler to work. Failure in RecheckTx will prevent
+// message. Transactions without EIP-155 replay protection are rejected unless
+// their hash is in the "allowed_unprotected_tx_hashes" of the EVM params.
+// It's not skipped for RecheckTx, because it set `From` address which is
+// critical from other ante handler to work. Failure in RecheckTx will prevent
 // tx to be included into block, especially when CheckTx succeed, in which case
 // user won't see the error message.
 func (esvd EthSigVerificationDecorator) AnteHandle(
 	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
 ) (newCtx sdk.Context, err error) {
 	chainID := esvd.evmKeeper.EthChainID(ctx)
-	ethCfg := evm.EthereumConfig(chainID)
+	params := esvd.evmKeeper.GetParams(ctx)
+	ethCfg := params.EthereumConfig(chainID)
 	blockNum := big.NewInt(ctx.BlockHeight())
 	signer := gethcore.MakeSigner(
 		ethCfg,
@@ -52,14 +55,6 @@ func (esvd EthSigVerificationDecorator) AnteHandle(
 		}
 
 		ethTx := msgEthTx.AsTransaction()
-		// if !ethTx.Protected() {
-		// 	return ctx, errors.Wrapf(
-		// 		sdkerrors.ErrNotSupported,
-		// 		"rejected unprotected Ethereum transaction. "+
-		// 			"Please EIP155 sign your transaction to protect it against replay-attacks",
-		// 	)
-		// }
-
 		sender, err := signer.Sender(ethTx)
 		if err != nil {
 			return ctx, sdkioerrors.Wrapf(
@@ -69,6 +64,18 @@ func (esvd EthSigVerificationDecorator) AnteHandle(
 			)
 		}
 
+		// Unprotected (pre-EIP-155) txs can be replayed on any chain, so only
+		// the ones allowed by the EVM params are accepted, like keyless
+		// deployments of contracts with the same address on every chain.
+		if !ethTx.Protected() && !params.IsUnprotectedTxAllowed(ethTx.Hash()) {
+			return ctx, sdkioerrors.Wrapf(
+				sdkerrors.ErrNotSupported,
+				"rejected unprotected Ethereum transaction %s. "+
+					"Please EIP155 sign your transaction to protect it against replay-attacks",
+				ethTx.Hash().Hex(),
+			)
+		}
+
 		// set up the sender to the transaction field if not already
 		msgEthTx.From = sender.Hex()
 	}
@@ -50,6 +50,30 @@ func (s *TestSuite) TestEthSigVerificationDecorator() {
 			},
 			wantErr: "invalid chain id for signer",
 		},
+		{
+			name: "sad: unprotected ethereum tx",
+			txSetup: func(deps *evmtest.TestDeps) sdk.Tx {
+				tx := evmtest.HappyCreateContractTx(deps)
+				err := tx.Sign(gethcore.HomesteadSigner{}, deps.Sender.KeyringSigner)
+				s.Require().NoError(err)
+				return tx
+			},
+			wantErr: "rejected unprotected Ethereum transaction",
+		},
+		{
+			name: "happy: allowed unprotected ethereum tx",
+			txSetup: func(deps *evmtest.TestDeps) sdk.Tx {
+				tx := evmtest.HappyCreateContractTx(deps)
+				err := tx.Sign(gethcore.HomesteadSigner{}, deps.Sender.KeyringSigner)
+				s.Require().NoError(err)
+
+				params := deps.EvmKeeper.GetParams(deps.Ctx)
+				params.AllowedUnprotectedTxHashes = []string{tx.AsTransaction().Hash().Hex()}
+				s.Require().NoError(deps.EvmKeeper.SetParams(deps.Ctx, params))
+				return tx
+			},
+			wantErr: "",
+		},
 		{
 			name: "happy: signed ethereum tx",
 			txSetup: func(deps *evmtest.TestDeps) sdk.Tx {
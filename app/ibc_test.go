@@ -12,6 +12,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/app"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 )
 
 // init changes the value of 'DefaultTestingAppInit' to use custom initialization.
@@ -191,3 +192,105 @@ func (suite *IBCTestSuite) TestHandleMsgTransfer() {
 	balance = chainCApp.BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), voucherDenomTrace.IBCDenom())
 	suite.Require().Zero(balance.Amount.Int64())
 }
+
+// TestTransferToEvm: Incoming transfers on an EVM channel are delivered as the
+// ERC20 of the FunToken mapping of the received coin.
+func (suite *IBCTestSuite) TestTransferToEvm() {
+	path := NewIBCTestingTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	chainBApp, ok := suite.chainB.App.(*app.NibiruApp)
+	suite.Require().True(ok)
+	params := chainBApp.EvmKeeper.GetParams(suite.chainB.GetContext())
+	params.EVMChannels = []string{path.EndpointB.ChannelID}
+	suite.Require().NoError(chainBApp.EvmKeeper.SetParams(suite.chainB.GetContext(), params))
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+	amount := sdkmath.NewInt(100)
+	transfer := func(receiver, memo string) {
+		msg := transfertypes.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			sdk.NewCoin(sdk.DefaultBondDenom, amount),
+			suite.chainA.SenderAccount.GetAddress().String(),
+			receiver,
+			suite.chainB.GetTimeoutHeight(),
+			0,
+			memo,
+		)
+		res, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		suite.Require().NoError(err)
+		suite.Require().NoError(path.RelayPacket(packet))
+	}
+	chainABalance := func() sdkmath.Int {
+		chainAApp, ok := suite.chainA.App.(*app.NibiruApp)
+		suite.Require().True(ok)
+		return chainAApp.BankKeeper.GetBalance(
+			suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom,
+		).Amount
+	}
+
+	suite.Run("sad: no FunToken mapping, the sender is refunded", func() {
+		receiver := evmtest.NewEthPrivAcc()
+		balanceBefore := chainABalance()
+		transfer(receiver.EthAddr.Hex(), "")
+		suite.Equal(balanceBefore.String(), chainABalance().String())
+		suite.True(chainBApp.BankKeeper.GetAllBalances(
+			suite.chainB.GetContext(), receiver.NibiruAddr,
+		).IsZero())
+	})
+
+	deps := evmtest.TestDeps{
+		App:       chainBApp,
+		Ctx:       suite.chainB.GetContext(),
+		EvmKeeper: chainBApp.EvmKeeper,
+		Sender:    evmtest.NewEthPrivAcc(),
+	}
+	funToken := evmtest.CreateFunTokenForBankCoin(deps, voucherDenom, &suite.Suite)
+
+	for _, tc := range []struct {
+		name     string
+		receiver func(acc evmtest.EthPrivKeyAcc) string
+		memo     string
+	}{
+		{
+			name:     "happy: hex receiver",
+			receiver: func(acc evmtest.EthPrivKeyAcc) string { return acc.EthAddr.Hex() },
+		},
+		{
+			name:     "happy: memo requests the conversion",
+			receiver: func(acc evmtest.EthPrivKeyAcc) string { return acc.NibiruAddr.String() },
+			memo:     `{"evm": {"convert": true}}`,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			receiver := evmtest.NewEthPrivAcc()
+			transfer(tc.receiver(receiver), tc.memo)
+
+			deps.Ctx = suite.chainB.GetContext()
+			evmObj, _ := deps.NewEVM()
+			evmtest.AssertERC20BalanceEqualWithDescription(
+				suite.T(), deps, evmObj, funToken.Erc20Addr.Address, receiver.EthAddr,
+				amount.BigInt(), "erc20 balance of the receiver",
+			)
+			// Drop the StateDB of the query context, so that the next block
+			// doesn't reuse it.
+			chainBApp.EvmKeeper.Bank.StateDB = nil
+			suite.True(chainBApp.BankKeeper.GetBalance(
+				deps.Ctx, receiver.NibiruAddr, voucherDenom,
+			).IsZero())
+		})
+	}
+
+	suite.Run("bech32 receiver without memo gets the bank coin", func() {
+		receiver := evmtest.NewEthPrivAcc()
+		transfer(receiver.NibiruAddr.String(), "")
+		suite.Equal(amount.String(), chainBApp.BankKeeper.GetBalance(
+			suite.chainB.GetContext(), receiver.NibiruAddr, voucherDenom,
+		).Amount.String())
+	})
+}
@@ -60,6 +60,7 @@ import (
 	devgaskeeper "github.com/NibiruChain/nibiru/v2/x/devgas/v1/keeper"
 	devgastypes "github.com/NibiruChain/nibiru/v2/x/devgas/v1/types"
 	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmmodule"
 	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
 )
 
@@ -133,14 +134,6 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 	}
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
 
-	// ---------------------------------- Nibiru Chain x/ keepers
-	app.EpochsKeeper.SetHooks(
-		epochstypes.NewMultiEpochHooks(
-			app.InflationKeeper.Hooks(),
-			app.OracleKeeper.Hooks(),
-		),
-	)
-
 	// ---------------------------------- IBC keepers
 
 	app.ibcKeeper = ibckeeper.NewKeeper(
@@ -269,6 +262,19 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 		govModuleAddr,
 	)
 
+	// ---------------------------------- Nibiru Chain x/ keepers
+	// The epoch hooks are set after the Wasm keeper because cron jobs execute
+	// Wasm contracts.
+	app.EpochsKeeper.SetHooks(
+		epochstypes.NewMultiEpochHooks(
+			app.InflationKeeper.Hooks(),
+			app.OracleKeeper.Hooks(),
+			app.SudoKeeper.Hooks(
+				app.EvmKeeper, wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper),
+			),
+		),
+	)
+
 	// register the proposal types
 
 	// Mock Module setup for testing IBC and also acts as the interchain accounts authentication module
@@ -281,10 +287,11 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 	// transferKeeper.SendPacket -> fee.SendPacket -> channel.SendPacket
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-	// channel.RecvPacket -> fee.OnRecvPacket -> transfer.OnRecvPacket
+	// channel.RecvPacket -> fee.OnRecvPacket -> evm.OnRecvPacket -> transfer.OnRecvPacket
 
 	// transfer stack contains (from top to bottom):
 	// - IBC Fee Middleware
+	// - EVM Middleware: converts incoming FunToken coins to ERC20
 	// - Transfer
 
 	ibcRouter := porttypes.NewRouter()
@@ -292,6 +299,7 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 	// create IBC module from bottom to top of stack
 	var transferStack porttypes.IBCModule
 	transferStack = ibctransfer.NewIBCModule(app.ibcTransferKeeper)
+	transferStack = evmmodule.NewIBCMiddleware(transferStack, app.EvmKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.ibcFeeKeeper)
 
 	// Create Interchain Accounts Stack
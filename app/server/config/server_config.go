@@ -162,6 +162,10 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// BundlerKey is the name of the keyring key that submits the ERC-4337 user
+	// operations received by "eth_sendUserOperation". The key must be an
+	// eth_secp256k1 key. If empty, "eth_sendUserOperation" is disabled.
+	BundlerKey string `mapstructure:"bundler-key"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -248,7 +252,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "net", "txpool", "debug"}
+	return []string{"web3", "eth", "net", "txpool", "debug", "bundler"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -272,6 +276,7 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		BundlerKey:               "",
 	}
 }
 
@@ -481,6 +486,15 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# BundlerKey is the name of the keyring key that submits the ERC-4337 user
+# operations received by "eth_sendUserOperation". The key must be an
+# eth_secp256k1 key. Leave empty to disable "eth_sendUserOperation".
+# The bundler methods are only served when "bundler" is in "api". User
+# operations are only simulated before submission, without the ERC-7562
+# validation rules, so an operation that reverts on chain costs the gas of
+# this key.
+bundler-key = "{{ .JSONRPC.BundlerKey }}"
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
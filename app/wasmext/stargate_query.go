@@ -111,16 +111,18 @@ func WasmAcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
 		"/nibiru.epochs.v1.Query/CurrentEpoch": new(epochs.QueryCurrentEpochResponse),
 
 		// nibiru inflation
-		"/nibiru.inflation.v1.Query/Period":             new(inflation.QueryPeriodResponse),
-		"/nibiru.inflation.v1.Query/EpochMintProvision": new(inflation.QueryEpochMintProvisionResponse),
-		"/nibiru.inflation.v1.Query/SkippedEpochs":      new(inflation.QuerySkippedEpochsResponse),
-		"/nibiru.inflation.v1.Query/CirculatingSupply":  new(inflation.QueryCirculatingSupplyResponse),
-		"/nibiru.inflation.v1.Query/InflationRate":      new(inflation.QueryInflationRateResponse),
-		"/nibiru.inflation.v1.Query/Params":             new(inflation.QueryParamsResponse),
+		"/nibiru.inflation.v1.Query/Period":               new(inflation.QueryPeriodResponse),
+		"/nibiru.inflation.v1.Query/EpochMintProvision":   new(inflation.QueryEpochMintProvisionResponse),
+		"/nibiru.inflation.v1.Query/SkippedEpochs":        new(inflation.QuerySkippedEpochsResponse),
+		"/nibiru.inflation.v1.Query/CirculatingSupply":    new(inflation.QueryCirculatingSupplyResponse),
+		"/nibiru.inflation.v1.Query/InflationRate":        new(inflation.QueryInflationRateResponse),
+		"/nibiru.inflation.v1.Query/InflationProjections": new(inflation.QueryInflationProjectionsResponse),
+		"/nibiru.inflation.v1.Query/Params":               new(inflation.QueryParamsResponse),
 
 		// nibiru oracle
 		"/nibiru.oracle.v1.Query/ExchangeRate":      new(oracle.QueryExchangeRateResponse),
 		"/nibiru.oracle.v1.Query/ExchangeRateTwap":  new(oracle.QueryExchangeRateResponse),
+		"/nibiru.oracle.v1.Query/ExchangeRateEma":   new(oracle.QueryExchangeRateEmaResponse),
 		"/nibiru.oracle.v1.Query/ExchangeRates":     new(oracle.QueryExchangeRatesResponse),
 		"/nibiru.oracle.v1.Query/Actives":           new(oracle.QueryActivesResponse),
 		"/nibiru.oracle.v1.Query/VoteTargets":       new(oracle.QueryVoteTargetsResponse),
@@ -131,9 +133,13 @@ func WasmAcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
 		"/nibiru.oracle.v1.Query/AggregateVote":     new(oracle.QueryAggregateVoteResponse),
 		"/nibiru.oracle.v1.Query/AggregateVotes":    new(oracle.QueryAggregateVotesResponse),
 		"/nibiru.oracle.v1.Query/Params":            new(oracle.QueryParamsResponse),
+		"/nibiru.oracle.v1.Query/PairStatuses":      new(oracle.QueryPairStatusesResponse),
 
 		// nibiru sudo
-		"/nibiru.sudo.v1.Query/QuerySudoers": new(sudotypes.QuerySudoersResponse),
+		"/nibiru.sudo.v1.Query/QuerySudoers":              new(sudotypes.QuerySudoersResponse),
+		"/nibiru.sudo.v1.Query/QueryRootChanges":          new(sudotypes.QueryRootChangesResponse),
+		"/nibiru.sudo.v1.Query/QueryScheduledSudoActions": new(sudotypes.QueryScheduledSudoActionsResponse),
+		"/nibiru.sudo.v1.Query/QueryCronJobs":             new(sudotypes.QueryCronJobsResponse),
 
 		// nibiru devgas
 		"/nibiru.devgas.v1.Query/FeeShares":             new(devgas.QueryFeeSharesResponse),
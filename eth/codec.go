@@ -3,6 +3,7 @@ package eth
 
 import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
@@ -47,4 +48,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		//   &authtypes.ModuleAccount{},
 		// ]
 	)
+
+	registry.RegisterImplementations(
+		(*tx.TxExtensionOptionI)(nil),
+		&ExtensionOptionsWeb3Tx{},
+	)
 }
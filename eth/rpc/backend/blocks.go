@@ -422,6 +422,13 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 	if err != nil {
 		b.logger.Error("failed to query consensus params", "error", err.Error())
 	}
+	// Report the gas that a single tx may use, since tools use the block gas
+	// limit as the upper bound for the gas of a tx.
+	if resParams, err := b.queryClient.Params(ctx, &evm.QueryParamsRequest{}); err != nil {
+		b.logger.Error("failed to query evm params", "height", block.Height, "error", err.Error())
+	} else {
+		gasLimit = int64(resParams.Params.TxGasCap(uint64(gasLimit))) // #nosec G701 -- bounded by gasLimit
+	}
 
 	gasUsed := uint64(0)
 
@@ -35,8 +35,12 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 
 	// check the local node config in case unprotected txs are disabled
 	if !b.allowUnprotectedTxs && !tx.Protected() {
-		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-		return common.Hash{}, pkgerrors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+		// Ensure only eip155 signed transactions are submitted if EIP155Required
+		// is set, unless the chain allows this particular tx.
+		res, err := b.queryClient.Params(b.ctx, &evm.QueryParamsRequest{})
+		if err != nil || !res.Params.IsUnprotectedTxAllowed(tx.Hash()) {
+			return common.Hash{}, pkgerrors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+		}
 	}
 
 	ethereumTx := &evm.MsgEthereumTx{}
@@ -249,9 +253,9 @@ func (b *Backend) EstimateGas(
 	return hexutil.Uint64(res.Gas), nil
 }
 
-// DoCall performs a simulated call operation through the evmtypes. It returns the
-// estimated gas used on the operation or an error if fails.
-func (b *Backend) DoCall(
+// ethCall performs a simulated call operation and returns the response even
+// if the call failed, so that callers can decode the revert data.
+func (b *Backend) ethCall(
 	args evm.JsonTxArgs, blockNr rpc.BlockNumber,
 ) (*evm.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
@@ -290,7 +294,15 @@ func (b *Backend) DoCall(
 	// this makes sure resources are cleaned up.
 	defer cancel()
 
-	res, err := b.queryClient.EthCall(ctx, &req)
+	return b.queryClient.EthCall(ctx, &req)
+}
+
+// DoCall performs a simulated call operation through the evmtypes. It returns the
+// estimated gas used on the operation or an error if fails.
+func (b *Backend) DoCall(
+	args evm.JsonTxArgs, blockNr rpc.BlockNumber,
+) (*evm.MsgEthereumTxResponse, error) {
+	res, err := b.ethCall(args, blockNr)
 	if err != nil {
 		return nil, err
 	}
@@ -27,11 +27,11 @@ func (b *Backend) ChainID() *hexutil.Big {
 
 // ChainConfig returns the latest ethereum chain configuration
 func (b *Backend) ChainConfig() *params.ChainConfig {
-	_, err := b.queryClient.Params(b.ctx, &evm.QueryParamsRequest{})
+	res, err := b.queryClient.Params(b.ctx, &evm.QueryParamsRequest{})
 	if err != nil {
 		return nil
 	}
-	return evm.EthereumConfig(b.chainID)
+	return res.Params.EthereumConfig(b.chainID)
 }
 
 // BaseFeeWei returns the EIP-1559 base fee.
@@ -0,0 +1,464 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package backend
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/ethsecp256k1"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+)
+
+// ErrBundlerDisabled is returned by [Backend.SendUserOperation] when the node
+// has no "json-rpc.bundler-key" configured.
+var ErrBundlerDisabled = errors.New(
+	"eth_sendUserOperation is disabled: the node has no json-rpc.bundler-key",
+)
+
+// Error codes of the ERC-4337 bundler RPC methods.
+const (
+	// UserOpErrCodeInvalidFields: The user operation has invalid fields, like
+	// a gas limit that is too low.
+	UserOpErrCodeInvalidFields = -32602
+	// UserOpErrCodeRejectedByAccount: The account or the factory rejected the
+	// user operation in validation.
+	UserOpErrCodeRejectedByAccount = -32500
+	// UserOpErrCodeRejectedByPaymaster: The paymaster rejected the user
+	// operation in validation.
+	UserOpErrCodeRejectedByPaymaster = -32501
+	// UserOpErrCodeExecutionReverted: The call of the user operation reverted
+	// during gas estimation.
+	UserOpErrCodeExecutionReverted = -32521
+)
+
+// UserOpError is an error of the bundler RPC methods with the JSON-RPC error
+// code of ERC-7769.
+type UserOpError struct {
+	Code   int
+	Reason string
+}
+
+func (e *UserOpError) Error() string { return e.Reason }
+
+// ErrorCode implements the JSON-RPC error interface of go-ethereum.
+func (e *UserOpError) ErrorCode() int { return e.Code }
+
+const (
+	// userOpSimulationGasLimit is the gas limit of each phase of a user
+	// operation, like validation and execution, when estimating its gas.
+	userOpSimulationGasLimit uint64 = 10_000_000
+	// userOpGasMarginPercent is the margin added to the estimated gas limits
+	// of user operations.
+	userOpGasMarginPercent uint64 = 10
+
+	// Parameters of the preVerificationGas, which pays for the share of a
+	// user operation in the gas of the bundle that isn't metered by the
+	// EntryPoint: the intrinsic gas, the calldata, and the loop overhead.
+	userOpBundleFixedGas   uint64 = 21_000
+	userOpPerOpGas         uint64 = 18_300
+	userOpPerWordGas       uint64 = 4
+	userOpZeroByteGas      uint64 = 4
+	userOpNonZeroByteGas   uint64 = 16
+	userOpDummySigLength          = 65
+	userOpMinCallGasLimit  uint64 = 9_100
+	userOpMinVerifyGasUsed uint64 = 10_000
+)
+
+// bundlerLocker prevents concurrent user operations from being submitted with
+// the same nonce of the bundler account.
+var bundlerLocker rpc.AddrLocker
+
+// SupportedEntryPoints returns the EntryPoint contracts that the node accepts
+// user operations for.
+func (b *Backend) SupportedEntryPoints() []common.Address {
+	return []common.Address{embeds.ADDR_ENTRYPOINT}
+}
+
+// EstimateUserOperationGas estimates the gas limits of a user operation by
+// simulating it with "simulateHandleOp" on the latest state. Fees are ignored
+// and the signature only needs to have the right length, so a dummy signature
+// can be used.
+func (b *Backend) EstimateUserOperationGas(
+	op rpc.UserOperation, entryPoint common.Address,
+) (*rpc.UserOperationGasEstimate, error) {
+	if err := b.checkEntryPoint(entryPoint); err != nil {
+		return nil, err
+	}
+
+	gasLimit := userOpSimulationGasLimit
+	if gasCap := b.RPCGasCap(); gasCap > 0 && gasLimit*4 > gasCap {
+		gasLimit = gasCap / 4
+	}
+	simLimit := (*hexutil.Big)(new(big.Int).SetUint64(gasLimit))
+	simOp := op
+	simOp.VerificationGasLimit = simLimit
+	simOp.CallGasLimit = simLimit
+	simOp.PreVerificationGas = new(hexutil.Big)
+	simOp.MaxFeePerGas = new(hexutil.Big)
+	simOp.MaxPriorityFeePerGas = new(hexutil.Big)
+	if simOp.Paymaster != nil {
+		simOp.PaymasterVerificationGasLimit = simLimit
+		if simOp.PaymasterPostOpGasLimit == nil {
+			simOp.PaymasterPostOpGasLimit = simLimit
+		}
+	}
+
+	input, err := embeds.SmartContract_EntryPoint.ABI.Pack("simulateHandleOp", simOp.Pack())
+	if err != nil {
+		return nil, err
+	}
+	res, err := b.ethCall(evm.JsonTxArgs{
+		To:    &entryPoint,
+		Input: (*hexutil.Bytes)(&input),
+	}, rpc.EthLatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if res.VmError != vm.ErrExecutionReverted.Error() {
+		return nil, fmt.Errorf("simulateHandleOp did not revert with a result: %s", res.VmError)
+	}
+
+	result, err := UnpackExecutionResult(res.Ret)
+	if err != nil {
+		return nil, err
+	}
+	if !result.ExecutionSuccess {
+		return nil, &UserOpError{
+			Code:   UserOpErrCodeExecutionReverted,
+			Reason: evm.NewRevertError(result.ExecutionResult).Error(),
+		}
+	}
+
+	// With a zero preVerificationGas, "preOpGas" is the gas used in
+	// validation.
+	verificationGas := max(result.PreOpGas.Uint64(), userOpMinVerifyGasUsed)
+	verificationGas = withGasMargin(verificationGas)
+	callGas := result.ExecutionGasUsed.Uint64() * 64 / 63
+	callGas = max(withGasMargin(callGas), userOpMinCallGasLimit)
+
+	estimate := &rpc.UserOperationGasEstimate{
+		PreVerificationGas:   hexutil.Uint64(PreVerificationGas(op)),
+		VerificationGasLimit: hexutil.Uint64(verificationGas),
+		CallGasLimit:         hexutil.Uint64(callGas),
+	}
+	if op.Paymaster != nil {
+		paymasterGas := hexutil.Uint64(verificationGas)
+		estimate.PaymasterVerificationGasLimit = &paymasterGas
+	}
+	return estimate, nil
+}
+
+// SendUserOperation validates a user operation by simulating "handleOps" with
+// it and submits it in a "handleOps" transaction signed by the bundler key of
+// the node. The bundler is paid by the EntryPoint for the gas of the
+// operation. Returns the hash of the user operation and of the transaction.
+func (b *Backend) SendUserOperation(
+	op rpc.UserOperation, entryPoint common.Address,
+) (userOpHash common.Hash, txHash common.Hash, err error) {
+	if err := b.checkEntryPoint(entryPoint); err != nil {
+		return userOpHash, txHash, err
+	}
+	bundler, err := b.bundlerAddress()
+	if err != nil {
+		return userOpHash, txHash, err
+	}
+
+	if minPvg := PreVerificationGas(op); bigOrZero(op.PreVerificationGas).Cmp(
+		new(big.Int).SetUint64(minPvg),
+	) < 0 {
+		return userOpHash, txHash, &UserOpError{
+			Code: UserOpErrCodeInvalidFields,
+			Reason: fmt.Sprintf(
+				"preVerificationGas %s is below the minimum of %d",
+				bigOrZero(op.PreVerificationGas), minPvg,
+			),
+		}
+	}
+	head, err := b.CurrentHeader()
+	if err != nil {
+		return userOpHash, txHash, err
+	}
+	if head.BaseFee != nil && bigOrZero(op.MaxFeePerGas).Cmp(head.BaseFee) < 0 {
+		return userOpHash, txHash, &UserOpError{
+			Code: UserOpErrCodeInvalidFields,
+			Reason: fmt.Sprintf(
+				"maxFeePerGas %s is below the base fee of %s wei",
+				bigOrZero(op.MaxFeePerGas), head.BaseFee,
+			),
+		}
+	}
+
+	input, err := embeds.SmartContract_EntryPoint.ABI.Pack(
+		"handleOps", []rpc.PackedUserOperation{op.Pack()}, bundler,
+	)
+	if err != nil {
+		return userOpHash, txHash, err
+	}
+
+	bundlerLocker.LockAddr(bundler)
+	defer bundlerLocker.UnlockAddr(bundler)
+
+	args := evm.JsonTxArgs{
+		From:  &bundler,
+		To:    &entryPoint,
+		Input: (*hexutil.Bytes)(&input),
+	}
+	res, err := b.ethCall(args, rpc.EthPendingBlockNumber)
+	if err != nil {
+		return userOpHash, txHash, err
+	}
+	if res.Failed() {
+		return userOpHash, txHash, entryPointRevertError(res)
+	}
+
+	args, err = b.SetTxDefaults(args)
+	if err != nil {
+		return userOpHash, txHash, err
+	}
+	msg := args.ToMsgEthTx()
+	signer := gethcore.LatestSignerForChainID(b.chainID)
+	if err := msg.Sign(signer, b.clientCtx.Keyring); err != nil {
+		return userOpHash, txHash, pkgerrors.Wrap(err, "failed to sign the handleOps transaction")
+	}
+	txBz, err := msg.AsTransaction().MarshalBinary()
+	if err != nil {
+		return userOpHash, txHash, err
+	}
+	txHash, err = b.SendRawTransaction(txBz)
+	if err != nil {
+		return userOpHash, txHash, err
+	}
+	return op.Hash(entryPoint, b.chainID), txHash, nil
+}
+
+// GetUserOperationReceipt returns the receipt of the user operation with hash
+// "userOpHash" that was included in the transaction "txHash". Returns nil if
+// the transaction has no receipt yet or doesn't include the operation.
+func (b *Backend) GetUserOperationReceipt(
+	userOpHash common.Hash, txHash common.Hash,
+) (*rpc.UserOperationReceipt, error) {
+	receipt, err := b.GetTransactionReceipt(txHash)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return UserOperationReceiptFromLogs(userOpHash, receipt.Logs, receipt)
+}
+
+// UserOperationReceiptFromLogs finds the "UserOperationEvent" of "userOpHash"
+// in the logs of a "handleOps" transaction and returns the receipt of the
+// operation. The logs of the operation are the logs emitted after the event
+// of the previous operation in the bundle, or after "BeforeExecution". Returns
+// nil if the operation isn't in the logs.
+func UserOperationReceiptFromLogs(
+	userOpHash common.Hash, logs []*gethcore.Log, txReceipt any,
+) (*rpc.UserOperationReceipt, error) {
+	entryPointAbi := embeds.SmartContract_EntryPoint.ABI
+	opEvent := entryPointAbi.Events["UserOperationEvent"]
+	revertEvent := entryPointAbi.Events["UserOperationRevertReason"]
+	beforeExecution := entryPointAbi.Events["BeforeExecution"]
+
+	start := 0
+	for idx, log := range logs {
+		if log.Address != embeds.ADDR_ENTRYPOINT || len(log.Topics) == 0 {
+			continue
+		}
+		switch {
+		case log.Topics[0] == beforeExecution.ID:
+			start = idx + 1
+			continue
+		case log.Topics[0] != opEvent.ID:
+			continue
+		case len(log.Topics) != 4 || log.Topics[1] != userOpHash:
+			start = idx + 1
+			continue
+		}
+
+		values, err := opEvent.Inputs.NonIndexed().Unpack(log.Data)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to unpack UserOperationEvent")
+		}
+		opLogs := logs[start:idx]
+		receipt := &rpc.UserOperationReceipt{
+			UserOpHash:    userOpHash,
+			EntryPoint:    log.Address,
+			Sender:        common.BytesToAddress(log.Topics[2].Bytes()),
+			Paymaster:     common.BytesToAddress(log.Topics[3].Bytes()),
+			Nonce:         (*hexutil.Big)(values[0].(*big.Int)),
+			Success:       values[1].(bool),
+			ActualGasCost: (*hexutil.Big)(values[2].(*big.Int)),
+			ActualGasUsed: (*hexutil.Big)(values[3].(*big.Int)),
+			Logs:          opLogs,
+			Receipt:       txReceipt,
+		}
+		for _, opLog := range opLogs {
+			if opLog.Address != log.Address || len(opLog.Topics) < 2 ||
+				opLog.Topics[0] != revertEvent.ID || opLog.Topics[1] != userOpHash {
+				continue
+			}
+			revertValues, err := revertEvent.Inputs.NonIndexed().Unpack(opLog.Data)
+			if err != nil {
+				return nil, pkgerrors.Wrap(err, "failed to unpack UserOperationRevertReason")
+			}
+			receipt.Reason = revertValues[1].([]byte)
+		}
+		return receipt, nil
+	}
+	return nil, nil
+}
+
+// ExecutionResult is the result of "simulateHandleOp" on the EntryPoint.
+type ExecutionResult struct {
+	PreOpGas                *big.Int
+	ExecutionGasUsed        *big.Int
+	AccountValidationData   *big.Int
+	PaymasterValidationData *big.Int
+	ExecutionSuccess        bool
+	ExecutionResult         []byte
+}
+
+// UnpackExecutionResult decodes the revert data of "simulateHandleOp". If the
+// simulation failed validation instead, the returned error is a [UserOpError].
+func UnpackExecutionResult(ret []byte) (*ExecutionResult, error) {
+	abiErr, ok := embeds.SmartContract_EntryPoint.ABI.Errors["ExecutionResult"]
+	if !ok || len(ret) < 4 || !bytes.Equal(ret[:4], abiErr.ID[:4]) {
+		return nil, entryPointRevertError(&evm.MsgEthereumTxResponse{
+			Ret: ret, VmError: vm.ErrExecutionReverted.Error(),
+		})
+	}
+	values, err := abiErr.Unpack(ret)
+	if err != nil {
+		return nil, err
+	}
+	fields, ok := values.([]any)
+	if !ok || len(fields) != 6 {
+		return nil, fmt.Errorf("invalid ExecutionResult: %v", values)
+	}
+	return &ExecutionResult{
+		PreOpGas:                fields[0].(*big.Int),
+		ExecutionGasUsed:        fields[1].(*big.Int),
+		AccountValidationData:   fields[2].(*big.Int),
+		PaymasterValidationData: fields[3].(*big.Int),
+		ExecutionSuccess:        fields[4].(bool),
+		ExecutionResult:         fields[5].([]byte),
+	}, nil
+}
+
+// PreVerificationGas returns the minimum preVerificationGas of a user
+// operation that is submitted in a bundle of its own. A dummy signature is
+// assumed if the signature is shorter than an ECDSA signature.
+func PreVerificationGas(op rpc.UserOperation) uint64 {
+	if len(op.Signature) < userOpDummySigLength {
+		sig := make([]byte, userOpDummySigLength)
+		for i := range sig {
+			sig[i] = 0x01
+		}
+		op.Signature = sig
+	}
+	// The ABI encoding of the operation in the calldata of "handleOps",
+	// without the function selector.
+	encoded, err := embeds.SmartContract_EntryPoint.ABI.Methods["handleOps"].
+		Inputs[:1].Pack([]rpc.PackedUserOperation{op.Pack()})
+	if err != nil {
+		return 0
+	}
+	callDataGas := uint64(0)
+	for _, b := range encoded {
+		if b == 0 {
+			callDataGas += userOpZeroByteGas
+		} else {
+			callDataGas += userOpNonZeroByteGas
+		}
+	}
+	words := uint64(len(encoded)+31) / 32
+	return userOpBundleFixedGas + userOpPerOpGas + callDataGas + words*userOpPerWordGas
+}
+
+// checkEntryPoint returns an error if "entryPoint" isn't supported.
+func (b *Backend) checkEntryPoint(entryPoint common.Address) error {
+	for _, supported := range b.SupportedEntryPoints() {
+		if entryPoint == supported {
+			return nil
+		}
+	}
+	return &UserOpError{
+		Code:   UserOpErrCodeInvalidFields,
+		Reason: fmt.Sprintf("unsupported entry point %s", entryPoint.Hex()),
+	}
+}
+
+// bundlerAddress returns the Ethereum address of the bundler key.
+func (b *Backend) bundlerAddress() (common.Address, error) {
+	keyName := b.cfg.JSONRPC.BundlerKey
+	if keyName == "" || b.clientCtx.Keyring == nil {
+		return common.Address{}, ErrBundlerDisabled
+	}
+	record, err := b.clientCtx.Keyring.Key(keyName)
+	if err != nil {
+		return common.Address{}, pkgerrors.Wrapf(err, "failed to load bundler key %q", keyName)
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return common.Address{}, err
+	}
+	if _, ok := pubKey.(*ethsecp256k1.PubKey); !ok {
+		return common.Address{}, fmt.Errorf(
+			"bundler key %q must be an %s key, got %s",
+			keyName, ethsecp256k1.KeyType, pubKey.Type(),
+		)
+	}
+	return common.BytesToAddress(pubKey.Address()), nil
+}
+
+// entryPointRevertError converts a failed call to the EntryPoint into an
+// error. The "FailedOp" and "FailedOpWithRevert" errors of validation become
+// a [UserOpError].
+func entryPointRevertError(res *evm.MsgEthereumTxResponse) error {
+	if res.VmError != vm.ErrExecutionReverted.Error() {
+		return fmt.Errorf("entry point call failed: %s", res.VmError)
+	}
+	entryPointAbi := embeds.SmartContract_EntryPoint.ABI
+	for _, name := range []string{"FailedOp", "FailedOpWithRevert"} {
+		abiErr := entryPointAbi.Errors[name]
+		if len(res.Ret) < 4 || !bytes.Equal(res.Ret[:4], abiErr.ID[:4]) {
+			continue
+		}
+		values, err := abiErr.Unpack(res.Ret)
+		if err != nil {
+			return err
+		}
+		fields := values.([]any)
+		reason := fields[1].(string)
+		if name == "FailedOpWithRevert" {
+			reason = fmt.Sprintf("%s: %s", reason, hexutil.Encode(fields[2].([]byte)))
+		}
+		code := UserOpErrCodeRejectedByAccount
+		if strings.HasPrefix(reason, "AA3") {
+			code = UserOpErrCodeRejectedByPaymaster
+		}
+		return &UserOpError{Code: code, Reason: reason}
+	}
+	return evm.NewRevertError(res.Ret)
+}
+
+// withGasMargin adds [userOpGasMarginPercent] to "gas".
+func withGasMargin(gas uint64) uint64 {
+	return gas + gas*userOpGasMarginPercent/100
+}
+
+func bigOrZero(b *hexutil.Big) *big.Int {
+	if b == nil {
+		return new(big.Int)
+	}
+	return b.ToInt()
+}
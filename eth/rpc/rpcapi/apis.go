@@ -30,6 +30,10 @@ const (
 	NamespaceNet    = "net"
 	NamespaceTxPool = "txpool"
 	NamespaceDebug  = "debug"
+	// NamespaceBundler enables the ERC-4337 bundler methods. They are served
+	// in the "eth" namespace, but are off unless "bundler" is in the enabled
+	// APIs.
+	NamespaceBundler = "bundler"
 
 	apiVersion = "1.0"
 )
@@ -100,6 +104,22 @@ func init() {
 				},
 			}
 		},
+		NamespaceBundler: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer eth.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: NamespaceEth,
+					Version:   apiVersion,
+					Service:   NewImplBundlerAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 		NamespaceDebug: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
@@ -0,0 +1,111 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"context"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/backend"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+)
+
+const (
+	// userOpTxCacheSize is the number of user operations sent through the node
+	// whose transaction hashes are remembered.
+	userOpTxCacheSize = 4096
+	// userOpReceiptLookbackBlocks is the number of recent blocks that are
+	// searched for the receipt of a user operation that wasn't sent through
+	// the node.
+	userOpReceiptLookbackBlocks int64 = 1000
+)
+
+// BundlerAPI is the ERC-4337 bundler API of the "eth" namespace, defined in
+// [ERC-7769]. User operations are submitted to the EntryPoint predeployed at
+// [embeds.ADDR_ENTRYPOINT] in a transaction signed by the bundler key of the
+// node, one operation per transaction. It is only served when the "bundler"
+// API is enabled.
+//
+// [ERC-7769]: https://eips.ethereum.org/EIPS/eip-7769
+type BundlerAPI struct {
+	logger  log.Logger
+	backend *backend.Backend
+	// userOpTxs maps the hash of each user operation sent through the node to
+	// the hash of its "handleOps" transaction.
+	userOpTxs *lru.Cache[common.Hash, common.Hash]
+}
+
+// NewImplBundlerAPI creates an instance of the bundler API.
+func NewImplBundlerAPI(logger log.Logger, backend *backend.Backend) *BundlerAPI {
+	return &BundlerAPI{
+		logger:    logger.With("api", "bundler"),
+		backend:   backend,
+		userOpTxs: lru.NewCache[common.Hash, common.Hash](userOpTxCacheSize),
+	}
+}
+
+// SendUserOperation validates and submits a user operation. Returns the hash
+// of the user operation.
+func (api *BundlerAPI) SendUserOperation(
+	op rpc.UserOperation, entryPoint common.Address,
+) (common.Hash, error) {
+	api.logger.Debug("eth_sendUserOperation", "sender", op.Sender.Hex())
+	userOpHash, txHash, err := api.backend.SendUserOperation(op, entryPoint)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	api.userOpTxs.Add(userOpHash, txHash)
+	return userOpHash, nil
+}
+
+// EstimateUserOperationGas estimates the gas limits of a user operation.
+func (api *BundlerAPI) EstimateUserOperationGas(
+	op rpc.UserOperation, entryPoint common.Address,
+) (*rpc.UserOperationGasEstimate, error) {
+	api.logger.Debug("eth_estimateUserOperationGas", "sender", op.Sender.Hex())
+	return api.backend.EstimateUserOperationGas(op, entryPoint)
+}
+
+// GetUserOperationReceipt returns the receipt of a user operation, or nil if
+// it isn't included in a block yet. Operations that weren't sent through this
+// node are found by searching the logs of the recent blocks.
+func (api *BundlerAPI) GetUserOperationReceipt(
+	ctx context.Context, userOpHash common.Hash,
+) (*rpc.UserOperationReceipt, error) {
+	api.logger.Debug("eth_getUserOperationReceipt", "hash", userOpHash.Hex())
+	if txHash, ok := api.userOpTxs.Get(userOpHash); ok {
+		return api.backend.GetUserOperationReceipt(userOpHash, txHash)
+	}
+
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	lookback := min(userOpReceiptLookbackBlocks, int64(api.backend.RPCBlockRangeCap()))
+	begin := max(int64(latest)-lookback, 0)
+	filter := NewRangeFilter(
+		api.logger, *api.backend, begin, int64(latest),
+		[]common.Address{embeds.ADDR_ENTRYPOINT},
+		[][]common.Hash{
+			{embeds.SmartContract_EntryPoint.ABI.Events["UserOperationEvent"].ID},
+			{userOpHash},
+		},
+	)
+	logs, err := filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
+	if err != nil || len(logs) == 0 {
+		return nil, err
+	}
+	txHash := logs[0].TxHash
+	api.userOpTxs.Add(userOpHash, txHash)
+	return api.backend.GetUserOperationReceipt(userOpHash, txHash)
+}
+
+// SupportedEntryPoints returns the EntryPoint contracts that the node accepts
+// user operations for.
+func (api *BundlerAPI) SupportedEntryPoints() ([]common.Address, error) {
+	api.logger.Debug("eth_supportedEntryPoints")
+	return api.backend.SupportedEntryPoints(), nil
+}
@@ -83,10 +83,11 @@ func (s *Suite) TestExpectedMethods() {
 		true, nil,
 		[]string{
 			rpcapi.NamespaceEth, // eth and filters services
+			rpcapi.NamespaceBundler,
 			rpcapi.NamespaceDebug,
 		},
 	)
-	s.Require().Len(apis, 3)
+	s.Require().Len(apis, 4)
 	type WantMethod struct {
 		ServiceName string
 		Methods     []string
@@ -129,6 +130,15 @@ func (s *Suite) TestExpectedMethods() {
 				"eth_getLogs",
 			},
 		},
+		{
+			ServiceName: "rpcapi.BundlerAPI",
+			Methods: []string{
+				"eth_estimateUserOperationGas",
+				"eth_getUserOperationReceipt",
+				"eth_sendUserOperation",
+				"eth_supportedEntryPoints",
+			},
+		},
 		{
 			ServiceName: "rpcapi.DebugAPI",
 			// See https://geth.ethereum.org/docs/interacting-with-geth/rpc/ns-debug
@@ -0,0 +1,146 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpc
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// UserOperation is an ERC-4337 user operation in the JSON format of the v0.7
+// bundler RPC methods, like "eth_sendUserOperation". Optional numeric fields
+// that are omitted are zero.
+type UserOperation struct {
+	Sender                        common.Address  `json:"sender"`
+	Nonce                         *hexutil.Big    `json:"nonce"`
+	Factory                       *common.Address `json:"factory,omitempty"`
+	FactoryData                   hexutil.Bytes   `json:"factoryData,omitempty"`
+	CallData                      hexutil.Bytes   `json:"callData"`
+	CallGasLimit                  *hexutil.Big    `json:"callGasLimit"`
+	VerificationGasLimit          *hexutil.Big    `json:"verificationGasLimit"`
+	PreVerificationGas            *hexutil.Big    `json:"preVerificationGas"`
+	MaxFeePerGas                  *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas          *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Paymaster                     *common.Address `json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit *hexutil.Big    `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big    `json:"paymasterPostOpGasLimit,omitempty"`
+	PaymasterData                 hexutil.Bytes   `json:"paymasterData,omitempty"`
+	Signature                     hexutil.Bytes   `json:"signature"`
+}
+
+// PackedUserOperation is the ABI tuple of a [UserOperation] taken by the
+// "handleOps" method of the EntryPoint.
+type PackedUserOperation struct {
+	Sender             common.Address
+	Nonce              *big.Int
+	InitCode           []byte
+	CallData           []byte
+	AccountGasLimits   [32]byte
+	PreVerificationGas *big.Int
+	GasFees            [32]byte
+	PaymasterAndData   []byte
+	Signature          []byte
+}
+
+// UserOperationGasEstimate is the result of "eth_estimateUserOperationGas".
+type UserOperationGasEstimate struct {
+	PreVerificationGas            hexutil.Uint64  `json:"preVerificationGas"`
+	VerificationGasLimit          hexutil.Uint64  `json:"verificationGasLimit"`
+	CallGasLimit                  hexutil.Uint64  `json:"callGasLimit"`
+	PaymasterVerificationGasLimit *hexutil.Uint64 `json:"paymasterVerificationGasLimit,omitempty"`
+}
+
+// UserOperationReceipt is the result of "eth_getUserOperationReceipt".
+type UserOperationReceipt struct {
+	UserOpHash    common.Hash     `json:"userOpHash"`
+	EntryPoint    common.Address  `json:"entryPoint"`
+	Sender        common.Address  `json:"sender"`
+	Nonce         *hexutil.Big    `json:"nonce"`
+	Paymaster     common.Address  `json:"paymaster"`
+	ActualGasCost *hexutil.Big    `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big    `json:"actualGasUsed"`
+	Success       bool            `json:"success"`
+	Reason        hexutil.Bytes   `json:"reason,omitempty"`
+	Logs          []*gethcore.Log `json:"logs"`
+	Receipt       any             `json:"receipt"`
+}
+
+// InitCode returns the factory address followed by the factory data, or nil
+// if the operation has no factory.
+func (op UserOperation) InitCode() []byte {
+	if op.Factory == nil {
+		return nil
+	}
+	return append(op.Factory.Bytes(), op.FactoryData...)
+}
+
+// PaymasterAndData returns the paymaster address, its gas limits, and the
+// paymaster data, or nil if the operation has no paymaster.
+func (op UserOperation) PaymasterAndData() []byte {
+	if op.Paymaster == nil {
+		return nil
+	}
+	gasLimits := packUints(
+		bigOrZero(op.PaymasterVerificationGasLimit),
+		bigOrZero(op.PaymasterPostOpGasLimit),
+	)
+	paymasterAndData := append(op.Paymaster.Bytes(), gasLimits[:]...)
+	return append(paymasterAndData, op.PaymasterData...)
+}
+
+// Pack returns the [PackedUserOperation] of the operation.
+func (op UserOperation) Pack() PackedUserOperation {
+	return PackedUserOperation{
+		Sender:   op.Sender,
+		Nonce:    bigOrZero(op.Nonce),
+		InitCode: op.InitCode(),
+		CallData: op.CallData,
+		AccountGasLimits: packUints(
+			bigOrZero(op.VerificationGasLimit), bigOrZero(op.CallGasLimit),
+		),
+		PreVerificationGas: bigOrZero(op.PreVerificationGas),
+		GasFees: packUints(
+			bigOrZero(op.MaxPriorityFeePerGas), bigOrZero(op.MaxFeePerGas),
+		),
+		PaymasterAndData: op.PaymasterAndData(),
+		Signature:        op.Signature,
+	}
+}
+
+// Hash returns the hash of the operation that the account signs, which is
+// the value of "getUserOpHash" on the EntryPoint.
+func (op UserOperation) Hash(entryPoint common.Address, chainID *big.Int) common.Hash {
+	packed := op.Pack()
+	opHash := crypto.Keccak256Hash(
+		common.LeftPadBytes(packed.Sender.Bytes(), 32),
+		common.BigToHash(packed.Nonce).Bytes(),
+		crypto.Keccak256(packed.InitCode),
+		crypto.Keccak256(packed.CallData),
+		packed.AccountGasLimits[:],
+		common.BigToHash(packed.PreVerificationGas).Bytes(),
+		packed.GasFees[:],
+		crypto.Keccak256(packed.PaymasterAndData),
+	)
+	return crypto.Keccak256Hash(
+		opHash.Bytes(),
+		common.LeftPadBytes(entryPoint.Bytes(), 32),
+		common.BigToHash(chainID).Bytes(),
+	)
+}
+
+// packUints packs two 128-bit values into a word, "high" first.
+func packUints(high, low *big.Int) (packed [32]byte) {
+	high.FillBytes(packed[:16])
+	low.FillBytes(packed[16:])
+	return packed
+}
+
+func bigOrZero(b *hexutil.Big) *big.Int {
+	if b == nil {
+		return new(big.Int)
+	}
+	return b.ToInt()
+}
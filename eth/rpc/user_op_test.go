@@ -0,0 +1,67 @@
+package rpc_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/NibiruChain/nibiru/v2/eth/rpc"
+)
+
+func TestUserOperationPack(t *testing.T) {
+	var op rpc.UserOperation
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"sender": "0x00000000000000000000000000000000000000aa",
+		"nonce": "0x2",
+		"factory": "0x00000000000000000000000000000000000000bb",
+		"factoryData": "0x1234",
+		"callData": "0xabcd",
+		"callGasLimit": "0x10",
+		"verificationGasLimit": "0x20",
+		"preVerificationGas": "0x30",
+		"maxFeePerGas": "0x40",
+		"maxPriorityFeePerGas": "0x50",
+		"paymaster": "0x00000000000000000000000000000000000000cc",
+		"paymasterVerificationGasLimit": "0x60",
+		"paymasterPostOpGasLimit": "0x70",
+		"paymasterData": "0x99",
+		"signature": "0x"
+	}`), &op))
+
+	packed := op.Pack()
+	require.Equal(t, big.NewInt(2), packed.Nonce)
+	require.Equal(t, "0x00000000000000000000000000000000000000bb1234", hexutil.Encode(packed.InitCode))
+	require.Equal(t,
+		"0x0000000000000000000000000000002000000000000000000000000000000010",
+		hexutil.Encode(packed.AccountGasLimits[:]),
+		"verificationGasLimit is the high 128 bits",
+	)
+	require.Equal(t,
+		"0x0000000000000000000000000000005000000000000000000000000000000040",
+		hexutil.Encode(packed.GasFees[:]),
+		"maxPriorityFeePerGas is the high 128 bits",
+	)
+	require.Equal(t,
+		"0x00000000000000000000000000000000000000cc"+
+			"00000000000000000000000000000060"+
+			"00000000000000000000000000000070"+
+			"99",
+		hexutil.Encode(packed.PaymasterAndData),
+	)
+
+	// Without a factory and a paymaster, both fields are empty.
+	op.Factory, op.Paymaster = nil, nil
+	packed = op.Pack()
+	require.Empty(t, packed.InitCode)
+	require.Empty(t, packed.PaymasterAndData)
+
+	// The hash commits to the entry point and the chain ID.
+	entryPoint := common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+	hash := op.Hash(entryPoint, big.NewInt(1))
+	require.NotEqual(t, hash, op.Hash(entryPoint, big.NewInt(2)))
+	require.NotEqual(t, hash, op.Hash(common.Address{}, big.NewInt(1)))
+}
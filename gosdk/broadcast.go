@@ -22,28 +22,50 @@ func BroadcastMsgsWithSeq(
 	seq uint64,
 	msgs ...sdk.Msg,
 ) (*sdk.TxResponse, error) {
-	broadcaster := args.Broadcaster
-
-	info, err := args.kring.KeyByAddress(from)
+	nums, err := args.gosdk.GetAccountNumbers(from.String())
 	if err != nil {
 		return nil, err
 	}
+	nums.Sequence = seq
 
-	txBuilder := args.txCfg.NewTxBuilder()
-	err = txBuilder.SetMsgs(msgs...)
+	txBytes, err := signMsgs(args, from, nums, DefaultGasLimit, DefaultFee(), msgs...)
 	if err != nil {
 		return nil, err
 	}
+	return args.Broadcaster.BroadcastTxSync(txBytes)
+}
 
-	bondDenom := denoms.NIBI
-	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(1000))))
-	txBuilder.SetGasLimit(uint64(2 * common.TO_MICRO))
+// DefaultGasLimit is the gas limit of txs broadcast without gas estimation.
+var DefaultGasLimit = uint64(2 * common.TO_MICRO)
 
-	nums, err := args.gosdk.GetAccountNumbers(from.String())
+// DefaultFee returns the fee paid by txs broadcast with [BroadcastMsgs].
+func DefaultFee() sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(denoms.NIBI, sdk.NewInt(1000)))
+}
+
+// signMsgs builds a tx with the msgs, signs it with the key of "from" using
+// the given account number and sequence, and returns the encoded tx.
+func signMsgs(
+	args BroadcastArgs,
+	from sdk.AccAddress,
+	nums AccountNumbers,
+	gasLimit uint64,
+	fee sdk.Coins,
+	msgs ...sdk.Msg,
+) (txBytes []byte, err error) {
+	info, err := args.kring.KeyByAddress(from)
 	if err != nil {
 		return nil, err
 	}
 
+	txBuilder := args.txCfg.NewTxBuilder()
+	err = txBuilder.SetMsgs(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gasLimit)
+
 	var accRetriever sdkclient.AccountRetriever = authtypes.AccountRetriever{}
 	txFactory := sdkclienttx.Factory{}.
 		WithChainID(args.chainID).
@@ -51,7 +73,7 @@ func BroadcastMsgsWithSeq(
 		WithTxConfig(args.txCfg).
 		WithAccountRetriever(accRetriever).
 		WithAccountNumber(nums.Number).
-		WithSequence(seq)
+		WithSequence(nums.Sequence)
 
 	overwriteSig := true
 	err = sdkclienttx.Sign(txFactory, info.Name, txBuilder, overwriteSig)
@@ -59,12 +81,7 @@ func BroadcastMsgsWithSeq(
 		return nil, err
 	}
 
-	txBytes, err := args.txCfg.TxEncoder()(txBuilder.GetTx())
-	if err != nil {
-		return nil, err
-	}
-
-	return broadcaster.BroadcastTxSync(txBytes)
+	return args.txCfg.TxEncoder()(txBuilder.GetTx())
 }
 
 func BroadcastMsgs(
@@ -0,0 +1,217 @@
+package gosdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	sdkioerrors "cosmossdk.io/errors"
+	cmtcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktypestx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// RetryConfig configures a [RetryingBroadcaster].
+type RetryConfig struct {
+	// MaxRetries is the number of times a tx is sent again after a sequence
+	// mismatch or running out of gas.
+	MaxRetries int
+	// PollInterval is the time between [NibiruSDK.TxByHash] queries while
+	// waiting for a tx to be included.
+	PollInterval time.Duration
+	// ConfirmTimeout is how long to wait for a tx to be included before giving
+	// up on it.
+	ConfirmTimeout time.Duration
+	// GasAdjustment multiplies the gas used in simulation to get the gas
+	// limit. If zero, txs use [DefaultGasLimit] without simulating. Each retry
+	// after running out of gas raises the adjustment by half of its value.
+	GasAdjustment float64
+	// Fee is the fee paid by every tx.
+	Fee sdk.Coins
+}
+
+// DefaultRetryConfig returns the [RetryConfig] used by
+// [NibiruSDK.NewRetryingBroadcaster].
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries:     3,
+		PollInterval:   500 * time.Millisecond,
+		ConfirmTimeout: 30 * time.Second,
+		GasAdjustment:  1.5,
+		Fee:            DefaultFee(),
+	}
+}
+
+// RetryingBroadcaster sends txs with sequences from a [SequenceManager] and
+// confirms their inclusion with [NibiruSDK.TxByHash]. Txs that fail with a
+// sequence mismatch are resigned with the expected sequence, and txs that run
+// out of gas are resent with a higher gas limit. It is safe to use from
+// several goroutines.
+type RetryingBroadcaster struct {
+	SDK       *NibiruSDK
+	Sequences *SequenceManager
+	Config    RetryConfig
+}
+
+// NewRetryingBroadcaster creates a RetryingBroadcaster with its own
+// [SequenceManager] and the [DefaultRetryConfig].
+func (nc *NibiruSDK) NewRetryingBroadcaster() *RetryingBroadcaster {
+	return &RetryingBroadcaster{
+		SDK:       nc,
+		Sequences: nc.NewSequenceManager(),
+		Config:    DefaultRetryConfig(),
+	}
+}
+
+// BroadcastMsgs signs and broadcasts a tx with the msgs from "from", and waits
+// for it to be included in a block. It returns the included tx, which may
+// have a non-zero code if it failed for a reason other than gas. The error is
+// set if the tx could not be included within the retries.
+func (b *RetryingBroadcaster) BroadcastMsgs(
+	ctx context.Context, from sdk.AccAddress, msgs ...sdk.Msg,
+) (*cmtcoretypes.ResultTx, error) {
+	cfg := b.Config
+	gasAdjustment := cfg.GasAdjustment
+	args := initBroadcastArgs(b.SDK, BroadcasterTmRpc{RPC: b.SDK.CometRPC})
+
+	var lastErr error
+	for attempt := 0; attempt <= cfg.MaxRetries; attempt++ {
+		nums, err := b.Sequences.Reserve(from)
+		if err != nil {
+			return nil, err
+		}
+
+		gasLimit := DefaultGasLimit
+		if gasAdjustment > 0 {
+			gasLimit, err = b.estimateGas(args, from, nums, gasAdjustment, msgs...)
+			if err != nil {
+				b.Sequences.Forget(from)
+				return nil, err
+			}
+		}
+
+		txBytes, err := signMsgs(args, from, nums, gasLimit, cfg.Fee, msgs...)
+		if err != nil {
+			b.Sequences.Forget(from)
+			return nil, err
+		}
+		txResp, err := args.Broadcaster.BroadcastTxSync(txBytes)
+		if err != nil {
+			b.Sequences.Forget(from)
+			return nil, err
+		}
+		if txResp.Code != 0 {
+			// Txs that fail CheckTx don't use their sequence. Later sequences
+			// may already be reserved, so the account is fetched again instead
+			// of giving the sequence back.
+			lastErr = sdkioerrors.ABCIError(txResp.Codespace, txResp.Code, txResp.RawLog)
+			switch {
+			case sdkerrors.ErrWrongSequence.Is(lastErr):
+				if err := b.resync(from, lastErr); err != nil {
+					return nil, err
+				}
+				continue
+			case sdkerrors.ErrOutOfGas.Is(lastErr) && gasAdjustment > 0:
+				b.Sequences.Forget(from)
+				gasAdjustment *= 1.5
+				continue
+			default:
+				b.Sequences.Forget(from)
+				return nil, lastErr
+			}
+		}
+
+		resTx, err := b.waitForTx(ctx, txResp.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		if resTx.TxResult.Code == sdkerrors.ErrOutOfGas.ABCICode() &&
+			resTx.TxResult.Codespace == sdkerrors.ErrOutOfGas.Codespace() &&
+			gasAdjustment > 0 {
+			lastErr = sdkioerrors.ABCIError(
+				resTx.TxResult.Codespace, resTx.TxResult.Code, resTx.TxResult.Log,
+			)
+			gasAdjustment *= 1.5
+			continue
+		}
+		return resTx, nil
+	}
+	return nil, sdkioerrors.Wrapf(lastErr, "tx failed after %d retries", cfg.MaxRetries)
+}
+
+// resync sets the next sequence of the account to the one expected by the ante
+// handler after a sequence mismatch.
+func (b *RetryingBroadcaster) resync(from sdk.AccAddress, errWrongSeq error) error {
+	var expectedSeq *uint64
+	if seq, ok := ParseExpectedSequence(errWrongSeq.Error()); ok {
+		expectedSeq = &seq
+	}
+	return b.Sequences.Resync(from, expectedSeq)
+}
+
+// estimateGas simulates the tx and returns the gas used times the adjustment.
+// Simulation checks the sequence against the state of the mempool, which
+// doesn't include txs with earlier sequences that other goroutines haven't
+// broadcast yet. The gas used doesn't depend on the sequence, so on a mismatch
+// the tx is simulated again with the expected sequence, which may change in
+// between as other txs enter the mempool.
+func (b *RetryingBroadcaster) estimateGas(
+	args BroadcastArgs,
+	from sdk.AccAddress,
+	nums AccountNumbers,
+	gasAdjustment float64,
+	msgs ...sdk.Msg,
+) (uint64, error) {
+	simulate := func(nums AccountNumbers) (*sdktypestx.SimulateResponse, error) {
+		txBytes, err := signMsgs(args, from, nums, DefaultGasLimit, b.Config.Fee, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		return sdktypestx.NewServiceClient(b.SDK.Querier.ClientConn).Simulate(
+			context.Background(), &sdktypestx.SimulateRequest{TxBytes: txBytes},
+		)
+	}
+	var err error
+	for attempt := 0; attempt <= b.Config.MaxRetries; attempt++ {
+		var resp *sdktypestx.SimulateResponse
+		resp, err = simulate(nums)
+		if err == nil {
+			return uint64(math.Ceil(float64(resp.GasInfo.GasUsed) * gasAdjustment)), nil
+		}
+		// The gRPC status only keeps the message of the ABCI error.
+		seq, ok := ParseExpectedSequence(err.Error())
+		if !ok {
+			break
+		}
+		nums.Sequence = seq
+	}
+	return 0, sdkioerrors.Wrap(err, "failed to simulate tx")
+}
+
+// waitForTx polls for the tx until it is included or the confirm timeout has
+// passed.
+func (b *RetryingBroadcaster) waitForTx(
+	ctx context.Context, txHashHex string,
+) (*cmtcoretypes.ResultTx, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.Config.ConfirmTimeout)
+	defer cancel()
+	ticker := time.NewTicker(b.Config.PollInterval)
+	defer ticker.Stop()
+	for {
+		resTx, err := b.SDK.TxByHash(txHashHex)
+		if err == nil {
+			return resTx, nil
+		}
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("tx %s was not included within %s: %w", txHashHex, b.Config.ConfirmTimeout, err)
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
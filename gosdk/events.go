@@ -0,0 +1,371 @@
+package gosdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// Backpressure decides what happens to events when the consumer of a
+// subscription reads slower than blocks are produced.
+type Backpressure int
+
+const (
+	// BackpressureBlock pauses reading blocks until the consumer catches up.
+	// No events are lost, since the subscription resumes from the last height
+	// it delivered.
+	BackpressureBlock Backpressure = iota
+	// BackpressureDrop drops events that don't fit in the buffer and reports
+	// the number dropped on the error channel.
+	BackpressureDrop
+)
+
+// SubscribeEventsOpts configures [NibiruSDK.SubscribeEvents].
+type SubscribeEventsOpts struct {
+	// EventTypes are the proto message names of the typed events to deliver,
+	// like "nibiru.oracle.v1.EventPriceUpdate". If empty, every typed event
+	// is delivered. Events that aren't proto messages, like "transfer", are
+	// always skipped.
+	EventTypes []string
+	// FromHeight is the first block height to deliver events from. Blocks
+	// before the latest one are read with BlockResults. If zero, the
+	// subscription starts at the next block.
+	FromHeight int64
+	// BufferSize is the capacity of the event channel.
+	BufferSize int
+	// Backpressure is the policy when the event channel is full.
+	Backpressure Backpressure
+	// PollInterval is how often the latest height is polled in case the
+	// websocket subscription misses a block or disconnects.
+	PollInterval time.Duration
+	// ReconnectBackoff is the wait before subscribing again after the
+	// websocket subscription fails.
+	ReconnectBackoff time.Duration
+}
+
+// DefaultSubscribeEventsOpts returns the options used for zero fields of
+// [SubscribeEventsOpts].
+func DefaultSubscribeEventsOpts() SubscribeEventsOpts {
+	return SubscribeEventsOpts{
+		BufferSize:       256,
+		Backpressure:     BackpressureBlock,
+		PollInterval:     5 * time.Second,
+		ReconnectBackoff: time.Second,
+	}
+}
+
+// TypedEvent is a typed event decoded from a block.
+type TypedEvent struct {
+	// Height is the height of the block that emitted the event.
+	Height int64
+	// TxHash is the hex-encoded hash of the tx that emitted the event. It is
+	// empty for events from BeginBlock and EndBlock.
+	TxHash string
+	// Event is the decoded event, for example *oracletypes.EventPriceUpdate.
+	Event proto.Message
+	// Raw is the ABCI event.
+	Raw abci.Event
+}
+
+// ErrEventsDropped is sent on the error channel of a subscription with
+// [BackpressureDrop] when events are dropped.
+type ErrEventsDropped struct {
+	Height  int64
+	Dropped int
+}
+
+func (e ErrEventsDropped) Error() string {
+	return fmt.Sprintf("dropped %d events at height %d: event channel is full", e.Dropped, e.Height)
+}
+
+// SubscribeEvents streams the typed events emitted by blocks, in order of
+// height, until the context is done. New blocks are announced over the
+// CometBFT websocket and, as a fallback, by polling the latest height. The
+// events of every block are read with BlockResults, so blocks missed while
+// disconnected are filled in when the subscription reconnects.
+//
+// Both channels are closed when the subscription stops. Errors that the
+// subscription recovers from, like a dropped websocket, are sent on the
+// error channel without blocking and don't stop it.
+func (nc *NibiruSDK) SubscribeEvents(
+	ctx context.Context, opts SubscribeEventsOpts,
+) (<-chan TypedEvent, <-chan error, error) {
+	defaults := DefaultSubscribeEventsOpts()
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = defaults.BufferSize
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaults.PollInterval
+	}
+	if opts.ReconnectBackoff <= 0 {
+		opts.ReconnectBackoff = defaults.ReconnectBackoff
+	}
+
+	status, err := nc.CometRPC.Status(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	nextHeight := opts.FromHeight
+	if nextHeight <= 0 {
+		nextHeight = status.SyncInfo.LatestBlockHeight + 1
+	}
+
+	sub := &eventSubscription{
+		nc:         nc,
+		opts:       opts,
+		eventTypes: make(map[string]bool),
+		events:     make(chan TypedEvent, opts.BufferSize),
+		errs:       make(chan error, opts.BufferSize),
+		newBlock:   make(chan struct{}, 1),
+	}
+	for _, eventType := range opts.EventTypes {
+		sub.eventTypes[eventType] = true
+	}
+	sub.latestHeight.Store(status.SyncInfo.LatestBlockHeight)
+
+	sub.watcherDone.Add(1)
+	go sub.watchNewBlocks(ctx)
+	go sub.run(ctx, nextHeight)
+	return sub.events, sub.errs, nil
+}
+
+// SubscribeEventsOf streams the events of type T. See
+// [NibiruSDK.SubscribeEvents] for the behavior of the subscription.
+func SubscribeEventsOf[T proto.Message](
+	ctx context.Context, nc *NibiruSDK, opts SubscribeEventsOpts,
+) (<-chan T, <-chan error, error) {
+	var zero T
+	opts.EventTypes = []string{proto.MessageName(zero)}
+	events, errs, err := nc.SubscribeEvents(ctx, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	out := make(chan T, cap(events))
+	go func() {
+		defer close(out)
+		for event := range events {
+			typed, ok := event.Event.(T)
+			if !ok {
+				continue
+			}
+			select {
+			case out <- typed:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, errs, nil
+}
+
+type eventSubscription struct {
+	nc         *NibiruSDK
+	opts       SubscribeEventsOpts
+	eventTypes map[string]bool
+
+	events chan TypedEvent
+	errs   chan error
+
+	// latestHeight is the highest block height known to exist.
+	latestHeight atomic.Int64
+	// newBlock wakes up the reader when latestHeight increases.
+	newBlock chan struct{}
+	// watcherDone lets the reader close the error channel only after the
+	// block watcher, which also reports errors, has stopped.
+	watcherDone sync.WaitGroup
+}
+
+const newBlockQuery = "tm.event='NewBlock'"
+
+// watchNewBlocks keeps latestHeight up to date from the websocket and from
+// polling, and reconnects the websocket when it fails.
+func (s *eventSubscription) watchNewBlocks(ctx context.Context) {
+	defer s.watcherDone.Done()
+	poll := time.NewTicker(s.opts.PollInterval)
+	defer poll.Stop()
+	subscriber := fmt.Sprintf("gosdk-events-%d", time.Now().UnixNano())
+
+	for ctx.Err() == nil {
+		blocks, err := s.subscribeNewBlocks(ctx, subscriber)
+		if err != nil {
+			s.reportErr(fmt.Errorf("failed to subscribe to new blocks: %w", err))
+		}
+
+	readBlocks:
+		for {
+			select {
+			case <-ctx.Done():
+				break readBlocks
+			case <-poll.C:
+				if status, err := s.nc.CometRPC.Status(ctx); err == nil {
+					s.setLatestHeight(status.SyncInfo.LatestBlockHeight)
+				}
+				if blocks == nil {
+					break readBlocks // reconnect
+				}
+			case result, ok := <-blocks:
+				if !ok {
+					s.reportErr(fmt.Errorf("new block subscription closed"))
+					break readBlocks
+				}
+				if block, ok := result.Data.(cmttypes.EventDataNewBlock); ok && block.Block != nil {
+					s.setLatestHeight(block.Block.Height)
+				}
+			}
+		}
+
+		if blocks != nil {
+			_ = s.nc.CometRPC.Unsubscribe(context.Background(), subscriber, newBlockQuery)
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.opts.ReconnectBackoff):
+		}
+	}
+}
+
+func (s *eventSubscription) subscribeNewBlocks(
+	ctx context.Context, subscriber string,
+) (<-chan cmtcoretypes.ResultEvent, error) {
+	if !s.nc.CometRPC.IsRunning() {
+		if err := s.nc.CometRPC.Start(); err != nil {
+			return nil, err
+		}
+	}
+	return s.nc.CometRPC.Subscribe(ctx, subscriber, newBlockQuery, s.opts.BufferSize)
+}
+
+func (s *eventSubscription) setLatestHeight(height int64) {
+	for {
+		latest := s.latestHeight.Load()
+		if height <= latest {
+			return
+		}
+		if s.latestHeight.CompareAndSwap(latest, height) {
+			break
+		}
+	}
+	select {
+	case s.newBlock <- struct{}{}:
+	default:
+	}
+}
+
+// run delivers the events of every block from nextHeight on, in order.
+func (s *eventSubscription) run(ctx context.Context, nextHeight int64) {
+	defer func() {
+		close(s.events)
+		s.watcherDone.Wait()
+		close(s.errs)
+	}()
+
+	for {
+		for nextHeight <= s.latestHeight.Load() {
+			if err := s.deliverBlock(ctx, nextHeight); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				s.reportErr(err)
+				break // retry the block after the next wake-up
+			}
+			nextHeight++
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.newBlock:
+		case <-time.After(s.opts.PollInterval):
+		}
+	}
+}
+
+// deliverBlock reads the events of the block at the height and sends the
+// typed events that match the filter.
+func (s *eventSubscription) deliverBlock(ctx context.Context, height int64) error {
+	results, err := s.nc.CometRPC.BlockResults(ctx, &height)
+	if err != nil {
+		return fmt.Errorf("failed to query block results at height %d: %w", height, err)
+	}
+
+	var txHashes []string
+	if len(results.TxsResults) > 0 {
+		block, err := s.nc.CometRPC.Block(ctx, &height)
+		if err != nil {
+			return fmt.Errorf("failed to query block at height %d: %w", height, err)
+		}
+		for _, tx := range block.Block.Txs {
+			txHashes = append(txHashes, fmt.Sprintf("%X", tx.Hash()))
+		}
+	}
+
+	var events []TypedEvent
+	collect := func(txHash string, abciEvents []abci.Event) {
+		for _, abciEvent := range abciEvents {
+			if len(s.eventTypes) > 0 && !s.eventTypes[abciEvent.Type] {
+				continue
+			}
+			if proto.MessageType(abciEvent.Type) == nil {
+				continue // not a typed event
+			}
+			event, err := sdk.ParseTypedEvent(abciEvent)
+			if err != nil {
+				s.reportErr(fmt.Errorf("failed to decode event %s at height %d: %w", abciEvent.Type, height, err))
+				continue
+			}
+			events = append(events, TypedEvent{
+				Height: height,
+				TxHash: txHash,
+				Event:  event,
+				Raw:    abciEvent,
+			})
+		}
+	}
+	collect("", results.BeginBlockEvents)
+	for i, txResult := range results.TxsResults {
+		var txHash string
+		if i < len(txHashes) {
+			txHash = txHashes[i]
+		}
+		collect(txHash, txResult.Events)
+	}
+	collect("", results.EndBlockEvents)
+
+	dropped := 0
+	for _, event := range events {
+		switch s.opts.Backpressure {
+		case BackpressureDrop:
+			select {
+			case s.events <- event:
+			default:
+				dropped++
+			}
+		default:
+			select {
+			case s.events <- event:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	if dropped > 0 {
+		s.reportErr(ErrEventsDropped{Height: height, Dropped: dropped})
+	}
+	return nil
+}
+
+// reportErr sends the error if there is room on the error channel, so that a
+// consumer that ignores errors doesn't stall the subscription.
+func (s *eventSubscription) reportErr(err error) {
+	select {
+	case s.errs <- err:
+	default:
+	}
+}
@@ -0,0 +1,51 @@
+package gosdk_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/NibiruChain/nibiru/v2/gosdk"
+	tftypes "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
+)
+
+// DoTestSubscribeEvents creates a denom and expects its typed event from a live
+// subscription and from one that resumes at an earlier height.
+func (s *TestSuite) DoTestSubscribeEvents() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	opts := gosdk.SubscribeEventsOpts{PollInterval: time.Second}
+	events, _, err := gosdk.SubscribeEventsOf[*tftypes.EventCreateDenom](ctx, s.nibiruSdk, opts)
+	s.Require().NoError(err)
+
+	from := s.val.Address
+	resTx, err := s.nibiruSdk.NewRetryingBroadcaster().BroadcastMsgs(ctx, from, &tftypes.MsgCreateDenom{
+		Sender:   from.String(),
+		Subdenom: "streamed",
+	})
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, resTx.TxResult.Code, resTx.TxResult.Log)
+	wantDenom := tftypes.TFDenom{Creator: from.String(), Subdenom: "streamed"}.Denom().String()
+
+	select {
+	case event := <-events:
+		s.Equal(wantDenom, event.Denom)
+		s.Equal(from.String(), event.Creator)
+	case <-ctx.Done():
+		s.Fail("timed out waiting for EventCreateDenom")
+	}
+
+	s.T().Log("A subscription from an earlier height reads past blocks")
+	opts.FromHeight = resTx.Height
+	opts.EventTypes = []string{"nibiru.tokenfactory.v1.EventCreateDenom"}
+	allEvents, _, err := s.nibiruSdk.SubscribeEvents(ctx, opts)
+	s.Require().NoError(err)
+	select {
+	case event := <-allEvents:
+		s.Equal(resTx.Height, event.Height)
+		s.Equal(resTx.Hash.String(), event.TxHash)
+		s.Equal(wantDenom, event.Event.(*tftypes.EventCreateDenom).Denom)
+	case <-ctx.Done():
+		s.Fail("timed out waiting for EventCreateDenom")
+	}
+}
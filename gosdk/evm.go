@@ -0,0 +1,335 @@
+package gosdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	serverconfig "github.com/NibiruChain/nibiru/v2/app/server/config"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// EvmGasCap is the gas cap passed to the EthCall and EstimateGas queries. It
+// matches the default "json-rpc.gas-cap" of a node.
+var EvmGasCap = serverconfig.DefaultEthCallGasLimit
+
+// EvmReceipt is the outcome of an included MsgEthereumTx.
+type EvmReceipt struct {
+	// EthTxHash is the Ethereum hash of the tx.
+	EthTxHash gethcommon.Hash
+	// CosmosTxHash is the hex-encoded hash of the Cosmos tx wrapping it.
+	CosmosTxHash string
+	// Height is the block height that included the tx.
+	Height int64
+	// Code is the ABCI code of the Cosmos tx. Zero means success.
+	Code uint32
+	// RawLog is the ABCI log of the Cosmos tx.
+	RawLog string
+	// Response has the EVM logs, return data, gas used, and VM error.
+	Response *evm.MsgEthereumTxResponse
+}
+
+// Failed returns true if the tx failed, either in the ABCI handler or in the
+// EVM, for example from a revert.
+func (r EvmReceipt) Failed() bool {
+	return r.Code != 0 || r.Response == nil || r.Response.Failed()
+}
+
+// EthChainID returns the EIP-155 chain ID of the network.
+func (nc *NibiruSDK) EthChainID() *big.Int {
+	return appconst.GetEthChainID(nc.ChainId)
+}
+
+// EvmNonce returns the nonce of the Ethereum account, which is the sequence
+// number of its Cosmos account. Accounts that don't exist yet have nonce 0.
+func (nc *NibiruSDK) EvmNonce(addr gethcommon.Address) (uint64, error) {
+	resp, err := nc.Querier.EVM.EthAccount(
+		context.Background(), &evm.QueryEthAccountRequest{Address: addr.Hex()},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Nonce, nil
+}
+
+// EvmBaseFeeWei returns the EIP-1559 base fee of the network in wei.
+func (nc *NibiruSDK) EvmBaseFeeWei() (*big.Int, error) {
+	resp, err := nc.Querier.EVM.BaseFee(context.Background(), &evm.QueryBaseFeeRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.BaseFee == nil {
+		return nil, fmt.Errorf("base fee query returned no base fee")
+	}
+	return resp.BaseFee.BigInt(), nil
+}
+
+// EstimateEvmGas returns the gas limit needed to execute the tx with the
+// EstimateGas query.
+func (nc *NibiruSDK) EstimateEvmGas(args evm.JsonTxArgs) (uint64, error) {
+	argsBz, err := json.Marshal(&args)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := nc.Querier.EVM.EstimateGas(context.Background(), &evm.EthCallRequest{
+		Args:    argsBz,
+		GasCap:  EvmGasCap,
+		ChainId: nc.EthChainID().Int64(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Gas, nil
+}
+
+// FillEvmTxDefaults populates the fields of the tx left empty by the caller:
+//   - Nonce: the current nonce of the sender.
+//   - Fees: a legacy tx pays the base fee as its gas price. A dynamic fee tx,
+//     selected by setting "MaxFeePerGas" or "MaxPriorityFeePerGas", gets a tip
+//     of zero and a fee cap of the tip plus twice the base fee.
+//   - Gas: the gas limit from EstimateGas.
+//   - ChainID: the EIP-155 chain ID of the network.
+//
+// The tx type follows from the fields that are set: dynamic fee if
+// "MaxFeePerGas" is set, access list if "AccessList" is set, and legacy
+// otherwise.
+func (nc *NibiruSDK) FillEvmTxDefaults(args evm.JsonTxArgs) (evm.JsonTxArgs, error) {
+	if args.From == nil {
+		return args, fmt.Errorf("EVM tx is missing the sender address \"from\"")
+	}
+	if args.GasPrice != nil && (args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil) {
+		return args, fmt.Errorf("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
+	}
+
+	if args.Nonce == nil {
+		nonce, err := nc.EvmNonce(*args.From)
+		if err != nil {
+			return args, sdkioerrors.Wrap(err, "failed to query nonce")
+		}
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+
+	if args.GasPrice == nil && (args.MaxFeePerGas == nil || args.MaxPriorityFeePerGas == nil) {
+		baseFee, err := nc.EvmBaseFeeWei()
+		if err != nil {
+			return args, sdkioerrors.Wrap(err, "failed to query base fee")
+		}
+		switch {
+		case args.MaxFeePerGas == nil && args.MaxPriorityFeePerGas == nil:
+			args.GasPrice = (*hexutil.Big)(baseFee)
+		case args.MaxPriorityFeePerGas == nil:
+			args.MaxPriorityFeePerGas = (*hexutil.Big)(new(big.Int))
+		default:
+			args.MaxFeePerGas = (*hexutil.Big)(new(big.Int).Add(
+				args.MaxPriorityFeePerGas.ToInt(), new(big.Int).Mul(baseFee, big.NewInt(2)),
+			))
+		}
+	}
+	if args.MaxFeePerGas != nil && args.MaxFeePerGas.ToInt().Cmp(args.MaxPriorityFeePerGas.ToInt()) < 0 {
+		return args, fmt.Errorf(
+			"maxFeePerGas (%v) < maxPriorityFeePerGas (%v)", args.MaxFeePerGas, args.MaxPriorityFeePerGas,
+		)
+	}
+
+	if args.Value == nil {
+		args.Value = new(hexutil.Big)
+	}
+	if args.ChainID == nil {
+		args.ChainID = (*hexutil.Big)(nc.EthChainID())
+	}
+
+	if args.Gas == nil {
+		gas, err := nc.EstimateEvmGas(args)
+		if err != nil {
+			return args, sdkioerrors.Wrap(err, "failed to estimate gas")
+		}
+		args.Gas = (*hexutil.Uint64)(&gas)
+	}
+	return args, nil
+}
+
+// SignEvmTx builds a MsgEthereumTx from the args and signs it with the key of
+// "args.From" in the keyring, which must be an "eth_secp256k1" key. The args
+// must be complete. See [NibiruSDK.FillEvmTxDefaults].
+func (nc *NibiruSDK) SignEvmTx(args evm.JsonTxArgs) (*evm.MsgEthereumTx, error) {
+	if args.From == nil {
+		return nil, fmt.Errorf("EVM tx is missing the sender address \"from\"")
+	}
+	msg := args.ToMsgEthTx()
+	msg.From = args.From.Hex()
+	signer := gethcore.LatestSignerForChainID(nc.EthChainID())
+	if err := msg.Sign(signer, nc.Keyring); err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to sign EVM tx")
+	}
+	return msg, nil
+}
+
+// BroadcastEvmTx wraps a signed MsgEthereumTx in a Cosmos tx and broadcasts it
+// in sync mode. It returns the Ethereum hash of the tx, which is the key for
+// [NibiruSDK.WaitForEvmReceipt].
+func (nc *NibiruSDK) BroadcastEvmTx(
+	msg *evm.MsgEthereumTx,
+) (ethTxHash gethcommon.Hash, txResp *sdk.TxResponse, err error) {
+	ethTxHash = msg.AsTransaction().Hash()
+	cosmosTx, err := msg.BuildTx(nc.EncCfg.TxConfig.NewTxBuilder(), evm.EVMBankDenom)
+	if err != nil {
+		return ethTxHash, nil, sdkioerrors.Wrap(err, "failed to build Cosmos tx")
+	}
+	txBytes, err := nc.EncCfg.TxConfig.TxEncoder()(cosmosTx)
+	if err != nil {
+		return ethTxHash, nil, err
+	}
+
+	broadcaster := BroadcasterTmRpc{RPC: nc.CometRPC}
+	txResp, err = broadcaster.BroadcastTxSync(txBytes)
+	if err != nil {
+		return ethTxHash, txResp, err
+	}
+	if txResp.Code != 0 {
+		return ethTxHash, txResp, sdkioerrors.ABCIError(txResp.Codespace, txResp.Code, txResp.RawLog)
+	}
+	return ethTxHash, txResp, nil
+}
+
+// SendEvmTx fills in the defaults of the tx, signs it, and broadcasts it.
+func (nc *NibiruSDK) SendEvmTx(
+	args evm.JsonTxArgs,
+) (ethTxHash gethcommon.Hash, txResp *sdk.TxResponse, err error) {
+	args, err = nc.FillEvmTxDefaults(args)
+	if err != nil {
+		return ethTxHash, nil, err
+	}
+	msg, err := nc.SignEvmTx(args)
+	if err != nil {
+		return ethTxHash, nil, err
+	}
+	return nc.BroadcastEvmTx(msg)
+}
+
+// GetEvmReceipt returns the receipt of an included EVM tx. It returns an error
+// if the tx is not in a block.
+func (nc *NibiruSDK) GetEvmReceipt(
+	ctx context.Context, ethTxHash gethcommon.Hash,
+) (*EvmReceipt, error) {
+	query := fmt.Sprintf(
+		"%s.%s='%s'", evm.PendingEthereumTxEvent, evm.PendingEthereumTxEventAttrEthHash, ethTxHash.Hex(),
+	)
+	res, err := nc.CometRPC.TxSearch(ctx, query, false, nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Txs) == 0 {
+		return nil, fmt.Errorf("EVM tx not found: %s", ethTxHash.Hex())
+	}
+
+	txResult := res.Txs[0]
+	receipt := &EvmReceipt{
+		EthTxHash:    ethTxHash,
+		CosmosTxHash: txResult.Hash.String(),
+		Height:       txResult.Height,
+		Code:         txResult.TxResult.Code,
+		RawLog:       txResult.TxResult.Log,
+	}
+	if txResult.TxResult.Code == 0 {
+		receipt.Response, err = evm.DecodeTxResponse(txResult.TxResult.Data)
+		if err != nil {
+			return nil, sdkioerrors.Wrap(err, "failed to decode EVM tx response")
+		}
+	}
+	return receipt, nil
+}
+
+// WaitForEvmReceipt polls for the receipt of the EVM tx until it is included
+// in a block or the context is done.
+func (nc *NibiruSDK) WaitForEvmReceipt(
+	ctx context.Context, ethTxHash gethcommon.Hash,
+) (*EvmReceipt, error) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		receipt, err := nc.GetEvmReceipt(ctx, ethTxHash)
+		if err == nil {
+			return receipt, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, sdkioerrors.Wrapf(ctx.Err(), "EVM tx %s was not included: %s", ethTxHash.Hex(), err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// EthCall executes the tx against the latest state without broadcasting it
+// and returns the EVM response.
+func (nc *NibiruSDK) EthCall(args evm.JsonTxArgs) (*evm.MsgEthereumTxResponse, error) {
+	argsBz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := nc.Querier.EVM.EthCall(context.Background(), &evm.EthCallRequest{
+		Args:    argsBz,
+		GasCap:  EvmGasCap,
+		ChainId: nc.EthChainID().Int64(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Failed() {
+		return resp, fmt.Errorf("eth call failed: %s", resp.VmError)
+	}
+	return resp, nil
+}
+
+// CallContract calls a read-only method of the contract and returns the
+// unpacked outputs.
+func (nc *NibiruSDK) CallContract(
+	from gethcommon.Address,
+	contract gethcommon.Address,
+	contractAbi abi.ABI,
+	method string,
+	args ...any,
+) ([]any, error) {
+	input, err := contractAbi.Pack(method, args...)
+	if err != nil {
+		return nil, sdkioerrors.Wrapf(err, "failed to pack ABI args for method %s", method)
+	}
+	resp, err := nc.EthCall(evm.JsonTxArgs{
+		From:  &from,
+		To:    &contract,
+		Input: (*hexutil.Bytes)(&input),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return contractAbi.Unpack(method, resp.Ret)
+}
+
+// ExecuteContract sends a tx that calls a method of the contract from "from",
+// which must be in the keyring.
+func (nc *NibiruSDK) ExecuteContract(
+	from gethcommon.Address,
+	contract gethcommon.Address,
+	contractAbi abi.ABI,
+	method string,
+	args ...any,
+) (ethTxHash gethcommon.Hash, txResp *sdk.TxResponse, err error) {
+	input, err := contractAbi.Pack(method, args...)
+	if err != nil {
+		return ethTxHash, nil, sdkioerrors.Wrapf(err, "failed to pack ABI args for method %s", method)
+	}
+	return nc.SendEvmTx(evm.JsonTxArgs{
+		From:  &from,
+		To:    &contract,
+		Input: (*hexutil.Bytes)(&input),
+	})
+}
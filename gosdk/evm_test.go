@@ -0,0 +1,103 @@
+package gosdk_test
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/gosdk"
+	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+)
+
+// DoTestEvmTxs funds a new eth_secp256k1 key from the validator and uses it to
+// send a transfer, deploy an ERC20, and call the contract.
+func (s *TestSuite) DoTestEvmTxs() {
+	evmSdk := *s.nibiruSdk
+	evmSdk.Keyring = gosdk.NewKeyring()
+	senderAddr, err := gosdk.AddSignerToKeyringEthSecp256k1(evmSdk.Keyring, "", "evm-sender")
+	s.Require().NoError(err)
+	sender := eth.NibiruAddrToEthAddr(senderAddr)
+
+	txResp, err := s.nibiruSdk.BroadcastMsgs(s.val.Address, banktypes.NewMsgSend(
+		s.val.Address, senderAddr, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 10_000_000)),
+	))
+	s.Require().NoError(err)
+	s.AssertTxResponseSuccess(txResp)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.Run("legacy transfer", func() {
+		to := gethcommon.BytesToAddress(crypto.Keccak256([]byte("gosdk evm recipient"))[:20])
+		amount := evm.NativeToWei(big.NewInt(420))
+		ethTxHash, _, err := evmSdk.SendEvmTx(evm.JsonTxArgs{
+			From:  &sender,
+			To:    &to,
+			Value: (*hexutil.Big)(amount),
+		})
+		s.Require().NoError(err)
+		receipt, err := evmSdk.WaitForEvmReceipt(ctx, ethTxHash)
+		s.Require().NoError(err)
+		s.False(receipt.Failed(), receipt.RawLog)
+		s.Equal(ethTxHash.Hex(), receipt.Response.Hash)
+
+		resp, err := evmSdk.Querier.EVM.EthAccount(
+			ctx, &evm.QueryEthAccountRequest{Address: to.Hex()},
+		)
+		s.Require().NoError(err)
+		s.Equal("420", resp.Balance)
+	})
+
+	s.Run("dynamic fee deploy and contract calls", func() {
+		bytecode := embeds.SmartContract_TestERC20.Bytecode
+		ethTxHash, _, err := evmSdk.SendEvmTx(evm.JsonTxArgs{
+			From:                 &sender,
+			Input:                (*hexutil.Bytes)(&bytecode),
+			MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(0)),
+		})
+		s.Require().NoError(err)
+		receipt, err := evmSdk.WaitForEvmReceipt(ctx, ethTxHash)
+		s.Require().NoError(err)
+		s.Require().False(receipt.Failed(), receipt.RawLog)
+
+		nonce, err := evmSdk.EvmNonce(sender)
+		s.Require().NoError(err)
+		contract := crypto.CreateAddress(sender, nonce-1)
+		erc20Abi := *embeds.SmartContract_TestERC20.ABI
+
+		out, err := evmSdk.CallContract(sender, contract, erc20Abi, "symbol")
+		s.Require().NoError(err)
+		s.Equal("FOO", out[0])
+
+		recipient := gethcommon.BytesToAddress(crypto.Keccak256([]byte("gosdk erc20 recipient"))[:20])
+		ethTxHash, _, err = evmSdk.ExecuteContract(
+			sender, contract, erc20Abi, "transfer", recipient, big.NewInt(69),
+		)
+		s.Require().NoError(err)
+		receipt, err = evmSdk.WaitForEvmReceipt(ctx, ethTxHash)
+		s.Require().NoError(err)
+		s.Require().False(receipt.Failed(), receipt.RawLog)
+		s.Len(receipt.Response.Logs, 1, "expect a Transfer event")
+
+		out, err = evmSdk.CallContract(sender, contract, erc20Abi, "balanceOf", recipient)
+		s.Require().NoError(err)
+		s.Equal(big.NewInt(69), out[0])
+	})
+
+	s.Run("reverting call", func() {
+		_, err := evmSdk.CallContract(
+			sender, sender, *embeds.SmartContract_TestERC20.ABI, "balanceOf", sender,
+		)
+		s.Error(err, "an EOA has no code to return a balance")
+	})
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/app"
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	ethcryptocodec "github.com/NibiruChain/nibiru/v2/eth/crypto/codec"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -32,8 +34,8 @@ func NewNibiruSdk(
 	rpcEndpt string,
 ) (NibiruSDK, error) {
 	EnsureNibiruPrefix()
-	encCfg := app.MakeEncodingConfig()
-	keyring := keyring.NewInMemory(encCfg.Codec)
+	encCfg := MakeEncodingConfig()
+	keyring := keyring.NewInMemory(encCfg.Codec, ethhd.EthSecp256k1Option())
 	queryClient, err := NewQuerier(grpcConn)
 	if err != nil {
 		return NibiruSDK{}, err
@@ -53,6 +55,16 @@ func NewNibiruSdk(
 	}, err
 }
 
+// MakeEncodingConfig returns the encoding config of the app with the
+// eth_secp256k1 key types registered, which the keyring needs to store keys
+// that sign EVM txs. The app registers these when it is constructed.
+func MakeEncodingConfig() app.EncodingConfig {
+	encCfg := app.MakeEncodingConfig()
+	ethcryptocodec.RegisterInterfaces(encCfg.InterfaceRegistry)
+	ethcryptocodec.RegisterCrypto(encCfg.Amino)
+	return encCfg
+}
+
 func EnsureNibiruPrefix() {
 	csdkConfig := sdk.GetConfig()
 	nibiruPrefix := appconst.AccountAddressPrefix
@@ -1,8 +1,10 @@
 package gosdk_test
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -89,6 +91,17 @@ func (s *TestSuite) TestNewNibiruSdk() {
 		}
 		s.DoTestBroadcastMsgsGrpc()
 	})
+	s.Run("DoTestRetryingBroadcaster", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestRetryingBroadcaster()
+	})
+	s.Run("DoTestSubscribeEvents", func() {
+		s.DoTestSubscribeEvents()
+	})
+	s.Run("DoTestEvmTxs", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestEvmTxs()
+	})
 	s.Run("DoTestNewQueryClient", func() {
 		_, err := gosdk.NewQuerier(s.grpcConn)
 		s.NoError(err)
@@ -156,3 +169,34 @@ func (s *TestSuite) DoTestGetGrpcConnection_NoNetwork() {
 	_, err = gosdk.NewQuerier(grpcConn)
 	s.Error(err)
 }
+
+// DoTestRetryingBroadcaster sends txs from the validator concurrently, then
+// from a stale sequence to check that the broadcaster resyncs.
+func (s *TestSuite) DoTestRetryingBroadcaster() {
+	broadcaster := s.nibiruSdk.NewRetryingBroadcaster()
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			from, _, _, msgSend := s.msgSendVars()
+			resTx, err := broadcaster.BroadcastMsgs(ctx, from, msgSend)
+			s.NoError(err)
+			if resTx != nil {
+				s.EqualValues(0, resTx.TxResult.Code, resTx.TxResult.Log)
+			}
+		}()
+	}
+	wg.Wait()
+
+	from, _, _, msgSend := s.msgSendVars()
+	nums, err := s.nibiruSdk.GetAccountNumbers(from.String())
+	s.Require().NoError(err)
+	staleSeq := nums.Sequence + 5
+	s.Require().NoError(broadcaster.Sequences.Resync(from, &staleSeq))
+	resTx, err := broadcaster.BroadcastMsgs(ctx, from, msgSend)
+	s.Require().NoError(err)
+	s.EqualValues(0, resTx.TxResult.Code, resTx.TxResult.Log)
+}
@@ -6,14 +6,19 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/NibiruChain/nibiru/v2/app"
 )
 
 // GetGRPCConnection establishes a connection to a gRPC server using either
 // secure (TLS) or insecure credentials. The function blocks until the connection
-// is established or the specified timeout is reached.
+// is established or the specified timeout is reached. Messages are encoded with
+// the gogoproto codec of the app, which supports the custom types in query
+// responses like "cosmossdk.io/math.Int".
 func GetGRPCConnection(
 	grpcUrl string, grpcInsecure bool, timeoutSeconds int64,
 ) (*grpc.ClientConn, error) {
@@ -27,6 +32,9 @@ func GetGRPCConnection(
 	options := []grpc.DialOption{
 		grpc.WithBlock(),
 		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(
+			codec.NewProtoCodec(app.MakeEncodingConfig().InterfaceRegistry).GRPCCodec(),
+		)),
 	}
 	timeout := time.Duration(timeoutSeconds) * time.Second
 	ctx, cancel := context.WithTimeout(
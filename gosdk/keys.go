@@ -8,12 +8,13 @@ import (
 	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/NibiruChain/nibiru/v2/app"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
 )
 
-// NewKeyring: Creates an empty, in-memory keyring
+// NewKeyring: Creates an empty, in-memory keyring. It supports both secp256k1
+// keys for Cosmos txs and eth_secp256k1 keys, which can also sign EVM txs.
 func NewKeyring() keyring.Keyring {
-	return keyring.NewInMemory(app.MakeEncodingConfig().Codec)
+	return keyring.NewInMemory(MakeEncodingConfig().Codec, ethhd.EthSecp256k1Option())
 }
 
 // TODO: Is it necessary to add support for interacting with local file system
@@ -39,7 +40,22 @@ func NewKeyring() keyring.Keyring {
 func AddSignerToKeyringSecp256k1(
 	kring keyring.Keyring, mnemonic string, keyName string,
 ) (sdk.AccAddress, error) {
-	algo := hd.Secp256k1
+	return addSignerToKeyring(kring, mnemonic, keyName, hd.Secp256k1)
+}
+
+// AddSignerToKeyringEthSecp256k1 adds an eth_secp256k1 key derived from the
+// mnemonic. Its account address has the same bytes as the Ethereum address of
+// the key, so it can sign EVM txs. The keyring must support the
+// algorithm, like the one from [NewKeyring].
+func AddSignerToKeyringEthSecp256k1(
+	kring keyring.Keyring, mnemonic string, keyName string,
+) (sdk.AccAddress, error) {
+	return addSignerToKeyring(kring, mnemonic, keyName, ethhd.EthSecp256k1)
+}
+
+func addSignerToKeyring(
+	kring keyring.Keyring, mnemonic string, keyName string, algo keyring.SignatureAlgo,
+) (sdk.AccAddress, error) {
 	overwrite := true
 	addr, secretMnem, err := sdktestutil.GenerateSaveCoinKey(
 		kring, keyName, mnemonic, overwrite, algo,
@@ -0,0 +1,110 @@
+package gosdk
+
+import (
+	"regexp"
+	"strconv"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountFetcher returns the on-chain account number and sequence of an
+// account.
+type AccountFetcher func(addr sdk.AccAddress) (AccountNumbers, error)
+
+// SequenceManager hands out account sequence numbers for signers that send
+// many txs, possibly from several goroutines. The account number and
+// sequence are fetched once per account, and each call to
+// [SequenceManager.Reserve] returns the next unused sequence, so txs can be
+// broadcast without waiting for the previous ones to be included.
+//
+// The local sequence goes out of sync when a reserved sequence is never
+// accepted into the mempool, for example because the tx failed CheckTx. The
+// next tx then fails with ErrWrongSequence, after which the caller should
+// [SequenceManager.Resync].
+type SequenceManager struct {
+	mu       sync.Mutex
+	fetch    AccountFetcher
+	accounts map[string]*AccountNumbers
+}
+
+// NewSequenceManager creates a SequenceManager that loads accounts with the
+// fetch function.
+func NewSequenceManager(fetch AccountFetcher) *SequenceManager {
+	return &SequenceManager{
+		fetch:    fetch,
+		accounts: make(map[string]*AccountNumbers),
+	}
+}
+
+// NewSequenceManager creates a SequenceManager that loads accounts from the
+// auth module of the chain.
+func (nc *NibiruSDK) NewSequenceManager() *SequenceManager {
+	return NewSequenceManager(func(addr sdk.AccAddress) (AccountNumbers, error) {
+		return nc.GetAccountNumbers(addr.String())
+	})
+}
+
+// Reserve returns the account number and the next unused sequence of the
+// account, and marks the sequence as used.
+func (m *SequenceManager) Reserve(addr sdk.AccAddress) (AccountNumbers, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	acc, ok := m.accounts[addr.String()]
+	if !ok {
+		nums, err := m.fetch(addr)
+		if err != nil {
+			return AccountNumbers{}, err
+		}
+		acc = &nums
+		m.accounts[addr.String()] = acc
+	}
+	nums := *acc
+	acc.Sequence++
+	return nums, nil
+}
+
+// Resync sets the next sequence of the account. If "expectedSeq" is nil, the
+// account is fetched again. Prefer the sequence expected by CheckTx (see
+// [ParseExpectedSequence]) when it is known, since it accounts for txs still in
+// the mempool while the fetched sequence only counts included txs.
+func (m *SequenceManager) Resync(addr sdk.AccAddress, expectedSeq *uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	acc, ok := m.accounts[addr.String()]
+	if !ok || expectedSeq == nil {
+		nums, err := m.fetch(addr)
+		if err != nil {
+			return err
+		}
+		acc = &nums
+		m.accounts[addr.String()] = acc
+	}
+	if expectedSeq != nil {
+		acc.Sequence = *expectedSeq
+	}
+	return nil
+}
+
+// Forget drops the cached account, so that the next [SequenceManager.Reserve]
+// fetches it.
+func (m *SequenceManager) Forget(addr sdk.AccAddress) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.accounts, addr.String())
+}
+
+var regexSeqMismatch = regexp.MustCompile(`account sequence mismatch, expected (\d+)`)
+
+// ParseExpectedSequence returns the sequence expected by the ante handler from
+// the log of a tx that failed with ErrWrongSequence.
+func ParseExpectedSequence(rawLog string) (seq uint64, ok bool) {
+	match := regexSeqMismatch.FindStringSubmatch(rawLog)
+	if match == nil {
+		return 0, false
+	}
+	seq, err := strconv.ParseUint(match[1], 10, 64)
+	return seq, err == nil
+}
@@ -0,0 +1,83 @@
+package gosdk_test
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/gosdk"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+)
+
+func TestSequenceManager(t *testing.T) {
+	addr := testutil.AccAddress()
+	fetches := 0
+	onChain := gosdk.AccountNumbers{Number: 7, Sequence: 10}
+	seqs := gosdk.NewSequenceManager(func(sdk.AccAddress) (gosdk.AccountNumbers, error) {
+		fetches++
+		return onChain, nil
+	})
+
+	t.Log("Concurrent reservations get distinct, consecutive sequences")
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		reserved []uint64
+	)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nums, err := seqs.Reserve(addr)
+			require.NoError(t, err)
+			require.EqualValues(t, 7, nums.Number)
+			mu.Lock()
+			reserved = append(reserved, nums.Sequence)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	sort.Slice(reserved, func(i, j int) bool { return reserved[i] < reserved[j] })
+	for i, seq := range reserved {
+		require.EqualValues(t, 10+i, seq)
+	}
+	require.Equal(t, 1, fetches, "the account is fetched once")
+
+	t.Log("Resync to the sequence expected by CheckTx")
+	expected := uint64(42)
+	require.NoError(t, seqs.Resync(addr, &expected))
+	nums, err := seqs.Reserve(addr)
+	require.NoError(t, err)
+	require.EqualValues(t, 42, nums.Sequence)
+	require.Equal(t, 1, fetches)
+
+	t.Log("Resync without an expected sequence fetches the account")
+	onChain.Sequence = 20
+	require.NoError(t, seqs.Resync(addr, nil))
+	nums, err = seqs.Reserve(addr)
+	require.NoError(t, err)
+	require.EqualValues(t, 20, nums.Sequence)
+	require.Equal(t, 2, fetches)
+
+	t.Log("Forget makes the next reservation fetch the account")
+	onChain.Sequence = 30
+	seqs.Forget(addr)
+	nums, err = seqs.Reserve(addr)
+	require.NoError(t, err)
+	require.EqualValues(t, 30, nums.Sequence)
+	require.Equal(t, 3, fetches)
+}
+
+func TestParseExpectedSequence(t *testing.T) {
+	seq, ok := gosdk.ParseExpectedSequence(
+		"account sequence mismatch, expected 12, got 15: incorrect account sequence",
+	)
+	require.True(t, ok)
+	require.EqualValues(t, 12, seq)
+
+	_, ok = gosdk.ParseExpectedSequence("insufficient funds")
+	require.False(t, ok)
+}
@@ -1,6 +1,8 @@
 package ewma
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 )
 
@@ -17,6 +19,61 @@ func NewMovingAverage(span sdkmath.LegacyDec) MovingAverage {
 	}
 }
 
+// NewMovingAverageWithDecay returns a moving average that gives a weight of
+// decay to every new value, as opposed to a fixed span of values.
+func NewMovingAverageWithDecay(decay sdkmath.LegacyDec) MovingAverage {
+	return &variableEWMA{
+		value: sdkmath.LegacyZeroDec(),
+		decay: decay,
+	}
+}
+
+// ln2 is the natural logarithm of 2 with 18 decimals of precision.
+var ln2 = sdkmath.LegacyMustNewDecFromStr("0.693147180559945309")
+
+// HalfLifeDecay returns the decay of a moving average whose past values lose
+// half of their weight every halfLife, for a value observed elapsed after the
+// previous one:
+//
+//	decay = 1 - 2^(-elapsed / halfLife)
+//
+// This makes the average independent of how regularly values are added.
+func HalfLifeDecay(elapsed, halfLife time.Duration) sdkmath.LegacyDec {
+	if halfLife <= 0 {
+		return sdkmath.LegacyOneDec()
+	}
+	if elapsed <= 0 {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	ratio := sdkmath.LegacyNewDec(elapsed.Milliseconds()).QuoInt64(halfLife.Milliseconds())
+	halvings := ratio.TruncateInt64()
+	// 2^-60 is below the 18 decimals of precision of LegacyDec.
+	if halvings >= 60 {
+		return sdkmath.LegacyOneDec()
+	}
+
+	// 2^(-ratio) = 2^(-halvings) * e^(-frac * ln2)
+	frac := ratio.Sub(sdkmath.LegacyNewDec(halvings))
+	weight := expNeg(frac.Mul(ln2)).QuoInt64(int64(1) << halvings)
+	return sdkmath.LegacyOneDec().Sub(weight)
+}
+
+// expNeg returns e^(-x) for x in [0, 1) using its Taylor series, which
+// converges within the precision of LegacyDec in less than 25 terms.
+func expNeg(x sdkmath.LegacyDec) sdkmath.LegacyDec {
+	sum := sdkmath.LegacyOneDec()
+	term := sdkmath.LegacyOneDec()
+	for k := int64(1); k <= 30; k++ {
+		term = term.Mul(x).QuoInt64(k).Neg()
+		if term.IsZero() {
+			break
+		}
+		sum = sum.Add(term)
+	}
+	return sum
+}
+
 type variableEWMA struct {
 	decay sdkmath.LegacyDec
 	value sdkmath.LegacyDec
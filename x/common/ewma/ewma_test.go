@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
@@ -39,3 +40,33 @@ func TestSimpleEWMA(t *testing.T) {
 		)
 	}
 }
+
+func TestHalfLifeDecay(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		elapsed  time.Duration
+		halfLife time.Duration
+		want     string
+	}{
+		{name: "no time elapsed", elapsed: 0, halfLife: time.Hour, want: "0"},
+		{name: "one half-life", elapsed: time.Hour, halfLife: time.Hour, want: "0.5"},
+		{name: "two half-lives", elapsed: 2 * time.Hour, halfLife: time.Hour, want: "0.75"},
+		{name: "half a half-life", elapsed: 30 * time.Minute, halfLife: time.Hour, want: "0.292893218813452476"},
+		{name: "many half-lives", elapsed: 100 * time.Hour, halfLife: time.Hour, want: "1"},
+		{name: "no half-life", elapsed: time.Hour, halfLife: 0, want: "1"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := HalfLifeDecay(tc.elapsed, tc.halfLife)
+			want := sdkmath.LegacyMustNewDecFromStr(tc.want)
+			require.True(
+				t, got.Sub(want).Abs().LTE(sdkmath.LegacyNewDecWithPrec(1, 15)),
+				"got %s, want %s", got, want,
+			)
+		})
+	}
+
+	ma := NewMovingAverageWithDecay(HalfLifeDecay(time.Hour, time.Hour))
+	ma.Add(sdkmath.LegacyNewDec(100))
+	ma.Add(sdkmath.LegacyNewDec(200))
+	require.Equal(t, sdkmath.LegacyNewDec(150), ma.Value())
+}
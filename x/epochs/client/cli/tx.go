@@ -2,15 +2,23 @@ package cli
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 
-	// "github.com/cosmos/cosmos-sdk/client/flags".
 	"github.com/NibiruChain/nibiru/v2/x/epochs/types"
 )
 
+// flags for the create-epoch command
+const (
+	FlagStartTime = "start-time"
+)
+
 // GetTxCmd returns the transaction commands for this module.
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -21,5 +29,100 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(
+		CmdCreateEpoch(),
+		CmdDeleteEpoch(),
+	)
+
+	return cmd
+}
+
+// CmdCreateEpoch adds an epoch identifier.
+func CmdCreateEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-epoch [identifier] [duration]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add an epoch identifier with the given duration",
+		Long: strings.TrimSpace(`
+Add an epoch identifier with the given duration. Counting starts at the
+--start-time (RFC 3339) or at the block time of the transaction if unset.
+
+Requires sudo permissions.
+
+$ nibid tx epochs create-epoch hour 1h
+$ nibid tx epochs create-epoch 4hour 4h --start-time 2024-01-01T00:00:00Z
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid duration %q: %w", args[1], err)
+			}
+
+			msg := &types.MsgCreateEpoch{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Identifier: args[0],
+				Duration:   duration,
+			}
+
+			if startTime, _ := cmd.Flags().GetString(FlagStartTime); startTime != "" {
+				msg.StartTime, err = time.Parse(time.RFC3339, startTime)
+				if err != nil {
+					return fmt.Errorf("invalid --%s %q: %w", FlagStartTime, startTime, err)
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagStartTime, "", "RFC 3339 time at which the epoch starts counting")
+
+	return cmd
+}
+
+// CmdDeleteEpoch removes an epoch identifier.
+func CmdDeleteEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-epoch [identifier]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove an epoch identifier",
+		Long: strings.TrimSpace(`
+Remove an epoch identifier so that its epoch hooks stop running.
+
+Requires sudo permissions.
+
+$ nibid tx epochs delete-epoch hour
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDeleteEpoch{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Identifier: args[0],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
 	return cmd
 }
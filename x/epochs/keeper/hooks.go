@@ -6,10 +6,16 @@ import (
 
 // AfterEpochEnd epoch hook
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber uint64) {
+	if k.hooks == nil {
+		return
+	}
 	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
 }
 
 // BeforeEpochStart epoch hook
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber uint64) {
+	if k.hooks == nil {
+		return
+	}
 	k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
 }
@@ -11,17 +11,21 @@ import (
 )
 
 type Keeper struct {
-	cdc      codec.Codec
-	storeKey storetypes.StoreKey
-	hooks    types.EpochHooks
+	cdc        codec.Codec
+	storeKey   storetypes.StoreKey
+	hooks      types.EpochHooks
+	sudoKeeper types.SudoKeeper
 
 	Epochs collections.Map[string, types.EpochInfo]
 }
 
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey) *Keeper {
+func NewKeeper(
+	cdc codec.Codec, storeKey storetypes.StoreKey, sudoKeeper types.SudoKeeper,
+) *Keeper {
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		sudoKeeper: sudoKeeper,
 
 		Epochs: collections.NewMap[string, types.EpochInfo](storeKey, 1, collections.StringKeyEncoder, collections.ProtoValueEncoder[types.EpochInfo](cdc)),
 	}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/epochs/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the epochs MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+var govModuleAddr = authtypes.NewModuleAddress(govtypes.ModuleName)
+
+// CreateEpoch: gRPC tx msg for adding an epoch identifier.
+// [SUDO] Only callable by sudoers or the governance module account.
+func (ms msgServer) CreateEpoch(
+	goCtx context.Context, msg *types.MsgCreateEpoch,
+) (*types.MsgCreateEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.checkAuthority(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	epoch := msg.EpochInfo()
+	if err := ms.AddEpochInfo(ctx, epoch); err != nil {
+		return nil, err
+	}
+	epoch, err := ms.GetEpochInfo(ctx, epoch.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateEpochResponse{},
+		ctx.EventManager().EmitTypedEvent(&types.EventEpochCreated{Epoch: epoch})
+}
+
+// DeleteEpoch: gRPC tx msg for removing an epoch identifier. Hooks stop
+// running for the identifier, so modules that key on it skip their epoch
+// logic from then on.
+// [SUDO] Only callable by sudoers or the governance module account.
+func (ms msgServer) DeleteEpoch(
+	goCtx context.Context, msg *types.MsgDeleteEpoch,
+) (*types.MsgDeleteEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.checkAuthority(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	if types.IsProtectedEpochID(msg.Identifier) {
+		return nil, types.ErrProtectedEpoch.Wrapf("cannot delete epoch %q", msg.Identifier)
+	}
+	if !ms.EpochExists(ctx, msg.Identifier) {
+		return nil, types.ErrEpochNotFound.Wrapf("identifier %q", msg.Identifier)
+	}
+	if err := ms.DeleteEpochInfo(ctx, msg.Identifier); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteEpochResponse{},
+		ctx.EventManager().EmitTypedEvent(&types.EventEpochDeleted{
+			Identifier: msg.Identifier,
+		})
+}
+
+// checkAuthority returns an error unless the sender is the governance module
+// account or a sudoer.
+func (ms msgServer) checkAuthority(ctx sdk.Context, sender string) error {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+	if senderAddr.Equals(govModuleAddr) {
+		return nil
+	}
+	return ms.sudoKeeper.CheckPermissions(senderAddr, ctx)
+}
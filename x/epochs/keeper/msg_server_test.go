@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/epochs"
+	"github.com/NibiruChain/nibiru/v2/x/epochs/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/epochs/types"
+	sudotypes "github.com/NibiruChain/nibiru/v2/x/sudo/types"
+)
+
+func TestMsgCreateDeleteEpoch(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	msgServer := keeper.NewMsgServerImpl(*nibiruApp.EpochsKeeper)
+
+	root := testutil.AccAddress().String()
+	stranger := testutil.AccAddress().String()
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	nibiruApp.SudoKeeper.Sudoers.Set(ctx, sudotypes.Sudoers{Root: root})
+
+	t.Log("Only sudoers and governance can create epochs")
+	_, err := msgServer.CreateEpoch(sdk.WrapSDKContext(ctx), &types.MsgCreateEpoch{
+		Sender:     stranger,
+		Identifier: types.HourEpochID,
+		Duration:   time.Hour,
+	})
+	require.ErrorIs(t, err, sudotypes.ErrUnauthorized)
+
+	_, err = msgServer.CreateEpoch(sdk.WrapSDKContext(ctx), &types.MsgCreateEpoch{
+		Sender:     root,
+		Identifier: types.HourEpochID,
+		Duration:   time.Hour,
+	})
+	require.NoError(t, err)
+	hourEpoch, err := nibiruApp.EpochsKeeper.GetEpochInfo(ctx, types.HourEpochID)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime(), hourEpoch.StartTime)
+	require.Equal(t, ctx.BlockHeight(), hourEpoch.CurrentEpochStartHeight)
+
+	startTime := ctx.BlockTime().Add(24 * time.Hour)
+	_, err = msgServer.CreateEpoch(sdk.WrapSDKContext(ctx), &types.MsgCreateEpoch{
+		Sender:     gov,
+		Identifier: "4 hour",
+		Duration:   4 * time.Hour,
+		StartTime:  startTime,
+	})
+	require.NoError(t, err)
+	require.True(t, nibiruApp.EpochsKeeper.EpochExists(ctx, "4 hour"))
+
+	t.Log("Identifiers are unique")
+	_, err = msgServer.CreateEpoch(sdk.WrapSDKContext(ctx), &types.MsgCreateEpoch{
+		Sender:     root,
+		Identifier: types.HourEpochID,
+		Duration:   2 * time.Hour,
+	})
+	require.ErrorContains(t, err, "already exists")
+
+	t.Log("Hooks tolerate the new identifier")
+	require.NotPanics(t, func() {
+		epochs.BeginBlocker(ctx, *nibiruApp.EpochsKeeper)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).
+			WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		epochs.BeginBlocker(ctx, *nibiruApp.EpochsKeeper)
+	})
+	hourEpoch, err = nibiruApp.EpochsKeeper.GetEpochInfo(ctx, types.HourEpochID)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, hourEpoch.CurrentEpoch)
+
+	t.Log("Delete epochs")
+	_, err = msgServer.DeleteEpoch(sdk.WrapSDKContext(ctx), &types.MsgDeleteEpoch{
+		Sender:     stranger,
+		Identifier: types.HourEpochID,
+	})
+	require.ErrorIs(t, err, sudotypes.ErrUnauthorized)
+
+	_, err = msgServer.DeleteEpoch(sdk.WrapSDKContext(ctx), &types.MsgDeleteEpoch{
+		Sender:     gov,
+		Identifier: types.DayEpochID,
+	})
+	require.ErrorIs(t, err, types.ErrProtectedEpoch)
+
+	_, err = msgServer.DeleteEpoch(sdk.WrapSDKContext(ctx), &types.MsgDeleteEpoch{
+		Sender:     root,
+		Identifier: "unknown",
+	})
+	require.ErrorIs(t, err, types.ErrEpochNotFound)
+
+	_, err = msgServer.DeleteEpoch(sdk.WrapSDKContext(ctx), &types.MsgDeleteEpoch{
+		Sender:     root,
+		Identifier: types.HourEpochID,
+	})
+	require.NoError(t, err)
+	require.False(t, nibiruApp.EpochsKeeper.EpochExists(ctx, types.HourEpochID))
+}
+
+func TestMsgCreateEpoch_ValidateBasic(t *testing.T) {
+	sender := testutil.AccAddress().String()
+	for _, tc := range []struct {
+		name    string
+		msg     types.MsgCreateEpoch
+		wantErr string
+	}{
+		{
+			name: "happy",
+			msg:  types.MsgCreateEpoch{Sender: sender, Identifier: "hour", Duration: time.Hour},
+		},
+		{
+			name:    "invalid sender",
+			msg:     types.MsgCreateEpoch{Sender: "nibi", Identifier: "hour", Duration: time.Hour},
+			wantErr: "decoding bech32",
+		},
+		{
+			name:    "blank identifier",
+			msg:     types.MsgCreateEpoch{Sender: sender, Identifier: " ", Duration: time.Hour},
+			wantErr: "blank epoch identifier",
+		},
+		{
+			name:    "zero duration",
+			msg:     types.MsgCreateEpoch{Sender: sender, Identifier: "hour"},
+			wantErr: "duration must be positive",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
@@ -51,10 +51,12 @@ func (AppModuleBasic) Name() string {
 }
 
 func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
 }
 
 // RegisterInterfaces registers the module's interface types.
 func (a AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
+	types.RegisterInterfaces(reg)
 }
 
 // DefaultGenesis returns the capability module's default genesis state.
@@ -118,6 +120,7 @@ func (am AppModule) IsAppModule() {}
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(*am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(*am.keeper))
 }
 
@@ -195,6 +198,8 @@ type EpochsInputs struct {
 	Config *modulev1.Module
 	Key    *store.KVStoreKey
 	Cdc    codec.Codec
+
+	SudoKeeper types.SudoKeeper
 }
 
 type EpochsOutputs struct {
@@ -206,7 +211,7 @@ type EpochsOutputs struct {
 }
 
 func ProvideModule(in EpochsInputs) EpochsOutputs {
-	k := keeper.NewKeeper(in.Cdc, in.Key)
+	k := keeper.NewKeeper(in.Cdc, in.Key, in.SudoKeeper)
 
 	m := NewAppModule(in.Cdc, k)
 
@@ -8,5 +8,7 @@ import (
 
 // x/epochs module sentinel errors.
 var (
-	ErrSample = sdkioerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample         = sdkioerrors.Register(ModuleName, 1100, "sample error")
+	ErrEpochNotFound  = sdkioerrors.Register(ModuleName, 1101, "epoch not found")
+	ErrProtectedEpoch = sdkioerrors.Register(ModuleName, 1102, "epoch identifier is protected")
 )
@@ -126,33 +126,131 @@ func (m *EventEpochEnd) GetEpochNumber() uint64 {
 	return 0
 }
 
+// EventEpochCreated: Emitted when an epoch identifier is added with
+// MsgCreateEpoch.
+type EventEpochCreated struct {
+	Epoch EpochInfo `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch"`
+}
+
+func (m *EventEpochCreated) Reset()         { *m = EventEpochCreated{} }
+func (m *EventEpochCreated) String() string { return proto.CompactTextString(m) }
+func (*EventEpochCreated) ProtoMessage()    {}
+func (*EventEpochCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af14d87a2487e5d, []int{2}
+}
+func (m *EventEpochCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochCreated.Merge(m, src)
+}
+func (m *EventEpochCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochCreated proto.InternalMessageInfo
+
+func (m *EventEpochCreated) GetEpoch() EpochInfo {
+	if m != nil {
+		return m.Epoch
+	}
+	return EpochInfo{}
+}
+
+// EventEpochDeleted: Emitted when an epoch identifier is removed with
+// MsgDeleteEpoch.
+type EventEpochDeleted struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *EventEpochDeleted) Reset()         { *m = EventEpochDeleted{} }
+func (m *EventEpochDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventEpochDeleted) ProtoMessage()    {}
+func (*EventEpochDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af14d87a2487e5d, []int{3}
+}
+func (m *EventEpochDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochDeleted.Merge(m, src)
+}
+func (m *EventEpochDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochDeleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochDeleted proto.InternalMessageInfo
+
+func (m *EventEpochDeleted) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventEpochStart)(nil), "nibiru.epochs.v1.EventEpochStart")
 	proto.RegisterType((*EventEpochEnd)(nil), "nibiru.epochs.v1.EventEpochEnd")
+	proto.RegisterType((*EventEpochCreated)(nil), "nibiru.epochs.v1.EventEpochCreated")
+	proto.RegisterType((*EventEpochDeleted)(nil), "nibiru.epochs.v1.EventEpochDeleted")
 }
 
 func init() { proto.RegisterFile("nibiru/epochs/v1/event.proto", fileDescriptor_7af14d87a2487e5d) }
 
 var fileDescriptor_7af14d87a2487e5d = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0x28, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b,
-	0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xea, 0x41, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94,
-	0x7c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x98, 0x97, 0x54, 0x9a, 0xa6, 0x5f, 0x92, 0x99,
-	0x9b, 0x5a, 0x5c, 0x92, 0x98, 0x5b, 0x00, 0x51, 0xa0, 0xd4, 0xc2, 0xc8, 0xc5, 0xef, 0x0a, 0x32,
-	0xd8, 0x15, 0x64, 0x52, 0x70, 0x49, 0x62, 0x51, 0x89, 0x90, 0x22, 0x17, 0x0f, 0xd8, 0xdc, 0xf8,
-	0xbc, 0xd2, 0xdc, 0xa4, 0xd4, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x6e, 0xb0, 0x98,
-	0x1f, 0x58, 0x48, 0xc8, 0x8f, 0x4b, 0x00, 0xa2, 0xa4, 0x18, 0xa4, 0x23, 0x1e, 0x64, 0xaa, 0x04,
-	0x93, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x94, 0x1e, 0xc4, 0x4a, 0x3d, 0x98, 0x95, 0x7a, 0x21, 0x30,
-	0x2b, 0x9d, 0x38, 0x4e, 0xdc, 0x93, 0x67, 0x98, 0x70, 0x5f, 0x9e, 0x31, 0x88, 0x2f, 0x15, 0x6e,
-	0x1d, 0x48, 0x5a, 0xc9, 0x88, 0x8b, 0x17, 0xe1, 0x0a, 0xd7, 0xbc, 0x14, 0x22, 0xdc, 0xe0, 0xe4,
-	0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x7e, 0xe0, 0x80, 0x72, 0xce, 0x48, 0xcc, 0xcc, 0xd3,
-	0x87, 0x06, 0x69, 0x99, 0x91, 0x7e, 0x05, 0x2c, 0x5c, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0x8e, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x87, 0x6a, 0xdc, 0x59, 0x75, 0x01, 0x00,
-	0x00,
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbf, 0x4f, 0xc2, 0x40,
+	0x14, 0xc7, 0x7b, 0x06, 0x8d, 0x1e, 0xfe, 0xc0, 0xc6, 0x81, 0xa0, 0x29, 0xd8, 0x89, 0xe9, 0x2e,
+	0x94, 0xc1, 0x1d, 0x64, 0x20, 0x31, 0x0c, 0xd5, 0xc9, 0x85, 0xb4, 0xf0, 0x28, 0x97, 0xd0, 0xbb,
+	0xa6, 0xbd, 0x36, 0xba, 0xfb, 0x07, 0xf0, 0x67, 0x31, 0x32, 0x3a, 0xa9, 0x81, 0x7f, 0xc4, 0xdc,
+	0x1d, 0x08, 0xd1, 0xc5, 0xed, 0xee, 0x7d, 0xbf, 0xef, 0x7d, 0x3f, 0x79, 0x0f, 0xdf, 0x70, 0x16,
+	0xb2, 0x34, 0xa7, 0x90, 0x88, 0xd1, 0x34, 0xa3, 0x45, 0x8b, 0x42, 0x01, 0x5c, 0x92, 0x24, 0x15,
+	0x52, 0xd8, 0x15, 0xa3, 0x12, 0xa3, 0x92, 0xa2, 0x55, 0xbb, 0x8a, 0x44, 0x24, 0xb4, 0x48, 0xd5,
+	0xcb, 0xf8, 0x6a, 0xf5, 0x48, 0x88, 0x68, 0x06, 0x54, 0xff, 0xc2, 0x7c, 0x42, 0x25, 0x8b, 0x21,
+	0x93, 0x41, 0x9c, 0x6c, 0x0c, 0x7f, 0x63, 0x32, 0x19, 0x48, 0x30, 0xaa, 0xfb, 0x86, 0xf0, 0x45,
+	0x4f, 0xc5, 0xf6, 0x94, 0xfc, 0x28, 0x83, 0x54, 0xda, 0xb7, 0xf8, 0x54, 0x9b, 0x87, 0x3c, 0x8f,
+	0x43, 0x48, 0xab, 0xa8, 0x81, 0x9a, 0x25, 0xbf, 0xac, 0x6b, 0x03, 0x5d, 0xb2, 0x07, 0xb8, 0x62,
+	0x2c, 0x99, 0xea, 0x18, 0xaa, 0xcc, 0xea, 0x41, 0x03, 0x35, 0xcb, 0x5e, 0x8d, 0x18, 0x20, 0xb2,
+	0x05, 0x22, 0x4f, 0x5b, 0xa0, 0xce, 0xf1, 0xe2, 0xa3, 0x6e, 0xcd, 0x3f, 0xeb, 0xc8, 0x3f, 0x87,
+	0x9f, 0x38, 0x25, 0xbb, 0x1e, 0x3e, 0xdb, 0x51, 0xf4, 0xf8, 0xf8, 0x1f, 0x0c, 0xee, 0x03, 0xbe,
+	0xdc, 0xf5, 0x74, 0x53, 0x08, 0x24, 0x8c, 0xed, 0x3b, 0x7c, 0xa8, 0x3d, 0xba, 0xa1, 0xec, 0x5d,
+	0x93, 0xdf, 0x6b, 0x24, 0xda, 0xde, 0xe7, 0x13, 0xd1, 0x29, 0x29, 0x1c, 0xdf, 0xf8, 0xdd, 0xf6,
+	0xfe, 0xb4, 0x7b, 0x98, 0x81, 0x9a, 0xe6, 0x60, 0xcc, 0xc6, 0xc0, 0x25, 0x9b, 0xb0, 0x0d, 0xc3,
+	0x89, 0xbf, 0x57, 0xe9, 0xf4, 0x17, 0x2b, 0x07, 0x2d, 0x57, 0x0e, 0xfa, 0x5a, 0x39, 0x68, 0xbe,
+	0x76, 0xac, 0xe5, 0xda, 0xb1, 0xde, 0xd7, 0x8e, 0xf5, 0x4c, 0x23, 0x26, 0xa7, 0x79, 0x48, 0x46,
+	0x22, 0xa6, 0x03, 0x8d, 0xd0, 0x9d, 0x06, 0x8c, 0xd3, 0xcd, 0x31, 0x0a, 0x8f, 0xbe, 0x6c, 0x2f,
+	0x22, 0x5f, 0x13, 0xc8, 0xc2, 0x23, 0xbd, 0xaf, 0xf6, 0xf7, 0x00, 0x06, 0xff, 0x5a, 0x42, 0x16,
+	0x02, 0x00, 0x00,
 }
 
 func (m *EventEpochStart) Marshal() (dAtA []byte, err error) {
@@ -219,6 +317,69 @@ func (m *EventEpochEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventEpochCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEpochDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -256,6 +417,30 @@ func (m *EventEpochEnd) Size() (n int) {
 	return n
 }
 
+func (m *EventEpochCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Epoch.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventEpochDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -433,6 +618,171 @@ func (m *EventEpochEnd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventEpochCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEpochDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
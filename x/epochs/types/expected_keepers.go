@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type SudoKeeper interface {
+	// CheckPermissions Checks if a contract is contained within the set of sudo
+	// contracts defined in the x/sudo module. These smart contracts are able to
+	// execute certain permissioned functions.
+	CheckPermissions(contract sdk.AccAddress, ctx sdk.Context) error
+}
@@ -20,6 +20,13 @@ const (
 	FifteenMinuteEpochID = "15 min"
 )
 
+// IsProtectedEpochID returns true for epoch identifiers that other modules
+// depend on and that cannot be deleted with a MsgDeleteEpoch. For example,
+// x/inflation mints at the end of each DayEpochID epoch.
+func IsProtectedEpochID(identifier string) bool {
+	return identifier == DayEpochID
+}
+
 // ValidateEpochIdentifierInterface performs a stateless
 // validation of the epoch ID interface.
 func ValidateEpochIdentifierInterface(i any) error {
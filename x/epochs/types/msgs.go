@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ legacytx.LegacyMsg = &MsgCreateEpoch{}
+	_ legacytx.LegacyMsg = &MsgDeleteEpoch{}
+)
+
+// epochs message types
+const (
+	TypeMsgCreateEpoch = "create_epoch"
+	TypeMsgDeleteEpoch = "delete_epoch"
+)
+
+// -------------------------------------------------
+// MsgCreateEpoch
+// Route implements legacytx.LegacyMsg
+func (msg MsgCreateEpoch) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg MsgCreateEpoch) Type() string { return TypeMsgCreateEpoch }
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgCreateEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateEpoch) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (m MsgCreateEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	if err := ValidateEpochIdentifierString(m.Identifier); err != nil {
+		return err
+	}
+	if m.Duration <= 0 {
+		return fmt.Errorf("epoch duration must be positive, got %s", m.Duration)
+	}
+	return nil
+}
+
+// EpochInfo returns the [EpochInfo] that the message adds to state. Counting
+// starts at the first block at or after the start time.
+func (m MsgCreateEpoch) EpochInfo() EpochInfo {
+	return EpochInfo{
+		Identifier:            m.Identifier,
+		StartTime:             m.StartTime,
+		Duration:              m.Duration,
+		CurrentEpoch:          0,
+		CurrentEpochStartTime: m.StartTime,
+		EpochCountingStarted:  false,
+	}
+}
+
+// -------------------------------------------------
+// MsgDeleteEpoch
+// Route implements legacytx.LegacyMsg
+func (msg MsgDeleteEpoch) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg MsgDeleteEpoch) Type() string { return TypeMsgDeleteEpoch }
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgDeleteEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgDeleteEpoch) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (m MsgDeleteEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	return ValidateEpochIdentifierString(m.Identifier)
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 )
 
-// EthereumConfig returns an Ethereum ChainConfig for EVM state transitions.
+// EthereumConfig returns an Ethereum ChainConfig for EVM state transitions
+// without the hard forks that are activated by the module parameters. Use
+// [Params.EthereumConfig] for the config of the current chain state.
 func EthereumConfig(chainID *big.Int) *params.ChainConfig {
 	return &params.ChainConfig{
 		ChainID:             chainID,
@@ -32,7 +34,12 @@ func EthereumConfig(chainID *big.Int) *params.ChainConfig {
 		// Shanghai switch time (nil = no fork, 0 => already on shanghai)
 		ShanghaiTime: ptrU64(0),
 		// CancunTime switch time (nil = no fork, 0 => already on cancun)
-		CancunTime:              nil, // nil => disable "blobs"
+		CancunTime: nil, // set by [Params.EthereumConfig]
+		// TODO: feat(evm): EIP-7702 set-code txs (type 4). Blocked on the
+		// upgrade of the Nibiru fork to go-ethereum v1.15. The v1.14 fork has
+		// no SetCodeTx type, so type 4 txs can't be decoded, signed, or hashed
+		// as a "gethcore.Transaction", and the EVM doesn't follow delegation
+		// designators.
 		PragueTime:              nil, // nil => disable EIP-7702, blob improvements, and increased CALL gas costs
 		VerkleTime:              nil, // nil => disable stateless verification
 		TerminalTotalDifficulty: nil,
@@ -41,6 +48,21 @@ func EthereumConfig(chainID *big.Int) *params.ChainConfig {
 	}
 }
 
+// EthereumConfig returns the Ethereum ChainConfig for EVM state transitions
+// with the hard forks activated by the parameters.
+//
+// Cancun: Activated at [Params.CancunTime]. Nibiru has no blob transactions,
+// so BLOBHASH always returns zero and BLOBBASEFEE returns zero. The
+// point-evaluation precompile of EIP-4844 is available to verify KZG proofs.
+func (p Params) EthereumConfig(chainID *big.Int) *params.ChainConfig {
+	cfg := EthereumConfig(chainID)
+	if p.CancunTime != 0 {
+		cancunTime := p.CancunTime
+		cfg.CancunTime = &cancunTime
+	}
+	return cfg
+}
+
 func ptrU64(n uint) *uint64 {
 	u64 := uint64(n)
 	return &u64
@@ -1,6 +1,7 @@
 package evm
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -10,3 +11,17 @@ func TestChainConfigValidate(t *testing.T) {
 	err := Validate()
 	require.NoError(t, err)
 }
+
+func TestParamsEthereumConfig(t *testing.T) {
+	chainID := big.NewInt(1)
+	params := DefaultParams()
+	require.Nil(t, params.EthereumConfig(chainID).CancunTime)
+	require.False(t, params.EthereumConfig(chainID).IsCancun(big.NewInt(1), 1_000))
+
+	params.CancunTime = 1_000
+	cfg := params.EthereumConfig(chainID)
+	require.False(t, cfg.IsCancun(big.NewInt(1), 999))
+	require.True(t, cfg.IsCancun(big.NewInt(1), 1_000))
+	require.False(t, EthereumConfig(chainID).IsCancun(big.NewInt(1), 1_000),
+		"base config is unchanged")
+}
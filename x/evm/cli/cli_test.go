@@ -49,6 +49,95 @@ func (s *Suite) TestCmdConvertCoinToEvm() {
 	}
 }
 
+func (s *Suite) TestCmdConvertEvmToCoin() {
+	testCases := []TestCase{
+		{
+			name: "happy: convert-evm-to-coin",
+			args: []string{
+				"convert-evm-to-coin",
+				dummyFuntoken.Erc20Addr.Hex(),
+				"123",
+				dummyAccs[1].NibiruAddr.String(),
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "",
+		},
+		{
+			name: "happy: convert-evm-to-coin to the sender",
+			args: []string{
+				"convert-evm-to-coin",
+				dummyFuntoken.Erc20Addr.Hex(),
+				"123",
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "",
+		},
+		{
+			name: "sad: amount format",
+			args: []string{
+				"convert-evm-to-coin",
+				dummyFuntoken.Erc20Addr.Hex(),
+				"123unibi",
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "invalid amount",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunTxCmd(s)
+	}
+}
+
+func (s *Suite) TestCmdFunTokenLifecycle() {
+	testCases := []TestCase{
+		{
+			name: "happy: sync-funtoken-metadata",
+			args: []string{
+				"sync-funtoken-metadata",
+				dummyFuntoken.Erc20Addr.Hex(),
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "",
+		},
+		{
+			name: "happy: set-funtoken-disabled",
+			args: []string{
+				"set-funtoken-disabled",
+				dummyFuntoken.Erc20Addr.Hex(),
+				"true",
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "",
+		},
+		{
+			name: "sad: set-funtoken-disabled bool format",
+			args: []string{
+				"set-funtoken-disabled",
+				dummyFuntoken.Erc20Addr.Hex(),
+				"paused",
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "invalid disabled value",
+		},
+		{
+			name: "happy: migrate-funtoken",
+			args: []string{
+				"migrate-funtoken",
+				dummyFuntoken.Erc20Addr.Hex(),
+				dummyEthAddr,
+				dummyAccs[2].NibiruAddr.String(),
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunTxCmd(s)
+	}
+}
+
 func (s *Suite) TestCmdCreateFunToken() {
 	testCases := []TestCase{
 		{
@@ -171,3 +260,68 @@ func (s *Suite) TestCmdQueryFunToken() {
 		tc.RunQueryCmd(s)
 	}
 }
+
+func (s *Suite) TestCmdQueryFunTokens() {
+	testCases := []TestCase{
+		{
+			name: "happy: query funtokens",
+			args: []string{
+				"funtokens",
+			},
+			wantErr: "",
+		},
+		{
+			name: "happy: query funtokens --origin erc20 --limit 10",
+			args: []string{
+				"funtokens",
+				"--origin=erc20",
+				"--limit=10",
+			},
+			wantErr: "",
+		},
+		{
+			name: "sad: invalid origin",
+			args: []string{
+				"funtokens",
+				"--origin=bank",
+			},
+			wantErr: "invalid --origin",
+		},
+		{
+			name: "happy: query funtoken-reserves (bank coin denom)",
+			args: []string{
+				"funtoken-reserves",
+				dummyFuntoken.BankDenom,
+			},
+			wantErr: "",
+		},
+		{
+			name: "happy: query funtoken-audit",
+			args: []string{
+				"funtoken-audit",
+			},
+			wantErr: "",
+		},
+		{
+			name: "sad: funtoken-audit takes no args",
+			args: []string{
+				"funtoken-audit",
+				"arg1",
+			},
+			wantErr: "unknown command",
+		},
+		{
+			name: "sad: funtoken-reserves too many args",
+			args: []string{
+				"funtoken-reserves",
+				"arg1",
+				"arg2",
+			},
+			wantErr: "accepts 1 arg",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunQueryCmd(s)
+	}
+}
@@ -30,6 +30,9 @@ func GetQueryCmd() *cobra.Command {
 	// Add subcommands
 	cmds := []*cobra.Command{
 		CmdQueryFunToken(),
+		CmdQueryFunTokens(),
+		CmdQueryFunTokenReserves(),
+		CmdQueryFunTokenAudit(),
 		CmdQueryAccount(),
 	}
 	for _, cmd := range cmds {
@@ -75,6 +78,151 @@ $ %s query %s get-fun-token 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6
 	return cmd
 }
 
+// CmdQueryFunTokens lists the fungible token mappings with pagination
+func CmdQueryFunTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funtokens",
+		Short: "Query all evm fungible token mappings",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all evm fungible token mappings, optionally filtered by
+the side the mapping was created from with --origin ("coin" or "erc20").
+
+Examples:
+$ %s query %s funtokens
+$ %s query %s funtokens --origin coin --limit 10
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			originFlag, err := cmd.Flags().GetString(FlagOrigin)
+			if err != nil {
+				return err
+			}
+			origin, err := parseFunTokenOrigin(originFlag)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FunTokenMappings(cmd.Context(), &evm.QueryFunTokenMappingsRequest{
+				Origin:     origin,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagOrigin, "", `Filter by the origin of the mapping: "coin" or "erc20"`)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "funtokens")
+	return cmd
+}
+
+// FlagOrigin filters FunToken mappings by the side they were created from.
+const FlagOrigin = "origin"
+
+func parseFunTokenOrigin(origin string) (evm.FunTokenOrigin, error) {
+	switch strings.ToLower(origin) {
+	case "":
+		return evm.FunTokenOrigin_FUNTOKEN_ORIGIN_UNSPECIFIED, nil
+	case "coin":
+		return evm.FunTokenOrigin_FUNTOKEN_ORIGIN_COIN, nil
+	case "erc20":
+		return evm.FunTokenOrigin_FUNTOKEN_ORIGIN_ERC20, nil
+	default:
+		return 0, fmt.Errorf(`invalid --%s "%s", expected "coin" or "erc20"`, FlagOrigin, origin)
+	}
+}
+
+// CmdQueryFunTokenReserves returns the supply on each side of a fungible token
+// mapping for either bank coin or erc20 addr
+func CmdQueryFunTokenReserves() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funtoken-reserves [coin-or-erc20addr]",
+		Short: "Query the escrowed and minted amounts of an evm fungible token mapping",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the bank supply, ERC20 total supply, and the amounts the EVM
+module escrows and mints for an evm fungible token mapping.
+
+Examples:
+$ %s query %s funtoken-reserves ibc/abcdef
+$ %s query %s funtoken-reserves 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FunTokenReserves(cmd.Context(), &evm.QueryFunTokenReservesRequest{
+				Token: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryFunTokenAudit reports the under-collateralized fungible token
+// mappings
+func CmdQueryFunTokenAudit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funtoken-audit",
+		Short: "Report the evm fungible token mappings that are under-collateralized",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Check the reserves of every evm fungible token mapping and report the
+mappings where the EVM module escrows less than it minted, along with the
+mappings whose reserves could not be queried.
+
+Examples:
+$ %s query %s funtoken-audit
+`,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FunTokenAudit(cmd.Context(), &evm.QueryFunTokenAuditRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdQueryAccount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "account [address]",
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -28,6 +30,10 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		CmdCreateFunToken(),
 		CmdConvertCoinToEvm(),
+		CmdConvertEvmToCoin(),
+		CmdSyncFunTokenMetadata(),
+		CmdSetFunTokenDisabled(),
+		CmdMigrateFunToken(),
 	}
 	for _, cmd := range cmds {
 		txCmd.AddCommand(cmd)
@@ -123,3 +129,166 @@ func CmdConvertCoinToEvm() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdConvertEvmToCoin broadcast MsgConvertEvmToCoin
+func CmdConvertEvmToCoin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-evm-to-coin [erc20_addr] [amount] [to_addr] [flags]",
+		Short: `Convert [amount] of the FunToken ERC20 [erc20_addr] held by the sender's EVM address to its bank coin representation and send it to [to_addr]`,
+		Long: heredoc.Doc(`
+			Convert ERC20 tokens with a FunToken mapping to bank coins.
+			The tokens are taken from the EVM address of the sender, and
+			[to_addr] is a bech32 "nibi" address or a hex Ethereum address.
+			If [to_addr] is omitted, the coins are sent to the sender.
+		`),
+		Example: heredoc.Doc(`
+			nibid tx evm convert-evm-to-coin 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6 1000000 --from=validator
+		`),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			erc20Addr, err := eth.NewEIP55AddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %q", args[1])
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			toAddr := sender
+			if len(args) == 3 {
+				toAddr = args[2]
+			}
+			msg := &evm.MsgConvertEvmToCoin{
+				Sender:    sender,
+				Erc20Addr: erc20Addr,
+				Amount:    amount,
+				ToAddr:    toAddr,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdSyncFunTokenMetadata broadcast MsgSyncFunTokenMetadata
+func CmdSyncFunTokenMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync-funtoken-metadata [erc20_addr] [flags]",
+		Short: `[SUDO] Refresh the metadata of the FunToken mapping of [erc20_addr] from its source`,
+		Long: heredoc.Doc(`
+			Refresh the metadata of a FunToken mapping. For mappings created
+			from an ERC20, the bank coin metadata is rebuilt from the ERC20.
+			For mappings created from a bank coin, the ERC20 deployed by the
+			EVM module takes the name, symbol, and decimals of the bank coin.
+		`),
+		Example: heredoc.Doc(`
+			nibid tx evm sync-funtoken-metadata 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6 --from=sudoer
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			erc20Addr, err := eth.NewEIP55AddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			msg := &evm.MsgSyncFunTokenMetadata{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Erc20Addr: erc20Addr,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdSetFunTokenDisabled broadcast MsgSetFunTokenDisabled
+func CmdSetFunTokenDisabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-funtoken-disabled [erc20_addr] [disabled] [flags]",
+		Short: `[SUDO] Pause (true) or resume (false) the conversions of the FunToken mapping of [erc20_addr]`,
+		Example: heredoc.Doc(`
+			nibid tx evm set-funtoken-disabled 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6 true --from=sudoer
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			erc20Addr, err := eth.NewEIP55AddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			disabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid disabled value %q: %w", args[1], err)
+			}
+			msg := &evm.MsgSetFunTokenDisabled{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Erc20Addr: erc20Addr,
+				Disabled:  disabled,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdMigrateFunToken broadcast MsgMigrateFunToken
+func CmdMigrateFunToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-funtoken [erc20_addr] [new_erc20_addr] [old_erc20_recipient] [flags]",
+		Short: `[SUDO] Move the FunToken mapping of [erc20_addr] to [new_erc20_addr]`,
+		Long: heredoc.Doc(`
+			Move a FunToken mapping created from an ERC20 to a new ERC20. The
+			EVM module must hold at least the bank coin supply in the new
+			ERC20. The escrowed balance of the old ERC20 is sent to
+			[old_erc20_recipient], a bech32 "nibi" address or a hex Ethereum
+			address.
+		`),
+		Example: heredoc.Doc(`
+			nibid tx evm migrate-funtoken 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6 0x9BD0b1a0e5aA1bA4e1e9A7e4F9C7A0d7c3E1b2F4 nibi1... --from=sudoer
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			erc20Addr, err := eth.NewEIP55AddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			newErc20Addr, err := eth.NewEIP55AddrFromStr(args[1])
+			if err != nil {
+				return err
+			}
+			msg := &evm.MsgMigrateFunToken{
+				Sender:            clientCtx.GetFromAddress().String(),
+				Erc20Addr:         erc20Addr,
+				NewErc20Addr:      newErc20Addr,
+				OldErc20Recipient: args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
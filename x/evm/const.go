@@ -4,6 +4,7 @@ package evm
 import (
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,7 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethcore "github.com/ethereum/go-ethereum/core"
 	gethvm "github.com/ethereum/go-ethereum/core/vm"
+	gethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 
 	"github.com/NibiruChain/nibiru/v2/x/common/set"
@@ -33,9 +35,24 @@ set.New[gethcommon.Address](
 		gethcommon.HexToAddress("0x0000000000000000000000000000000000000802"),
 		// Oracle 0x...801
 		gethcommon.HexToAddress("0x0000000000000000000000000000000000000801"),
+		// P256VERIFY 0x...100 (RIP-7212)
+		gethcommon.HexToAddress("0x0000000000000000000000000000000000000100"),
 	}...)...,
 ).ToSlice()
 
+// PRECOMPILE_ADDR_POINT_EVALUATION is the address of the point-evaluation
+// precompile of EIP-4844, which is active from the Cancun hard fork.
+var PRECOMPILE_ADDR_POINT_EVALUATION = gethcommon.BytesToAddress([]byte{0x0a})
+
+// PrecompileAddrs returns the addresses of the precompiles that are active
+// under the given rules.
+func PrecompileAddrs(rules gethparams.Rules) []gethcommon.Address {
+	if !rules.IsCancun {
+		return PRECOMPILE_ADDRS
+	}
+	return append(slices.Clone(PRECOMPILE_ADDRS), PRECOMPILE_ADDR_POINT_EVALUATION)
+}
+
 const (
 	// ModuleName string name of module
 	ModuleName = "evm"
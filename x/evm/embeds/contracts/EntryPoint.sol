@@ -0,0 +1,1001 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.21;
+
+/// @notice User operation in the packed form of ERC-4337 EntryPoint v0.7.
+/// @param sender The account making the operation.
+/// @param nonce Anti-replay value: a 192-bit key and a 64-bit sequence number.
+/// @param initCode Factory address followed by the factory call data, which
+/// deploys the sender if it doesn't exist yet. Empty for existing accounts.
+/// @param callData The call the sender executes in the execution phase.
+/// @param accountGasLimits verificationGasLimit (high 128 bits) and
+/// callGasLimit (low 128 bits).
+/// @param preVerificationGas Gas paid to the bundler for the gas used outside
+/// of validation and execution, like the calldata of the bundle.
+/// @param gasFees maxPriorityFeePerGas (high 128 bits) and maxFeePerGas (low
+/// 128 bits).
+/// @param paymasterAndData Paymaster address, paymaster verification gas limit
+/// (16 bytes), paymaster post-op gas limit (16 bytes), and paymaster data.
+/// Empty if the sender pays for itself.
+/// @param signature Data the account uses to validate the operation.
+struct PackedUserOperation {
+    address sender;
+    uint256 nonce;
+    bytes initCode;
+    bytes callData;
+    bytes32 accountGasLimits;
+    uint256 preVerificationGas;
+    bytes32 gasFees;
+    bytes paymasterAndData;
+    bytes signature;
+}
+
+/// @notice Smart contract account interface of ERC-4337.
+interface IAccount {
+    /// @notice Validates the signature and nonce of a user operation and pays
+    /// the EntryPoint the missing funds for the operation.
+    /// @return validationData Packed authorizer (0 for a valid signature, 1
+    /// for an invalid signature), validUntil, and validAfter.
+    function validateUserOp(
+        PackedUserOperation calldata userOp,
+        bytes32 userOpHash,
+        uint256 missingAccountFunds
+    ) external returns (uint256 validationData);
+}
+
+/// @notice Optional account interface. If the call data of a user operation
+/// starts with the "executeUserOp" selector, the EntryPoint calls
+/// "executeUserOp" with the full user operation instead.
+interface IAccountExecute {
+    function executeUserOp(
+        PackedUserOperation calldata userOp,
+        bytes32 userOpHash
+    ) external;
+}
+
+/// @notice Paymaster interface of ERC-4337.
+interface IPaymaster {
+    enum PostOpMode {
+        opSucceeded,
+        opReverted,
+        postOpReverted
+    }
+
+    /// @notice Validates a user operation the paymaster agrees to pay for.
+    function validatePaymasterUserOp(
+        PackedUserOperation calldata userOp,
+        bytes32 userOpHash,
+        uint256 maxCost
+    ) external returns (bytes memory context, uint256 validationData);
+
+    /// @notice Called after the execution of a user operation when
+    /// "validatePaymasterUserOp" returned a non-empty context.
+    function postOp(
+        PostOpMode mode,
+        bytes calldata context,
+        uint256 actualGasCost,
+        uint256 actualUserOpFeePerGas
+    ) external;
+}
+
+/// @notice Helper contract that calls account factories on behalf of the
+/// EntryPoint, so that factories never see the EntryPoint as the caller.
+contract SenderCreator {
+    /// @notice Calls the factory in "initCode" and returns the address of the
+    /// created account, or the zero address if the factory call failed.
+    function createSender(
+        bytes calldata initCode
+    ) external returns (address sender) {
+        address factory = address(bytes20(initCode[0:20]));
+        bytes memory initCallData = initCode[20:];
+        bool success;
+        assembly ("memory-safe") {
+            success := call(
+                gas(),
+                factory,
+                0,
+                add(initCallData, 0x20),
+                mload(initCallData),
+                0,
+                32
+            )
+            sender := mload(0)
+        }
+        if (!success) {
+            sender = address(0);
+        }
+    }
+}
+
+/// @notice ERC-4337 EntryPoint implementing the v0.7 interface. It validates
+/// and executes user operations in bundles, and keeps the deposits and stakes
+/// that pay for them.
+/// @dev Aggregated signatures ("handleAggregatedOps") aren't supported. Use
+/// "simulateHandleOp" with eth_call to simulate an operation; it always
+/// reverts.
+contract EntryPoint {
+    /// @dev Address of the predeployed [SenderCreator].
+    SenderCreator private constant SENDER_CREATOR =
+        SenderCreator(0x0000000000000000000000000000000000004338);
+
+    uint256 private constant REVERT_REASON_MAX_LEN = 2048;
+    uint256 private constant PENALTY_PERCENT = 10;
+    uint256 private constant INNER_GAS_OVERHEAD = 10000;
+    bytes32 private constant INNER_OUT_OF_GAS = hex"deaddead";
+    bytes32 private constant INNER_REVERT_LOW_PREFUND = hex"deadaa51";
+
+    uint256 private constant PAYMASTER_VALIDATION_GAS_OFFSET = 20;
+    uint256 private constant PAYMASTER_POSTOP_GAS_OFFSET = 36;
+    uint256 private constant PAYMASTER_DATA_OFFSET = 52;
+
+    /// @notice Deposit and stake of an account or paymaster.
+    struct DepositInfo {
+        uint256 deposit;
+        bool staked;
+        uint112 stake;
+        uint32 unstakeDelaySec;
+        uint48 withdrawTime;
+    }
+
+    /// @notice The unpacked fields of a user operation.
+    struct MemoryUserOp {
+        address sender;
+        uint256 nonce;
+        uint256 verificationGasLimit;
+        uint256 callGasLimit;
+        uint256 paymasterVerificationGasLimit;
+        uint256 paymasterPostOpGasLimit;
+        uint256 preVerificationGas;
+        address paymaster;
+        uint256 maxFeePerGas;
+        uint256 maxPriorityFeePerGas;
+    }
+
+    /// @notice State of a user operation between validation and execution.
+    struct UserOpInfo {
+        MemoryUserOp mUserOp;
+        bytes32 userOpHash;
+        uint256 prefund;
+        bytes context;
+        uint256 preOpGas;
+    }
+
+    event Deposited(address indexed account, uint256 totalDeposit);
+    event Withdrawn(
+        address indexed account,
+        address withdrawAddress,
+        uint256 amount
+    );
+    event StakeLocked(
+        address indexed account,
+        uint256 totalStaked,
+        uint256 unstakeDelaySec
+    );
+    event StakeUnlocked(address indexed account, uint256 withdrawTime);
+    event StakeWithdrawn(
+        address indexed account,
+        address withdrawAddress,
+        uint256 amount
+    );
+
+    /// @notice Emitted after the execution of each user operation.
+    event UserOperationEvent(
+        bytes32 indexed userOpHash,
+        address indexed sender,
+        address indexed paymaster,
+        uint256 nonce,
+        bool success,
+        uint256 actualGasCost,
+        uint256 actualGasUsed
+    );
+    /// @notice Emitted when an account is created from "initCode".
+    event AccountDeployed(
+        bytes32 indexed userOpHash,
+        address indexed sender,
+        address factory,
+        address paymaster
+    );
+    /// @notice Emitted when the call of a user operation reverts.
+    event UserOperationRevertReason(
+        bytes32 indexed userOpHash,
+        address indexed sender,
+        uint256 nonce,
+        bytes revertReason
+    );
+    /// @notice Emitted when the "postOp" of a paymaster reverts.
+    event PostOpRevertReason(
+        bytes32 indexed userOpHash,
+        address indexed sender,
+        uint256 nonce,
+        bytes revertReason
+    );
+    /// @notice Emitted when the prefund of a user operation doesn't cover its
+    /// actual gas cost.
+    event UserOperationPrefundTooLow(
+        bytes32 indexed userOpHash,
+        address indexed sender,
+        uint256 nonce
+    );
+    /// @notice Emitted between the validation and execution loops.
+    event BeforeExecution();
+
+    /// @notice A user operation failed validation. Bundlers must drop it.
+    error FailedOp(uint256 opIndex, string reason);
+    /// @notice A user operation failed validation with an inner revert.
+    error FailedOpWithRevert(uint256 opIndex, string reason, bytes inner);
+    error PostOpReverted(bytes returnData);
+    /// @notice Result of "getSenderAddress", which always reverts.
+    error SenderAddressResult(address sender);
+    /// @notice Result of "simulateHandleOp", which always reverts.
+    /// @param preOpGas Gas used in validation, plus the preVerificationGas.
+    /// @param executionGasUsed Gas used by the call to the account.
+    /// @param accountValidationData Validation data of the account.
+    /// @param paymasterValidationData Validation data of the paymaster.
+    /// @param executionSuccess Whether the call to the account succeeded.
+    /// @param executionResult Return or revert data of the call.
+    error ExecutionResult(
+        uint256 preOpGas,
+        uint256 executionGasUsed,
+        uint256 accountValidationData,
+        uint256 paymasterValidationData,
+        bool executionSuccess,
+        bytes executionResult
+    );
+
+    /// @notice Deposit and stake of each account and paymaster.
+    mapping(address => DepositInfo) public deposits;
+
+    /// @notice Next nonce sequence number of each account and nonce key.
+    mapping(address => mapping(uint192 => uint256)) public nonceSequenceNumber;
+
+    uint256 private _entered;
+
+    modifier nonReentrant() {
+        require(_entered == 0, "ReentrancyGuard: reentrant call");
+        _entered = 1;
+        _;
+        _entered = 0;
+    }
+
+    receive() external payable {
+        depositTo(msg.sender);
+    }
+
+    // ------------------------------------------------------------------------
+    // Stake manager
+    // ------------------------------------------------------------------------
+
+    /// @notice Returns the deposit and stake of an account.
+    function getDepositInfo(
+        address account
+    ) external view returns (DepositInfo memory info) {
+        return deposits[account];
+    }
+
+    /// @notice Returns the deposit of an account, which pays for its gas.
+    function balanceOf(address account) public view returns (uint256) {
+        return deposits[account].deposit;
+    }
+
+    /// @notice Adds to the deposit of an account.
+    function depositTo(address account) public payable {
+        uint256 newDeposit = _incrementDeposit(account, msg.value);
+        emit Deposited(account, newDeposit);
+    }
+
+    /// @notice Adds to the stake of the sender and locks it for at least
+    /// "unstakeDelaySec" seconds after "unlockStake".
+    function addStake(uint32 unstakeDelaySec) external payable {
+        DepositInfo storage info = deposits[msg.sender];
+        require(unstakeDelaySec > 0, "must specify unstake delay");
+        require(
+            unstakeDelaySec >= info.unstakeDelaySec,
+            "cannot decrease unstake time"
+        );
+        uint256 stake = info.stake + msg.value;
+        require(stake > 0, "no stake specified");
+        require(stake <= type(uint112).max, "stake overflow");
+        info.staked = true;
+        info.stake = uint112(stake);
+        info.unstakeDelaySec = unstakeDelaySec;
+        info.withdrawTime = 0;
+        emit StakeLocked(msg.sender, stake, unstakeDelaySec);
+    }
+
+    /// @notice Starts the unstake delay of the sender's stake.
+    function unlockStake() external {
+        DepositInfo storage info = deposits[msg.sender];
+        require(info.unstakeDelaySec != 0, "not staked");
+        require(info.staked, "already unstaking");
+        uint48 withdrawTime = uint48(block.timestamp) + info.unstakeDelaySec;
+        info.withdrawTime = withdrawTime;
+        info.staked = false;
+        emit StakeUnlocked(msg.sender, withdrawTime);
+    }
+
+    /// @notice Withdraws the unlocked stake of the sender.
+    function withdrawStake(address payable withdrawAddress) external {
+        DepositInfo storage info = deposits[msg.sender];
+        uint256 stake = info.stake;
+        require(stake > 0, "No stake to withdraw");
+        require(info.withdrawTime > 0, "must call unlockStake() first");
+        require(
+            info.withdrawTime <= block.timestamp,
+            "Stake withdrawal is not due"
+        );
+        info.unstakeDelaySec = 0;
+        info.withdrawTime = 0;
+        info.stake = 0;
+        emit StakeWithdrawn(msg.sender, withdrawAddress, stake);
+        (bool success, ) = withdrawAddress.call{value: stake}("");
+        require(success, "failed to withdraw stake");
+    }
+
+    /// @notice Withdraws from the deposit of the sender.
+    function withdrawTo(
+        address payable withdrawAddress,
+        uint256 withdrawAmount
+    ) external {
+        DepositInfo storage info = deposits[msg.sender];
+        require(withdrawAmount <= info.deposit, "Withdraw amount too large");
+        info.deposit = info.deposit - withdrawAmount;
+        emit Withdrawn(msg.sender, withdrawAddress, withdrawAmount);
+        (bool success, ) = withdrawAddress.call{value: withdrawAmount}("");
+        require(success, "failed to withdraw");
+    }
+
+    function _incrementDeposit(
+        address account,
+        uint256 amount
+    ) internal returns (uint256) {
+        DepositInfo storage info = deposits[account];
+        uint256 newAmount = info.deposit + amount;
+        info.deposit = newAmount;
+        return newAmount;
+    }
+
+    // ------------------------------------------------------------------------
+    // Nonce manager
+    // ------------------------------------------------------------------------
+
+    /// @notice Returns the next valid nonce of "sender" for the nonce "key".
+    function getNonce(
+        address sender,
+        uint192 key
+    ) public view returns (uint256 nonce) {
+        return nonceSequenceNumber[sender][key] | (uint256(key) << 64);
+    }
+
+    /// @notice Manually increments the nonce of the sender for "key".
+    function incrementNonce(uint192 key) external {
+        nonceSequenceNumber[msg.sender][key]++;
+    }
+
+    function _validateAndUpdateNonce(
+        address sender,
+        uint256 nonce
+    ) internal returns (bool) {
+        uint192 key = uint192(nonce >> 64);
+        uint64 seq = uint64(nonce);
+        return nonceSequenceNumber[sender][key]++ == seq;
+    }
+
+    // ------------------------------------------------------------------------
+    // User operations
+    // ------------------------------------------------------------------------
+
+    /// @notice Returns the hash that the account signs for a user operation.
+    function getUserOpHash(
+        PackedUserOperation calldata userOp
+    ) public view returns (bytes32) {
+        return
+            keccak256(abi.encode(_hashUserOp(userOp), address(this), block.chainid));
+    }
+
+    /// @notice Returns the address of the account "initCode" creates by
+    /// reverting with [SenderAddressResult].
+    function getSenderAddress(bytes calldata initCode) external {
+        address sender = SENDER_CREATOR.createSender(initCode);
+        revert SenderAddressResult(sender);
+    }
+
+    /// @notice Validates and executes a bundle of user operations, then pays
+    /// the collected fees to "beneficiary".
+    function handleOps(
+        PackedUserOperation[] calldata ops,
+        address payable beneficiary
+    ) external nonReentrant {
+        uint256 opslen = ops.length;
+        UserOpInfo[] memory opInfos = new UserOpInfo[](opslen);
+        for (uint256 i = 0; i < opslen; i++) {
+            (
+                uint256 validationData,
+                uint256 pmValidationData
+            ) = _validatePrepayment(i, ops[i], opInfos[i]);
+            _validateAccountAndPaymasterValidationData(
+                i,
+                validationData,
+                pmValidationData
+            );
+        }
+
+        uint256 collected = 0;
+        emit BeforeExecution();
+        for (uint256 i = 0; i < opslen; i++) {
+            collected += _executeUserOp(i, ops[i], opInfos[i]);
+        }
+        _compensate(beneficiary, collected);
+    }
+
+    /// @notice Simulates the validation and the call of a user operation and
+    /// reverts with [ExecutionResult]. Signature and time range failures are
+    /// reported in the validation data instead of failing the simulation, so
+    /// that gas can be estimated with a dummy signature.
+    function simulateHandleOp(
+        PackedUserOperation calldata op
+    ) external {
+        UserOpInfo memory opInfo;
+        (
+            uint256 validationData,
+            uint256 pmValidationData
+        ) = _validatePrepayment(0, op, opInfo);
+        bytes memory callData = _executionCallData(op, opInfo.userOpHash);
+        uint256 preGas = gasleft();
+        (bool success, bytes memory result) = opInfo.mUserOp.sender.call{
+            gas: opInfo.mUserOp.callGasLimit
+        }(callData);
+        uint256 executionGasUsed = preGas - gasleft();
+        revert ExecutionResult(
+            opInfo.preOpGas,
+            executionGasUsed,
+            validationData,
+            pmValidationData,
+            success,
+            result
+        );
+    }
+
+    /// @notice Executes the call of a user operation and its post-op. Only
+    /// callable by the EntryPoint itself, so that a revert undoes the
+    /// execution without failing the bundle.
+    function innerHandleOp(
+        bytes memory callData,
+        UserOpInfo memory opInfo
+    ) external returns (uint256 actualGasCost) {
+        uint256 preGas = gasleft();
+        require(msg.sender == address(this), "AA92 internal call only");
+        MemoryUserOp memory mUserOp = opInfo.mUserOp;
+
+        uint256 callGasLimit = mUserOp.callGasLimit;
+        unchecked {
+            if (
+                (gasleft() * 63) / 64 <
+                callGasLimit +
+                    mUserOp.paymasterPostOpGasLimit +
+                    INNER_GAS_OVERHEAD
+            ) {
+                assembly ("memory-safe") {
+                    mstore(0, INNER_OUT_OF_GAS)
+                    revert(0, 32)
+                }
+            }
+        }
+
+        IPaymaster.PostOpMode mode = IPaymaster.PostOpMode.opSucceeded;
+        if (callData.length > 0) {
+            (bool success, ) = mUserOp.sender.call{gas: callGasLimit}(
+                callData
+            );
+            if (!success) {
+                bytes memory result = _getReturnData(REVERT_REASON_MAX_LEN);
+                if (result.length > 0) {
+                    emit UserOperationRevertReason(
+                        opInfo.userOpHash,
+                        mUserOp.sender,
+                        mUserOp.nonce,
+                        result
+                    );
+                }
+                mode = IPaymaster.PostOpMode.opReverted;
+            }
+        }
+
+        unchecked {
+            uint256 actualGas = preGas - gasleft() + opInfo.preOpGas;
+            return _postExecution(mode, opInfo, actualGas);
+        }
+    }
+
+    function _compensate(address payable beneficiary, uint256 amount) internal {
+        require(beneficiary != address(0), "AA90 invalid beneficiary");
+        (bool success, ) = beneficiary.call{value: amount}("");
+        require(success, "AA91 failed send to beneficiary");
+    }
+
+    function _executionCallData(
+        PackedUserOperation calldata userOp,
+        bytes32 userOpHash
+    ) internal pure returns (bytes memory) {
+        bytes calldata callData = userOp.callData;
+        if (
+            callData.length >= 4 &&
+            bytes4(callData[0:4]) == IAccountExecute.executeUserOp.selector
+        ) {
+            return
+                abi.encodeCall(
+                    IAccountExecute.executeUserOp,
+                    (userOp, userOpHash)
+                );
+        }
+        return callData;
+    }
+
+    function _executeUserOp(
+        uint256 opIndex,
+        PackedUserOperation calldata userOp,
+        UserOpInfo memory opInfo
+    ) internal returns (uint256 collected) {
+        uint256 preGas = gasleft();
+        bytes memory innerCall = abi.encodeCall(
+            this.innerHandleOp,
+            (_executionCallData(userOp, opInfo.userOpHash), opInfo)
+        );
+        (bool success, bytes memory ret) = address(this).call(innerCall);
+        if (success && ret.length == 32) {
+            return abi.decode(ret, (uint256));
+        }
+
+        bytes32 innerRevertCode;
+        if (ret.length == 32) {
+            innerRevertCode = bytes32(ret);
+        }
+        if (innerRevertCode == INNER_OUT_OF_GAS) {
+            // The bundler didn't provide enough gas for the call.
+            revert FailedOp(opIndex, "AA95 out of gas");
+        }
+        unchecked {
+            uint256 actualGas = preGas - gasleft() + opInfo.preOpGas;
+            if (innerRevertCode == INNER_REVERT_LOW_PREFUND) {
+                // The prefund didn't cover the gas, so all of it is used.
+                collected = opInfo.prefund;
+                _emitPrefundTooLow(opInfo);
+                _emitUserOperationEvent(opInfo, false, collected, actualGas);
+                return collected;
+            }
+            emit PostOpRevertReason(
+                opInfo.userOpHash,
+                opInfo.mUserOp.sender,
+                opInfo.mUserOp.nonce,
+                ret
+            );
+            return
+                _postExecution(
+                    IPaymaster.PostOpMode.postOpReverted,
+                    opInfo,
+                    actualGas
+                );
+        }
+    }
+
+    function _postExecution(
+        IPaymaster.PostOpMode mode,
+        UserOpInfo memory opInfo,
+        uint256 actualGas
+    ) internal returns (uint256 actualGasCost) {
+        uint256 preGas = gasleft();
+        unchecked {
+            MemoryUserOp memory mUserOp = opInfo.mUserOp;
+            uint256 gasPrice = _getUserOpGasPrice(mUserOp);
+            address refundAddress = mUserOp.sender;
+            if (mUserOp.paymaster != address(0)) {
+                refundAddress = mUserOp.paymaster;
+                if (
+                    opInfo.context.length > 0 &&
+                    mode != IPaymaster.PostOpMode.postOpReverted
+                ) {
+                    actualGasCost = actualGas * gasPrice;
+                    try
+                        IPaymaster(mUserOp.paymaster).postOp{
+                            gas: mUserOp.paymasterPostOpGasLimit
+                        }(mode, opInfo.context, actualGasCost, gasPrice)
+                    {} catch {
+                        revert PostOpReverted(
+                            _getReturnData(REVERT_REASON_MAX_LEN)
+                        );
+                    }
+                }
+            }
+            actualGas += preGas - gasleft();
+
+            // Penalize unused execution gas so that bundlers aren't hurt by
+            // operations reserving more gas than they need.
+            uint256 executionGasLimit = mUserOp.callGasLimit +
+                mUserOp.paymasterPostOpGasLimit;
+            uint256 executionGasUsed = actualGas - opInfo.preOpGas;
+            if (executionGasLimit > executionGasUsed) {
+                actualGas +=
+                    ((executionGasLimit - executionGasUsed) * PENALTY_PERCENT) /
+                    100;
+            }
+
+            actualGasCost = actualGas * gasPrice;
+            uint256 prefund = opInfo.prefund;
+            if (prefund < actualGasCost) {
+                if (mode == IPaymaster.PostOpMode.postOpReverted) {
+                    actualGasCost = prefund;
+                    _emitPrefundTooLow(opInfo);
+                    _emitUserOperationEvent(
+                        opInfo,
+                        false,
+                        actualGasCost,
+                        actualGas
+                    );
+                } else {
+                    assembly ("memory-safe") {
+                        mstore(0, INNER_REVERT_LOW_PREFUND)
+                        revert(0, 32)
+                    }
+                }
+            } else {
+                _incrementDeposit(refundAddress, prefund - actualGasCost);
+                _emitUserOperationEvent(
+                    opInfo,
+                    mode == IPaymaster.PostOpMode.opSucceeded,
+                    actualGasCost,
+                    actualGas
+                );
+            }
+        }
+    }
+
+    function _emitUserOperationEvent(
+        UserOpInfo memory opInfo,
+        bool success,
+        uint256 actualGasCost,
+        uint256 actualGas
+    ) internal {
+        emit UserOperationEvent(
+            opInfo.userOpHash,
+            opInfo.mUserOp.sender,
+            opInfo.mUserOp.paymaster,
+            opInfo.mUserOp.nonce,
+            success,
+            actualGasCost,
+            actualGas
+        );
+    }
+
+    function _emitPrefundTooLow(UserOpInfo memory opInfo) internal {
+        emit UserOperationPrefundTooLow(
+            opInfo.userOpHash,
+            opInfo.mUserOp.sender,
+            opInfo.mUserOp.nonce
+        );
+    }
+
+    // ------------------------------------------------------------------------
+    // Validation
+    // ------------------------------------------------------------------------
+
+    function _validatePrepayment(
+        uint256 opIndex,
+        PackedUserOperation calldata userOp,
+        UserOpInfo memory outOpInfo
+    ) internal returns (uint256 validationData, uint256 paymasterValidationData) {
+        uint256 preGas = gasleft();
+        MemoryUserOp memory mUserOp = outOpInfo.mUserOp;
+        _copyUserOpToMemory(userOp, mUserOp);
+        outOpInfo.userOpHash = getUserOpHash(userOp);
+
+        // Values below 2^120 can be added and multiplied without overflow.
+        uint256 maxGasValues = mUserOp.preVerificationGas |
+            mUserOp.verificationGasLimit |
+            mUserOp.callGasLimit |
+            mUserOp.paymasterVerificationGasLimit |
+            mUserOp.paymasterPostOpGasLimit |
+            mUserOp.maxFeePerGas |
+            mUserOp.maxPriorityFeePerGas;
+        if (maxGasValues > type(uint120).max) {
+            revert FailedOp(opIndex, "AA94 gas values overflow");
+        }
+
+        uint256 requiredPreFund = _getRequiredPrefund(mUserOp);
+        validationData = _validateAccountPrepayment(
+            opIndex,
+            userOp,
+            outOpInfo,
+            requiredPreFund
+        );
+        if (!_validateAndUpdateNonce(mUserOp.sender, mUserOp.nonce)) {
+            revert FailedOp(opIndex, "AA25 invalid account nonce");
+        }
+        unchecked {
+            if (preGas - gasleft() > mUserOp.verificationGasLimit) {
+                revert FailedOp(opIndex, "AA26 over verificationGasLimit");
+            }
+        }
+
+        if (mUserOp.paymaster != address(0)) {
+            (
+                outOpInfo.context,
+                paymasterValidationData
+            ) = _validatePaymasterPrepayment(
+                opIndex,
+                userOp,
+                outOpInfo,
+                requiredPreFund
+            );
+        }
+        unchecked {
+            outOpInfo.prefund = requiredPreFund;
+            outOpInfo.preOpGas =
+                preGas -
+                gasleft() +
+                mUserOp.preVerificationGas;
+        }
+    }
+
+    function _validateAccountPrepayment(
+        uint256 opIndex,
+        PackedUserOperation calldata op,
+        UserOpInfo memory opInfo,
+        uint256 requiredPrefund
+    ) internal returns (uint256 validationData) {
+        MemoryUserOp memory mUserOp = opInfo.mUserOp;
+        address sender = mUserOp.sender;
+        _createSenderIfNeeded(opIndex, opInfo, op.initCode);
+
+        uint256 missingAccountFunds = 0;
+        if (mUserOp.paymaster == address(0)) {
+            uint256 bal = balanceOf(sender);
+            missingAccountFunds = bal > requiredPrefund
+                ? 0
+                : requiredPrefund - bal;
+        }
+        try
+            IAccount(sender).validateUserOp{
+                gas: mUserOp.verificationGasLimit
+            }(op, opInfo.userOpHash, missingAccountFunds)
+        returns (uint256 _validationData) {
+            validationData = _validationData;
+        } catch {
+            revert FailedOpWithRevert(
+                opIndex,
+                "AA23 reverted",
+                _getReturnData(REVERT_REASON_MAX_LEN)
+            );
+        }
+
+        if (mUserOp.paymaster == address(0)) {
+            DepositInfo storage senderInfo = deposits[sender];
+            if (requiredPrefund > senderInfo.deposit) {
+                revert FailedOp(opIndex, "AA21 didn't pay prefund");
+            }
+            senderInfo.deposit = senderInfo.deposit - requiredPrefund;
+        }
+    }
+
+    function _validatePaymasterPrepayment(
+        uint256 opIndex,
+        PackedUserOperation calldata op,
+        UserOpInfo memory opInfo,
+        uint256 requiredPreFund
+    ) internal returns (bytes memory context, uint256 validationData) {
+        uint256 preGas = gasleft();
+        MemoryUserOp memory mUserOp = opInfo.mUserOp;
+        DepositInfo storage paymasterInfo = deposits[mUserOp.paymaster];
+        if (paymasterInfo.deposit < requiredPreFund) {
+            revert FailedOp(opIndex, "AA31 paymaster deposit too low");
+        }
+        paymasterInfo.deposit = paymasterInfo.deposit - requiredPreFund;
+
+        uint256 pmVerificationGasLimit = mUserOp.paymasterVerificationGasLimit;
+        try
+            IPaymaster(mUserOp.paymaster).validatePaymasterUserOp{
+                gas: pmVerificationGasLimit
+            }(op, opInfo.userOpHash, requiredPreFund)
+        returns (bytes memory _context, uint256 _validationData) {
+            context = _context;
+            validationData = _validationData;
+        } catch {
+            revert FailedOpWithRevert(
+                opIndex,
+                "AA33 reverted",
+                _getReturnData(REVERT_REASON_MAX_LEN)
+            );
+        }
+        unchecked {
+            if (preGas - gasleft() > pmVerificationGasLimit) {
+                revert FailedOp(opIndex, "AA36 over paymasterVerificationGasLimit");
+            }
+        }
+    }
+
+    function _createSenderIfNeeded(
+        uint256 opIndex,
+        UserOpInfo memory opInfo,
+        bytes calldata initCode
+    ) internal {
+        if (initCode.length == 0) {
+            return;
+        }
+        address sender = opInfo.mUserOp.sender;
+        if (sender.code.length != 0) {
+            revert FailedOp(opIndex, "AA10 sender already constructed");
+        }
+        if (initCode.length < 20) {
+            revert FailedOp(opIndex, "AA99 initCode too small");
+        }
+        address sender1 = SENDER_CREATOR.createSender{
+            gas: opInfo.mUserOp.verificationGasLimit
+        }(initCode);
+        if (sender1 == address(0)) {
+            revert FailedOp(opIndex, "AA13 initCode failed or OOG");
+        }
+        if (sender1 != sender) {
+            revert FailedOp(opIndex, "AA14 initCode must return sender");
+        }
+        if (sender1.code.length == 0) {
+            revert FailedOp(opIndex, "AA15 initCode must create sender");
+        }
+        emit AccountDeployed(
+            opInfo.userOpHash,
+            sender,
+            address(bytes20(initCode[0:20])),
+            opInfo.mUserOp.paymaster
+        );
+    }
+
+    function _validateAccountAndPaymasterValidationData(
+        uint256 opIndex,
+        uint256 validationData,
+        uint256 paymasterValidationData
+    ) internal view {
+        (address aggregator, bool outOfTimeRange) = _parseValidationData(
+            validationData
+        );
+        // Signature aggregators aren't supported, so any authorizer other than
+        // zero fails the signature check.
+        if (aggregator != address(0)) {
+            revert FailedOp(opIndex, "AA24 signature error");
+        }
+        if (outOfTimeRange) {
+            revert FailedOp(opIndex, "AA22 expired or not due");
+        }
+        (aggregator, outOfTimeRange) = _parseValidationData(
+            paymasterValidationData
+        );
+        if (aggregator != address(0)) {
+            revert FailedOp(opIndex, "AA34 signature error");
+        }
+        if (outOfTimeRange) {
+            revert FailedOp(opIndex, "AA32 paymaster expired or not due");
+        }
+    }
+
+    function _parseValidationData(
+        uint256 validationData
+    ) internal view returns (address aggregator, bool outOfTimeRange) {
+        if (validationData == 0) {
+            return (address(0), false);
+        }
+        aggregator = address(uint160(validationData));
+        uint48 validUntil = uint48(validationData >> 160);
+        if (validUntil == 0) {
+            validUntil = type(uint48).max;
+        }
+        uint48 validAfter = uint48(validationData >> (48 + 160));
+        outOfTimeRange =
+            block.timestamp > validUntil ||
+            block.timestamp < validAfter;
+    }
+
+    // ------------------------------------------------------------------------
+    // Helpers
+    // ------------------------------------------------------------------------
+
+    function _copyUserOpToMemory(
+        PackedUserOperation calldata userOp,
+        MemoryUserOp memory mUserOp
+    ) internal pure {
+        mUserOp.sender = userOp.sender;
+        mUserOp.nonce = userOp.nonce;
+        (mUserOp.verificationGasLimit, mUserOp.callGasLimit) = _unpackUints(
+            userOp.accountGasLimits
+        );
+        mUserOp.preVerificationGas = userOp.preVerificationGas;
+        (mUserOp.maxPriorityFeePerGas, mUserOp.maxFeePerGas) = _unpackUints(
+            userOp.gasFees
+        );
+        bytes calldata paymasterAndData = userOp.paymasterAndData;
+        if (paymasterAndData.length > 0) {
+            require(
+                paymasterAndData.length >= PAYMASTER_DATA_OFFSET,
+                "AA93 invalid paymasterAndData"
+            );
+            mUserOp.paymaster = address(bytes20(paymasterAndData[0:20]));
+            mUserOp.paymasterVerificationGasLimit = uint128(
+                bytes16(
+                    paymasterAndData[PAYMASTER_VALIDATION_GAS_OFFSET:PAYMASTER_POSTOP_GAS_OFFSET]
+                )
+            );
+            mUserOp.paymasterPostOpGasLimit = uint128(
+                bytes16(
+                    paymasterAndData[PAYMASTER_POSTOP_GAS_OFFSET:PAYMASTER_DATA_OFFSET]
+                )
+            );
+        } else {
+            mUserOp.paymaster = address(0);
+            mUserOp.paymasterVerificationGasLimit = 0;
+            mUserOp.paymasterPostOpGasLimit = 0;
+        }
+    }
+
+    function _unpackUints(
+        bytes32 packed
+    ) internal pure returns (uint256 high128, uint256 low128) {
+        return (uint128(bytes16(packed)), uint128(uint256(packed)));
+    }
+
+    function _getRequiredPrefund(
+        MemoryUserOp memory mUserOp
+    ) internal pure returns (uint256 requiredPrefund) {
+        unchecked {
+            uint256 requiredGas = mUserOp.verificationGasLimit +
+                mUserOp.callGasLimit +
+                mUserOp.paymasterVerificationGasLimit +
+                mUserOp.paymasterPostOpGasLimit +
+                mUserOp.preVerificationGas;
+            requiredPrefund = requiredGas * mUserOp.maxFeePerGas;
+        }
+    }
+
+    function _getUserOpGasPrice(
+        MemoryUserOp memory mUserOp
+    ) internal view returns (uint256) {
+        unchecked {
+            uint256 maxFeePerGas = mUserOp.maxFeePerGas;
+            uint256 maxPriorityFeePerGas = mUserOp.maxPriorityFeePerGas;
+            if (maxFeePerGas == maxPriorityFeePerGas) {
+                // Legacy mode, which doesn't use the BASEFEE opcode.
+                return maxFeePerGas;
+            }
+            uint256 gasPrice = maxPriorityFeePerGas + block.basefee;
+            return gasPrice < maxFeePerGas ? gasPrice : maxFeePerGas;
+        }
+    }
+
+    function _hashUserOp(
+        PackedUserOperation calldata userOp
+    ) internal pure returns (bytes32) {
+        return
+            keccak256(
+                abi.encode(
+                    userOp.sender,
+                    userOp.nonce,
+                    keccak256(userOp.initCode),
+                    keccak256(userOp.callData),
+                    userOp.accountGasLimits,
+                    userOp.preVerificationGas,
+                    userOp.gasFees,
+                    keccak256(userOp.paymasterAndData)
+                )
+            );
+    }
+
+    /// @dev Returns at most "maxLen" bytes of the last call's return data.
+    function _getReturnData(
+        uint256 maxLen
+    ) internal pure returns (bytes memory returnData) {
+        assembly ("memory-safe") {
+            let len := returndatasize()
+            if gt(len, maxLen) {
+                len := maxLen
+            }
+            let ptr := mload(0x40)
+            mstore(0x40, add(ptr, add(len, 0x20)))
+            mstore(ptr, len)
+            returndatacopy(add(ptr, 0x20), 0, len)
+            returnData := ptr
+        }
+    }
+}
@@ -10,7 +10,9 @@ interface IOracle {
     /// @return blockTimeMs The block time in milliseconds when the price was
     /// last updated
     /// @return blockHeight The block height when the price was last updated
-    /// @dev This function is view-only and does not modify state.
+    /// @dev This function is view-only and does not modify state. It reverts
+    /// if the pair is halted by the oracle circuit breaker or if its price is
+    /// older than the max age configured for the pair.
     function queryExchangeRate(
         string memory pair
     )
@@ -18,6 +20,21 @@ interface IOracle {
         view
         returns (uint256 price, uint64 blockTimeMs, uint64 blockHeight);
 
+    /// @notice Queries the exponentially weighted moving average (EMA) of the
+    /// exchange rate for a given pair
+    /// @param pair The asset pair to query, e.g. "ubtc:uusd".
+    /// @param halfLifeSeconds The half-life of the EMA in seconds. It must be
+    /// one of the EMA half-lives in the oracle module params.
+    /// @return price The EMA of the exchange rate for the given pair
+    /// @return blockTimeMs The block time in milliseconds of the last price
+    /// included in the EMA
+    /// @dev This function is view-only and does not modify state. It reverts
+    /// under the same conditions as queryExchangeRate.
+    function queryExchangeRateEma(
+        string memory pair,
+        uint64 halfLifeSeconds
+    ) external view returns (uint256 price, uint64 blockTimeMs);
+
     function chainLinkLatestRoundData(
         string memory pair
     )
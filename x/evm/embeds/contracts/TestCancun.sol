@@ -0,0 +1,106 @@
+// contracts/TestCancun.sol
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+/// @notice Exercises the op codes and precompiles of the Cancun hard fork.
+/// Compiled with evmVersion "cancun".
+contract TestCancun {
+    uint256 constant SLOT = 0;
+
+    /// @notice EIP-1153: Writes and reads transient storage in one call.
+    function transientRoundTrip(uint256 value) external returns (uint256 got) {
+        assembly {
+            tstore(SLOT, value)
+            got := tload(SLOT)
+        }
+    }
+
+    function setTransient(uint256 value) external {
+        assembly {
+            tstore(SLOT, value)
+        }
+    }
+
+    function getTransient() external view returns (uint256 got) {
+        assembly {
+            got := tload(SLOT)
+        }
+    }
+
+    function setTransientAndRevert(uint256 value) external {
+        assembly {
+            tstore(SLOT, value)
+        }
+        revert("reverted");
+    }
+
+    /// @notice Transient storage written in a reverted call frame is rolled
+    /// back. Returns the value of the slot after the reverted call.
+    function setTransientInRevertedCall(
+        uint256 before,
+        uint256 value
+    ) external returns (uint256 got) {
+        assembly {
+            tstore(SLOT, before)
+        }
+        try this.setTransientAndRevert(value) {} catch {}
+        assembly {
+            got := tload(SLOT)
+        }
+    }
+
+    /// @notice Transient storage is shared by the call frames of a tx.
+    function setTransientThenReadInSubcall(
+        uint256 value
+    ) external returns (uint256) {
+        assembly {
+            tstore(SLOT, value)
+        }
+        return this.getTransient();
+    }
+
+    /// @notice EIP-5656: Copies memory with MCOPY.
+    function memoryCopy(
+        bytes memory data
+    ) external pure returns (bytes memory out) {
+        out = new bytes(data.length);
+        assembly {
+            mcopy(add(out, 32), add(data, 32), mload(data))
+        }
+    }
+
+    /// @notice EIP-4844: BLOBHASH
+    function blobHash(uint256 index) external view returns (bytes32) {
+        return blobhash(index);
+    }
+
+    /// @notice EIP-7516: BLOBBASEFEE
+    function blobBaseFee() external view returns (uint256) {
+        return block.blobbasefee;
+    }
+
+    function blockHash(uint256 number) external view returns (bytes32) {
+        return blockhash(number);
+    }
+
+    /// @notice EIP-4844: Calls the point-evaluation precompile at 0x0a.
+    function pointEvaluation(
+        bytes calldata input
+    ) external view returns (bool ok, bytes memory out) {
+        (ok, out) = address(0x0a).staticcall(input);
+    }
+
+    /// @notice EIP-6780: Creates a contract and self-destructs it in the
+    /// same transaction, which deletes the contract.
+    function createAndDestroy() external returns (address created) {
+        TestSelfDestruct c = new TestSelfDestruct();
+        c.destroy(payable(msg.sender));
+        created = address(c);
+    }
+}
+
+contract TestSelfDestruct {
+    function destroy(address payable to) external {
+        selfdestruct(to);
+    }
+}
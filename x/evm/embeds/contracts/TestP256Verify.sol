@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+/// @notice Test contract that verifies secp256r1 (P-256) signatures, such as
+/// those of WebAuthn passkeys, with the RIP-7212 "P256VERIFY" precompile.
+contract TestP256Verify {
+    /// @dev Address of the RIP-7212 "P256VERIFY" precompile.
+    address constant P256VERIFY = address(0x100);
+
+    /// @notice Returns true if (r, s) is a valid signature of the message hash
+    /// by the public key (x, y).
+    function verify(
+        bytes32 hash,
+        bytes32 r,
+        bytes32 s,
+        bytes32 x,
+        bytes32 y
+    ) external view returns (bool) {
+        (bool success, bytes memory output) = P256VERIFY.staticcall(
+            abi.encodePacked(hash, r, s, x, y)
+        );
+        return success && output.length == 32 && uint256(bytes32(output)) == 1;
+    }
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+/// @notice Packed user operation of the ERC-4337 v0.7 EntryPoint.
+struct PackedUserOperation {
+    address sender;
+    uint256 nonce;
+    bytes initCode;
+    bytes callData;
+    bytes32 accountGasLimits;
+    uint256 preVerificationGas;
+    bytes32 gasFees;
+    bytes paymasterAndData;
+    bytes signature;
+}
+
+/// @notice Test ERC-4337 account owned by a single ECDSA key. The owner signs
+/// the EIP-191 personal message of the user operation hash.
+contract TestSimpleAccount {
+    address public immutable entryPoint;
+    address public immutable owner;
+
+    constructor(address _entryPoint, address _owner) {
+        entryPoint = _entryPoint;
+        owner = _owner;
+    }
+
+    receive() external payable {}
+
+    /// @notice Returns 0 if the owner signed "userOpHash" and 1 otherwise,
+    /// then pays the missing funds to the EntryPoint.
+    function validateUserOp(
+        PackedUserOperation calldata userOp,
+        bytes32 userOpHash,
+        uint256 missingAccountFunds
+    ) external returns (uint256 validationData) {
+        require(msg.sender == entryPoint, "account: not from EntryPoint");
+        bytes32 digest = keccak256(
+            abi.encodePacked("\x19Ethereum Signed Message:\n32", userOpHash)
+        );
+        validationData = _recover(digest, userOp.signature) == owner ? 0 : 1;
+        if (missingAccountFunds > 0) {
+            (bool success, ) = payable(msg.sender).call{
+                value: missingAccountFunds
+            }("");
+            (success);
+        }
+    }
+
+    /// @notice Calls "dest" with "value" and "func". Only callable by the
+    /// EntryPoint or the owner.
+    function execute(address dest, uint256 value, bytes calldata func) external {
+        require(
+            msg.sender == entryPoint || msg.sender == owner,
+            "account: not owner or EntryPoint"
+        );
+        (bool success, bytes memory result) = dest.call{value: value}(func);
+        if (!success) {
+            assembly {
+                revert(add(result, 32), mload(result))
+            }
+        }
+    }
+
+    function _recover(
+        bytes32 digest,
+        bytes calldata signature
+    ) internal pure returns (address) {
+        if (signature.length != 65) {
+            return address(0);
+        }
+        bytes32 r = bytes32(signature[0:32]);
+        bytes32 s = bytes32(signature[32:64]);
+        uint8 v = uint8(signature[64]);
+        if (v < 27) {
+            v += 27;
+        }
+        return ecrecover(digest, v, r, s);
+    }
+}
+
+/// @notice CREATE2 factory of [TestSimpleAccount], for "initCode" in user
+/// operations.
+contract TestSimpleAccountFactory {
+    address public immutable entryPoint;
+
+    constructor(address _entryPoint) {
+        entryPoint = _entryPoint;
+    }
+
+    /// @notice Deploys the account of "owner" for "salt" if it doesn't exist
+    /// and returns its address.
+    function createAccount(
+        address owner,
+        uint256 salt
+    ) external returns (address account) {
+        account = getAddress(owner, salt);
+        if (account.code.length > 0) {
+            return account;
+        }
+        return address(new TestSimpleAccount{salt: bytes32(salt)}(entryPoint, owner));
+    }
+
+    /// @notice Returns the counterfactual address of the account of "owner"
+    /// for "salt".
+    function getAddress(
+        address owner,
+        uint256 salt
+    ) public view returns (address) {
+        bytes32 initCodeHash = keccak256(
+            abi.encodePacked(
+                type(TestSimpleAccount).creationCode,
+                abi.encode(entryPoint, owner)
+            )
+        );
+        return
+            address(
+                uint160(
+                    uint256(
+                        keccak256(
+                            abi.encodePacked(
+                                bytes1(0xff),
+                                address(this),
+                                bytes32(salt),
+                                initCodeHash
+                            )
+                        )
+                    )
+                )
+            );
+    }
+}
@@ -12,6 +12,7 @@ import (
 
 	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 var (
@@ -53,6 +54,18 @@ var (
 	testDirtyStateAttack4 []byte
 	//go:embed artifacts/contracts/TestDirtyStateAttack5.sol/TestDirtyStateAttack5.json
 	testDirtyStateAttack5 []byte
+	//go:embed artifacts/contracts/TestP256Verify.sol/TestP256Verify.json
+	testP256Verify []byte
+	//go:embed artifacts/contracts/EntryPoint.sol/EntryPoint.json
+	entryPointJson []byte
+	//go:embed artifacts/contracts/EntryPoint.sol/SenderCreator.json
+	senderCreatorJson []byte
+	//go:embed artifacts/contracts/TestSimpleAccount.sol/TestSimpleAccount.json
+	testSimpleAccount []byte
+	//go:embed artifacts/contracts/TestSimpleAccount.sol/TestSimpleAccountFactory.json
+	testSimpleAccountFactory []byte
+	//go:embed artifacts/contracts/TestCancun.sol/TestCancun.json
+	testCancun []byte
 )
 
 var (
@@ -177,8 +190,116 @@ var (
 		Name:      "TestDirtyStateAttack5.sol",
 		EmbedJSON: testDirtyStateAttack5,
 	}
+
+	// SmartContract_TestP256Verify is a test contract that verifies P-256
+	// signatures with the RIP-7212 "P256VERIFY" precompile
+	SmartContract_TestP256Verify = CompiledEvmContract{
+		Name:      "TestP256Verify.sol",
+		EmbedJSON: testP256Verify,
+	}
+
+	// SmartContract_EntryPoint: ERC-4337 EntryPoint implementing the v0.7
+	// interface, predeployed at [ADDR_ENTRYPOINT].
+	SmartContract_EntryPoint = CompiledEvmContract{
+		Name:      "EntryPoint.sol",
+		EmbedJSON: entryPointJson,
+	}
+	// SmartContract_SenderCreator: Helper of the EntryPoint that calls account
+	// factories, predeployed at [ADDR_SENDER_CREATOR].
+	SmartContract_SenderCreator = CompiledEvmContract{
+		Name:      "SenderCreator.sol",
+		EmbedJSON: senderCreatorJson,
+	}
+	// SmartContract_TestSimpleAccount is a test ERC-4337 account owned by a
+	// single ECDSA key
+	SmartContract_TestSimpleAccount = CompiledEvmContract{
+		Name:      "TestSimpleAccount.sol",
+		EmbedJSON: testSimpleAccount,
+	}
+	// SmartContract_TestSimpleAccountFactory is a CREATE2 factory of
+	// [SmartContract_TestSimpleAccount] used for the "initCode" of user
+	// operations
+	SmartContract_TestSimpleAccountFactory = CompiledEvmContract{
+		Name:      "TestSimpleAccountFactory.sol",
+		EmbedJSON: testSimpleAccountFactory,
+	}
+
+	// SmartContract_TestCancun is a test contract compiled for the Cancun
+	// hard fork that uses transient storage, MCOPY, and the blob op codes
+	SmartContract_TestCancun = CompiledEvmContract{
+		Name:      "TestCancun.sol",
+		EmbedJSON: testCancun,
+	}
+)
+
+var (
+	// ADDR_ENTRYPOINT is the address of [SmartContract_EntryPoint]. It is not
+	// the canonical v0.7 address on Ethereum, 0x0000000071727De22E5E9d8BAf0edAc6f37da032,
+	// because the bytecode differs from the eth-infinitism deployment. That
+	// address is left free for the audited EntryPoint, which can be deployed
+	// with CREATE2 through [ADDR_DETERMINISTIC_DEPLOYMENT_PROXY].
+	ADDR_ENTRYPOINT = gethcommon.HexToAddress("0x0000000000000000000000000000000000004337")
+	// ADDR_SENDER_CREATOR is the address of [SmartContract_SenderCreator],
+	// the helper that [SmartContract_EntryPoint] calls to deploy accounts.
+	ADDR_SENDER_CREATOR = gethcommon.HexToAddress("0x0000000000000000000000000000000000004338")
+	// ADDR_DETERMINISTIC_DEPLOYMENT_PROXY is the address of the CREATE2
+	// factory that Foundry and hardhat-deploy use for deterministic
+	// deployments. On other chains it is deployed with a keyless transaction
+	// that has no EIP-155 chain ID.
+	ADDR_DETERMINISTIC_DEPLOYMENT_PROXY = gethcommon.HexToAddress("0x4e59b44847b379578588920cA78FbF26c0B4956C")
+
+	// DeterministicDeploymentProxyCode is the runtime bytecode of Arachnid's
+	// deterministic deployment proxy, byte for byte the code at
+	// [ADDR_DETERMINISTIC_DEPLOYMENT_PROXY] on Ethereum. The calldata is a
+	// 32-byte salt followed by init code, and the proxy returns the 20-byte
+	// address of the contract it creates with CREATE2.
+	DeterministicDeploymentProxyCode = hexutil.MustDecode("0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f58015156039578182fd5b8082525050506014600cf3")
 )
 
+// Predeploy is a contract whose runtime bytecode is set at a fixed address
+// when the EVM module is initialized from genesis.
+type Predeploy struct {
+	// Name identifies the predeploy in the "predeploys" of the EVM genesis.
+	Name    string
+	Address gethcommon.Address
+	// Code is the runtime bytecode set at the address.
+	Code []byte
+}
+
+// Predeploys returns the contracts that can exist at fixed addresses from
+// genesis.
+func Predeploys() []Predeploy {
+	return []Predeploy{
+		{Name: "EntryPoint", Address: ADDR_ENTRYPOINT, Code: SmartContract_EntryPoint.DeployedBytecode},
+		{Name: "SenderCreator", Address: ADDR_SENDER_CREATOR, Code: SmartContract_SenderCreator.DeployedBytecode},
+		{
+			Name:    "DeterministicDeploymentProxy",
+			Address: ADDR_DETERMINISTIC_DEPLOYMENT_PROXY,
+			Code:    DeterministicDeploymentProxyCode,
+		},
+	}
+}
+
+// PredeployNames returns the names of all [Predeploys].
+func PredeployNames() []string {
+	predeploys := Predeploys()
+	names := make([]string, len(predeploys))
+	for i, predeploy := range predeploys {
+		names[i] = predeploy.Name
+	}
+	return names
+}
+
+// PredeployByName returns the predeploy with the given name.
+func PredeployByName(name string) (predeploy Predeploy, found bool) {
+	for _, predeploy := range Predeploys() {
+		if predeploy.Name == name {
+			return predeploy, true
+		}
+	}
+	return predeploy, false
+}
+
 func init() {
 	SmartContract_ERC20MinterWithMetadataUpdates.MustLoad()
 	SmartContract_FunToken.MustLoad()
@@ -198,6 +319,12 @@ func init() {
 	SmartContract_TestPrecompileSendToBankThenERC20Transfer.MustLoad()
 	SmartContract_TestDirtyStateAttack4.MustLoad()
 	SmartContract_TestDirtyStateAttack5.MustLoad()
+	SmartContract_TestP256Verify.MustLoad()
+	SmartContract_EntryPoint.MustLoad()
+	SmartContract_SenderCreator.MustLoad()
+	SmartContract_TestSimpleAccount.MustLoad()
+	SmartContract_TestSimpleAccountFactory.MustLoad()
+	SmartContract_TestCancun.MustLoad()
 }
 
 type CompiledEvmContract struct {
@@ -207,6 +334,9 @@ type CompiledEvmContract struct {
 	// filled in post-load
 	ABI      *gethabi.ABI `json:"abi"`
 	Bytecode []byte       `json:"bytecode"`
+	// DeployedBytecode is the runtime bytecode, which is the code stored at
+	// the address of the contract after its deployment.
+	DeployedBytecode []byte `json:"deployedBytecode"`
 }
 
 func (sc *CompiledEvmContract) MustLoad() {
@@ -233,4 +363,12 @@ func (sc *CompiledEvmContract) MustLoad() {
 	}
 	sc.Bytecode = gethcommon.FromHex(bytecodeStr)
 	sc.ABI = abi
+
+	if rawDeployedBytecode, ok := rawJsonBz["deployedBytecode"]; ok {
+		var deployedBytecodeStr string
+		if err = json.Unmarshal(rawDeployedBytecode, &deployedBytecodeStr); err != nil {
+			panic(err)
+		}
+		sc.DeployedBytecode = gethcommon.FromHex(deployedBytecodeStr)
+	}
 }
@@ -23,5 +23,11 @@ func TestLoadContracts(t *testing.T) {
 		embeds.SmartContract_TestERC20TransferWithFee.MustLoad()
 		embeds.SmartContract_TestRandom.MustLoad()
 		embeds.SmartContract_TestBytes32Metadata.MustLoad()
+		embeds.SmartContract_TestP256Verify.MustLoad()
+		embeds.SmartContract_EntryPoint.MustLoad()
+		embeds.SmartContract_SenderCreator.MustLoad()
+		embeds.SmartContract_TestSimpleAccount.MustLoad()
+		embeds.SmartContract_TestSimpleAccountFactory.MustLoad()
+		embeds.SmartContract_TestCancun.MustLoad()
 	})
 }
@@ -7,5 +7,15 @@ module.exports = {
       { version: "0.4.19" },
       { version: "0.8.24" },
     ],
+    overrides: {
+      // Pinned so that the bytecode of the EntryPoint predeploy is reproducible.
+      "contracts/EntryPoint.sol": {
+        version: "0.8.21",
+        settings: {
+          optimizer: { enabled: true, runs: 1000000 },
+          evmVersion: "paris",
+        },
+      },
+    },
   },
 };
@@ -48,3 +48,22 @@ func NewFunToken(
 		IsMadeFromCoin: isMadeFromCoin,
 	}
 }
+
+// WithDisabled returns a copy of the [FunToken] with the given
+// [FunToken.Disabled] value.
+func (fun FunToken) WithDisabled(disabled bool) FunToken {
+	fun.Disabled = disabled
+	return fun
+}
+
+// AssertEnabled returns an error if the conversions between the Bank Coin and
+// the ERC20 of the [FunToken] are paused.
+func (fun FunToken) AssertEnabled() error {
+	if fun.Disabled {
+		return fmt.Errorf(
+			"FunToken mapping for ERC20 \"%s\" and bank denom \"%s\" is disabled",
+			fun.Erc20Addr.Hex(), fun.BankDenom,
+		)
+	}
+	return nil
+}
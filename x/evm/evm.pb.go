@@ -38,6 +38,9 @@ type FunToken struct {
 	// the ERC-20 contract gets deployed by the module account. False if the
 	// mapping was created from an externally owned ERC-20 contract.
 	IsMadeFromCoin bool `protobuf:"varint,3,opt,name=is_made_from_coin,json=isMadeFromCoin,proto3" json:"is_made_from_coin,omitempty"`
+	// True if conversions between the Bank Coin and the ERC-20 are paused. Set
+	// with "MsgSetFunTokenDisabled".
+	Disabled bool `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
 }
 
 func (m *FunToken) Reset()         { *m = FunToken{} }
@@ -87,6 +90,13 @@ func (m *FunToken) GetIsMadeFromCoin() bool {
 	return false
 }
 
+func (m *FunToken) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// extra_eips defines the additional EIPs for the vm.Config
@@ -96,6 +106,20 @@ type Params struct {
 	// Fee deducted and burned when calling "CreateFunToken" in units of
 	// "evm_denom".
 	CreateFuntokenFee cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=create_funtoken_fee,json=createFuntokenFee,proto3,customtype=cosmossdk.io/math.Int" json:"create_funtoken_fee"`
+	// cancun_time is the block time in Unix seconds from which the Cancun hard
+	// fork is active. Zero keeps Cancun disabled. It cannot be changed once the
+	// fork is active.
+	CancunTime uint64 `protobuf:"varint,10,opt,name=cancun_time,json=cancunTime,proto3" json:"cancun_time,omitempty"`
+	// allowed_unprotected_tx_hashes is the list of hex-encoded hashes of signed
+	// transactions that may execute without EIP-155 replay protection. It is
+	// meant for keyless deployments (Nick's method) of contracts that have the
+	// same address on every EVM chain. Other unprotected transactions are
+	// rejected.
+	AllowedUnprotectedTxHashes []string `protobuf:"bytes,11,rep,name=allowed_unprotected_tx_hashes,json=allowedUnprotectedTxHashes,proto3" json:"allowed_unprotected_tx_hashes,omitempty"`
+	// max_tx_gas is the most gas that a single Ethereum tx, "eth_call", or
+	// "eth_estimateGas" may use. Zero means that only the block gas limit
+	// applies.
+	MaxTxGas uint64 `protobuf:"varint,12,opt,name=max_tx_gas,json=maxTxGas,proto3" json:"max_tx_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -145,6 +169,27 @@ func (m *Params) GetEVMChannels() []string {
 	return nil
 }
 
+func (m *Params) GetCancunTime() uint64 {
+	if m != nil {
+		return m.CancunTime
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedUnprotectedTxHashes() []string {
+	if m != nil {
+		return m.AllowedUnprotectedTxHashes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTxGas() uint64 {
+	if m != nil {
+		return m.MaxTxGas
+	}
+	return 0
+}
+
 // State represents a single Storage key value pair item.
 type State struct {
 	// key is the stored key
@@ -553,67 +598,73 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/evm.proto", fileDescriptor_98abbdadb327b7d0) }
 
 var fileDescriptor_98abbdadb327b7d0 = []byte{
-	// 954 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x1b, 0x27, 0x71, 0x26, 0xe9, 0xd6, 0x9d, 0x16, 0x64, 0x21, 0x6d, 0x1c, 0xf9, 0x80,
-	0x82, 0xb4, 0x4a, 0xd8, 0xae, 0xca, 0xa1, 0x5c, 0x68, 0xb2, 0xad, 0x68, 0xa0, 0x4b, 0x35, 0x5b,
-	0x38, 0x70, 0xb1, 0x26, 0xf6, 0x6b, 0x62, 0xc5, 0xf6, 0x44, 0x33, 0xe3, 0x28, 0xf9, 0x07, 0x1c,
-	0xf9, 0x09, 0x7b, 0xe7, 0x8f, 0xac, 0x38, 0xed, 0x11, 0x71, 0xb0, 0x50, 0x7b, 0x41, 0x39, 0x72,
-	0x42, 0x9c, 0xd0, 0x8c, 0xdd, 0x4d, 0x0a, 0x12, 0x9c, 0xfc, 0xbe, 0xef, 0xcd, 0x7b, 0xf3, 0xe6,
-	0xfb, 0xc6, 0x36, 0x3a, 0x02, 0x39, 0xed, 0xc3, 0x22, 0xe9, 0x2f, 0x9e, 0xab, 0x47, 0x6f, 0xce,
-	0x99, 0x64, 0x18, 0x81, 0x9c, 0xf6, 0x14, 0x5c, 0x3c, 0xff, 0xe8, 0x68, 0xc2, 0x26, 0x4c, 0xd3,
-	0x7d, 0x15, 0x15, 0x2b, 0xbc, 0x9f, 0x0c, 0x64, 0x5d, 0x64, 0xe9, 0x0d, 0x9b, 0x41, 0x8a, 0xbf,
-	0x45, 0x08, 0x78, 0x70, 0xfc, 0xa9, 0x4f, 0xc3, 0x90, 0x3b, 0x46, 0xc7, 0xe8, 0x36, 0x06, 0x9f,
-	0xbd, 0xcd, 0xdd, 0x9d, 0x5f, 0x73, 0xb7, 0x37, 0x89, 0xe4, 0x34, 0x1b, 0xf7, 0x02, 0x96, 0xf4,
-	0x5f, 0x45, 0xe3, 0x88, 0x67, 0xc3, 0x29, 0x8d, 0xd2, 0x7e, 0xaa, 0xe3, 0xfe, 0xe2, 0xb8, 0xaf,
-	0xf6, 0x3a, 0xbf, 0xbc, 0x3e, 0x39, 0x39, 0x0b, 0x43, 0x4e, 0x1a, 0xba, 0x93, 0x0a, 0xf1, 0x53,
-	0x84, 0xc6, 0x34, 0x9d, 0xf9, 0x21, 0xa4, 0x2c, 0x71, 0x76, 0x55, 0x5b, 0xd2, 0x50, 0xcc, 0x4b,
-	0x45, 0xe0, 0x4f, 0xd0, 0x41, 0x24, 0xfc, 0x84, 0x86, 0xe0, 0xdf, 0x72, 0x96, 0xf8, 0x01, 0x8b,
-	0x52, 0xa7, 0xd2, 0x31, 0xba, 0x16, 0x79, 0x12, 0x89, 0x2b, 0x1a, 0xc2, 0x05, 0x67, 0xc9, 0x90,
-	0x45, 0xa9, 0xf7, 0xa7, 0x81, 0x6a, 0xd7, 0x94, 0xd3, 0x44, 0xe0, 0x33, 0x84, 0x60, 0x29, 0x39,
-	0xf5, 0x21, 0x9a, 0x0b, 0xc7, 0xec, 0x54, 0xba, 0x95, 0x81, 0x77, 0x97, 0xbb, 0x8d, 0x73, 0xc5,
-	0x9e, 0x5f, 0x5e, 0x8b, 0x3f, 0x72, 0xf7, 0x60, 0x45, 0x93, 0xf8, 0xd4, 0xdb, 0x2c, 0xf4, 0x48,
-	0x43, 0x83, 0xf3, 0x68, 0x2e, 0xf0, 0x31, 0x6a, 0xc1, 0x22, 0xf1, 0x83, 0x29, 0x4d, 0x53, 0x88,
-	0x85, 0x63, 0x75, 0x2a, 0xdd, 0xc6, 0x60, 0xff, 0x2e, 0x77, 0x9b, 0xe7, 0xdf, 0x5d, 0x0d, 0x4b,
-	0x9a, 0x34, 0x61, 0x91, 0x3c, 0x00, 0x7c, 0x85, 0x0e, 0x03, 0x0e, 0x54, 0x82, 0x7f, 0x9b, 0xa5,
-	0x52, 0xa9, 0xe6, 0xdf, 0x02, 0x38, 0x0d, 0xad, 0xd5, 0xd3, 0x52, 0xab, 0x0f, 0x02, 0x26, 0x12,
-	0x26, 0x44, 0x38, 0xeb, 0x45, 0xac, 0x9f, 0x50, 0x39, 0xed, 0x5d, 0xa6, 0x92, 0x1c, 0x14, 0x95,
-	0x17, 0x65, 0xe1, 0x05, 0xc0, 0xa9, 0xf9, 0xfb, 0x1b, 0xd7, 0x18, 0x99, 0x96, 0x61, 0xef, 0x8e,
-	0x4c, 0x6b, 0xd7, 0xae, 0x8c, 0x4c, 0xab, 0x62, 0x9b, 0x23, 0xd3, 0xaa, 0xda, 0xb5, 0x91, 0x69,
-	0xd5, 0xec, 0xfa, 0xc8, 0xb4, 0xea, 0xb6, 0xe5, 0xf5, 0x51, 0xf5, 0xb5, 0xa4, 0x12, 0xb0, 0x8d,
-	0x2a, 0x33, 0x58, 0x15, 0xee, 0x10, 0x15, 0xe2, 0x23, 0x54, 0x5d, 0xd0, 0x38, 0x83, 0x52, 0xda,
-	0x02, 0x78, 0x3f, 0xef, 0xa2, 0xca, 0xd7, 0x6c, 0x82, 0x1d, 0x54, 0x57, 0x76, 0x82, 0x10, 0x65,
-	0xcd, 0x03, 0xc4, 0x1f, 0xa2, 0x9a, 0x64, 0xf3, 0x28, 0x10, 0xce, 0xae, 0x3a, 0x39, 0x29, 0x11,
-	0xc6, 0xc8, 0x0c, 0xa9, 0xa4, 0xda, 0x83, 0x16, 0xd1, 0xb1, 0xd2, 0x6a, 0x1c, 0xb3, 0x60, 0xe6,
-	0xa7, 0x59, 0x32, 0x06, 0xee, 0x98, 0x1d, 0xa3, 0x6b, 0x0e, 0xf6, 0xd7, 0xb9, 0xdb, 0xd4, 0xfc,
-	0x2b, 0x4d, 0x93, 0x6d, 0x80, 0x9f, 0xa1, 0xba, 0x5c, 0xfa, 0x53, 0x2a, 0xa6, 0x4e, 0x55, 0xeb,
-	0x73, 0xb8, 0xce, 0xdd, 0x7d, 0xc9, 0x69, 0x2a, 0x68, 0x20, 0x23, 0x96, 0x7e, 0x49, 0xc5, 0x94,
-	0xd4, 0xe4, 0x52, 0x3d, 0x71, 0x1f, 0x59, 0x72, 0xe9, 0x47, 0x69, 0x08, 0x4b, 0xa7, 0xa6, 0xbb,
-	0x1f, 0xad, 0x73, 0xd7, 0xde, 0x5a, 0x7e, 0xa9, 0x72, 0xa4, 0x2e, 0x97, 0x3a, 0xc0, 0xcf, 0x10,
-	0x2a, 0x46, 0xd2, 0x3b, 0xd4, 0xf5, 0x0e, 0x7b, 0xeb, 0xdc, 0x6d, 0x68, 0x56, 0xf7, 0xde, 0x84,
-	0xd8, 0x43, 0xd5, 0xa2, 0xb7, 0xa5, 0x7b, 0xb7, 0xd6, 0xb9, 0x6b, 0xc5, 0x6c, 0x52, 0xf4, 0x2c,
-	0x52, 0x4a, 0x2a, 0x0e, 0x09, 0x5b, 0x40, 0xa8, 0x0d, 0xb5, 0xc8, 0x03, 0xf4, 0x28, 0x6a, 0x9e,
-	0x05, 0x01, 0x08, 0x71, 0x93, 0xcd, 0x63, 0xf8, 0x0f, 0x4d, 0x8f, 0x51, 0x4b, 0x48, 0xc6, 0xe9,
-	0x04, 0xfc, 0x19, 0xac, 0x4a, 0x65, 0x0b, 0x9d, 0x4a, 0xfe, 0x2b, 0x58, 0x09, 0xb2, 0x0d, 0x4e,
-	0xcd, 0x1f, 0xde, 0xb8, 0x3b, 0xde, 0x10, 0xb5, 0x6e, 0x38, 0x0d, 0x80, 0x0f, 0x59, 0x7a, 0x1b,
-	0x4d, 0xf0, 0x0b, 0xb4, 0xc7, 0xd2, 0x78, 0xe5, 0x4b, 0x36, 0xf7, 0x03, 0x1a, 0xc7, 0x7a, 0x27,
-	0xab, 0x68, 0xa5, 0x12, 0x37, 0x6c, 0x3e, 0xa4, 0x71, 0x4c, 0xb6, 0x81, 0xf7, 0x57, 0x05, 0x35,
-	0x75, 0x97, 0xb2, 0x89, 0xb2, 0x58, 0x37, 0x2d, 0xe7, 0x2c, 0x91, 0x3a, 0x80, 0x8c, 0x12, 0x60,
-	0x99, 0x2c, 0x2f, 0xcd, 0x03, 0x54, 0x15, 0x1c, 0x60, 0x09, 0x81, 0xb6, 0xdf, 0x24, 0x25, 0xc2,
-	0x27, 0x68, 0x2f, 0x8c, 0x04, 0x1d, 0xc7, 0xe0, 0x0b, 0x49, 0x83, 0x99, 0xb6, 0xd4, 0x1a, 0xd8,
-	0xeb, 0xdc, 0x6d, 0x95, 0x89, 0xd7, 0x8a, 0x27, 0x8f, 0x10, 0xfe, 0x1c, 0xed, 0x6f, 0xca, 0xf4,
-	0x91, 0xb5, 0xb9, 0xd6, 0x00, 0xaf, 0x73, 0xf7, 0xc9, 0xfb, 0xa5, 0x3a, 0x43, 0xfe, 0x81, 0xd5,
-	0xc5, 0x0e, 0x61, 0x9c, 0x4d, 0xb4, 0x67, 0x16, 0x29, 0x80, 0x62, 0xe3, 0x28, 0x89, 0xa4, 0xf6,
-	0xa8, 0x4a, 0x0a, 0xa0, 0xe6, 0x83, 0x54, 0xef, 0x93, 0x40, 0xc2, 0xf8, 0xca, 0x69, 0x6e, 0xe6,
-	0x2b, 0x12, 0x57, 0x9a, 0x27, 0x8f, 0x10, 0x1e, 0x20, 0x5c, 0x96, 0x71, 0x90, 0x19, 0x4f, 0x7d,
-	0x7d, 0xf3, 0x5b, 0xba, 0x56, 0xdf, 0xbf, 0x22, 0x4b, 0x74, 0xf2, 0x25, 0x95, 0x94, 0xfc, 0x8b,
-	0xc1, 0xdf, 0xa0, 0xbd, 0x42, 0x56, 0x3f, 0xd0, 0xaa, 0x3b, 0x7b, 0x1d, 0xa3, 0xdb, 0x3c, 0x76,
-	0x7a, 0x9b, 0xaf, 0x6f, 0x6f, 0xdb, 0xda, 0x62, 0x28, 0xb9, 0xc5, 0x90, 0x47, 0x68, 0x64, 0x5a,
-	0xa6, 0x5d, 0x2d, 0xde, 0xfb, 0x91, 0x69, 0x21, 0xbb, 0xf9, 0x5e, 0x99, 0xf2, 0x70, 0xe4, 0xf0,
-	0x01, 0x6f, 0x4d, 0x3d, 0xf8, 0xe2, 0xed, 0x5d, 0xdb, 0x78, 0x77, 0xd7, 0x36, 0x7e, 0xbb, 0x6b,
-	0x1b, 0x3f, 0xde, 0xb7, 0x77, 0xde, 0xdd, 0xb7, 0x77, 0x7e, 0xb9, 0x6f, 0xef, 0x7c, 0xff, 0xf1,
-	0xff, 0x7e, 0xbc, 0x97, 0xea, 0xaf, 0x31, 0xae, 0xe9, 0x9f, 0xc2, 0x8b, 0xbf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x33, 0x26, 0x36, 0x60, 0x4e, 0x06, 0x00, 0x00,
+	// 1042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x6e, 0x1a, 0x27, 0x71, 0x26, 0xe9, 0x36, 0x3b, 0x5b, 0x90, 0x55, 0xd1, 0x38, 0xca, 0x01,
+	0x05, 0x69, 0x95, 0xb0, 0x5d, 0x95, 0x43, 0xb9, 0xd0, 0x64, 0x5b, 0x68, 0xa0, 0x4b, 0x35, 0x9b,
+	0xe5, 0xc0, 0xc5, 0x9a, 0xd8, 0xaf, 0x89, 0x15, 0x7b, 0x26, 0xf2, 0x8c, 0x83, 0xf3, 0x1f, 0x70,
+	0xe4, 0xcc, 0x69, 0xff, 0x1b, 0x56, 0x9c, 0xf6, 0x88, 0x38, 0x58, 0x28, 0xbd, 0xa0, 0x1c, 0x39,
+	0x72, 0x42, 0x33, 0x76, 0x9b, 0x14, 0x04, 0x9c, 0xf2, 0xbe, 0xef, 0xfd, 0x98, 0x37, 0xdf, 0x7b,
+	0xce, 0xa0, 0x03, 0x90, 0xd3, 0x1e, 0x2c, 0xc2, 0xde, 0xe2, 0x99, 0xfa, 0xe9, 0xce, 0x23, 0x2e,
+	0x39, 0x46, 0x20, 0xa7, 0x5d, 0x05, 0x17, 0xcf, 0x0e, 0x0f, 0x26, 0x7c, 0xc2, 0x35, 0xdd, 0x53,
+	0x56, 0x16, 0xd1, 0xfe, 0xa9, 0x80, 0xcc, 0x8b, 0x98, 0x8d, 0xf8, 0x0c, 0x18, 0x7e, 0x8d, 0x10,
+	0x44, 0xee, 0xf1, 0xc7, 0x0e, 0xf5, 0xbc, 0xc8, 0x2a, 0xb4, 0x0a, 0x9d, 0x6a, 0xff, 0x93, 0xb7,
+	0xa9, 0xbd, 0xf3, 0x6b, 0x6a, 0x77, 0x27, 0xbe, 0x9c, 0xc6, 0xe3, 0xae, 0xcb, 0xc3, 0xde, 0x4b,
+	0x7f, 0xec, 0x47, 0xf1, 0x60, 0x4a, 0x7d, 0xd6, 0x63, 0xda, 0xee, 0x2d, 0x8e, 0x7b, 0xea, 0xac,
+	0xf3, 0xcb, 0xeb, 0x93, 0x93, 0x33, 0xcf, 0x8b, 0x48, 0x55, 0x57, 0x52, 0x26, 0x3e, 0x42, 0x68,
+	0x4c, 0xd9, 0xcc, 0xf1, 0x80, 0xf1, 0xd0, 0xda, 0x55, 0x65, 0x49, 0x55, 0x31, 0x2f, 0x14, 0x81,
+	0x3f, 0x42, 0x8f, 0x7d, 0xe1, 0x84, 0xd4, 0x03, 0xe7, 0x26, 0xe2, 0xa1, 0xe3, 0x72, 0x9f, 0x59,
+	0xc5, 0x56, 0xa1, 0x63, 0x92, 0x47, 0xbe, 0xb8, 0xa2, 0x1e, 0x5c, 0x44, 0x3c, 0x1c, 0x70, 0x9f,
+	0xe1, 0x43, 0x64, 0x7a, 0xbe, 0xa0, 0xe3, 0x00, 0x3c, 0xcb, 0xd0, 0x11, 0xf7, 0xb8, 0xfd, 0x63,
+	0x11, 0x95, 0xaf, 0x69, 0x44, 0x43, 0x81, 0xcf, 0x10, 0x82, 0x44, 0x46, 0xd4, 0x01, 0x7f, 0x2e,
+	0x2c, 0xa3, 0x55, 0xec, 0x14, 0xfb, 0xed, 0x55, 0x6a, 0x57, 0xcf, 0x15, 0x7b, 0x7e, 0x79, 0x2d,
+	0xfe, 0x48, 0xed, 0xc7, 0x4b, 0x1a, 0x06, 0xa7, 0xed, 0x4d, 0x60, 0x9b, 0x54, 0x35, 0x38, 0xf7,
+	0xe7, 0x02, 0x1f, 0xa3, 0x3a, 0x2c, 0x42, 0xc7, 0x9d, 0x52, 0xc6, 0x20, 0x10, 0x96, 0xd9, 0x2a,
+	0x76, 0xaa, 0xfd, 0xfd, 0x55, 0x6a, 0xd7, 0xce, 0xbf, 0xb9, 0x1a, 0xe4, 0x34, 0xa9, 0xc1, 0x22,
+	0xbc, 0x03, 0xf8, 0x0a, 0x3d, 0x71, 0x23, 0xa0, 0x12, 0x9c, 0x9b, 0x98, 0x49, 0xa5, 0xa8, 0x73,
+	0x03, 0x60, 0x55, 0xb5, 0x8e, 0x47, 0xb9, 0x8e, 0xef, 0xb9, 0x5c, 0x84, 0x5c, 0x08, 0x6f, 0xd6,
+	0xf5, 0x79, 0x2f, 0xa4, 0x72, 0xda, 0xbd, 0x64, 0x92, 0x3c, 0xce, 0x32, 0x2f, 0xf2, 0xc4, 0x0b,
+	0x00, 0x6c, 0xa3, 0x9a, 0x4b, 0x99, 0x1b, 0x33, 0x47, 0xfa, 0x21, 0x58, 0xa8, 0x55, 0xe8, 0x18,
+	0x04, 0x65, 0xd4, 0xc8, 0x0f, 0x01, 0x53, 0x74, 0x44, 0x83, 0x80, 0x7f, 0x07, 0x9e, 0x13, 0x33,
+	0x35, 0x4e, 0x70, 0x25, 0x78, 0x8e, 0x4c, 0x9c, 0x29, 0x15, 0x53, 0x10, 0x56, 0x4d, 0x37, 0xdd,
+	0x5c, 0xa5, 0xf6, 0xe1, 0x59, 0x16, 0xf8, 0x7a, 0x13, 0x37, 0x4a, 0xbe, 0xd0, 0x51, 0xe4, 0x90,
+	0xfe, 0xab, 0x0f, 0x7f, 0x80, 0x50, 0x48, 0x13, 0x55, 0x72, 0x42, 0x85, 0x55, 0xd7, 0x2d, 0x98,
+	0x21, 0x4d, 0x46, 0xc9, 0xe7, 0x54, 0x9c, 0x1a, 0xbf, 0xbf, 0xb1, 0x0b, 0x43, 0xc3, 0x2c, 0x34,
+	0x76, 0x87, 0x86, 0xb9, 0xdb, 0x28, 0x0e, 0x0d, 0xb3, 0xd8, 0x30, 0x86, 0x86, 0x59, 0x6a, 0x94,
+	0x87, 0x86, 0x59, 0x6e, 0x54, 0x86, 0x86, 0x59, 0x69, 0x98, 0xed, 0x1e, 0x2a, 0xbd, 0x92, 0x54,
+	0x02, 0x6e, 0xa0, 0xe2, 0x0c, 0x96, 0xd9, 0x6e, 0x11, 0x65, 0xe2, 0x03, 0x54, 0x5a, 0xd0, 0x20,
+	0x86, 0x7c, 0x31, 0x32, 0xd0, 0xfe, 0x79, 0x17, 0x15, 0xbf, 0xe2, 0x13, 0x6c, 0xa1, 0x8a, 0x5a,
+	0x46, 0x10, 0x22, 0xcf, 0xb9, 0x83, 0xf8, 0x7d, 0x54, 0x96, 0x7c, 0xee, 0xbb, 0xc2, 0xda, 0x55,
+	0xd7, 0x24, 0x39, 0xc2, 0x18, 0x19, 0x1e, 0x95, 0x54, 0x6f, 0x50, 0x9d, 0x68, 0x5b, 0x4d, 0x73,
+	0x1c, 0x70, 0x77, 0xe6, 0xb0, 0x38, 0x1c, 0x43, 0xa4, 0x77, 0xc7, 0xe8, 0xef, 0xaf, 0x53, 0xbb,
+	0xa6, 0xf9, 0x97, 0x9a, 0x26, 0xdb, 0x00, 0x3f, 0x45, 0x95, 0x5c, 0x49, 0xab, 0xa4, 0x27, 0xf8,
+	0x64, 0x9d, 0xda, 0xfb, 0x32, 0xa2, 0x4c, 0x50, 0x57, 0xfa, 0x9c, 0x29, 0x89, 0x48, 0x59, 0x6a,
+	0xa9, 0x70, 0x0f, 0x99, 0x32, 0x71, 0x7c, 0xe6, 0x41, 0x62, 0x95, 0x75, 0xf5, 0x83, 0x75, 0x6a,
+	0x37, 0xb6, 0xc2, 0x2f, 0x95, 0x8f, 0x54, 0x64, 0xa2, 0x0d, 0xfc, 0x14, 0xa1, 0xac, 0x25, 0x7d,
+	0x42, 0x45, 0x9f, 0xb0, 0xb7, 0x4e, 0xed, 0xaa, 0x66, 0x75, 0xed, 0x8d, 0x89, 0xdb, 0xa8, 0x94,
+	0xd5, 0x36, 0x75, 0xed, 0xfa, 0x3a, 0xb5, 0xcd, 0x80, 0x4f, 0xb2, 0x9a, 0x99, 0x4b, 0x49, 0x15,
+	0x41, 0xc8, 0x17, 0xe0, 0xe9, 0x95, 0x33, 0xc9, 0x1d, 0x6c, 0x53, 0x54, 0x3b, 0x73, 0x5d, 0x10,
+	0x62, 0x14, 0xcf, 0x03, 0xf8, 0x0f, 0x4d, 0x8f, 0x51, 0x5d, 0x48, 0x1e, 0xd1, 0x09, 0x38, 0x33,
+	0x58, 0xe6, 0xca, 0x66, 0x3a, 0xe5, 0xfc, 0x97, 0xb0, 0x14, 0x64, 0x1b, 0x9c, 0x1a, 0xdf, 0xbf,
+	0xb1, 0x77, 0xda, 0x03, 0x54, 0x1f, 0x45, 0xd4, 0x85, 0x68, 0xc0, 0xd9, 0x8d, 0x3f, 0xc1, 0xcf,
+	0xd1, 0x1e, 0x67, 0xc1, 0xd2, 0x91, 0x7c, 0xee, 0xb8, 0x34, 0x08, 0xf4, 0x49, 0x66, 0x56, 0x4a,
+	0x39, 0x46, 0x7c, 0x3e, 0xa0, 0x41, 0x40, 0xb6, 0x41, 0xfb, 0xcf, 0x22, 0xaa, 0xe9, 0x2a, 0x79,
+	0x11, 0x35, 0x62, 0x5d, 0x34, 0xef, 0x33, 0x47, 0xea, 0x02, 0xea, 0x93, 0xe0, 0xb1, 0xcc, 0x97,
+	0xe6, 0x0e, 0xaa, 0x8c, 0x08, 0x20, 0x01, 0x57, 0x8f, 0xdf, 0x20, 0x39, 0xc2, 0x27, 0x68, 0x2f,
+	0xff, 0xa3, 0x70, 0x84, 0xa4, 0xee, 0x4c, 0x8f, 0xd4, 0xec, 0x37, 0xd6, 0xa9, 0x5d, 0xcf, 0x1d,
+	0xaf, 0x14, 0x4f, 0x1e, 0x20, 0xfc, 0x29, 0xda, 0xdf, 0xa4, 0xe9, 0x2b, 0xeb, 0xe1, 0x9a, 0x7d,
+	0xbc, 0x4e, 0xed, 0x47, 0xf7, 0xa1, 0xda, 0x43, 0xfe, 0x86, 0xd5, 0x62, 0x7b, 0x30, 0x8e, 0x27,
+	0x7a, 0x66, 0x26, 0xc9, 0x80, 0x62, 0x03, 0x3f, 0xf4, 0xa5, 0x9e, 0x51, 0x89, 0x64, 0x40, 0xf5,
+	0x07, 0x4c, 0x9f, 0x13, 0x42, 0xc8, 0xa3, 0xa5, 0x55, 0xdb, 0xf4, 0x97, 0x39, 0xae, 0x34, 0x4f,
+	0x1e, 0x20, 0xdc, 0x47, 0x38, 0x4f, 0x8b, 0x40, 0xc6, 0x11, 0x73, 0xf4, 0xe6, 0xd7, 0x75, 0xae,
+	0xde, 0xbf, 0xcc, 0x4b, 0xb4, 0xf3, 0x05, 0x95, 0x94, 0xfc, 0x83, 0xc1, 0x5f, 0xa3, 0xbd, 0x4c,
+	0x56, 0xc7, 0xd5, 0xaa, 0x5b, 0x7b, 0xad, 0x42, 0xa7, 0x76, 0x6c, 0x75, 0x37, 0x6f, 0x47, 0x77,
+	0x7b, 0xb4, 0x59, 0x53, 0x72, 0x8b, 0x21, 0x0f, 0xd0, 0xd0, 0x30, 0x8d, 0x46, 0x29, 0xfb, 0xee,
+	0x87, 0x86, 0x89, 0x1a, 0xb5, 0x7b, 0x65, 0xf2, 0xcb, 0x91, 0x27, 0x77, 0x78, 0xab, 0xeb, 0xfe,
+	0x67, 0x6f, 0x57, 0xcd, 0xc2, 0xbb, 0x55, 0xb3, 0xf0, 0xdb, 0xaa, 0x59, 0xf8, 0xe1, 0xb6, 0xb9,
+	0xf3, 0xee, 0xb6, 0xb9, 0xf3, 0xcb, 0x6d, 0x73, 0xe7, 0xdb, 0x0f, 0xff, 0xf7, 0xe9, 0x49, 0xd4,
+	0x9b, 0x37, 0x2e, 0xeb, 0x27, 0xed, 0xf9, 0x5f, 0x03, 0x00, 0xa3, 0x72, 0x44, 0x24, 0x0c, 0x07,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -654,6 +705,20 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.CreateFuntokenFee.Equal(that1.CreateFuntokenFee) {
 		return false
 	}
+	if this.CancunTime != that1.CancunTime {
+		return false
+	}
+	if len(this.AllowedUnprotectedTxHashes) != len(that1.AllowedUnprotectedTxHashes) {
+		return false
+	}
+	for i := range this.AllowedUnprotectedTxHashes {
+		if this.AllowedUnprotectedTxHashes[i] != that1.AllowedUnprotectedTxHashes[i] {
+			return false
+		}
+	}
+	if this.MaxTxGas != that1.MaxTxGas {
+		return false
+	}
 	return true
 }
 func (m *FunToken) Marshal() (dAtA []byte, err error) {
@@ -676,6 +741,16 @@ func (m *FunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.IsMadeFromCoin {
 		i--
 		if m.IsMadeFromCoin {
@@ -726,6 +801,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxTxGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxTxGas))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.AllowedUnprotectedTxHashes) > 0 {
+		for iNdEx := len(m.AllowedUnprotectedTxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedUnprotectedTxHashes[iNdEx])
+			copy(dAtA[i:], m.AllowedUnprotectedTxHashes[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedUnprotectedTxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.CancunTime != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.CancunTime))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size := m.CreateFuntokenFee.Size()
 		i -= size
@@ -1096,6 +1190,9 @@ func (m *FunToken) Size() (n int) {
 	if m.IsMadeFromCoin {
 		n += 2
 	}
+	if m.Disabled {
+		n += 2
+	}
 	return n
 }
 
@@ -1120,6 +1217,18 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.CreateFuntokenFee.Size()
 	n += 1 + l + sovEvm(uint64(l))
+	if m.CancunTime != 0 {
+		n += 1 + sovEvm(uint64(m.CancunTime))
+	}
+	if len(m.AllowedUnprotectedTxHashes) > 0 {
+		for _, s := range m.AllowedUnprotectedTxHashes {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.MaxTxGas != 0 {
+		n += 1 + sovEvm(uint64(m.MaxTxGas))
+	}
 	return n
 }
 
@@ -1377,6 +1486,26 @@ func (m *FunToken) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsMadeFromCoin = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -1569,6 +1698,76 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancunTime", wireType)
+			}
+			m.CancunTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancunTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedUnprotectedTxHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedUnprotectedTxHashes = append(m.AllowedUnprotectedTxHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxGas", wireType)
+			}
+			m.MaxTxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
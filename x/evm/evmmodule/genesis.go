@@ -69,11 +69,14 @@ func InitGenesis(
 		}
 	}
 
+	// Set the code of the predeployed contracts, like the ERC-4337 EntryPoint
+	if err := k.DeployPredeploys(ctx, genState.Predeploys); err != nil {
+		panic(fmt.Errorf("failed to deploy predeploys: %w", err))
+	}
+
 	// Create fungible token mappings
 	for _, funToken := range genState.FuntokenMappings {
-		err := k.FunTokens.SafeInsert(
-			ctx, gethcommon.HexToAddress(funToken.Erc20Addr.String()), funToken.BankDenom, funToken.IsMadeFromCoin,
-		)
+		err := k.FunTokens.SafeInsertFunToken(ctx, funToken)
 		if err != nil {
 			panic(fmt.Errorf("failed creating funtoken: %w", err))
 		}
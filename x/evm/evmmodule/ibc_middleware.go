@@ -0,0 +1,154 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evmmodule
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware is an ICS-20 middleware that delivers the tokens of incoming
+// transfers as the ERC20 of their FunToken mapping. It applies to packets
+// received on one of the [evm.Params.EVMChannels] when either:
+//   - the receiver is an Ethereum hex address, or
+//   - the memo requests the conversion with `{"evm": {"convert": true}}`, in
+//     which case the tokens go to the Ethereum address of the receiver.
+//
+// The bank coin is credited by the wrapped transfer app first and then
+// converted with [keeper.Keeper.ConvertCoinToEvm]. If the conversion fails,
+// the middleware returns an error acknowledgement. IBC core then discards the
+// state changes of the packet, and the sender is refunded on the source chain.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper *keeper.Keeper
+}
+
+// NewIBCMiddleware wraps the ICS-20 transfer app with the FunToken conversion
+// of incoming transfers.
+func NewIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{IBCModule: app, keeper: k}
+}
+
+// ibcMemo is the part of an ICS-20 memo that is read by the [IBCMiddleware].
+type ibcMemo struct {
+	EVM *struct {
+		Convert bool `json:"convert"`
+	} `json:"evm,omitempty"`
+}
+
+// OnRecvPacket implements [porttypes.IBCModule].
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if !im.keeper.GetParams(ctx).IsEVMChannel(packet.GetDestChannel()) {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// The transfer app returns the error acknowledgement.
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	toEthAddr, isHexReceiver, ok := evmRecipient(data)
+	if !ok {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	if isHexReceiver {
+		// The transfer app only accepts Bech32 receivers.
+		data.Receiver = eth.EthAddrToNibiruAddr(toEthAddr).String()
+		packet.Data = data.GetBytes()
+	}
+
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(
+			fmt.Errorf("unable to parse transfer amount %q", data.Amount),
+		)
+	}
+	coin := sdk.NewCoin(receivedDenom(packet, data), amount)
+	if coin.Denom == evm.EVMBankDenom {
+		// The bank balance of the base denom already is the EVM balance.
+		return ack
+	}
+
+	receiver := eth.EthAddrToNibiruAddr(toEthAddr)
+	if _, err := im.keeper.ConvertCoinToEvm(
+		sdk.WrapSDKContext(ctx),
+		&evm.MsgConvertCoinToEvm{
+			Sender:    receiver.String(),
+			BankCoin:  coin,
+			ToEthAddr: eth.EIP55Addr{Address: toEthAddr},
+		},
+	); err != nil {
+		return channeltypes.NewErrorAcknowledgement(
+			fmt.Errorf("failed to convert %s to ERC20: %w", coin, err),
+		)
+	}
+	return ack
+}
+
+// evmRecipient returns the Ethereum address that receives the ERC20 tokens of
+// an incoming transfer and whether the receiver of the packet is a hex
+// address. The last return value is false if the transfer isn't converted.
+func evmRecipient(
+	data transfertypes.FungibleTokenPacketData,
+) (toEthAddr gethcommon.Address, isHexReceiver bool, ok bool) {
+	if gethcommon.IsHexAddress(data.Receiver) {
+		return gethcommon.HexToAddress(data.Receiver), true, true
+	}
+
+	var memo ibcMemo
+	if err := json.Unmarshal([]byte(data.Memo), &memo); err != nil ||
+		memo.EVM == nil || !memo.EVM.Convert {
+		return toEthAddr, false, false
+	}
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return toEthAddr, false, false
+	}
+	return eth.NibiruAddrToEthAddr(receiver), false, true
+}
+
+// receivedDenom returns the denom that the transfer app credits for an
+// incoming transfer, following the ICS-20 denom trace rules.
+func receivedDenom(
+	packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData,
+) string {
+	if transfertypes.ReceiverChainIsSource(
+		packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom,
+	) {
+		// The tokens return to this chain and lose one hop of their trace.
+		voucherPrefix := transfertypes.GetDenomPrefix(
+			packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+		unprefixedDenom := data.Denom[len(voucherPrefix):]
+		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
+		if denomTrace.Path == "" {
+			return unprefixedDenom
+		}
+		return denomTrace.IBCDenom()
+	}
+
+	sourcePrefix := transfertypes.GetDenomPrefix(
+		packet.GetDestPort(), packet.GetDestChannel(),
+	)
+	return transfertypes.ParseDenomTrace(sourcePrefix + data.Denom).IBCDenom()
+}
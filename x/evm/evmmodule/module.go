@@ -43,7 +43,7 @@ import (
 )
 
 // consensusVersion: EVM module consensus version for upgrades.
-const consensusVersion = 1
+const consensusVersion = 2
 
 var (
 	_ module.AppModule           = AppModule{}
@@ -142,9 +142,9 @@ func (am AppModule) IsOnePerModuleType() {}
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
-// RegisterInvariants interface for registering invariants. Performs a no-op
-// as the evm module doesn't expose invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
+// RegisterInvariants registers the invariants of the evm module.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // RegisterServices registers a GRPC query service to respond to the
@@ -152,6 +152,11 @@ func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	evm.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	evm.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(evm.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", evm.ModuleName, err))
+	}
 }
 
 // BeginBlock returns the begin block for the evm module.
@@ -276,6 +281,7 @@ type EvmInputs struct {
 	AccountKeeper authkeeper.AccountKeeper
 	BankKeeper    bankkeeper.Keeper
 	StakingKeeper evm.StakingKeeper
+	SudoKeeper    evm.SudoKeeper
 }
 
 type EvmOutputs struct {
@@ -292,7 +298,7 @@ func ProvideModule(in EvmInputs) EvmOutputs {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
 
-	k := keeper.NewKeeper(in.Cdc, in.Key, in.TransientKey, authority, in.AccountKeeper, in.BankKeeper.(*evmkeeper.NibiruBankKeeper), in.StakingKeeper, cast.ToString(in.AppOpts.Get("evm.tracer")))
+	k := keeper.NewKeeper(in.Cdc, in.Key, in.TransientKey, authority, in.AccountKeeper, in.BankKeeper.(*evmkeeper.NibiruBankKeeper), in.StakingKeeper, in.SudoKeeper, cast.ToString(in.AppOpts.Get("evm.tracer")))
 
 	m := NewAppModule(&k, in.AccountKeeper)
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
 )
 
 // Validate performs a basic validation of a GenesisAccount fields.
@@ -15,12 +16,13 @@ func (ga GenesisAccount) Validate() error {
 	return ga.Storage.Validate()
 }
 
-// DefaultGenesisState sets default evm genesis state with empty accounts and default params and
-// chain config values.
+// DefaultGenesisState sets default evm genesis state with empty accounts, every
+// predeploy, and default params and chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Accounts: []GenesisAccount{},
-		Params:   DefaultParams(),
+		Accounts:   []GenesisAccount{},
+		Params:     DefaultParams(),
+		Predeploys: embeds.PredeployNames(),
 	}
 }
 
@@ -38,5 +40,16 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	seenPredeploys := make(map[string]bool)
+	for _, name := range gs.Predeploys {
+		if seenPredeploys[name] {
+			return fmt.Errorf("duplicate predeploy %s", name)
+		}
+		if _, found := embeds.PredeployByName(name); !found {
+			return fmt.Errorf("unknown predeploy %s, expected one of %v", name, embeds.PredeployNames())
+		}
+		seenPredeploys[name] = true
+	}
+
 	return gs.Params.Validate()
 }
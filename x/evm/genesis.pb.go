@@ -31,6 +31,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// Fungible token mappings corresponding to ERC-20 smart contract tokens.
 	FuntokenMappings []FunToken `protobuf:"bytes,3,rep,name=funtoken_mappings,json=funtokenMappings,proto3" json:"funtoken_mappings"`
+	// Names of the contracts from "x/evm/embeds" to predeploy at their fixed
+	// addresses, like "EntryPoint" or "DeterministicDeploymentProxy". An
+	// address that already holds code from "accounts" must hold the same code.
+	Predeploys []string `protobuf:"bytes,4,rep,name=predeploys,proto3" json:"predeploys,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +91,13 @@ func (m *GenesisState) GetFuntokenMappings() []FunToken {
 	return nil
 }
 
+func (m *GenesisState) GetPredeploys() []string {
+	if m != nil {
+		return m.Predeploys
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -161,28 +172,29 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/genesis.proto", fileDescriptor_d41c81841e3983b5) }
 
 var fileDescriptor_d41c81841e3983b5 = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
+	// 352 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x63, 0x5a, 0xb5, 0xd4, 0x45, 0x40, 0xad, 0x0e, 0x51, 0x87, 0xb4, 0xea, 0x80, 0x3a,
-	0xc5, 0xb4, 0xac, 0x1d, 0xa0, 0x48, 0x74, 0x02, 0xa1, 0x94, 0x89, 0x05, 0xb9, 0xa9, 0x49, 0x22,
-	0x14, 0x3b, 0x8a, 0x9d, 0x88, 0x81, 0x43, 0x70, 0x0e, 0x2e, 0x42, 0xc7, 0x8e, 0x4c, 0x80, 0xda,
-	0x8b, 0x20, 0x3b, 0x0e, 0x84, 0xed, 0xf7, 0xf3, 0xff, 0xbd, 0xff, 0xd9, 0x0f, 0xda, 0x54, 0x86,
-	0x98, 0xe6, 0x31, 0xce, 0xc7, 0x38, 0xa0, 0x8c, 0x8a, 0x48, 0xb8, 0x49, 0xca, 0x25, 0x47, 0x90,
-	0xca, 0xd0, 0xa5, 0x79, 0xec, 0xe6, 0xe3, 0x5e, 0xb7, 0xe2, 0x52, 0x25, 0xed, 0xe8, 0x75, 0x03,
-	0x1e, 0x70, 0x2d, 0xb1, 0x52, 0x45, 0x75, 0xf8, 0x0e, 0xe0, 0xc1, 0xbc, 0xe8, 0xb4, 0x90, 0x44,
-	0x52, 0x34, 0x85, 0xfb, 0xc4, 0xf7, 0x79, 0xc6, 0xa4, 0xb0, 0xc1, 0xa0, 0x36, 0x6a, 0x4f, 0x7a,
-	0xee, 0x5f, 0x6f, 0xd7, 0x78, 0x2f, 0x0a, 0xcb, 0xac, 0xbe, 0xfe, 0xec, 0x5b, 0xde, 0x2f, 0x81,
-	0x4e, 0x61, 0x23, 0x21, 0x29, 0x89, 0x85, 0xbd, 0x37, 0x00, 0xa3, 0xf6, 0x04, 0x55, 0xd9, 0x5b,
-	0x7d, 0x63, 0x18, 0xe3, 0x43, 0x73, 0xd8, 0x79, 0xcc, 0x98, 0xe4, 0x4f, 0x94, 0x3d, 0xc4, 0x24,
-	0x49, 0x22, 0x16, 0x08, 0xbb, 0xa6, 0x83, 0xbb, 0x55, 0xf8, 0x2a, 0x63, 0x77, 0xca, 0x64, 0xf0,
-	0xe3, 0x12, 0xba, 0x36, 0xcc, 0xf0, 0x05, 0x1e, 0xfe, 0x1f, 0x0e, 0xd9, 0xb0, 0x49, 0x56, 0xab,
-	0x94, 0x0a, 0xf5, 0x12, 0x30, 0x6a, 0x79, 0xe5, 0x11, 0x21, 0x58, 0xf7, 0xf9, 0x8a, 0xea, 0x21,
-	0x5b, 0x9e, 0xd6, 0x68, 0x0a, 0x9b, 0x42, 0xf2, 0x94, 0x04, 0xd4, 0xc4, 0x77, 0xaa, 0xf1, 0xfa,
-	0x73, 0x66, 0x47, 0x2a, 0xfb, 0xed, 0xab, 0xdf, 0x5c, 0x14, 0x4e, 0xaf, 0x44, 0x66, 0xe7, 0xeb,
-	0xad, 0x03, 0x36, 0x5b, 0x07, 0x7c, 0x6f, 0x1d, 0xf0, 0xba, 0x73, 0xac, 0xcd, 0xce, 0xb1, 0x3e,
-	0x76, 0x8e, 0x75, 0x7f, 0x12, 0x44, 0x32, 0xcc, 0x96, 0xae, 0xcf, 0x63, 0x7c, 0x13, 0x2d, 0xa3,
-	0x34, 0xbb, 0x0c, 0x49, 0xc4, 0x30, 0xd3, 0x1a, 0xe7, 0x13, 0xfc, 0xac, 0xb6, 0xb4, 0x6c, 0xe8,
-	0x85, 0x9c, 0xfd, 0x04, 0x00, 0x00, 0xff, 0xff, 0x72, 0xb9, 0x50, 0x40, 0xe4, 0x01, 0x00, 0x00,
+	0x14, 0x86, 0x63, 0x5a, 0xb5, 0xd4, 0x45, 0x40, 0xad, 0x0e, 0x51, 0x07, 0xb7, 0xea, 0x80, 0x32,
+	0xc5, 0xb4, 0xac, 0x1d, 0xa0, 0x48, 0x74, 0x02, 0xa1, 0x94, 0x89, 0x05, 0xb9, 0x89, 0x49, 0x22,
+	0x88, 0x1d, 0xc5, 0x4e, 0x04, 0x12, 0x87, 0xe0, 0x1c, 0x9c, 0xa4, 0x63, 0x47, 0x26, 0x40, 0xed,
+	0xc0, 0x35, 0x50, 0x9c, 0x14, 0xc2, 0xf6, 0xfb, 0xf9, 0xff, 0x9e, 0xff, 0xe7, 0x07, 0x4d, 0xa6,
+	0x02, 0xc2, 0xb2, 0x88, 0x64, 0x23, 0xe2, 0x33, 0xce, 0x64, 0x28, 0xed, 0x38, 0x11, 0x4a, 0x20,
+	0xc8, 0x54, 0x60, 0xb3, 0x2c, 0xb2, 0xb3, 0x51, 0xaf, 0x5b, 0x71, 0xe5, 0x25, 0xed, 0xe8, 0x75,
+	0x7d, 0xe1, 0x0b, 0x2d, 0x49, 0xae, 0x8a, 0xea, 0xf0, 0x1b, 0xc0, 0xbd, 0x59, 0xd1, 0x69, 0xae,
+	0xa8, 0x62, 0x68, 0x02, 0x77, 0xa9, 0xeb, 0x8a, 0x94, 0x2b, 0x69, 0x82, 0x41, 0xcd, 0x6a, 0x8f,
+	0x7b, 0xf6, 0x5f, 0x6f, 0xbb, 0xf4, 0x9e, 0x15, 0x96, 0x69, 0x7d, 0xf9, 0xd1, 0x37, 0x9c, 0x5f,
+	0x02, 0x1d, 0xc3, 0x46, 0x4c, 0x13, 0x1a, 0x49, 0x73, 0x67, 0x00, 0xac, 0xf6, 0x18, 0x55, 0xd9,
+	0x6b, 0x7d, 0x53, 0x32, 0xa5, 0x0f, 0xcd, 0x60, 0xe7, 0x3e, 0xe5, 0x4a, 0x3c, 0x30, 0x7e, 0x17,
+	0xd1, 0x38, 0x0e, 0xb9, 0x2f, 0xcd, 0x9a, 0x7e, 0xb8, 0x5b, 0x85, 0x2f, 0x52, 0x7e, 0x93, 0x9b,
+	0x4a, 0xfc, 0x70, 0x0b, 0x5d, 0x96, 0x0c, 0xc2, 0x10, 0xc6, 0x09, 0xf3, 0x58, 0xfc, 0x28, 0x9e,
+	0xa5, 0x59, 0x1f, 0xd4, 0xac, 0x96, 0x53, 0xa9, 0x0c, 0x5f, 0xe0, 0xfe, 0xff, 0xf0, 0xc8, 0x84,
+	0x4d, 0xea, 0x79, 0x09, 0x93, 0xf9, 0xa4, 0xc0, 0x6a, 0x39, 0xdb, 0x23, 0x42, 0xb0, 0xee, 0x0a,
+	0x8f, 0xe9, 0x21, 0x5a, 0x8e, 0xd6, 0x68, 0x02, 0x9b, 0x52, 0x89, 0x84, 0xfa, 0xac, 0x8c, 0xd7,
+	0xa9, 0xc6, 0xd3, 0x9f, 0x37, 0x3d, 0xc8, 0xb3, 0xbd, 0x7d, 0xf6, 0x9b, 0xf3, 0xc2, 0xe9, 0x6c,
+	0x91, 0xe9, 0xe9, 0x72, 0x8d, 0xc1, 0x6a, 0x8d, 0xc1, 0xd7, 0x1a, 0x83, 0xd7, 0x0d, 0x36, 0x56,
+	0x1b, 0x6c, 0xbc, 0x6f, 0xb0, 0x71, 0x7b, 0xe4, 0x87, 0x2a, 0x48, 0x17, 0xb6, 0x2b, 0x22, 0x72,
+	0x15, 0x2e, 0xc2, 0x24, 0x3d, 0x0f, 0x68, 0xc8, 0x09, 0xd7, 0x9a, 0x64, 0x63, 0xf2, 0x94, 0x6f,
+	0x71, 0xd1, 0xd0, 0x0b, 0x3b, 0xf9, 0x19, 0x00, 0x3b, 0xee, 0x67, 0xf3, 0x04, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -205,6 +217,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Predeploys) > 0 {
+		for iNdEx := len(m.Predeploys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predeploys[iNdEx])
+			copy(dAtA[i:], m.Predeploys[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Predeploys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.FuntokenMappings) > 0 {
 		for iNdEx := len(m.FuntokenMappings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -328,6 +349,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Predeploys) > 0 {
+		for _, s := range m.Predeploys {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -490,6 +517,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predeploys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predeploys = append(m.Predeploys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
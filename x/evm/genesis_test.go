@@ -172,6 +172,54 @@ func (s *GenesisSuite) TestValidateGenesis() {
 				},
 			},
 		},
+		{
+			name: "happy: subset of the predeploys",
+			genState: &evm.GenesisState{
+				Params:     evm.DefaultParams(),
+				Predeploys: []string{"EntryPoint", "SenderCreator"},
+			},
+		},
+		{
+			name:    "unknown predeploy",
+			wantErr: "unknown predeploy Multicall3",
+			genState: &evm.GenesisState{
+				Params:     evm.DefaultParams(),
+				Predeploys: []string{"Multicall3"},
+			},
+		},
+		{
+			name:    "duplicate predeploy",
+			wantErr: "duplicate predeploy EntryPoint",
+			genState: &evm.GenesisState{
+				Params:     evm.DefaultParams(),
+				Predeploys: []string{"EntryPoint", "SenderCreator", "EntryPoint"},
+			},
+		},
+		{
+			name:    "invalid allowed unprotected tx hash",
+			wantErr: "invalid tx hash 0x1234: expected 32 bytes, got 2",
+			genState: &evm.GenesisState{
+				Params: evm.Params{
+					AllowedUnprotectedTxHashes: []string{"0x1234"},
+				},
+			},
+		},
+		{
+			name:    "duplicate allowed unprotected tx hash",
+			wantErr: "found duplicate tx hash",
+			genState: &evm.GenesisState{
+				Params: evm.Params{
+					AllowedUnprotectedTxHashes: []string{s.hash.Hex(), s.hash.Hex()},
+				},
+			},
+		},
+		{
+			name:    "max tx gas below intrinsic gas",
+			wantErr: "max tx gas 1000 is lower than the intrinsic gas of a tx (21000)",
+			genState: &evm.GenesisState{
+				Params: evm.Params{MaxTxGas: 1_000},
+			},
+		},
 		{
 			name: "happy: empty params",
 			genState: &evm.GenesisState{
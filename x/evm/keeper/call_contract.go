@@ -88,3 +88,57 @@ func (k Keeper) CallContractWithInput(
 	}
 	return evmResp, nil
 }
+
+// CallContractFromAccount executes a state-changing contract call from
+// "fromAcc" outside of an Ethereum tx, for example in a cron job of the sudo
+// module. The state changes are committed to "ctx", and the logs of the call
+// are emitted as an [evm.EventTxLog]. Reverted calls return an error.
+func (k *Keeper) CallContractFromAccount(
+	ctx sdk.Context,
+	fromAcc gethcommon.Address,
+	contract gethcommon.Address,
+	contractInput []byte,
+	gasLimit uint64,
+) (evmResp *evm.MsgEthereumTxResponse, err error) {
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &contract,
+		From:             fromAcc,
+		Nonce:            k.GetAccNonce(ctx, fromAcc),
+		Value:            unusedBigInt, // amount
+		GasLimit:         gasLimit,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             contractInput,
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+	stateDB := k.Bank.StateDB
+	if stateDB == nil {
+		stateDB = k.NewStateDB(ctx, k.TxConfig(ctx, gethcommon.Hash{}))
+	}
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+
+	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+	evmResp, err = k.CallContractWithInput(
+		ctx, evmObj, fromAcc, &contract, true /*commit*/, contractInput, gasLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = stateDB.Commit(); err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to commit stateDB")
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: evmResp.Logs})
+	if err == nil {
+		k.updateBlockBloom(ctx, evmResp, uint64(k.EvmState.BlockTxIndex.GetOr(ctx, 0)))
+	}
+	return evmResp, nil
+}
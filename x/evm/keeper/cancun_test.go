@@ -0,0 +1,190 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	"math/big"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+)
+
+// TestCancun runs the op codes and precompiles of the Cancun hard fork before
+// and after its activation with [evm.Params.CancunTime].
+func (s *Suite) TestCancun() {
+	deps := evmtest.NewTestDeps()
+	deployResp, err := evmtest.DeployContract(&deps, embeds.SmartContract_TestCancun)
+	s.Require().NoError(err)
+	contractAddr := deployResp.ContractAddr
+	contractAbi := embeds.SmartContract_TestCancun.ABI
+
+	call := func(method string, args ...any) ([]any, error) {
+		input, err := contractAbi.Pack(method, args...)
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		resp, err := deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &contractAddr, true, input, 1_000_000,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return contractAbi.Unpack(method, resp.Ret)
+	}
+
+	s.Run("op codes are invalid before the activation", func() {
+		_, err := call("transientRoundTrip", big.NewInt(42))
+		s.Require().ErrorContains(err, "invalid opcode: TSTORE")
+	})
+
+	params := deps.EvmKeeper.GetParams(deps.Ctx)
+	params.CancunTime = evm.ParseBlockTimeUnixU64(deps.Ctx)
+	s.Require().NotZero(params.CancunTime)
+	s.Require().NoError(deps.EvmKeeper.SetParams(deps.Ctx, params))
+	s.Require().True(deps.EvmKeeper.GetEVMConfig(deps.Ctx).ChainConfig.IsCancun(
+		big.NewInt(deps.Ctx.BlockHeight()), params.CancunTime,
+	))
+
+	s.Run("EIP-1153: transient storage", func() {
+		out, err := call("transientRoundTrip", big.NewInt(42))
+		s.Require().NoError(err)
+		s.Equal(big.NewInt(42), out[0])
+
+		out, err = call("setTransientThenReadInSubcall", big.NewInt(7))
+		s.Require().NoError(err)
+		s.Equal(big.NewInt(7), out[0], "shared by the call frames of a tx")
+
+		out, err = call("setTransientInRevertedCall", big.NewInt(1), big.NewInt(2))
+		s.Require().NoError(err)
+		s.Equal(big.NewInt(1), out[0], "rolled back with the reverted call frame")
+
+		_, err = call("setTransient", big.NewInt(3))
+		s.Require().NoError(err)
+		out, err = call("getTransient")
+		s.Require().NoError(err)
+		s.Zero(out[0].(*big.Int).Sign(), "cleared at the end of the tx")
+	})
+
+	s.Run("EIP-5656: MCOPY", func() {
+		data := []byte("nibiru cancun mcopy test data that spans more than one word")
+		out, err := call("memoryCopy", data)
+		s.Require().NoError(err)
+		s.Equal(data, out[0])
+	})
+
+	s.Run("EIP-4844 and EIP-7516: blob op codes return zero", func() {
+		out, err := call("blobHash", big.NewInt(0))
+		s.Require().NoError(err)
+		s.Equal([32]byte{}, out[0])
+
+		out, err = call("blobBaseFee")
+		s.Require().NoError(err)
+		s.Zero(out[0].(*big.Int).Sign())
+	})
+
+	s.Run("EIP-4844: point evaluation precompile", func() {
+		// Test vector of go-ethereum: core/vm/testdata/precompiles/pointEvaluation.json
+		input := hexutil.MustDecode("0x01e798154708fe7789429634053cbf9f99b619f9f084048927333fce637f549b564c0a11a0f704f4fc3e8acfe0f8245f0ad1347b378fbf96e206da11a5d3630624d25032e67a7e6a4910df5834b8fe70e6bcfeeac0352434196bdf4b2485d5a18f59a8d2a1a625a17f3fea0fe5eb8c896db3764f3185481bc22f91b4aaffcca25f26936857bc3a7c2539ea8ec3a952b7873033e038326e87ed3e1276fd140253fa08e9fc25fb2d9a98527fc22a2c9612fbeafdad446cbc7bcdbdcd780af2c16a")
+		out, err := call("pointEvaluation", input)
+		s.Require().NoError(err)
+		s.True(out[0].(bool))
+		s.Equal(
+			"0x000000000000000000000000000000000000000000000000000000000000100073eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001",
+			hexutil.Encode(out[1].([]byte)),
+		)
+
+		input[len(input)-1] ^= 1
+		out, err = call("pointEvaluation", input)
+		s.Require().NoError(err)
+		s.False(out[0].(bool), "invalid proof")
+	})
+
+	s.Run("EIP-6780: SELFDESTRUCT deletes contracts created in the same tx", func() {
+		out, err := call("createAndDestroy")
+		s.Require().NoError(err)
+		created := out[0].(gethcommon.Address)
+		acc := deps.EvmKeeper.GetAccount(deps.Ctx, created)
+		s.True(acc == nil || !acc.IsContract(), "contract was not deleted")
+	})
+
+	s.Run("BLOCKHASH", func() {
+		deps.Ctx = deps.Ctx.WithBlockHeight(10)
+		header := cmtproto.Header{
+			Version:         cmtversion.Consensus{Block: version.BlockProtocol},
+			ChainID:         deps.Ctx.ChainID(),
+			Height:          9,
+			Time:            deps.Ctx.BlockTime(),
+			ValidatorsHash:  gethcommon.BigToHash(big.NewInt(9)).Bytes(),
+			ProposerAddress: deps.Sender.NibiruAddr,
+		}
+		deps.App.StakingKeeper.SetHistoricalInfo(
+			deps.Ctx, 9, &stakingtypes.HistoricalInfo{Header: header},
+		)
+		cmtHeader, err := cmttypes.HeaderFromProto(&header)
+		s.Require().NoError(err)
+
+		for _, tc := range []struct {
+			height int64
+			want   gethcommon.Hash
+		}{
+			{height: 9, want: gethcommon.BytesToHash(cmtHeader.Hash())},
+			{height: 8, want: gethcommon.Hash{}},  // no historical info
+			{height: 10, want: gethcommon.Hash{}}, // current block
+			{height: 11, want: gethcommon.Hash{}}, // future block
+		} {
+			out, err := call("blockHash", big.NewInt(tc.height))
+			s.Require().NoError(err)
+			s.Equal(tc.want, gethcommon.Hash(out[0].([32]byte)), "height %d", tc.height)
+		}
+	})
+}
+
+// TestCancunTime_UpdateParams: Governance can schedule the Cancun hard fork, but
+// it cannot move or disable it once it is active.
+func (s *Suite) TestCancunTime_UpdateParams() {
+	deps := evmtest.NewTestDeps()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	blockTime := evm.ParseBlockTimeUnixU64(deps.Ctx)
+	updateCancunTime := func(cancunTime uint64) error {
+		params := deps.EvmKeeper.GetParams(deps.Ctx)
+		params.CancunTime = cancunTime
+		_, err := deps.EvmKeeper.UpdateParams(
+			deps.GoCtx(), &evm.MsgUpdateParams{Authority: authority, Params: params},
+		)
+		return err
+	}
+
+	s.Run("a scheduled fork can be moved or disabled", func() {
+		s.Require().NoError(updateCancunTime(blockTime + 100))
+		s.Require().NoError(updateCancunTime(blockTime + 200))
+		s.Require().NoError(updateCancunTime(0))
+	})
+
+	s.Require().NoError(updateCancunTime(blockTime))
+
+	s.Run("an active fork cannot be moved or disabled", func() {
+		for _, cancunTime := range []uint64{0, blockTime - 1, blockTime + 100} {
+			err := updateCancunTime(cancunTime)
+			s.Require().ErrorContains(err, "cancun_time is immutable once active")
+		}
+		s.Equal(blockTime, deps.EvmKeeper.GetParams(deps.Ctx).CancunTime)
+	})
+
+	s.Run("other params can still be updated", func() {
+		params := deps.EvmKeeper.GetParams(deps.Ctx)
+		params.EVMChannels = []string{"channel-0"}
+		_, err := deps.EvmKeeper.UpdateParams(
+			deps.GoCtx(), &evm.MsgUpdateParams{Authority: authority, Params: params},
+		)
+		s.Require().NoError(err)
+	})
+}
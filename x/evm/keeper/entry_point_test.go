@@ -0,0 +1,186 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/backend"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+)
+
+// TestEntryPoint_Predeploys: The predeploys exist at their addresses from
+// genesis.
+func (s *Suite) TestEntryPoint_Predeploys() {
+	deps := evmtest.NewTestDeps()
+	for _, predeploy := range embeds.Predeploys() {
+		acc := deps.EvmKeeper.GetAccount(deps.Ctx, predeploy.Address)
+		s.Require().NotNil(acc, predeploy.Name)
+		s.True(acc.IsContract(), predeploy.Name)
+		s.Equal(uint64(1), acc.Nonce, predeploy.Name)
+		s.Equal(
+			predeploy.Code,
+			deps.EvmKeeper.GetCode(deps.Ctx, gethcommon.BytesToHash(acc.CodeHash)),
+		)
+	}
+
+	// Deploying again keeps the existing accounts.
+	s.Require().NoError(deps.EvmKeeper.DeployPredeploys(deps.Ctx, embeds.PredeployNames()))
+}
+
+// TestEntryPoint_HandleOps runs user operations of a [TestSimpleAccount]
+// through the predeployed EntryPoint.
+func (s *Suite) TestEntryPoint_HandleOps() {
+	deps := evmtest.NewTestDeps()
+	owner := evmtest.NewEthPrivAcc()
+	recipient := evmtest.NewEthPrivAcc()
+	beneficiary := evmtest.NewEthPrivAcc()
+	entryPointAbi := embeds.SmartContract_EntryPoint.ABI
+
+	deployResp, err := evmtest.DeployContract(
+		&deps, embeds.SmartContract_TestSimpleAccount,
+		embeds.ADDR_ENTRYPOINT, owner.EthAddr,
+	)
+	s.Require().NoError(err)
+	account := deployResp.ContractAddr
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx, eth.EthAddrToNibiruAddr(account),
+		sdk.NewCoins(sdk.NewInt64Coin(evm.EVMBankDenom, 1_000_000_000)),
+	))
+
+	oneMicronibi := evm.NativeToWei(big.NewInt(1))
+	callData, err := embeds.SmartContract_TestSimpleAccount.ABI.Pack(
+		"execute", recipient.EthAddr, oneMicronibi, []byte{},
+	)
+	s.Require().NoError(err)
+	newUserOp := func(nonce int64) rpc.UserOperation {
+		return rpc.UserOperation{
+			Sender:               account,
+			Nonce:                (*hexutil.Big)(big.NewInt(nonce)),
+			CallData:             callData,
+			CallGasLimit:         (*hexutil.Big)(big.NewInt(100_000)),
+			VerificationGasLimit: (*hexutil.Big)(big.NewInt(200_000)),
+			PreVerificationGas:   (*hexutil.Big)(big.NewInt(50_000)),
+			MaxFeePerGas:         (*hexutil.Big)(oneMicronibi),
+			MaxPriorityFeePerGas: (*hexutil.Big)(oneMicronibi),
+		}
+	}
+	chainID := deps.EvmKeeper.EthChainID(deps.Ctx)
+	sign := func(op *rpc.UserOperation, signer evmtest.EthPrivKeyAcc) gethcommon.Hash {
+		userOpHash := op.Hash(embeds.ADDR_ENTRYPOINT, chainID)
+		privKey, err := signer.PrivKey.ToECDSA()
+		s.Require().NoError(err)
+		op.Signature, err = crypto.Sign(accounts.TextHash(userOpHash.Bytes()), privKey)
+		s.Require().NoError(err)
+		return userOpHash
+	}
+	handleOps := func(op rpc.UserOperation) (*evm.MsgEthereumTxResponse, error) {
+		input, err := entryPointAbi.Pack(
+			"handleOps", []rpc.PackedUserOperation{op.Pack()}, beneficiary.EthAddr,
+		)
+		s.Require().NoError(err)
+		return deps.EvmKeeper.CallContractFromAccount(
+			deps.Ctx, deps.Sender.EthAddr, embeds.ADDR_ENTRYPOINT, input, 2_000_000,
+		)
+	}
+
+	s.Run("user op hash matches getUserOpHash", func() {
+		op := newUserOp(0)
+		input, err := entryPointAbi.Pack("getUserOpHash", op.Pack())
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		resp, err := deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &embeds.ADDR_ENTRYPOINT,
+			false, input, 100_000,
+		)
+		s.Require().NoError(err)
+		s.Equal(op.Hash(embeds.ADDR_ENTRYPOINT, chainID).Bytes(), resp.Ret)
+	})
+
+	s.Run("signature of another key fails validation", func() {
+		op := newUserOp(0)
+		sign(&op, recipient)
+		_, err := handleOps(op)
+		s.Require().ErrorContains(err, "AA24 signature error")
+	})
+
+	s.Run("happy: execute a transfer", func() {
+		op := newUserOp(0)
+		userOpHash := sign(&op, owner)
+		resp, err := handleOps(op)
+		s.Require().NoError(err)
+
+		receipt, err := backend.UserOperationReceiptFromLogs(
+			userOpHash, evm.LogsToEthereum(resp.Logs), nil,
+		)
+		s.Require().NoError(err)
+		s.Require().NotNil(receipt)
+		s.True(receipt.Success)
+		s.Equal(account, receipt.Sender)
+		s.Equal(gethcommon.Address{}, receipt.Paymaster)
+		s.Zero(receipt.Nonce.ToInt().Sign())
+		s.Positive(receipt.ActualGasCost.ToInt().Sign())
+
+		s.Equal("1", deps.App.BankKeeper.GetBalance(
+			deps.Ctx, recipient.NibiruAddr, evm.EVMBankDenom,
+		).Amount.String())
+		s.True(deps.App.BankKeeper.GetBalance(
+			deps.Ctx, beneficiary.NibiruAddr, evm.EVMBankDenom,
+		).IsPositive(), "the beneficiary is paid for the gas")
+	})
+
+	s.Run("replayed nonce fails validation", func() {
+		op := newUserOp(0)
+		sign(&op, owner)
+		_, err := handleOps(op)
+		s.Require().ErrorContains(err, "AA25 invalid account nonce")
+	})
+
+	s.Run("simulateHandleOp reverts with the execution result", func() {
+		op := newUserOp(1)
+		op.MaxFeePerGas = new(hexutil.Big)
+		op.MaxPriorityFeePerGas = new(hexutil.Big)
+		input, err := entryPointAbi.Pack("simulateHandleOp", op.Pack())
+		s.Require().NoError(err)
+		evmMsg := core.Message{
+			To:               &embeds.ADDR_ENTRYPOINT,
+			From:             deps.Sender.EthAddr,
+			Value:            big.NewInt(0),
+			GasLimit:         2_000_000,
+			GasPrice:         big.NewInt(0),
+			GasFeeCap:        big.NewInt(0),
+			GasTipCap:        big.NewInt(0),
+			Data:             input,
+			SkipNonceChecks:  true,
+			SkipFromEOACheck: true,
+		}
+		evmObj, _ := deps.NewEVM()
+		resp, err := deps.EvmKeeper.ApplyEvmMsg(
+			deps.Ctx, evmMsg, evmObj, false, gethcommon.Hash{},
+		)
+		s.Require().NoError(err)
+		s.Require().Equal(vm.ErrExecutionReverted.Error(), resp.VmError)
+
+		result, err := backend.UnpackExecutionResult(resp.Ret)
+		s.Require().NoError(err)
+		s.True(result.ExecutionSuccess)
+		s.Positive(result.PreOpGas.Sign())
+		s.Positive(result.ExecutionGasUsed.Sign())
+		s.Equal(
+			uint64(1), result.AccountValidationData.Uint64(),
+			"a missing signature is reported instead of failing the simulation",
+		)
+	})
+}
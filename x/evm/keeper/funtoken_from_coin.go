@@ -63,18 +63,10 @@ func (k *Keeper) deployERC20ForBankCoin(
 ) (erc20Addr gethcommon.Address, err error) {
 	erc20Addr = crypto.CreateAddress(evm.EVM_MODULE_ADDRESS, k.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS))
 
-	// bank.Metadata validation guarantees that both "Base" and "Display" denoms
-	// pass "sdk.ValidateDenom" and that the "DenomUnits" slice has exponents in
-	// ascending order with at least one element, which must be the base
-	// denomination and have exponent 0.
-	decimals := uint8(0)
-	if len(bankCoin.DenomUnits) > 0 {
-		decimalsIdx := len(bankCoin.DenomUnits) - 1
-		decimals = uint8(bankCoin.DenomUnits[decimalsIdx].Exponent)
-	}
+	erc20Info := erc20MetadataFromBank(bankCoin)
 
 	// pass empty method name to deploy the contract
-	packedArgs, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack("", bankCoin.Name, bankCoin.Symbol, decimals)
+	packedArgs, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack("", erc20Info.Name, erc20Info.Symbol, erc20Info.Decimals)
 	if err != nil {
 		return gethcommon.Address{}, sdkioerrors.Wrap(err, "failed to pack ABI args")
 	}
@@ -125,3 +117,22 @@ func (k *Keeper) deployERC20ForBankCoin(
 
 	return erc20Addr, nil
 }
+
+// erc20MetadataFromBank returns the metadata of the ERC20 that the EVM module
+// deploys for a FunToken mapping created from a Bank Coin.
+func erc20MetadataFromBank(bankCoin bank.Metadata) ERC20Metadata {
+	// bank.Metadata validation guarantees that both "Base" and "Display" denoms
+	// pass "sdk.ValidateDenom" and that the "DenomUnits" slice has exponents in
+	// ascending order with at least one element, which must be the base
+	// denomination and have exponent 0.
+	decimals := uint8(0)
+	if len(bankCoin.DenomUnits) > 0 {
+		decimalsIdx := len(bankCoin.DenomUnits) - 1
+		decimals = uint8(bankCoin.DenomUnits[decimalsIdx].Exponent)
+	}
+	return ERC20Metadata{
+		Name:     bankCoin.Name,
+		Symbol:   bankCoin.Symbol,
+		Decimals: decimals,
+	}
+}
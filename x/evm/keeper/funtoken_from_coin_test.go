@@ -335,6 +335,96 @@ func (s *FunTokenFromCoinSuite) TestConvertCoinToEvmAndBack() {
 	s.Require().NotZero(deps.Ctx.GasMeter().GasConsumed())
 }
 
+// TestConvertEvmToCoin: Converts the ERC20 of a FunToken made from a bank coin
+// back to the bank coin with MsgConvertEvmToCoin.
+func (s *FunTokenFromCoinSuite) TestConvertEvmToCoin() {
+	deps := evmtest.NewTestDeps()
+	alice := evmtest.NewEthPrivAcc()
+	funToken := s.fundAndCreateFunToken(deps, 100)
+
+	s.T().Log("Convert bank coin to erc-20")
+	_, err := deps.EvmKeeper.ConvertCoinToEvm(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			BankCoin:  sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(10)),
+			ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+		},
+	)
+	s.Require().NoError(err)
+
+	s.T().Log("Convert erc-20 back to bank coin")
+	deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	resp, err := deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			Erc20Addr: funToken.Erc20Addr,
+			Amount:    sdk.NewInt(4),
+			ToAddr:    alice.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NotZero(deps.Ctx.GasMeter().GasConsumed())
+	s.Equal(sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(4)), resp.BankCoin)
+
+	testutil.RequireContainsTypedEvent(
+		s.T(),
+		deps.Ctx,
+		&evm.EventConvertEvmToCoin{
+			Sender:               deps.Sender.NibiruAddr.String(),
+			Erc20ContractAddress: funToken.Erc20Addr.Hex(),
+			ToAddr:               alice.NibiruAddr.String(),
+			BankCoin:             sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(4)),
+		},
+	)
+
+	// Check 1: module balance
+	moduleBalance := deps.App.BankKeeper.GetBalance(deps.Ctx, authtypes.NewModuleAddress(evm.ModuleName), evm.EVMBankDenom)
+	s.Require().Equal(sdk.NewInt(6), moduleBalance.Amount)
+
+	// Check 2: recipient balance
+	aliceBalance := deps.App.BankKeeper.GetBalance(deps.Ctx, alice.NibiruAddr, evm.EVMBankDenom)
+	s.Require().Equal(sdk.NewInt(4), aliceBalance.Amount)
+
+	// Check 3: erc-20 balance, the converted tokens are burned
+	evmObj, _ := deps.NewEVM()
+	balance, err := deps.EvmKeeper.ERC20().BalanceOf(funToken.Erc20Addr.Address, deps.Sender.EthAddr, deps.Ctx, evmObj)
+	s.Require().NoError(err)
+	s.Require().Equal("6", balance.String())
+	supply, err := deps.EvmKeeper.ERC20().LoadERC20BigInt(
+		deps.Ctx, evmObj, embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI, funToken.Erc20Addr.Address, "totalSupply",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal("6", supply.String())
+
+	s.Run("sad: amount exceeds the erc-20 balance", func() {
+		_, err := deps.EvmKeeper.ConvertEvmToCoin(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertEvmToCoin{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				Erc20Addr: funToken.Erc20Addr,
+				Amount:    sdk.NewInt(7),
+				ToAddr:    alice.NibiruAddr.String(),
+			},
+		)
+		s.Require().ErrorContains(err, "transfer amount exceeds balance")
+	})
+
+	s.Run("sad: no FunToken mapping", func() {
+		_, err := deps.EvmKeeper.ConvertEvmToCoin(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertEvmToCoin{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				Erc20Addr: eth.EIP55Addr{Address: alice.EthAddr},
+				Amount:    sdk.NewInt(1),
+				ToAddr:    alice.NibiruAddr.String(),
+			},
+		)
+		s.Require().ErrorContains(err, "no FunToken mapping")
+	})
+}
+
 // TestNativeSendThenPrecompileSend tests a race condition where the state DB
 // commit may overwrite the state after the precompile execution, potentially
 // causing a loss of funds.
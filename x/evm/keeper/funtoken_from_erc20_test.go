@@ -355,6 +355,84 @@ func (s *FunTokenFromErc20Suite) TestSendFromEvmToBank_MadeFromErc20() {
 	s.Require().Error(err)
 }
 
+// TestConvertEvmToCoin_MadeFromErc20: MsgConvertEvmToCoin escrows the ERC20 of
+// a FunToken made from an ERC20 in the EVM module and mints the bank coin.
+func (s *FunTokenFromErc20Suite) TestConvertEvmToCoin_MadeFromErc20() {
+	deps := evmtest.NewTestDeps()
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+	))
+
+	s.T().Log("Deploy ERC20 and CreateFunToken")
+	deployResp, err := evmtest.DeployContract(
+		&deps, embeds.SmartContract_ERC20MinterWithMetadataUpdates,
+		"erc20name", "TOKEN", uint8(18),
+	)
+	s.Require().NoError(err)
+	resp, err := deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunToken{
+			FromErc20: &eth.EIP55Addr{Address: deployResp.ContractAddr},
+			Sender:    deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+	bankDenom := resp.FuntokenMapping.BankDenom
+
+	s.T().Log("Mint erc20 tokens")
+	contractInput, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack("mint", deps.Sender.EthAddr, big.NewInt(69_420))
+	s.Require().NoError(err)
+	evmObj, _ := deps.NewEVM()
+	_, err = deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx, evmObj, deps.Sender.EthAddr, &deployResp.ContractAddr, true, contractInput, keeper.Erc20GasLimitExecute,
+	)
+	s.Require().NoError(err)
+
+	s.T().Log("Convert erc20 tokens to a hex recipient")
+	recipient := evmtest.NewEthPrivAcc()
+	deps.Ctx = deps.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			Erc20Addr: eth.EIP55Addr{Address: deployResp.ContractAddr},
+			Amount:    sdk.NewInt(30),
+			ToAddr:    recipient.EthAddr.Hex(),
+		},
+	)
+	s.Require().NoError(err)
+	testutil.RequireContainsTypedEvent(
+		s.T(),
+		deps.Ctx,
+		&evm.EventConvertEvmToCoin{
+			Sender:               deps.Sender.NibiruAddr.String(),
+			Erc20ContractAddress: deployResp.ContractAddr.Hex(),
+			ToAddr:               recipient.NibiruAddr.String(),
+			BankCoin:             sdk.NewCoin(bankDenom, sdk.NewInt(30)),
+		},
+	)
+
+	s.Equal(
+		sdk.NewInt(30),
+		deps.App.BankKeeper.GetBalance(deps.Ctx, recipient.NibiruAddr, bankDenom).Amount,
+	)
+	evmObj, _ = deps.NewEVM()
+	for _, tc := range []struct {
+		account gethcommon.Address
+		want    string
+	}{
+		{account: deps.Sender.EthAddr, want: "69390"},
+		{account: evm.EVM_MODULE_ADDRESS, want: "30"},
+	} {
+		balance, err := deps.EvmKeeper.ERC20().BalanceOf(deployResp.ContractAddr, tc.account, deps.Ctx, evmObj)
+		s.Require().NoError(err)
+		s.Equal(tc.want, balance.String())
+	}
+}
+
 // TestCreateFunTokenFromERC20MaliciousName tries to create funtoken from a contract
 // with a malicious (gas intensive) name() function.
 // Fun token should fail creation with "out of gas"
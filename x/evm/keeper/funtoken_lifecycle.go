@@ -0,0 +1,371 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
+)
+
+// SyncFunTokenMetadata refreshes the metadata of a FunToken mapping from its
+// source. For mappings created from an ERC20, the Bank Coin metadata is
+// rebuilt with [ERC20Metadata.ToBankMetadata]. For mappings created from a
+// Bank Coin, the name, symbol, and decimals of the ERC20 deployed by the EVM
+// module are updated to match the Bank Coin metadata.
+// [SUDO] Only callable by sudoers or the governance module account.
+func (k *Keeper) SyncFunTokenMetadata(
+	goCtx context.Context, msg *evm.MsgSyncFunTokenMetadata,
+) (resp *evm.MsgSyncFunTokenMetadataResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkSudoAuthority(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+	funtoken, err := k.funTokenForERC20(ctx, msg.Erc20Addr.Address)
+	if err != nil {
+		return nil, err
+	}
+	erc20Addr := funtoken.Erc20Addr.Address
+
+	stateDB, evmObj := k.newModuleEVM(ctx, erc20Addr)
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+
+	var (
+		bankMetadata bank.Metadata
+		logs         []evm.Log
+	)
+	if funtoken.IsMadeFromCoin {
+		var isFound bool
+		bankMetadata, isFound = k.Bank.GetDenomMetaData(ctx, funtoken.BankDenom)
+		if !isFound {
+			return nil, fmt.Errorf("bank coin denom should have bank metadata for denom \"%s\"", funtoken.BankDenom)
+		}
+		logs, err = k.setERC20Metadata(ctx, evmObj, erc20Addr, erc20MetadataFromBank(bankMetadata))
+		if err != nil {
+			return nil, sdkioerrors.Wrap(err, "failed to update ERC20 metadata")
+		}
+	} else {
+		bankMetadata, err = k.bankMetadataFromERC20(ctx, evmObj, erc20Addr, funtoken.BankDenom)
+		if err != nil {
+			return nil, err
+		}
+		k.Bank.SetDenomMetaData(ctx, bankMetadata)
+	}
+
+	if err := stateDB.Commit(); err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to commit stateDB")
+	}
+	k.emitModuleCallLogs(ctx, logs)
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventFunTokenMetadataSynced{
+		BankDenom:            funtoken.BankDenom,
+		Erc20ContractAddress: erc20Addr.Hex(),
+		Sender:               msg.Sender,
+	})
+	return &evm.MsgSyncFunTokenMetadataResponse{BankMetadata: bankMetadata}, nil
+}
+
+// SetFunTokenDisabled pauses or resumes the conversions between the Bank Coin
+// and the ERC20 of a FunToken mapping. While disabled, "ConvertCoinToEvm",
+// "ConvertEvmToCoin", and the "sendToBank" and "sendToEvm" methods of the
+// FunToken precompile fail for the mapping. Incoming IBC transfers that request
+// the conversion are refunded.
+// [SUDO] Only callable by sudoers or the governance module account.
+func (k *Keeper) SetFunTokenDisabled(
+	goCtx context.Context, msg *evm.MsgSetFunTokenDisabled,
+) (resp *evm.MsgSetFunTokenDisabledResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkSudoAuthority(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+	funtoken, err := k.funTokenForERC20(ctx, msg.Erc20Addr.Address)
+	if err != nil {
+		return nil, err
+	}
+	if funtoken.Disabled == msg.Disabled {
+		return nil, fmt.Errorf(
+			"FunToken mapping for ERC20 \"%s\" already has disabled=%t",
+			funtoken.Erc20Addr.Hex(), msg.Disabled,
+		)
+	}
+
+	funtoken = funtoken.WithDisabled(msg.Disabled)
+	if err := k.FunTokens.SafeInsertFunToken(ctx, funtoken); err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventFunTokenDisabledSet{
+		BankDenom:            funtoken.BankDenom,
+		Erc20ContractAddress: funtoken.Erc20Addr.Hex(),
+		Sender:               msg.Sender,
+		Disabled:             msg.Disabled,
+	})
+	return &evm.MsgSetFunTokenDisabledResponse{FuntokenMapping: funtoken}, nil
+}
+
+// MigrateFunToken moves a FunToken mapping created from an ERC20 to a new
+// ERC20 contract, for example after the token issuer deployed a new version
+// of the contract. The Bank Coin keeps its denomination and supply.
+//
+// The Bank Coins in circulation are backed by the ERC20 tokens escrowed by the
+// EVM module, so the migration:
+//  1. Requires the EVM module to hold at least the Bank Coin supply in the new
+//     ERC20, which must have the same decimals as the old one.
+//  2. Sends the escrowed balance of the old ERC20 to "old_erc20_recipient".
+//  3. Replaces the mapping and rebuilds the Bank Coin metadata from the new
+//     ERC20. The [evm.FunToken.Disabled] status carries over.
+//
+// Mappings created from a Bank Coin can't be migrated because the EVM module
+// mints their ERC20 and can't move the balances of its holders.
+// [SUDO] Only callable by sudoers or the governance module account.
+func (k *Keeper) MigrateFunToken(
+	goCtx context.Context, msg *evm.MsgMigrateFunToken,
+) (resp *evm.MsgMigrateFunTokenResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkSudoAuthority(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+	funtoken, err := k.funTokenForERC20(ctx, msg.Erc20Addr.Address)
+	if err != nil {
+		return nil, err
+	}
+	if funtoken.IsMadeFromCoin {
+		return nil, fmt.Errorf(
+			"FunToken mapping for bank denom \"%s\" was created from a coin, and only mappings created from an ERC20 can be migrated",
+			funtoken.BankDenom,
+		)
+	}
+	oldErc20 := funtoken.Erc20Addr.Address
+	newErc20 := msg.NewErc20Addr.Address
+	if funtokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, newErc20)); len(funtokens) > 0 {
+		return nil, fmt.Errorf("funtoken mapping already created for ERC20 \"%s\"", newErc20.Hex())
+	}
+	oldErc20Recipient, err := evm.ParseFunTokenRecipient(msg.OldErc20Recipient)
+	if err != nil {
+		return nil, fmt.Errorf("recipient address invalid (%s): %w", msg.OldErc20Recipient, err)
+	}
+
+	stateDB, evmObj := k.newModuleEVM(ctx, newErc20)
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+
+	// 1 | The new ERC20 must back the Bank Coin supply with the same decimals
+	oldInfo, err := k.FindERC20Metadata(ctx, evmObj, oldErc20, nil)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to load metadata of the old ERC20")
+	}
+	newInfo, err := k.FindERC20Metadata(ctx, evmObj, newErc20, nil)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to load metadata of the new ERC20")
+	}
+	if newInfo.Decimals != oldInfo.Decimals {
+		return nil, fmt.Errorf(
+			"new ERC20 has %d decimals, but the old ERC20 has %d", newInfo.Decimals, oldInfo.Decimals,
+		)
+	}
+	bankMetadata := newInfo.ToBankMetadata(funtoken.BankDenom, newErc20)
+	if err := bankMetadata.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate bank metadata: %w", err)
+	}
+
+	supply := k.Bank.GetSupply(ctx, funtoken.BankDenom).Amount
+	newBalance, err := k.ERC20().BalanceOf(newErc20, evm.EVM_MODULE_ADDRESS, ctx, evmObj)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to load the EVM module balance of the new ERC20")
+	}
+	if newBalance.Cmp(supply.BigInt()) < 0 {
+		return nil, fmt.Errorf(
+			"EVM module holds %s of the new ERC20 \"%s\", but the supply of \"%s\" is %s: send the difference to %s before migrating",
+			newBalance, newErc20.Hex(), funtoken.BankDenom, supply, evm.EVM_MODULE_ADDRESS.Hex(),
+		)
+	}
+
+	// 2 | Release the escrow of the old ERC20
+	var logs []evm.Log
+	oldBalance, err := k.ERC20().BalanceOf(oldErc20, evm.EVM_MODULE_ADDRESS, ctx, evmObj)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to load the EVM module balance of the old ERC20")
+	}
+	if oldBalance.Sign() > 0 {
+		_, evmResp, err := k.ERC20().Transfer(
+			oldErc20, evm.EVM_MODULE_ADDRESS, oldErc20Recipient, oldBalance, ctx, evmObj,
+		)
+		if err != nil {
+			return nil, sdkioerrors.Wrap(err, "failed to send the old ERC20 escrow")
+		}
+		logs = evmResp.Logs
+	}
+
+	// 3 | Replace the mapping
+	if err := k.FunTokens.Delete(ctx, funtoken.ID()); err != nil {
+		return nil, err
+	}
+	migrated := evm.NewFunToken(newErc20, funtoken.BankDenom, false).WithDisabled(funtoken.Disabled)
+	if err := k.FunTokens.SafeInsertFunToken(ctx, migrated); err != nil {
+		return nil, err
+	}
+	k.Bank.SetDenomMetaData(ctx, bankMetadata)
+
+	if err := stateDB.Commit(); err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to commit stateDB")
+	}
+	k.emitModuleCallLogs(ctx, logs)
+
+	oldErc20Amount := sdkmath.NewIntFromBigInt(oldBalance)
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventFunTokenMigrated{
+		BankDenom:               funtoken.BankDenom,
+		OldErc20ContractAddress: oldErc20.Hex(),
+		NewErc20ContractAddress: newErc20.Hex(),
+		Sender:                  msg.Sender,
+		OldErc20Recipient:       eth.EthAddrToNibiruAddr(oldErc20Recipient).String(),
+		OldErc20Amount:          oldErc20Amount.String(),
+	})
+	return &evm.MsgMigrateFunTokenResponse{
+		FuntokenMapping: migrated,
+		OldErc20Amount:  oldErc20Amount,
+	}, nil
+}
+
+// checkSudoAuthority returns an error unless the sender is the governance
+// module account (the authority of the keeper) or a sudoer.
+func (k Keeper) checkSudoAuthority(ctx sdk.Context, sender string) error {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+	if senderAddr.Equals(k.authority) {
+		return nil
+	}
+	if err := k.sudoKeeper.CheckPermissions(senderAddr, ctx); err != nil {
+		return sdkioerrors.Wrapf(
+			govtypes.ErrInvalidSigner, "sender %s is neither a sudoer nor %s: %s", sender, k.authority, err,
+		)
+	}
+	return nil
+}
+
+// funTokenForERC20 returns the FunToken mapping of an ERC20.
+func (k Keeper) funTokenForERC20(
+	ctx sdk.Context, erc20Addr gethcommon.Address,
+) (evm.FunToken, error) {
+	funTokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20Addr))
+	if len(funTokens) != 1 {
+		return evm.FunToken{}, fmt.Errorf("no FunToken mapping exists for ERC20 \"%s\"", erc20Addr.Hex())
+	}
+	return funTokens[0], nil
+}
+
+// newModuleEVM returns an EVM for calls from the EVM module account outside of
+// an Ethereum tx. The caller must commit the returned StateDB and reset
+// "k.Bank.StateDB" when done.
+func (k *Keeper) newModuleEVM(
+	ctx sdk.Context, contract gethcommon.Address,
+) (*statedb.StateDB, *vm.EVM) {
+	stateDB := k.Bank.StateDB
+	if stateDB == nil {
+		stateDB = k.NewStateDB(ctx, k.TxConfig(ctx, gethcommon.Hash{}))
+	}
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &contract,
+		From:             evm.EVM_MODULE_ADDRESS,
+		Nonce:            k.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS),
+		Value:            unusedBigInt, // amount
+		GasLimit:         Erc20GasLimitExecute,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             []byte{},
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+	return stateDB, k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+}
+
+// bankMetadataFromERC20 returns the validated Bank Coin metadata of a FunToken
+// mapping created from an ERC20.
+func (k Keeper) bankMetadataFromERC20(
+	ctx sdk.Context, evmObj *vm.EVM, erc20Addr gethcommon.Address, bankDenom string,
+) (bank.Metadata, error) {
+	erc20Info, err := k.FindERC20Metadata(ctx, evmObj, erc20Addr, nil)
+	if err != nil {
+		return bank.Metadata{}, err
+	}
+	bankMetadata := erc20Info.ToBankMetadata(bankDenom, erc20Addr)
+	if err := bankMetadata.Validate(); err != nil {
+		return bank.Metadata{}, fmt.Errorf("failed to validate bank metadata: %w", err)
+	}
+	return bankMetadata, nil
+}
+
+// setERC20Metadata updates the name, symbol, and decimals of an ERC20 deployed
+// by the EVM module that differ from "want" and returns the logs of the calls.
+func (k Keeper) setERC20Metadata(
+	ctx sdk.Context, evmObj *vm.EVM, erc20Addr gethcommon.Address, want ERC20Metadata,
+) (logs []evm.Log, err error) {
+	got, err := k.FindERC20Metadata(ctx, evmObj, erc20Addr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	erc20 := k.ERC20()
+	for _, update := range []struct {
+		method  string
+		arg     any
+		changed bool
+	}{
+		{method: "setName", arg: want.Name, changed: got.Name != want.Name},
+		{method: "setSymbol", arg: want.Symbol, changed: got.Symbol != want.Symbol},
+		{method: "setDecimals", arg: want.Decimals, changed: got.Decimals != want.Decimals},
+	} {
+		if !update.changed {
+			continue
+		}
+		contractInput, err := erc20.ABI.Pack(update.method, update.arg)
+		if err != nil {
+			return nil, err
+		}
+		evmResp, err := k.CallContractWithInput(
+			ctx, evmObj, evm.EVM_MODULE_ADDRESS, &erc20Addr, false /*commit*/, contractInput,
+			getCallGasWithLimit(ctx, Erc20GasLimitExecute),
+		)
+		if err != nil {
+			return nil, sdkioerrors.Wrapf(err, "failed to call %s", update.method)
+		}
+		logs = append(logs, evmResp.Logs...)
+	}
+	return logs, nil
+}
+
+// emitModuleCallLogs emits the logs of contract calls made by the EVM module
+// outside of an Ethereum tx.
+func (k *Keeper) emitModuleCallLogs(ctx sdk.Context, logs []evm.Log) {
+	if len(logs) == 0 {
+		return
+	}
+	err := ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: logs})
+	if err == nil {
+		k.updateBlockBloom(
+			ctx, &evm.MsgEthereumTxResponse{Logs: logs}, uint64(k.EvmState.BlockTxIndex.GetOr(ctx, 0)),
+		)
+	}
+}
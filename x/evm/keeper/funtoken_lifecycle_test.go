@@ -0,0 +1,372 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	sudotypes "github.com/NibiruChain/nibiru/v2/x/sudo/types"
+)
+
+var govModuleAddr = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+// deployFunTokenFromERC20 deploys an ERC20 owned by "deps.Sender", creates its
+// FunToken mapping, and mints "mintAmount" ERC20 tokens to "deps.Sender".
+func (s *Suite) deployFunTokenFromERC20(
+	deps *evmtest.TestDeps, symbol string, decimals uint8, mintAmount int64,
+) evm.FunToken {
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+	))
+	erc20Addr := s.deployERC20(deps, symbol, decimals)
+	resp, err := deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunToken{
+			FromErc20: &eth.EIP55Addr{Address: erc20Addr},
+			Sender:    deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+	s.mintERC20(deps, erc20Addr, deps.Sender.EthAddr, mintAmount)
+	return resp.FuntokenMapping
+}
+
+func (s *Suite) deployERC20(
+	deps *evmtest.TestDeps, symbol string, decimals uint8,
+) gethcommon.Address {
+	deployResp, err := evmtest.DeployContract(
+		deps, embeds.SmartContract_ERC20MinterWithMetadataUpdates,
+		"erc20name", symbol, decimals,
+	)
+	s.Require().NoError(err)
+	return deployResp.ContractAddr
+}
+
+func (s *Suite) mintERC20(
+	deps *evmtest.TestDeps, erc20Addr, to gethcommon.Address, amount int64,
+) {
+	contractInput, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack(
+		"mint", to, big.NewInt(amount),
+	)
+	s.Require().NoError(err)
+	evmObj, _ := deps.NewEVM()
+	_, err = deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx, evmObj, deps.Sender.EthAddr, &erc20Addr, true, contractInput, keeper.Erc20GasLimitExecute,
+	)
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestSetFunTokenDisabled() {
+	deps := evmtest.NewTestDeps()
+	funtoken := s.deployFunTokenFromERC20(&deps, "TOKEN", 18, 1_000)
+	erc20Addr := funtoken.Erc20Addr
+
+	convertEvmToCoin := func() error {
+		_, err := deps.EvmKeeper.ConvertEvmToCoin(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertEvmToCoin{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				Erc20Addr: erc20Addr,
+				Amount:    sdkmath.NewInt(10),
+				ToAddr:    deps.Sender.NibiruAddr.String(),
+			},
+		)
+		return err
+	}
+	convertCoinToEvm := func() error {
+		_, err := deps.EvmKeeper.ConvertCoinToEvm(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertCoinToEvm{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				BankCoin:  sdk.NewCoin(funtoken.BankDenom, sdkmath.NewInt(5)),
+				ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+			},
+		)
+		return err
+	}
+	s.Require().NoError(convertEvmToCoin())
+
+	s.Run("sad: sender is neither a sudoer nor the authority", func() {
+		_, err := deps.EvmKeeper.SetFunTokenDisabled(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgSetFunTokenDisabled{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				Erc20Addr: erc20Addr,
+				Disabled:  true,
+			},
+		)
+		s.Require().ErrorContains(err, "neither a sudoer")
+	})
+
+	s.Run("happy: governance disables the mapping", func() {
+		deps.Ctx = deps.Ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := deps.EvmKeeper.SetFunTokenDisabled(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgSetFunTokenDisabled{
+				Sender:    govModuleAddr,
+				Erc20Addr: erc20Addr,
+				Disabled:  true,
+			},
+		)
+		s.Require().NoError(err)
+		s.True(resp.FuntokenMapping.Disabled)
+		testutil.RequireContainsTypedEvent(
+			s.T(),
+			deps.Ctx,
+			&evm.EventFunTokenDisabledSet{
+				BankDenom:            funtoken.BankDenom,
+				Erc20ContractAddress: erc20Addr.Hex(),
+				Sender:               govModuleAddr,
+				Disabled:             true,
+			},
+		)
+
+		queryResp, err := deps.EvmKeeper.FunTokenMapping(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.QueryFunTokenMappingRequest{Token: funtoken.BankDenom},
+		)
+		s.Require().NoError(err)
+		s.True(queryResp.FunToken.Disabled)
+
+		s.Require().ErrorContains(convertEvmToCoin(), "is disabled")
+		s.Require().ErrorContains(convertCoinToEvm(), "is disabled")
+	})
+
+	s.Run("sad: already disabled", func() {
+		_, err := deps.EvmKeeper.SetFunTokenDisabled(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgSetFunTokenDisabled{
+				Sender:    govModuleAddr,
+				Erc20Addr: erc20Addr,
+				Disabled:  true,
+			},
+		)
+		s.Require().ErrorContains(err, "already has disabled=true")
+	})
+
+	s.Run("happy: sudoer enables the mapping", func() {
+		deps.App.SudoKeeper.Sudoers.Set(deps.Ctx, sudotypes.Sudoers{
+			Root: deps.Sender.NibiruAddr.String(),
+		})
+		_, err := deps.EvmKeeper.SetFunTokenDisabled(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgSetFunTokenDisabled{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				Erc20Addr: erc20Addr,
+				Disabled:  false,
+			},
+		)
+		s.Require().NoError(err)
+		s.Require().NoError(convertEvmToCoin())
+		s.Require().NoError(convertCoinToEvm())
+	})
+}
+
+func (s *Suite) TestSyncFunTokenMetadata() {
+	deps := evmtest.NewTestDeps()
+
+	s.Run("happy: bank metadata follows the ERC20", func() {
+		funtoken := s.deployFunTokenFromERC20(&deps, "TOKEN", 18, 0)
+		erc20Addr := funtoken.Erc20Addr.Address
+
+		contractInput, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack("setSymbol", "TOKEN2")
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		_, err = deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &erc20Addr, true, contractInput, keeper.Erc20GasLimitExecute,
+		)
+		s.Require().NoError(err)
+
+		resp, err := deps.EvmKeeper.SyncFunTokenMetadata(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgSyncFunTokenMetadata{
+				Sender:    govModuleAddr,
+				Erc20Addr: funtoken.Erc20Addr,
+			},
+		)
+		s.Require().NoError(err)
+		s.Equal("TOKEN2", resp.BankMetadata.Symbol)
+		bankMetadata, _ := deps.App.BankKeeper.GetDenomMetaData(deps.Ctx, funtoken.BankDenom)
+		s.Equal(resp.BankMetadata, bankMetadata)
+		s.Equal("TOKEN2", bankMetadata.Display)
+	})
+
+	s.Run("happy: ERC20 follows the bank metadata", func() {
+		funtoken := evmtest.CreateFunTokenForBankCoin(deps, "unibi_sync", &s.Suite)
+		bankMetadata, _ := deps.App.BankKeeper.GetDenomMetaData(deps.Ctx, funtoken.BankDenom)
+		bankMetadata.Name = "Synced Name"
+		bankMetadata.Symbol = "SYNC"
+		bankMetadata.DenomUnits = append(
+			bankMetadata.DenomUnits, &bank.DenomUnit{Denom: "sync", Exponent: 6},
+		)
+		deps.App.BankKeeper.SetDenomMetaData(deps.Ctx, bankMetadata)
+
+		_, err := deps.EvmKeeper.SyncFunTokenMetadata(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgSyncFunTokenMetadata{
+				Sender:    govModuleAddr,
+				Erc20Addr: funtoken.Erc20Addr,
+			},
+		)
+		s.Require().NoError(err)
+
+		evmObj, _ := deps.NewEVM()
+		info, err := deps.EvmKeeper.FindERC20Metadata(deps.Ctx, evmObj, funtoken.Erc20Addr.Address, nil)
+		s.Require().NoError(err)
+		s.Equal(keeper.ERC20Metadata{Name: "Synced Name", Symbol: "SYNC", Decimals: 6}, *info)
+	})
+
+	s.Run("sad: no FunToken mapping", func() {
+		_, err := deps.EvmKeeper.SyncFunTokenMetadata(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgSyncFunTokenMetadata{
+				Sender:    govModuleAddr,
+				Erc20Addr: eth.EIP55Addr{Address: evmtest.NewEthPrivAcc().EthAddr},
+			},
+		)
+		s.Require().ErrorContains(err, "no FunToken mapping exists")
+	})
+}
+
+func (s *Suite) TestMigrateFunToken() {
+	deps := evmtest.NewTestDeps()
+	funtoken := s.deployFunTokenFromERC20(&deps, "OLD", 18, 1_000)
+	oldErc20 := funtoken.Erc20Addr.Address
+
+	s.T().Log("Convert 60 ERC20 tokens into coins escrowed by the EVM module")
+	_, err := deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			Erc20Addr: funtoken.Erc20Addr,
+			Amount:    sdkmath.NewInt(60),
+			ToAddr:    deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+
+	newErc20 := s.deployERC20(&deps, "NEW", 18)
+	oldErc20Recipient := evmtest.NewEthPrivAcc()
+	migrate := func(newErc20 gethcommon.Address) (*evm.MsgMigrateFunTokenResponse, error) {
+		return deps.EvmKeeper.MigrateFunToken(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgMigrateFunToken{
+				Sender:            govModuleAddr,
+				Erc20Addr:         funtoken.Erc20Addr,
+				NewErc20Addr:      eth.EIP55Addr{Address: newErc20},
+				OldErc20Recipient: oldErc20Recipient.NibiruAddr.String(),
+			},
+		)
+	}
+
+	s.Run("sad: different decimals", func() {
+		_, err := migrate(s.deployERC20(&deps, "SIX", 6))
+		s.Require().ErrorContains(err, "new ERC20 has 6 decimals, but the old ERC20 has 18")
+	})
+
+	s.Run("sad: EVM module doesn't back the coin supply with the new ERC20", func() {
+		s.mintERC20(&deps, newErc20, evm.EVM_MODULE_ADDRESS, 59)
+		_, err := migrate(newErc20)
+		s.Require().ErrorContains(err, "send the difference")
+	})
+
+	s.Run("sad: mapping created from a coin", func() {
+		coinFuntoken := evmtest.CreateFunTokenForBankCoin(deps, "unibi_migrate", &s.Suite)
+		_, err := deps.EvmKeeper.MigrateFunToken(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgMigrateFunToken{
+				Sender:            govModuleAddr,
+				Erc20Addr:         coinFuntoken.Erc20Addr,
+				NewErc20Addr:      eth.EIP55Addr{Address: newErc20},
+				OldErc20Recipient: oldErc20Recipient.NibiruAddr.String(),
+			},
+		)
+		s.Require().ErrorContains(err, "only mappings created from an ERC20 can be migrated")
+	})
+
+	s.Run("happy: migrate to the new ERC20", func() {
+		s.mintERC20(&deps, newErc20, evm.EVM_MODULE_ADDRESS, 1)
+		deps.Ctx = deps.Ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := migrate(newErc20)
+		s.Require().NoError(err)
+		s.Equal(evm.NewFunToken(newErc20, funtoken.BankDenom, false), resp.FuntokenMapping)
+		s.Equal(sdkmath.NewInt(60), resp.OldErc20Amount)
+		testutil.RequireContainsTypedEvent(
+			s.T(),
+			deps.Ctx,
+			&evm.EventFunTokenMigrated{
+				BankDenom:               funtoken.BankDenom,
+				OldErc20ContractAddress: oldErc20.Hex(),
+				NewErc20ContractAddress: newErc20.Hex(),
+				Sender:                  govModuleAddr,
+				OldErc20Recipient:       oldErc20Recipient.NibiruAddr.String(),
+				OldErc20Amount:          "60",
+			},
+		)
+
+		evmObj, _ := deps.NewEVM()
+		for _, tc := range []struct {
+			erc20   gethcommon.Address
+			account gethcommon.Address
+			want    string
+		}{
+			{erc20: oldErc20, account: evm.EVM_MODULE_ADDRESS, want: "0"},
+			{erc20: oldErc20, account: oldErc20Recipient.EthAddr, want: "60"},
+			{erc20: newErc20, account: evm.EVM_MODULE_ADDRESS, want: "60"},
+		} {
+			balance, err := deps.EvmKeeper.ERC20().BalanceOf(tc.erc20, tc.account, deps.Ctx, evmObj)
+			s.Require().NoError(err)
+			s.Equal(tc.want, balance.String(), "erc20 %s, account %s", tc.erc20, tc.account)
+		}
+
+		queryResp, err := deps.EvmKeeper.FunTokenMapping(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.QueryFunTokenMappingRequest{Token: funtoken.BankDenom},
+		)
+		s.Require().NoError(err)
+		s.Equal(newErc20, queryResp.FunToken.Erc20Addr.Address)
+		_, err = deps.EvmKeeper.FunTokenMapping(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.QueryFunTokenMappingRequest{Token: oldErc20.Hex()},
+		)
+		s.Require().Error(err)
+
+		bankMetadata, _ := deps.App.BankKeeper.GetDenomMetaData(deps.Ctx, funtoken.BankDenom)
+		s.Equal("NEW", bankMetadata.Symbol)
+		s.Contains(bankMetadata.Description, newErc20.Hex())
+	})
+
+	s.Run("happy: coins convert to the new ERC20", func() {
+		recipient := evmtest.NewEthPrivAcc()
+		_, err := deps.EvmKeeper.ConvertCoinToEvm(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertCoinToEvm{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				BankCoin:  sdk.NewCoin(funtoken.BankDenom, sdkmath.NewInt(25)),
+				ToEthAddr: eth.EIP55Addr{Address: recipient.EthAddr},
+			},
+		)
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		balance, err := deps.EvmKeeper.ERC20().BalanceOf(newErc20, recipient.EthAddr, deps.Ctx, evmObj)
+		s.Require().NoError(err)
+		s.Equal(fmt.Sprint(25), balance.String())
+	})
+}
@@ -74,7 +74,15 @@ type IndexesFunToken struct {
 func (fun FunTokenState) SafeInsert(
 	ctx sdk.Context, erc20 gethcommon.Address, bankDenom string, isMadeFromCoin bool,
 ) error {
-	funtoken := evm.NewFunToken(erc20, bankDenom, isMadeFromCoin)
+	return fun.SafeInsertFunToken(ctx, evm.NewFunToken(erc20, bankDenom, isMadeFromCoin))
+}
+
+// SafeInsertFunToken adds an [evm.FunToken] to state with defensive validation
+// and keeps all of its fields, like [evm.FunToken.Disabled].
+func (fun FunTokenState) SafeInsertFunToken(ctx sdk.Context, funtoken evm.FunToken) error {
+	funtoken = evm.NewFunToken(
+		funtoken.Erc20Addr.Address, funtoken.BankDenom, funtoken.IsMadeFromCoin,
+	).WithDisabled(funtoken.Disabled)
 	if err := funtoken.Validate(); err != nil {
 		return err
 	}
@@ -17,7 +17,6 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	gethparams "github.com/ethereum/go-ethereum/params"
 
-	"github.com/NibiruChain/nibiru/v2/app/appconst"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 )
 
@@ -138,16 +137,18 @@ func (k *Keeper) DeductTxCostsFromUserBalance(
 //   - txData: Tx data related to gas, effectie gas, nonce, and chain ID
 //     implemented by every Ethereum tx type.
 //   - baseFeeMicronibi:EIP1559 base fee in units of micronibi ("unibi").
+//   - chainConfig: Chain config with the hard forks activated by the EVM params.
 //   - isCheckTx: Comes from `[sdk.Context].isCheckTx()`
 func VerifyFee(
 	txData evm.TxData,
 	baseFeeMicronibi *big.Int,
+	chainConfig *gethparams.ChainConfig,
 	ctx sdk.Context,
 ) (sdk.Coins, error) {
 	var (
 		isContractCreation = txData.GetTo() == nil
 		isCheckTx          = ctx.IsCheckTx()
-		rules              = Rules(ctx)
+		rules              = Rules(ctx, chainConfig)
 	)
 
 	gasLimit := txData.GetGas()
@@ -196,8 +197,7 @@ func VerifyFee(
 	return sdk.Coins{{Denom: bankDenom, Amount: sdkmath.NewIntFromBigInt(feeAmtMicronibi)}}, nil
 }
 
-func Rules(ctx sdk.Context) gethparams.Rules {
-	chainConfig := evm.EthereumConfig(appconst.GetEthChainID(ctx.ChainID()))
+func Rules(ctx sdk.Context, chainConfig *gethparams.ChainConfig) gethparams.Rules {
 	return chainConfig.Rules(
 		big.NewInt(ctx.BlockHeight()),
 		false, // isMerge
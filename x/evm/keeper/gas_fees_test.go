@@ -104,7 +104,10 @@ func (s *Suite) TestVerifyFee() {
 		ctx := sdk.Context{}.WithIsCheckTx(true)
 		s.Run(tc.name, func() {
 			gotCoins, err := evmkeeper.VerifyFee(
-				tc.txData, tc.baseFeeMicronibi, ctx,
+				tc.txData,
+				tc.baseFeeMicronibi,
+				evm.DefaultParams().EthereumConfig(nil),
+				ctx,
 			)
 			if tc.wantErr != "" {
 				s.Require().ErrorContains(err, tc.wantErr)
@@ -14,10 +14,13 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common"
 	"github.com/NibiruChain/nibiru/v2/x/common/set"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
@@ -277,7 +280,7 @@ func (k *Keeper) EthCall(
 	nonce := k.GetAccNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
-	msg, err := args.ToMessage(req.GasCap, evmCfg.BaseFeeWei)
+	msg, err := args.ToMessage(evmCfg.Params.TxGasCap(req.GasCap), evmCfg.BaseFeeWei)
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
 	}
@@ -326,6 +329,8 @@ func (k Keeper) EstimateGasForEvmCallType(
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	evmCfg := k.GetEVMConfig(ctx)
+	// Estimates can't exceed the gas that a tx is allowed to use.
+	req.GasCap = evmCfg.Params.TxGasCap(req.GasCap)
 
 	if req.GasCap < gethparams.TxGas {
 		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "gas cap cannot be lower than %d", gethparams.TxGas)
@@ -861,25 +866,142 @@ func (k Keeper) FunTokenMapping(
 	goCtx context.Context, req *evm.QueryFunTokenMappingRequest,
 ) (*evm.QueryFunTokenMappingResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	funtoken, err := k.funTokenForToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &evm.QueryFunTokenMappingResponse{
+		FunToken: &funtoken,
+	}, nil
+}
 
+// funTokenForToken finds the FunToken mapping for either a bank denom or a
+// hexadecimal-encoded ERC20 contract address.
+func (k Keeper) funTokenForToken(ctx sdk.Context, token string) (evm.FunToken, error) {
 	// first try lookup by cosmos denom
-	bankDenomIter := k.FunTokens.Indexes.BankDenom.ExactMatch(ctx, req.Token)
+	bankDenomIter := k.FunTokens.Indexes.BankDenom.ExactMatch(ctx, token)
 	funTokenMappings := k.FunTokens.Collect(ctx, bankDenomIter)
 	if len(funTokenMappings) > 0 {
 		// assumes that there is only one mapping for a given denom
-		return &evm.QueryFunTokenMappingResponse{
-			FunToken: &funTokenMappings[0],
-		}, nil
+		return funTokenMappings[0], nil
 	}
 
-	erc20AddrIter := k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, gethcommon.HexToAddress(req.Token))
+	erc20AddrIter := k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, gethcommon.HexToAddress(token))
 	funTokenMappings = k.FunTokens.Collect(ctx, erc20AddrIter)
 	if len(funTokenMappings) > 0 {
 		// assumes that there is only one mapping for a given erc20 address
-		return &evm.QueryFunTokenMappingResponse{
-			FunToken: &funTokenMappings[0],
-		}, nil
+		return funTokenMappings[0], nil
+	}
+
+	return evm.FunToken{}, grpcstatus.Errorf(grpccodes.NotFound, "token mapping not found for %s", token)
+}
+
+// FunTokenMappings: Implements the gRPC query for
+// "/eth.evm.v1.Query/FunTokenMappings". It lists the FunToken mappings in the
+// order of their IDs, optionally filtered by the side the mapping was created
+// from.
+func (k Keeper) FunTokenMappings(
+	goCtx context.Context, req *evm.QueryFunTokenMappingsRequest,
+) (*evm.QueryFunTokenMappingsResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "empty request")
+	}
+	if _, ok := evm.FunTokenOrigin_name[int32(req.Origin)]; !ok {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid FunToken origin %d", req.Origin)
+	}
+	pageReq, _, err := common.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), evm.KeyPrefixFunTokens.Prefix())
+	funtokens := []evm.FunToken{}
+	pageRes, err := sdkquery.FilteredPaginate(
+		store, pageReq,
+		func(_ []byte, value []byte, accumulate bool) (bool, error) {
+			var funtoken evm.FunToken
+			if err := k.cdc.Unmarshal(value, &funtoken); err != nil {
+				return false, err
+			}
+			switch {
+			case req.Origin == evm.FunTokenOrigin_FUNTOKEN_ORIGIN_COIN && !funtoken.IsMadeFromCoin,
+				req.Origin == evm.FunTokenOrigin_FUNTOKEN_ORIGIN_ERC20 && funtoken.IsMadeFromCoin:
+				return false, nil
+			}
+			if accumulate {
+				funtokens = append(funtokens, funtoken)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	return &evm.QueryFunTokenMappingsResponse{
+		FunTokens:  funtokens,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FunTokenReserves: Implements the gRPC query for
+// "/eth.evm.v1.Query/FunTokenReserves". It reports the bank supply, the ERC20
+// "totalSupply", and the amounts the EVM module escrows and mints for the
+// FunToken mapping of the given bank denom or ERC20 address.
+func (k Keeper) FunTokenReserves(
+	goCtx context.Context, req *evm.QueryFunTokenReservesRequest,
+) (*evm.QueryFunTokenReservesResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	funtoken, err := k.funTokenForToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return k.funTokenReserves(ctx, funtoken)
+}
+
+// FunTokenAudit: Implements the gRPC query for
+// "/eth.evm.v1.Query/FunTokenAudit". It checks the reserves of every FunToken
+// mapping and reports the under-collateralized ones. See [Keeper.AuditFunTokens].
+func (k Keeper) FunTokenAudit(
+	goCtx context.Context, req *evm.QueryFunTokenAuditRequest,
+) (*evm.QueryFunTokenAuditResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "empty request")
+	}
+	audit := k.AuditFunTokens(sdk.UnwrapSDKContext(goCtx))
+	return &audit, nil
+}
+
+// funTokenReserves computes the supply on each side of a FunToken mapping. See
+// [evm.QueryFunTokenReservesResponse] for the meaning of each amount.
+func (k Keeper) funTokenReserves(
+	ctx sdk.Context, funtoken evm.FunToken,
+) (*evm.QueryFunTokenReservesResponse, error) {
+	erc20Addr := funtoken.Erc20Addr.Address
+	_, evmObj := k.newModuleEVM(ctx, erc20Addr)
+	erc20TotalSupply, err := k.ERC20().TotalSupply(erc20Addr, ctx, evmObj)
+	if err != nil {
+		return nil, grpcstatus.Errorf(grpccodes.Internal, "failed to query ERC20 totalSupply: %s", err)
+	}
+	resp := &evm.QueryFunTokenReservesResponse{
+		FunToken:         funtoken,
+		BankSupply:       k.Bank.GetSupply(ctx, funtoken.BankDenom).Amount,
+		Erc20TotalSupply: sdkmath.NewIntFromBigInt(erc20TotalSupply),
+	}
+	if funtoken.IsMadeFromCoin {
+		resp.EscrowedAmount = k.Bank.GetBalance(ctx, evm.EVM_MODULE_ADDRESS_NIBI, funtoken.BankDenom).Amount
+		resp.MintedAmount = resp.Erc20TotalSupply
+		return resp, nil
 	}
 
-	return nil, grpcstatus.Errorf(grpccodes.NotFound, "token mapping not found for %s", req.Token)
+	escrowedErc20, err := k.ERC20().BalanceOf(erc20Addr, evm.EVM_MODULE_ADDRESS, ctx, evmObj)
+	if err != nil {
+		return nil, grpcstatus.Errorf(grpccodes.Internal, "failed to query ERC20 balance of the EVM module: %s", err)
+	}
+	resp.EscrowedAmount = sdkmath.NewIntFromBigInt(escrowedErc20)
+	resp.MintedAmount = resp.BankSupply
+	return resp, nil
 }
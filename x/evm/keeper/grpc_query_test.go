@@ -9,6 +9,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethparams "github.com/ethereum/go-ethereum/params"
@@ -727,6 +728,36 @@ func (s *Suite) TestEstimateGasForEvmCallType() {
 			},
 			wantErr: "insufficient balance for transfer",
 		},
+		{
+			name: "sad: gas above max tx gas",
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				deployResp, err := evmtest.DeployContract(deps, embeds.SmartContract_TestERC20)
+				s.Require().NoError(err)
+
+				// An ERC20 transfer to a new holder costs more than 40_000 gas.
+				params := deps.EvmKeeper.GetParams(deps.Ctx)
+				params.MaxTxGas = 40_000
+				s.Require().NoError(deps.EvmKeeper.SetParams(deps.Ctx, params))
+
+				input, err := embeds.SmartContract_TestERC20.ABI.Pack(
+					"transfer", evmtest.NewEthPrivAcc().EthAddr, big.NewInt(1),
+				)
+				s.Require().NoError(err)
+				data := hexutil.Bytes(input)
+				jsonTxArgs, err := json.Marshal(&evm.JsonTxArgs{
+					From: &deps.Sender.EthAddr,
+					To:   &deployResp.ContractAddr,
+					Data: &data,
+				})
+				s.Require().NoError(err)
+				req = &evm.EthCallRequest{
+					Args:   jsonTxArgs,
+					GasCap: 1_000_000,
+				}
+				return req, nil
+			},
+			wantErr: "gas required exceeds allowance (40000)",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1081,3 +1112,136 @@ func (s *Suite) TestQueryFunTokenMapping() {
 		})
 	}
 }
+
+func (s *Suite) TestQueryFunTokenMappings() {
+	deps := evmtest.NewTestDeps()
+	for _, funtoken := range []evm.FunToken{
+		evm.NewFunToken(gethcommon.BigToAddress(big.NewInt(1)), "coin1", true),
+		evm.NewFunToken(gethcommon.BigToAddress(big.NewInt(2)), "coin2", true),
+		evm.NewFunToken(gethcommon.BigToAddress(big.NewInt(3)), "erc20/0x3", false),
+	} {
+		s.Require().NoError(deps.EvmKeeper.FunTokens.SafeInsertFunToken(deps.Ctx, funtoken))
+	}
+	query := func(req *evm.QueryFunTokenMappingsRequest) *evm.QueryFunTokenMappingsResponse {
+		resp, err := deps.EvmKeeper.FunTokenMappings(sdk.WrapSDKContext(deps.Ctx), req)
+		s.Require().NoError(err)
+		return resp
+	}
+	bankDenoms := func(funtokens []evm.FunToken) (denoms []string) {
+		for _, funtoken := range funtokens {
+			denoms = append(denoms, funtoken.BankDenom)
+		}
+		return denoms
+	}
+
+	s.Run("all mappings", func() {
+		resp := query(&evm.QueryFunTokenMappingsRequest{})
+		s.ElementsMatch([]string{"coin1", "coin2", "erc20/0x3"}, bankDenoms(resp.FunTokens))
+	})
+
+	s.Run("pages by key", func() {
+		resp := query(&evm.QueryFunTokenMappingsRequest{
+			Pagination: &sdkquery.PageRequest{Limit: 2, CountTotal: true},
+		})
+		s.Len(resp.FunTokens, 2)
+		s.EqualValues(3, resp.Pagination.Total)
+		s.NotNil(resp.Pagination.NextKey)
+
+		nextResp := query(&evm.QueryFunTokenMappingsRequest{
+			Pagination: &sdkquery.PageRequest{Limit: 2, Key: resp.Pagination.NextKey},
+		})
+		s.Len(nextResp.FunTokens, 1)
+		s.Nil(nextResp.Pagination.NextKey)
+		s.ElementsMatch(
+			[]string{"coin1", "coin2", "erc20/0x3"},
+			append(bankDenoms(resp.FunTokens), bankDenoms(nextResp.FunTokens)...),
+		)
+	})
+
+	s.Run("filter by origin", func() {
+		resp := query(&evm.QueryFunTokenMappingsRequest{
+			Origin:     evm.FunTokenOrigin_FUNTOKEN_ORIGIN_COIN,
+			Pagination: &sdkquery.PageRequest{CountTotal: true},
+		})
+		s.ElementsMatch([]string{"coin1", "coin2"}, bankDenoms(resp.FunTokens))
+		s.EqualValues(2, resp.Pagination.Total)
+
+		resp = query(&evm.QueryFunTokenMappingsRequest{
+			Origin: evm.FunTokenOrigin_FUNTOKEN_ORIGIN_ERC20,
+		})
+		s.Equal([]string{"erc20/0x3"}, bankDenoms(resp.FunTokens))
+	})
+
+	s.Run("sad: invalid origin", func() {
+		_, err := deps.EvmKeeper.FunTokenMappings(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.QueryFunTokenMappingsRequest{Origin: 3},
+		)
+		s.Require().ErrorContains(err, "invalid FunToken origin")
+	})
+}
+
+func (s *Suite) TestQueryFunTokenReserves() {
+	deps := evmtest.NewTestDeps()
+	query := func(token string) *evm.QueryFunTokenReservesResponse {
+		resp, err := deps.EvmKeeper.FunTokenReserves(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.QueryFunTokenReservesRequest{Token: token},
+		)
+		s.Require().NoError(err)
+		return resp
+	}
+
+	s.Run("mapping made from a coin", func() {
+		funtoken := evmtest.CreateFunTokenForBankCoin(deps, "unibi_reserves", &s.Suite)
+		s.Require().NoError(testapp.FundAccount(
+			deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+			sdk.NewCoins(sdk.NewInt64Coin(funtoken.BankDenom, 100)),
+		))
+		_, err := deps.EvmKeeper.ConvertCoinToEvm(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertCoinToEvm{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				BankCoin:  sdk.NewInt64Coin(funtoken.BankDenom, 40),
+				ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+			},
+		)
+		s.Require().NoError(err)
+
+		resp := query(funtoken.Erc20Addr.Hex())
+		s.Equal(funtoken, resp.FunToken)
+		s.Equal(sdkmath.NewInt(100), resp.BankSupply)
+		s.Equal(sdkmath.NewInt(40), resp.Erc20TotalSupply)
+		s.Equal(sdkmath.NewInt(40), resp.EscrowedAmount)
+		s.Equal(sdkmath.NewInt(40), resp.MintedAmount)
+	})
+
+	s.Run("mapping made from an ERC20", func() {
+		funtoken := s.deployFunTokenFromERC20(&deps, "TOKEN", 18, 1_000)
+		_, err := deps.EvmKeeper.ConvertEvmToCoin(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertEvmToCoin{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				Erc20Addr: funtoken.Erc20Addr,
+				Amount:    sdkmath.NewInt(60),
+				ToAddr:    deps.Sender.NibiruAddr.String(),
+			},
+		)
+		s.Require().NoError(err)
+
+		resp := query(funtoken.BankDenom)
+		s.Equal(funtoken, resp.FunToken)
+		s.Equal(sdkmath.NewInt(60), resp.BankSupply)
+		s.Equal(sdkmath.NewInt(1_000), resp.Erc20TotalSupply)
+		s.Equal(sdkmath.NewInt(60), resp.EscrowedAmount)
+		s.Equal(sdkmath.NewInt(60), resp.MintedAmount)
+	})
+
+	s.Run("sad: no token mapping", func() {
+		_, err := deps.EvmKeeper.FunTokenReserves(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.QueryFunTokenReservesRequest{Token: "unibi"},
+		)
+		s.Require().ErrorContains(err, "token mapping not found for unibi")
+	})
+}
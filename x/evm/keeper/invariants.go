@@ -0,0 +1,71 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// RegisterInvariants registers the invariants of the EVM module.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(evm.ModuleName, "funtoken-backing", FunTokenBackingInvariant(k))
+}
+
+// FunTokenBackingInvariant checks that every FunToken mapping is backed 1:1,
+// meaning the EVM module escrows at least as many tokens on the origin side of
+// the mapping as are minted on the other side. Mappings whose reserves can't be
+// queried also break the invariant.
+func FunTokenBackingInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		audit := k.AuditFunTokens(ctx)
+		broken := len(audit.UnderCollateralized) > 0 || len(audit.Failed) > 0
+
+		var msg strings.Builder
+		fmt.Fprintf(&msg, "checked %d FunToken mappings\n", audit.NumMappings)
+		for _, reserves := range audit.UnderCollateralized {
+			fmt.Fprintf(&msg,
+				"\tunder-collateralized: bank denom %s, ERC20 %s, escrowed %s, minted %s\n",
+				reserves.FunToken.BankDenom, reserves.FunToken.Erc20Addr,
+				reserves.EscrowedAmount, reserves.MintedAmount,
+			)
+		}
+		for _, failure := range audit.Failed {
+			fmt.Fprintf(&msg,
+				"\tfailed to query reserves: bank denom %s, ERC20 %s: %s\n",
+				failure.FunToken.BankDenom, failure.FunToken.Erc20Addr, failure.Error,
+			)
+		}
+		return sdk.FormatInvariant(evm.ModuleName, "funtoken-backing", msg.String()), broken
+	}
+}
+
+// AuditFunTokens computes the reserves of every FunToken mapping and reports
+// the under-collateralized mappings, where the amount escrowed by the EVM
+// module is less than the amount it minted.
+func (k *Keeper) AuditFunTokens(ctx sdk.Context) evm.QueryFunTokenAuditResponse {
+	funtokens := k.FunTokens.Iterate(ctx, collections.Range[[]byte]{}).Values()
+	audit := evm.QueryFunTokenAuditResponse{
+		UnderCollateralized: []evm.QueryFunTokenReservesResponse{},
+		Failed:              []evm.FunTokenAuditFailure{},
+		NumMappings:         uint64(len(funtokens)),
+	}
+	for _, funtoken := range funtokens {
+		reserves, err := k.funTokenReserves(ctx, funtoken)
+		if err != nil {
+			audit.Failed = append(audit.Failed, evm.FunTokenAuditFailure{
+				FunToken: funtoken,
+				Error:    err.Error(),
+			})
+			continue
+		}
+		if reserves.EscrowedAmount.LT(reserves.MintedAmount) {
+			audit.UnderCollateralized = append(audit.UnderCollateralized, *reserves)
+		}
+	}
+	return audit
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+func (s *Suite) TestFunTokenBackingInvariant() {
+	deps := evmtest.NewTestDeps()
+	invariant := keeper.FunTokenBackingInvariant(deps.EvmKeeper)
+
+	coinFuntoken := evmtest.CreateFunTokenForBankCoin(deps, "unibi_audit", &s.Suite)
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(coinFuntoken.BankDenom, 100)),
+	))
+	_, err := deps.EvmKeeper.ConvertCoinToEvm(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			BankCoin:  sdk.NewInt64Coin(coinFuntoken.BankDenom, 40),
+			ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+		},
+	)
+	s.Require().NoError(err)
+
+	erc20Funtoken := s.deployFunTokenFromERC20(&deps, "TOKEN", 18, 1_000)
+	_, err = deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			Erc20Addr: erc20Funtoken.Erc20Addr,
+			Amount:    sdkmath.NewInt(60),
+			ToAddr:    deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+
+	s.Run("every mapping is backed", func() {
+		msg, broken := invariant(deps.Ctx)
+		s.False(broken, msg)
+
+		resp, err := deps.EvmKeeper.FunTokenAudit(
+			sdk.WrapSDKContext(deps.Ctx), &evm.QueryFunTokenAuditRequest{},
+		)
+		s.Require().NoError(err)
+		s.EqualValues(2, resp.NumMappings)
+		s.Empty(resp.UnderCollateralized)
+		s.Empty(resp.Failed)
+	})
+
+	s.Run("escrowed coins leave the EVM module", func() {
+		s.Require().NoError(deps.App.BankKeeper.SendCoinsFromModuleToAccount(
+			deps.Ctx, evm.ModuleName, deps.Sender.NibiruAddr,
+			sdk.NewCoins(sdk.NewInt64Coin(coinFuntoken.BankDenom, 1)),
+		))
+		msg, broken := invariant(deps.Ctx)
+		s.True(broken)
+		s.Contains(msg, "under-collateralized: bank denom unibi_audit")
+		s.Contains(msg, "escrowed 39, minted 40")
+
+		resp, err := deps.EvmKeeper.FunTokenAudit(
+			sdk.WrapSDKContext(deps.Ctx), &evm.QueryFunTokenAuditRequest{},
+		)
+		s.Require().NoError(err)
+		s.Require().Len(resp.UnderCollateralized, 1)
+		s.Equal(coinFuntoken, resp.UnderCollateralized[0].FunToken)
+	})
+
+	s.Run("mapping whose reserves can't be queried", func() {
+		brokenFuntoken := evm.NewFunToken(gethcommon.HexToAddress("0x404"), "erc20/broken", false)
+		s.Require().NoError(deps.EvmKeeper.FunTokens.SafeInsertFunToken(deps.Ctx, brokenFuntoken))
+
+		msg, broken := invariant(deps.Ctx)
+		s.True(broken)
+		s.Contains(msg, "failed to query reserves: bank denom erc20/broken")
+
+		resp, err := deps.EvmKeeper.FunTokenAudit(
+			sdk.WrapSDKContext(deps.Ctx), &evm.QueryFunTokenAuditRequest{},
+		)
+		s.Require().NoError(err)
+		s.EqualValues(3, resp.NumMappings)
+		s.Require().Len(resp.Failed, 1)
+		s.Equal(brokenFuntoken, resp.Failed[0].FunToken)
+	})
+}
@@ -39,6 +39,7 @@ type Keeper struct {
 	Bank          *NibiruBankKeeper
 	accountKeeper evm.AccountKeeper
 	stakingKeeper evm.StakingKeeper
+	sudoKeeper    evm.SudoKeeper
 
 	// tracer: Configures the output type for a geth `vm.EVMLogger`. Tracer types
 	// include "access_list", "json", "struct", and "markdown". If any other
@@ -55,6 +56,7 @@ func NewKeeper(
 	accKeeper evm.AccountKeeper,
 	bankKeeper *NibiruBankKeeper,
 	stakingKeeper evm.StakingKeeper,
+	sudoKeeper evm.SudoKeeper,
 	tracer string,
 ) Keeper {
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -71,6 +73,7 @@ func NewKeeper(
 		accountKeeper: accKeeper,
 		Bank:          bankKeeper,
 		stakingKeeper: stakingKeeper,
+		sudoKeeper:    sudoKeeper,
 		tracer:        tracer,
 	}
 }
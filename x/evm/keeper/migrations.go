@@ -0,0 +1,25 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+)
+
+// Migrator is a struct for handling in-place store migrations of the EVM
+// module.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 installs every predeploy on chains that launched before the
+// predeploys were part of the EVM genesis.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.DeployPredeploys(ctx, embeds.PredeployNames())
+}
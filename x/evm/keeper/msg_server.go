@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -137,10 +138,18 @@ func (k *Keeper) NewEVM(
 		Time:        evm.ParseBlockTimeUnixU64(ctx),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		BaseFee:     evmCfg.BaseFeeWei,
-		Random:      &pseudoRandom,
+		// Nibiru has no blob transactions, so the BLOBBASEFEE op code
+		// returns zero.
+		BlobBaseFee: big.NewInt(0),
+		// PREVRANDAO returns a hash of the block time and the last commit hash.
+		// It is known to the block proposer in advance and must not be used as
+		// a source of randomness that needs to be unpredictable.
+		Random: &pseudoRandom,
 	}
 
 	txCtx := core.NewEVMTxContext(&msg)
+	// Without blob transactions, the BLOBHASH op code always returns zero.
+	txCtx.BlobHashes = nil
 	if tracer == nil {
 		// Return a default tracer (*[tracing.Hooks]) based on current keeper state
 		tracer = evm.NewTracer(k.tracer, msg, evmCfg.ChainConfig, ctx.BlockHeight())
@@ -357,7 +366,7 @@ func (k *Keeper) ApplyEvmMsg(
 		msg.From,                // sender
 		evmObj.Context.Coinbase, // coinbase
 		msg.To,
-		evm.PRECOMPILE_ADDRS,
+		evm.PrecompileAddrs(rules),
 		msg.AccessList, // accessList
 	)
 
@@ -549,6 +558,9 @@ func (k *Keeper) ConvertCoinToEvm(
 	}
 
 	fungibleTokenMapping := funTokens[0]
+	if err := fungibleTokenMapping.AssertEnabled(); err != nil {
+		return nil, err
+	}
 
 	if fungibleTokenMapping.IsMadeFromCoin {
 		return k.convertCoinToEvmBornCoin(
@@ -753,6 +765,120 @@ func (k Keeper) convertCoinToEvmBornERC20(
 	return &evm.MsgConvertCoinToEvmResponse{}, nil
 }
 
+// ConvertEvmToCoin Sends ERC20 tokens with a valid "FunToken" mapping from the
+// EVM address of the sender to the given recipient address ("to_addr") in the
+// corresponding Bank Coin representation. It performs the same conversion as
+// "sendToBank" in the FunToken precompile for senders that sign Cosmos txs.
+func (k *Keeper) ConvertEvmToCoin(
+	goCtx context.Context, msg *evm.MsgConvertEvmToCoin,
+) (resp *evm.MsgConvertEvmToCoinResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	senderEthAddr := eth.NibiruAddrToEthAddr(sender)
+	erc20Addr := msg.Erc20Addr.Address
+
+	funTokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20Addr))
+	if len(funTokens) != 1 {
+		return nil, fmt.Errorf("no FunToken mapping exists for ERC20 \"%s\"", erc20Addr.Hex())
+	}
+	funToken := funTokens[0]
+	if err := funToken.AssertEnabled(); err != nil {
+		return nil, err
+	}
+
+	toAddr, err := evm.ParseFunTokenRecipient(msg.ToAddr)
+	if err != nil {
+		return nil, fmt.Errorf("recipient address invalid (%s): %w", msg.ToAddr, err)
+	}
+
+	// needs to run first to populate the StateDB on the BankKeeperExtension
+	stateDB := k.Bank.StateDB
+	if stateDB == nil {
+		stateDB = k.NewStateDB(ctx, k.TxConfig(ctx, gethcommon.Hash{}))
+	}
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &erc20Addr,
+		From:             senderEthAddr,
+		Nonce:            k.GetAccNonce(ctx, senderEthAddr),
+		Value:            unusedBigInt, // amount
+		GasLimit:         Erc20GasLimitExecute,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             []byte{},
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+
+	// 1 | Sender transfers the ERC20 tokens to the EVM module account
+	gotAmount, evmResp, err := k.ERC20().Transfer(
+		erc20Addr,
+		senderEthAddr,
+		evm.EVM_MODULE_ADDRESS,
+		msg.Amount.BigInt(),
+		ctx,
+		evmObj,
+	)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to transfer ERC-20 tokens to the EVM module")
+	}
+	logs := evmResp.Logs
+
+	// 2 | Burn the ERC20 tokens if the EVM module owns the contract. Otherwise,
+	// they stay escrowed in the EVM module and the Bank Coins are minted.
+	coin := sdk.NewCoin(funToken.BankDenom, sdkmath.NewIntFromBigInt(gotAmount))
+	if funToken.IsMadeFromCoin {
+		burnResp, err := k.ERC20().Burn(erc20Addr, evm.EVM_MODULE_ADDRESS, gotAmount, ctx, evmObj)
+		if err != nil {
+			return nil, sdkioerrors.Wrap(err, "failed to burn ERC-20 tokens")
+		}
+		logs = append(logs, burnResp.Logs...)
+	} else {
+		if err := k.Bank.MintCoins(ctx, evm.ModuleName, sdk.NewCoins(coin)); err != nil {
+			return nil, sdkioerrors.Wrap(err, "failed to mint coins")
+		}
+	}
+
+	// 3 | Send the Bank Coins to the recipient
+	if err := k.Bank.SendCoinsFromModuleToAccount(
+		ctx, evm.ModuleName, eth.EthAddrToNibiruAddr(toAddr), sdk.NewCoins(coin),
+	); err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to send coins to the recipient")
+	}
+
+	// Commit the stateDB to the BankKeeperExtension because we don't go through
+	// ApplyEvmMsg at all in this tx.
+	if err := stateDB.Commit(); err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to commit stateDB")
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventConvertEvmToCoin{
+		Sender:               sender.String(),
+		Erc20ContractAddress: erc20Addr.Hex(),
+		ToAddr:               eth.EthAddrToNibiruAddr(toAddr).String(),
+		BankCoin:             coin,
+	})
+
+	// Emit tx logs of the Transfer and Burn events
+	evmResp.Logs = logs
+	err = ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: logs})
+	if err == nil {
+		k.updateBlockBloom(ctx, evmResp, uint64(k.EvmState.BlockTxIndex.GetOr(ctx, 0)))
+	}
+
+	return &evm.MsgConvertEvmToCoinResponse{BankCoin: coin}, nil
+}
+
 // EmitEthereumTxEvents emits all types of EVM events applicable to a particular execution case
 func (k *Keeper) EmitEthereumTxEvents(
 	ctx sdk.Context,
@@ -18,6 +18,19 @@ func (k *Keeper) UpdateParams(
 		return nil, sdkioerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Once the Cancun hard fork is active, moving or disabling it would
+	// rewrite the rules under which past blocks were executed.
+	cancunTime := k.GetParams(ctx).CancunTime
+	if cancunTime != 0 && cancunTime <= evm.ParseBlockTimeUnixU64(ctx) &&
+		req.Params.CancunTime != cancunTime {
+		return nil, sdkioerrors.Wrapf(
+			evm.ErrInvalidState,
+			"cancun_time is immutable once active: cannot change it from %d to %d",
+			cancunTime, req.Params.CancunTime,
+		)
+	}
+
 	err = k.SetParams(ctx, req.Params)
 	if err != nil {
 		return nil, sdkioerrors.Wrapf(err, "failed to set params")
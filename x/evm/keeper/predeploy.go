@@ -0,0 +1,52 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
+)
+
+// DeployPredeploys sets the runtime bytecode of each of the named
+// [embeds.Predeploys] at its address with a nonce of 1, like a contract
+// created by a transaction (EIP-161). An address that already holds the same
+// code, for example from the genesis accounts, is left untouched. Returns an
+// error if it holds different code.
+func (k *Keeper) DeployPredeploys(ctx sdk.Context, names []string) error {
+	for _, name := range names {
+		predeploy, found := embeds.PredeployByName(name)
+		if !found {
+			return fmt.Errorf("unknown predeploy %s", name)
+		}
+		codeHash := crypto.Keccak256Hash(predeploy.Code)
+		acc := k.GetAccount(ctx, predeploy.Address)
+		if acc.IsContract() {
+			if !bytes.Equal(acc.CodeHash, codeHash.Bytes()) {
+				return fmt.Errorf(
+					"predeploy %s: address %s holds code with hash %s, expected %s",
+					name, predeploy.Address.Hex(), gethcommon.BytesToHash(acc.CodeHash).Hex(), codeHash.Hex(),
+				)
+			}
+			continue
+		}
+		if acc == nil {
+			acc = statedb.NewEmptyAccount()
+		}
+
+		k.SetCode(ctx, codeHash.Bytes(), predeploy.Code)
+		acc.CodeHash = codeHash.Bytes()
+		if acc.Nonce == 0 {
+			acc.Nonce = 1
+		}
+		if err := k.SetAccount(ctx, predeploy.Address, *acc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
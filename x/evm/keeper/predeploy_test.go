@@ -0,0 +1,91 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
+)
+
+// TestPredeploy_DeterministicDeploymentProxy: The predeployed CREATE2 factory
+// deploys init code to the address that tools like Foundry precompute.
+func (s *Suite) TestPredeploy_DeterministicDeploymentProxy() {
+	deps := evmtest.NewTestDeps()
+	s.Len(embeds.DeterministicDeploymentProxyCode, 69)
+
+	// Init code of a contract whose runtime code returns 42 for any call.
+	initCode := hexutil.MustDecode("0x600a600c600039600a6000f3602a60005260206000f3")
+	salt := gethcommon.HexToHash("0x5a17")
+	wantAddr := crypto.CreateAddress2(
+		embeds.ADDR_DETERMINISTIC_DEPLOYMENT_PROXY, salt, crypto.Keccak256(initCode),
+	)
+
+	resp, err := deps.EvmKeeper.CallContractFromAccount(
+		deps.Ctx, deps.Sender.EthAddr, embeds.ADDR_DETERMINISTIC_DEPLOYMENT_PROXY,
+		append(salt.Bytes(), initCode...), 200_000,
+	)
+	s.Require().NoError(err)
+	s.Require().Empty(resp.VmError)
+	s.Equal(wantAddr.Bytes(), resp.Ret)
+
+	evmObj, _ := deps.NewEVM()
+	callResp, err := deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx, evmObj, deps.Sender.EthAddr, &wantAddr, false, nil, 100_000,
+	)
+	s.Require().NoError(err)
+	s.Equal(gethcommon.BigToHash(big.NewInt(42)).Bytes(), callResp.Ret)
+
+	s.Run("same salt and init code fails", func() {
+		_, err := deps.EvmKeeper.CallContractFromAccount(
+			deps.Ctx, deps.Sender.EthAddr, embeds.ADDR_DETERMINISTIC_DEPLOYMENT_PROXY,
+			append(salt.Bytes(), initCode...), 200_000,
+		)
+		s.Require().Error(err)
+	})
+}
+
+// TestMigrate1to2: Chains that launched before the predeploys get them from
+// the EVM module migration, which keeps the code of existing contracts.
+func (s *Suite) TestMigrate1to2() {
+	deps := evmtest.NewTestDeps()
+	s.Require().NoError(deps.EvmKeeper.DeleteAccount(deps.Ctx, embeds.ADDR_DETERMINISTIC_DEPLOYMENT_PROXY))
+	s.False(deps.EvmKeeper.GetAccount(deps.Ctx, embeds.ADDR_DETERMINISTIC_DEPLOYMENT_PROXY).IsContract())
+
+	s.Require().NoError(keeper.NewMigrator(deps.EvmKeeper).Migrate1to2(deps.Ctx))
+	for _, predeploy := range embeds.Predeploys() {
+		acc := deps.EvmKeeper.GetAccount(deps.Ctx, predeploy.Address)
+		s.Require().True(acc.IsContract(), predeploy.Name)
+		s.Equal(uint64(1), acc.Nonce, predeploy.Name)
+		s.Equal(
+			predeploy.Code,
+			deps.EvmKeeper.GetCode(deps.Ctx, gethcommon.BytesToHash(acc.CodeHash)),
+		)
+	}
+}
+
+// TestDeployPredeploys_OtherCode: Predeploying to an address that holds
+// different code fails instead of keeping the other code.
+func (s *Suite) TestDeployPredeploys_OtherCode() {
+	deps := evmtest.NewTestDeps()
+	otherCode := []byte{0x60, 0x00, 0x60, 0x00, 0xf3}
+	s.Require().NoError(deps.EvmKeeper.SetAccount(
+		deps.Ctx, embeds.ADDR_DETERMINISTIC_DEPLOYMENT_PROXY, statedb.Account{
+			BalanceNative: new(uint256.Int),
+			Nonce:         1,
+			CodeHash:      crypto.Keccak256(otherCode),
+		},
+	))
+	deps.EvmKeeper.SetCode(deps.Ctx, crypto.Keccak256(otherCode), otherCode)
+
+	err := deps.EvmKeeper.DeployPredeploys(deps.Ctx, []string{"DeterministicDeploymentProxy"})
+	s.Require().ErrorContains(err, "predeploy DeterministicDeploymentProxy: address "+
+		embeds.ADDR_DETERMINISTIC_DEPLOYMENT_PROXY.Hex()+" holds code with hash")
+}
@@ -24,6 +24,14 @@ func (s *Suite) TestRandom() {
 	s.Require().NotNil(random1)
 	s.Require().NotZero(random1.Int64())
 
+	// PREVRANDAO is deterministic within a block
+	evmObj, _ = deps.NewEVM()
+	random1Again, err := deps.EvmKeeper.ERC20().LoadERC20BigInt(
+		deps.Ctx, evmObj, embeds.SmartContract_TestRandom.ABI, randomContractAddr, "getRandom",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(random1, random1Again)
+
 	// Update block time to check that random changes
 	deps.Ctx = deps.Ctx.WithBlockTime(deps.Ctx.BlockTime().Add(1 * time.Second))
 	evmObj, _ = deps.NewEVM()
@@ -8,14 +8,14 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
-	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
 )
 
 func (k *Keeper) GetEVMConfig(ctx sdk.Context) statedb.EVMConfig {
+	params := k.GetParams(ctx)
 	return statedb.EVMConfig{
-		Params:        k.GetParams(ctx),
-		ChainConfig:   evm.EthereumConfig(appconst.GetEthChainID(ctx.ChainID())),
+		Params:        params,
+		ChainConfig:   params.EthereumConfig(appconst.GetEthChainID(ctx.ChainID())),
 		BlockCoinbase: k.GetCoinbaseAddress(ctx),
 		BaseFeeWei:    k.BaseFeeWeiPerGas(ctx),
 	}
@@ -36,6 +36,11 @@ func (k *Keeper) TxConfig(
 // VMConfig creates an EVM configuration from the debug setting and the extra
 // EIPs enabled on the module parameters. The config generated uses the default
 // JumpTable from the EVM.
+//
+// TODO: feat(evm): governance params for the contract code size limit
+// (24 KiB, EIP-170) and the init code size limit (48 KiB, EIP-3860). Both are
+// constants of go-ethereum that "vm.Config" can't override, so they need a
+// hook in the Nibiru fork of go-ethereum before they can be EVM params.
 func (k Keeper) VMConfig(
 	ctx sdk.Context, cfg *statedb.EVMConfig, tracer *tracing.Hooks,
 ) vm.Config {
@@ -520,3 +520,119 @@ func (m *MsgConvertCoinToEvm) ValidateBasic() error {
 func (m MsgConvertCoinToEvm) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgConvertEvmToCoin message.
+func (m MsgConvertEvmToCoin) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgConvertEvmToCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender addr")
+	}
+	if m.Erc20Addr.Size() == 0 || m.Erc20Addr.Address == (common.Address{}) {
+		return fmt.Errorf("empty erc20_addr")
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive")
+	}
+	if _, err := ParseFunTokenRecipient(m.ToAddr); err != nil {
+		return fmt.Errorf("invalid to_addr: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgConvertEvmToCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSyncFunTokenMetadata message.
+func (m MsgSyncFunTokenMetadata) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSyncFunTokenMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender addr")
+	}
+	if m.Erc20Addr.Size() == 0 || m.Erc20Addr.Address == (common.Address{}) {
+		return fmt.Errorf("empty erc20_addr")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSyncFunTokenMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetFunTokenDisabled message.
+func (m MsgSetFunTokenDisabled) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetFunTokenDisabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender addr")
+	}
+	if m.Erc20Addr.Size() == 0 || m.Erc20Addr.Address == (common.Address{}) {
+		return fmt.Errorf("empty erc20_addr")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetFunTokenDisabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgMigrateFunToken message.
+func (m MsgMigrateFunToken) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgMigrateFunToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender addr")
+	}
+	if m.Erc20Addr.Size() == 0 || m.Erc20Addr.Address == (common.Address{}) {
+		return fmt.Errorf("empty erc20_addr")
+	}
+	if m.NewErc20Addr.Size() == 0 || m.NewErc20Addr.Address == (common.Address{}) {
+		return fmt.Errorf("empty new_erc20_addr")
+	}
+	if m.NewErc20Addr.Address == m.Erc20Addr.Address {
+		return fmt.Errorf("new_erc20_addr must differ from erc20_addr")
+	}
+	if _, err := ParseFunTokenRecipient(m.OldErc20Recipient); err != nil {
+		return fmt.Errorf("invalid old_erc20_recipient: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgMigrateFunToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ParseFunTokenRecipient parses the recipient of a FunToken conversion, which
+// is either a hexadecimal Ethereum address or a bech32 "nibi" address.
+func ParseFunTokenRecipient(toStr string) (common.Address, error) {
+	if err := eth.ValidateAddress(toStr); err == nil {
+		return common.HexToAddress(toStr), nil
+	}
+	nibiAddr, err := sdk.AccAddressFromBech32(toStr)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid bech32 or hex address: %w", err)
+	}
+	return eth.NibiruAddrToEthAddr(nibiAddr), nil
+}
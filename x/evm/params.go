@@ -9,7 +9,10 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/vm"
+	gethparams "github.com/ethereum/go-ethereum/params"
 	"golang.org/x/exp/slices"
 
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
@@ -28,9 +31,13 @@ const (
 func DefaultParams() Params {
 	return Params{
 		ExtraEIPs: []int64{},
-		// EVMChannels: Unused but intended for use with future IBC functionality
+		// EVMChannels: Channels on which incoming ICS-20 transfers can be
+		// converted to FunToken ERC20s. See evmmodule.IBCMiddleware.
 		EVMChannels:       []string{},
 		CreateFuntokenFee: sdkmath.NewIntWithDecimal(10_000, 6), // 10_000 NIBI
+		// AllowedUnprotectedTxHashes: Transactions without EIP-155 replay
+		// protection are rejected unless governance allows them one by one.
+		AllowedUnprotectedTxHashes: []string{},
 	}
 }
 
@@ -58,7 +65,28 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateChannels(p.EVMChannels)
+	if err := validateChannels(p.EVMChannels); err != nil {
+		return err
+	}
+
+	if err := validateTxHashes(p.AllowedUnprotectedTxHashes); err != nil {
+		return err
+	}
+
+	if p.MaxTxGas != 0 && p.MaxTxGas < gethparams.TxGas {
+		return fmt.Errorf("max tx gas %d is lower than the intrinsic gas of a tx (%d)", p.MaxTxGas, gethparams.TxGas)
+	}
+	return nil
+}
+
+// TxGasCap returns the most gas that a single Ethereum tx may use: the lower
+// of the block gas limit and MaxTxGas. A zero value for either is treated as
+// no limit.
+func (p Params) TxGasCap(blockGasLimit uint64) uint64 {
+	if p.MaxTxGas != 0 && (blockGasLimit == 0 || p.MaxTxGas < blockGasLimit) {
+		return p.MaxTxGas
+	}
+	return blockGasLimit
 }
 
 // EIPs returns the ExtraEIPS as a int slice
@@ -76,6 +104,42 @@ func (p Params) IsEVMChannel(channel string) bool {
 	return slices.Contains(p.EVMChannels, channel)
 }
 
+// IsUnprotectedTxAllowed returns true if the transaction with the given hash
+// may execute without EIP-155 replay protection.
+func (p Params) IsUnprotectedTxAllowed(txHash gethcommon.Hash) bool {
+	for _, allowedHash := range p.AllowedUnprotectedTxHashes {
+		if gethcommon.HexToHash(allowedHash) == txHash {
+			return true
+		}
+	}
+	return false
+}
+
+// validateTxHashes checks that each of the hashes is a hex-encoded 32 byte
+// transaction hash and that none is repeated.
+func validateTxHashes(txHashes []string) error {
+	seenHashes := make(map[gethcommon.Hash]struct{})
+	for _, txHash := range txHashes {
+		hashBz, err := hexutil.Decode(txHash)
+		if err != nil {
+			return fmt.Errorf("invalid tx hash %s: %w", txHash, err)
+		}
+		if len(hashBz) != gethcommon.HashLength {
+			return fmt.Errorf(
+				"invalid tx hash %s: expected %d bytes, got %d", txHash, gethcommon.HashLength, len(hashBz),
+			)
+		}
+
+		hash := gethcommon.BytesToHash(hashBz)
+		if _, ok := seenHashes[hash]; ok {
+			return fmt.Errorf("found duplicate tx hash: %s", txHash)
+		}
+		seenHashes[hash] = struct{}{}
+	}
+
+	return nil
+}
+
 func validateEIPs(i any) error {
 	eips, ok := i.([]int64)
 	if !ok {
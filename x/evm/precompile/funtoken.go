@@ -173,6 +173,9 @@ func (p precompileFunToken) sendToBank(
 		return
 	}
 	funtoken := funtokens[0]
+	if err := funtoken.AssertEnabled(); err != nil {
+		return nil, err
+	}
 
 	// Amount should be positive
 	if amount == nil || amount.Cmp(big.NewInt(0)) != 1 {
@@ -180,7 +183,7 @@ func (p precompileFunToken) sendToBank(
 	}
 
 	// The "to" argument must be a valid nibi or EVM address
-	toAddr, err := parseToAddr(to)
+	toAddr, err := evm.ParseFunTokenRecipient(to)
 	if err != nil {
 		return nil, fmt.Errorf("recipient address invalid (%s): %w", to, err)
 	}
@@ -570,13 +573,16 @@ func (p precompileFunToken) sendToEvm(
 		return nil, fmt.Errorf("no funtoken found for bank denom \"%s\"", bankDenom)
 	}
 	funtoken := funtokens[0]
+	if err := funtoken.AssertEnabled(); err != nil {
+		return nil, err
+	}
 
 	if amount == nil || amount.Sign() != 1 {
 		return nil, fmt.Errorf("transfer amount must be positive")
 	}
 
 	// parse the `to` argument as hex or bech32 address
-	toEthAddr, err := parseToAddr(toStr)
+	toEthAddr, err := evm.ParseFunTokenRecipient(toStr)
 	if err != nil {
 		return nil, fmt.Errorf("recipient address invalid: %w", err)
 	}
@@ -681,21 +687,6 @@ func parseArgsSendToEvm(args []any) (bankDenom string, amount *big.Int, to strin
 	return
 }
 
-// parse a user-specified address "toStr" that might be bech32 or hex
-func parseToAddr(toStr string) (gethcommon.Address, error) {
-	// check if bech32 or hex
-	if err := eth.ValidateAddress(toStr); err == nil {
-		// hex address
-		return gethcommon.HexToAddress(toStr), nil
-	}
-	// else try bech32
-	nibAddr, e := sdk.AccAddressFromBech32(toStr)
-	if e != nil {
-		return gethcommon.Address{}, fmt.Errorf("invalid bech32 or hex address: %w", e)
-	}
-	return eth.NibiruAddrToEthAddr(nibAddr), nil
-}
-
 func (p precompileFunToken) bankMsgSend(
 	startResult OnRunStartResult,
 	caller gethcommon.Address,
@@ -713,7 +704,7 @@ func (p precompileFunToken) bankMsgSend(
 	}
 
 	// parse toStr (bech32 or hex)
-	toEthAddr, e := parseToAddr(toStr)
+	toEthAddr, e := evm.ParseFunTokenRecipient(toStr)
 	if e != nil {
 		return nil, e
 	}
@@ -2,7 +2,9 @@ package precompile
 
 import (
 	"fmt"
+	"math"
 	"math/big"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
@@ -35,6 +37,7 @@ func (p precompileOracle) ABI() *gethabi.ABI {
 
 const (
 	OracleMethod_queryExchangeRate        PrecompileMethod = "queryExchangeRate"
+	OracleMethod_queryExchangeRateEma     PrecompileMethod = "queryExchangeRateEma"
 	OracleMethod_chainLinkLatestRoundData PrecompileMethod = "chainLinkLatestRoundData"
 )
 
@@ -62,6 +65,8 @@ func (p precompileOracle) Run(
 	switch PrecompileMethod(method.Name) {
 	case OracleMethod_queryExchangeRate:
 		bz, err = p.queryExchangeRate(ctx, method, args)
+	case OracleMethod_queryExchangeRateEma:
+		bz, err = p.queryExchangeRateEma(ctx, method, args)
 	// For "@chainlink/contracts/src/v0.8/shared/interfaces/AggregatorV3Interface.sol"
 	case OracleMethod_chainLinkLatestRoundData:
 		bz, err = p.chainLinkLatestRoundData(ctx, method, args)
@@ -104,6 +109,9 @@ type precompileOracle struct {
 //	    view
 //	    returns (uint256 price, uint64 blockTimeMs, uint64 blockHeight);
 //	```
+//
+// Reverts if the pair is halted by its circuit breaker or if its price is
+// older than the max age of the pair.
 func (p precompileOracle) queryExchangeRate(
 	ctx sdk.Context,
 	method *gethabi.Method,
@@ -118,7 +126,7 @@ func (p precompileOracle) queryExchangeRate(
 		return nil, err
 	}
 
-	priceAtBlock, err := p.oracleKeeper.ExchangeRates.Get(ctx, assetPair)
+	priceAtBlock, err := p.oracleKeeper.GetExchangeRateChecked(ctx, assetPair)
 	if err != nil {
 		return nil, err
 	}
@@ -148,6 +156,65 @@ func (p precompileOracle) parseQueryExchangeRateArgs(args []any) (
 	return pair, nil
 }
 
+// maxHalfLifeSeconds is the largest half-life in seconds that fits in a
+// time.Duration.
+const maxHalfLifeSeconds = uint64(math.MaxInt64 / int64(time.Second))
+
+// Implements "IOracle.queryExchangeRateEma"
+//
+//	```solidity
+//	function queryExchangeRateEma(
+//	    string memory pair,
+//	    uint64 halfLifeSeconds
+//	)
+//	    external
+//	    view
+//	    returns (uint256 price, uint64 blockTimeMs);
+//	```
+//
+// Reverts under the same conditions as "queryExchangeRate", and when
+// "halfLifeSeconds" is too large to be a duration.
+func (p precompileOracle) queryExchangeRateEma(
+	ctx sdk.Context,
+	method *gethabi.Method,
+	args []any,
+) (bz []byte, err error) {
+	if e := assertNumArgs(args, 2); e != nil {
+		return nil, e
+	}
+	pair, ok := args[0].(string)
+	if !ok {
+		return nil, ErrArgTypeValidation("string pair", args[0])
+	}
+	halfLifeSeconds, ok := args[1].(uint64)
+	if !ok {
+		return nil, ErrArgTypeValidation("uint64 halfLifeSeconds", args[1])
+	}
+	// Larger values overflow time.Duration and could wrap around to a
+	// configured half-life.
+	if halfLifeSeconds > maxHalfLifeSeconds {
+		return nil, fmt.Errorf(
+			"halfLifeSeconds %d is out of range, the maximum is %d", halfLifeSeconds, maxHalfLifeSeconds,
+		)
+	}
+	assetPair, err := asset.TryNewPair(pair)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.oracleKeeper.GetExchangeRateChecked(ctx, assetPair); err != nil {
+		return nil, err
+	}
+	ema, timestampMs, err := p.oracleKeeper.GetExchangeRateEma(
+		ctx, assetPair, time.Duration(halfLifeSeconds)*time.Second,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(ema.BigInt(), uint64(timestampMs))
+}
+
 // Implements "IOracle.chainLinkLatestRoundData"
 //
 //	```solidity
@@ -181,7 +248,7 @@ func (p precompileOracle) chainLinkLatestRoundData(
 		return nil, err
 	}
 
-	priceAtBlock, err := p.oracleKeeper.ExchangeRates.Get(ctx, assetPair)
+	priceAtBlock, err := p.oracleKeeper.GetExchangeRateChecked(ctx, assetPair)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package precompile_test
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"testing"
 	"time"
@@ -10,10 +11,12 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+	oracletypes "github.com/NibiruChain/nibiru/v2/x/oracle/types"
 )
 
 const OracleGasLimitQuery = 100_000
@@ -162,6 +165,103 @@ func (s *OracleSuite) TestOracle_HappyPath() {
 	}
 }
 
+func (s *OracleSuite) TestOracle_CircuitBreaker() {
+	deps := evmtest.NewTestDeps()
+	pair := asset.Pair("unibi:uusd")
+	runQuery := func() error {
+		contractInput, err := embeds.SmartContract_Oracle.ABI.Pack(
+			string(precompile.OracleMethod_queryExchangeRate), pair.String(),
+		)
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		_, err = deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx,
+			evmObj,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_Oracle,
+			false,
+			contractInput,
+			OracleGasLimitQuery,
+		)
+		return err
+	}
+
+	deps.Ctx = deps.Ctx.WithBlockHeight(69)
+	deps.App.OracleKeeper.SetPrice(deps.Ctx, pair, sdk.MustNewDecFromStr("0.067"))
+	deps.App.OracleKeeper.SetPairAggregationConfig(deps.Ctx, oracletypes.PairAggregationConfig{
+		Pair:         pair,
+		MaxAgeBlocks: 10,
+	})
+
+	s.T().Log("Prices older than the max age revert")
+	deps.Ctx = deps.Ctx.WithBlockHeight(79)
+	s.NoError(runQuery())
+	deps.Ctx = deps.Ctx.WithBlockHeight(80)
+	s.ErrorContains(runQuery(), "stale exchange rate")
+
+	s.T().Log("Halted pairs revert")
+	deps.Ctx = deps.Ctx.WithBlockHeight(69)
+	deps.App.OracleKeeper.PairStatuses.Insert(deps.Ctx, pair, oracletypes.PairStatus{
+		Pair:          pair,
+		Halted:        true,
+		LastGoodPrice: sdk.MustNewDecFromStr("0.05"),
+	})
+	s.ErrorContains(runQuery(), "pair halted by circuit breaker")
+}
+
+func (s *OracleSuite) TestOracle_QueryExchangeRateEma() {
+	deps := evmtest.NewTestDeps()
+	pair := asset.Pair("unibi:uusd")
+	runQuery := func(halfLifeSeconds uint64) ([]byte, error) {
+		contractInput, err := embeds.SmartContract_Oracle.ABI.Pack(
+			string(precompile.OracleMethod_queryExchangeRateEma), pair.String(), halfLifeSeconds,
+		)
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		resp, err := deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx,
+			evmObj,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_Oracle,
+			false,
+			contractInput,
+			OracleGasLimitQuery,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Ret, nil
+	}
+
+	blockTime := time.Unix(69, 0)
+	deps.Ctx = deps.Ctx.WithBlockTime(blockTime).WithBlockHeight(69)
+	deps.App.OracleKeeper.SetPrice(deps.Ctx, pair, sdk.NewDec(100))
+	deps.Ctx = deps.Ctx.WithBlockTime(blockTime.Add(time.Hour)).WithBlockHeight(70)
+	deps.App.OracleKeeper.SetPrice(deps.Ctx, pair, sdk.NewDec(200))
+
+	ret, err := runQuery(uint64(time.Hour.Seconds()))
+	s.Require().NoError(err)
+	vals, err := embeds.SmartContract_Oracle.ABI.Unpack(
+		string(precompile.OracleMethod_queryExchangeRateEma), ret,
+	)
+	s.Require().NoError(err)
+	s.Equal(sdk.NewDec(150).BigInt().String(), vals[0].(*big.Int).String())
+	s.Equal(uint64(blockTime.Add(time.Hour).UnixMilli()), vals[1].(uint64))
+
+	s.T().Log("Half-lives outside the params revert")
+	_, err = runQuery(1)
+	s.ErrorContains(err, "no moving average with half-life")
+
+	s.T().Log("Half-lives that overflow a duration revert")
+	for _, halfLifeSeconds := range []uint64{
+		uint64(math.MaxInt64/int64(time.Second)) + 1,
+		math.MaxUint64,
+	} {
+		_, err = runQuery(halfLifeSeconds)
+		s.ErrorContains(err, "is out of range")
+	}
+}
+
 type OracleSuite struct {
 	suite.Suite
 }
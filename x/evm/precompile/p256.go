@@ -0,0 +1,71 @@
+package precompile
+
+import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/secp256r1"
+)
+
+// PrecompileAddr_P256Verify is the address of the "P256VERIFY" precompile from
+// RIP-7212, which verifies signatures on the secp256r1 (P-256) curve used by
+// WebAuthn passkeys and secure enclaves.
+var PrecompileAddr_P256Verify = gethcommon.HexToAddress("0x0000000000000000000000000000000000000100")
+
+const (
+	// P256VerifyGas is the gas cost of a P256VERIFY call from RIP-7212.
+	P256VerifyGas uint64 = 3450
+
+	// p256VerifyInputLength is the length of the RIP-7212 input:
+	// hash (32) || r (32) || s (32) || x (32) || y (32).
+	p256VerifyInputLength = 160
+)
+
+// p256VerifySuccess is the 32 byte output of a valid signature, the number 1.
+var p256VerifySuccess = gethcommon.LeftPadBytes([]byte{1}, 32)
+
+func (p precompileP256Verify) Address() gethcommon.Address {
+	return PrecompileAddr_P256Verify
+}
+
+// RequiredGas is a flat fee, regardless of the input.
+func (p precompileP256Verify) RequiredGas(input []byte) (gasPrice uint64) {
+	return P256VerifyGas
+}
+
+// Run verifies the signature (r, s) of the message hash against the public key
+// (x, y). Following RIP-7212, it returns 32 bytes with the value 1 for a valid
+// signature and empty output otherwise, including for inputs that are not
+// exactly 160 bytes. It never returns an error, so calls don't revert.
+func (p precompileP256Verify) Run(
+	evm *vm.EVM,
+	trueCaller gethcommon.Address,
+	contract *vm.Contract,
+	readonly bool,
+	isDelegatedCall bool,
+) (bz []byte, err error) {
+	input := contract.Input
+	if len(input) != p256VerifyInputLength {
+		return nil, nil
+	}
+
+	hash := input[0:32]
+	r := new(big.Int).SetBytes(input[32:64])
+	s := new(big.Int).SetBytes(input[64:96])
+	x := new(big.Int).SetBytes(input[96:128])
+	y := new(big.Int).SetBytes(input[128:160])
+	if !secp256r1.Verify(hash, r, s, x, y) {
+		return nil, nil
+	}
+	return p256VerifySuccess, nil
+}
+
+func PrecompileP256Verify(_ keepers.PublicKeepers) NibiruCustomPrecompile {
+	return precompileP256Verify{}
+}
+
+// precompileP256Verify implements the stateless "P256VERIFY" precompile.
+type precompileP256Verify struct{}
@@ -0,0 +1,123 @@
+package precompile_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+type P256Suite struct {
+	suite.Suite
+}
+
+func TestP256Suite(t *testing.T) {
+	suite.Run(t, new(P256Suite))
+}
+
+// p256VerifyInput signs a message with a new P-256 key and returns the 160 byte
+// RIP-7212 input: hash || r || s || x || y.
+func (s *P256Suite) p256VerifyInput(msg string) []byte {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	hash := sha256.Sum256([]byte(msg))
+	r, sig, err := ecdsa.Sign(rand.Reader, privKey, hash[:])
+	s.Require().NoError(err)
+
+	input := make([]byte, 0, 160)
+	input = append(input, hash[:]...)
+	for _, word := range [][]byte{r.Bytes(), sig.Bytes(), privKey.X.Bytes(), privKey.Y.Bytes()} {
+		input = append(input, gethcommon.LeftPadBytes(word, 32)...)
+	}
+	return input
+}
+
+func (s *P256Suite) TestP256Verify_Precompile() {
+	deps := evmtest.NewTestDeps()
+	validInput := s.p256VerifyInput("passkey login")
+	wrongHashInput := append([]byte{}, validInput...)
+	wrongHashInput[0] ^= 0xff
+
+	for _, tc := range []struct {
+		name    string
+		input   []byte
+		wantOut []byte
+	}{
+		{
+			name:    "valid signature",
+			input:   validInput,
+			wantOut: gethcommon.LeftPadBytes([]byte{1}, 32),
+		},
+		{
+			name:  "signature over another hash",
+			input: wrongHashInput,
+		},
+		{
+			name:  "input too short",
+			input: validInput[:159],
+		},
+		{
+			name:  "public key not on the curve",
+			input: append(append([]byte{}, validInput[:128]...), make([]byte, 32)...),
+		},
+	} {
+		s.Run(tc.name, func() {
+			evmObj, _ := deps.NewEVM()
+			resp, err := deps.EvmKeeper.CallContractWithInput(
+				deps.Ctx,
+				evmObj,
+				deps.Sender.EthAddr,
+				&precompile.PrecompileAddr_P256Verify,
+				false,
+				tc.input,
+				OracleGasLimitQuery,
+			)
+			s.Require().NoError(err, "P256VERIFY never reverts")
+			s.Equal(tc.wantOut, resp.Ret)
+			s.GreaterOrEqual(resp.GasUsed, precompile.P256VerifyGas)
+		})
+	}
+}
+
+func (s *P256Suite) TestP256Verify_FromContract() {
+	deps := evmtest.NewTestDeps()
+	deployResp, err := evmtest.DeployContract(&deps, embeds.SmartContract_TestP256Verify)
+	s.Require().NoError(err)
+
+	verify := func(input []byte) bool {
+		var args []any
+		for i := 0; i < 160; i += 32 {
+			args = append(args, [32]byte(input[i:i+32]))
+		}
+		contractInput, err := embeds.SmartContract_TestP256Verify.ABI.Pack("verify", args...)
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		resp, err := deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx,
+			evmObj,
+			deps.Sender.EthAddr,
+			&deployResp.ContractAddr,
+			false,
+			contractInput,
+			OracleGasLimitQuery,
+		)
+		s.Require().NoError(err)
+		out, err := embeds.SmartContract_TestP256Verify.ABI.Unpack("verify", resp.Ret)
+		s.Require().NoError(err)
+		return out[0].(bool)
+	}
+
+	input := s.p256VerifyInput("passkey login")
+	s.True(verify(input))
+
+	input[63] ^= 0x01 // tamper with r
+	s.False(verify(input))
+}
@@ -8,6 +8,7 @@
 // Key components:
 //   - InitPrecompiles: Initializes and returns a map of precompiled contracts.
 //   - PrecompileFunToken: Implements the FunToken precompile for ERC20-to-bank transfers.
+//   - PrecompileP256Verify: Implements RIP-7212 secp256r1 signature verification.
 //
 // The package also provides utility functions for working with precompiles, such
 // as "ABIMethodByID" and "OnRunStart" for common precompile execution setup.
@@ -45,6 +46,7 @@ func InitPrecompiles(
 		PrecompileFunToken,
 		PrecompileWasm,
 		PrecompileOracle,
+		PrecompileP256Verify,
 	} {
 		pc := precompileSetupFn(k)
 		for _, precompileMap := range []map[gethcommon.Address]vm.PrecompiledContract{
@@ -52,8 +54,8 @@ func InitPrecompiles(
 			vm.PrecompiledContractsByzantium,
 			vm.PrecompiledContractsIstanbul,
 			vm.PrecompiledContractsBerlin,
+			vm.PrecompiledContractsCancun,
 			// Below precompiles omitted intentionally.
-			// vm.PrecompiledContractsCancun,
 			// vm.PrecompiledContractsBLS,
 		} {
 			precompileMap[pc.Address()] = pc
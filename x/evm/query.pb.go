@@ -36,6 +36,40 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FunTokenOrigin filters FunToken mappings by the side they were created from.
+type FunTokenOrigin int32
+
+const (
+	// FUNTOKEN_ORIGIN_UNSPECIFIED: no filter, includes every mapping.
+	FunTokenOrigin_FUNTOKEN_ORIGIN_UNSPECIFIED FunTokenOrigin = 0
+	// FUNTOKEN_ORIGIN_COIN: mappings created from a bank coin
+	// ("is_made_from_coin" is true).
+	FunTokenOrigin_FUNTOKEN_ORIGIN_COIN FunTokenOrigin = 1
+	// FUNTOKEN_ORIGIN_ERC20: mappings created from an ERC20 contract
+	// ("is_made_from_coin" is false).
+	FunTokenOrigin_FUNTOKEN_ORIGIN_ERC20 FunTokenOrigin = 2
+)
+
+var FunTokenOrigin_name = map[int32]string{
+	0: "FUNTOKEN_ORIGIN_UNSPECIFIED",
+	1: "FUNTOKEN_ORIGIN_COIN",
+	2: "FUNTOKEN_ORIGIN_ERC20",
+}
+
+var FunTokenOrigin_value = map[string]int32{
+	"FUNTOKEN_ORIGIN_UNSPECIFIED": 0,
+	"FUNTOKEN_ORIGIN_COIN":        1,
+	"FUNTOKEN_ORIGIN_ERC20":       2,
+}
+
+func (x FunTokenOrigin) String() string {
+	return proto.EnumName(FunTokenOrigin_name, int32(x))
+}
+
+func (FunTokenOrigin) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{0}
+}
+
 // QueryEthAccountRequest is the request type for the Query/Account RPC method.
 type QueryEthAccountRequest struct {
 	// address is the Ethereum hex address or nibi Bech32 address to query the account for.
@@ -1316,7 +1350,316 @@ func (m *QueryFunTokenMappingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryFunTokenMappingResponse proto.InternalMessageInfo
 
+type QueryFunTokenMappingsRequest struct {
+	// origin optionally filters the mappings by the side they were created from.
+	Origin FunTokenOrigin `protobuf:"varint,1,opt,name=origin,proto3,enum=eth.evm.v1.FunTokenOrigin" json:"origin,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFunTokenMappingsRequest) Reset()         { *m = QueryFunTokenMappingsRequest{} }
+func (m *QueryFunTokenMappingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenMappingsRequest) ProtoMessage()    {}
+func (*QueryFunTokenMappingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{24}
+}
+func (m *QueryFunTokenMappingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenMappingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenMappingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenMappingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenMappingsRequest.Merge(m, src)
+}
+func (m *QueryFunTokenMappingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenMappingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenMappingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenMappingsRequest proto.InternalMessageInfo
+
+type QueryFunTokenMappingsResponse struct {
+	FunTokens []FunToken `protobuf:"bytes,1,rep,name=fun_tokens,json=funTokens,proto3" json:"fun_tokens"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFunTokenMappingsResponse) Reset()         { *m = QueryFunTokenMappingsResponse{} }
+func (m *QueryFunTokenMappingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenMappingsResponse) ProtoMessage()    {}
+func (*QueryFunTokenMappingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{25}
+}
+func (m *QueryFunTokenMappingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenMappingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenMappingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenMappingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenMappingsResponse.Merge(m, src)
+}
+func (m *QueryFunTokenMappingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenMappingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenMappingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenMappingsResponse proto.InternalMessageInfo
+
+type QueryFunTokenReservesRequest struct {
+	// Either the hexadecimal-encoded ERC20 contract address or denomination of the
+	// Bank Coin.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryFunTokenReservesRequest) Reset()         { *m = QueryFunTokenReservesRequest{} }
+func (m *QueryFunTokenReservesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenReservesRequest) ProtoMessage()    {}
+func (*QueryFunTokenReservesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{26}
+}
+func (m *QueryFunTokenReservesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenReservesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenReservesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenReservesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenReservesRequest.Merge(m, src)
+}
+func (m *QueryFunTokenReservesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenReservesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenReservesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenReservesRequest proto.InternalMessageInfo
+
+// QueryFunTokenReservesResponse: Supply on each side of a FunToken mapping.
+//
+// For a mapping made from a coin, the EVM module escrows the coins and mints
+// the ERC20, so "escrowed_amount" is the EVM module's bank balance and
+// "minted_amount" is the ERC20 "totalSupply".
+//
+// For a mapping made from an ERC20, the EVM module escrows the ERC20 and mints
+// the coins, so "escrowed_amount" is the ERC20 balance of the EVM module and
+// "minted_amount" is the bank supply.
+//
+// The mapping is fully backed when "escrowed_amount" is at least
+// "minted_amount".
+type QueryFunTokenReservesResponse struct {
+	FunToken FunToken `protobuf:"bytes,1,opt,name=fun_token,json=funToken,proto3" json:"fun_token"`
+	// bank_supply: Total supply of the bank coin.
+	BankSupply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=bank_supply,json=bankSupply,proto3,customtype=cosmossdk.io/math.Int" json:"bank_supply"`
+	// erc20_total_supply: "totalSupply" of the ERC20 contract.
+	Erc20TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=erc20_total_supply,json=erc20TotalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"erc20_total_supply"`
+	// escrowed_amount: Tokens of the origin side held by the EVM module.
+	EscrowedAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=escrowed_amount,json=escrowedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"escrowed_amount"`
+	// minted_amount: Tokens in circulation on the side the EVM module mints.
+	MintedAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=minted_amount,json=mintedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"minted_amount"`
+}
+
+func (m *QueryFunTokenReservesResponse) Reset()         { *m = QueryFunTokenReservesResponse{} }
+func (m *QueryFunTokenReservesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenReservesResponse) ProtoMessage()    {}
+func (*QueryFunTokenReservesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{27}
+}
+func (m *QueryFunTokenReservesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenReservesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenReservesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenReservesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenReservesResponse.Merge(m, src)
+}
+func (m *QueryFunTokenReservesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenReservesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenReservesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenReservesResponse proto.InternalMessageInfo
+
+type QueryFunTokenAuditRequest struct {
+}
+
+func (m *QueryFunTokenAuditRequest) Reset()         { *m = QueryFunTokenAuditRequest{} }
+func (m *QueryFunTokenAuditRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenAuditRequest) ProtoMessage()    {}
+func (*QueryFunTokenAuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{28}
+}
+func (m *QueryFunTokenAuditRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenAuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenAuditRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenAuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenAuditRequest.Merge(m, src)
+}
+func (m *QueryFunTokenAuditRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenAuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenAuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenAuditRequest proto.InternalMessageInfo
+
+type QueryFunTokenAuditResponse struct {
+	// under_collateralized: Reserves of the mappings where the EVM module escrows
+	// less than the minted amount.
+	UnderCollateralized []QueryFunTokenReservesResponse `protobuf:"bytes,1,rep,name=under_collateralized,json=underCollateralized,proto3" json:"under_collateralized"`
+	// failed: Mappings whose reserves could not be queried, for example because
+	// a call to the ERC20 contract reverted.
+	Failed []FunTokenAuditFailure `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed"`
+	// num_mappings: Number of FunToken mappings that were checked.
+	NumMappings uint64 `protobuf:"varint,3,opt,name=num_mappings,json=numMappings,proto3" json:"num_mappings,omitempty"`
+}
+
+func (m *QueryFunTokenAuditResponse) Reset()         { *m = QueryFunTokenAuditResponse{} }
+func (m *QueryFunTokenAuditResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenAuditResponse) ProtoMessage()    {}
+func (*QueryFunTokenAuditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{29}
+}
+func (m *QueryFunTokenAuditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenAuditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenAuditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenAuditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenAuditResponse.Merge(m, src)
+}
+func (m *QueryFunTokenAuditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenAuditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenAuditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenAuditResponse proto.InternalMessageInfo
+
+// FunTokenAuditFailure: A FunToken mapping whose reserves could not be queried.
+type FunTokenAuditFailure struct {
+	FunToken FunToken `protobuf:"bytes,1,opt,name=fun_token,json=funToken,proto3" json:"fun_token"`
+	Error    string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *FunTokenAuditFailure) Reset()         { *m = FunTokenAuditFailure{} }
+func (m *FunTokenAuditFailure) String() string { return proto.CompactTextString(m) }
+func (*FunTokenAuditFailure) ProtoMessage()    {}
+func (*FunTokenAuditFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{30}
+}
+func (m *FunTokenAuditFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunTokenAuditFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunTokenAuditFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunTokenAuditFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunTokenAuditFailure.Merge(m, src)
+}
+func (m *FunTokenAuditFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunTokenAuditFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunTokenAuditFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunTokenAuditFailure proto.InternalMessageInfo
+
+func (m *FunTokenAuditFailure) GetFunToken() FunToken {
+	if m != nil {
+		return m.FunToken
+	}
+	return FunToken{}
+}
+
+func (m *FunTokenAuditFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("eth.evm.v1.FunTokenOrigin", FunTokenOrigin_name, FunTokenOrigin_value)
 	proto.RegisterType((*QueryEthAccountRequest)(nil), "eth.evm.v1.QueryEthAccountRequest")
 	proto.RegisterType((*QueryEthAccountResponse)(nil), "eth.evm.v1.QueryEthAccountResponse")
 	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "eth.evm.v1.QueryValidatorAccountRequest")
@@ -1341,112 +1684,147 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "eth.evm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryFunTokenMappingRequest)(nil), "eth.evm.v1.QueryFunTokenMappingRequest")
 	proto.RegisterType((*QueryFunTokenMappingResponse)(nil), "eth.evm.v1.QueryFunTokenMappingResponse")
+	proto.RegisterType((*QueryFunTokenMappingsRequest)(nil), "eth.evm.v1.QueryFunTokenMappingsRequest")
+	proto.RegisterType((*QueryFunTokenMappingsResponse)(nil), "eth.evm.v1.QueryFunTokenMappingsResponse")
+	proto.RegisterType((*QueryFunTokenReservesRequest)(nil), "eth.evm.v1.QueryFunTokenReservesRequest")
+	proto.RegisterType((*QueryFunTokenReservesResponse)(nil), "eth.evm.v1.QueryFunTokenReservesResponse")
+	proto.RegisterType((*QueryFunTokenAuditRequest)(nil), "eth.evm.v1.QueryFunTokenAuditRequest")
+	proto.RegisterType((*QueryFunTokenAuditResponse)(nil), "eth.evm.v1.QueryFunTokenAuditResponse")
+	proto.RegisterType((*FunTokenAuditFailure)(nil), "eth.evm.v1.FunTokenAuditFailure")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/query.proto", fileDescriptor_ffa36cdc5add14ed) }
 
 var fileDescriptor_ffa36cdc5add14ed = []byte{
-	// 1592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0x5b, 0xc5,
-	0x16, 0x8f, 0x63, 0x27, 0x76, 0x8e, 0xd3, 0x24, 0x6f, 0x9a, 0x36, 0x89, 0x93, 0xd8, 0xc9, 0xcd,
-	0x7b, 0x49, 0xda, 0xd7, 0xde, 0xfb, 0xe2, 0x3e, 0xbd, 0xa7, 0x57, 0xbd, 0x0a, 0xea, 0x28, 0x0d,
-	0xa5, 0x7f, 0xd4, 0x9a, 0x00, 0x12, 0x08, 0x59, 0x63, 0x7b, 0x72, 0x7d, 0x15, 0xfb, 0x8e, 0xeb,
-	0x19, 0xbb, 0x0e, 0x25, 0x1b, 0xba, 0x41, 0x42, 0x95, 0x2a, 0xf1, 0x05, 0xba, 0xe2, 0x23, 0xc0,
-	0x57, 0xe8, 0x8e, 0x4a, 0x6c, 0x10, 0x8b, 0x82, 0x5a, 0x16, 0xac, 0x59, 0xb2, 0x42, 0xf3, 0xcf,
-	0xbe, 0xb6, 0xaf, 0x13, 0xaa, 0xc2, 0x8e, 0xd5, 0x9d, 0x39, 0x73, 0xfe, 0xfc, 0xe6, 0xcc, 0xb9,
-	0xe7, 0xfc, 0xe0, 0x2c, 0xe1, 0x15, 0x87, 0xb4, 0x6a, 0x4e, 0x6b, 0xcb, 0xb9, 0xd7, 0x24, 0x8d,
-	0x43, 0xbb, 0xde, 0xa0, 0x9c, 0x22, 0x20, 0xbc, 0x62, 0x93, 0x56, 0xcd, 0x6e, 0x6d, 0xa5, 0xce,
-	0x97, 0x28, 0xab, 0x51, 0xe6, 0x14, 0x31, 0x23, 0x4a, 0xc9, 0x69, 0x6d, 0x15, 0x09, 0xc7, 0x5b,
-	0x4e, 0x1d, 0xbb, 0x9e, 0x8f, 0xb9, 0x47, 0x7d, 0x65, 0x97, 0x9a, 0x0d, 0xf8, 0x13, 0xe6, 0x4a,
-	0x7a, 0x3a, 0x20, 0xe5, 0x6d, 0xa3, 0xea, 0x52, 0x97, 0xca, 0xa5, 0x23, 0x56, 0x5a, 0xba, 0xe4,
-	0x52, 0xea, 0x56, 0x89, 0x83, 0xeb, 0x9e, 0x83, 0x7d, 0x9f, 0x72, 0xe9, 0x9d, 0xe9, 0xd3, 0x8c,
-	0x3e, 0x95, 0xbb, 0x62, 0x73, 0xdf, 0xe1, 0x5e, 0x8d, 0x30, 0x8e, 0x6b, 0x75, 0xa5, 0x60, 0xfd,
-	0x1f, 0xce, 0xde, 0x15, 0x08, 0x77, 0x78, 0xe5, 0x6a, 0xa9, 0x44, 0x9b, 0x3e, 0xcf, 0x93, 0x7b,
-	0x4d, 0xc2, 0x38, 0x9a, 0x87, 0x38, 0x2e, 0x97, 0x1b, 0x84, 0xb1, 0xf9, 0xc8, 0x4a, 0x64, 0x73,
-	0x22, 0x6f, 0xb6, 0x97, 0x13, 0x9f, 0x3d, 0xc9, 0x8c, 0xfc, 0xfc, 0x24, 0x33, 0x62, 0x7d, 0x13,
-	0x81, 0xb9, 0x01, 0x73, 0x56, 0xa7, 0x3e, 0x23, 0xc2, 0xbe, 0x88, 0xab, 0xd8, 0x2f, 0x11, 0x63,
-	0xaf, 0xb7, 0x28, 0x03, 0x49, 0xbd, 0x2c, 0xdc, 0x27, 0xde, 0xfc, 0xa8, 0x3c, 0x05, 0x2d, 0x7a,
-	0x9f, 0x78, 0x68, 0x11, 0x26, 0x4a, 0xb4, 0x4c, 0x0a, 0x15, 0xcc, 0x2a, 0xf3, 0x51, 0x79, 0x9c,
-	0x10, 0x82, 0xb7, 0x30, 0xab, 0xa0, 0x59, 0x18, 0xf3, 0xa9, 0xf0, 0x1a, 0x5b, 0x89, 0x6c, 0xc6,
-	0xf2, 0x6a, 0x23, 0x7c, 0x12, 0x5e, 0x29, 0x18, 0xc4, 0x63, 0xca, 0x27, 0xe1, 0x95, 0xab, 0x4a,
-	0x82, 0xfe, 0x01, 0x53, 0x45, 0x52, 0xaa, 0x5c, 0xca, 0x76, 0x74, 0xc6, 0xa5, 0xce, 0x29, 0x25,
-	0xd5, 0x6a, 0xd6, 0x0d, 0x58, 0x92, 0x17, 0x7a, 0x0f, 0x57, 0xbd, 0x32, 0xe6, 0xb4, 0xd1, 0x97,
-	0x95, 0x55, 0x98, 0x2c, 0x51, 0x9f, 0x15, 0x7a, 0x53, 0x93, 0x14, 0xb2, 0xab, 0x03, 0xe9, 0xf9,
-	0x3c, 0x02, 0xcb, 0x43, 0xbc, 0xe9, 0x24, 0x6d, 0xc0, 0x34, 0x56, 0xa2, 0x3e, 0x8f, 0x53, 0x5a,
-	0x6c, 0xe0, 0xa7, 0x20, 0xc1, 0x04, 0x04, 0x71, 0xf1, 0x51, 0x79, 0xf1, 0xce, 0x5e, 0x5c, 0xcd,
-	0x38, 0xf1, 0x9b, 0xb5, 0x22, 0x69, 0xc8, 0x9c, 0xc5, 0xf2, 0xa7, 0xb4, 0xf4, 0xb6, 0x14, 0x5a,
-	0xff, 0x83, 0xd3, 0x12, 0x4c, 0x4e, 0x25, 0xfa, 0x55, 0xde, 0xf9, 0x2e, 0xcc, 0xf6, 0x9a, 0xbe,
-	0xf6, 0x1b, 0x5b, 0x37, 0x34, 0x9a, 0x77, 0x38, 0x6d, 0x60, 0xf7, 0x64, 0x34, 0x68, 0x06, 0xa2,
-	0x07, 0xe4, 0x50, 0x7b, 0x12, 0xcb, 0x00, 0xbe, 0x0b, 0x1a, 0x5f, 0xc7, 0x99, 0xc6, 0x37, 0x0b,
-	0x63, 0x2d, 0x5c, 0x6d, 0x1a, 0x74, 0x6a, 0x63, 0xfd, 0x07, 0x66, 0xa4, 0xf6, 0x36, 0x2d, 0xbf,
-	0x52, 0x16, 0x36, 0xe0, 0x6f, 0x01, 0x3b, 0x1d, 0x02, 0x41, 0x4c, 0x94, 0xa6, 0xb4, 0x9a, 0xcc,
-	0xcb, 0xb5, 0xf5, 0x31, 0x20, 0xa9, 0xb8, 0xd7, 0xbe, 0x49, 0x5d, 0x66, 0x42, 0x20, 0x88, 0xc9,
-	0x82, 0x56, 0xfe, 0xe5, 0x1a, 0x5d, 0x03, 0xe8, 0xb6, 0x04, 0x79, 0xb7, 0x64, 0x76, 0xdd, 0x56,
-	0xfd, 0xc3, 0x16, 0xfd, 0xc3, 0x56, 0x4d, 0x46, 0xf7, 0x0f, 0xfb, 0x4e, 0x37, 0x55, 0xf9, 0x80,
-	0x65, 0x00, 0xe4, 0xc3, 0x88, 0x4e, 0xac, 0x09, 0xae, 0x71, 0xae, 0x41, 0xac, 0x4a, 0x5d, 0x71,
-	0xbb, 0xe8, 0x66, 0x32, 0x3b, 0x6d, 0x77, 0xfb, 0x95, 0x7d, 0x93, 0xba, 0x79, 0x79, 0x88, 0x76,
-	0x43, 0xe0, 0x6c, 0x9c, 0x08, 0x47, 0x45, 0x08, 0xe2, 0xb1, 0x66, 0x75, 0x06, 0xee, 0xe0, 0x06,
-	0xae, 0x99, 0x0c, 0x58, 0xbb, 0x1a, 0x9a, 0x91, 0x6a, 0x68, 0xff, 0x82, 0xf1, 0xba, 0x94, 0xc8,
-	0xd4, 0x24, 0xb3, 0x28, 0x08, 0x4e, 0xe9, 0xe6, 0x62, 0x4f, 0x9f, 0x67, 0x46, 0xf2, 0x5a, 0xcf,
-	0xfa, 0x3a, 0x02, 0x53, 0x3b, 0xbc, 0xb2, 0x8d, 0xab, 0xd5, 0x40, 0x76, 0x71, 0xc3, 0x65, 0xe6,
-	0x1d, 0xc4, 0x1a, 0xcd, 0x41, 0xdc, 0xc5, 0xac, 0x50, 0xc2, 0x75, 0xfd, 0xcf, 0x8c, 0xbb, 0x98,
-	0x6d, 0xe3, 0x3a, 0xfa, 0x08, 0x66, 0xea, 0x0d, 0x5a, 0xa7, 0x8c, 0x34, 0x3a, 0xff, 0x9d, 0xf8,
-	0x67, 0x26, 0x73, 0xd9, 0x5f, 0x9f, 0x67, 0x6c, 0xd7, 0xe3, 0x95, 0x66, 0xd1, 0x2e, 0xd1, 0x9a,
-	0xa3, 0x5b, 0xb9, 0xfa, 0x5c, 0x64, 0xe5, 0x03, 0x87, 0x1f, 0xd6, 0x09, 0xb3, 0xb7, 0xbb, 0x3f,
-	0x7c, 0x7e, 0xda, 0xf8, 0x32, 0x3f, 0xeb, 0x02, 0x24, 0x4a, 0x15, 0xec, 0xf9, 0x05, 0xaf, 0x2c,
-	0xbb, 0x54, 0x34, 0x1f, 0x97, 0xfb, 0xeb, 0x65, 0x6b, 0x03, 0x4e, 0xef, 0x30, 0xee, 0xd5, 0x30,
-	0x27, 0xbb, 0xb8, 0x9b, 0x82, 0x19, 0x88, 0xba, 0x58, 0x81, 0x8f, 0xe5, 0xc5, 0xd2, 0xfa, 0x25,
-	0x6a, 0xde, 0xb1, 0x81, 0x4b, 0x64, 0xaf, 0x6d, 0xee, 0xf9, 0x4f, 0x88, 0xd6, 0x98, 0xab, 0x33,
-	0xb5, 0x10, 0xcc, 0xd4, 0x2d, 0xe6, 0xee, 0xf0, 0x0a, 0x69, 0x90, 0x66, 0x6d, 0xaf, 0x9d, 0x17,
-	0x5a, 0xe8, 0x32, 0x4c, 0x72, 0x61, 0x5e, 0x28, 0x51, 0x7f, 0xdf, 0x73, 0xe5, 0x1d, 0x93, 0xd9,
-	0xb9, 0xa0, 0x95, 0x74, 0xbf, 0x2d, 0x8f, 0xf3, 0x49, 0xde, 0xdd, 0xa0, 0x2b, 0x30, 0x59, 0x6f,
-	0x90, 0x32, 0x29, 0x11, 0xc6, 0x68, 0x83, 0xcd, 0xc7, 0x64, 0xe1, 0x1c, 0x13, 0xb1, 0x47, 0x5d,
-	0x34, 0xca, 0x62, 0x95, 0x96, 0x0e, 0x4c, 0x4b, 0x1a, 0x93, 0x79, 0x48, 0x4a, 0x99, 0x6a, 0x48,
-	0x68, 0x19, 0x40, 0xa9, 0xc8, 0xdf, 0x42, 0xb5, 0xe3, 0x09, 0x29, 0x91, 0x8d, 0x7e, 0xdb, 0x1c,
-	0x8b, 0x99, 0x35, 0x1f, 0x97, 0xd0, 0x53, 0xb6, 0x1a, 0x68, 0xb6, 0x19, 0x68, 0xf6, 0x9e, 0x19,
-	0x68, 0xb9, 0x84, 0x28, 0x91, 0xc7, 0x3f, 0x64, 0x22, 0xda, 0x89, 0x38, 0x09, 0x7d, 0xe9, 0xc4,
-	0x9f, 0xf3, 0xd2, 0x13, 0x3d, 0x2f, 0x8d, 0x2c, 0x38, 0xa5, 0xe0, 0xd7, 0x70, 0xbb, 0x20, 0x1e,
-	0x17, 0x02, 0x19, 0xb8, 0x85, 0xdb, 0xbb, 0x98, 0xbd, 0x1d, 0x4b, 0x8c, 0xce, 0x44, 0xf3, 0x09,
-	0xde, 0x2e, 0x78, 0x7e, 0x99, 0xb4, 0xad, 0xf3, 0xba, 0x8f, 0x75, 0xde, 0xbc, 0xdb, 0x64, 0xca,
-	0x98, 0x63, 0x53, 0xdc, 0x62, 0x6d, 0x7d, 0x19, 0xd5, 0xa3, 0x5b, 0x2a, 0xe7, 0x84, 0xd7, 0x40,
-	0x8d, 0xf0, 0xb6, 0xf9, 0xd5, 0x8f, 0xab, 0x11, 0xde, 0x66, 0xaf, 0x55, 0x23, 0x7f, 0x3d, 0xf2,
-	0xc9, 0x8f, 0x6c, 0x5d, 0xd4, 0x1c, 0x29, 0xf8, 0x4e, 0xc7, 0xbc, 0xeb, 0x99, 0xce, 0x98, 0x66,
-	0xe4, 0x1a, 0x31, 0xdd, 0xde, 0x7a, 0x14, 0xe9, 0xcc, 0x60, 0x2d, 0xd7, 0x3e, 0xfe, 0x0d, 0x09,
-	0xd1, 0x99, 0x0b, 0xfb, 0x44, 0x8f, 0xb9, 0xdc, 0xc2, 0xf7, 0xcf, 0x33, 0x67, 0xd4, 0x15, 0x59,
-	0xf9, 0xc0, 0xf6, 0xa8, 0x53, 0xc3, 0xbc, 0x62, 0x5f, 0xf7, 0xb9, 0x98, 0xcf, 0xd2, 0x1a, 0xbd,
-	0x01, 0x53, 0xc6, 0xaa, 0xd0, 0xf4, 0xbd, 0xa2, 0x1e, 0xd1, 0xc7, 0xd9, 0x4e, 0x6a, 0xdb, 0x77,
-	0x85, 0xba, 0x75, 0x05, 0x16, 0x25, 0x9c, 0x6b, 0x4d, 0x7f, 0x8f, 0x1e, 0x10, 0xff, 0x16, 0xae,
-	0xd7, 0x3d, 0xdf, 0x35, 0x25, 0x38, 0x0b, 0x63, 0x5c, 0x88, 0xcd, 0xe4, 0x95, 0x9b, 0xc0, 0x98,
-	0xfa, 0x50, 0xf3, 0xac, 0x01, 0x73, 0x7d, 0xab, 0x2d, 0x98, 0xd8, 0x6f, 0xfa, 0x85, 0xae, 0x8f,
-	0x64, 0x76, 0x36, 0x58, 0x92, 0xc6, 0x2e, 0x9f, 0xd8, 0xd7, 0xab, 0xae, 0xf3, 0xec, 0x57, 0x93,
-	0x30, 0x26, 0xbd, 0xa3, 0x87, 0x11, 0x80, 0x2e, 0x37, 0x45, 0x56, 0xd0, 0x45, 0x38, 0xef, 0x4d,
-	0xad, 0x1d, 0xab, 0xa3, 0xe0, 0x59, 0x17, 0x3e, 0xfd, 0xf6, 0xa7, 0x2f, 0x46, 0xd7, 0xd1, 0xdf,
-	0x1d, 0x91, 0x8c, 0x46, 0xb3, 0x43, 0xe1, 0x05, 0x07, 0x55, 0xba, 0xce, 0x03, 0x5d, 0x8a, 0x47,
-	0xe8, 0x49, 0x04, 0x66, 0xfa, 0x29, 0x20, 0xda, 0x1c, 0x88, 0x33, 0x84, 0x73, 0xa6, 0xce, 0xfd,
-	0x0e, 0x4d, 0x8d, 0xeb, 0xbf, 0x12, 0xd7, 0x16, 0x72, 0xfa, 0x70, 0xb5, 0x8c, 0x41, 0x17, 0x5d,
-	0x90, 0xc6, 0x1e, 0xa1, 0xfb, 0x10, 0xcf, 0x19, 0xea, 0x36, 0x10, 0xae, 0x97, 0x31, 0xa6, 0x56,
-	0x86, 0x2b, 0x68, 0x18, 0xe7, 0x24, 0x8c, 0x35, 0xb4, 0xda, 0x07, 0x43, 0xf3, 0x3f, 0x16, 0xc8,
-	0xcd, 0x27, 0x10, 0xd7, 0xac, 0x2d, 0x24, 0x70, 0x2f, 0x39, 0x0c, 0x09, 0xdc, 0x47, 0xf8, 0x2c,
-	0x5b, 0x06, 0xde, 0x44, 0xeb, 0x7d, 0x81, 0x99, 0xd2, 0xeb, 0xc6, 0x75, 0x1e, 0x1c, 0x90, 0xc3,
-	0x23, 0x74, 0x00, 0x31, 0xc1, 0xe6, 0xd0, 0xd2, 0x80, 0xe7, 0x00, 0x39, 0x4c, 0x2d, 0x0f, 0x39,
-	0xd5, 0x41, 0xd7, 0x65, 0xd0, 0x15, 0x94, 0xee, 0x0b, 0x2a, 0xb8, 0x60, 0xf0, 0xaa, 0x15, 0x18,
-	0x57, 0x6c, 0x06, 0xa5, 0x07, 0x1c, 0xf6, 0x10, 0xa5, 0x54, 0x66, 0xe8, 0xb9, 0x0e, 0xb9, 0x2c,
-	0x43, 0xce, 0xa1, 0x33, 0x7d, 0x21, 0x15, 0x3f, 0x42, 0x1e, 0xc4, 0x35, 0x3d, 0x42, 0xa9, 0xa0,
-	0xab, 0x5e, 0xce, 0x94, 0x5a, 0x1d, 0x3e, 0x1a, 0x4c, 0xa0, 0x8c, 0x0c, 0xb4, 0x80, 0xe6, 0x42,
-	0x0a, 0xbd, 0x24, 0xfc, 0x53, 0x48, 0x06, 0x08, 0xcd, 0xb1, 0xe1, 0x7a, 0x6e, 0x15, 0xc2, 0x82,
-	0xac, 0x35, 0x19, 0x6c, 0x19, 0x2d, 0xf6, 0x07, 0xd3, 0xba, 0xa2, 0xc3, 0xa2, 0x1a, 0xc4, 0xf5,
-	0x78, 0x0c, 0x29, 0x98, 0x5e, 0xb2, 0x14, 0x52, 0x30, 0x7d, 0x93, 0x75, 0xe8, 0xfd, 0xd4, 0x48,
-	0xe4, 0x6d, 0x74, 0x08, 0xd0, 0x6d, 0xdc, 0x21, 0x0d, 0x64, 0x60, 0xfa, 0x86, 0x34, 0x90, 0xc1,
-	0xce, 0x6f, 0x59, 0x32, 0xee, 0x12, 0x4a, 0x85, 0xc6, 0x95, 0xe3, 0x03, 0xdd, 0x83, 0x09, 0x35,
-	0x79, 0x45, 0x9e, 0xff, 0x80, 0xbb, 0xae, 0xca, 0x98, 0x8b, 0x68, 0x21, 0x34, 0xa6, 0x7c, 0xcd,
-	0x9a, 0x68, 0x03, 0x6a, 0x42, 0x84, 0xb5, 0x81, 0xe0, 0x44, 0x0a, 0x6d, 0x03, 0x3d, 0xa3, 0x69,
-	0x68, 0x72, 0xcd, 0xe4, 0x41, 0x8f, 0x22, 0x30, 0xdd, 0x37, 0x01, 0xd0, 0xc6, 0x80, 0xdb, 0xf0,
-	0x11, 0x93, 0xda, 0x3c, 0x59, 0x51, 0xe3, 0xd8, 0x90, 0x38, 0x56, 0x51, 0xa6, 0x0f, 0xc7, 0x7e,
-	0xd3, 0x97, 0x03, 0xc6, 0x79, 0x20, 0x3f, 0x47, 0xb9, 0x37, 0x9f, 0xbe, 0x48, 0x47, 0x9e, 0xbd,
-	0x48, 0x47, 0x7e, 0x7c, 0x91, 0x8e, 0x3c, 0x7e, 0x99, 0x1e, 0x79, 0xf6, 0x32, 0x3d, 0xf2, 0xdd,
-	0xcb, 0xf4, 0xc8, 0x07, 0xeb, 0x01, 0x12, 0x71, 0x5b, 0x3a, 0xd9, 0x16, 0x14, 0xc0, 0x38, 0x6c,
-	0x65, 0x9d, 0xb6, 0xf0, 0x5a, 0x1c, 0x97, 0x9c, 0xe5, 0xd2, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xe6, 0x31, 0x27, 0xb5, 0x28, 0x12, 0x00, 0x00,
+	// 2033 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xc7, 0x4e, 0xe2, 0x3c, 0xe7, 0xc3, 0x54, 0x3c, 0x13, 0xa7, 0xf3, 0x61, 0xa7, 0xc3,
+	0x24, 0x99, 0x61, 0xd7, 0x9e, 0x78, 0x11, 0xab, 0x5d, 0xb1, 0x03, 0xb1, 0x49, 0x42, 0x98, 0x9d,
+	0xcc, 0x6c, 0x4f, 0x06, 0x24, 0x10, 0x6a, 0x95, 0xed, 0x4a, 0xbb, 0x15, 0x77, 0xb7, 0xa7, 0xab,
+	0x3b, 0xe3, 0xec, 0x30, 0x17, 0x56, 0x48, 0x20, 0xb4, 0xd2, 0x4a, 0x9c, 0xb8, 0xcd, 0x01, 0x71,
+	0xe3, 0xcc, 0xbf, 0xb0, 0x37, 0x56, 0xe2, 0x82, 0x38, 0x0c, 0x68, 0x06, 0x21, 0x2e, 0x5c, 0x38,
+	0xc2, 0x05, 0x55, 0x75, 0x95, 0xdd, 0xed, 0x8f, 0x24, 0xcb, 0xee, 0xdc, 0x38, 0xb9, 0xab, 0xea,
+	0xbd, 0xdf, 0xfb, 0xd5, 0x7b, 0x55, 0xaf, 0xde, 0x33, 0x5c, 0x27, 0x7e, 0xb3, 0x44, 0xce, 0xec,
+	0xd2, 0xd9, 0x4e, 0xe9, 0x71, 0x40, 0xbc, 0xf3, 0x62, 0xdb, 0x73, 0x7d, 0x17, 0x01, 0xf1, 0x9b,
+	0x45, 0x72, 0x66, 0x17, 0xcf, 0x76, 0xd4, 0x5b, 0x75, 0x97, 0xda, 0x2e, 0x2d, 0xd5, 0x30, 0x25,
+	0xa1, 0x50, 0xe9, 0x6c, 0xa7, 0x46, 0x7c, 0xbc, 0x53, 0x6a, 0x63, 0xd3, 0x72, 0xb0, 0x6f, 0xb9,
+	0x4e, 0xa8, 0xa7, 0x66, 0x23, 0x78, 0x4c, 0x3d, 0x9c, 0x5d, 0x88, 0xcc, 0xfa, 0x1d, 0x29, 0x6a,
+	0xba, 0xa6, 0xcb, 0x3f, 0x4b, 0xec, 0x4b, 0xcc, 0xae, 0x98, 0xae, 0x6b, 0xb6, 0x48, 0x09, 0xb7,
+	0xad, 0x12, 0x76, 0x1c, 0xd7, 0xe7, 0xe8, 0x54, 0xac, 0xe6, 0xc5, 0x2a, 0x1f, 0xd5, 0x82, 0x93,
+	0x92, 0x6f, 0xd9, 0x84, 0xfa, 0xd8, 0x6e, 0x87, 0x02, 0xda, 0x37, 0xe1, 0xfa, 0x07, 0x8c, 0xe1,
+	0x9e, 0xdf, 0xdc, 0xad, 0xd7, 0xdd, 0xc0, 0xf1, 0x75, 0xf2, 0x38, 0x20, 0xd4, 0x47, 0x39, 0x98,
+	0xc2, 0x8d, 0x86, 0x47, 0x28, 0xcd, 0x29, 0x05, 0x65, 0x7b, 0x5a, 0x97, 0xc3, 0x77, 0x53, 0x3f,
+	0x7f, 0x9e, 0x1f, 0xfb, 0xc7, 0xf3, 0xfc, 0x98, 0xf6, 0x07, 0x05, 0x16, 0x07, 0xd4, 0x69, 0xdb,
+	0x75, 0x28, 0x61, 0xfa, 0x35, 0xdc, 0xc2, 0x4e, 0x9d, 0x48, 0x7d, 0x31, 0x44, 0x79, 0x48, 0x8b,
+	0x4f, 0xe3, 0x09, 0xb1, 0x72, 0xe3, 0x7c, 0x15, 0xc4, 0xd4, 0x0f, 0x88, 0x85, 0x96, 0x61, 0xba,
+	0xee, 0x36, 0x88, 0xd1, 0xc4, 0xb4, 0x99, 0x4b, 0xf0, 0xe5, 0x14, 0x9b, 0xf8, 0x2e, 0xa6, 0x4d,
+	0x94, 0x85, 0x09, 0xc7, 0x65, 0xa8, 0xc9, 0x82, 0xb2, 0x9d, 0xd4, 0xc3, 0x01, 0xc3, 0x24, 0x7e,
+	0xd3, 0x90, 0x8c, 0x27, 0x42, 0x4c, 0xe2, 0x37, 0x77, 0xc3, 0x19, 0x74, 0x03, 0xe6, 0x6a, 0xa4,
+	0xde, 0x7c, 0xab, 0xdc, 0x95, 0x99, 0xe4, 0x32, 0xb3, 0xe1, 0xac, 0x10, 0xd3, 0xee, 0xc2, 0x0a,
+	0xdf, 0xd0, 0xf7, 0x71, 0xcb, 0x6a, 0x60, 0xdf, 0xf5, 0xfa, 0xbc, 0xb2, 0x0e, 0x33, 0x75, 0xd7,
+	0xa1, 0x46, 0xdc, 0x35, 0x69, 0x36, 0xb7, 0x3b, 0xe0, 0x9e, 0x5f, 0x2a, 0xb0, 0x3a, 0x02, 0x4d,
+	0x38, 0x69, 0x0b, 0xe6, 0x71, 0x38, 0xd5, 0x87, 0x38, 0x27, 0xa6, 0x25, 0x7d, 0x15, 0x52, 0x94,
+	0x51, 0x60, 0x1b, 0x1f, 0xe7, 0x1b, 0xef, 0x8e, 0xd9, 0xd6, 0x24, 0x88, 0x13, 0xd8, 0x35, 0xe2,
+	0x71, 0x9f, 0x25, 0xf5, 0x59, 0x31, 0x7b, 0xc4, 0x27, 0xb5, 0x77, 0x60, 0x81, 0x93, 0xa9, 0x84,
+	0x8e, 0xfe, 0x3c, 0x71, 0xfe, 0x00, 0xb2, 0x71, 0xd5, 0x2f, 0x1c, 0x63, 0xed, 0xae, 0x60, 0xf3,
+	0xd0, 0x77, 0x3d, 0x6c, 0x5e, 0xce, 0x06, 0x65, 0x20, 0x71, 0x4a, 0xce, 0x05, 0x12, 0xfb, 0x8c,
+	0xf0, 0x7b, 0x03, 0xb2, 0x71, 0x30, 0xc1, 0x2f, 0x0b, 0x13, 0x67, 0xb8, 0x15, 0x48, 0x76, 0xe1,
+	0x40, 0xfb, 0x06, 0x64, 0xb8, 0x74, 0xd5, 0x6d, 0x7c, 0x2e, 0x2f, 0x6c, 0xc1, 0x57, 0x22, 0x7a,
+	0xc2, 0x04, 0x82, 0x24, 0x3b, 0x9a, 0x5c, 0x6b, 0x46, 0xe7, 0xdf, 0xda, 0x87, 0x80, 0xb8, 0xe0,
+	0x71, 0xe7, 0x7d, 0xd7, 0xa4, 0xd2, 0x04, 0x82, 0x24, 0x3f, 0xd0, 0x21, 0x3e, 0xff, 0x46, 0xfb,
+	0x00, 0xbd, 0x94, 0xc0, 0xf7, 0x96, 0x2e, 0x6f, 0x16, 0xc3, 0xfc, 0x51, 0x64, 0xf9, 0xa3, 0x18,
+	0x26, 0x19, 0x91, 0x3f, 0x8a, 0x0f, 0x7a, 0xae, 0xd2, 0x23, 0x9a, 0x11, 0x92, 0x1f, 0x29, 0xb0,
+	0x10, 0x33, 0x2e, 0x78, 0x6e, 0x40, 0xb2, 0xe5, 0x9a, 0x6c, 0x77, 0x89, 0xed, 0x74, 0x79, 0xbe,
+	0xd8, 0xcb, 0x57, 0xc5, 0xf7, 0x5d, 0x53, 0xe7, 0x8b, 0xe8, 0x60, 0x08, 0x9d, 0xad, 0x4b, 0xe9,
+	0x84, 0x16, 0xa2, 0x7c, 0xb4, 0xac, 0xf0, 0xc0, 0x03, 0xec, 0x61, 0x5b, 0x7a, 0x40, 0x3b, 0x80,
+	0x85, 0xd8, 0xac, 0xa0, 0x76, 0x1b, 0x26, 0xdb, 0x7c, 0x86, 0xbb, 0x26, 0x5d, 0x46, 0x51, 0x72,
+	0xa1, 0x6c, 0x25, 0xf9, 0xe9, 0x8b, 0xfc, 0x98, 0x2e, 0xe4, 0xb4, 0xdf, 0x2b, 0x30, 0xb7, 0xe7,
+	0x37, 0xab, 0xb8, 0xd5, 0x8a, 0x78, 0x17, 0x7b, 0x26, 0x95, 0x71, 0x60, 0xdf, 0x68, 0x11, 0xa6,
+	0x4c, 0x4c, 0x8d, 0x3a, 0x6e, 0x8b, 0x3b, 0x33, 0x69, 0x62, 0x5a, 0xc5, 0x6d, 0xf4, 0x63, 0xc8,
+	0xb4, 0x3d, 0xb7, 0xed, 0x52, 0xe2, 0x75, 0xef, 0x1d, 0xbb, 0x33, 0x33, 0x95, 0xf2, 0xbf, 0x5f,
+	0xe4, 0x8b, 0xa6, 0xe5, 0x37, 0x83, 0x5a, 0xb1, 0xee, 0xda, 0x25, 0x91, 0xca, 0xc3, 0x9f, 0x37,
+	0x69, 0xe3, 0xb4, 0xe4, 0x9f, 0xb7, 0x09, 0x2d, 0x56, 0x7b, 0x17, 0x5e, 0x9f, 0x97, 0x58, 0xf2,
+	0xb2, 0x2e, 0x41, 0xaa, 0xde, 0xc4, 0x96, 0x63, 0x58, 0x0d, 0x9e, 0xa5, 0x12, 0xfa, 0x14, 0x1f,
+	0x1f, 0x36, 0xb4, 0x2d, 0x58, 0xd8, 0xa3, 0xbe, 0x65, 0x63, 0x9f, 0x1c, 0xe0, 0x9e, 0x0b, 0x32,
+	0x90, 0x30, 0x71, 0x48, 0x3e, 0xa9, 0xb3, 0x4f, 0xed, 0x5f, 0x09, 0x19, 0x47, 0x0f, 0xd7, 0xc9,
+	0x71, 0x47, 0xee, 0xf3, 0x6b, 0x90, 0xb0, 0xa9, 0x29, 0x3c, 0xb5, 0x14, 0xf5, 0xd4, 0x3d, 0x6a,
+	0xee, 0xf9, 0x4d, 0xe2, 0x91, 0xc0, 0x3e, 0xee, 0xe8, 0x4c, 0x0a, 0xbd, 0x0b, 0x33, 0x3e, 0x53,
+	0x37, 0xea, 0xae, 0x73, 0x62, 0x99, 0x7c, 0x8f, 0xe9, 0xf2, 0x62, 0x54, 0x8b, 0xc3, 0x57, 0xf9,
+	0xb2, 0x9e, 0xf6, 0x7b, 0x03, 0xf4, 0x1e, 0xcc, 0xb4, 0x3d, 0xd2, 0x20, 0x75, 0x42, 0xa9, 0xeb,
+	0xd1, 0x5c, 0xb2, 0x90, 0xb8, 0xd8, 0x62, 0x4c, 0x9c, 0x25, 0xca, 0x5a, 0xcb, 0xad, 0x9f, 0xca,
+	0x94, 0x34, 0xc1, 0xfd, 0x90, 0xe6, 0x73, 0x61, 0x42, 0x42, 0xab, 0x00, 0xa1, 0x08, 0xbf, 0x16,
+	0x61, 0x3a, 0x9e, 0xe6, 0x33, 0x3c, 0xd1, 0x57, 0xe5, 0x32, 0x7b, 0xb3, 0x72, 0x53, 0x9c, 0xba,
+	0x5a, 0x0c, 0x1f, 0xb4, 0xa2, 0x7c, 0xd0, 0x8a, 0xc7, 0xf2, 0x41, 0xab, 0xa4, 0xd8, 0x11, 0xf9,
+	0xe4, 0x2f, 0x79, 0x45, 0x80, 0xb0, 0x95, 0xa1, 0x91, 0x4e, 0xbd, 0x9e, 0x48, 0x4f, 0xc7, 0x22,
+	0x8d, 0x34, 0x98, 0x0d, 0xe9, 0xdb, 0xb8, 0x63, 0xb0, 0xe0, 0x42, 0xc4, 0x03, 0xf7, 0x70, 0xe7,
+	0x00, 0xd3, 0xef, 0x25, 0x53, 0xe3, 0x99, 0x84, 0x9e, 0xf2, 0x3b, 0x86, 0xe5, 0x34, 0x48, 0x47,
+	0xbb, 0x25, 0xf2, 0x58, 0x37, 0xe6, 0xbd, 0x24, 0xd3, 0xc0, 0x3e, 0x96, 0x87, 0x9b, 0x7d, 0x6b,
+	0xbf, 0x4d, 0xc0, 0xf5, 0x9e, 0x70, 0x85, 0xa1, 0x46, 0xce, 0x88, 0xdf, 0x91, 0x57, 0xfd, 0xa2,
+	0x33, 0xe2, 0x77, 0xe8, 0x17, 0x3a, 0x23, 0xff, 0x0f, 0xf2, 0xe5, 0x41, 0xd6, 0xde, 0x14, 0x35,
+	0x52, 0x34, 0x4e, 0x17, 0xc4, 0xf5, 0x5a, 0xf7, 0x99, 0xa6, 0x64, 0x9f, 0xc8, 0x6c, 0xaf, 0x7d,
+	0xac, 0x40, 0x36, 0x3e, 0x2f, 0x30, 0xbe, 0x0e, 0x29, 0x96, 0x99, 0x8d, 0x13, 0x22, 0x9e, 0xb9,
+	0xca, 0xd2, 0x9f, 0x5f, 0xe4, 0xaf, 0x85, 0x5b, 0xa4, 0x8d, 0xd3, 0xa2, 0xe5, 0x96, 0x6c, 0xec,
+	0x37, 0x8b, 0x87, 0x8e, 0xcf, 0xde, 0x67, 0xae, 0x8d, 0xbe, 0x05, 0x73, 0x52, 0xcb, 0x08, 0x1c,
+	0xab, 0x26, 0x9e, 0xe8, 0x8b, 0x74, 0x67, 0x84, 0xee, 0x23, 0x26, 0xae, 0xbd, 0x07, 0xcb, 0x9c,
+	0xce, 0x7e, 0xe0, 0x1c, 0xbb, 0xa7, 0xc4, 0xb9, 0x87, 0xdb, 0x6d, 0xcb, 0x31, 0xe5, 0x11, 0xcc,
+	0xc2, 0x84, 0xcf, 0xa6, 0xe5, 0xcb, 0xcb, 0x07, 0x91, 0x67, 0xea, 0x47, 0xb0, 0x32, 0x5c, 0x5d,
+	0xec, 0x6a, 0x07, 0xa6, 0x4f, 0x02, 0xc7, 0xe8, 0x61, 0xa4, 0xcb, 0xd9, 0xe8, 0x91, 0x94, 0x7a,
+	0x7a, 0xea, 0x44, 0x7c, 0x45, 0xc0, 0x7f, 0xa3, 0x0c, 0x47, 0xef, 0x3e, 0xc5, 0x65, 0x98, 0x74,
+	0x3d, 0xcb, 0xb4, 0x42, 0xe8, 0xb9, 0xb2, 0x3a, 0x0c, 0xfa, 0x3e, 0x97, 0xd0, 0x85, 0xe4, 0x6b,
+	0x78, 0xaa, 0x7f, 0x27, 0xcb, 0xc3, 0x41, 0x9a, 0xc2, 0x0b, 0xef, 0x00, 0x74, 0xbd, 0x20, 0xef,
+	0xf3, 0x50, 0x37, 0x88, 0xf7, 0x71, 0x5a, 0x3a, 0xe3, 0xcb, 0x7b, 0xca, 0x23, 0x7c, 0xef, 0xf4,
+	0x79, 0x55, 0x27, 0x94, 0x78, 0x67, 0x84, 0x5e, 0x35, 0xe6, 0xff, 0x19, 0x87, 0xd5, 0x11, 0x00,
+	0x62, 0xbf, 0x6f, 0x5f, 0x31, 0xea, 0x62, 0xbb, 0xdd, 0xd8, 0xa3, 0x3b, 0xac, 0xdc, 0x74, 0x4e,
+	0x0d, 0x1a, 0xb4, 0xdb, 0x2d, 0x51, 0x24, 0x56, 0x56, 0x99, 0xd0, 0xe8, 0xf3, 0x0c, 0x4c, 0xe3,
+	0x21, 0x57, 0x40, 0x77, 0x01, 0x11, 0xaf, 0x5e, 0xbe, 0x6d, 0xf8, 0xae, 0x8f, 0x5b, 0x12, 0x26,
+	0x71, 0x15, 0x98, 0x0c, 0x57, 0x3c, 0x66, 0x7a, 0x02, 0x6c, 0x1f, 0xe6, 0x09, 0xad, 0x7b, 0xee,
+	0x13, 0xd2, 0x30, 0xb0, 0xcd, 0x2a, 0xf0, 0x5c, 0xf2, 0x2a, 0x48, 0x73, 0x52, 0x6b, 0x97, 0x2b,
+	0xa1, 0x0a, 0xcc, 0xda, 0x96, 0xe3, 0xf7, 0x50, 0x26, 0xae, 0x82, 0x32, 0x13, 0xea, 0x84, 0x18,
+	0x11, 0xef, 0x2f, 0xc3, 0x52, 0xcc, 0xf9, 0xbb, 0x41, 0xc3, 0x92, 0x6d, 0x8d, 0xf6, 0x4f, 0x05,
+	0xd4, 0x61, 0xab, 0x22, 0x2e, 0x35, 0xc8, 0x06, 0x4e, 0x83, 0x78, 0x46, 0xdd, 0x6d, 0xb5, 0xb0,
+	0x4f, 0x3c, 0xdc, 0xb2, 0x3e, 0x24, 0x0d, 0x71, 0x22, 0x6f, 0x46, 0x43, 0x74, 0x61, 0x80, 0x45,
+	0xdc, 0x16, 0x38, 0x58, 0x35, 0x86, 0x85, 0xee, 0xc0, 0xe4, 0x09, 0xb6, 0x5a, 0xa4, 0x91, 0x1b,
+	0xe7, 0xa8, 0x85, 0x61, 0x81, 0xe7, 0xb4, 0xf6, 0xb1, 0xd5, 0x0a, 0x3c, 0x09, 0x26, 0xb4, 0xd8,
+	0x5b, 0xe4, 0x04, 0xb6, 0x61, 0x8b, 0x3b, 0x24, 0x7a, 0xa0, 0xb4, 0x13, 0xd8, 0xf2, 0x5a, 0x45,
+	0x9c, 0x41, 0x20, 0x3b, 0x0c, 0xf2, 0x7f, 0x3f, 0x80, 0x59, 0x98, 0x20, 0x9e, 0xe7, 0x7a, 0xa2,
+	0x3f, 0x09, 0x07, 0xb7, 0x4e, 0x60, 0x2e, 0x9e, 0x4d, 0x50, 0x1e, 0x96, 0xf7, 0x1f, 0x1d, 0x1d,
+	0xdf, 0xbf, 0xbb, 0x77, 0x64, 0xdc, 0xd7, 0x0f, 0x0f, 0x0e, 0x8f, 0x8c, 0x47, 0x47, 0x0f, 0x1f,
+	0xec, 0x55, 0x0f, 0xf7, 0x0f, 0xf7, 0xbe, 0x93, 0x19, 0x43, 0x39, 0xc8, 0xf6, 0x0b, 0x54, 0xef,
+	0x1f, 0x1e, 0x65, 0x14, 0xb4, 0x04, 0xd7, 0xfa, 0x57, 0xf6, 0xf4, 0x6a, 0xf9, 0x76, 0x66, 0xbc,
+	0xfc, 0xf7, 0x79, 0x98, 0xe0, 0x8e, 0x47, 0x1f, 0x29, 0x00, 0xbd, 0x66, 0x1c, 0x69, 0x03, 0xa1,
+	0x19, 0x68, 0xf4, 0xd5, 0x8d, 0x0b, 0x65, 0xc2, 0xc0, 0x69, 0x6f, 0xfc, 0xf4, 0x8f, 0x7f, 0xfb,
+	0xd5, 0xf8, 0x26, 0xfa, 0x6a, 0x89, 0x65, 0x7f, 0x2f, 0xe8, 0xfe, 0x67, 0xc1, 0x9a, 0xee, 0x50,
+	0xb6, 0xf4, 0x54, 0xbc, 0xbd, 0xcf, 0xd0, 0x73, 0x05, 0x32, 0xfd, 0x3d, 0x2f, 0xda, 0x1e, 0xb0,
+	0x33, 0xa2, 0xc9, 0x56, 0x6f, 0x5e, 0x41, 0x52, 0xf0, 0x7a, 0x9b, 0xf3, 0xda, 0x41, 0xa5, 0x3e,
+	0x5e, 0x67, 0x52, 0xa1, 0xc7, 0x2e, 0xda, 0xb7, 0x3f, 0x43, 0x4f, 0x60, 0xaa, 0x22, 0x7b, 0xd5,
+	0x01, 0x73, 0xf1, 0x16, 0x59, 0x2d, 0x8c, 0x16, 0x10, 0x34, 0x6e, 0x72, 0x1a, 0x1b, 0x68, 0xbd,
+	0x8f, 0x86, 0x68, 0x78, 0x69, 0xc4, 0x37, 0x3f, 0x81, 0x29, 0xd1, 0xa6, 0x0e, 0x31, 0x1c, 0xef,
+	0x86, 0xd5, 0xc2, 0x68, 0x01, 0x61, 0xb8, 0xc8, 0x0d, 0x6f, 0xa3, 0xcd, 0x3e, 0xc3, 0x34, 0x94,
+	0xeb, 0xd9, 0x2d, 0x3d, 0x3d, 0x25, 0xe7, 0xcf, 0xd0, 0x29, 0x24, 0x59, 0xfb, 0x8a, 0x56, 0x06,
+	0x90, 0x23, 0xdd, 0xb0, 0xba, 0x3a, 0x62, 0x55, 0x18, 0xdd, 0xe4, 0x46, 0x0b, 0x68, 0xad, 0xcf,
+	0x28, 0x6b, 0x7e, 0xa3, 0x5b, 0x6d, 0xc2, 0x64, 0xd8, 0xbe, 0xa1, 0xb5, 0x01, 0xc0, 0x58, 0x67,
+	0xa8, 0xe6, 0x47, 0xae, 0x0b, 0x93, 0xab, 0xdc, 0xe4, 0x22, 0xba, 0xd6, 0x67, 0x32, 0x6c, 0x08,
+	0x91, 0x05, 0x53, 0xa2, 0x1f, 0x44, 0xb1, 0xb7, 0x3c, 0xde, 0x24, 0xaa, 0xeb, 0xa3, 0x6b, 0x61,
+	0x69, 0x28, 0xcf, 0x0d, 0x2d, 0xa1, 0xc5, 0x21, 0x07, 0xbd, 0xce, 0xf0, 0x5d, 0x48, 0x47, 0x3a,
+	0xb8, 0x0b, 0xcd, 0xc5, 0x76, 0x35, 0xa4, 0xed, 0xd3, 0x36, 0xb8, 0xb1, 0x55, 0xb4, 0xdc, 0x6f,
+	0x4c, 0xc8, 0xb2, 0x92, 0x12, 0xd9, 0x30, 0x25, 0xfa, 0x81, 0x21, 0x07, 0x26, 0xde, 0x1d, 0xaa,
+	0x85, 0xd1, 0x02, 0x97, 0xec, 0x2f, 0xec, 0x01, 0xfc, 0x0e, 0x3a, 0x07, 0xe8, 0x55, 0xaa, 0x43,
+	0x12, 0xc8, 0x40, 0xbb, 0xa1, 0x6e, 0x5c, 0x28, 0x23, 0xec, 0x6a, 0xdc, 0xee, 0x0a, 0x52, 0x87,
+	0xda, 0xe5, 0xf5, 0x32, 0x7a, 0x0c, 0xd3, 0x61, 0xab, 0xc1, 0xfc, 0xfc, 0x25, 0xec, 0x75, 0x9d,
+	0xdb, 0x5c, 0x46, 0x4b, 0x43, 0x6d, 0xf2, 0x68, 0xda, 0x2c, 0x0d, 0x84, 0x25, 0xf1, 0xb0, 0x34,
+	0x10, 0x2d, 0xc1, 0xd5, 0xc2, 0x68, 0x81, 0x4b, 0x9c, 0x2b, 0x4b, 0x6d, 0xf4, 0xb1, 0x02, 0xf3,
+	0x7d, 0xd5, 0x1e, 0xda, 0x1a, 0xf9, 0x7c, 0xc6, 0x6b, 0x6a, 0x75, 0xfb, 0x72, 0x41, 0xc1, 0x63,
+	0x8b, 0xf3, 0x58, 0x47, 0xf9, 0x3e, 0x1e, 0x27, 0x81, 0xc3, 0x9f, 0xb6, 0xd2, 0x53, 0xfe, 0xf3,
+	0x0c, 0xfd, 0x42, 0x81, 0x4c, 0x1f, 0x08, 0x45, 0x97, 0xda, 0xa1, 0xa3, 0x13, 0xf5, 0xa8, 0x52,
+	0x56, 0x2b, 0x70, 0x4a, 0x2a, 0xca, 0x8d, 0xa0, 0x44, 0xd1, 0xaf, 0x23, 0x5c, 0x64, 0xe1, 0x70,
+	0x01, 0x97, 0xbe, 0xea, 0x53, 0xbd, 0x7a, 0x15, 0xa2, 0xdd, 0xe6, 0x5c, 0x6e, 0xa1, 0xed, 0x11,
+	0x5c, 0x0c, 0x4f, 0x68, 0x74, 0xfd, 0xf4, 0x33, 0x05, 0x66, 0x63, 0x05, 0x03, 0xba, 0x31, 0xd2,
+	0x5c, 0xb4, 0xb0, 0x52, 0x37, 0x2f, 0x13, 0x13, 0x94, 0x6e, 0x70, 0x4a, 0x79, 0xb4, 0x3a, 0x8a,
+	0x12, 0x66, 0xe2, 0x95, 0x6f, 0x7f, 0xfa, 0x72, 0x4d, 0xf9, 0xec, 0xe5, 0x9a, 0xf2, 0xd7, 0x97,
+	0x6b, 0xca, 0x27, 0xaf, 0xd6, 0xc6, 0x3e, 0x7b, 0xb5, 0x36, 0xf6, 0xa7, 0x57, 0x6b, 0x63, 0x3f,
+	0xdc, 0x8c, 0x74, 0xb9, 0x47, 0x1c, 0xa2, 0xca, 0x7a, 0x54, 0x09, 0x77, 0x56, 0x2e, 0x75, 0x18,
+	0x66, 0x6d, 0x92, 0x37, 0xd5, 0x6f, 0xfd, 0x77, 0x00, 0x13, 0x01, 0x31, 0x6e, 0xc9, 0x18, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1490,6 +1868,15 @@ type QueryClient interface {
 	// Similar to feemarket module's method
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(ctx context.Context, in *QueryFunTokenMappingRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingResponse, error)
+	// FunTokenMappings lists the FunToken mappings with pagination, optionally
+	// filtered by the side the mapping originated from.
+	FunTokenMappings(ctx context.Context, in *QueryFunTokenMappingsRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingsResponse, error)
+	// FunTokenReserves reports the supply on each side of a FunToken mapping and
+	// the amount the EVM module holds in escrow to back it.
+	FunTokenReserves(ctx context.Context, in *QueryFunTokenReservesRequest, opts ...grpc.CallOption) (*QueryFunTokenReservesResponse, error)
+	// FunTokenAudit checks the reserves of every FunToken mapping and reports the
+	// mappings that are under-collateralized.
+	FunTokenAudit(ctx context.Context, in *QueryFunTokenAuditRequest, opts ...grpc.CallOption) (*QueryFunTokenAuditResponse, error)
 }
 
 type queryClient struct {
@@ -1617,6 +2004,33 @@ func (c *queryClient) FunTokenMapping(ctx context.Context, in *QueryFunTokenMapp
 	return out, nil
 }
 
+func (c *queryClient) FunTokenMappings(ctx context.Context, in *QueryFunTokenMappingsRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingsResponse, error) {
+	out := new(QueryFunTokenMappingsResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FunTokenReserves(ctx context.Context, in *QueryFunTokenReservesRequest, opts ...grpc.CallOption) (*QueryFunTokenReservesResponse, error) {
+	out := new(QueryFunTokenReservesResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenReserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FunTokenAudit(ctx context.Context, in *QueryFunTokenAuditRequest, opts ...grpc.CallOption) (*QueryFunTokenAuditResponse, error) {
+	out := new(QueryFunTokenAuditResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EthAccount queries a Nibiru account using its EVM address or Bech32 Nibiru
@@ -1648,6 +2062,15 @@ type QueryServer interface {
 	// Similar to feemarket module's method
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(context.Context, *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error)
+	// FunTokenMappings lists the FunToken mappings with pagination, optionally
+	// filtered by the side the mapping originated from.
+	FunTokenMappings(context.Context, *QueryFunTokenMappingsRequest) (*QueryFunTokenMappingsResponse, error)
+	// FunTokenReserves reports the supply on each side of a FunToken mapping and
+	// the amount the EVM module holds in escrow to back it.
+	FunTokenReserves(context.Context, *QueryFunTokenReservesRequest) (*QueryFunTokenReservesResponse, error)
+	// FunTokenAudit checks the reserves of every FunToken mapping and reports the
+	// mappings that are under-collateralized.
+	FunTokenAudit(context.Context, *QueryFunTokenAuditRequest) (*QueryFunTokenAuditResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1693,6 +2116,15 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) FunTokenMapping(ctx context.Context, req *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMapping not implemented")
 }
+func (*UnimplementedQueryServer) FunTokenMappings(ctx context.Context, req *QueryFunTokenMappingsRequest) (*QueryFunTokenMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMappings not implemented")
+}
+func (*UnimplementedQueryServer) FunTokenReserves(ctx context.Context, req *QueryFunTokenReservesRequest) (*QueryFunTokenReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenReserves not implemented")
+}
+func (*UnimplementedQueryServer) FunTokenAudit(ctx context.Context, req *QueryFunTokenAuditRequest) (*QueryFunTokenAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenAudit not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1932,6 +2364,60 @@ func _Query_FunTokenMapping_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FunTokenMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenMappings(ctx, req.(*QueryFunTokenMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FunTokenReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenReservesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenReserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenReserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenReserves(ctx, req.(*QueryFunTokenReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FunTokenAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenAudit(ctx, req.(*QueryFunTokenAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "eth.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1988,6 +2474,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FunTokenMapping",
 			Handler:    _Query_FunTokenMapping_Handler,
 		},
+		{
+			MethodName: "FunTokenMappings",
+			Handler:    _Query_FunTokenMappings_Handler,
+		},
+		{
+			MethodName: "FunTokenReserves",
+			Handler:    _Query_FunTokenReserves_Handler,
+		},
+		{
+			MethodName: "FunTokenAudit",
+			Handler:    _Query_FunTokenAudit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/query.proto",
@@ -2951,184 +3449,495 @@ func (m *QueryFunTokenMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFunTokenMappingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryEthAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryFunTokenMappingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEthAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFunTokenMappingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BalanceWei)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Bech32Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Origin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFunTokenMappingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFunTokenMappingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenMappingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
+	if len(m.FunTokens) > 0 {
+		for iNdEx := len(m.FunTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FunTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFunTokenReservesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunTokenReservesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFunTokenReservesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunTokenReservesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.MintedAmount.Size()
+		i -= size
+		if _, err := m.MintedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.BalanceWei)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EscrowedAmount.Size()
+		i -= size
+		if _, err := m.EscrowedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryStorageRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Erc20TotalSupply.Size()
+		i -= size
+		if _, err := m.Erc20TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BankSupply.Size()
+		i -= size
+		if _, err := m.BankSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FunToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFunTokenAuditRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryFunTokenAuditRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFunTokenAuditRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxLogsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFunTokenAuditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunTokenAuditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenAuditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumMappings != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumMappings))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Failed) > 0 {
+		for iNdEx := len(m.Failed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.UnderCollateralized) > 0 {
+		for iNdEx := len(m.UnderCollateralized) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnderCollateralized[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunTokenAuditFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunTokenAuditFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunTokenAuditFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.FunToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEthAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEthAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BalanceWei)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Bech32Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
+	return n
+}
+
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BalanceWei)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Hash)
@@ -3358,29 +4167,657 @@ func (m *QueryFunTokenMappingRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryFunTokenMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FunToken != nil {
+		l = m.FunToken.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunTokenMappingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Origin != 0 {
+		n += 1 + sovQuery(uint64(m.Origin))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunTokenMappingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FunTokens) > 0 {
+		for _, e := range m.FunTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunTokenReservesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunTokenReservesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FunToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BankSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Erc20TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EscrowedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFunTokenAuditRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFunTokenAuditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnderCollateralized) > 0 {
+		for _, e := range m.UnderCollateralized {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Failed) > 0 {
+		for _, e := range m.Failed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NumMappings != 0 {
+		n += 1 + sovQuery(uint64(m.NumMappings))
+	}
+	return n
+}
+
+func (m *FunTokenAuditFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FunToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEthAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEthAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEthAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEthAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEthAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEthAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWei", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceWei = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryFunTokenMappingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FunToken != nil {
-		l = m.FunToken.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryEthAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3403,10 +4840,10 @@ func (m *QueryEthAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEthAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEthAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3462,7 +4899,7 @@ func (m *QueryEthAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEthAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3485,10 +4922,10 @@ func (m *QueryEthAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEthAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEthAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3555,60 +4992,59 @@ func (m *QueryEthAccountResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.BalanceWei = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3636,11 +5072,11 @@ func (m *QueryEthAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3668,7 +5104,7 @@ func (m *QueryEthAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bech32Address = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3691,7 +5127,7 @@ func (m *QueryEthAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3714,15 +5150,15 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3750,7 +5186,7 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3773,7 +5209,7 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3796,15 +5232,15 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3832,46 +5268,8 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
-			}
-			m.AccountNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3893,7 +5291,7 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3916,17 +5314,17 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3936,23 +5334,25 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3975,7 +5375,7 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3998,15 +5398,15 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4034,13 +5434,13 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWei", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4050,23 +5450,27 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BalanceWei = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4089,7 +5493,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4112,17 +5516,17 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4132,29 +5536,31 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4164,23 +5570,27 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4203,7 +5613,7 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4226,17 +5636,67 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4246,23 +5706,24 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4285,7 +5746,7 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4308,17 +5769,17 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4328,24 +5789,98 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4367,7 +5902,7 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4390,17 +5925,17 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 			}
-			var byteLen int
+			m.Gas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4410,26 +5945,11 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Gas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4451,7 +5971,7 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4474,17 +5994,17 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4494,27 +6014,31 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4541,68 +6065,71 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4612,29 +6139,27 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4661,63 +6186,82 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4739,7 +6283,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4762,17 +6306,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4782,23 +6326,24 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4822,7 +6367,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4845,15 +6390,169 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &MsgEthereumTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4880,16 +6579,16 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
-			if m.Args == nil {
-				m.Args = []byte{}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.GasCap = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4899,14 +6598,83 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasCap |= uint64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4933,30 +6701,11 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4978,7 +6727,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5001,31 +6750,12 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-			}
-			m.Gas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5047,7 +6777,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5070,17 +6800,17 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5090,33 +6820,33 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgEthereumTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeUnibi", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5126,84 +6856,81 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.Int
+			m.BaseFeeUnibi = &v
+			if err := m.BaseFeeUnibi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
-			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunTokenMappingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunTokenMappingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunTokenMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5231,11 +6958,61 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunTokenMappingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunTokenMappingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunTokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5262,82 +7039,13 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.FunToken == nil {
+				m.FunToken = &FunToken{}
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
-			}
-			m.BlockMaxGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5359,7 +7067,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFunTokenMappingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5382,17 +7090,36 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunTokenMappingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunTokenMappingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= FunTokenOrigin(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5402,24 +7129,26 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5443,7 +7172,7 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFunTokenMappingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5466,15 +7195,15 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunTokenMappingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunTokenMappingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunTokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5501,14 +7230,14 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &MsgEthereumTx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FunTokens = append(m.FunTokens, FunToken{})
+			if err := m.FunTokens[len(m.FunTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5535,35 +7264,66 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 6:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunTokenReservesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunTokenReservesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunTokenReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5591,11 +7351,61 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunTokenReservesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunTokenReservesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunTokenReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5622,15 +7432,15 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankSupply", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5640,31 +7450,31 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			if err := m.BankSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20TotalSupply", wireType)
 			}
-			m.ChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5674,16 +7484,31 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.BlockMaxGas = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedAmount", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5693,66 +7518,31 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.EscrowedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5762,24 +7552,24 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := m.MintedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5803,7 +7593,7 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFunTokenAuditRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5826,10 +7616,10 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunTokenAuditRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunTokenAuditRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5853,7 +7643,7 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFunTokenAuditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5876,17 +7666,17 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunTokenAuditResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunTokenAuditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderCollateralized", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5896,33 +7686,31 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFee = &v
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnderCollateralized = append(m.UnderCollateralized, QueryFunTokenReservesResponse{})
+			if err := m.UnderCollateralized[len(m.UnderCollateralized)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeUnibi", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5932,28 +7720,45 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFeeUnibi = &v
-			if err := m.BaseFeeUnibi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Failed = append(m.Failed, FunTokenAuditFailure{})
+			if err := m.Failed[len(m.Failed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMappings", wireType)
+			}
+			m.NumMappings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumMappings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5975,7 +7780,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFunTokenMappingRequest) Unmarshal(dAtA []byte) error {
+func (m *FunTokenAuditFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5998,17 +7803,17 @@ func (m *QueryFunTokenMappingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunTokenMappingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FunTokenAuditFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunTokenMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FunTokenAuditFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6018,79 +7823,30 @@ func (m *QueryFunTokenMappingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFunTokenMappingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunTokenMappingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunTokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6100,27 +7856,23 @@ func (m *QueryFunTokenMappingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FunToken == nil {
-				m.FunToken = &FunToken{}
-			}
-			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -97,6 +97,8 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 		Journal:      newJournal(),
 		accessList:   newAccessList(),
 		txConfig:     txConfig,
+
+		transientStorage: make(transientStorage),
 	}
 }
 
@@ -490,8 +492,8 @@ func (s *StateDB) HasSelfDestructed(addr common.Address) bool {
 	return stateObject.SelfDestructed
 }
 
-// SelfDestruct6780 calls [SelfDesrtuct] only if the [stateObject] corresponding to
-// the given "addr" was created this block.
+// SelfDestruct6780 calls [SelfDesrtuct] only if the contract at the given
+// "addr" was created in the same transaction (see [StateDB.CreateContract]).
 //
 // SelfDestruct6780 is post-EIP6780 selfdestruct, which means that it's a
 // send-all-to-beneficiary, unless the contract was created in this same
@@ -504,7 +506,7 @@ func (s *StateDB) SelfDestruct6780(
 	stateObject := s.getStateObject(addr)
 	if stateObject == nil {
 		isSelfDestructed = false
-	} else if stateObject.createdThisBlock {
+	} else if stateObject.newContract {
 		prevWei, isSelfDestructed = s.SelfDestruct(addr), true
 	} else {
 		prevWei, isSelfDestructed = *(stateObject.Balance()), false
@@ -805,7 +807,7 @@ func (s *StateDB) SetTransientState(
 		key:       key,
 		prevValue: prev,
 	})
-	s.transientStorage.Set(addr, key, prev)
+	s.transientStorage.Set(addr, key, value)
 }
 
 // Witness returns nil.
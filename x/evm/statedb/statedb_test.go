@@ -476,6 +476,57 @@ func (s *Suite) TestNestedSnapshot() {
 	s.Require().Equal(common.Hash{}, db.GetState(address, key))
 }
 
+// TestTransientStorage: EIP-1153 transient storage is journaled like the
+// persistent storage and reset by [statedb.StateDB.Prepare].
+func (s *Suite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	deps := evmtest.NewTestDeps()
+	db := deps.NewStateDB()
+
+	s.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+	db.SetTransientState(address, key, value1)
+	s.Require().Equal(value1, db.GetTransientState(address, key))
+	s.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+
+	rev := db.Snapshot()
+	db.SetTransientState(address, key, value2)
+	s.Require().Equal(value2, db.GetTransientState(address, key))
+	db.RevertToSnapshot(rev)
+	s.Require().Equal(value1, db.GetTransientState(address, key))
+
+	// Transient storage doesn't outlive the transaction.
+	db.Prepare(params.Rules{}, address2, common.Address{}, nil, nil, nil)
+	s.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+}
+
+// TestSelfDestruct6780: Post EIP-6780, SELFDESTRUCT only deletes contracts
+// created in the same transaction.
+func (s *Suite) TestSelfDestruct6780() {
+	deps := evmtest.NewTestDeps()
+	db := deps.NewStateDB()
+	db.SetCode(address, []byte("hello world"))
+	s.Require().NoError(db.Commit())
+
+	db = deps.NewStateDB()
+	_, destructed := db.SelfDestruct6780(address)
+	s.False(destructed, "existing contract")
+	s.False(db.HasSelfDestructed(address))
+
+	// An account with a balance that becomes a contract in this tx, for
+	// example the target of a counterfactual CREATE2 deployment
+	db.AddBalanceSigned(address2, big.NewInt(100))
+	s.Require().NoError(db.Commit())
+	db = deps.NewStateDB()
+	db.CreateContract(address2)
+	db.SetCode(address2, []byte("hello world"))
+	_, destructed = db.SelfDestruct6780(address2)
+	s.True(destructed, "contract created in this tx")
+	s.True(db.HasSelfDestructed(address2))
+}
+
 func (s *Suite) TestInvalidSnapshotId() {
 	deps := evmtest.NewTestDeps()
 	db := deps.NewStateDB()
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -28,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 		GetSkippedEpochs(),
 		GetCirculatingSupply(),
 		GetInflationRate(),
+		GetInflationProjections(),
 		GetParams(),
 	)
 
@@ -182,6 +184,45 @@ func GetInflationRate() *cobra.Command {
 	return cmd
 }
 
+// GetInflationProjections implements a command to return the projected mint
+// amounts of the next inflation periods.
+func GetInflationProjections() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projections [num-periods]",
+		Short: "Query the projected mint amounts of the next inflation periods",
+		Long: `Query the projected mint amounts per epoch and per period, split by the
+inflation distribution. If "num-periods" is omitted, every period until the max
+period is projected.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInflationProjectionsRequest{}
+			if len(args) > 0 {
+				req.NumPeriods, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid num-periods %q: %w", args[0], err)
+				}
+			}
+			res, err := queryClient.InflationProjections(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetParams implements a command to return the current inflation
 // parameters.
 func GetParams() *cobra.Command {
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
@@ -69,7 +70,7 @@ $ nibid tx inflation toggle-inflation true
 
 func CmdEditInflationParams() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "edit-params --staking-proportion [staking-proportion] --community-pool-proportion [community-pool-proportion] --strategic-reserves-proportion [strategic-reserves-proportion] --polynomial-factors [polynomial-factors] --epochs-per-period [epochs-per-period] --periods-per-year [periods-per-year] --max-period [max-period]",
+		Use:   "edit-params --staking-proportion [staking-proportion] --community-pool-proportion [community-pool-proportion] --strategic-reserves-proportion [strategic-reserves-proportion] --recipients [address:weight,...] --polynomial-factors [polynomial-factors] --epochs-per-period [epochs-per-period] --periods-per-year [periods-per-year] --max-period [max-period]",
 		Args:  cobra.ExactArgs(0),
 		Short: "Edit the inflation module parameters",
 		Long: strings.TrimSpace(`
@@ -80,6 +81,7 @@ Requires sudo permissions.
 --staking-proportion: the proportion of minted tokens to be distributed to stakers
 --community-pool-proportion: the proportion of minted tokens to be distributed to the community pool
 --strategic-reserves-proportion: the proportion of minted tokens to be distributed to validators
+--recipients: comma-separated address:weight pairs for accounts or contracts that receive a proportion of minted tokens
 
 --polynomial-factors: the polynomial factors of the inflation distribution curve
 --epochs-per-period: the number of epochs per period
@@ -101,19 +103,16 @@ $ nibid tx oracle edit-params --staking-proportion 0.6 --community-pool-proporti
 			var stakingProportionDec sdkmath.LegacyDec
 			if stakingProportion, _ := cmd.Flags().GetString("staking-proportion"); stakingProportion != "" {
 				stakingProportionDec = sdkmath.LegacyMustNewDecFromStr(stakingProportion)
-				msg.InflationDistribution.StakingRewards = stakingProportionDec
 			}
 
 			var communityPoolProportionDec sdkmath.LegacyDec
 			if communityPoolProportion, _ := cmd.Flags().GetString("community-pool-proportion"); communityPoolProportion != "" {
 				communityPoolProportionDec = sdkmath.LegacyMustNewDecFromStr(communityPoolProportion)
-				msg.InflationDistribution.CommunityPool = communityPoolProportionDec
 			}
 
 			var strategicReservesProportionDec sdkmath.LegacyDec
 			if strategicReservesProportion, _ := cmd.Flags().GetString("strategic-reserves-proportion"); strategicReservesProportion != "" {
 				strategicReservesProportionDec = sdkmath.LegacyMustNewDecFromStr(strategicReservesProportion)
-				msg.InflationDistribution.StrategicReserves = strategicReservesProportionDec
 			}
 
 			if !stakingProportionDec.IsNil() && !communityPoolProportionDec.IsNil() && !strategicReservesProportionDec.IsNil() {
@@ -124,6 +123,26 @@ $ nibid tx oracle edit-params --staking-proportion 0.6 --community-pool-proporti
 				}
 			}
 
+			if recipients, _ := cmd.Flags().GetString("recipients"); recipients != "" {
+				if msg.InflationDistribution == nil {
+					return fmt.Errorf("--recipients requires the staking, community pool, and strategic reserves proportions")
+				}
+				for _, recipient := range strings.Split(recipients, ",") {
+					address, weight, found := strings.Cut(recipient, ":")
+					if !found {
+						return fmt.Errorf("invalid recipient %q: expected address:weight", recipient)
+					}
+					weightDec, err := sdkmath.LegacyNewDecFromStr(weight)
+					if err != nil {
+						return fmt.Errorf("invalid recipient weight %q: %w", weight, err)
+					}
+					msg.InflationDistribution.Recipients = append(
+						msg.InflationDistribution.Recipients,
+						types.InflationRecipient{Address: address, Weight: weightDec},
+					)
+				}
+			}
+
 			if polynomialFactors, _ := cmd.Flags().GetString("polynomial-factors"); polynomialFactors != "" {
 				polynomialFactorsArr := strings.Split(polynomialFactors, ",")
 				realPolynomialFactors := make([]sdkmath.LegacyDec, len(polynomialFactorsArr))
@@ -162,6 +181,7 @@ $ nibid tx oracle edit-params --staking-proportion 0.6 --community-pool-proporti
 	cmd.Flags().String("staking-proportion", "", "the proportion of minted tokens to be distributed to stakers")
 	cmd.Flags().String("community-pool-proportion", "", "the proportion of minted tokens to be distributed to the community pool")
 	cmd.Flags().String("strategic-reserves-proportion", "", "the proportion of minted tokens to be distributed to validators")
+	cmd.Flags().String("recipients", "", "comma-separated address:weight pairs for accounts or contracts that receive a proportion of minted tokens")
 	cmd.Flags().String("polynomial-factors", "", "the polynomial factors of the inflation distribution curve")
 	cmd.Flags().Uint64("epochs-per-period", 0, "the number of epochs per period")
 	cmd.Flags().Uint64("periods-per-year", 0, "the number of periods per year")
@@ -73,6 +73,23 @@ func (k Keeper) InflationRate(
 	return &types.QueryInflationRateResponse{InflationRate: inflationRate}, nil
 }
 
+// InflationProjections returns the projected mint amounts of the next periods.
+func (k Keeper) InflationProjections(
+	c context.Context,
+	req *types.QueryInflationProjectionsRequest,
+) (*types.QueryInflationProjectionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	projections, err := k.ProjectInflation(ctx, req.NumPeriods)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryInflationProjectionsResponse{
+		CurrentPeriod: k.CurrentPeriod.Peek(ctx),
+		SkippedEpochs: k.NumSkippedEpochs.Peek(ctx),
+		Projections:   projections,
+	}, nil
+}
+
 // CirculatingSupply returns the total supply in circulation excluding the team
 // allocation in the first year
 func (k Keeper) CirculatingSupply(
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/NibiruChain/nibiru/v2/x/inflation/keeper"
@@ -11,6 +12,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/app"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
 
+	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
 	inflationtypes "github.com/NibiruChain/nibiru/v2/x/inflation/types"
 )
 
@@ -107,3 +109,58 @@ func (s *QueryServerSuite) TestQueryParams() {
 	s.NoError(err)
 	s.NotNil(resp2)
 }
+
+func TestQueryInflationProjections(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	k := nibiruApp.InflationKeeper
+
+	params := inflationtypes.DefaultParams()
+	params.InflationEnabled = true
+	params.HasInflationStarted = true
+	params.MaxPeriod = 5
+	k.Params.Set(ctx, params)
+
+	// Epochs 1 through 10 ended, 3 of which were skipped while inflation was
+	// disabled, so 7 of the 30 epochs in period 0 already minted.
+	dayEpoch, err := nibiruApp.EpochsKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID)
+	require.NoError(t, err)
+	dayEpoch.EpochCountingStarted = true
+	dayEpoch.CurrentEpoch = 11
+	nibiruApp.EpochsKeeper.Epochs.Insert(ctx, epochstypes.DayEpochID, dayEpoch)
+	k.NumSkippedEpochs.Set(ctx, 3)
+
+	resp, err := k.InflationProjections(
+		sdk.WrapSDKContext(ctx), &inflationtypes.QueryInflationProjectionsRequest{NumPeriods: 2},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, resp.CurrentPeriod)
+	require.EqualValues(t, 3, resp.SkippedEpochs)
+	require.Len(t, resp.Projections, 2)
+	require.EqualValues(t, 23, resp.Projections[0].NumEpochs)
+	require.EqualValues(t, 30, resp.Projections[1].NumEpochs)
+
+	for idx, projection := range resp.Projections {
+		require.EqualValues(t, idx, projection.Period)
+		wantEpochMint := inflationtypes.CalculateEpochMintProvision(params, projection.Period).TruncateInt()
+		require.Equal(t, wantEpochMint, projection.EpochMintProvision.Amount)
+		require.Equal(t,
+			wantEpochMint.MulRaw(int64(projection.NumEpochs)),
+			projection.PeriodMintProvision.Amount,
+		)
+		require.Equal(t,
+			projection.PeriodMintProvision,
+			projection.StakingRewards.Add(projection.StrategicReserve).Add(projection.CommunityPool),
+		)
+	}
+
+	t.Log("Projections stop at the max period")
+	k.CurrentPeriod.Set(ctx, 3)
+	resp, err = k.InflationProjections(
+		sdk.WrapSDKContext(ctx), &inflationtypes.QueryInflationProjectionsRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Projections, 2)
+	require.EqualValues(t, 3, resp.Projections[0].Period)
+	require.EqualValues(t, 30, resp.Projections[0].NumEpochs)
+	require.EqualValues(t, 4, resp.Projections[1].Period)
+}
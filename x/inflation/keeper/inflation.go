@@ -5,13 +5,16 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
 	"github.com/NibiruChain/nibiru/v2/x/inflation/types"
 )
 
 // MintAndAllocateInflation mints and allocates tokens based on the polynomial
-// inflation coefficients and current block height.
+// inflation coefficients and current block height. Minting and allocation are
+// all-or-nothing: if any transfer fails, nothing is minted.
 //
 // Args:
 //   - coins: Tokens to be minted.
@@ -38,13 +41,20 @@ func (k Keeper) MintAndAllocateInflation(
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, nil
 	}
 
+	cacheCtx, writeCache := ctx.CacheContext()
+
 	// Mint coins for distribution
-	if err := k.MintCoins(ctx, coins); err != nil {
+	if err := k.MintCoins(cacheCtx, coins); err != nil {
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
 	}
 
 	// Allocate minted coins according to allocation proportions (staking, strategic, community pool)
-	return k.AllocatePolynomialInflation(ctx, coins, params)
+	staking, strategic, community, err = k.AllocatePolynomialInflation(cacheCtx, coins, params)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	writeCache()
+	return staking, strategic, community, nil
 }
 
 // MintCoins calls the underlying [BankKeeper] mints tokens "coin".
@@ -54,7 +64,9 @@ func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
 }
 
 // AllocatePolynomialInflation allocates coins from the inflation to external
-// modules according to proportions:
+// modules and to the custom inflation recipients according to proportions,
+// which must sum to 1. Recipient allocations are reported in the emitted
+// [types.EventInflationDistribution].
 //
 // Returns:
 //   - staking: Tokens minted for staking inflation that go to the decentralized
@@ -74,9 +86,14 @@ func (k Keeper) AllocatePolynomialInflation(
 ) {
 	inflationDistribution := params.InflationDistribution
 	inflationModuleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if total := inflationDistribution.TotalProportions(); !total.Equal(sdkmath.LegacyOneDec()) {
+		return staking, strategic, community, fmt.Errorf(
+			"inflation error: distribution proportions sum to %s instead of 1", total,
+		)
+	}
 
 	// Allocate staking rewards into fee collector account
-	staking = k.GetProportions(ctx, mintedCoin, inflationDistribution.StakingRewards)
+	staking, community, recipients := k.splitEpochMint(ctx, mintedCoin, inflationDistribution)
 	if err := k.bankKeeper.SendCoinsFromModuleToModule(
 		ctx,
 		types.ModuleName,
@@ -87,7 +104,6 @@ func (k Keeper) AllocatePolynomialInflation(
 	}
 
 	// Allocate community pool rewards into community pool
-	community = k.GetProportions(ctx, mintedCoin, inflationDistribution.CommunityPool)
 	if err = k.distrKeeper.FundCommunityPool(
 		ctx,
 		sdk.NewCoins(community),
@@ -96,6 +112,18 @@ func (k Keeper) AllocatePolynomialInflation(
 		return staking, strategic, community, err
 	}
 
+	// Allocate the custom recipient rewards
+	for _, recipient := range recipients {
+		if !recipient.Amount.IsPositive() {
+			continue
+		}
+		if err = k.sendToRecipient(ctx, recipient); err != nil {
+			err := fmt.Errorf("inflation error: failed to send coins to recipient %s: %w", recipient.Address, err)
+			k.Logger(ctx).Error(err.Error())
+			return staking, strategic, community, err
+		}
+	}
+
 	// Remaining balance is strategic reserve allocation to the root account
 	// of the x/sudo module
 	strategic = k.bankKeeper.GetBalance(ctx, inflationModuleAddr, denoms.NIBI)
@@ -119,9 +147,47 @@ func (k Keeper) AllocatePolynomialInflation(
 			StakingRewards:   staking,
 			StrategicReserve: strategic,
 			CommunityPool:    community,
+			Recipients:       recipients,
 		})
 }
 
+// sendToRecipient sends the allocation of a custom inflation recipient. Module
+// accounts are blocked from receiving coins from
+// "SendCoinsFromModuleToAccount", so they receive them from
+// "SendCoinsFromModuleToModule" instead.
+func (k Keeper) sendToRecipient(ctx sdk.Context, recipient types.RecipientAllocation) error {
+	addr, err := types.InflationRecipient{Address: recipient.Address}.AccAddress()
+	if err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(recipient.Amount)
+	if modAcc, isModule := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI); isModule &&
+		k.accountKeeper.GetModuleAddress(modAcc.GetName()).Equals(addr) {
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, modAcc.GetName(), coins)
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+}
+
+// splitEpochMint returns the parts of the coins minted in an epoch that
+// [Keeper.AllocatePolynomialInflation] sends to stakers, to the community
+// pool, and to each custom recipient. The strategic reserve receives the
+// remainder.
+func (k Keeper) splitEpochMint(
+	ctx sdk.Context,
+	mintedCoin sdk.Coin,
+	inflationDistribution types.InflationDistribution,
+) (staking, community sdk.Coin, recipients []types.RecipientAllocation) {
+	staking = k.GetProportions(ctx, mintedCoin, inflationDistribution.StakingRewards)
+	community = k.GetProportions(ctx, mintedCoin, inflationDistribution.CommunityPool)
+	for _, recipient := range inflationDistribution.Recipients {
+		recipients = append(recipients, types.RecipientAllocation{
+			Address: recipient.Address,
+			Amount:  k.GetProportions(ctx, mintedCoin, recipient.Weight),
+		})
+	}
+	return staking, community, recipients
+}
+
 // GetAllocationProportion calculates the proportion of coins that is to be
 // allocated during inflation for a given distribution.
 func (k Keeper) GetProportions(
@@ -172,3 +238,89 @@ func (k Keeper) GetEpochMintProvision(ctx sdk.Context) sdkmath.LegacyDec {
 		peek,
 	)
 }
+
+// MaxInflationProjectionPeriods is the maximum number of periods that
+// [Keeper.ProjectInflation] computes in a single call.
+const MaxInflationProjectionPeriods uint64 = 1_000
+
+// ProjectInflation returns the mint amounts of the next "numPeriods" periods,
+// starting at the current period, given the current params. Each period mints
+// the truncated [types.CalculateEpochMintProvision] once per epoch and splits
+// it the same way [Keeper.AllocatePolynomialInflation] does. Epochs of the
+// current period that already ended, including skipped epochs, are left out.
+// If "numPeriods" is zero, every period until the max period is projected.
+func (k Keeper) ProjectInflation(
+	ctx sdk.Context, numPeriods uint64,
+) (projections []types.PeriodProjection, err error) {
+	params := k.GetParams(ctx)
+	period := k.CurrentPeriod.Peek(ctx)
+	if period >= params.MaxPeriod {
+		return projections, nil
+	}
+
+	remainingPeriods := params.MaxPeriod - period
+	if numPeriods == 0 || numPeriods > remainingPeriods {
+		numPeriods = remainingPeriods
+	}
+	if numPeriods > MaxInflationProjectionPeriods {
+		return nil, fmt.Errorf(
+			"cannot project more than %d periods, got %d",
+			MaxInflationProjectionPeriods, numPeriods,
+		)
+	}
+
+	epochsPerPeriod := params.EpochsPerPeriod
+	for p := period; p < period+numPeriods; p++ {
+		numEpochs := epochsPerPeriod
+		if p == period {
+			numEpochs -= k.numEndedEpochsInPeriod(ctx, period, epochsPerPeriod)
+		}
+
+		mintedCoin := sdk.Coin{
+			Denom:  denoms.NIBI,
+			Amount: types.CalculateEpochMintProvision(params, p).TruncateInt(),
+		}
+		staking, community, recipients := k.splitEpochMint(ctx, mintedCoin, params.InflationDistribution)
+		strategic := mintedCoin.Sub(staking).Sub(community)
+
+		epochs := sdkmath.NewIntFromUint64(numEpochs)
+		for idx, recipient := range recipients {
+			strategic = strategic.Sub(recipient.Amount)
+			recipients[idx].Amount.Amount = recipient.Amount.Amount.Mul(epochs)
+		}
+		projections = append(projections, types.PeriodProjection{
+			Period:              p,
+			NumEpochs:           numEpochs,
+			EpochMintProvision:  mintedCoin,
+			PeriodMintProvision: sdk.NewCoin(denoms.NIBI, mintedCoin.Amount.Mul(epochs)),
+			StakingRewards:      sdk.NewCoin(denoms.NIBI, staking.Amount.Mul(epochs)),
+			StrategicReserve:    sdk.NewCoin(denoms.NIBI, strategic.Amount.Mul(epochs)),
+			CommunityPool:       sdk.NewCoin(denoms.NIBI, community.Amount.Mul(epochs)),
+			Recipients:          recipients,
+		})
+	}
+	return projections, nil
+}
+
+// numEndedEpochsInPeriod returns the number of inflation epochs that ended in
+// the current period, following the period accounting in [Hooks.AfterEpochEnd].
+func (k Keeper) numEndedEpochsInPeriod(
+	ctx sdk.Context, period, epochsPerPeriod uint64,
+) uint64 {
+	epochInfo, err := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID)
+	if err != nil || !epochInfo.EpochCountingStarted {
+		return 0
+	}
+
+	// The epoch with number "CurrentEpoch" is still running.
+	numEndedEpochs := int64(epochInfo.CurrentEpoch) - 1 -
+		int64(epochsPerPeriod*period) -
+		int64(k.NumSkippedEpochs.Peek(ctx))
+	switch {
+	case numEndedEpochs < 0:
+		return 0
+	case uint64(numEndedEpochs) > epochsPerPeriod:
+		return epochsPerPeriod
+	}
+	return uint64(numEndedEpochs)
+}
@@ -11,9 +11,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/v2/app"
+	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
 	"github.com/NibiruChain/nibiru/v2/x/inflation/types"
+	oracletypes "github.com/NibiruChain/nibiru/v2/x/oracle/types"
 	sudotypes "github.com/NibiruChain/nibiru/v2/x/sudo/types"
 )
 
@@ -121,6 +124,60 @@ func TestMintAndAllocateInflation(t *testing.T) {
 	}
 }
 
+func TestAllocatePolynomialInflation_Recipients(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	root := testutil.AccAddress()
+	nibiruApp.SudoKeeper.Sudoers.Set(ctx, sudotypes.Sudoers{Root: root.String()})
+
+	recipientA, recipientB := testutil.AccAddress(), testutil.AccAddress()
+	// Module accounts are blocked from "SendCoinsFromModuleToAccount".
+	moduleRecipient := nibiruApp.AccountKeeper.GetModuleAddress(oracletypes.ModuleName)
+	moduleBalance := nibiruApp.BankKeeper.GetBalance(ctx, moduleRecipient, denoms.NIBI).Amount
+	params := types.DefaultParams()
+	params.InflationDistribution = types.InflationDistribution{
+		StakingRewards:    sdkmath.LegacyNewDecWithPrec(5, 1),
+		CommunityPool:     sdkmath.LegacyNewDecWithPrec(2, 1),
+		StrategicReserves: sdkmath.LegacyNewDecWithPrec(1, 1),
+		Recipients: []types.InflationRecipient{
+			{Address: recipientA.String(), Weight: sdkmath.LegacyNewDecWithPrec(10, 2)},
+			// EVM contracts can be given by their hex address.
+			{Address: eth.NibiruAddrToEthAddr(recipientB).Hex(), Weight: sdkmath.LegacyNewDecWithPrec(5, 2)},
+			{Address: moduleRecipient.String(), Weight: sdkmath.LegacyNewDecWithPrec(5, 2)},
+		},
+	}
+	require.NoError(t, params.Validate())
+
+	staking, strategic, community, err := nibiruApp.InflationKeeper.MintAndAllocateInflation(
+		ctx, sdk.NewCoin(denoms.NIBI, sdkmath.NewInt(1_000_000)), params,
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(500_000), staking.Amount)
+	require.Equal(t, sdkmath.NewInt(200_000), community.Amount)
+	require.Equal(t, sdkmath.NewInt(100_000), strategic.Amount)
+	require.Equal(t, sdkmath.NewInt(100_000), nibiruApp.BankKeeper.GetBalance(ctx, recipientA, denoms.NIBI).Amount)
+	require.Equal(t, sdkmath.NewInt(50_000), nibiruApp.BankKeeper.GetBalance(ctx, recipientB, denoms.NIBI).Amount)
+	require.Equal(t, moduleBalance.AddRaw(50_000), nibiruApp.BankKeeper.GetBalance(ctx, moduleRecipient, denoms.NIBI).Amount)
+	require.Equal(t, sdkmath.NewInt(100_000), nibiruApp.BankKeeper.GetBalance(ctx, root, denoms.NIBI).Amount)
+
+	t.Log("A failed allocation mints and sends nothing")
+	supply := nibiruApp.BankKeeper.GetSupply(ctx, denoms.NIBI)
+	nibiruApp.SudoKeeper.Sudoers.Set(ctx, sudotypes.Sudoers{Root: ""})
+	_, _, _, err = nibiruApp.InflationKeeper.MintAndAllocateInflation(
+		ctx, sdk.NewCoin(denoms.NIBI, sdkmath.NewInt(1_000_000)), params,
+	)
+	require.ErrorContains(t, err, "failed to get sudo root account")
+	require.Equal(t, supply, nibiruApp.BankKeeper.GetSupply(ctx, denoms.NIBI))
+	require.Equal(t, sdkmath.NewInt(100_000), nibiruApp.BankKeeper.GetBalance(ctx, recipientA, denoms.NIBI).Amount)
+	nibiruApp.SudoKeeper.Sudoers.Set(ctx, sudotypes.Sudoers{Root: root.String()})
+
+	t.Log("Proportions that don't sum to 1 are rejected")
+	params.InflationDistribution.Recipients[1].Weight = sdkmath.LegacyNewDecWithPrec(10, 2)
+	_, _, _, err = nibiruApp.InflationKeeper.MintAndAllocateInflation(
+		ctx, sdk.NewCoin(denoms.NIBI, sdkmath.NewInt(1_000_000)), params,
+	)
+	require.ErrorContains(t, err, "distribution proportions sum to")
+}
+
 func TestGetCirculatingSupplyAndInflationRate(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -23,6 +23,7 @@ type Keeper struct {
 	distrKeeper   types.DistrKeeper
 	stakingKeeper types.StakingKeeper
 	sudoKeeper    types.SudoKeeper
+	epochsKeeper  types.EpochsKeeper
 	// feeCollectorName is the name of x/auth module's fee collector module
 	// account, "fee_collector", which collects transaction fees for distribution
 	// to all stakers.
@@ -57,6 +58,7 @@ func NewKeeper(
 	distributionKeeper types.DistrKeeper,
 	stakingKeeper types.StakingKeeper,
 	sudoKeeper types.SudoKeeper,
+	epochsKeeper types.EpochsKeeper,
 	feeCollectorName string,
 ) Keeper {
 	// ensure mint module account is set
@@ -72,6 +74,7 @@ func NewKeeper(
 		distrKeeper:      distributionKeeper,
 		stakingKeeper:    stakingKeeper,
 		sudoKeeper:       sudoKeeper,
+		epochsKeeper:     epochsKeeper,
 		feeCollectorName: feeCollectorName,
 		CurrentPeriod:    collections.NewSequence(storeKey, 0),
 		NumSkippedEpochs: collections.NewSequence(storeKey, 1),
@@ -207,6 +207,7 @@ type InflationInputs struct {
 	DistrKeeper   types.DistrKeeper
 	StakingKeeper *stakingkeeper.Keeper
 	SudoKeeper    types.SudoKeeper
+	EpochsKeeper  types.EpochsKeeper
 }
 
 type InflationOutputs struct {
@@ -219,7 +220,7 @@ type InflationOutputs struct {
 
 func ProvideModule(in InflationInputs) InflationOutputs {
 	k := keeper.NewKeeper(in.Cdc, in.Key, in.AccountKeeper, in.BankKeeper,
-		in.DistrKeeper, in.StakingKeeper, in.SudoKeeper, authtypes.FeeCollectorName)
+		in.DistrKeeper, in.StakingKeeper, in.SudoKeeper, in.EpochsKeeper, authtypes.FeeCollectorName)
 
 	m := NewAppModule(k, in.AccountKeeper, *in.StakingKeeper)
 
@@ -27,9 +27,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // EventInflationDistribution: Emitted when NIBI tokens are minted on the
 // network based on Nibiru's inflation schedule.
 type EventInflationDistribution struct {
-	StakingRewards   types.Coin `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards" yaml:"staking_rewards"`
-	StrategicReserve types.Coin `protobuf:"bytes,2,opt,name=strategic_reserve,json=strategicReserve,proto3" json:"strategic_reserve" yaml:"strategic_reserve"`
-	CommunityPool    types.Coin `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3" json:"community_pool" yaml:"community_pool"`
+	StakingRewards   types.Coin            `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards" yaml:"staking_rewards"`
+	StrategicReserve types.Coin            `protobuf:"bytes,2,opt,name=strategic_reserve,json=strategicReserve,proto3" json:"strategic_reserve" yaml:"strategic_reserve"`
+	CommunityPool    types.Coin            `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3" json:"community_pool" yaml:"community_pool"`
+	Recipients       []RecipientAllocation `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients" yaml:"recipients"`
 }
 
 func (m *EventInflationDistribution) Reset()         { *m = EventInflationDistribution{} }
@@ -86,6 +87,13 @@ func (m *EventInflationDistribution) GetCommunityPool() types.Coin {
 	return types.Coin{}
 }
 
+func (m *EventInflationDistribution) GetRecipients() []RecipientAllocation {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventInflationDistribution)(nil), "nibiru.inflation.v1.EventInflationDistribution")
 }
@@ -93,28 +101,31 @@ func init() {
 func init() { proto.RegisterFile("nibiru/inflation/v1/event.proto", fileDescriptor_18fa0385facaf5d9) }
 
 var fileDescriptor_18fa0385facaf5d9 = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xbf, 0x4e, 0xeb, 0x30,
-	0x14, 0xc6, 0x93, 0x5e, 0xe9, 0x0e, 0xb9, 0xba, 0x05, 0xc2, 0x1f, 0x85, 0x4a, 0xb8, 0x55, 0x26,
-	0x26, 0x5b, 0x29, 0x1b, 0x63, 0x0b, 0x03, 0x12, 0x42, 0x28, 0x23, 0x4b, 0x64, 0x07, 0x93, 0x5a,
-	0x24, 0x3e, 0x95, 0xed, 0x04, 0xfa, 0x16, 0x3c, 0x56, 0x25, 0x96, 0x8e, 0x4c, 0x15, 0x6a, 0xdf,
-	0x80, 0x27, 0x40, 0xa9, 0xd3, 0x0a, 0xba, 0x74, 0x3b, 0xfa, 0xce, 0x39, 0xbf, 0xdf, 0xf0, 0x79,
-	0x5d, 0x29, 0x98, 0x50, 0x25, 0x11, 0xf2, 0x29, 0xa7, 0x46, 0x80, 0x24, 0x55, 0x44, 0x78, 0xc5,
-	0xa5, 0xc1, 0x63, 0x05, 0x06, 0xfc, 0x43, 0x7b, 0x80, 0x37, 0x07, 0xb8, 0x8a, 0x3a, 0x47, 0x19,
-	0x64, 0xb0, 0xda, 0x93, 0x7a, 0xb2, 0xa7, 0x1d, 0x94, 0x82, 0x2e, 0x40, 0x13, 0x46, 0x35, 0x27,
-	0x55, 0xc4, 0xb8, 0xa1, 0x11, 0x49, 0x41, 0x48, 0xbb, 0x0f, 0xdf, 0x5b, 0x5e, 0xe7, 0xba, 0x46,
-	0xdf, 0xac, 0x59, 0x57, 0x42, 0x1b, 0x25, 0x58, 0x59, 0xcf, 0x3e, 0xf3, 0xf6, 0xb4, 0xa1, 0xcf,
-	0x42, 0x66, 0x89, 0xe2, 0x2f, 0x54, 0x3d, 0xea, 0xc0, 0xed, 0xb9, 0xe7, 0xff, 0xfa, 0xa7, 0xd8,
-	0x82, 0x71, 0x0d, 0xc6, 0x0d, 0x18, 0x0f, 0x41, 0xc8, 0x01, 0x9a, 0xce, 0xbb, 0xce, 0xd7, 0xbc,
-	0x7b, 0x32, 0xa1, 0x45, 0x7e, 0x19, 0x6e, 0xfd, 0x87, 0x71, 0xbb, 0x49, 0x62, 0x1b, 0xf8, 0x23,
-	0xef, 0x40, 0x1b, 0x45, 0x0d, 0xcf, 0x44, 0x9a, 0x28, 0xae, 0xb9, 0xaa, 0x78, 0xd0, 0xda, 0x65,
-	0xe9, 0x35, 0x96, 0x60, 0x6d, 0xd9, 0x22, 0x84, 0xf1, 0xfe, 0x26, 0x8b, 0x6d, 0xe4, 0x27, 0x5e,
-	0x3b, 0x85, 0xa2, 0x28, 0xa5, 0x30, 0x93, 0x64, 0x0c, 0x90, 0x07, 0x7f, 0x76, 0x69, 0xce, 0x1a,
-	0xcd, 0xb1, 0xd5, 0xfc, 0x7e, 0x0f, 0xe3, 0xff, 0x9b, 0xe0, 0x1e, 0x20, 0x1f, 0xdc, 0x4e, 0x17,
-	0xc8, 0x9d, 0x2d, 0x90, 0xfb, 0xb9, 0x40, 0xee, 0xdb, 0x12, 0x39, 0xb3, 0x25, 0x72, 0x3e, 0x96,
-	0xc8, 0x79, 0xe8, 0x67, 0xc2, 0x8c, 0x4a, 0x86, 0x53, 0x28, 0xc8, 0xdd, 0xaa, 0xbd, 0xe1, 0x88,
-	0x0a, 0x49, 0x9a, 0xaa, 0xab, 0x3e, 0x79, 0xfd, 0xd1, 0xb7, 0x99, 0x8c, 0xb9, 0x66, 0x7f, 0x57,
-	0x15, 0x5d, 0x7c, 0x07, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x1d, 0x75, 0xa0, 0x10, 0x02, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbd, 0xce, 0xd3, 0x30,
+	0x14, 0x86, 0x13, 0x8a, 0x18, 0x52, 0x51, 0x68, 0xf8, 0x51, 0x5a, 0x09, 0xb7, 0x0a, 0x4b, 0x27,
+	0x5b, 0x09, 0x1b, 0x1b, 0x2d, 0x0c, 0x48, 0x08, 0xa1, 0x8c, 0x2c, 0x91, 0x63, 0x4c, 0x6a, 0x91,
+	0xf8, 0x44, 0xb6, 0x13, 0xe8, 0x5d, 0x70, 0x29, 0x5c, 0x46, 0xc7, 0x8e, 0x4c, 0x15, 0x6a, 0xef,
+	0x80, 0x2b, 0xf8, 0x94, 0x9f, 0xfe, 0x7c, 0x55, 0xa5, 0x6e, 0xd6, 0x73, 0xde, 0xf3, 0x3e, 0x83,
+	0x8f, 0x33, 0x91, 0x22, 0x11, 0xaa, 0x24, 0x42, 0x7e, 0xcf, 0xa8, 0x11, 0x20, 0x49, 0x15, 0x10,
+	0x5e, 0x71, 0x69, 0x70, 0xa1, 0xc0, 0x80, 0xfb, 0xac, 0x0d, 0xe0, 0x63, 0x00, 0x57, 0xc1, 0xf8,
+	0x79, 0x0a, 0x29, 0x34, 0x73, 0x52, 0xbf, 0xda, 0xe8, 0x18, 0x31, 0xd0, 0x39, 0x68, 0x92, 0x50,
+	0xcd, 0x49, 0x15, 0x24, 0xdc, 0xd0, 0x80, 0x30, 0x10, 0xb2, 0x9b, 0xbf, 0xbe, 0xe6, 0x3a, 0xf5,
+	0x36, 0x21, 0xff, 0x4f, 0xcf, 0x19, 0x7f, 0xa8, 0xfd, 0x1f, 0x0f, 0x83, 0xf7, 0x42, 0x1b, 0x25,
+	0x92, 0xb2, 0x7e, 0xbb, 0x89, 0xf3, 0x44, 0x1b, 0xfa, 0x43, 0xc8, 0x34, 0x56, 0xfc, 0x27, 0x55,
+	0xdf, 0xb4, 0x67, 0x4f, 0xed, 0x59, 0x3f, 0x1c, 0xe1, 0xd6, 0x8e, 0x6b, 0x3b, 0xee, 0xec, 0x78,
+	0x01, 0x42, 0xce, 0xd1, 0x7a, 0x3b, 0xb1, 0xfe, 0x6f, 0x27, 0x2f, 0x57, 0x34, 0xcf, 0xde, 0xfa,
+	0x17, 0xfb, 0x7e, 0x34, 0xe8, 0x48, 0xd4, 0x02, 0x77, 0xe9, 0x0c, 0xb5, 0x51, 0xd4, 0xf0, 0x54,
+	0xb0, 0x58, 0x71, 0xcd, 0x55, 0xc5, 0xbd, 0x07, 0xb7, 0x2c, 0xd3, 0xce, 0xe2, 0x1d, 0x2c, 0x17,
+	0x0d, 0x7e, 0xf4, 0xf4, 0xc8, 0xa2, 0x16, 0xb9, 0xb1, 0x33, 0x60, 0x90, 0xe7, 0xa5, 0x14, 0x66,
+	0x15, 0x17, 0x00, 0x99, 0xd7, 0xbb, 0xa5, 0x79, 0xd5, 0x69, 0x5e, 0xb4, 0x9a, 0xfb, 0xeb, 0x7e,
+	0xf4, 0xf8, 0x08, 0xbe, 0x00, 0x64, 0x2e, 0x73, 0x1c, 0xc5, 0x99, 0x28, 0x04, 0x97, 0x46, 0x7b,
+	0x0f, 0xa7, 0xbd, 0x59, 0x3f, 0x9c, 0xe1, 0x2b, 0x5f, 0x8a, 0xa3, 0x43, 0xec, 0x5d, 0x96, 0x01,
+	0x6b, 0xf0, 0x7c, 0xd4, 0xb9, 0x86, 0xad, 0xeb, 0xd4, 0xe4, 0x47, 0x67, 0xb5, 0xf3, 0x4f, 0xeb,
+	0x1d, 0xb2, 0x37, 0x3b, 0x64, 0xff, 0xdb, 0x21, 0xfb, 0xf7, 0x1e, 0x59, 0x9b, 0x3d, 0xb2, 0xfe,
+	0xee, 0x91, 0xf5, 0x35, 0x4c, 0x85, 0x59, 0x96, 0x09, 0x66, 0x90, 0x93, 0xcf, 0x8d, 0x74, 0xb1,
+	0xa4, 0x42, 0x92, 0xee, 0x10, 0xaa, 0x90, 0xfc, 0x3a, 0xbb, 0x06, 0xb3, 0x2a, 0xb8, 0x4e, 0x1e,
+	0x35, 0x77, 0xf0, 0xe6, 0x6e, 0x00, 0xf8, 0x52, 0x02, 0x69, 0x9a, 0x02, 0x00, 0x00,
 }
 
 func (m *EventInflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -137,6 +148,20 @@ func (m *EventInflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.CommunityPool.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -193,6 +218,12 @@ func (m *EventInflationDistribution) Size() (n int) {
 	n += 1 + l + sovEvent(uint64(l))
 	l = m.CommunityPool.Size()
 	n += 1 + l + sovEvent(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -330,6 +361,40 @@ func (m *EventInflationDistribution) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, RecipientAllocation{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -4,6 +4,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
 )
 
 // AccountKeeper defines the contract required for account APIs.
@@ -47,3 +49,9 @@ type SudoKeeper interface {
 	GetRootAddr(ctx sdk.Context) (sdk.AccAddress, error)
 	CheckPermissions(contract sdk.AccAddress, ctx sdk.Context) error
 }
+
+// EpochsKeeper defines the x/epochs functionality used to locate the current
+// inflation epoch.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, error)
+}
@@ -59,13 +59,19 @@ func (m MsgEditInflationParams) ValidateBasic() error {
 			return fmt.Errorf("inflation distribution strategic reserves should not be nil")
 		}
 
-		sum := sdkmath.LegacyNewDec(0)
-		sum = sum.Add(m.InflationDistribution.CommunityPool)
-		sum = sum.Add(m.InflationDistribution.StakingRewards)
-		sum = sum.Add(m.InflationDistribution.StrategicReserves)
+		for _, recipient := range m.InflationDistribution.Recipients {
+			if recipient.Weight.IsNil() {
+				return fmt.Errorf("inflation recipient %s weight should not be nil", recipient.Address)
+			}
+		}
+
+		sum := m.InflationDistribution.TotalProportions()
 		if !sum.Equal(sdkmath.LegacyOneDec()) {
 			return fmt.Errorf("inflation distribution sum should be 1, got %s", sum)
 		}
+		if err := validateInflationDistribution(*m.InflationDistribution); err != nil {
+			return err
+		}
 	}
 
 	if m.PolynomialFactors != nil {
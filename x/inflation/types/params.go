@@ -5,6 +5,10 @@ import (
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
 )
 
 var (
@@ -100,14 +104,52 @@ func validateInflationDistribution(i any) error {
 		return errors.New("pool incentives distribution ratio must not be negative")
 	}
 
-	totalProportions := v.StakingRewards.Add(v.StrategicReserves).Add(v.CommunityPool)
-	if !totalProportions.Equal(sdkmath.LegacyOneDec()) {
+	seenRecipients := make(map[string]bool)
+	for _, recipient := range v.Recipients {
+		addr, err := recipient.AccAddress()
+		if err != nil {
+			return err
+		}
+		if seenRecipients[addr.String()] {
+			return fmt.Errorf("duplicate inflation recipient %s", recipient.Address)
+		}
+		seenRecipients[addr.String()] = true
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return fmt.Errorf("inflation recipient %s must have a positive weight", recipient.Address)
+		}
+	}
+
+	if !v.TotalProportions().Equal(sdkmath.LegacyOneDec()) {
 		return errors.New("total distributions ratio should be 1")
 	}
 
 	return nil
 }
 
+// AccAddress returns the address of the recipient, given either in Bech32 or
+// as a hex EVM address.
+func (r InflationRecipient) AccAddress() (sdk.AccAddress, error) {
+	if gethcommon.IsHexAddress(r.Address) {
+		return eth.EthAddrToNibiruAddr(gethcommon.HexToAddress(r.Address)), nil
+	}
+	addr, err := sdk.AccAddressFromBech32(r.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid inflation recipient address %q: %w", r.Address, err)
+	}
+	return addr, nil
+}
+
+// TotalProportions returns the sum of the proportions of every bucket and
+// recipient in the distribution.
+func (d InflationDistribution) TotalProportions() sdkmath.LegacyDec {
+	total := d.StakingRewards.Add(d.StrategicReserves).Add(d.CommunityPool)
+	for _, recipient := range d.Recipients {
+		total = total.Add(recipient.Weight)
+	}
+	return total
+}
+
 func validateBool(i any) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -4,13 +4,18 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	inflationtypes "github.com/NibiruChain/nibiru/v2/x/inflation/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestParamsValidate(t *testing.T) {
+	recipientA := testutil.AccAddress().String()
+	recipientB := testutil.AccAddress().String()
 	testCases := []struct {
 		name     string
 		params   inflationtypes.Params
@@ -122,6 +127,97 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid - inflation distribution with recipients",
+			inflationtypes.Params{
+				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
+				InflationDistribution: inflationtypes.InflationDistribution{
+					StakingRewards:    sdkmath.LegacyNewDecWithPrec(5, 1),
+					CommunityPool:     sdkmath.LegacyNewDecWithPrec(2, 1),
+					StrategicReserves: sdkmath.LegacyNewDecWithPrec(1, 1),
+					Recipients: []inflationtypes.InflationRecipient{
+						{Address: recipientA, Weight: sdkmath.LegacyNewDecWithPrec(15, 2)},
+						{Address: recipientB, Weight: sdkmath.LegacyNewDecWithPrec(5, 2)},
+					},
+				},
+				EpochsPerPeriod: inflationtypes.DefaultEpochsPerPeriod,
+				PeriodsPerYear:  inflationtypes.DefaultPeriodsPerYear,
+			},
+			false,
+		},
+		{
+			"invalid - inflation distribution - recipients push the total above 1",
+			inflationtypes.Params{
+				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
+				InflationDistribution: inflationtypes.InflationDistribution{
+					StakingRewards:    sdkmath.LegacyNewDecWithPrec(5, 1),
+					CommunityPool:     sdkmath.LegacyNewDecWithPrec(3, 1),
+					StrategicReserves: sdkmath.LegacyNewDecWithPrec(2, 1),
+					Recipients: []inflationtypes.InflationRecipient{
+						{Address: recipientA, Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				EpochsPerPeriod: inflationtypes.DefaultEpochsPerPeriod,
+				PeriodsPerYear:  inflationtypes.DefaultPeriodsPerYear,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - duplicate recipient",
+			inflationtypes.Params{
+				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
+				InflationDistribution: inflationtypes.InflationDistribution{
+					StakingRewards:    sdkmath.LegacyNewDecWithPrec(5, 1),
+					CommunityPool:     sdkmath.LegacyNewDecWithPrec(2, 1),
+					StrategicReserves: sdkmath.LegacyNewDecWithPrec(1, 1),
+					Recipients: []inflationtypes.InflationRecipient{
+						{Address: recipientA, Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+						{Address: recipientA, Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				EpochsPerPeriod: inflationtypes.DefaultEpochsPerPeriod,
+				PeriodsPerYear:  inflationtypes.DefaultPeriodsPerYear,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - duplicate recipient in hex",
+			inflationtypes.Params{
+				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
+				InflationDistribution: inflationtypes.InflationDistribution{
+					StakingRewards:    sdkmath.LegacyNewDecWithPrec(5, 1),
+					CommunityPool:     sdkmath.LegacyNewDecWithPrec(2, 1),
+					StrategicReserves: sdkmath.LegacyNewDecWithPrec(1, 1),
+					Recipients: []inflationtypes.InflationRecipient{
+						{Address: recipientA, Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+						{
+							Address: eth.NibiruAddrToEthAddr(sdk.MustAccAddressFromBech32(recipientA)).Hex(),
+							Weight:  sdkmath.LegacyNewDecWithPrec(1, 1),
+						},
+					},
+				},
+				EpochsPerPeriod: inflationtypes.DefaultEpochsPerPeriod,
+				PeriodsPerYear:  inflationtypes.DefaultPeriodsPerYear,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - recipient with invalid address",
+			inflationtypes.Params{
+				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
+				InflationDistribution: inflationtypes.InflationDistribution{
+					StakingRewards:    sdkmath.LegacyNewDecWithPrec(5, 1),
+					CommunityPool:     sdkmath.LegacyNewDecWithPrec(2, 1),
+					StrategicReserves: sdkmath.LegacyNewDecWithPrec(2, 1),
+					Recipients: []inflationtypes.InflationRecipient{
+						{Address: "0xabc", Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				EpochsPerPeriod: inflationtypes.DefaultEpochsPerPeriod,
+				PeriodsPerYear:  inflationtypes.DefaultPeriodsPerYear,
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -449,6 +449,236 @@ func (m *QueryInflationRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryInflationRateResponse proto.InternalMessageInfo
 
+// QueryInflationProjectionsRequest is the request type for the
+// Query/InflationProjections RPC method.
+type QueryInflationProjectionsRequest struct {
+	// num_periods is the number of periods to project, starting at the current
+	// period. Defaults to every period until the max period if zero.
+	NumPeriods uint64 `protobuf:"varint,1,opt,name=num_periods,json=numPeriods,proto3" json:"num_periods,omitempty"`
+}
+
+func (m *QueryInflationProjectionsRequest) Reset()         { *m = QueryInflationProjectionsRequest{} }
+func (m *QueryInflationProjectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationProjectionsRequest) ProtoMessage()    {}
+func (*QueryInflationProjectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{10}
+}
+func (m *QueryInflationProjectionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationProjectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationProjectionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationProjectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationProjectionsRequest.Merge(m, src)
+}
+func (m *QueryInflationProjectionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationProjectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationProjectionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationProjectionsRequest proto.InternalMessageInfo
+
+func (m *QueryInflationProjectionsRequest) GetNumPeriods() uint64 {
+	if m != nil {
+		return m.NumPeriods
+	}
+	return 0
+}
+
+// QueryInflationProjectionsResponse is the response type for the
+// Query/InflationProjections RPC method.
+type QueryInflationProjectionsResponse struct {
+	// current_period is the period that the first projection corresponds to.
+	CurrentPeriod uint64 `protobuf:"varint,1,opt,name=current_period,json=currentPeriod,proto3" json:"current_period,omitempty"`
+	// skipped_epochs is the number of epochs that the inflation module has been
+	// disabled.
+	SkippedEpochs uint64 `protobuf:"varint,2,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
+	// projections holds one entry per period. Periods at or beyond the max
+	// period mint nothing and are left out.
+	Projections []PeriodProjection `protobuf:"bytes,3,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QueryInflationProjectionsResponse) Reset()         { *m = QueryInflationProjectionsResponse{} }
+func (m *QueryInflationProjectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationProjectionsResponse) ProtoMessage()    {}
+func (*QueryInflationProjectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{11}
+}
+func (m *QueryInflationProjectionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationProjectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationProjectionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationProjectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationProjectionsResponse.Merge(m, src)
+}
+func (m *QueryInflationProjectionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationProjectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationProjectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationProjectionsResponse proto.InternalMessageInfo
+
+func (m *QueryInflationProjectionsResponse) GetCurrentPeriod() uint64 {
+	if m != nil {
+		return m.CurrentPeriod
+	}
+	return 0
+}
+
+func (m *QueryInflationProjectionsResponse) GetSkippedEpochs() uint64 {
+	if m != nil {
+		return m.SkippedEpochs
+	}
+	return 0
+}
+
+func (m *QueryInflationProjectionsResponse) GetProjections() []PeriodProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// PeriodProjection is the projected inflation for a single period.
+type PeriodProjection struct {
+	// period is the inflation period of the projection.
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// num_epochs is the number of epochs that mint in the period. For the
+	// current period, this only counts the epochs that haven't ended yet.
+	NumEpochs uint64 `protobuf:"varint,2,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+	// epoch_mint_provision is the amount minted at the end of each epoch.
+	EpochMintProvision types.Coin `protobuf:"bytes,3,opt,name=epoch_mint_provision,json=epochMintProvision,proto3" json:"epoch_mint_provision"`
+	// period_mint_provision is the amount minted over the "num_epochs" epochs.
+	PeriodMintProvision types.Coin `protobuf:"bytes,4,opt,name=period_mint_provision,json=periodMintProvision,proto3" json:"period_mint_provision"`
+	// staking_rewards is the part of "period_mint_provision" that goes to
+	// stakers.
+	StakingRewards types.Coin `protobuf:"bytes,5,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards"`
+	// strategic_reserve is the part of "period_mint_provision" that goes to the
+	// strategic reserve, which receives what is left after the other buckets and
+	// recipients.
+	StrategicReserve types.Coin `protobuf:"bytes,6,opt,name=strategic_reserve,json=strategicReserve,proto3" json:"strategic_reserve"`
+	// community_pool is the part of "period_mint_provision" that goes to the
+	// community pool.
+	CommunityPool types.Coin `protobuf:"bytes,7,opt,name=community_pool,json=communityPool,proto3" json:"community_pool"`
+	// recipients are the parts of "period_mint_provision" that go to the custom
+	// inflation recipients.
+	Recipients []RecipientAllocation `protobuf:"bytes,8,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *PeriodProjection) Reset()         { *m = PeriodProjection{} }
+func (m *PeriodProjection) String() string { return proto.CompactTextString(m) }
+func (*PeriodProjection) ProtoMessage()    {}
+func (*PeriodProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{12}
+}
+func (m *PeriodProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodProjection.Merge(m, src)
+}
+func (m *PeriodProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodProjection proto.InternalMessageInfo
+
+func (m *PeriodProjection) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodProjection) GetNumEpochs() uint64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
+}
+
+func (m *PeriodProjection) GetEpochMintProvision() types.Coin {
+	if m != nil {
+		return m.EpochMintProvision
+	}
+	return types.Coin{}
+}
+
+func (m *PeriodProjection) GetPeriodMintProvision() types.Coin {
+	if m != nil {
+		return m.PeriodMintProvision
+	}
+	return types.Coin{}
+}
+
+func (m *PeriodProjection) GetStakingRewards() types.Coin {
+	if m != nil {
+		return m.StakingRewards
+	}
+	return types.Coin{}
+}
+
+func (m *PeriodProjection) GetStrategicReserve() types.Coin {
+	if m != nil {
+		return m.StrategicReserve
+	}
+	return types.Coin{}
+}
+
+func (m *PeriodProjection) GetCommunityPool() types.Coin {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return types.Coin{}
+}
+
+func (m *PeriodProjection) GetRecipients() []RecipientAllocation {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -457,7 +687,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9cef9ea5e4d20e5e, []int{10}
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{13}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,7 +726,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9cef9ea5e4d20e5e, []int{11}
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{14}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,6 +773,9 @@ func init() {
 	proto.RegisterType((*QueryCirculatingSupplyResponse)(nil), "nibiru.inflation.v1.QueryCirculatingSupplyResponse")
 	proto.RegisterType((*QueryInflationRateRequest)(nil), "nibiru.inflation.v1.QueryInflationRateRequest")
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "nibiru.inflation.v1.QueryInflationRateResponse")
+	proto.RegisterType((*QueryInflationProjectionsRequest)(nil), "nibiru.inflation.v1.QueryInflationProjectionsRequest")
+	proto.RegisterType((*QueryInflationProjectionsResponse)(nil), "nibiru.inflation.v1.QueryInflationProjectionsResponse")
+	proto.RegisterType((*PeriodProjection)(nil), "nibiru.inflation.v1.PeriodProjection")
 	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.inflation.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.inflation.v1.QueryParamsResponse")
 }
@@ -550,53 +783,70 @@ func init() {
 func init() { proto.RegisterFile("nibiru/inflation/v1/query.proto", fileDescriptor_9cef9ea5e4d20e5e) }
 
 var fileDescriptor_9cef9ea5e4d20e5e = []byte{
-	// 721 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x4e, 0x13, 0x41,
-	0x1c, 0xc7, 0xbb, 0x06, 0x6b, 0x1c, 0x03, 0x09, 0x03, 0x31, 0xb2, 0x85, 0x2d, 0x96, 0x10, 0x20,
-	0x84, 0x9d, 0xb4, 0xf5, 0xe2, 0x95, 0xe2, 0xc1, 0x04, 0x0d, 0x96, 0x8b, 0xf1, 0xd2, 0x4c, 0xb7,
-	0xe3, 0x76, 0x02, 0x9d, 0x59, 0x76, 0xa6, 0x8d, 0xbd, 0x19, 0x7d, 0x01, 0x13, 0xcf, 0x9e, 0x3c,
-	0x98, 0x98, 0x78, 0x21, 0x3e, 0x04, 0x47, 0xa2, 0x17, 0xe3, 0x01, 0x0d, 0xf8, 0x20, 0x66, 0x67,
-	0x66, 0x4b, 0x4b, 0xa7, 0xad, 0x1c, 0x3c, 0x75, 0x77, 0x7e, 0xff, 0xbe, 0xf3, 0xdd, 0xf9, 0x4c,
-	0x41, 0x9e, 0xd1, 0x3a, 0x8d, 0xdb, 0x88, 0xb2, 0x97, 0x87, 0x58, 0x52, 0xce, 0x50, 0xa7, 0x88,
-	0x8e, 0xda, 0x24, 0xee, 0xfa, 0x51, 0xcc, 0x25, 0x87, 0x73, 0x3a, 0xc1, 0xef, 0x25, 0xf8, 0x9d,
-	0xa2, 0xeb, 0x05, 0x5c, 0xb4, 0xb8, 0x40, 0x75, 0x2c, 0x08, 0xea, 0x14, 0xeb, 0x44, 0xe2, 0x22,
-	0x0a, 0x38, 0x65, 0xba, 0xc8, 0xbd, 0x6f, 0xeb, 0x1a, 0x12, 0x46, 0x04, 0x15, 0x26, 0x65, 0x3e,
-	0xe4, 0x21, 0x57, 0x8f, 0x28, 0x79, 0x32, 0xab, 0x8b, 0x21, 0xe7, 0xe1, 0x21, 0x41, 0x38, 0xa2,
-	0x08, 0x33, 0xc6, 0xa5, 0xaa, 0x4e, 0x6b, 0x16, 0xf4, 0xd8, 0x9a, 0x2e, 0xd3, 0x2f, 0x3a, 0x54,
-	0x98, 0x07, 0xf0, 0x59, 0xa2, 0x7a, 0x8f, 0xc4, 0x94, 0x37, 0xaa, 0xe4, 0xa8, 0x4d, 0x84, 0x2c,
-	0x6c, 0x81, 0xb9, 0x81, 0x55, 0x11, 0x71, 0x26, 0x08, 0xbc, 0x0b, 0xb2, 0x91, 0x5a, 0xb9, 0xe7,
-	0x2c, 0x3b, 0xeb, 0x53, 0x55, 0xf3, 0x56, 0x58, 0x06, 0x9e, 0x4a, 0x7f, 0x14, 0xf1, 0xa0, 0xf9,
-	0x84, 0x32, 0xb9, 0x17, 0xf3, 0x0e, 0x15, 0x94, 0xb3, 0xb4, 0xe1, 0x27, 0x07, 0xe4, 0x47, 0xa6,
-	0x98, 0xee, 0x6f, 0x1d, 0x30, 0x4f, 0x92, 0x70, 0xad, 0x45, 0x99, 0x4c, 0xc4, 0xea, 0x04, 0x35,
-	0xec, 0x4e, 0x69, 0xd1, 0x37, 0xc2, 0x13, 0xf3, 0x7c, 0x63, 0x9e, 0xbf, 0x43, 0x82, 0x0a, 0xa7,
-	0x6c, 0xbb, 0x7c, 0x72, 0x96, 0xcf, 0x7c, 0xfe, 0x95, 0xdf, 0x0c, 0xa9, 0x6c, 0xb6, 0xeb, 0x7e,
-	0xc0, 0x5b, 0x66, 0xa3, 0xe6, 0x67, 0x4b, 0x34, 0x0e, 0x90, 0xec, 0x46, 0x44, 0xa4, 0x35, 0xa2,
-	0x0a, 0xc9, 0x90, 0x9a, 0x42, 0x0e, 0x2c, 0x28, 0xa1, 0xfb, 0x07, 0x34, 0x8a, 0x48, 0x43, 0xe9,
-	0x15, 0xe9, 0x36, 0x2a, 0xc0, 0xb5, 0x05, 0xcd, 0x06, 0x56, 0xc1, 0x8c, 0xd0, 0x81, 0x9a, 0x6a,
-	0x2c, 0x8c, 0x4d, 0xd3, 0xa2, 0x3f, 0xbd, 0x90, 0x07, 0x4b, 0xaa, 0x49, 0x85, 0xc6, 0x41, 0x3b,
-	0xf9, 0xcc, 0x2c, 0xdc, 0x6f, 0x47, 0xd1, 0x61, 0x37, 0x9d, 0xf2, 0xd1, 0x31, 0x7e, 0x5a, 0x32,
-	0xcc, 0xa8, 0xd7, 0x0e, 0x80, 0xc1, 0x65, 0xb4, 0x26, 0x54, 0xf8, 0xff, 0x39, 0x35, 0x1b, 0x5c,
-	0x95, 0xd2, 0x33, 0xea, 0x71, 0x7a, 0x56, 0xab, 0x58, 0x92, 0x74, 0x0b, 0x1d, 0x63, 0xd4, 0x95,
-	0xa0, 0x51, 0xff, 0x1c, 0xcc, 0xf4, 0x4e, 0x78, 0x2d, 0xc6, 0x92, 0x28, 0xe1, 0xb7, 0xb7, 0x8b,
-	0x89, 0xb4, 0x9f, 0x67, 0xf9, 0x9c, 0x16, 0x22, 0x1a, 0x07, 0x3e, 0xe5, 0xa8, 0x85, 0x65, 0xd3,
-	0xdf, 0x25, 0x21, 0x0e, 0xba, 0x3b, 0x24, 0xf8, 0xf6, 0x75, 0x0b, 0x98, 0xed, 0xed, 0x90, 0xa0,
-	0x3a, 0x4d, 0xfb, 0x27, 0x5c, 0x1e, 0x67, 0x1c, 0xe3, 0x56, 0xef, 0xb3, 0xed, 0xa5, 0xc7, 0xd9,
-	0xac, 0x1a, 0x19, 0x0f, 0x41, 0x36, 0x52, 0x2b, 0xc6, 0xb7, 0x9c, 0x6f, 0x61, 0xd6, 0xd7, 0x45,
-	0xdb, 0x53, 0x89, 0xb6, 0xaa, 0x29, 0x28, 0x1d, 0xdf, 0x02, 0x37, 0x55, 0xcb, 0xe4, 0x4b, 0x64,
-	0x35, 0x26, 0x70, 0xcd, 0x5a, 0x3f, 0x8c, 0x97, 0xbb, 0x3e, 0x39, 0x51, 0x4b, 0x2c, 0xac, 0xbc,
-	0xf9, 0xfe, 0xe7, 0xfd, 0x8d, 0x25, 0x98, 0x43, 0xb6, 0x9b, 0x41, 0xe3, 0x07, 0x8f, 0x1d, 0x00,
-	0x87, 0xb9, 0x82, 0xe5, 0xd1, 0x53, 0x46, 0x82, 0xea, 0x3e, 0xb8, 0x5e, 0x91, 0x91, 0x59, 0x54,
-	0x32, 0x37, 0xe1, 0x86, 0x55, 0xa6, 0x0d, 0x6a, 0xf8, 0xc1, 0x01, 0xd3, 0x03, 0x18, 0x41, 0x7f,
-	0xf4, 0x68, 0x1b, 0x8c, 0x2e, 0xfa, 0xe7, 0x7c, 0xa3, 0x72, 0x53, 0xa9, 0x5c, 0x85, 0x2b, 0x56,
-	0x95, 0x83, 0xe8, 0xc2, 0x2f, 0x0e, 0x98, 0x1d, 0xe2, 0x0f, 0x96, 0x46, 0xcf, 0x1c, 0x85, 0xb3,
-	0x5b, 0xbe, 0x56, 0x8d, 0xd1, 0x8a, 0x94, 0xd6, 0x0d, 0xb8, 0x66, 0xd5, 0x3a, 0x8c, 0xbe, 0xf2,
-	0x73, 0x80, 0xb6, 0x71, 0x7e, 0xda, 0x98, 0x1d, 0xe7, 0xa7, 0x15, 0xe3, 0x09, 0x7e, 0x0e, 0x12,
-	0xae, 0x39, 0x51, 0xf0, 0x8c, 0xe5, 0xa4, 0x9f, 0xdb, 0xb1, 0x9c, 0x0c, 0xa0, 0x3c, 0x89, 0x13,
-	0x8d, 0xf0, 0xee, 0xc9, 0xb9, 0xe7, 0x9c, 0x9e, 0x7b, 0xce, 0xef, 0x73, 0xcf, 0x79, 0x77, 0xe1,
-	0x65, 0x4e, 0x2f, 0xbc, 0xcc, 0x8f, 0x0b, 0x2f, 0xf3, 0xa2, 0xd4, 0x77, 0x17, 0x3e, 0x55, 0x0d,
-	0x2a, 0x4d, 0x4c, 0x59, 0xda, 0xac, 0x53, 0x42, 0xaf, 0xfa, 0x3a, 0xaa, 0xbb, 0xb1, 0x9e, 0x55,
-	0x7f, 0xa0, 0xe5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x5d, 0x2c, 0xa8, 0x0a, 0x08, 0x00,
-	0x00,
+	// 1006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x77, 0xbb, 0x61, 0xf7, 0x55, 0x29, 0xdb, 0x69, 0x41, 0xa9, 0xbb, 0x4d, 0xba, 0xa9,
+	0xaa, 0xcd, 0x52, 0x6a, 0x2b, 0x29, 0x20, 0x71, 0xa4, 0x29, 0x08, 0xa4, 0xee, 0x2a, 0x9b, 0x5e,
+	0x10, 0x97, 0xc8, 0x71, 0x86, 0x74, 0x68, 0x3c, 0xe3, 0xf5, 0xd8, 0x81, 0xdc, 0x10, 0xfc, 0x01,
+	0x24, 0xce, 0x1c, 0x10, 0x07, 0x24, 0x24, 0x2e, 0x88, 0x0b, 0x37, 0x8e, 0x7b, 0x5c, 0xc1, 0x05,
+	0x71, 0x58, 0x50, 0xcb, 0x99, 0xdf, 0x80, 0x3c, 0x33, 0x4e, 0xec, 0x66, 0xdc, 0xba, 0x07, 0x4e,
+	0x71, 0x66, 0xbe, 0xf7, 0xbd, 0x6f, 0x9e, 0xbf, 0x37, 0xcf, 0x50, 0xa3, 0xa4, 0x4f, 0x82, 0xc8,
+	0x26, 0xf4, 0xe3, 0x91, 0x13, 0x12, 0x46, 0xed, 0x71, 0xd3, 0x7e, 0x1a, 0xe1, 0x60, 0x62, 0xf9,
+	0x01, 0x0b, 0x19, 0x5a, 0x95, 0x00, 0x6b, 0x0a, 0xb0, 0xc6, 0x4d, 0xb3, 0xea, 0x32, 0xee, 0x31,
+	0x6e, 0xf7, 0x1d, 0x8e, 0xed, 0x71, 0xb3, 0x8f, 0x43, 0xa7, 0x69, 0xbb, 0x8c, 0x50, 0x19, 0x64,
+	0xde, 0xd7, 0xb1, 0x0e, 0x31, 0xc5, 0x9c, 0x70, 0x05, 0xd9, 0xd6, 0x41, 0x66, 0x49, 0x24, 0x68,
+	0x6d, 0xc8, 0x86, 0x4c, 0x3c, 0xda, 0xf1, 0x93, 0x5a, 0xbd, 0x37, 0x64, 0x6c, 0x38, 0xc2, 0xb6,
+	0xe3, 0x13, 0xdb, 0xa1, 0x94, 0x85, 0x22, 0x24, 0x21, 0x5e, 0x97, 0xda, 0x7a, 0x32, 0x4c, 0xfe,
+	0x91, 0x5b, 0xf5, 0x35, 0x40, 0x4f, 0xe2, 0xa3, 0x75, 0x70, 0x40, 0xd8, 0xa0, 0x8b, 0x9f, 0x46,
+	0x98, 0x87, 0xf5, 0x3d, 0x58, 0xcd, 0xac, 0x72, 0x9f, 0x51, 0x8e, 0xd1, 0xab, 0x50, 0xf2, 0xc5,
+	0x4a, 0xc5, 0xd8, 0x32, 0x1a, 0x8b, 0x5d, 0xf5, 0xaf, 0xbe, 0x05, 0x55, 0x01, 0x7f, 0xd7, 0x67,
+	0xee, 0xc9, 0x23, 0x42, 0xc3, 0x4e, 0xc0, 0xc6, 0x84, 0x13, 0x46, 0x13, 0xc2, 0xef, 0x0d, 0xa8,
+	0xe5, 0x42, 0x14, 0xfb, 0x97, 0x06, 0xac, 0xe1, 0x78, 0xbb, 0xe7, 0x11, 0x1a, 0xf6, 0xfc, 0x04,
+	0x20, 0x92, 0x2d, 0xb5, 0xee, 0x59, 0x4a, 0x78, 0x5c, 0x61, 0x4b, 0x55, 0xd8, 0x3a, 0xc4, 0x6e,
+	0x9b, 0x11, 0x7a, 0xb0, 0xff, 0xec, 0x45, 0x6d, 0xe1, 0x87, 0xbf, 0x6a, 0xbb, 0x43, 0x12, 0x9e,
+	0x44, 0x7d, 0xcb, 0x65, 0x9e, 0x3a, 0xa8, 0xfa, 0xd9, 0xe3, 0x83, 0x53, 0x3b, 0x9c, 0xf8, 0x98,
+	0x27, 0x31, 0xbc, 0x8b, 0xf0, 0x9c, 0x9a, 0xfa, 0x06, 0xac, 0x0b, 0xa1, 0xc7, 0xa7, 0xc4, 0xf7,
+	0xf1, 0x40, 0xe8, 0xe5, 0xc9, 0x31, 0xda, 0x60, 0xea, 0x36, 0xd5, 0x01, 0x76, 0x60, 0x99, 0xcb,
+	0x8d, 0x9e, 0x20, 0xe6, 0xaa, 0x4c, 0x65, 0x9e, 0x86, 0xd7, 0x6b, 0xb0, 0x29, 0x48, 0xda, 0x24,
+	0x70, 0xa3, 0xf8, 0xdd, 0xd2, 0xe1, 0x71, 0xe4, 0xfb, 0xa3, 0x49, 0x92, 0xe5, 0x3b, 0x03, 0xaa,
+	0x79, 0x08, 0x95, 0xea, 0x73, 0x03, 0x90, 0x3b, 0xdb, 0xed, 0x71, 0xb1, 0xfd, 0xff, 0x55, 0x6a,
+	0xc5, 0xbd, 0x28, 0x65, 0x5a, 0xa8, 0x0f, 0x12, 0x83, 0x76, 0x9d, 0x10, 0x27, 0x47, 0x18, 0x83,
+	0xa9, 0xdb, 0x54, 0xea, 0x3f, 0x84, 0xe5, 0xa9, 0xad, 0x7b, 0x81, 0x13, 0x62, 0x21, 0xfc, 0xce,
+	0x41, 0x33, 0x96, 0xf6, 0xe7, 0x8b, 0xda, 0x86, 0x14, 0xc2, 0x07, 0xa7, 0x16, 0x61, 0xb6, 0xe7,
+	0x84, 0x27, 0xd6, 0x11, 0x1e, 0x3a, 0xee, 0xe4, 0x10, 0xbb, 0xbf, 0xfd, 0xbc, 0x07, 0xea, 0x78,
+	0x87, 0xd8, 0xed, 0x96, 0x49, 0x3a, 0x43, 0xbd, 0x0d, 0x5b, 0xd9, 0xbc, 0x9d, 0x80, 0x7d, 0x82,
+	0xdd, 0xf8, 0x29, 0x79, 0x89, 0xa8, 0x06, 0x4b, 0x34, 0xf2, 0x7a, 0xd2, 0xbb, 0xc9, 0x3b, 0x02,
+	0x1a, 0x79, 0xd2, 0xed, 0xbc, 0xfe, 0xab, 0x01, 0xf7, 0x2f, 0x61, 0x99, 0xbd, 0x6d, 0x37, 0x0a,
+	0x02, 0x1c, 0x5b, 0x35, 0xdd, 0x14, 0x65, 0xb5, 0x2a, 0xd9, 0x34, 0xa6, 0xb8, 0xa1, 0x31, 0x05,
+	0x7a, 0x04, 0x4b, 0xfe, 0x2c, 0x49, 0xe5, 0xe6, 0xd6, 0xcd, 0xc6, 0x52, 0x6b, 0xc7, 0xd2, 0xdc,
+	0x34, 0x96, 0x24, 0x9e, 0x49, 0x3a, 0x58, 0x8c, 0xcb, 0xd6, 0x4d, 0xc7, 0xd7, 0xbf, 0x5d, 0x84,
+	0xbb, 0x17, 0x71, 0x79, 0xed, 0x8b, 0x36, 0x21, 0x3e, 0x7d, 0x56, 0xde, 0x1d, 0x1a, 0x79, 0x4a,
+	0xda, 0x93, 0x9c, 0xb6, 0xbc, 0x29, 0xcc, 0xb6, 0xae, 0x35, 0x9b, 0x70, 0x9a, 0xd4, 0xa5, 0x69,
+	0x32, 0x74, 0x0c, 0xaf, 0xc8, 0xdc, 0x17, 0x39, 0x17, 0x8b, 0x71, 0xae, 0xca, 0xe8, 0x2c, 0xe9,
+	0xfb, 0xf0, 0x32, 0x0f, 0x9d, 0xd3, 0xb8, 0x1d, 0x02, 0xfc, 0xa9, 0x13, 0x0c, 0x78, 0xe5, 0x56,
+	0x31, 0xba, 0x65, 0x15, 0xd7, 0x95, 0x61, 0xe8, 0x08, 0x56, 0x78, 0x18, 0xfb, 0x72, 0x48, 0xdc,
+	0x5e, 0x80, 0x39, 0x0e, 0xc6, 0xb8, 0x52, 0x2a, 0xc6, 0x75, 0x77, 0x1a, 0xd9, 0x95, 0x81, 0xe8,
+	0x3d, 0x58, 0x76, 0x99, 0xe7, 0x45, 0x94, 0x84, 0x93, 0x9e, 0xcf, 0xd8, 0xa8, 0xf2, 0x52, 0x31,
+	0xaa, 0xf2, 0x34, 0xac, 0xc3, 0xd8, 0x08, 0x3d, 0x06, 0x08, 0xb0, 0x4b, 0x7c, 0x82, 0x69, 0xc8,
+	0x2b, 0xb7, 0x85, 0x43, 0x1a, 0x5a, 0x87, 0x74, 0x13, 0xd8, 0x3b, 0xa3, 0x11, 0x73, 0x9d, 0x94,
+	0x49, 0x52, 0x0c, 0xb3, 0xab, 0xdf, 0x09, 0x1c, 0x6f, 0x7a, 0xc5, 0x75, 0x60, 0x35, 0xb3, 0xaa,
+	0xdc, 0xfe, 0x36, 0x94, 0x7c, 0xb1, 0xa2, 0xee, 0x98, 0x0d, 0xbd, 0x35, 0x05, 0x44, 0xe5, 0x52,
+	0x01, 0xad, 0x7f, 0x6f, 0xc3, 0x2d, 0x41, 0x19, 0xdf, 0x5a, 0x25, 0xd5, 0x16, 0x0f, 0xb4, 0xf1,
+	0xf3, 0xa3, 0xc8, 0x6c, 0x5c, 0x0d, 0x94, 0x12, 0xeb, 0xdb, 0x5f, 0xfc, 0xfe, 0xcf, 0xd7, 0x37,
+	0x36, 0xd1, 0x86, 0xad, 0x9b, 0xa3, 0xca, 0xeb, 0x3f, 0x19, 0x80, 0xe6, 0x67, 0x10, 0xda, 0xcf,
+	0xcf, 0x92, 0x3b, 0xd4, 0xcc, 0x37, 0xae, 0x17, 0xa4, 0x64, 0x36, 0x85, 0xcc, 0x5d, 0xf4, 0x50,
+	0x2b, 0x53, 0xd7, 0x69, 0xe8, 0x1b, 0x03, 0xca, 0x99, 0x91, 0x83, 0xac, 0xfc, 0xd4, 0xba, 0xc1,
+	0x65, 0xda, 0x85, 0xf1, 0x4a, 0xe5, 0xae, 0x50, 0xb9, 0x83, 0xb6, 0xb5, 0x2a, 0xb3, 0x37, 0x1a,
+	0xfa, 0xd1, 0x80, 0x95, 0xb9, 0x59, 0x85, 0x5a, 0xf9, 0x39, 0xf3, 0x46, 0x9f, 0xb9, 0x7f, 0xad,
+	0x18, 0xa5, 0xd5, 0x16, 0x5a, 0x1f, 0xa2, 0x07, 0x5a, 0xad, 0xf3, 0x63, 0x52, 0xd4, 0x33, 0x33,
+	0x99, 0x2e, 0xab, 0xa7, 0x6e, 0xbe, 0x99, 0x76, 0x61, 0x7c, 0xa1, 0x7a, 0x66, 0xa7, 0x21, 0xfa,
+	0xc5, 0x80, 0x35, 0xdd, 0xec, 0x41, 0x6f, 0x16, 0x48, 0x3b, 0x3f, 0xf1, 0xcc, 0xb7, 0xae, 0x1b,
+	0xa6, 0x44, 0xb7, 0x84, 0xe8, 0xd7, 0xd1, 0x6b, 0x57, 0x88, 0x4e, 0x4d, 0x1e, 0xd9, 0xe3, 0xa2,
+	0xf1, 0x2f, 0xed, 0xf1, 0xf4, 0x9d, 0x63, 0x36, 0xae, 0x06, 0x16, 0xeb, 0x71, 0x79, 0xfd, 0x1c,
+	0x3d, 0x3b, 0xab, 0x1a, 0xcf, 0xcf, 0xaa, 0xc6, 0xdf, 0x67, 0x55, 0xe3, 0xab, 0xf3, 0xea, 0xc2,
+	0xf3, 0xf3, 0xea, 0xc2, 0x1f, 0xe7, 0xd5, 0x85, 0x8f, 0x5a, 0xa9, 0x6f, 0x9e, 0xc7, 0x82, 0xa0,
+	0x7d, 0xe2, 0x10, 0x9a, 0x90, 0x8d, 0x5b, 0xf6, 0x67, 0x29, 0x46, 0xf1, 0x0d, 0xd4, 0x2f, 0x89,
+	0x0f, 0xe5, 0xfd, 0xff, 0x06, 0x00, 0x04, 0x59, 0x59, 0xc0, 0x17, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -622,6 +872,9 @@ type QueryClient interface {
 	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
+	// InflationProjections retrieves the projected mint amounts per epoch and
+	// per period for the next periods, split by the inflation distribution.
+	InflationProjections(ctx context.Context, in *QueryInflationProjectionsRequest, opts ...grpc.CallOption) (*QueryInflationProjectionsResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -679,6 +932,15 @@ func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateR
 	return out, nil
 }
 
+func (c *queryClient) InflationProjections(ctx context.Context, in *QueryInflationProjectionsRequest, opts ...grpc.CallOption) (*QueryInflationProjectionsResponse, error) {
+	out := new(QueryInflationProjectionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/InflationProjections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/Params", in, out, opts...)
@@ -701,6 +963,9 @@ type QueryServer interface {
 	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
+	// InflationProjections retrieves the projected mint amounts per epoch and
+	// per period for the next periods, split by the inflation distribution.
+	InflationProjections(context.Context, *QueryInflationProjectionsRequest) (*QueryInflationProjectionsResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -724,6 +989,9 @@ func (*UnimplementedQueryServer) CirculatingSupply(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) InflationRate(ctx context.Context, req *QueryInflationRateRequest) (*QueryInflationRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InflationRate not implemented")
 }
+func (*UnimplementedQueryServer) InflationProjections(ctx context.Context, req *QueryInflationProjectionsRequest) (*QueryInflationProjectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationProjections not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -822,6 +1090,24 @@ func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InflationProjections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationProjectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationProjections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.inflation.v1.Query/InflationProjections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationProjections(ctx, req.(*QueryInflationProjectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -864,6 +1150,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InflationRate",
 			Handler:    _Query_InflationRate_Handler,
 		},
+		{
+			MethodName: "InflationProjections",
+			Handler:    _Query_InflationProjections_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1143,7 +1433,7 @@ func (m *QueryInflationRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryInflationProjectionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1153,20 +1443,25 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryInflationProjectionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryInflationProjectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.NumPeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPeriods))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryInflationProjectionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1176,100 +1471,267 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryInflationProjectionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryInflationProjectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.SkippedEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SkippedEpochs))
+		i--
+		dAtA[i] = 0x10
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryPeriodRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CurrentPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentPeriod))
+		i--
+		dAtA[i] = 0x8
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPeriodResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Period != 0 {
-		n += 1 + sovQuery(uint64(m.Period))
+func (m *PeriodProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryEpochMintProvisionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *PeriodProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEpochMintProvisionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PeriodProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.EpochMintProvision.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QuerySkippedEpochsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QuerySkippedEpochsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.CommunityPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.SkippedEpochs != 0 {
-		n += 1 + sovQuery(uint64(m.SkippedEpochs))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.StrategicReserve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.StakingRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.PeriodMintProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.EpochMintProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NumEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPeriodRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *QueryEpochMintProvisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochMintProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochMintProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySkippedEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySkippedEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SkippedEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.SkippedEpochs))
+	}
+	return n
 }
 
 func (m *QueryCirculatingSupplyRequest) Size() (n int) {
@@ -1312,6 +1774,70 @@ func (m *QueryInflationRateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInflationProjectionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPeriods != 0 {
+		n += 1 + sovQuery(uint64(m.NumPeriods))
+	}
+	return n
+}
+
+func (m *QueryInflationProjectionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentPeriod))
+	}
+	if m.SkippedEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.SkippedEpochs))
+	}
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PeriodProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	if m.NumEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.NumEpochs))
+	}
+	l = m.EpochMintProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PeriodMintProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StakingRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StrategicReserve.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1976,6 +2502,484 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInflationProjectionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationProjectionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationProjectionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPeriods", wireType)
+			}
+			m.NumPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationProjectionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationProjectionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationProjectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPeriod", wireType)
+			}
+			m.CurrentPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedEpochs", wireType)
+			}
+			m.SkippedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, PeriodProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodMintProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StrategicReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, RecipientAllocation{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -33,6 +33,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAggregatePrevote(),
 		GetCmdQueryAggregateVote(),
 		GetCmdQueryVoteTargets(),
+		GetCmdQueryPairStatuses(),
+		GetCmdQueryExchangeRateEma(),
 	)
 
 	return oracleQueryCmd
@@ -368,3 +370,72 @@ func GetCmdQueryVoteTargets() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdQueryPairStatuses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-statuses",
+		Args:  cobra.NoArgs,
+		Short: "Query the circuit breaker status and staleness of every whitelisted pair",
+		Long: strings.TrimSpace(`
+Query the circuit breaker status, last good price, and staleness of every
+whitelisted pair.
+
+$ nibid query oracle pair-statuses
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PairStatuses(context.Background(), &types.QueryPairStatusesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryExchangeRateEma() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-ema [pair]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the exponential moving averages of the exchange rate of a pair",
+		Long: strings.TrimSpace(`
+Query the exponential moving averages of the exchange rate of a pair, one for
+every half-life in the module params.
+
+$ nibid query oracle exchange-rate-ema ubtc:uusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExchangeRateEma(
+				context.Background(), &types.QueryExchangeRateEmaRequest{Pair: pair},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -31,6 +31,7 @@ func GetTxCmd() *cobra.Command {
 		GetCmdAggregateExchangeRatePrevote(),
 		GetCmdAggregateExchangeRateVote(),
 		GetCmdEditOracleParams(),
+		GetCmdResumePair(),
 	)
 
 	return oracleTxCmd
@@ -347,3 +348,45 @@ $ nibid tx oracle edit-params --vote-period 10 --vote-threshold 0.5 --reward-ban
 
 	return cmd
 }
+
+// GetCmdResumePair lifts the circuit breaker of a halted pair.
+func GetCmdResumePair() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-pair [pair]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Resume a pair halted by its circuit breaker",
+		Long: strings.TrimSpace(`
+Resume a pair halted by its circuit breaker. The next tally of the pair is
+accepted whatever its deviation and becomes its new last good price.
+
+Requires sudo permissions.
+
+$ nibid tx oracle resume-pair ubtc:uusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgResumePair{
+				Sender: clientCtx.GetFromAddress().String(),
+				Pair:   pair,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
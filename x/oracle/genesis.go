@@ -15,6 +15,9 @@ import (
 // InitGenesis initialize default parameters
 // and the keeper's address to pubkey map
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
+	// params are set first because SetPrice reads them
+	keeper.Params.Set(ctx, data.Params)
+
 	for _, d := range data.FeederDelegations {
 		voter, err := sdk.ValAddressFromBech32(d.ValidatorAddress)
 		if err != nil {
@@ -70,6 +73,18 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		}
 	}
 
+	for _, config := range data.PairAggregationConfigs {
+		keeper.SetPairAggregationConfig(ctx, config)
+	}
+
+	for _, status := range data.PairStatuses {
+		keeper.PairStatuses.Insert(ctx, status.Pair, status)
+	}
+
+	for _, emas := range data.ExchangeRateEmas {
+		keeper.ExchangeRateEmas.Insert(ctx, emas.Pair, emas)
+	}
+
 	for _, pr := range data.Rewards {
 		keeper.Rewards.Insert(ctx, pr.Id, pr)
 	}
@@ -78,7 +93,6 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 	if len(data.Rewards) != 0 {
 		keeper.RewardsID.Set(ctx, data.Rewards[len(data.Rewards)-1].Id)
 	}
-	keeper.Params.Set(ctx, data.Params)
 
 	// check if the module account exists
 	moduleAcc := keeper.AccountKeeper.GetModuleAccount(ctx, types.ModuleName)
@@ -129,5 +143,8 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		keeper.Votes.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Values(),
 		pairs,
 		keeper.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values(),
+		keeper.PairAggregationConfigs.Iterate(ctx, collections.Range[asset.Pair]{}).Values(),
+		keeper.PairStatuses.Iterate(ctx, collections.Range[asset.Pair]{}).Values(),
+		keeper.ExchangeRateEmas.Iterate(ctx, collections.Range[asset.Pair]{}).Values(),
 	)
 }
@@ -2,11 +2,13 @@ package oracle_test
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/v2/app"
 	"github.com/NibiruChain/nibiru/v2/x/oracle"
 	"github.com/NibiruChain/nibiru/v2/x/oracle/keeper"
 	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
@@ -33,13 +35,34 @@ func TestExportInitGenesis(t *testing.T) {
 		VotePeriods: 100,
 		Coins:       sdk.NewCoins(sdk.NewInt64Coin("test", 1000)),
 	})
+	input.OracleKeeper.ExchangeRateEmas.Insert(input.Ctx, "pair1:pair2", types.ExchangeRateEma{
+		Pair:        "pair1:pair2",
+		TimestampMs: 1_700_000_000_000,
+		Emas: []types.EmaValue{
+			{HalfLife: time.Hour, Ema: sdkmath.LegacyMustNewDecFromStr("122.5")},
+			{HalfLife: 24 * time.Hour, Ema: sdkmath.LegacyNewDec(120)},
+		},
+	})
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
+	require.Len(t, genesis.ExchangeRateEmas, 1)
+	require.NoError(t, types.ValidateGenesis(genesis))
 
 	newInput := keeper.CreateTestFixture(t)
 	oracle.InitGenesis(newInput.Ctx, newInput.OracleKeeper, genesis)
 	newGenesis := oracle.ExportGenesis(newInput.Ctx, newInput.OracleKeeper)
 
 	require.Equal(t, genesis, newGenesis)
+
+	ema, timestampMs, err := newInput.OracleKeeper.GetExchangeRateEma(newInput.Ctx, "pair1:pair2", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("122.5"), ema)
+	require.EqualValues(t, 1_700_000_000_000, timestampMs)
+
+	t.Log("The moving averages survive a JSON round trip")
+	cdc := app.MakeEncodingConfig().Codec
+	var jsonGenesis types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(genesis), &jsonGenesis)
+	require.Equal(t, genesis.ExchangeRateEmas, jsonGenesis.ExchangeRateEmas)
 }
 
 func TestInitGenesis(t *testing.T) {
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -109,10 +111,14 @@ func (k Keeper) removeInvalidVotes(
 	ctx sdk.Context,
 	pairVotes map[asset.Pair]types.ExchangeRateVotes,
 	whitelistedPairs set.Set[asset.Pair],
-) {
+) error {
 	totalBondedPower := sdk.TokensToConsensusPower(
 		k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx),
 	)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get oracle params: %w", err)
+	}
 
 	// Iterate through sorted keys for deterministic ordering.
 	orderedPairVotes := omap.SortedMap_Pair[types.ExchangeRateVotes](pairVotes)
@@ -124,33 +130,37 @@ func (k Keeper) removeInvalidVotes(
 		}
 
 		// If the votes is not passed, remove it from the whitelistedPairs set
-		// to prevent slashing validators who did valid vote.
+		// to prevent slashing validators who did valid vote. The thresholds of
+		// the pair's aggregation config take precedence over the params.
+		config := k.GetPairAggregationConfig(ctx, pair)
 		if !isPassingVoteThreshold(
 			pairVotes[pair],
-			k.VoteThreshold(ctx).MulInt64(totalBondedPower).RoundInt(),
-			k.MinVoters(ctx),
+			config.VoteThresholdOr(params.VoteThreshold).MulInt64(totalBondedPower).RoundInt(),
+			config.MinVotersOr(params.MinVoters),
 		) {
 			delete(whitelistedPairs, pair)
 			delete(pairVotes, pair)
 			continue
 		}
 	}
+	return nil
 }
 
-// Tally calculates the median and returns it. Sets the set of voters to be
-// rewarded, i.e. voted within a reasonable spread from the weighted median to
-// the store.
+// Tally aggregates the votes with the method of the config and returns the
+// result. Sets the set of voters to be rewarded, i.e. voted within a reasonable
+// spread from the aggregated exchange rate to the store.
 //
 // ALERT: This function mutates validatorPerformances slice based on the votes
 // made by the validators.
 func Tally(
 	votes types.ExchangeRateVotes,
+	config types.PairAggregationConfig,
 	rewardBand sdkmath.LegacyDec,
 	validatorPerformances types.ValidatorPerformances,
 ) sdkmath.LegacyDec {
-	weightedMedian := votes.WeightedMedianWithAssertion()
-	standardDeviation := votes.StandardDeviation(weightedMedian)
-	rewardSpread := weightedMedian.Mul(rewardBand.QuoInt64(2))
+	exchangeRate := votes.Aggregate(config.Method, config.TrimFractionOr(sdkmath.LegacyZeroDec()))
+	standardDeviation := votes.StandardDeviation(exchangeRate)
+	rewardSpread := exchangeRate.Mul(rewardBand.QuoInt64(2))
 
 	if standardDeviation.GT(rewardSpread) {
 		rewardSpread = standardDeviation
@@ -159,8 +169,8 @@ func Tally(
 	missedValidators := make(map[string]bool)
 	for _, v := range votes {
 		// Filter votes winners & abstain voters
-		isInsideSpread := v.ExchangeRate.GTE(weightedMedian.Sub(rewardSpread)) &&
-			v.ExchangeRate.LTE(weightedMedian.Add(rewardSpread))
+		isInsideSpread := v.ExchangeRate.GTE(exchangeRate.Sub(rewardSpread)) &&
+			v.ExchangeRate.LTE(exchangeRate.Add(rewardSpread))
 		isAbstainVote := !v.ExchangeRate.IsPositive() // strictly less than zero, don't want to include zero
 		isMiss := !isInsideSpread && !isAbstainVote
 
@@ -183,5 +193,5 @@ func Tally(
 		validatorPerformances[v.Voter.String()] = validatorPerformance
 	}
 
-	return weightedMedian
+	return exchangeRate
 }
@@ -198,7 +198,7 @@ func TestFuzzTally(t *testing.T) {
 	f.Fuzz(&rewardBand)
 
 	require.NotPanics(t, func() {
-		Tally(votes, rewardBand, claimMap)
+		Tally(votes, types.PairAggregationConfig{}, rewardBand, claimMap)
 	})
 }
 
@@ -235,7 +235,7 @@ func TestTallyMissCount(t *testing.T) {
 	allVotes := append(btcVotes, ethVotes...)
 
 	claimMap := types.ValidatorPerformances{}
-	Tally(allVotes, sdkmath.LegacyNewDec(10), claimMap)
+	Tally(allVotes, types.PairAggregationConfig{}, sdkmath.LegacyNewDec(10), claimMap)
 	assert.Equal(t, int64(1), claimMap[ValAddrs[2].String()].MissCount)
 }
 
@@ -303,10 +303,11 @@ func TestRemoveInvalidBallots(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			fixture, _ := Setup(t)
 			assert.NotPanics(t, func() {
-				fixture.OracleKeeper.removeInvalidVotes(fixture.Ctx, tc.voteMap, set.New[asset.Pair](
+				err := fixture.OracleKeeper.removeInvalidVotes(fixture.Ctx, tc.voteMap, set.New[asset.Pair](
 					asset.NewPair(denoms.BTC, denoms.NUSD),
 					asset.NewPair(denoms.ETH, denoms.NUSD),
 				))
+				assert.NoError(t, err)
 			}, "voteMap: %v", tc.voteMap)
 		})
 	}
@@ -382,7 +383,7 @@ func TestFuzzPickReferencePair(t *testing.T) {
 	f.Fuzz(&voteMap)
 
 	assert.NotPanics(t, func() {
-		input.OracleKeeper.removeInvalidVotes(input.Ctx, voteMap, whitelistedPairs)
+		assert.NoError(t, input.OracleKeeper.removeInvalidVotes(input.Ctx, voteMap, whitelistedPairs))
 	}, "voteMap: %v", voteMap)
 }
 
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+// GetPairStatus returns the circuit breaker status of the pair. Pairs that
+// were never tallied are not halted and have no last good price.
+func (k Keeper) GetPairStatus(ctx sdk.Context, pair asset.Pair) types.PairStatus {
+	return k.PairStatuses.GetOr(ctx, pair, types.PairStatus{
+		Pair:          pair,
+		LastGoodPrice: sdkmath.LegacyZeroDec(),
+	})
+}
+
+// updatePairStatus runs the circuit breaker of the pair on a new tally and
+// returns whether the pair is halted. A tally that deviates from the last good
+// price by more than the max deviation halts the pair, and a tally back within
+// the bound resumes it.
+func (k Keeper) updatePairStatus(
+	ctx sdk.Context, config types.PairAggregationConfig, price sdkmath.LegacyDec,
+) (halted bool) {
+	status := k.GetPairStatus(ctx, config.Pair)
+
+	if config.ExceedsMaxDeviation(status.LastGoodPrice, price) {
+		if status.Halted {
+			return true
+		}
+		status.Halted = true
+		status.HaltedBlock = uint64(ctx.BlockHeight())
+		k.PairStatuses.Insert(ctx, config.Pair, status)
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventPairHalted{
+			Pair:          config.Pair.String(),
+			LastGoodPrice: status.LastGoodPrice,
+			Price:         price,
+		})
+		return true
+	}
+
+	if status.Halted {
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventPairResumed{
+			Pair:  config.Pair.String(),
+			Price: price,
+		})
+	}
+	status.Halted = false
+	status.HaltedBlock = 0
+	status.LastGoodPrice = price
+	status.LastGoodBlock = uint64(ctx.BlockHeight())
+	k.PairStatuses.Insert(ctx, config.Pair, status)
+	return false
+}
+
+// ResumePair lifts the circuit breaker of a halted pair and clears its last
+// good price, so that the next tally is accepted whatever its deviation. Until
+// then, the pair keeps the exchange rate it had before it was halted.
+func (k Keeper) ResumePair(ctx sdk.Context, pair asset.Pair) error {
+	status := k.GetPairStatus(ctx, pair)
+	if !status.Halted {
+		return fmt.Errorf("pair %s is not halted", pair)
+	}
+
+	status.Halted = false
+	status.HaltedBlock = 0
+	status.LastGoodPrice = sdkmath.LegacyZeroDec()
+	status.LastGoodBlock = 0
+	k.PairStatuses.Insert(ctx, pair, status)
+
+	price := sdkmath.LegacyZeroDec()
+	if rate, err := k.ExchangeRates.Get(ctx, pair); err == nil {
+		price = rate.ExchangeRate
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventPairResumed{
+		Pair:  pair.String(),
+		Price: price,
+	})
+}
+
+// GetExchangeRateChecked returns the exchange rate of the pair, failing if the
+// pair is halted by its circuit breaker or if the exchange rate is older than
+// the max age of the pair. Consumers that must not act on bad prices, such as
+// the oracle precompile, should read prices through this method.
+func (k Keeper) GetExchangeRateChecked(
+	ctx sdk.Context, pair asset.Pair,
+) (types.ExchangeRateAtBlock, error) {
+	rate, err := k.ExchangeRates.Get(ctx, pair)
+	if err != nil {
+		return rate, err
+	}
+
+	if k.GetPairStatus(ctx, pair).Halted {
+		return rate, sdkioerrors.Wrapf(types.ErrPairHalted, "pair %s", pair)
+	}
+
+	config := k.GetPairAggregationConfig(ctx, pair)
+	if config.IsStale(rate.CreatedBlock, ctx.BlockHeight()) {
+		return rate, sdkioerrors.Wrapf(
+			types.ErrStalePrice, "pair %s was last updated at block %d, max age is %d blocks",
+			pair, rate.CreatedBlock, config.MaxAgeBlocks,
+		)
+	}
+	return rate, nil
+}
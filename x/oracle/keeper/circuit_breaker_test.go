@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	input := CreateTestFixture(t)
+	k := input.OracleKeeper
+	ctx := input.Ctx.WithBlockHeight(10)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	maxDeviation := sdkmath.LegacyNewDecWithPrec(1, 1) // 10%
+	config := types.PairAggregationConfig{Pair: pair, MaxDeviation: &maxDeviation}
+	k.SetPairAggregationConfig(ctx, config)
+
+	k.updatePairStatus(ctx, config, sdkmath.LegacyNewDec(100))
+	k.updatePairStatus(ctx, config, sdkmath.LegacyNewDec(105))
+	status := k.GetPairStatus(ctx, pair)
+	require.False(t, status.Halted)
+	require.Equal(t, sdkmath.LegacyNewDec(105), status.LastGoodPrice)
+
+	t.Log("A tally beyond the max deviation halts the pair")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.updatePairStatus(ctx, config, sdkmath.LegacyNewDec(130))
+	status = k.GetPairStatus(ctx, pair)
+	require.True(t, status.Halted)
+	require.EqualValues(t, 10, status.HaltedBlock)
+	require.Equal(t, sdkmath.LegacyNewDec(105), status.LastGoodPrice)
+	testutil.RequireContainsTypedEvent(t, ctx, &types.EventPairHalted{
+		Pair:          pair.String(),
+		LastGoodPrice: sdkmath.LegacyNewDec(105),
+		Price:         sdkmath.LegacyNewDec(130),
+	})
+
+	k.SetPrice(ctx, pair, sdkmath.LegacyNewDec(130))
+	_, err := k.GetExchangeRateChecked(ctx, pair)
+	require.ErrorIs(t, err, types.ErrPairHalted)
+
+	t.Log("A tally back within the bound resumes the pair")
+	k.updatePairStatus(ctx, config, sdkmath.LegacyNewDec(110))
+	status = k.GetPairStatus(ctx, pair)
+	require.False(t, status.Halted)
+	require.Equal(t, sdkmath.LegacyNewDec(110), status.LastGoodPrice)
+
+	t.Log("ResumePair lets the next tally through")
+	require.ErrorContains(t, k.ResumePair(ctx, pair), "is not halted")
+	require.True(t, k.updatePairStatus(ctx, config, sdkmath.LegacyNewDec(200)))
+	require.NoError(t, k.ResumePair(ctx, pair))
+	status = k.GetPairStatus(ctx, pair)
+	require.False(t, status.Halted)
+	require.False(t, k.updatePairStatus(ctx, config, sdkmath.LegacyNewDec(200)))
+	status = k.GetPairStatus(ctx, pair)
+	require.Equal(t, sdkmath.LegacyNewDec(200), status.LastGoodPrice)
+}
+
+func TestCircuitBreaker_HaltedPairKeepsItsPrice(t *testing.T) {
+	fixture, msgServer := Setup(t)
+	k := fixture.OracleKeeper
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	maxDeviation := sdkmath.LegacyNewDecWithPrec(1, 1) // 10%
+	k.SetPairAggregationConfig(fixture.Ctx, types.PairAggregationConfig{
+		Pair: pair, MaxDeviation: &maxDeviation,
+	})
+
+	tally := func(blockHeight int64, price sdkmath.LegacyDec) {
+		fixture.Ctx = fixture.Ctx.WithBlockHeight(blockHeight)
+		for valIdx := range 4 {
+			MakeAggregatePrevoteAndVote(t, fixture, msgServer, blockHeight, types.ExchangeRateTuples{
+				{Pair: pair, ExchangeRate: price},
+			}, valIdx)
+		}
+		k.UpdateExchangeRates(fixture.Ctx)
+	}
+
+	tally(10, sdkmath.LegacyNewDec(100))
+	rateBefore, err := k.ExchangeRates.Get(fixture.Ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(100), rateBefore.ExchangeRate)
+
+	t.Log("The tally that trips the circuit breaker is not stored")
+	tally(20, sdkmath.LegacyNewDec(150))
+	require.True(t, k.GetPairStatus(fixture.Ctx, pair).Halted)
+	rateAfter, err := k.ExchangeRates.Get(fixture.Ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, rateBefore, rateAfter)
+
+	t.Log("Nor are the tallies while the pair is halted")
+	tally(30, sdkmath.LegacyNewDec(160))
+	rateAfter, err = k.ExchangeRates.Get(fixture.Ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, rateBefore, rateAfter)
+
+	t.Log("A tally back within the bound is stored")
+	tally(40, sdkmath.LegacyNewDec(105))
+	require.False(t, k.GetPairStatus(fixture.Ctx, pair).Halted)
+	rateAfter, err = k.ExchangeRates.Get(fixture.Ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(105), rateAfter.ExchangeRate)
+}
+
+func TestCircuitBreaker_Staleness(t *testing.T) {
+	input := CreateTestFixture(t)
+	k := input.OracleKeeper
+	ctx := input.Ctx.WithBlockHeight(10)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	k.WhitelistedPairs.Insert(ctx, pair)
+	k.SetPairAggregationConfig(ctx, types.PairAggregationConfig{Pair: pair, MaxAgeBlocks: 5})
+	k.SetPrice(ctx, pair, sdkmath.LegacyNewDec(100))
+
+	querier := NewQuerier(k)
+	ctx = ctx.WithBlockHeight(15)
+	_, err := k.GetExchangeRateChecked(ctx, pair)
+	require.NoError(t, err)
+	resp, err := querier.ExchangeRate(sdk.WrapSDKContext(ctx), &types.QueryExchangeRateRequest{Pair: pair})
+	require.NoError(t, err)
+	require.EqualValues(t, 5, resp.AgeBlocks)
+	require.False(t, resp.Stale)
+
+	ctx = ctx.WithBlockHeight(16)
+	_, err = k.GetExchangeRateChecked(ctx, pair)
+	require.ErrorIs(t, err, types.ErrStalePrice)
+	resp, err = querier.ExchangeRate(sdk.WrapSDKContext(ctx), &types.QueryExchangeRateRequest{Pair: pair})
+	require.NoError(t, err)
+	require.True(t, resp.Stale)
+	require.False(t, resp.Halted)
+
+	statuses, err := querier.PairStatuses(sdk.WrapSDKContext(ctx), &types.QueryPairStatusesRequest{})
+	require.NoError(t, err)
+	for _, result := range statuses.Statuses {
+		// pairs without prices are reported as stale
+		require.True(t, result.Stale, result.Status.Pair)
+		if result.Status.Pair == pair {
+			require.EqualValues(t, 6, result.AgeBlocks)
+		}
+	}
+}
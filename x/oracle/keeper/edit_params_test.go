@@ -3,9 +3,12 @@ package keeper_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
 	"github.com/NibiruChain/nibiru/v2/x/oracle/keeper"
@@ -51,4 +54,28 @@ func TestMsgServer_EditOracleParams(t *testing.T) {
 
 	_, err = msgServer.EditOracleParams(goCtx, &msg)
 	require.NoError(t, err)
+
+	// Case 3: per-pair aggregation configs are stored alongside the params
+	pair := asset.Registry.Pair(denoms.ETH, denoms.USD)
+	rewardBand := sdkmath.LegacyNewDecWithPrec(5, 2)
+	msg = types.MsgEditOracleParams{
+		Sender: alice.String(),
+		Params: &types.OracleParamsMsg{
+			PairAggregationConfigs: []types.PairAggregationConfig{{
+				Pair:       pair,
+				Method:     types.AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEAN,
+				RewardBand: &rewardBand,
+			}},
+		},
+	}
+
+	_, err = msgServer.EditOracleParams(goCtx, &msg)
+	require.NoError(t, err)
+	config := app.OracleKeeper.GetPairAggregationConfig(ctx, pair)
+	require.Equal(t, types.AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEAN, config.Method)
+	require.Equal(t, rewardBand, config.RewardBandOr(sdkmath.LegacyZeroDec()))
+
+	params, err := app.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, params.VotePeriod)
 }
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"time"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/common/ewma"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+// updateExchangeRateEma adds the price to the moving averages of the pair for
+// every half-life in the params. Averages for new half-lives start at the
+// price, and averages for removed half-lives are dropped.
+func (k Keeper) updateExchangeRateEma(
+	ctx sdk.Context,
+	pair asset.Pair,
+	price sdkmath.LegacyDec,
+	timestampMs int64,
+	halfLives []time.Duration,
+) {
+	if len(halfLives) == 0 {
+		_ = k.ExchangeRateEmas.Delete(ctx, pair)
+		return
+	}
+
+	prev, err := k.ExchangeRateEmas.Get(ctx, pair)
+	hasPrev := err == nil
+	elapsed := time.Duration(timestampMs-prev.TimestampMs) * time.Millisecond
+
+	next := types.ExchangeRateEma{
+		Pair:        pair,
+		TimestampMs: timestampMs,
+		Emas:        make([]types.EmaValue, 0, len(halfLives)),
+	}
+	for _, halfLife := range halfLives {
+		movingAverage := ewma.NewMovingAverageWithDecay(ewma.HalfLifeDecay(elapsed, halfLife))
+		if hasPrev {
+			if value, ok := prev.GetEma(halfLife); ok {
+				movingAverage.Set(value)
+			}
+		}
+		movingAverage.Add(price)
+		next.Emas = append(next.Emas, types.EmaValue{
+			HalfLife: halfLife,
+			Ema:      movingAverage.Value(),
+		})
+	}
+	k.ExchangeRateEmas.Insert(ctx, pair, next)
+}
+
+// GetExchangeRateEma returns the moving average of the exchange rate of the
+// pair with the given half-life, along with the block time in milliseconds of
+// the last price it includes. Unlike the TWAP, it is read in constant time.
+func (k Keeper) GetExchangeRateEma(
+	ctx sdk.Context, pair asset.Pair, halfLife time.Duration,
+) (price sdkmath.LegacyDec, timestampMs int64, err error) {
+	emas, err := k.ExchangeRateEmas.Get(ctx, pair)
+	if err != nil {
+		return price, 0, sdkioerrors.Wrapf(types.ErrUnknownPair, "no moving averages for pair %s", pair)
+	}
+
+	price, ok := emas.GetEma(halfLife)
+	if !ok {
+		return price, 0, sdkioerrors.Wrapf(
+			types.ErrUnknownPair, "no moving average with half-life %s for pair %s", halfLife, pair,
+		)
+	}
+	return price, emas.TimestampMs, nil
+}
+
+// maxPrunedPriceSnapshots is the most snapshots that [Keeper.prunePriceSnapshots]
+// deletes in one call. Each call adds one snapshot, so a backlog of expired
+// snapshots, like the ones written before the retention period existed, is
+// drained over several vote periods instead of in a single block.
+const maxPrunedPriceSnapshots = 100
+
+// prunePriceSnapshots deletes up to [maxPrunedPriceSnapshots] of the oldest
+// snapshots of the pair that are older than the retention period, so that the
+// store doesn't grow with every vote period.
+func (k Keeper) prunePriceSnapshots(ctx sdk.Context, pair asset.Pair, retention time.Duration) {
+	if retention <= 0 {
+		return
+	}
+
+	cutoff := ctx.BlockTime().Add(-retention)
+	iter := k.PriceSnapshots.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(pair).
+			EndExclusive(cutoff),
+	)
+	staleKeys := make([]collections.Pair[asset.Pair, time.Time], 0, maxPrunedPriceSnapshots)
+	for ; iter.Valid() && len(staleKeys) < maxPrunedPriceSnapshots; iter.Next() {
+		staleKeys = append(staleKeys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range staleKeys {
+		_ = k.PriceSnapshots.Delete(ctx, key)
+	}
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+func TestExchangeRateEma(t *testing.T) {
+	input := CreateTestFixture(t)
+	k := input.OracleKeeper
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	params, err := k.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.EmaHalfLives = []time.Duration{time.Hour, 2 * time.Hour}
+	k.Params.Set(input.Ctx, params)
+
+	start := time.UnixMilli(1_000_000)
+	ctx := input.Ctx.WithBlockTime(start)
+	k.SetPrice(ctx, pair, sdkmath.LegacyNewDec(100))
+
+	t.Log("The first price initializes every moving average")
+	for _, halfLife := range params.EmaHalfLives {
+		price, timestampMs, err := k.GetExchangeRateEma(ctx, pair, halfLife)
+		require.NoError(t, err)
+		require.Equal(t, sdkmath.LegacyNewDec(100), price)
+		require.Equal(t, start.UnixMilli(), timestampMs)
+	}
+
+	t.Log("After one half-life, the average moves halfway to the new price")
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	k.SetPrice(ctx, pair, sdkmath.LegacyNewDec(200))
+	price, timestampMs, err := k.GetExchangeRateEma(ctx, pair, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(150), price)
+	require.Equal(t, start.Add(time.Hour).UnixMilli(), timestampMs)
+
+	price, _, err = k.GetExchangeRateEma(ctx, pair, 2*time.Hour)
+	require.NoError(t, err)
+	require.True(t, price.GT(sdkmath.LegacyNewDec(100)) && price.LT(sdkmath.LegacyNewDec(150)), price)
+
+	_, _, err = k.GetExchangeRateEma(ctx, pair, time.Minute)
+	require.ErrorIs(t, err, types.ErrUnknownPair)
+	_, _, err = k.GetExchangeRateEma(ctx, asset.Registry.Pair(denoms.ETH, denoms.USD), time.Hour)
+	require.ErrorIs(t, err, types.ErrUnknownPair)
+
+	resp, err := NewQuerier(k).ExchangeRateEma(
+		sdk.WrapSDKContext(ctx), &types.QueryExchangeRateEmaRequest{Pair: pair},
+	)
+	require.NoError(t, err)
+	require.Equal(t, pair, resp.ExchangeRateEma.Pair)
+	require.Len(t, resp.ExchangeRateEma.Emas, 2)
+
+	t.Log("Removing every half-life drops the moving averages")
+	params.EmaHalfLives = nil
+	k.Params.Set(ctx, params)
+	k.SetPrice(ctx, pair, sdkmath.LegacyNewDec(300))
+	_, err = k.ExchangeRateEmas.Get(ctx, pair)
+	require.Error(t, err)
+}
+
+func TestPrunePriceSnapshots(t *testing.T) {
+	input := CreateTestFixture(t)
+	k := input.OracleKeeper
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	params, err := k.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.TwapLookbackWindow = 10 * time.Minute
+	params.EmaHalfLives = []time.Duration{time.Hour}
+	k.Params.Set(input.Ctx, params)
+	require.Equal(t, time.Hour, params.PriceSnapshotRetention())
+
+	start := time.UnixMilli(1_000_000)
+	for i := 0; i < 5; i++ {
+		ctx := input.Ctx.WithBlockTime(start.Add(time.Duration(i) * 30 * time.Minute))
+		k.SetPrice(ctx, pair, sdkmath.LegacyNewDec(int64(100+i)))
+	}
+
+	t.Log("Snapshots older than the longest window are pruned")
+	snapshots := k.PriceSnapshots.Iterate(
+		input.Ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair),
+	).Values()
+	require.Len(t, snapshots, 3)
+	require.Equal(t, start.Add(time.Hour).UnixMilli(), snapshots[0].TimestampMs)
+}
+
+func TestPrunePriceSnapshots_Backlog(t *testing.T) {
+	input := CreateTestFixture(t)
+	k := input.OracleKeeper
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	params, err := k.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.TwapLookbackWindow = 10 * time.Minute
+	params.EmaHalfLives = nil
+	k.Params.Set(input.Ctx, params)
+
+	start := time.UnixMilli(1_000_000)
+	backlog := 2*maxPrunedPriceSnapshots + 50
+	for i := 0; i < backlog; i++ {
+		timestamp := start.Add(time.Duration(i) * time.Second)
+		k.PriceSnapshots.Insert(input.Ctx, collections.Join(pair, timestamp), types.PriceSnapshot{
+			Pair: pair, Price: sdkmath.LegacyOneDec(), TimestampMs: timestamp.UnixMilli(),
+		})
+	}
+	countSnapshots := func() int {
+		return len(k.PriceSnapshots.Iterate(
+			input.Ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair),
+		).Keys())
+	}
+
+	t.Log("Each price update prunes a bounded number of expired snapshots")
+	now := start.Add(24 * time.Hour)
+	for _, wantCount := range []int{
+		backlog - maxPrunedPriceSnapshots + 1,
+		backlog - 2*maxPrunedPriceSnapshots + 2,
+		3,
+	} {
+		now = now.Add(time.Second)
+		k.SetPrice(input.Ctx.WithBlockTime(now), pair, sdkmath.LegacyOneDec())
+		require.Equal(t, wantCount, countSnapshots())
+	}
+
+	t.Log("The oldest snapshots are pruned first")
+	snapshots := k.PriceSnapshots.Iterate(
+		input.Ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair),
+	).Values()
+	require.Equal(t, now.Add(-2*time.Second).UnixMilli(), snapshots[0].TimestampMs)
+}
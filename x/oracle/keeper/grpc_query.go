@@ -54,13 +54,46 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 	if err != nil {
 		return nil, err
 	}
+	config := q.Keeper.GetPairAggregationConfig(ctx, req.Pair)
 	return &types.QueryExchangeRateResponse{
 		ExchangeRate:     out.ExchangeRate,
 		BlockTimestampMs: out.BlockTimestampMs,
 		BlockHeight:      out.CreatedBlock,
+		AgeBlocks:        priceAge(ctx, out),
+		Stale:            config.IsStale(out.CreatedBlock, ctx.BlockHeight()),
+		Halted:           q.Keeper.GetPairStatus(ctx, req.Pair).Halted,
 	}, nil
 }
 
+// PairStatuses queries the circuit breaker status of every whitelisted pair
+func (q querier) PairStatuses(c context.Context, _ *types.QueryPairStatusesRequest) (*types.QueryPairStatusesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	statuses := []types.PairStatusResult{}
+	for _, pair := range q.Keeper.GetWhitelistedPairs(ctx) {
+		result := types.PairStatusResult{
+			Status: q.Keeper.GetPairStatus(ctx, pair),
+			Stale:  true,
+		}
+		if rate, err := q.Keeper.ExchangeRates.Get(ctx, pair); err == nil {
+			result.AgeBlocks = priceAge(ctx, rate)
+			result.Stale = q.Keeper.GetPairAggregationConfig(ctx, pair).
+				IsStale(rate.CreatedBlock, ctx.BlockHeight())
+		}
+		statuses = append(statuses, result)
+	}
+
+	return &types.QueryPairStatusesResponse{Statuses: statuses}, nil
+}
+
+// priceAge returns the number of blocks since the exchange rate was posted.
+func priceAge(ctx sdk.Context, rate types.ExchangeRateAtBlock) uint64 {
+	if uint64(ctx.BlockHeight()) <= rate.CreatedBlock {
+		return 0
+	}
+	return uint64(ctx.BlockHeight()) - rate.CreatedBlock
+}
+
 /*
 Gets the time-weighted average price from ( ctx.BlockTime() - interval, ctx.BlockTime() ]
 Note the open-ended right bracket.
@@ -82,6 +115,24 @@ func (q querier) ExchangeRateTwap(c context.Context, req *types.QueryExchangeRat
 	return &types.QueryExchangeRateResponse{ExchangeRate: twap}, nil
 }
 
+// ExchangeRateEma queries the moving averages of the exchange rate of a pair
+func (q querier) ExchangeRateEma(c context.Context, req *types.QueryExchangeRateEmaRequest) (*types.QueryExchangeRateEmaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Pair) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty pair")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	emas, err := q.Keeper.ExchangeRateEmas.Get(ctx, req.Pair)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryExchangeRateEmaResponse{ExchangeRateEma: emas}, nil
+}
+
 // ExchangeRates queries exchange rates of all pairs
 func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -46,6 +46,13 @@ type Keeper struct {
 		collections.Pair[asset.Pair, time.Time],
 		types.PriceSnapshot]
 	WhitelistedPairs collections.KeySet[asset.Pair]
+	// PairAggregationConfigs maps a whitelisted pair to the overrides used to
+	// tally its votes. Pairs without a config use the module params.
+	PairAggregationConfigs collections.Map[asset.Pair, types.PairAggregationConfig]
+	// PairStatuses maps a pair to the state of its circuit breaker.
+	PairStatuses collections.Map[asset.Pair, types.PairStatus]
+	// ExchangeRateEmas maps a pair to the moving averages of its exchange rate.
+	ExchangeRateEmas collections.Map[asset.Pair, types.ExchangeRateEma]
 	Rewards          collections.Map[uint64, types.Rewards]
 	RewardsID        collections.Sequence
 }
@@ -87,6 +94,15 @@ func NewKeeper(
 		Prevotes:          collections.NewMap(storeKey, 4, collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.AggregateExchangeRatePrevote](cdc)),
 		Votes:             collections.NewMap(storeKey, 5, collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.AggregateExchangeRateVote](cdc)),
 		WhitelistedPairs:  collections.NewKeySet(storeKey, 6, asset.PairKeyEncoder),
+		PairAggregationConfigs: collections.NewMap(
+			storeKey, 8,
+			asset.PairKeyEncoder, collections.ProtoValueEncoder[types.PairAggregationConfig](cdc)),
+		PairStatuses: collections.NewMap(
+			storeKey, 12,
+			asset.PairKeyEncoder, collections.ProtoValueEncoder[types.PairStatus](cdc)),
+		ExchangeRateEmas: collections.NewMap(
+			storeKey, 13,
+			asset.PairKeyEncoder, collections.ProtoValueEncoder[types.ExchangeRateEma](cdc)),
 		Rewards: collections.NewMap(
 			storeKey, 7,
 			collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Rewards](cdc)),
@@ -182,7 +198,8 @@ func (k Keeper) GetExchangeRateTwap(ctx sdk.Context, pair asset.Pair) (price sdk
 	return cumulativePrice.QuoInt64(ctx.BlockTime().UnixMilli() - firstTimestampMs), nil
 }
 
-// SetPrice sets the price for a pair as well as the price snapshot.
+// SetPrice sets the price for a pair as well as the price snapshot. It also
+// updates the moving averages of the pair and prunes its expired snapshots.
 func (k Keeper) SetPrice(ctx sdk.Context, pair asset.Pair, price sdkmath.LegacyDec) {
 	blockTimestampMs := ctx.BlockTime().UnixMilli()
 	k.ExchangeRates.Insert(ctx, pair,
@@ -198,6 +215,10 @@ func (k Keeper) SetPrice(ctx sdk.Context, pair asset.Pair, price sdkmath.LegacyD
 		Price:       price,
 		TimestampMs: blockTimestampMs,
 	})
+	if params, err := k.Params.Get(ctx); err == nil {
+		k.updateExchangeRateEma(ctx, pair, price, blockTimestampMs, params.EmaHalfLives)
+		k.prunePriceSnapshots(ctx, pair, params.PriceSnapshotRetention())
+	}
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventPriceUpdate{
 		Pair:        pair.String(),
 		Price:       price,
@@ -195,5 +195,34 @@ func (ms msgServer) EditOracleParams(goCtx context.Context, msg *types.MsgEditOr
 
 	ms.Keeper.UpdateParams(ctx, mergedParams)
 
+	for _, config := range msg.Params.PairAggregationConfigs {
+		if err := config.Validate(); err != nil {
+			return nil, err
+		}
+		ms.Keeper.SetPairAggregationConfig(ctx, config)
+	}
+
 	return &types.MsgEditOracleParamsResponse{}, nil
 }
+
+// ResumePair: gRPC tx msg for lifting the circuit breaker of a halted pair.
+// [SUDO] Only callable by sudoers.
+func (ms msgServer) ResumePair(goCtx context.Context, msg *types.MsgResumePair) (*types.MsgResumePairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address")
+	}
+
+	err = ms.SudoKeeper.CheckPermissions(sender, ctx)
+	if err != nil {
+		return nil, sudotypes.ErrUnauthorized
+	}
+
+	if err := ms.Keeper.ResumePair(ctx, msg.Pair); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumePairResponse{}, nil
+}
@@ -120,5 +120,11 @@ func mergeOracleParams(msg *types.MsgEditOracleParams, oracleParams types.Params
 		oracleParams.ExpirationBlocks = msg.Params.ExpirationBlocks
 	}
 
+	if msg.Params.ClearEmaHalfLives {
+		oracleParams.EmaHalfLives = nil
+	} else if len(msg.Params.EmaHalfLives) != 0 {
+		oracleParams.EmaHalfLives = msg.Params.EmaHalfLives
+	}
+
 	return oracleParams
 }
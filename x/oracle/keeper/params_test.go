@@ -108,6 +108,7 @@ func TestMergeOracleParams(t *testing.T) {
 		ValidatorFeeRatio:  minFeeRatio,
 		TwapLookbackWindow: twapLoopbackWindow,
 		ExpirationBlocks:   expirationBlocks,
+		EmaHalfLives:       types.DefaultEmaHalfLives,
 	}
 
 	tests := []struct {
@@ -423,6 +424,37 @@ func TestMergeOracleParams(t *testing.T) {
 				require.Equal(t, expirationBlocks, params.ExpirationBlocks)
 			},
 		},
+		{
+			name: "emaHalfLives",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{
+					EmaHalfLives: []time.Duration{time.Minute},
+				},
+			},
+			require: func(params types.Params) {
+				require.Equal(t, []time.Duration{time.Minute}, params.EmaHalfLives)
+			},
+		},
+		{
+			name: "empty emaHalfLives not updated",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{},
+			},
+			require: func(params types.Params) {
+				require.Equal(t, types.DefaultEmaHalfLives, params.EmaHalfLives)
+			},
+		},
+		{
+			name: "clear emaHalfLives",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{
+					ClearEmaHalfLives: true,
+				},
+			},
+			require: func(params types.Params) {
+				require.Empty(t, params.EmaHalfLives)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -16,6 +16,7 @@ import (
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	"github.com/cometbft/cometbft/libs/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -234,7 +235,7 @@ func CreateTestFixture(t *testing.T) TestFixture {
 
 	bankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, stakingtypes.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(denoms.NIBI, InitTokens.MulRaw(int64(len(Addrs))))))
 
-	sudoKeeper := sudokeeper.NewKeeper(appCodec, keySudo)
+	sudoKeeper := sudokeeper.NewKeeper(appCodec, keySudo, baseapp.NewMsgServiceRouter())
 	sudoAcc := authtypes.NewEmptyModuleAccount(sudotypes.ModuleName)
 
 	accountKeeper.SetModuleAccount(ctx, feeCollectorAcc)
@@ -17,9 +17,13 @@ func (k Keeper) UpdateExchangeRates(ctx sdk.Context) types.ValidatorPerformances
 	validatorPerformances := k.newValidatorPerformances(ctx)
 	whitelistedPairs := set.New[asset.Pair](k.GetWhitelistedPairs(ctx)...)
 
-	pairVotes := k.getPairVotes(ctx, validatorPerformances, whitelistedPairs)
+	pairVotes, err := k.getPairVotes(ctx, validatorPerformances, whitelistedPairs)
+	if err != nil {
+		k.Logger(ctx).Error("failed to get pair votes", "error", err)
+		return validatorPerformances
+	}
 
-	k.clearExchangeRates(ctx, pairVotes)
+	k.clearExchangeRates(ctx)
 	k.tallyVotesAndUpdatePrices(ctx, pairVotes, validatorPerformances)
 
 	k.incrementMissCounters(ctx, whitelistedPairs, validatorPerformances)
@@ -88,7 +92,15 @@ func (k Keeper) tallyVotesAndUpdatePrices(
 	// Iterate through sorted keys for deterministic ordering.
 	orderedPairVotes := omap.SortedMap_Pair[types.ExchangeRateVotes](pairVotes)
 	for pair := range orderedPairVotes.Range() {
-		exchangeRate := Tally(pairVotes[pair], rewardBand, validatorPerformances)
+		config := k.GetPairAggregationConfig(ctx, pair)
+		exchangeRate := Tally(
+			pairVotes[pair], config, config.RewardBandOr(rewardBand), validatorPerformances,
+		)
+		// A halted pair keeps its last exchange rate until a tally comes back
+		// within the deviation bound or a sudoer resumes it.
+		if halted := k.updatePairStatus(ctx, config, exchangeRate); halted {
+			continue
+		}
 		k.SetPrice(ctx, pair, exchangeRate)
 	}
 }
@@ -98,25 +110,27 @@ func (k Keeper) getPairVotes(
 	ctx sdk.Context,
 	validatorPerformances types.ValidatorPerformances,
 	whitelistedPairs set.Set[asset.Pair],
-) (pairVotes map[asset.Pair]types.ExchangeRateVotes) {
+) (pairVotes map[asset.Pair]types.ExchangeRateVotes, err error) {
 	pairVotes = k.groupVotesByPair(ctx, validatorPerformances)
 
-	k.removeInvalidVotes(ctx, pairVotes, whitelistedPairs)
+	if err := k.removeInvalidVotes(ctx, pairVotes, whitelistedPairs); err != nil {
+		return nil, err
+	}
 
-	return pairVotes
+	return pairVotes, nil
 }
 
-// clearExchangeRates removes all exchange rates from the state
-// We remove the price for pair with expired prices or valid votes
-func (k Keeper) clearExchangeRates(ctx sdk.Context, pairVotes map[asset.Pair]types.ExchangeRateVotes) {
+// clearExchangeRates removes the expired exchange rates from the state. Pairs
+// with valid votes get their new exchange rate from the tally, except for
+// halted pairs, which keep their last one until it expires.
+func (k Keeper) clearExchangeRates(ctx sdk.Context) {
 	params, _ := k.Params.Get(ctx)
 
 	for _, key := range k.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
-		_, isValid := pairVotes[key]
 		previousExchangeRate, _ := k.ExchangeRates.Get(ctx, key)
 		isExpired := previousExchangeRate.CreatedBlock+params.ExpirationBlocks <= uint64(ctx.BlockHeight())
 
-		if isValid || isExpired {
+		if isExpired {
 			err := k.ExchangeRates.Delete(ctx, key)
 			if err != nil {
 				k.Logger(ctx).Error("failed to delete exchange rate", "pair", key.String(), "error", err)
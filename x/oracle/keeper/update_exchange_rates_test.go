@@ -96,9 +96,6 @@ func TestResetExchangeRates(t *testing.T) {
 	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
 	fixture, _ := Setup(t)
 
-	emptyVotes := map[asset.Pair]types.ExchangeRateVotes{}
-	validVotes := map[asset.Pair]types.ExchangeRateVotes{pair: {}}
-
 	// Set expiration blocks to 10
 	params, _ := fixture.OracleKeeper.Params.Get(fixture.Ctx)
 	params.ExpirationBlocks = 10
@@ -109,21 +106,15 @@ func TestResetExchangeRates(t *testing.T) {
 
 	// reset exchange rates at block 2
 	// Price should still be there because not expired yet
-	fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(2), emptyVotes)
+	fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(2))
 	_, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
 	assert.NoError(t, err)
 
-	// reset exchange rates at block 3 but pair is in votes
-	// Price should be removed there because there was a valid votes
-	fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(3), validVotes)
-	_, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
-	assert.Error(t, err)
-
 	// Post a price at block 69
 	// reset exchange rates at block 79
 	// Price should not be there anymore because expired
 	fixture.OracleKeeper.SetPrice(fixture.Ctx.WithBlockHeight(69), pair, testExchangeRate)
-	fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(79), emptyVotes)
+	fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(79))
 
 	_, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
 	assert.Error(t, err)
@@ -209,7 +200,8 @@ func TestOracleTally(t *testing.T) {
 	}
 
 	tallyMedian := Tally(
-		votes, fixture.OracleKeeper.RewardBand(fixture.Ctx), validatorPerformances)
+		votes, types.PairAggregationConfig{Method: types.AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEDIAN},
+		fixture.OracleKeeper.RewardBand(fixture.Ctx), validatorPerformances)
 
 	assert.Equal(t, expectedValidatorPerformances, validatorPerformances)
 	assert.Equal(t, tallyMedian.MulInt64(100).TruncateInt(), weightedMedian.MulInt64(100).TruncateInt())
@@ -489,3 +481,88 @@ func TestWhitelistedPairs(t *testing.T) {
 	assert.EqualValues(t, 1, perf.AbstainCount)
 	assert.EqualValues(t, 0, perf.MissCount)
 }
+
+func TestOraclePairAggregationConfig(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	voteRates := []int64{1, 2, 3, 10}
+
+	submitVotes := func(fixture TestFixture, msgServer types.MsgServer) {
+		for i, rate := range voteRates {
+			exchangeRateStr, err := types.ExchangeRateTuples{
+				{Pair: pair, ExchangeRate: sdkmath.LegacyNewDec(rate)},
+			}.ToString()
+			require.NoError(t, err)
+
+			salt := fmt.Sprintf("%d", i)
+			hash := types.GetAggregateVoteHash(salt, exchangeRateStr, ValAddrs[i])
+			prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[i], ValAddrs[i])
+			voteMsg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRateStr, Addrs[i], ValAddrs[i])
+
+			_, err = msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(fixture.Ctx.WithBlockHeight(0)), prevoteMsg)
+			require.NoError(t, err)
+			_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(fixture.Ctx.WithBlockHeight(1)), voteMsg)
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("method override", func(t *testing.T) {
+		fixture, msgServer := Setup(t)
+		fixture.OracleKeeper.SetPairAggregationConfig(fixture.Ctx, types.PairAggregationConfig{
+			Pair:   pair,
+			Method: types.AggregationMethod_AGGREGATION_METHOD_MEDIAN,
+		})
+
+		submitVotes(fixture, msgServer)
+		fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
+
+		rate, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
+		require.NoError(t, err)
+		assert.Equal(t, sdkmath.LegacyMustNewDecFromStr("2.5"), rate.ExchangeRate)
+	})
+
+	t.Run("trimmed mean", func(t *testing.T) {
+		fixture, msgServer := Setup(t)
+		trimFraction := sdkmath.LegacyMustNewDecFromStr("0.125")
+		fixture.OracleKeeper.SetPairAggregationConfig(fixture.Ctx, types.PairAggregationConfig{
+			Pair:         pair,
+			Method:       types.AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN,
+			TrimFraction: &trimFraction,
+		})
+
+		submitVotes(fixture, msgServer)
+		fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
+
+		// Half of the power of the lowest and highest votes is trimmed:
+		// (0.5*1 + 2 + 3 + 0.5*10) / 3
+		rate, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
+		require.NoError(t, err)
+		assert.Equal(t, sdkmath.LegacyMustNewDecFromStr("3.5"), rate.ExchangeRate)
+	})
+
+	t.Run("min voters override", func(t *testing.T) {
+		fixture, msgServer := Setup(t)
+		fixture.OracleKeeper.SetPairAggregationConfig(fixture.Ctx, types.PairAggregationConfig{
+			Pair:      pair,
+			MinVoters: uint64(len(voteRates) + 1),
+		})
+
+		submitVotes(fixture, msgServer)
+		fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
+
+		_, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
+		assert.Error(t, err)
+	})
+
+	t.Run("default config is not stored", func(t *testing.T) {
+		fixture, _ := Setup(t)
+		fixture.OracleKeeper.SetPairAggregationConfig(fixture.Ctx, types.PairAggregationConfig{
+			Pair: pair, MinVoters: 2,
+		})
+		_, err := fixture.OracleKeeper.PairAggregationConfigs.Get(fixture.Ctx, pair)
+		require.NoError(t, err)
+
+		fixture.OracleKeeper.SetPairAggregationConfig(fixture.Ctx, types.DefaultPairAggregationConfig(pair))
+		_, err = fixture.OracleKeeper.PairAggregationConfigs.Get(fixture.Ctx, pair)
+		require.Error(t, err)
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/x/common/asset"
 	"github.com/NibiruChain/nibiru/v2/x/common/set"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
 )
 
 // IsWhitelistedPair returns existence of a pair in the voting target list
@@ -45,3 +46,19 @@ func (k Keeper) refreshWhitelist(ctx sdk.Context, nextWhitelist []asset.Pair, cu
 		}
 	}
 }
+
+// GetPairAggregationConfig returns the aggregation config of the pair, or the
+// default config if the pair has no overrides.
+func (k Keeper) GetPairAggregationConfig(ctx sdk.Context, pair asset.Pair) types.PairAggregationConfig {
+	return k.PairAggregationConfigs.GetOr(ctx, pair, types.DefaultPairAggregationConfig(pair))
+}
+
+// SetPairAggregationConfig stores the aggregation config of its pair. Configs
+// without overrides remove the stored config instead.
+func (k Keeper) SetPairAggregationConfig(ctx sdk.Context, config types.PairAggregationConfig) {
+	if config.IsDefault() {
+		_ = k.PairAggregationConfigs.Delete(ctx, config.Pair)
+		return
+	}
+	k.PairAggregationConfigs.Insert(ctx, config.Pair, config)
+}
@@ -116,6 +116,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		[]types.AggregateExchangeRateVote{},
 		[]asset.Pair{},
 		[]types.Rewards{},
+		[]types.PairAggregationConfig{},
+		[]types.PairStatus{},
+		[]types.ExchangeRateEma{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis, "", " ")
@@ -0,0 +1,236 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+)
+
+// DefaultPairAggregationConfig returns the config of a pair without overrides,
+// which tallies its votes with the weighted median and the module params.
+func DefaultPairAggregationConfig(pair asset.Pair) PairAggregationConfig {
+	return PairAggregationConfig{
+		Pair:   pair,
+		Method: AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEDIAN,
+	}
+}
+
+// Validate performs basic validation on the aggregation config.
+func (c PairAggregationConfig) Validate() error {
+	if err := c.Pair.Validate(); err != nil {
+		return fmt.Errorf("invalid aggregation config pair: %w", err)
+	}
+
+	if _, ok := AggregationMethod_name[int32(c.Method)]; !ok {
+		return fmt.Errorf("invalid aggregation method %d for pair %s", c.Method, c.Pair)
+	}
+
+	if c.RewardBand != nil && !c.RewardBand.IsNil() {
+		if c.RewardBand.GT(sdkmath.LegacyOneDec()) || c.RewardBand.IsNegative() {
+			return fmt.Errorf("aggregation config RewardBand of %s must be between [0, 1]", c.Pair)
+		}
+	}
+
+	if c.VoteThreshold != nil && !c.VoteThreshold.IsNil() {
+		if c.VoteThreshold.LTE(sdkmath.LegacyNewDecWithPrec(33, 2)) || c.VoteThreshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("aggregation config VoteThreshold of %s must be between (0.33, 1]", c.Pair)
+		}
+	}
+
+	if c.MaxDeviation != nil && !c.MaxDeviation.IsNil() && !c.MaxDeviation.IsPositive() {
+		return fmt.Errorf("aggregation config MaxDeviation of %s must be positive", c.Pair)
+	}
+
+	hasTrimFraction := c.TrimFraction != nil && !c.TrimFraction.IsNil()
+	if c.Method == AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN {
+		if !hasTrimFraction {
+			return fmt.Errorf("aggregation config TrimFraction of %s is required by %s", c.Pair, c.Method)
+		}
+		if c.TrimFraction.IsNegative() || c.TrimFraction.GTE(sdkmath.LegacyNewDecWithPrec(5, 1)) {
+			return fmt.Errorf("aggregation config TrimFraction of %s must be between [0, 0.5)", c.Pair)
+		}
+	} else if hasTrimFraction {
+		return fmt.Errorf(
+			"aggregation config TrimFraction of %s is only used by %s",
+			c.Pair, AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN,
+		)
+	}
+
+	return nil
+}
+
+// IsDefault returns true if the config doesn't override anything, in which
+// case it doesn't need to be stored.
+func (c PairAggregationConfig) IsDefault() bool {
+	return c.Method == AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEDIAN &&
+		c.MinVoters == 0 &&
+		(c.RewardBand == nil || c.RewardBand.IsNil()) &&
+		(c.VoteThreshold == nil || c.VoteThreshold.IsNil()) &&
+		!c.HasCircuitBreaker() &&
+		c.MaxAgeBlocks == 0 &&
+		(c.TrimFraction == nil || c.TrimFraction.IsNil())
+}
+
+// HasCircuitBreaker returns true if the pair halts on tallies that deviate too
+// much from its last good price.
+func (c PairAggregationConfig) HasCircuitBreaker() bool {
+	return c.MaxDeviation != nil && !c.MaxDeviation.IsNil()
+}
+
+// ExceedsMaxDeviation returns true if the relative change from the last good
+// price to the new price is beyond the max deviation of the pair. It is always
+// false without a circuit breaker or a last good price.
+func (c PairAggregationConfig) ExceedsMaxDeviation(lastGoodPrice, price sdkmath.LegacyDec) bool {
+	if !c.HasCircuitBreaker() || lastGoodPrice.IsNil() || !lastGoodPrice.IsPositive() {
+		return false
+	}
+	deviation := price.Sub(lastGoodPrice).Abs().Quo(lastGoodPrice)
+	return deviation.GT(*c.MaxDeviation)
+}
+
+// IsStale returns true if a price set at createdBlock is older than the max age
+// of the pair at the given block height.
+func (c PairAggregationConfig) IsStale(createdBlock uint64, blockHeight int64) bool {
+	if c.MaxAgeBlocks == 0 || blockHeight < 0 || uint64(blockHeight) <= createdBlock {
+		return false
+	}
+	return uint64(blockHeight)-createdBlock > c.MaxAgeBlocks
+}
+
+// MinVotersOr returns the min voters override or the given default.
+func (c PairAggregationConfig) MinVotersOr(defaultMinVoters uint64) uint64 {
+	if c.MinVoters == 0 {
+		return defaultMinVoters
+	}
+	return c.MinVoters
+}
+
+// RewardBandOr returns the reward band override or the given default.
+func (c PairAggregationConfig) RewardBandOr(defaultRewardBand sdkmath.LegacyDec) sdkmath.LegacyDec {
+	if c.RewardBand == nil || c.RewardBand.IsNil() {
+		return defaultRewardBand
+	}
+	return *c.RewardBand
+}
+
+// VoteThresholdOr returns the vote threshold override or the given default.
+func (c PairAggregationConfig) VoteThresholdOr(defaultVoteThreshold sdkmath.LegacyDec) sdkmath.LegacyDec {
+	if c.VoteThreshold == nil || c.VoteThreshold.IsNil() {
+		return defaultVoteThreshold
+	}
+	return *c.VoteThreshold
+}
+
+// TrimFractionOr returns the trim fraction or the given default.
+func (c PairAggregationConfig) TrimFractionOr(defaultTrimFraction sdkmath.LegacyDec) sdkmath.LegacyDec {
+	if c.TrimFraction == nil || c.TrimFraction.IsNil() {
+		return defaultTrimFraction
+	}
+	return *c.TrimFraction
+}
+
+// Aggregate combines the votes into a single exchange rate using the given
+// method. The trim fraction is only used by the trimmed mean. Unknown methods
+// fall back to the weighted median.
+func (pb ExchangeRateVotes) Aggregate(
+	method AggregationMethod, trimFraction sdkmath.LegacyDec,
+) sdkmath.LegacyDec {
+	switch method {
+	case AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEAN:
+		return pb.WeightedMean()
+	case AggregationMethod_AGGREGATION_METHOD_MEDIAN:
+		return pb.Median()
+	case AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN:
+		return pb.TrimmedMean(trimFraction)
+	default:
+		return pb.WeightedMedianWithAssertion()
+	}
+}
+
+// WeightedMean returns the mean of the votes weighted by their power. Abstain
+// votes are ignored.
+func (pb ExchangeRateVotes) WeightedMean() sdkmath.LegacyDec {
+	sum := sdkmath.LegacyZeroDec()
+	totalPower := int64(0)
+	for _, v := range pb {
+		if !v.ExchangeRate.IsPositive() {
+			continue
+		}
+		sum = sum.Add(v.ExchangeRate.MulInt64(v.Power))
+		totalPower += v.Power
+	}
+
+	if totalPower == 0 {
+		return sdkmath.LegacyZeroDec()
+	}
+	return sum.QuoInt64(totalPower)
+}
+
+// Median returns the unweighted median of the votes, i.e. every validator
+// counts once. Abstain votes are ignored. With an even number of votes, the
+// mean of the two middle votes is returned.
+func (pb ExchangeRateVotes) Median() sdkmath.LegacyDec {
+	var rates []sdkmath.LegacyDec
+	for _, v := range pb {
+		if v.ExchangeRate.IsPositive() {
+			rates = append(rates, v.ExchangeRate)
+		}
+	}
+
+	if len(rates) == 0 {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	sort.Slice(rates, func(i, j int) bool { return rates[i].LT(rates[j]) })
+	mid := len(rates) / 2
+	if len(rates)%2 == 1 {
+		return rates[mid]
+	}
+	return rates[mid-1].Add(rates[mid]).QuoInt64(2)
+}
+
+// TrimmedMean returns the mean of the votes weighted by their power after
+// discarding the trimFraction of the total power with the lowest votes and the
+// same fraction with the highest votes. A vote that straddles a cut-off counts
+// with the part of its power inside the kept range. Abstain votes are ignored.
+// A trim fraction of zero gives the weighted mean.
+func (pb ExchangeRateVotes) TrimmedMean(trimFraction sdkmath.LegacyDec) sdkmath.LegacyDec {
+	var votes ExchangeRateVotes
+	totalPower := int64(0)
+	for _, v := range pb {
+		if v.ExchangeRate.IsPositive() && v.Power > 0 {
+			votes = append(votes, v)
+			totalPower += v.Power
+		}
+	}
+	if totalPower == 0 {
+		return sdkmath.LegacyZeroDec()
+	}
+	sort.SliceStable(votes, func(i, j int) bool {
+		return votes[i].ExchangeRate.LT(votes[j].ExchangeRate)
+	})
+
+	// Keep the power in the range [lower, upper) of the cumulative power.
+	lower := trimFraction.MulInt64(totalPower)
+	upper := sdkmath.LegacyNewDec(totalPower).Sub(lower)
+	sum, keptPower := sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()
+	cumPower := sdkmath.LegacyZeroDec()
+	for _, v := range votes {
+		start := cumPower
+		cumPower = cumPower.Add(sdkmath.LegacyNewDec(v.Power))
+		kept := sdkmath.LegacyMinDec(cumPower, upper).Sub(sdkmath.LegacyMaxDec(start, lower))
+		if !kept.IsPositive() {
+			continue
+		}
+		sum = sum.Add(v.ExchangeRate.Mul(kept))
+		keptPower = keptPower.Add(kept)
+	}
+
+	if !keptPower.IsPositive() {
+		return sdkmath.LegacyZeroDec()
+	}
+	return sum.Quo(keptPower)
+}
@@ -0,0 +1,173 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+func TestExchangeRateVotesAggregate(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	votes := types.ExchangeRateVotes{
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(1), pair, nil, 1),
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(2), pair, nil, 1),
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(10), pair, nil, 8),
+		// abstain votes are ignored
+		types.NewExchangeRateVote(sdkmath.LegacyZeroDec(), pair, nil, 0),
+	}
+
+	for _, tc := range []struct {
+		name         string
+		method       types.AggregationMethod
+		trimFraction sdkmath.LegacyDec
+		want         sdkmath.LegacyDec
+	}{
+		{method: types.AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEDIAN, want: sdkmath.LegacyNewDec(10)},
+		{method: types.AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEAN, want: sdkmath.LegacyMustNewDecFromStr("8.3")},
+		{method: types.AggregationMethod_AGGREGATION_METHOD_MEDIAN, want: sdkmath.LegacyNewDec(2)},
+		{
+			name:         "no trim is the weighted mean",
+			method:       types.AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN,
+			trimFraction: sdkmath.LegacyZeroDec(),
+			want:         sdkmath.LegacyMustNewDecFromStr("8.3"),
+		},
+		{
+			// Keeps the power in [1, 9): 1 of the vote for 2 and 7 of the
+			// vote for 10.
+			name:         "trim the lowest vote",
+			method:       types.AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN,
+			trimFraction: sdkmath.LegacyMustNewDecFromStr("0.1"),
+			want:         sdkmath.LegacyNewDec(9),
+		},
+		{
+			// Keeps the power in [1.5, 8.5): 0.5 of the vote for 2 and 6.5
+			// of the vote for 10.
+			name:         "trim part of a vote",
+			method:       types.AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN,
+			trimFraction: sdkmath.LegacyMustNewDecFromStr("0.15"),
+			want:         sdkmath.LegacyMustNewDecFromStr("9.428571428571428571"),
+		},
+		{
+			name:         "trim up to the middle",
+			method:       types.AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN,
+			trimFraction: sdkmath.LegacyMustNewDecFromStr("0.49"),
+			want:         sdkmath.LegacyNewDec(10),
+		},
+	} {
+		name := tc.method.String()
+		if tc.name != "" {
+			name += "/" + tc.name
+		}
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.want, votes.Aggregate(tc.method, tc.trimFraction))
+		})
+	}
+
+	evenVotes := types.ExchangeRateVotes{
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(2), pair, nil, 1),
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(1), pair, nil, 1),
+	}
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("1.5"), evenVotes.Median())
+	require.True(t, types.ExchangeRateVotes{}.WeightedMean().IsZero())
+	require.True(t, types.ExchangeRateVotes{}.Median().IsZero())
+	require.True(t, types.ExchangeRateVotes{}.TrimmedMean(sdkmath.LegacyZeroDec()).IsZero())
+}
+
+func TestPairAggregationConfigValidate(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	dec := func(s string) *sdkmath.LegacyDec {
+		d := sdkmath.LegacyMustNewDecFromStr(s)
+		return &d
+	}
+
+	for _, tc := range []struct {
+		name    string
+		config  types.PairAggregationConfig
+		wantErr string
+	}{
+		{name: "default", config: types.DefaultPairAggregationConfig(pair)},
+		{
+			name: "happy",
+			config: types.PairAggregationConfig{
+				Pair:          pair,
+				Method:        types.AggregationMethod_AGGREGATION_METHOD_MEDIAN,
+				MinVoters:     3,
+				RewardBand:    dec("0.1"),
+				VoteThreshold: dec("0.5"),
+			},
+		},
+		{
+			name:    "invalid pair",
+			config:  types.PairAggregationConfig{Pair: "btc"},
+			wantErr: "invalid aggregation config pair",
+		},
+		{
+			name:    "invalid method",
+			config:  types.PairAggregationConfig{Pair: pair, Method: 42},
+			wantErr: "invalid aggregation method",
+		},
+		{
+			name:    "reward band too large",
+			config:  types.PairAggregationConfig{Pair: pair, RewardBand: dec("1.1")},
+			wantErr: "RewardBand",
+		},
+		{
+			name: "trimmed mean",
+			config: types.PairAggregationConfig{
+				Pair:         pair,
+				Method:       types.AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN,
+				TrimFraction: dec("0.2"),
+			},
+		},
+		{
+			name: "trimmed mean without trim fraction",
+			config: types.PairAggregationConfig{
+				Pair:   pair,
+				Method: types.AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN,
+			},
+			wantErr: "TrimFraction of ubtc:uusd is required",
+		},
+		{
+			name: "trim fraction too large",
+			config: types.PairAggregationConfig{
+				Pair:         pair,
+				Method:       types.AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN,
+				TrimFraction: dec("0.5"),
+			},
+			wantErr: "must be between [0, 0.5)",
+		},
+		{
+			name: "negative trim fraction",
+			config: types.PairAggregationConfig{
+				Pair:         pair,
+				Method:       types.AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN,
+				TrimFraction: dec("-0.1"),
+			},
+			wantErr: "must be between [0, 0.5)",
+		},
+		{
+			name:    "trim fraction with another method",
+			config:  types.PairAggregationConfig{Pair: pair, TrimFraction: dec("0.1")},
+			wantErr: "is only used by AGGREGATION_METHOD_TRIMMED_MEAN",
+		},
+		{
+			name:    "vote threshold too low",
+			config:  types.PairAggregationConfig{Pair: pair, VoteThreshold: dec("0.2")},
+			wantErr: "VoteThreshold",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// GetEma returns the moving average with the given half-life, if any.
+func (e ExchangeRateEma) GetEma(halfLife time.Duration) (sdkmath.LegacyDec, bool) {
+	for _, ema := range e.Emas {
+		if ema.HalfLife == halfLife {
+			return ema.Ema, true
+		}
+	}
+	return sdkmath.LegacyDec{}, false
+}
+
+// Validate performs basic validation on the moving averages of a pair.
+func (e ExchangeRateEma) Validate() error {
+	if err := e.Pair.Validate(); err != nil {
+		return fmt.Errorf("invalid exchange rate ema: %w", err)
+	}
+	seenHalfLives := make(map[time.Duration]bool)
+	for _, ema := range e.Emas {
+		if ema.HalfLife <= 0 {
+			return fmt.Errorf("ema half-life of %s must be positive, got %s", e.Pair, ema.HalfLife)
+		}
+		if seenHalfLives[ema.HalfLife] {
+			return fmt.Errorf("duplicate ema half-life %s for pair %s", ema.HalfLife, e.Pair)
+		}
+		seenHalfLives[ema.HalfLife] = true
+		if ema.Ema.IsNil() || ema.Ema.IsNegative() {
+			return fmt.Errorf("invalid ema with half-life %s for pair %s", ema.HalfLife, e.Pair)
+		}
+	}
+	return nil
+}
@@ -35,4 +35,6 @@ var (
 	ErrNoAggregateVote        = registerError("no aggregate vote")
 	ErrUnknownPair            = registerError("unknown pair")
 	ErrNoValidTWAP            = registerError("TWA price not found")
+	ErrPairHalted             = registerError("pair halted by circuit breaker")
+	ErrStalePrice             = registerError("stale exchange rate")
 )
@@ -348,52 +348,152 @@ func (m *EventValidatorPerformance) GetMissCount() int64 {
 	return 0
 }
 
+// Emitted when a tally deviates from the last good price of a pair by more
+// than its max deviation, which halts the pair.
+type EventPairHalted struct {
+	Pair          string                      `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	LastGoodPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=last_good_price,json=lastGoodPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"last_good_price"`
+	Price         cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *EventPairHalted) Reset()         { *m = EventPairHalted{} }
+func (m *EventPairHalted) String() string { return proto.CompactTextString(m) }
+func (*EventPairHalted) ProtoMessage()    {}
+func (*EventPairHalted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94ec441b793fc0ea, []int{5}
+}
+func (m *EventPairHalted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPairHalted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPairHalted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPairHalted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPairHalted.Merge(m, src)
+}
+func (m *EventPairHalted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPairHalted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPairHalted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPairHalted proto.InternalMessageInfo
+
+func (m *EventPairHalted) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+// Emitted when a halted pair resumes, either because a tally came back within
+// the deviation bound or because a sudoer resumed it.
+type EventPairResumed struct {
+	Pair  string                      `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *EventPairResumed) Reset()         { *m = EventPairResumed{} }
+func (m *EventPairResumed) String() string { return proto.CompactTextString(m) }
+func (*EventPairResumed) ProtoMessage()    {}
+func (*EventPairResumed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94ec441b793fc0ea, []int{6}
+}
+func (m *EventPairResumed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPairResumed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPairResumed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPairResumed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPairResumed.Merge(m, src)
+}
+func (m *EventPairResumed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPairResumed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPairResumed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPairResumed proto.InternalMessageInfo
+
+func (m *EventPairResumed) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventPriceUpdate)(nil), "nibiru.oracle.v1.EventPriceUpdate")
 	proto.RegisterType((*EventDelegateFeederConsent)(nil), "nibiru.oracle.v1.EventDelegateFeederConsent")
 	proto.RegisterType((*EventAggregateVote)(nil), "nibiru.oracle.v1.EventAggregateVote")
 	proto.RegisterType((*EventAggregatePrevote)(nil), "nibiru.oracle.v1.EventAggregatePrevote")
 	proto.RegisterType((*EventValidatorPerformance)(nil), "nibiru.oracle.v1.EventValidatorPerformance")
+	proto.RegisterType((*EventPairHalted)(nil), "nibiru.oracle.v1.EventPairHalted")
+	proto.RegisterType((*EventPairResumed)(nil), "nibiru.oracle.v1.EventPairResumed")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/event.proto", fileDescriptor_94ec441b793fc0ea) }
 
 var fileDescriptor_94ec441b793fc0ea = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0x13, 0x3b,
-	0x14, 0xcd, 0xbc, 0xb4, 0xd1, 0x8b, 0x1b, 0xa4, 0xca, 0x02, 0x94, 0xa6, 0xe9, 0xa4, 0x4d, 0x37,
-	0xd9, 0x30, 0x56, 0xca, 0x17, 0x90, 0xa4, 0x20, 0x24, 0x8a, 0xa2, 0x11, 0xb4, 0x12, 0x9b, 0xc8,
-	0x99, 0xdc, 0x3a, 0x16, 0x19, 0x7b, 0x64, 0x3b, 0x93, 0xf6, 0x2f, 0x10, 0xbf, 0xc0, 0x8e, 0x35,
-	0x1f, 0xd1, 0x65, 0xc5, 0x0a, 0xb1, 0x28, 0x28, 0xf9, 0x11, 0x34, 0xb6, 0x53, 0x04, 0x5d, 0x20,
-	0x75, 0x77, 0xef, 0x39, 0xe7, 0x9e, 0x39, 0xe3, 0xab, 0x8b, 0x9a, 0x82, 0x8f, 0xb9, 0x9a, 0x13,
-	0xa9, 0x68, 0x32, 0x03, 0x92, 0x77, 0x09, 0xe4, 0x20, 0x4c, 0x94, 0x29, 0x69, 0x24, 0xde, 0x76,
-	0x6c, 0xe4, 0xd8, 0x28, 0xef, 0x36, 0xf6, 0xee, 0xe8, 0x3d, 0x67, 0x07, 0x1a, 0x0f, 0x99, 0x64,
-	0xd2, 0x96, 0xa4, 0xa8, 0x3c, 0xda, 0x64, 0x52, 0xb2, 0x19, 0x10, 0x9a, 0x71, 0x42, 0x85, 0x90,
-	0x86, 0x1a, 0x2e, 0x85, 0xf6, 0xec, 0x4e, 0x22, 0x75, 0x2a, 0xf5, 0xc8, 0x8d, 0xb9, 0xc6, 0x51,
-	0xed, 0x8f, 0x01, 0xda, 0x3e, 0x2e, 0xf2, 0x0c, 0x15, 0x4f, 0xe0, 0x6d, 0x36, 0xa1, 0x06, 0x30,
-	0x46, 0x1b, 0x19, 0xe5, 0xaa, 0x1e, 0xec, 0x07, 0x9d, 0x6a, 0x6c, 0x6b, 0xfc, 0x02, 0x6d, 0x66,
-	0x85, 0xa4, 0xfe, 0x5f, 0x01, 0xf6, 0xba, 0x57, 0x37, 0xad, 0xd2, 0xf7, 0x9b, 0xd6, 0xae, 0x73,
-	0xd3, 0x93, 0xf7, 0x11, 0x97, 0x24, 0xa5, 0x66, 0x1a, 0xbd, 0x02, 0x46, 0x93, 0xcb, 0x01, 0x24,
-	0x5f, 0xbf, 0x3c, 0x41, 0xfe, 0x63, 0x03, 0x48, 0x62, 0x37, 0x8f, 0x0f, 0x50, 0xcd, 0xf0, 0x14,
-	0xb4, 0xa1, 0x69, 0x36, 0x4a, 0x75, 0xbd, 0xbc, 0x1f, 0x74, 0xca, 0xf1, 0xd6, 0x2d, 0x76, 0xa2,
-	0xdb, 0x31, 0x6a, 0xd8, 0x4c, 0x03, 0x98, 0x01, 0xa3, 0x06, 0x9e, 0x03, 0x4c, 0x40, 0xf5, 0xa5,
-	0xd0, 0x20, 0x0c, 0x6e, 0xa2, 0x6a, 0x4e, 0x67, 0x7c, 0x42, 0x8d, 0x5c, 0x47, 0xfc, 0x0d, 0xe0,
-	0xc7, 0xa8, 0x72, 0x6e, 0xe5, 0x2e, 0x68, 0xec, 0xbb, 0xf6, 0xa7, 0x00, 0x61, 0x6b, 0xfa, 0x8c,
-	0x31, 0x65, 0x5d, 0x4f, 0xa5, 0x81, 0xfb, 0x99, 0xe1, 0x33, 0x54, 0xb1, 0x3f, 0x53, 0xa4, 0x2f,
-	0x77, 0xb6, 0x8e, 0x0e, 0xa3, 0xbf, 0xd7, 0x18, 0x1d, 0x5f, 0x24, 0x53, 0x2a, 0x18, 0xc4, 0xd4,
-	0xc0, 0x9b, 0x79, 0x36, 0x83, 0x5e, 0xa3, 0x78, 0xb2, 0xcf, 0x3f, 0x5a, 0xf8, 0x0e, 0xa5, 0x63,
-	0x6f, 0xd7, 0x3e, 0x41, 0x8f, 0xfe, 0x0c, 0x39, 0x54, 0x90, 0xdf, 0x3b, 0x67, 0x7b, 0x19, 0xa0,
-	0x1d, 0xeb, 0x77, 0xba, 0x96, 0x0e, 0x41, 0x9d, 0x4b, 0x95, 0x52, 0x91, 0xfc, 0xcb, 0xf3, 0x00,
-	0xd5, 0x72, 0x69, 0xb8, 0x60, 0xa3, 0x4c, 0x2e, 0xbc, 0x73, 0x39, 0xde, 0x72, 0xd8, 0xb0, 0x80,
-	0xf0, 0x21, 0x7a, 0xa0, 0x60, 0x41, 0xd5, 0x64, 0xb4, 0x00, 0xce, 0xa6, 0xc6, 0xef, 0xb2, 0xe6,
-	0xc0, 0x33, 0x8b, 0xe1, 0x5d, 0x54, 0x5d, 0x70, 0x31, 0x4a, 0xe4, 0x5c, 0x98, 0xfa, 0x86, 0x15,
-	0xfc, 0xbf, 0xe0, 0xa2, 0x5f, 0xf4, 0x85, 0x03, 0x1d, 0x6b, 0x43, 0x6f, 0x05, 0x9b, 0xce, 0xc1,
-	0x83, 0x4e, 0xb4, 0x87, 0x50, 0xca, 0xb5, 0xf6, 0x8a, 0x8a, 0x55, 0x54, 0x0b, 0xc4, 0xd2, 0xbd,
-	0x97, 0x57, 0xcb, 0x30, 0xb8, 0x5e, 0x86, 0xc1, 0xcf, 0x65, 0x18, 0x7c, 0x58, 0x85, 0xa5, 0xeb,
-	0x55, 0x58, 0xfa, 0xb6, 0x0a, 0x4b, 0xef, 0x08, 0xe3, 0x66, 0x3a, 0x1f, 0x47, 0x89, 0x4c, 0xc9,
-	0x6b, 0xbb, 0xa0, 0xfe, 0x94, 0x72, 0x41, 0xfc, 0x85, 0xe5, 0x47, 0xe4, 0x62, 0x7d, 0x66, 0xe6,
-	0x32, 0x03, 0x3d, 0xae, 0xd8, 0xa3, 0x78, 0xfa, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x06, 0x7f, 0xd3,
-	0x5e, 0xb4, 0x03, 0x00, 0x00,
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x4e, 0xd4, 0x40,
+	0x14, 0xde, 0xba, 0xb0, 0x71, 0x07, 0x08, 0xa4, 0x51, 0xb3, 0x2c, 0xb0, 0x40, 0xb9, 0xe1, 0xc6,
+	0x36, 0xe0, 0x13, 0xc8, 0x8f, 0x68, 0x22, 0x66, 0xd3, 0x28, 0x44, 0x6f, 0x9a, 0xd9, 0xf6, 0x30,
+	0x3b, 0xb1, 0x9d, 0xd3, 0xcc, 0xcc, 0x76, 0xe1, 0x2d, 0x8c, 0xaf, 0xe0, 0x9d, 0xd7, 0x3e, 0x04,
+	0xde, 0x11, 0xaf, 0x8c, 0x17, 0x68, 0x96, 0x17, 0x31, 0x9d, 0xe9, 0x42, 0x14, 0x8d, 0x09, 0x78,
+	0x37, 0xf3, 0x7d, 0xdf, 0x7c, 0xfd, 0xa6, 0x73, 0xce, 0x21, 0x8b, 0x82, 0xf7, 0xb8, 0x1c, 0x04,
+	0x28, 0x69, 0x9c, 0x42, 0x50, 0x6c, 0x04, 0x50, 0x80, 0xd0, 0x7e, 0x2e, 0x51, 0xa3, 0x3b, 0x67,
+	0x59, 0xdf, 0xb2, 0x7e, 0xb1, 0xd1, 0x5e, 0xba, 0xa6, 0xaf, 0x38, 0x73, 0xa0, 0x7d, 0x8f, 0x21,
+	0x43, 0xb3, 0x0c, 0xca, 0x55, 0x85, 0x2e, 0x32, 0x44, 0x96, 0x42, 0x40, 0x73, 0x1e, 0x50, 0x21,
+	0x50, 0x53, 0xcd, 0x51, 0xa8, 0x8a, 0x9d, 0x8f, 0x51, 0x65, 0xa8, 0x22, 0x7b, 0xcc, 0x6e, 0x2c,
+	0xe5, 0xbd, 0x77, 0xc8, 0xdc, 0x6e, 0x99, 0xa7, 0x2b, 0x79, 0x0c, 0xaf, 0xf2, 0x84, 0x6a, 0x70,
+	0x5d, 0x32, 0x91, 0x53, 0x2e, 0x5b, 0xce, 0x8a, 0xb3, 0xde, 0x0c, 0xcd, 0xda, 0xdd, 0x23, 0x93,
+	0x79, 0x29, 0x69, 0xdd, 0x29, 0xc1, 0xad, 0x8d, 0xd3, 0xf3, 0xe5, 0xda, 0xb7, 0xf3, 0xe5, 0x05,
+	0xeb, 0xa6, 0x92, 0xb7, 0x3e, 0xc7, 0x20, 0xa3, 0xba, 0xef, 0x3f, 0x07, 0x46, 0xe3, 0x93, 0x1d,
+	0x88, 0xbf, 0x7c, 0x7a, 0x48, 0xaa, 0x8f, 0xed, 0x40, 0x1c, 0xda, 0xf3, 0xee, 0x2a, 0x99, 0xd6,
+	0x3c, 0x03, 0xa5, 0x69, 0x96, 0x47, 0x99, 0x6a, 0xd5, 0x57, 0x9c, 0xf5, 0x7a, 0x38, 0x75, 0x89,
+	0xed, 0x2b, 0x2f, 0x24, 0x6d, 0x93, 0x69, 0x07, 0x52, 0x60, 0x54, 0xc3, 0x13, 0x80, 0x04, 0xe4,
+	0x36, 0x0a, 0x05, 0x42, 0xbb, 0x8b, 0xa4, 0x59, 0xd0, 0x94, 0x27, 0x54, 0xe3, 0x38, 0xe2, 0x15,
+	0xe0, 0x3e, 0x20, 0x8d, 0x23, 0x23, 0xb7, 0x41, 0xc3, 0x6a, 0xe7, 0x7d, 0x70, 0x88, 0x6b, 0x4c,
+	0x1f, 0x33, 0x26, 0x8d, 0xeb, 0x01, 0x6a, 0xb8, 0x99, 0x99, 0x7b, 0x48, 0x1a, 0xe6, 0x32, 0x65,
+	0xfa, 0xfa, 0xfa, 0xd4, 0xe6, 0x9a, 0xff, 0xfb, 0x33, 0xfa, 0xbb, 0xc7, 0x71, 0x9f, 0x0a, 0x06,
+	0x21, 0xd5, 0xf0, 0x72, 0x90, 0xa7, 0xb0, 0xd5, 0x2e, 0x7f, 0xd9, 0xc7, 0xef, 0xcb, 0xee, 0x35,
+	0x4a, 0x85, 0x95, 0x9d, 0xb7, 0x4f, 0xee, 0xff, 0x1a, 0xb2, 0x2b, 0xa1, 0xb8, 0x71, 0x4e, 0x6f,
+	0xe4, 0x90, 0x79, 0xe3, 0x77, 0x30, 0x96, 0x76, 0x41, 0x1e, 0xa1, 0xcc, 0xa8, 0x88, 0xff, 0xe5,
+	0xb9, 0x4a, 0xa6, 0x0b, 0xd4, 0x5c, 0xb0, 0x28, 0xc7, 0x61, 0xe5, 0x5c, 0x0f, 0xa7, 0x2c, 0xd6,
+	0x2d, 0x21, 0x77, 0x8d, 0xcc, 0x48, 0x18, 0x52, 0x99, 0x44, 0x43, 0xe0, 0xac, 0xaf, 0xab, 0xb7,
+	0x9c, 0xb6, 0xe0, 0xa1, 0xc1, 0xdc, 0x05, 0xd2, 0x1c, 0x72, 0x11, 0xc5, 0x38, 0x10, 0xba, 0x35,
+	0x61, 0x04, 0x77, 0x87, 0x5c, 0x6c, 0x97, 0xfb, 0xd2, 0x81, 0xf6, 0x94, 0xa6, 0x97, 0x82, 0x49,
+	0xeb, 0x50, 0x81, 0x56, 0xb4, 0x44, 0x48, 0xc6, 0x95, 0xaa, 0x14, 0x0d, 0xa3, 0x68, 0x96, 0x88,
+	0xa1, 0xbd, 0xcf, 0x0e, 0x99, 0xb5, 0x25, 0x4c, 0xb9, 0x7c, 0x4a, 0x53, 0x0d, 0xc9, 0x1f, 0x2b,
+	0xf8, 0x35, 0x99, 0x4d, 0xa9, 0xd2, 0x11, 0x43, 0x4c, 0xa2, 0x5b, 0xd6, 0xf2, 0x4c, 0xe9, 0xb4,
+	0x87, 0x98, 0x98, 0xb6, 0xb9, 0x6a, 0x8e, 0xfa, 0xed, 0x9a, 0xc3, 0xc3, 0x71, 0x37, 0x52, 0x2e,
+	0x43, 0x50, 0x83, 0xec, 0x2f, 0x77, 0xf9, 0x5f, 0xdd, 0xb8, 0xf5, 0xec, 0x74, 0xd4, 0x71, 0xce,
+	0x46, 0x1d, 0xe7, 0xc7, 0xa8, 0xe3, 0xbc, 0xbb, 0xe8, 0xd4, 0xce, 0x2e, 0x3a, 0xb5, 0xaf, 0x17,
+	0x9d, 0xda, 0x9b, 0x80, 0x71, 0xdd, 0x1f, 0xf4, 0xfc, 0x18, 0xb3, 0xe0, 0x85, 0xa9, 0xee, 0xed,
+	0x3e, 0xe5, 0x22, 0xa8, 0xc6, 0x53, 0xb1, 0x19, 0x1c, 0x8f, 0x67, 0x94, 0x3e, 0xc9, 0x41, 0xf5,
+	0x1a, 0x66, 0xa2, 0x3c, 0xfa, 0x39, 0x00, 0x2f, 0xc4, 0xf5, 0xdf, 0xf1, 0x04, 0x00, 0x00,
 }
 
 func (m *EventPriceUpdate) Marshal() (dAtA []byte, err error) {
@@ -621,6 +721,96 @@ func (m *EventValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPairHalted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPairHalted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPairHalted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LastGoodPrice.Size()
+		i -= size
+		if _, err := m.LastGoodPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPairResumed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPairResumed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPairResumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -735,6 +925,38 @@ func (m *EventValidatorPerformance) Size() (n int) {
 	return n
 }
 
+func (m *EventPairHalted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.LastGoodPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventPairResumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1429,6 +1651,272 @@ func (m *EventValidatorPerformance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPairHalted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPairHalted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPairHalted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGoodPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastGoodPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPairResumed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPairResumed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPairResumed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
@@ -16,6 +17,9 @@ func NewGenesisState(
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
 	pairs []asset.Pair,
 	rewards []Rewards,
+	pairAggregationConfigs []PairAggregationConfig,
+	pairStatuses []PairStatus,
+	exchangeRateEmas []ExchangeRateEma,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -26,6 +30,9 @@ func NewGenesisState(
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
 		Pairs:                         pairs,
 		Rewards:                       rewards,
+		PairAggregationConfigs:        pairAggregationConfigs,
+		PairStatuses:                  pairStatuses,
+		ExchangeRateEmas:              exchangeRateEmas,
 	}
 }
 
@@ -39,12 +46,49 @@ func DefaultGenesisState() *GenesisState {
 		[]AggregateExchangeRatePrevote{},
 		[]AggregateExchangeRateVote{},
 		[]asset.Pair{},
-		[]Rewards{})
+		[]Rewards{},
+		[]PairAggregationConfig{},
+		[]PairStatus{},
+		[]ExchangeRateEma{})
 }
 
 // ValidateGenesis validates the oracle genesis state
 func ValidateGenesis(data *GenesisState) error {
-	return data.Params.Validate()
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenPairs := make(map[asset.Pair]bool)
+	for _, config := range data.PairAggregationConfigs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+		if seenPairs[config.Pair] {
+			return fmt.Errorf("duplicate aggregation config for pair %s", config.Pair)
+		}
+		seenPairs[config.Pair] = true
+	}
+
+	for _, status := range data.PairStatuses {
+		if err := status.Pair.Validate(); err != nil {
+			return fmt.Errorf("invalid pair status: %w", err)
+		}
+		if status.LastGoodPrice.IsNil() || status.LastGoodPrice.IsNegative() {
+			return fmt.Errorf("invalid last good price for pair %s", status.Pair)
+		}
+	}
+
+	seenPairs = make(map[asset.Pair]bool)
+	for _, emas := range data.ExchangeRateEmas {
+		if err := emas.Validate(); err != nil {
+			return err
+		}
+		if seenPairs[emas.Pair] {
+			return fmt.Errorf("duplicate exchange rate ema for pair %s", emas.Pair)
+		}
+		seenPairs[emas.Pair] = true
+	}
+	return nil
 }
 
 // GetGenesisStateFromAppState returns x/oracle GenesisState given raw application
@@ -35,6 +35,9 @@ type GenesisState struct {
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote                            `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
 	Pairs                         []github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,7,rep,name=pairs,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"pairs"`
 	Rewards                       []Rewards                                              `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards"`
+	PairAggregationConfigs        []PairAggregationConfig                                `protobuf:"bytes,9,rep,name=pair_aggregation_configs,json=pairAggregationConfigs,proto3" json:"pair_aggregation_configs"`
+	PairStatuses                  []PairStatus                                           `protobuf:"bytes,10,rep,name=pair_statuses,json=pairStatuses,proto3" json:"pair_statuses"`
+	ExchangeRateEmas              []ExchangeRateEma                                      `protobuf:"bytes,11,rep,name=exchange_rate_emas,json=exchangeRateEmas,proto3" json:"exchange_rate_emas"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -119,6 +122,27 @@ func (m *GenesisState) GetRewards() []Rewards {
 	return nil
 }
 
+func (m *GenesisState) GetPairAggregationConfigs() []PairAggregationConfig {
+	if m != nil {
+		return m.PairAggregationConfigs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPairStatuses() []PairStatus {
+	if m != nil {
+		return m.PairStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExchangeRateEmas() []ExchangeRateEma {
+	if m != nil {
+		return m.ExchangeRateEmas
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -237,42 +261,47 @@ func init() {
 func init() { proto.RegisterFile("nibiru/oracle/v1/genesis.proto", fileDescriptor_d88ebb2fa2659942) }
 
 var fileDescriptor_d88ebb2fa2659942 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0xc6, 0xb3, 0x6d, 0x93, 0xda, 0x49, 0x52, 0xd2, 0xc1, 0xc3, 0x1a, 0xc8, 0x26, 0x46, 0x84,
-	0x40, 0x61, 0x87, 0x44, 0x11, 0x04, 0x2f, 0x4d, 0xfd, 0x7b, 0x50, 0xc2, 0x2a, 0x0a, 0x82, 0x84,
-	0xc9, 0xee, 0x64, 0x33, 0x90, 0xdd, 0x59, 0xe6, 0x9d, 0xc4, 0x7a, 0xf0, 0x3b, 0xf8, 0x39, 0xfc,
-	0x24, 0x3d, 0xf6, 0x22, 0x88, 0x87, 0x2a, 0xc9, 0x17, 0x91, 0x9d, 0xd9, 0x36, 0x31, 0xdb, 0x4a,
-	0x6f, 0xe1, 0x7d, 0x7e, 0xef, 0xf3, 0xbc, 0x13, 0x9e, 0x04, 0x39, 0x31, 0x1f, 0x71, 0x39, 0x23,
-	0x42, 0x52, 0x7f, 0xca, 0xc8, 0xbc, 0x4b, 0x42, 0x16, 0x33, 0xe0, 0xe0, 0x26, 0x52, 0x28, 0x81,
-	0x6b, 0x46, 0x77, 0x8d, 0xee, 0xce, 0xbb, 0xf5, 0xdb, 0xa1, 0x08, 0x85, 0x16, 0x49, 0xfa, 0xc9,
-	0x70, 0xf5, 0x46, 0xce, 0x27, 0xdb, 0x30, 0xb2, 0xe3, 0x0b, 0x88, 0x04, 0x90, 0x11, 0x85, 0x54,
-	0x1c, 0x31, 0x45, 0xbb, 0xc4, 0x17, 0x3c, 0x36, 0x7a, 0xfb, 0x47, 0x11, 0x55, 0x5e, 0x98, 0xe0,
-	0xb7, 0x8a, 0x2a, 0x86, 0x1f, 0xa1, 0x52, 0x42, 0x25, 0x8d, 0xc0, 0xb6, 0x5a, 0x56, 0xa7, 0xdc,
-	0xb3, 0xdd, 0xcd, 0x43, 0xdc, 0x81, 0xd6, 0xfb, 0x3b, 0xa7, 0xe7, 0xcd, 0x82, 0x97, 0xd1, 0xf8,
-	0x03, 0xc2, 0x63, 0xc6, 0x02, 0x26, 0x87, 0x01, 0x9b, 0xb2, 0x90, 0x2a, 0x2e, 0x62, 0xb0, 0xb7,
-	0x5a, 0xdb, 0x9d, 0x72, 0xaf, 0x9d, 0xf7, 0x78, 0xae, 0xd9, 0xa7, 0x97, 0x68, 0xe6, 0x76, 0x30,
-	0xde, 0x98, 0x03, 0x1e, 0xa3, 0x7d, 0x76, 0xe2, 0x4f, 0x68, 0x1c, 0xb2, 0xa1, 0xa4, 0x8a, 0x81,
-	0xbd, 0xad, 0x4d, 0xef, 0xe5, 0x4d, 0x9f, 0x65, 0x9c, 0x47, 0x15, 0x7b, 0x37, 0x4b, 0xa6, 0xac,
-	0x5f, 0x4f, 0x5d, 0xbf, 0xff, 0x6e, 0xe2, 0x9c, 0x04, 0x5e, 0x95, 0xad, 0xcd, 0x00, 0xbf, 0x44,
-	0xd5, 0x88, 0x03, 0x0c, 0x7d, 0x31, 0x8b, 0x15, 0x93, 0x60, 0xef, 0xe8, 0x98, 0x46, 0x3e, 0xe6,
-	0x35, 0x07, 0x38, 0x36, 0x54, 0x76, 0x76, 0x25, 0x5a, 0x8d, 0x00, 0x7f, 0x45, 0x2d, 0x1a, 0x86,
-	0x32, 0x7d, 0x01, 0x1b, 0xfe, 0x73, 0xfb, 0x30, 0x91, 0x6c, 0x2e, 0xd2, 0x37, 0x14, 0xb5, 0xb9,
-	0x9b, 0x37, 0x3f, 0xba, 0xd8, 0x5c, 0xbf, 0x78, 0x60, 0xd6, 0xb2, 0xb4, 0x06, 0xfd, 0x0f, 0x03,
-	0x58, 0xa1, 0xc6, 0x75, 0xf1, 0x26, 0xbb, 0xa4, 0xb3, 0x0f, 0x6f, 0x98, 0xfd, 0x7e, 0x15, 0x5c,
-	0xa7, 0xd7, 0x01, 0x80, 0x3d, 0x54, 0x4c, 0x28, 0x97, 0x60, 0xef, 0xb6, 0xb6, 0x3b, 0x7b, 0xfd,
-	0x27, 0xe9, 0xc2, 0xaf, 0xf3, 0xe6, 0xc3, 0x90, 0xab, 0xc9, 0x6c, 0xe4, 0xfa, 0x22, 0x22, 0x6f,
-	0x74, 0xde, 0xf1, 0x84, 0xf2, 0x98, 0x64, 0xad, 0x9d, 0xf7, 0xc8, 0x09, 0xf1, 0x45, 0x14, 0x89,
-	0x98, 0x50, 0x00, 0xa6, 0xdc, 0x01, 0xe5, 0xd2, 0x33, 0x56, 0xf8, 0x31, 0xda, 0x95, 0xec, 0x33,
-	0x95, 0x01, 0xd8, 0xb7, 0xf4, 0xcd, 0x77, 0xf2, 0x37, 0x7b, 0x06, 0xc8, 0x2e, 0xbc, 0xe0, 0xdb,
-	0x63, 0x54, 0xdb, 0xac, 0x18, 0xbe, 0x8f, 0xf6, 0xb3, 0x8a, 0xd2, 0x20, 0x90, 0x0c, 0x4c, 0xc5,
-	0xf7, 0xbc, 0xaa, 0x99, 0x1e, 0x99, 0x21, 0x3e, 0x44, 0x07, 0x73, 0x3a, 0xe5, 0x01, 0x55, 0x62,
-	0x45, 0x6e, 0x69, 0xb2, 0x76, 0x29, 0x64, 0x70, 0xfb, 0x13, 0x2a, 0xaf, 0xd5, 0xe1, 0xea, 0x5d,
-	0xeb, 0xea, 0x5d, 0x7c, 0x17, 0x55, 0xd6, 0x1b, 0xa7, 0x33, 0x76, 0xbc, 0xf2, 0x5a, 0x97, 0xfa,
-	0xaf, 0x4e, 0x17, 0x8e, 0x75, 0xb6, 0x70, 0xac, 0x3f, 0x0b, 0xc7, 0xfa, 0xb6, 0x74, 0x0a, 0x67,
-	0x4b, 0xa7, 0xf0, 0x73, 0xe9, 0x14, 0x3e, 0x92, 0x1b, 0x7c, 0xb1, 0xd9, 0x7f, 0x82, 0xfa, 0x92,
-	0x30, 0x18, 0x95, 0xf4, 0x0f, 0xfe, 0xc1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x90, 0xe5, 0x08,
-	0xe6, 0x79, 0x04, 0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdf, 0x4a, 0x1b, 0x4d,
+	0x14, 0x4f, 0xfc, 0xfb, 0x39, 0x31, 0x12, 0x87, 0x8f, 0x8f, 0xfd, 0x82, 0x59, 0x63, 0x4a, 0xa9,
+	0x20, 0xec, 0xa2, 0x2d, 0x85, 0x42, 0x6f, 0x8c, 0xb5, 0xb6, 0x17, 0x2d, 0xb2, 0xf6, 0x0f, 0x14,
+	0xca, 0x32, 0xd9, 0x9c, 0xac, 0x03, 0xd9, 0x9d, 0x65, 0xce, 0x24, 0xb5, 0x17, 0x7d, 0x87, 0x3e,
+	0x47, 0x9f, 0xc4, 0x4b, 0x2f, 0x4b, 0x2f, 0x6c, 0xd1, 0x17, 0x29, 0x3b, 0x33, 0x31, 0xd1, 0x8d,
+	0xe2, 0x5d, 0x38, 0xbf, 0xbf, 0x4b, 0xce, 0x19, 0xe2, 0xa6, 0xbc, 0xc3, 0xe5, 0xc0, 0x17, 0x92,
+	0x45, 0x7d, 0xf0, 0x87, 0xdb, 0x7e, 0x0c, 0x29, 0x20, 0x47, 0x2f, 0x93, 0x42, 0x09, 0x5a, 0x33,
+	0xb8, 0x67, 0x70, 0x6f, 0xb8, 0x5d, 0xff, 0x37, 0x16, 0xb1, 0xd0, 0xa0, 0x9f, 0xff, 0x32, 0xbc,
+	0x7a, 0xa3, 0xe0, 0x63, 0x15, 0x06, 0x5e, 0x2b, 0xc0, 0xa8, 0x98, 0x1a, 0xa1, 0x6e, 0x24, 0x30,
+	0x11, 0xe8, 0x77, 0x18, 0xe6, 0x58, 0x07, 0x14, 0xdb, 0xf6, 0x23, 0xc1, 0x53, 0x83, 0xb7, 0xce,
+	0x17, 0xc9, 0xf2, 0x81, 0xa9, 0x75, 0x94, 0xcb, 0xe8, 0x53, 0xb2, 0x90, 0x31, 0xc9, 0x12, 0x74,
+	0xca, 0xcd, 0xf2, 0x66, 0x65, 0xc7, 0xf1, 0x6e, 0xd6, 0xf4, 0x0e, 0x35, 0xde, 0x9e, 0x3b, 0x3d,
+	0x5f, 0x2f, 0x05, 0x96, 0x4d, 0x3f, 0x12, 0xda, 0x03, 0xe8, 0x82, 0x0c, 0xbb, 0xd0, 0x87, 0x98,
+	0x29, 0x2e, 0x52, 0x74, 0x66, 0x9a, 0xb3, 0x9b, 0x95, 0x9d, 0x56, 0xd1, 0xe3, 0xa5, 0xe6, 0xbe,
+	0xb8, 0xa2, 0x5a, 0xb7, 0xd5, 0xde, 0x8d, 0x39, 0xd2, 0x1e, 0x59, 0x81, 0x93, 0xe8, 0x98, 0xa5,
+	0x31, 0x84, 0x92, 0x29, 0x40, 0x67, 0x56, 0x9b, 0x3e, 0x28, 0x9a, 0xee, 0x5b, 0x5e, 0xc0, 0x14,
+	0xbc, 0x1b, 0x64, 0x7d, 0x68, 0xd7, 0x73, 0xd7, 0x1f, 0xbf, 0xd7, 0x69, 0x01, 0xc2, 0xa0, 0x0a,
+	0x13, 0x33, 0xa4, 0xaf, 0x48, 0x35, 0xe1, 0x88, 0x61, 0x24, 0x06, 0xa9, 0x02, 0x89, 0xce, 0x9c,
+	0x8e, 0x69, 0x14, 0x63, 0xde, 0x70, 0xc4, 0x3d, 0xc3, 0xb2, 0xb5, 0x97, 0x93, 0xf1, 0x08, 0xe9,
+	0x37, 0xd2, 0x64, 0x71, 0x2c, 0xf3, 0x2f, 0x80, 0xf0, 0x5a, 0xf7, 0x30, 0x93, 0x30, 0x14, 0xf9,
+	0x37, 0xcc, 0x6b, 0x73, 0xaf, 0x68, 0xbe, 0x3b, 0x52, 0x4e, 0x36, 0x3e, 0x34, 0x32, 0x9b, 0xd6,
+	0x60, 0x77, 0x70, 0x90, 0x2a, 0xd2, 0xb8, 0x2d, 0xde, 0x64, 0x2f, 0xe8, 0xec, 0xad, 0x7b, 0x66,
+	0x7f, 0x18, 0x07, 0xd7, 0xd9, 0x6d, 0x04, 0xa4, 0x01, 0x99, 0xcf, 0x18, 0x97, 0xe8, 0x2c, 0x36,
+	0x67, 0x37, 0x97, 0xda, 0xcf, 0x73, 0xc1, 0xaf, 0xf3, 0xf5, 0x27, 0x31, 0x57, 0xc7, 0x83, 0x8e,
+	0x17, 0x89, 0xc4, 0x7f, 0xab, 0xf3, 0xf6, 0x8e, 0x19, 0x4f, 0x7d, 0xbb, 0xb4, 0xc3, 0x1d, 0xff,
+	0xc4, 0x8f, 0x44, 0x92, 0x88, 0xd4, 0x67, 0x88, 0xa0, 0xbc, 0x43, 0xc6, 0x65, 0x60, 0xac, 0xe8,
+	0x33, 0xb2, 0x28, 0xe1, 0x0b, 0x93, 0x5d, 0x74, 0xfe, 0xd1, 0x9d, 0xff, 0x2f, 0x76, 0x0e, 0x0c,
+	0xc1, 0x36, 0x1c, 0xf1, 0x69, 0x4c, 0x9c, 0xdc, 0x23, 0x1c, 0x35, 0xe6, 0x22, 0x0d, 0x23, 0x91,
+	0xf6, 0x78, 0x8c, 0xce, 0x92, 0xf6, 0x7a, 0x34, 0x6d, 0xb1, 0xb9, 0xdc, 0x1d, 0x0b, 0xf6, 0x34,
+	0xdf, 0x3a, 0xff, 0x97, 0x4d, 0x03, 0x91, 0x1e, 0x90, 0xaa, 0x0e, 0xca, 0x8f, 0x6e, 0x80, 0x80,
+	0x0e, 0xd1, 0xee, 0x6b, 0xd3, 0xdd, 0x8f, 0x34, 0x6b, 0xb4, 0x35, 0xd9, 0xd5, 0x04, 0x90, 0xbe,
+	0x27, 0xf4, 0xfa, 0x9f, 0x05, 0x09, 0x43, 0xa7, 0xa2, 0xdd, 0x36, 0xee, 0xde, 0xf5, 0xfd, 0x84,
+	0x59, 0xcb, 0x1a, 0x5c, 0x1f, 0x63, 0xab, 0x47, 0x6a, 0x37, 0x6f, 0x8d, 0x3e, 0x24, 0x2b, 0xf6,
+	0x56, 0x59, 0xb7, 0x2b, 0x01, 0xcd, 0xad, 0x2f, 0x05, 0x55, 0x33, 0xdd, 0x35, 0x43, 0xba, 0x45,
+	0x56, 0x87, 0xac, 0xcf, 0xbb, 0x4c, 0x89, 0x31, 0x73, 0x46, 0x33, 0x6b, 0x57, 0x80, 0x25, 0xb7,
+	0x3e, 0x93, 0xca, 0xc4, 0x5d, 0x4c, 0xd7, 0x96, 0xa7, 0x6b, 0xe9, 0x06, 0x59, 0x9e, 0x3c, 0x3d,
+	0x9d, 0x31, 0x17, 0x54, 0x26, 0x8e, 0xaa, 0xfd, 0xfa, 0xf4, 0xc2, 0x2d, 0x9f, 0x5d, 0xb8, 0xe5,
+	0x3f, 0x17, 0x6e, 0xf9, 0xfb, 0xa5, 0x5b, 0x3a, 0xbb, 0x74, 0x4b, 0x3f, 0x2f, 0xdd, 0xd2, 0x27,
+	0xff, 0x1e, 0x1b, 0x66, 0xdf, 0x46, 0xf5, 0x35, 0x03, 0xec, 0x2c, 0xe8, 0x97, 0xef, 0xf1, 0xdf,
+	0x01, 0x00, 0xb5, 0xb3, 0xb5, 0xa9, 0xa0, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -295,6 +324,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExchangeRateEmas) > 0 {
+		for iNdEx := len(m.ExchangeRateEmas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PairStatuses) > 0 {
+		for iNdEx := len(m.PairStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PairAggregationConfigs) > 0 {
+		for iNdEx := len(m.PairAggregationConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairAggregationConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Rewards) > 0 {
 		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -539,6 +610,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PairAggregationConfigs) > 0 {
+		for _, e := range m.PairAggregationConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairStatuses) > 0 {
+		for _, e := range m.PairStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeRateEmas) > 0 {
+		for _, e := range m.ExchangeRateEmas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -883,6 +972,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairAggregationConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairAggregationConfigs = append(m.PairAggregationConfigs, PairAggregationConfig{})
+			if err := m.PairAggregationConfigs[len(m.PairAggregationConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairStatuses = append(m.PairStatuses, PairStatus{})
+			if err := m.PairStatuses[len(m.PairStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateEmas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateEmas = append(m.ExchangeRateEmas, ExchangeRateEma{})
+			if err := m.ExchangeRateEmas[len(m.ExchangeRateEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
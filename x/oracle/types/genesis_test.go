@@ -3,10 +3,14 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/v2/app"
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
 	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
 )
 
@@ -18,6 +22,73 @@ func TestGenesisValidation(t *testing.T) {
 	require.Error(t, types.ValidateGenesis(genState))
 }
 
+func TestGenesisValidation_ExchangeRateEmas(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	validEma := types.ExchangeRateEma{
+		Pair:        pair,
+		TimestampMs: 1,
+		Emas: []types.EmaValue{
+			{HalfLife: time.Hour, Ema: sdkmath.LegacyNewDec(10)},
+		},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		emas    []types.ExchangeRateEma
+		wantErr string
+	}{
+		{name: "happy", emas: []types.ExchangeRateEma{validEma}},
+		{
+			name:    "duplicate pair",
+			emas:    []types.ExchangeRateEma{validEma, validEma},
+			wantErr: "duplicate exchange rate ema",
+		},
+		{
+			name: "invalid pair",
+			emas: []types.ExchangeRateEma{{
+				Pair: "btc",
+				Emas: validEma.Emas,
+			}},
+			wantErr: "invalid exchange rate ema",
+		},
+		{
+			name: "non-positive half-life",
+			emas: []types.ExchangeRateEma{{
+				Pair: pair,
+				Emas: []types.EmaValue{{Ema: sdkmath.LegacyNewDec(10)}},
+			}},
+			wantErr: "must be positive",
+		},
+		{
+			name: "duplicate half-life",
+			emas: []types.ExchangeRateEma{{
+				Pair: pair,
+				Emas: []types.EmaValue{validEma.Emas[0], validEma.Emas[0]},
+			}},
+			wantErr: "duplicate ema half-life",
+		},
+		{
+			name: "negative ema",
+			emas: []types.ExchangeRateEma{{
+				Pair: pair,
+				Emas: []types.EmaValue{{HalfLife: time.Hour, Ema: sdkmath.LegacyNewDec(-1)}},
+			}},
+			wantErr: "invalid ema",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.ExchangeRateEmas = tc.emas
+			err := types.ValidateGenesis(genState)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestGetGenesisStateFromAppState(t *testing.T) {
 	cdc := app.MakeEncodingConfig().Codec
 	appState := make(map[string]json.RawMessage)
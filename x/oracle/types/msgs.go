@@ -14,6 +14,7 @@ var (
 	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
 	_ sdk.Msg = &MsgEditOracleParams{}
+	_ sdk.Msg = &MsgResumePair{}
 )
 
 // oracle message types
@@ -22,6 +23,7 @@ const (
 	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
 	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
 	TypeMsgEditOracleParams             = "edit_oracle_params"
+	TypeMsgResumePair                   = "resume_pair"
 )
 
 //-------------------------------------------------
@@ -206,6 +208,18 @@ func (m MsgEditOracleParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
 		return err
 	}
+	if m.Params != nil {
+		if m.Params.ClearEmaHalfLives && len(m.Params.EmaHalfLives) != 0 {
+			return sdkioerrors.Wrap(
+				sdkerrors.ErrInvalidRequest, "can't set EmaHalfLives and ClearEmaHalfLives at the same time",
+			)
+		}
+		for _, config := range m.Params.PairAggregationConfigs {
+			if err := config.Validate(); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -220,3 +234,27 @@ func (m MsgEditOracleParams) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// ------------------------ MsgResumePair ------------------------
+
+func (m MsgResumePair) Route() string { return RouterKey }
+func (m MsgResumePair) Type() string  { return TypeMsgResumePair }
+
+func (m MsgResumePair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	return m.Pair.Validate()
+}
+
+func (m MsgResumePair) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgResumePair) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
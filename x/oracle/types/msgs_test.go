@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -123,3 +124,16 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgEditOracleParams(t *testing.T) {
+	sender := sdk.AccAddress([]byte("addr1_______________")).String()
+
+	msg := types.MsgEditOracleParams{
+		Sender: sender,
+		Params: &types.OracleParamsMsg{ClearEmaHalfLives: true},
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Params.EmaHalfLives = []time.Duration{time.Hour}
+	require.ErrorContains(t, msg.ValidateBasic(), "can't set EmaHalfLives and ClearEmaHalfLives")
+}
@@ -31,6 +31,49 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AggregationMethod defines how the votes of a ballot are combined into a
+// single exchange rate.
+type AggregationMethod int32
+
+const (
+	// AGGREGATION_METHOD_WEIGHTED_MEDIAN: median of the votes weighted by the
+	// voting power of each validator. This is the default.
+	AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEDIAN AggregationMethod = 0
+	// AGGREGATION_METHOD_WEIGHTED_MEAN: mean of the votes weighted by the voting
+	// power of each validator.
+	AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEAN AggregationMethod = 1
+	// AGGREGATION_METHOD_MEDIAN: median of the votes where each validator counts
+	// once, regardless of its voting power.
+	AggregationMethod_AGGREGATION_METHOD_MEDIAN AggregationMethod = 2
+	// AGGREGATION_METHOD_TRIMMED_MEAN: mean of the votes weighted by the voting
+	// power of each validator, after discarding the "trim_fraction" of the
+	// voting power with the lowest votes and the same fraction with the highest
+	// votes.
+	AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN AggregationMethod = 3
+)
+
+var AggregationMethod_name = map[int32]string{
+	0: "AGGREGATION_METHOD_WEIGHTED_MEDIAN",
+	1: "AGGREGATION_METHOD_WEIGHTED_MEAN",
+	2: "AGGREGATION_METHOD_MEDIAN",
+	3: "AGGREGATION_METHOD_TRIMMED_MEAN",
+}
+
+var AggregationMethod_value = map[string]int32{
+	"AGGREGATION_METHOD_WEIGHTED_MEDIAN": 0,
+	"AGGREGATION_METHOD_WEIGHTED_MEAN":   1,
+	"AGGREGATION_METHOD_MEDIAN":          2,
+	"AGGREGATION_METHOD_TRIMMED_MEAN":    3,
+}
+
+func (x AggregationMethod) String() string {
+	return proto.EnumName(AggregationMethod_name, int32(x))
+}
+
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{0}
+}
+
 // Params defines the module parameters for the x/oracle module.
 type Params struct {
 	// VotePeriod defines the number of blocks during which voting takes place.
@@ -38,7 +81,7 @@ type Params struct {
 	// VoteThreshold specifies the minimum proportion of votes that must be
 	// received for a ballot to pass.
 	VoteThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"vote_threshold" yaml:"vote_threshold"`
-	// RewardBand defines a maxium divergence that a price vote can have from the
+	// RewardBand defines a maximum divergence that a price vote can have from the
 	// weighted median in the ballot. If a vote lies within the valid range
 	// defined by:
 	//	μ := weightedMedian,
@@ -69,6 +112,9 @@ type Params struct {
 	// The validator fee ratio that is given to validators every epoch.
 	ValidatorFeeRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_fee_ratio" yaml:"validator_fee_ratio"`
 	ExpirationBlocks  uint64                      `protobuf:"varint,11,opt,name=expiration_blocks,json=expirationBlocks,proto3" json:"expiration_blocks,omitempty" yaml:"expiration_blocks"`
+	// Half-lives of the exponentially weighted moving averages (EMA) maintained
+	// for every pair. Each EMA halves the weight of past prices every half-life.
+	EmaHalfLives []time.Duration `protobuf:"bytes,12,rep,name=ema_half_lives,json=emaHalfLives,proto3,stdduration" json:"ema_half_lives,omitempty" yaml:"ema_half_lives"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -139,6 +185,13 @@ func (m *Params) GetExpirationBlocks() uint64 {
 	return 0
 }
 
+func (m *Params) GetEmaHalfLives() []time.Duration {
+	if m != nil {
+		return m.EmaHalfLives
+	}
+	return nil
+}
+
 // Struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
 // which is formatted as hex string in
@@ -383,84 +436,182 @@ func (m *Rewards) GetCoins() []types.Coin {
 	return nil
 }
 
+// PairAggregationConfig overrides how the ballot of a single pair is tallied.
+// Unset overrides fall back to the module params.
+type PairAggregationConfig struct {
+	Pair github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	// Method used to aggregate the votes of the pair.
+	Method AggregationMethod `protobuf:"varint,2,opt,name=method,proto3,enum=nibiru.oracle.v1.AggregationMethod" json:"method,omitempty" yaml:"method"`
+	// Overrides Params.MinVoters for the pair when non-zero.
+	MinVoters uint64 `protobuf:"varint,3,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters"`
+	// Overrides Params.RewardBand for the pair when set.
+	RewardBand *cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=reward_band,json=rewardBand,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_band,omitempty" yaml:"reward_band"`
+	// Overrides Params.VoteThreshold for the pair when set.
+	VoteThreshold *cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"vote_threshold,omitempty" yaml:"vote_threshold"`
+	// Maximum relative change between the last good price of the pair and a new
+	// tally, e.g. "0.1" for 10%. A tally beyond this bound halts the pair. Unset
+	// disables the circuit breaker.
+	MaxDeviation *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_deviation,json=maxDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_deviation,omitempty" yaml:"max_deviation"`
+	// Number of blocks after which the exchange rate of the pair is considered
+	// stale. Zero disables the staleness check.
+	MaxAgeBlocks uint64 `protobuf:"varint,7,opt,name=max_age_blocks,json=maxAgeBlocks,proto3" json:"max_age_blocks,omitempty" yaml:"max_age_blocks"`
+	// Fraction of the voting power trimmed from each end of the sorted votes
+	// with AGGREGATION_METHOD_TRIMMED_MEAN, in [0, 0.5). Required by that
+	// method and not allowed with the others.
+	TrimFraction *cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=trim_fraction,json=trimFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trim_fraction,omitempty" yaml:"trim_fraction"`
+}
+
+func (m *PairAggregationConfig) Reset()         { *m = PairAggregationConfig{} }
+func (m *PairAggregationConfig) String() string { return proto.CompactTextString(m) }
+func (*PairAggregationConfig) ProtoMessage()    {}
+func (*PairAggregationConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{6}
+}
+func (m *PairAggregationConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairAggregationConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairAggregationConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairAggregationConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairAggregationConfig.Merge(m, src)
+}
+func (m *PairAggregationConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairAggregationConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairAggregationConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairAggregationConfig proto.InternalMessageInfo
+
+func (m *PairAggregationConfig) GetMethod() AggregationMethod {
+	if m != nil {
+		return m.Method
+	}
+	return AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEDIAN
+}
+
+func (m *PairAggregationConfig) GetMinVoters() uint64 {
+	if m != nil {
+		return m.MinVoters
+	}
+	return 0
+}
+
+func (m *PairAggregationConfig) GetMaxAgeBlocks() uint64 {
+	if m != nil {
+		return m.MaxAgeBlocks
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("nibiru.oracle.v1.AggregationMethod", AggregationMethod_name, AggregationMethod_value)
 	proto.RegisterType((*Params)(nil), "nibiru.oracle.v1.Params")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "nibiru.oracle.v1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "nibiru.oracle.v1.ExchangeRateTuple")
 	proto.RegisterType((*ExchangeRateAtBlock)(nil), "nibiru.oracle.v1.ExchangeRateAtBlock")
 	proto.RegisterType((*Rewards)(nil), "nibiru.oracle.v1.Rewards")
+	proto.RegisterType((*PairAggregationConfig)(nil), "nibiru.oracle.v1.PairAggregationConfig")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/oracle.proto", fileDescriptor_43d45df86ea09ed4) }
 
 var fileDescriptor_43d45df86ea09ed4 = []byte{
-	// 1032 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x5e, 0x27, 0xdb, 0x34, 0x3b, 0x9b, 0x94, 0x64, 0x92, 0x82, 0x93, 0xb6, 0xeb, 0xe0, 0x4a,
-	0x28, 0x07, 0xb0, 0x95, 0x50, 0x84, 0x88, 0xc4, 0xa1, 0x6e, 0x28, 0x0a, 0x0d, 0xb0, 0xb2, 0x22,
-	0x90, 0xb8, 0x58, 0xb3, 0xf6, 0xc4, 0x1e, 0x62, 0x7b, 0x16, 0xcf, 0x6c, 0x36, 0x39, 0x21, 0x6e,
-	0x1c, 0xcb, 0x05, 0x71, 0xcc, 0x99, 0x1b, 0x12, 0x7f, 0x44, 0x8f, 0x15, 0x27, 0xd4, 0x83, 0x5b,
-	0x25, 0x97, 0x8a, 0x03, 0x87, 0xfd, 0x0b, 0xd0, 0xfc, 0xd8, 0xac, 0x93, 0xdd, 0xc3, 0xaa, 0x12,
-	0x37, 0xcf, 0xfb, 0x9e, 0xbf, 0xf7, 0xbd, 0x1f, 0x7e, 0x1e, 0x70, 0x2f, 0x27, 0x1d, 0x52, 0xf4,
-	0x5c, 0x5a, 0xa0, 0x30, 0xc5, 0xee, 0xf1, 0x96, 0x7e, 0x72, 0xba, 0x05, 0xe5, 0x14, 0x2e, 0x29,
-	0xd8, 0xd1, 0xc6, 0xe3, 0xad, 0xf5, 0xd5, 0x98, 0xc6, 0x54, 0x82, 0xae, 0x78, 0x52, 0x7e, 0xeb,
-	0xad, 0x98, 0xd2, 0x38, 0xc5, 0xae, 0x3c, 0x75, 0x7a, 0x87, 0x6e, 0xd4, 0x2b, 0x10, 0x27, 0x34,
-	0x1f, 0xe2, 0x21, 0x65, 0x19, 0x65, 0x6e, 0x07, 0x31, 0x11, 0xa4, 0x83, 0x39, 0xda, 0x72, 0x43,
-	0x4a, 0x86, 0xf8, 0x9a, 0xc2, 0x03, 0x45, 0xac, 0x0e, 0x0a, 0xb2, 0xcb, 0x79, 0x30, 0xd7, 0x46,
-	0x05, 0xca, 0x18, 0xfc, 0x18, 0x34, 0x8f, 0x29, 0xc7, 0x41, 0x17, 0x17, 0x84, 0x46, 0xa6, 0xb1,
-	0x61, 0x6c, 0xd6, 0xbd, 0xb7, 0x07, 0xa5, 0x05, 0x4f, 0x51, 0x96, 0xee, 0xd8, 0x15, 0xd0, 0xf6,
-	0x81, 0x38, 0xb5, 0xe5, 0x01, 0xfe, 0x00, 0x6e, 0x49, 0x8c, 0x27, 0x05, 0x66, 0x09, 0x4d, 0x23,
-	0x73, 0x66, 0xc3, 0xd8, 0x6c, 0x78, 0x5f, 0x3c, 0x2b, 0xad, 0xda, 0x8b, 0xd2, 0xba, 0xa3, 0x22,
-	0xb2, 0xe8, 0xc8, 0x21, 0xd4, 0xcd, 0x10, 0x4f, 0x9c, 0x7d, 0x1c, 0xa3, 0xf0, 0x74, 0x17, 0x87,
-	0x83, 0xd2, 0xba, 0x5d, 0xa1, 0xbf, 0xa4, 0xb0, 0xff, 0xfa, 0xf3, 0x03, 0xa0, 0x95, 0xee, 0xe2,
-	0xd0, 0x5f, 0x14, 0xf0, 0xc1, 0x10, 0x85, 0x09, 0x68, 0x16, 0xb8, 0x8f, 0x8a, 0x28, 0xe8, 0xa0,
-	0x3c, 0x32, 0x67, 0x65, 0xbc, 0xcf, 0xa7, 0x8b, 0xa7, 0xd3, 0xa9, 0xbc, 0x7f, 0x3d, 0x18, 0x50,
-	0x98, 0x87, 0xf2, 0x08, 0x7e, 0x0f, 0x1a, 0xfd, 0x84, 0x70, 0x9c, 0x12, 0xc6, 0xcd, 0xfa, 0xc6,
-	0xec, 0x66, 0xc3, 0xdb, 0x7f, 0x51, 0x5a, 0x0f, 0x62, 0xc2, 0x93, 0x5e, 0xc7, 0x09, 0x69, 0xe6,
-	0x7e, 0x25, 0xbb, 0xf8, 0x28, 0x41, 0x24, 0x77, 0x75, 0xc3, 0x8f, 0xb7, 0xdd, 0x13, 0x37, 0xa4,
-	0x59, 0x46, 0x73, 0x17, 0x31, 0x86, 0xb9, 0xd3, 0x46, 0xa4, 0x18, 0x94, 0xd6, 0x92, 0x0a, 0x7e,
-	0x49, 0x69, 0xfb, 0x23, 0x7a, 0x51, 0x48, 0x96, 0x22, 0x96, 0x04, 0x87, 0x05, 0x0a, 0x45, 0x7f,
-	0xcd, 0x1b, 0x6f, 0x50, 0xc8, 0xab, 0x14, 0x63, 0x85, 0x94, 0xf0, 0x63, 0x8d, 0xc2, 0x1d, 0xb0,
-	0xa0, 0xfc, 0xfb, 0x24, 0x8f, 0x68, 0xdf, 0x9c, 0x93, 0x5d, 0x7f, 0x67, 0x50, 0x5a, 0x2b, 0x55,
-	0x36, 0x85, 0xda, 0x7e, 0x53, 0x1e, 0xbf, 0x95, 0x27, 0xf8, 0x93, 0x01, 0x56, 0x33, 0x92, 0x07,
-	0xc7, 0x28, 0x25, 0x91, 0x98, 0x8c, 0x21, 0xc9, 0x4d, 0xa9, 0xba, 0x3d, 0x9d, 0xea, 0x3b, 0x2a,
-	0xce, 0x24, 0xa2, 0xeb, 0xda, 0x97, 0x33, 0x92, 0x7f, 0x23, 0x7c, 0xda, 0xb8, 0xd0, 0x1a, 0x7e,
-	0x35, 0xc0, 0x2a, 0xef, 0xa3, 0x6e, 0x90, 0x52, 0x7a, 0xd4, 0x41, 0xe1, 0xd1, 0x50, 0xc3, 0xfc,
-	0x86, 0xb1, 0xd9, 0xdc, 0x5e, 0x73, 0xd4, 0xa7, 0xe3, 0x0c, 0x3f, 0x1d, 0x67, 0x57, 0x7f, 0x3a,
-	0xde, 0x9e, 0x90, 0xf7, 0x4f, 0x69, 0xb5, 0x26, 0xbd, 0xfe, 0x3e, 0xcd, 0x08, 0xc7, 0x59, 0x97,
-	0x9f, 0x8e, 0x14, 0x4e, 0xf2, 0xb3, 0x7f, 0x7b, 0x69, 0x19, 0x3e, 0x14, 0xd0, 0xbe, 0x46, 0xb4,
-	0xb0, 0x07, 0x00, 0xc8, 0x94, 0x28, 0xc7, 0x05, 0x33, 0x1b, 0xb2, 0xac, 0xb7, 0x07, 0xa5, 0xb5,
-	0x5c, 0x49, 0x57, 0x62, 0xb6, 0xdf, 0x10, 0x69, 0xc9, 0x67, 0xf8, 0x23, 0x58, 0x91, 0x45, 0x40,
-	0x9c, 0x16, 0xc1, 0x21, 0xc6, 0x81, 0x14, 0x6b, 0x02, 0x59, 0xd0, 0xaf, 0xa7, 0x2b, 0xe8, 0xba,
-	0xfe, 0x9e, 0xc6, 0x79, 0xc6, 0xea, 0x79, 0xe9, 0xf3, 0x18, 0x63, 0x5f, 0x78, 0xc0, 0x3d, 0xb0,
-	0x8c, 0x4f, 0xba, 0x44, 0xd5, 0x28, 0xe8, 0xa4, 0x34, 0x3c, 0x62, 0x66, 0x53, 0xaa, 0xbf, 0x3b,
-	0x28, 0x2d, 0x53, 0x71, 0x8f, 0xb9, 0xd8, 0xfe, 0xd2, 0xc8, 0xe6, 0x49, 0xd3, 0x4e, 0xfd, 0xf5,
-	0x99, 0x65, 0xd8, 0x7f, 0x18, 0xe0, 0xee, 0xc3, 0x38, 0x2e, 0x70, 0x8c, 0x38, 0xfe, 0xec, 0x24,
-	0x4c, 0x50, 0x1e, 0x8b, 0x58, 0xb8, 0x5d, 0x60, 0x51, 0x00, 0x78, 0x1f, 0xd4, 0x13, 0xc4, 0x12,
-	0xb9, 0x6f, 0x1a, 0xde, 0x5b, 0x83, 0xd2, 0x6a, 0xaa, 0x20, 0xc2, 0x6a, 0xfb, 0x12, 0x84, 0xef,
-	0x81, 0x1b, 0xb2, 0x5a, 0x7a, 0xb3, 0x2c, 0x0d, 0x4a, 0x6b, 0x61, 0xb4, 0x36, 0x0a, 0xdb, 0x57,
-	0xb0, 0x1c, 0xe7, 0x5e, 0x27, 0x23, 0x5c, 0xe9, 0x92, 0x8b, 0xe1, 0xea, 0x38, 0x57, 0x50, 0x31,
-	0xce, 0xf2, 0x28, 0x05, 0xef, 0xcc, 0xff, 0x7c, 0x66, 0xd5, 0x5e, 0x9f, 0x59, 0x35, 0xfb, 0x95,
-	0x01, 0xd6, 0x26, 0x6a, 0x16, 0x5d, 0x82, 0x4f, 0x0d, 0xb0, 0x8a, 0xb5, 0x51, 0xd4, 0x15, 0x07,
-	0xbc, 0xd7, 0x4d, 0x31, 0x33, 0x8d, 0x8d, 0xd9, 0xcd, 0xe6, 0xf6, 0x7d, 0xe7, 0xfa, 0x56, 0x77,
-	0xaa, 0x14, 0x07, 0xc2, 0xd7, 0xfb, 0x44, 0xb4, 0x72, 0x34, 0x5a, 0x93, 0xe8, 0xec, 0xdf, 0x5f,
-	0x5a, 0x70, 0xec, 0x4d, 0xe6, 0x43, 0x3c, 0x66, 0x9b, 0xb6, 0x3c, 0x95, 0x14, 0xff, 0x35, 0xc0,
-	0xf2, 0x18, 0x39, 0x0c, 0x40, 0xbd, 0x8b, 0x48, 0xa1, 0x7b, 0xf1, 0x44, 0xcf, 0xdb, 0x9b, 0xee,
-	0x3a, 0xdd, 0x47, 0xc1, 0x68, 0xfb, 0x92, 0x18, 0xe6, 0x60, 0xf1, 0x4a, 0xae, 0x5a, 0xf0, 0xde,
-	0x74, 0x93, 0xbd, 0x3a, 0xa1, 0x5a, 0xd7, 0x67, 0x7a, 0xa1, 0x5a, 0x9e, 0x4a, 0xc2, 0xbf, 0xcc,
-	0x80, 0x95, 0x6a, 0xc2, 0x0f, 0x55, 0xd7, 0xc7, 0x15, 0x19, 0xff, 0xab, 0x22, 0xf8, 0x29, 0x58,
-	0x0c, 0x0b, 0x8c, 0x38, 0x8e, 0xf4, 0x88, 0xce, 0xc8, 0x11, 0x35, 0x47, 0x64, 0x57, 0x60, 0xdb,
-	0x5f, 0xd0, 0x67, 0x25, 0xf7, 0x09, 0x80, 0xd2, 0x1e, 0x70, 0x92, 0x61, 0xc6, 0x51, 0xd6, 0x0d,
-	0x32, 0x26, 0xc7, 0x7c, 0xd6, 0xbb, 0x37, 0x28, 0xad, 0x35, 0xc5, 0x31, 0xee, 0x63, 0xfb, 0x4b,
-	0xd2, 0x78, 0x30, 0xb4, 0x7d, 0xc9, 0x6c, 0x06, 0x6e, 0xfa, 0xf2, 0x4f, 0xc7, 0xe0, 0x2d, 0x30,
-	0x43, 0xf4, 0x3f, 0xdf, 0x9f, 0x21, 0x11, 0x7c, 0x17, 0x2c, 0x54, 0xfe, 0xf7, 0x4c, 0xa9, 0xf4,
-	0x9b, 0xa3, 0xbf, 0x3e, 0x83, 0x1f, 0x81, 0x1b, 0xe2, 0x8e, 0x21, 0xa2, 0xcf, 0xca, 0x55, 0xab,
-	0x73, 0x16, 0xb7, 0x10, 0x47, 0xdf, 0x42, 0x9c, 0x47, 0x94, 0xe4, 0x5e, 0x5d, 0x14, 0xd3, 0x57,
-	0xde, 0xde, 0xde, 0xb3, 0xf3, 0x96, 0xf1, 0xfc, 0xbc, 0x65, 0xbc, 0x3a, 0x6f, 0x19, 0x4f, 0x2f,
-	0x5a, 0xb5, 0xe7, 0x17, 0xad, 0xda, 0xdf, 0x17, 0xad, 0xda, 0x77, 0xee, 0x14, 0x73, 0xa6, 0x6f,
-	0x52, 0xfc, 0xb4, 0x8b, 0x59, 0x67, 0x4e, 0x6e, 0xf5, 0x0f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x82, 0x82, 0xec, 0xf5, 0x67, 0x09, 0x00, 0x00,
+	// 1303 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x3d, 0x6f, 0xdb, 0x46,
+	0x18, 0x16, 0x2d, 0xc5, 0x89, 0x4e, 0xb2, 0x2b, 0x5f, 0x9c, 0x96, 0xce, 0x87, 0xe8, 0x32, 0x45,
+	0x60, 0x14, 0x2d, 0x89, 0xa4, 0x29, 0x8a, 0x1a, 0x08, 0x0a, 0x29, 0x72, 0x1c, 0x35, 0xb6, 0x63,
+	0x10, 0x42, 0x03, 0x74, 0x21, 0x4e, 0xd4, 0x99, 0xbc, 0x9a, 0xe4, 0xa9, 0x24, 0x2d, 0xdb, 0x4b,
+	0x8b, 0x76, 0xea, 0x98, 0x2e, 0x45, 0xc7, 0x2c, 0x5d, 0xba, 0x15, 0xe8, 0x8f, 0xc8, 0x18, 0x74,
+	0x2a, 0x32, 0x30, 0x41, 0xb2, 0x04, 0x1d, 0x3a, 0xe8, 0x17, 0x14, 0xf7, 0x21, 0x8b, 0x32, 0x85,
+	0x40, 0x08, 0x9a, 0x4d, 0xf7, 0x3e, 0x2f, 0x9f, 0x7b, 0xee, 0xfd, 0xb8, 0x7b, 0x05, 0xae, 0x84,
+	0xa4, 0x4b, 0xa2, 0x03, 0x93, 0x46, 0xc8, 0xf1, 0xb1, 0x39, 0xb8, 0x2e, 0x7f, 0x19, 0xfd, 0x88,
+	0x26, 0x14, 0xd6, 0x04, 0x6c, 0x48, 0xe3, 0xe0, 0xfa, 0xc5, 0x65, 0x97, 0xba, 0x94, 0x83, 0x26,
+	0xfb, 0x25, 0xfc, 0x2e, 0xd6, 0x5d, 0x4a, 0x5d, 0x1f, 0x9b, 0x7c, 0xd5, 0x3d, 0xd8, 0x33, 0x7b,
+	0x07, 0x11, 0x4a, 0x08, 0x0d, 0x47, 0xb8, 0x43, 0xe3, 0x80, 0xc6, 0x66, 0x17, 0xc5, 0x6c, 0x93,
+	0x2e, 0x4e, 0xd0, 0x75, 0xd3, 0xa1, 0x64, 0x84, 0xaf, 0x08, 0xdc, 0x16, 0xc4, 0x62, 0x21, 0x20,
+	0x3d, 0x2d, 0x83, 0xf9, 0x5d, 0x14, 0xa1, 0x20, 0x86, 0x9f, 0x81, 0xca, 0x80, 0x26, 0xd8, 0xee,
+	0xe3, 0x88, 0xd0, 0x9e, 0xaa, 0xac, 0x2a, 0x6b, 0xa5, 0xe6, 0xbb, 0xc3, 0x54, 0x83, 0xc7, 0x28,
+	0xf0, 0xd7, 0xf5, 0x0c, 0xa8, 0x5b, 0x80, 0xad, 0x76, 0xf9, 0x02, 0x7e, 0x0b, 0x16, 0x39, 0x96,
+	0x78, 0x11, 0x8e, 0x3d, 0xea, 0xf7, 0xd4, 0xb9, 0x55, 0x65, 0xad, 0xdc, 0xfc, 0xf2, 0x71, 0xaa,
+	0x15, 0x9e, 0xa6, 0xda, 0x25, 0xb1, 0x63, 0xdc, 0xdb, 0x37, 0x08, 0x35, 0x03, 0x94, 0x78, 0xc6,
+	0x16, 0x76, 0x91, 0x73, 0xdc, 0xc2, 0xce, 0x30, 0xd5, 0x2e, 0x64, 0xe8, 0x4f, 0x28, 0xf4, 0xbf,
+	0xfe, 0xfc, 0x18, 0x48, 0xa5, 0x2d, 0xec, 0x58, 0x0b, 0x0c, 0xee, 0x8c, 0x50, 0xe8, 0x81, 0x4a,
+	0x84, 0x0f, 0x51, 0xd4, 0xb3, 0xbb, 0x28, 0xec, 0xa9, 0x45, 0xbe, 0xdf, 0xe6, 0x6c, 0xfb, 0xc9,
+	0xe3, 0x64, 0xbe, 0x3f, 0xbd, 0x19, 0x10, 0x58, 0x13, 0x85, 0x3d, 0xf8, 0x0d, 0x28, 0x1f, 0x7a,
+	0x24, 0xc1, 0x3e, 0x89, 0x13, 0xb5, 0xb4, 0x5a, 0x5c, 0x2b, 0x37, 0xb7, 0x9e, 0xa6, 0xda, 0x4d,
+	0x97, 0x24, 0xde, 0x41, 0xd7, 0x70, 0x68, 0x60, 0xee, 0xf0, 0x2c, 0xde, 0xf6, 0x10, 0x09, 0x4d,
+	0x99, 0xf0, 0xc1, 0x0d, 0xf3, 0xc8, 0x74, 0x68, 0x10, 0xd0, 0xd0, 0x44, 0x71, 0x8c, 0x13, 0x63,
+	0x17, 0x91, 0x68, 0x98, 0x6a, 0x35, 0xb1, 0xf9, 0x09, 0xa5, 0x6e, 0x8d, 0xe9, 0x59, 0x20, 0x63,
+	0x1f, 0xc5, 0x9e, 0xbd, 0x17, 0x21, 0x87, 0xe5, 0x57, 0x3d, 0xf3, 0x06, 0x81, 0x9c, 0xa4, 0xc8,
+	0x05, 0x92, 0xc3, 0x77, 0x24, 0x0a, 0xd7, 0x41, 0x55, 0xf8, 0x1f, 0x92, 0xb0, 0x47, 0x0f, 0xd5,
+	0x79, 0x9e, 0xf5, 0xf7, 0x86, 0xa9, 0x76, 0x3e, 0xcb, 0x26, 0x50, 0xdd, 0xaa, 0xf0, 0xe5, 0x03,
+	0xbe, 0x82, 0x3f, 0x28, 0x60, 0x39, 0x20, 0xa1, 0x3d, 0x40, 0x3e, 0xe9, 0xb1, 0xca, 0x18, 0x91,
+	0x9c, 0xe5, 0xaa, 0x77, 0x67, 0x53, 0x7d, 0x49, 0xec, 0x33, 0x8d, 0xe8, 0xb4, 0xf6, 0xa5, 0x80,
+	0x84, 0x5f, 0x31, 0x9f, 0x5d, 0x1c, 0x49, 0x0d, 0xbf, 0x28, 0x60, 0x39, 0x39, 0x44, 0x7d, 0xdb,
+	0xa7, 0x74, 0xbf, 0x8b, 0x9c, 0xfd, 0x91, 0x86, 0x73, 0xab, 0xca, 0x5a, 0xe5, 0xc6, 0x8a, 0x21,
+	0x5a, 0xc7, 0x18, 0xb5, 0x8e, 0xd1, 0x92, 0xad, 0xd3, 0x6c, 0x33, 0x79, 0xff, 0xa4, 0x5a, 0x7d,
+	0xda, 0xe7, 0x1f, 0xd1, 0x80, 0x24, 0x38, 0xe8, 0x27, 0xc7, 0x63, 0x85, 0xd3, 0xfc, 0xf4, 0x5f,
+	0x9f, 0x69, 0x8a, 0x05, 0x19, 0xb4, 0x25, 0x11, 0x29, 0xec, 0x26, 0x00, 0xfc, 0x48, 0x34, 0xc1,
+	0x51, 0xac, 0x96, 0x79, 0x58, 0x2f, 0x0c, 0x53, 0x6d, 0x29, 0x73, 0x5c, 0x8e, 0xe9, 0x56, 0x99,
+	0x1d, 0x8b, 0xff, 0x86, 0xdf, 0x83, 0xf3, 0x3c, 0x08, 0x28, 0xa1, 0x91, 0xbd, 0x87, 0xb1, 0xcd,
+	0xc5, 0xaa, 0x80, 0x07, 0xf4, 0xfe, 0x6c, 0x01, 0xbd, 0x28, 0xfb, 0x29, 0xcf, 0x93, 0x8b, 0xe7,
+	0x89, 0xcf, 0x1d, 0x8c, 0x2d, 0xe6, 0x01, 0xdb, 0x60, 0x09, 0x1f, 0xf5, 0x89, 0x88, 0x91, 0xdd,
+	0xf5, 0xa9, 0xb3, 0x1f, 0xab, 0x15, 0xae, 0xfe, 0xf2, 0x30, 0xd5, 0x54, 0xc1, 0x9d, 0x73, 0xd1,
+	0xad, 0xda, 0xd8, 0xd6, 0xe4, 0x26, 0xf8, 0x1d, 0x58, 0xc4, 0x01, 0xb2, 0x3d, 0xe4, 0xef, 0xd9,
+	0x3e, 0x19, 0xe0, 0x58, 0xad, 0xae, 0x16, 0x5f, 0x9f, 0x93, 0x5b, 0x32, 0x27, 0xea, 0xe4, 0x87,
+	0x13, 0xd9, 0x90, 0x55, 0x3e, 0xe9, 0x21, 0xf2, 0x50, 0xc5, 0x01, 0xba, 0x8b, 0xfc, 0xbd, 0x2d,
+	0x66, 0x5a, 0x2f, 0xbd, 0x7a, 0xa4, 0x29, 0xfa, 0x1f, 0x0a, 0xb8, 0xdc, 0x70, 0xdd, 0x08, 0xbb,
+	0x28, 0xc1, 0x1b, 0x47, 0x8e, 0x87, 0x42, 0x97, 0x9d, 0x15, 0xef, 0x46, 0x98, 0x25, 0x00, 0x5e,
+	0x05, 0x25, 0x0f, 0xc5, 0x1e, 0xbf, 0xef, 0xca, 0xcd, 0x77, 0x86, 0xa9, 0x56, 0x11, 0x3b, 0x30,
+	0xab, 0x6e, 0x71, 0x10, 0x5e, 0x03, 0x67, 0x78, 0xb6, 0xe4, 0xcd, 0x56, 0x1b, 0xa6, 0x5a, 0x75,
+	0x7c, 0x6d, 0x45, 0xba, 0x25, 0x60, 0xde, 0x4e, 0x07, 0xdd, 0x80, 0x24, 0x22, 0x2e, 0x6a, 0x31,
+	0xd7, 0x4e, 0x19, 0x94, 0xb5, 0x13, 0x5f, 0xf2, 0x80, 0xad, 0x9f, 0xfb, 0xe9, 0x91, 0x56, 0x78,
+	0xf5, 0x48, 0x2b, 0xe8, 0xcf, 0x15, 0xb0, 0x32, 0x55, 0x33, 0xab, 0x12, 0xf8, 0x50, 0x01, 0xcb,
+	0x58, 0x1a, 0x59, 0x5e, 0xb1, 0x9d, 0x1c, 0xf4, 0x7d, 0x1c, 0xab, 0x0a, 0x0f, 0xef, 0x55, 0xe3,
+	0xf4, 0xab, 0x62, 0x64, 0x29, 0x3a, 0xcc, 0xb7, 0xf9, 0x39, 0x0b, 0xf4, 0xb8, 0xb4, 0xa7, 0xd1,
+	0xe9, 0xbf, 0x3f, 0xd3, 0x60, 0xee, 0xcb, 0xd8, 0x82, 0x38, 0x67, 0x9b, 0x35, 0x3c, 0x99, 0x23,
+	0xfe, 0xab, 0x80, 0xa5, 0x1c, 0x39, 0xb4, 0x41, 0xa9, 0x8f, 0x48, 0x24, 0x73, 0x71, 0x4f, 0xd6,
+	0xfb, 0x9b, 0xde, 0xb5, 0x32, 0x8f, 0x8c, 0x51, 0xb7, 0x38, 0x31, 0x0c, 0xc1, 0xc2, 0xc4, 0x59,
+	0xa5, 0xe0, 0xf6, 0x6c, 0x9d, 0xb5, 0x3c, 0x25, 0x5a, 0xa7, 0x7b, 0xaa, 0x9a, 0x0d, 0x4f, 0xe6,
+	0xc0, 0x3f, 0xcf, 0x81, 0xf3, 0xd9, 0x03, 0x37, 0x44, 0xd6, 0xf3, 0x8a, 0x94, 0xb7, 0xaa, 0x08,
+	0xde, 0x02, 0x0b, 0x4e, 0x84, 0x51, 0x82, 0x7b, 0xb2, 0x44, 0xe7, 0x78, 0x89, 0xaa, 0x63, 0xb2,
+	0x09, 0x58, 0xb7, 0xaa, 0x72, 0x2d, 0xe4, 0xde, 0x03, 0x90, 0xdb, 0xed, 0x84, 0x04, 0x38, 0x4e,
+	0x50, 0xd0, 0xb7, 0x83, 0x98, 0x97, 0x79, 0xb1, 0x79, 0x65, 0x98, 0x6a, 0x2b, 0x82, 0x23, 0xef,
+	0xa3, 0x5b, 0x35, 0x6e, 0xec, 0x8c, 0x6c, 0xdb, 0xb1, 0x1e, 0x83, 0xb3, 0x16, 0x7f, 0x69, 0x63,
+	0xb8, 0x08, 0xe6, 0x88, 0x9c, 0x39, 0xac, 0x39, 0xd2, 0x83, 0xef, 0x83, 0x6a, 0x66, 0xde, 0x88,
+	0x85, 0x4a, 0xab, 0x32, 0x9e, 0x3a, 0x62, 0xf8, 0x29, 0x38, 0xc3, 0x66, 0x1c, 0xb6, 0xbb, 0xb8,
+	0x56, 0xe4, 0x99, 0xd9, 0x14, 0x64, 0xc8, 0x29, 0xc8, 0xb8, 0x4d, 0x49, 0xd8, 0x2c, 0xb1, 0x60,
+	0x5a, 0xc2, 0x5b, 0xff, 0x71, 0x1e, 0x5c, 0x60, 0x05, 0x32, 0x6a, 0x30, 0x42, 0xc3, 0xdb, 0x34,
+	0xdc, 0x23, 0xee, 0xdb, 0xaf, 0xbe, 0x1d, 0x30, 0x1f, 0xe0, 0xc4, 0xa3, 0x62, 0x40, 0x5a, 0x9c,
+	0xd6, 0xaa, 0x19, 0x55, 0xdb, 0xdc, 0xb5, 0xb9, 0x34, 0x4c, 0xb5, 0x05, 0xf9, 0x68, 0x70, 0x8b,
+	0x6e, 0x49, 0x96, 0x53, 0x6f, 0x4c, 0x71, 0xc6, 0x37, 0xe6, 0xd4, 0xec, 0x54, 0x3a, 0x99, 0x9d,
+	0x94, 0xff, 0x7b, 0x76, 0xca, 0x0f, 0x86, 0xe3, 0x79, 0x46, 0x79, 0x3b, 0x83, 0x61, 0x08, 0x16,
+	0x02, 0x74, 0x64, 0xf7, 0xf0, 0x80, 0xf0, 0x20, 0xaa, 0xf3, 0x27, 0xed, 0xa4, 0xcc, 0xdc, 0x4e,
+	0x13, 0x0c, 0xb9, 0x76, 0x0a, 0xd0, 0x51, 0x6b, 0x04, 0xc2, 0x2f, 0xc0, 0x22, 0xf3, 0x46, 0x2e,
+	0x1e, 0x3d, 0x96, 0x67, 0x79, 0x1a, 0x56, 0xc6, 0xfa, 0x27, 0x71, 0x9d, 0x13, 0x34, 0x5c, 0x2c,
+	0x5f, 0xc9, 0x10, 0x2c, 0x24, 0x11, 0x09, 0xc6, 0x23, 0xdf, 0xb9, 0x37, 0x10, 0x3c, 0xc1, 0x90,
+	0x13, 0xcc, 0xd0, 0xd1, 0xc0, 0x27, 0x5e, 0xc5, 0x0f, 0x7f, 0x53, 0xc0, 0x52, 0xae, 0xd4, 0xe0,
+	0x35, 0xa0, 0x37, 0x36, 0x37, 0xad, 0x8d, 0xcd, 0x46, 0xa7, 0x7d, 0x7f, 0xc7, 0xde, 0xde, 0xe8,
+	0xdc, 0xbd, 0xdf, 0xb2, 0x1f, 0x6c, 0xb4, 0x37, 0xef, 0x76, 0x36, 0x5a, 0xf6, 0xf6, 0x46, 0xab,
+	0xdd, 0xd8, 0xa9, 0x15, 0xe0, 0x07, 0x60, 0xf5, 0xf5, 0x7e, 0x8d, 0x9d, 0x9a, 0x02, 0xaf, 0x80,
+	0x95, 0x29, 0x5e, 0x92, 0x64, 0x0e, 0x5e, 0x05, 0xda, 0x14, 0xb8, 0x63, 0xb5, 0xb7, 0xb7, 0x47,
+	0x1c, 0xc5, 0x66, 0xfb, 0xf1, 0x8b, 0xba, 0xf2, 0xe4, 0x45, 0x5d, 0x79, 0xfe, 0xa2, 0xae, 0x3c,
+	0x7c, 0x59, 0x2f, 0x3c, 0x79, 0x59, 0x2f, 0xfc, 0xfd, 0xb2, 0x5e, 0xf8, 0xda, 0x9c, 0xa1, 0x2d,
+	0xe5, 0xdf, 0xae, 0xe4, 0xb8, 0x8f, 0xe3, 0xee, 0x3c, 0x1f, 0x37, 0x3e, 0xf9, 0x6f, 0x00, 0x32,
+	0xf8, 0xa8, 0x0b, 0x94, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -520,6 +671,75 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ExpirationBlocks != that1.ExpirationBlocks {
 		return false
 	}
+	if len(this.EmaHalfLives) != len(that1.EmaHalfLives) {
+		return false
+	}
+	for i := range this.EmaHalfLives {
+		if this.EmaHalfLives[i] != that1.EmaHalfLives[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *PairAggregationConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairAggregationConfig)
+	if !ok {
+		that2, ok := that.(PairAggregationConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if this.MinVoters != that1.MinVoters {
+		return false
+	}
+	if that1.RewardBand == nil {
+		if this.RewardBand != nil {
+			return false
+		}
+	} else if !this.RewardBand.Equal(*that1.RewardBand) {
+		return false
+	}
+	if that1.VoteThreshold == nil {
+		if this.VoteThreshold != nil {
+			return false
+		}
+	} else if !this.VoteThreshold.Equal(*that1.VoteThreshold) {
+		return false
+	}
+	if that1.MaxDeviation == nil {
+		if this.MaxDeviation != nil {
+			return false
+		}
+	} else if !this.MaxDeviation.Equal(*that1.MaxDeviation) {
+		return false
+	}
+	if this.MaxAgeBlocks != that1.MaxAgeBlocks {
+		return false
+	}
+	if that1.TrimFraction == nil {
+		if this.TrimFraction != nil {
+			return false
+		}
+	} else if !this.TrimFraction.Equal(*that1.TrimFraction) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -542,6 +762,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EmaHalfLives) > 0 {
+		for iNdEx := len(m.EmaHalfLives) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EmaHalfLives[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmaHalfLives[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintOracle(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if m.ExpirationBlocks != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.ExpirationBlocks))
 		i--
@@ -856,6 +1088,102 @@ func (m *Rewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PairAggregationConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairAggregationConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairAggregationConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrimFraction != nil {
+		{
+			size := m.TrimFraction.Size()
+			i -= size
+			if _, err := m.TrimFraction.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxAgeBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxAgeBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxDeviation != nil {
+		{
+			size := m.MaxDeviation.Size()
+			i -= size
+			if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.VoteThreshold != nil {
+		{
+			size := m.VoteThreshold.Size()
+			i -= size
+			if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Method != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOracle(v)
 	base := offset
@@ -903,6 +1231,12 @@ func (m *Params) Size() (n int) {
 	if m.ExpirationBlocks != 0 {
 		n += 1 + sovOracle(uint64(m.ExpirationBlocks))
 	}
+	if len(m.EmaHalfLives) > 0 {
+		for _, e := range m.EmaHalfLives {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -996,6 +1330,42 @@ func (m *Rewards) Size() (n int) {
 	return n
 }
 
+func (m *PairAggregationConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Method != 0 {
+		n += 1 + sovOracle(uint64(m.Method))
+	}
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.VoteThreshold != nil {
+		l = m.VoteThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MaxDeviation != nil {
+		l = m.MaxDeviation.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MaxAgeBlocks != 0 {
+		n += 1 + sovOracle(uint64(m.MaxAgeBlocks))
+	}
+	if m.TrimFraction != nil {
+		l = m.TrimFraction.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
 func sovOracle(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1346,6 +1716,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaHalfLives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmaHalfLives = append(m.EmaHalfLives, time.Duration(0))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.EmaHalfLives[len(m.EmaHalfLives)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1978,6 +2382,291 @@ func (m *Rewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PairAggregationConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairAggregationConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairAggregationConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			m.Method = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Method |= AggregationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.RewardBand = &v
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.VoteThreshold = &v
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxDeviation = &v
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeBlocks", wireType)
+			}
+			m.MaxAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.TrimFraction = &v
+			if err := m.TrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOracle(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -54,6 +54,7 @@ var (
 	DefaultMinValidPerWindow  = sdkmath.LegacyNewDecWithPrec(69, 2) // 69%
 	DefaultTwapLookbackWindow = time.Duration(15 * time.Minute)     // 15 minutes
 	DefaultValidatorFeeRatio  = sdkmath.LegacyNewDecWithPrec(5, 2)  // 0.05%
+	DefaultEmaHalfLives       = []time.Duration{15 * time.Minute, time.Hour}
 )
 
 // DefaultParams creates default oracle module parameters
@@ -70,6 +71,7 @@ func DefaultParams() Params {
 		MinValidPerWindow:  DefaultMinValidPerWindow,
 		TwapLookbackWindow: DefaultTwapLookbackWindow,
 		ValidatorFeeRatio:  DefaultValidatorFeeRatio,
+		EmaHalfLives:       DefaultEmaHalfLives,
 	}
 }
 
@@ -112,5 +114,28 @@ func (p Params) Validate() error {
 			return fmt.Errorf("oracle parameter Whitelist Pair invalid format: %w", err)
 		}
 	}
+
+	seenHalfLives := make(map[time.Duration]bool)
+	for _, halfLife := range p.EmaHalfLives {
+		if halfLife < time.Millisecond {
+			return fmt.Errorf("oracle parameter EmaHalfLives must be at least 1ms, got %s", halfLife)
+		}
+		if seenHalfLives[halfLife] {
+			return fmt.Errorf("oracle parameter EmaHalfLives has duplicate half-life %s", halfLife)
+		}
+		seenHalfLives[halfLife] = true
+	}
 	return nil
 }
+
+// PriceSnapshotRetention returns how long price snapshots are kept: the longest
+// of the TWAP lookback window and the EMA half-lives.
+func (p Params) PriceSnapshotRetention() time.Duration {
+	retention := p.TwapLookbackWindow
+	for _, halfLife := range p.EmaHalfLives {
+		if halfLife > retention {
+			retention = halfLife
+		}
+	}
+	return retention
+}
@@ -84,6 +84,12 @@ type QueryExchangeRateResponse struct {
 	BlockTimestampMs int64 `protobuf:"varint,2,opt,name=block_timestamp_ms,json=blockTimestampMs,proto3" json:"block_timestamp_ms,omitempty"`
 	// Block height when the oracle came to consensus for this price.
 	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Number of blocks since the oracle came to consensus for this price.
+	AgeBlocks uint64 `protobuf:"varint,4,opt,name=age_blocks,json=ageBlocks,proto3" json:"age_blocks,omitempty"`
+	// Whether the price is older than the max age of the pair.
+	Stale bool `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
+	// Whether the pair is halted by its circuit breaker.
+	Halted bool `protobuf:"varint,6,opt,name=halted,proto3" json:"halted,omitempty"`
 }
 
 func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResponse{} }
@@ -133,6 +139,112 @@ func (m *QueryExchangeRateResponse) GetBlockHeight() uint64 {
 	return 0
 }
 
+func (m *QueryExchangeRateResponse) GetAgeBlocks() uint64 {
+	if m != nil {
+		return m.AgeBlocks
+	}
+	return 0
+}
+
+func (m *QueryExchangeRateResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+func (m *QueryExchangeRateResponse) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+// QueryExchangeRateEmaRequest is the request type for the Query/ExchangeRateEma
+// RPC method.
+type QueryExchangeRateEmaRequest struct {
+	Pair github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"pair"`
+}
+
+func (m *QueryExchangeRateEmaRequest) Reset()         { *m = QueryExchangeRateEmaRequest{} }
+func (m *QueryExchangeRateEmaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateEmaRequest) ProtoMessage()    {}
+func (*QueryExchangeRateEmaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{2}
+}
+func (m *QueryExchangeRateEmaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateEmaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateEmaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateEmaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateEmaRequest.Merge(m, src)
+}
+func (m *QueryExchangeRateEmaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateEmaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateEmaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateEmaRequest proto.InternalMessageInfo
+
+// QueryExchangeRateEmaResponse is the response type for the
+// Query/ExchangeRateEma RPC method.
+type QueryExchangeRateEmaResponse struct {
+	ExchangeRateEma ExchangeRateEma `protobuf:"bytes,1,opt,name=exchange_rate_ema,json=exchangeRateEma,proto3" json:"exchange_rate_ema"`
+}
+
+func (m *QueryExchangeRateEmaResponse) Reset()         { *m = QueryExchangeRateEmaResponse{} }
+func (m *QueryExchangeRateEmaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateEmaResponse) ProtoMessage()    {}
+func (*QueryExchangeRateEmaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{3}
+}
+func (m *QueryExchangeRateEmaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateEmaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateEmaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateEmaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateEmaResponse.Merge(m, src)
+}
+func (m *QueryExchangeRateEmaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateEmaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateEmaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateEmaResponse proto.InternalMessageInfo
+
+func (m *QueryExchangeRateEmaResponse) GetExchangeRateEma() ExchangeRateEma {
+	if m != nil {
+		return m.ExchangeRateEma
+	}
+	return ExchangeRateEma{}
+}
+
 // QueryExchangeRatesRequest is the request type for the Query/ExchangeRates RPC
 // method.
 type QueryExchangeRatesRequest struct {
@@ -142,7 +254,7 @@ func (m *QueryExchangeRatesRequest) Reset()         { *m = QueryExchangeRatesReq
 func (m *QueryExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryExchangeRatesRequest) ProtoMessage()    {}
 func (*QueryExchangeRatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{2}
+	return fileDescriptor_16aef2382d1249a8, []int{4}
 }
 func (m *QueryExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +295,7 @@ func (m *QueryExchangeRatesResponse) Reset()         { *m = QueryExchangeRatesRe
 func (m *QueryExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryExchangeRatesResponse) ProtoMessage()    {}
 func (*QueryExchangeRatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{3}
+	return fileDescriptor_16aef2382d1249a8, []int{5}
 }
 func (m *QueryExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,7 +339,7 @@ func (m *QueryActivesRequest) Reset()         { *m = QueryActivesRequest{} }
 func (m *QueryActivesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesRequest) ProtoMessage()    {}
 func (*QueryActivesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{4}
+	return fileDescriptor_16aef2382d1249a8, []int{6}
 }
 func (m *QueryActivesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,7 +379,7 @@ func (m *QueryActivesResponse) Reset()         { *m = QueryActivesResponse{} }
 func (m *QueryActivesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesResponse) ProtoMessage()    {}
 func (*QueryActivesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{5}
+	return fileDescriptor_16aef2382d1249a8, []int{7}
 }
 func (m *QueryActivesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +417,7 @@ func (m *QueryVoteTargetsRequest) Reset()         { *m = QueryVoteTargetsRequest
 func (m *QueryVoteTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsRequest) ProtoMessage()    {}
 func (*QueryVoteTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{6}
+	return fileDescriptor_16aef2382d1249a8, []int{8}
 }
 func (m *QueryVoteTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +458,7 @@ func (m *QueryVoteTargetsResponse) Reset()         { *m = QueryVoteTargetsRespon
 func (m *QueryVoteTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsResponse) ProtoMessage()    {}
 func (*QueryVoteTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{7}
+	return fileDescriptor_16aef2382d1249a8, []int{9}
 }
 func (m *QueryVoteTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +498,7 @@ func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegat
 func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationRequest) ProtoMessage()    {}
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{8}
+	return fileDescriptor_16aef2382d1249a8, []int{10}
 }
 func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,7 +538,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{9}
+	return fileDescriptor_16aef2382d1249a8, []int{11}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +585,7 @@ func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest
 func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterRequest) ProtoMessage()    {}
 func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{10}
+	return fileDescriptor_16aef2382d1249a8, []int{12}
 }
 func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -513,7 +625,7 @@ func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterRespon
 func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterResponse) ProtoMessage()    {}
 func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{11}
+	return fileDescriptor_16aef2382d1249a8, []int{13}
 }
 func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -560,7 +672,7 @@ func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrev
 func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{12}
+	return fileDescriptor_16aef2382d1249a8, []int{14}
 }
 func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -601,7 +713,7 @@ func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{13}
+	return fileDescriptor_16aef2382d1249a8, []int{15}
 }
 func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -646,7 +758,7 @@ func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{14}
+	return fileDescriptor_16aef2382d1249a8, []int{16}
 }
 func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +799,7 @@ func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePr
 func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{15}
+	return fileDescriptor_16aef2382d1249a8, []int{17}
 }
 func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,7 +846,7 @@ func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteReq
 func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteRequest) ProtoMessage()    {}
 func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{16}
+	return fileDescriptor_16aef2382d1249a8, []int{18}
 }
 func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +887,7 @@ func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteRe
 func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteResponse) ProtoMessage()    {}
 func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{17}
+	return fileDescriptor_16aef2382d1249a8, []int{19}
 }
 func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -820,7 +932,7 @@ func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesR
 func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesRequest) ProtoMessage()    {}
 func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{18}
+	return fileDescriptor_16aef2382d1249a8, []int{20}
 }
 func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -861,7 +973,7 @@ func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotes
 func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesResponse) ProtoMessage()    {}
 func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{19}
+	return fileDescriptor_16aef2382d1249a8, []int{21}
 }
 func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,7 +1017,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{20}
+	return fileDescriptor_16aef2382d1249a8, []int{22}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,7 +1056,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16aef2382d1249a8, []int{21}
+	return fileDescriptor_16aef2382d1249a8, []int{23}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -980,9 +1092,160 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryPairStatusesRequest is the request type for the Query/PairStatuses RPC
+// method.
+type QueryPairStatusesRequest struct {
+}
+
+func (m *QueryPairStatusesRequest) Reset()         { *m = QueryPairStatusesRequest{} }
+func (m *QueryPairStatusesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPairStatusesRequest) ProtoMessage()    {}
+func (*QueryPairStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{24}
+}
+func (m *QueryPairStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPairStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPairStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPairStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPairStatusesRequest.Merge(m, src)
+}
+func (m *QueryPairStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPairStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPairStatusesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPairStatusesRequest proto.InternalMessageInfo
+
+// QueryPairStatusesResponse is the response type for the Query/PairStatuses
+// RPC method.
+type QueryPairStatusesResponse struct {
+	Statuses []PairStatusResult `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses"`
+}
+
+func (m *QueryPairStatusesResponse) Reset()         { *m = QueryPairStatusesResponse{} }
+func (m *QueryPairStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPairStatusesResponse) ProtoMessage()    {}
+func (*QueryPairStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{25}
+}
+func (m *QueryPairStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPairStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPairStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPairStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPairStatusesResponse.Merge(m, src)
+}
+func (m *QueryPairStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPairStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPairStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPairStatusesResponse proto.InternalMessageInfo
+
+func (m *QueryPairStatusesResponse) GetStatuses() []PairStatusResult {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// PairStatusResult is the circuit breaker status of a pair along with the
+// staleness of its current exchange rate.
+type PairStatusResult struct {
+	Status PairStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
+	// Number of blocks since the current exchange rate was posted.
+	AgeBlocks uint64 `protobuf:"varint,2,opt,name=age_blocks,json=ageBlocks,proto3" json:"age_blocks,omitempty"`
+	// Whether the pair has no exchange rate or it is older than the max age of
+	// the pair.
+	Stale bool `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (m *PairStatusResult) Reset()         { *m = PairStatusResult{} }
+func (m *PairStatusResult) String() string { return proto.CompactTextString(m) }
+func (*PairStatusResult) ProtoMessage()    {}
+func (*PairStatusResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{26}
+}
+func (m *PairStatusResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairStatusResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairStatusResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairStatusResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairStatusResult.Merge(m, src)
+}
+func (m *PairStatusResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairStatusResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairStatusResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairStatusResult proto.InternalMessageInfo
+
+func (m *PairStatusResult) GetStatus() PairStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PairStatus{}
+}
+
+func (m *PairStatusResult) GetAgeBlocks() uint64 {
+	if m != nil {
+		return m.AgeBlocks
+	}
+	return 0
+}
+
+func (m *PairStatusResult) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryExchangeRateRequest)(nil), "nibiru.oracle.v1.QueryExchangeRateRequest")
 	proto.RegisterType((*QueryExchangeRateResponse)(nil), "nibiru.oracle.v1.QueryExchangeRateResponse")
+	proto.RegisterType((*QueryExchangeRateEmaRequest)(nil), "nibiru.oracle.v1.QueryExchangeRateEmaRequest")
+	proto.RegisterType((*QueryExchangeRateEmaResponse)(nil), "nibiru.oracle.v1.QueryExchangeRateEmaResponse")
 	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "nibiru.oracle.v1.QueryExchangeRatesRequest")
 	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "nibiru.oracle.v1.QueryExchangeRatesResponse")
 	proto.RegisterType((*QueryActivesRequest)(nil), "nibiru.oracle.v1.QueryActivesRequest")
@@ -1003,86 +1266,102 @@ func init() {
 	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "nibiru.oracle.v1.QueryAggregateVotesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.oracle.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.oracle.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPairStatusesRequest)(nil), "nibiru.oracle.v1.QueryPairStatusesRequest")
+	proto.RegisterType((*QueryPairStatusesResponse)(nil), "nibiru.oracle.v1.QueryPairStatusesResponse")
+	proto.RegisterType((*PairStatusResult)(nil), "nibiru.oracle.v1.PairStatusResult")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/query.proto", fileDescriptor_16aef2382d1249a8) }
 
 var fileDescriptor_16aef2382d1249a8 = []byte{
-	// 1183 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x6d, 0x48, 0x61, 0x1c, 0xa7, 0xce, 0x10, 0x84, 0xe3, 0x24, 0x76, 0xba, 0x34,
-	0x55, 0xda, 0x24, 0xbb, 0x38, 0xa9, 0x8a, 0x02, 0x45, 0x90, 0x1f, 0x54, 0x14, 0x25, 0x10, 0xac,
-	0x28, 0x42, 0xbd, 0x58, 0xe3, 0xf5, 0x74, 0xbd, 0xaa, 0x77, 0xc7, 0xdd, 0x19, 0x9b, 0x46, 0x85,
-	0x4b, 0x05, 0x88, 0x23, 0x12, 0x42, 0xdc, 0xa0, 0x17, 0x24, 0xc4, 0xb9, 0x70, 0x07, 0x71, 0xe8,
-	0xb1, 0x82, 0x0b, 0xe2, 0x50, 0x50, 0xc2, 0x81, 0x3f, 0x03, 0xed, 0xcc, 0x78, 0xb3, 0xeb, 0xf5,
-	0x2a, 0x8b, 0x0b, 0xb7, 0x64, 0xde, 0xdb, 0xf7, 0x3e, 0xef, 0xbb, 0x33, 0xf3, 0x5d, 0xc3, 0x19,
-	0xd7, 0xae, 0xdb, 0x5e, 0xc7, 0xa0, 0x1e, 0x36, 0x5b, 0xc4, 0xe8, 0x56, 0x8c, 0xdb, 0x1d, 0xe2,
-	0x1d, 0xe8, 0x6d, 0x8f, 0x72, 0x8a, 0xf2, 0x32, 0xaa, 0xcb, 0xa8, 0xde, 0xad, 0x14, 0x27, 0x2d,
-	0x6a, 0x51, 0x11, 0x34, 0xfc, 0xbf, 0x64, 0x5e, 0x71, 0xc6, 0xa2, 0xd4, 0x6a, 0x11, 0x03, 0xb7,
-	0x6d, 0x03, 0xbb, 0x2e, 0xe5, 0x98, 0xdb, 0xd4, 0x65, 0x2a, 0x3a, 0x1b, 0xeb, 0xa1, 0xea, 0xc9,
-	0x70, 0xc9, 0xa4, 0xcc, 0xa1, 0xcc, 0xa8, 0x63, 0xe6, 0x07, 0xeb, 0x84, 0xe3, 0x8a, 0x61, 0x52,
-	0xdb, 0x55, 0xf1, 0x29, 0x19, 0xaf, 0xc9, 0xae, 0xf2, 0x1f, 0x19, 0xd2, 0xba, 0xb0, 0xf0, 0xae,
-	0x8f, 0xfb, 0xc6, 0x1d, 0xb3, 0x89, 0x5d, 0x8b, 0x54, 0x31, 0x27, 0x55, 0x72, 0xbb, 0x43, 0x18,
-	0x47, 0xbb, 0x70, 0xa4, 0x8d, 0x6d, 0xaf, 0x00, 0xe6, 0xc0, 0xc2, 0x33, 0x1b, 0x57, 0x1f, 0x3e,
-	0x2e, 0x67, 0x7e, 0x7f, 0x5c, 0xbe, 0x6c, 0xd9, 0xbc, 0xd9, 0xa9, 0xeb, 0x26, 0x75, 0x8c, 0xb7,
-	0x05, 0xd6, 0x66, 0x13, 0xdb, 0xae, 0xa1, 0x10, 0xbb, 0x2b, 0xc6, 0x1d, 0xc3, 0xa4, 0x8e, 0x43,
-	0x5d, 0x03, 0x33, 0x46, 0xb8, 0xbe, 0x8b, 0x6d, 0xaf, 0x2a, 0x2a, 0xbd, 0xfc, 0xf4, 0xa7, 0xf7,
-	0xcb, 0x99, 0xbf, 0xef, 0x97, 0x33, 0xda, 0xcf, 0x00, 0x4e, 0x0d, 0x68, 0xcc, 0xda, 0xd4, 0x65,
-	0x04, 0xed, 0xc3, 0x1c, 0x51, 0xeb, 0x35, 0x0f, 0x73, 0xa2, 0x10, 0x2a, 0x0a, 0x61, 0x5a, 0x8e,
-	0xc0, 0x1a, 0xb7, 0x74, 0x9b, 0x1a, 0x0e, 0xe6, 0x4d, 0x7d, 0x9b, 0x58, 0xd8, 0x3c, 0xd8, 0x22,
-	0xe6, 0x2f, 0x0f, 0x96, 0xa1, 0x9a, 0x70, 0x8b, 0x98, 0xd5, 0x31, 0x12, 0xaa, 0x8f, 0x96, 0x20,
-	0xaa, 0xb7, 0xa8, 0x79, 0xab, 0xc6, 0x6d, 0x87, 0x30, 0x8e, 0x9d, 0x76, 0xcd, 0x61, 0x85, 0x53,
-	0x73, 0x60, 0xe1, 0x74, 0x35, 0x2f, 0x22, 0x7b, 0xbd, 0xc0, 0x0e, 0x43, 0xe7, 0xe0, 0x98, 0xcc,
-	0x6e, 0x12, 0xdb, 0x6a, 0xf2, 0xc2, 0xe9, 0x39, 0xb0, 0x30, 0x52, 0xcd, 0x8a, 0xb5, 0x37, 0xc5,
-	0x92, 0x36, 0x3d, 0x60, 0x0a, 0xa6, 0xf4, 0xd3, 0x3e, 0x02, 0xb0, 0x38, 0x28, 0xaa, 0x86, 0xbc,
-	0x09, 0xc7, 0x23, 0x43, 0xb2, 0x02, 0x98, 0x3b, 0xbd, 0x90, 0x5d, 0x79, 0x41, 0xef, 0xdf, 0x33,
-	0x7a, 0xb8, 0xc0, 0x5e, 0xa7, 0xdd, 0x22, 0x1b, 0x45, 0x5f, 0x8a, 0xef, 0xfe, 0x28, 0xa3, 0x58,
-	0x88, 0x55, 0x73, 0xe1, 0x99, 0x99, 0xf6, 0x1c, 0x7c, 0x56, 0x50, 0xac, 0x9b, 0xdc, 0xee, 0x1e,
-	0xd3, 0xb9, 0x70, 0x32, 0xba, 0x1c, 0x68, 0x7f, 0x06, 0xcb, 0x25, 0xc1, 0xf3, 0xa4, 0x2f, 0xbe,
-	0x57, 0x4c, 0x9b, 0x82, 0xcf, 0x8b, 0x7e, 0xfb, 0x94, 0x93, 0x3d, 0xec, 0x59, 0x84, 0x07, 0x28,
-	0x77, 0xd5, 0x26, 0x8c, 0x84, 0x14, 0x4e, 0x0d, 0x8e, 0x75, 0x29, 0x27, 0x35, 0x2e, 0xd7, 0xff,
-	0x13, 0xa6, 0x6c, 0xf7, 0xb8, 0x91, 0xf6, 0x0e, 0x9c, 0x11, 0xcd, 0xaf, 0x11, 0xd2, 0x20, 0xde,
-	0x16, 0x69, 0x11, 0x4b, 0x9c, 0xbd, 0xde, 0x29, 0x98, 0x87, 0xe3, 0x5d, 0xdc, 0xb2, 0x1b, 0x98,
-	0x53, 0xaf, 0x86, 0x1b, 0x0d, 0x75, 0x1e, 0xaa, 0xb9, 0x60, 0x75, 0xbd, 0xd1, 0x08, 0x6f, 0xed,
-	0xd7, 0xe1, 0x6c, 0x42, 0x41, 0x35, 0x52, 0x19, 0x66, 0x6f, 0x8a, 0x58, 0xb8, 0x1c, 0x94, 0x4b,
-	0x7e, 0x2d, 0xed, 0x2d, 0x25, 0xd5, 0x8e, 0xcd, 0xd8, 0x26, 0xed, 0xb8, 0x9c, 0x78, 0x43, 0xd3,
-	0xbc, 0xaa, 0xb4, 0x8d, 0xd4, 0x52, 0x20, 0xe7, 0xe0, 0x98, 0x63, 0x33, 0x56, 0x33, 0xe5, 0xba,
-	0x28, 0x35, 0x52, 0xcd, 0x3a, 0xc7, 0xa9, 0x81, 0x3a, 0xeb, 0x96, 0xe5, 0xf9, 0x73, 0x90, 0x5d,
-	0x8f, 0xf8, 0xea, 0x0d, 0xcd, 0x73, 0x0f, 0x28, 0x79, 0xe2, 0x15, 0x15, 0x15, 0x86, 0x13, 0xb8,
-	0x17, 0xab, 0xb5, 0x65, 0x50, 0x54, 0xcd, 0xae, 0xe8, 0xf1, 0xa3, 0x11, 0x94, 0x09, 0x1f, 0x04,
-	0x55, 0x72, 0x63, 0xc4, 0xdf, 0x26, 0xd5, 0x3c, 0xee, 0x6b, 0xa5, 0x95, 0x13, 0x18, 0x82, 0x1d,
-	0xf9, 0x31, 0x80, 0xa5, 0xa4, 0x0c, 0x85, 0x69, 0x42, 0x14, 0xc3, 0xec, 0x1d, 0xe1, 0xe1, 0x38,
-	0x27, 0xfa, 0x39, 0x99, 0xb6, 0xad, 0xee, 0x97, 0xe0, 0xe9, 0xfd, 0x27, 0xd1, 0xbe, 0xab, 0xee,
-	0xa3, 0xbe, 0x6a, 0x6a, 0xa0, 0xf7, 0xe0, 0xf8, 0xf1, 0x40, 0x21, 0xd1, 0x17, 0x53, 0x0e, 0xb3,
-	0x7f, 0x3c, 0x49, 0x0e, 0x87, 0x3b, 0x68, 0x33, 0x83, 0xfa, 0x06, 0x5a, 0x1f, 0xc0, 0xe9, 0x81,
-	0x51, 0x85, 0x75, 0x03, 0x9e, 0x8d, 0x62, 0xf5, 0x44, 0x1e, 0x82, 0x6b, 0x3c, 0xc2, 0xc5, 0xb4,
-	0x49, 0x88, 0x44, 0xeb, 0x5d, 0xec, 0x61, 0x27, 0x00, 0xda, 0x51, 0x17, 0x66, 0x6f, 0x55, 0x81,
-	0x5c, 0x81, 0xa3, 0x6d, 0xb1, 0xa2, 0x74, 0x29, 0xc4, 0xfb, 0xcb, 0x27, 0x54, 0x33, 0x95, 0xbd,
-	0xf2, 0xd3, 0x59, 0xf8, 0x94, 0xa8, 0x87, 0xbe, 0x00, 0x70, 0x2c, 0x4c, 0x86, 0x2e, 0xc5, 0x4b,
-	0x24, 0xb9, 0x71, 0x71, 0x31, 0x55, 0xae, 0x64, 0xd5, 0x96, 0xee, 0xfd, 0xfa, 0xd7, 0xe7, 0xa7,
-	0x2e, 0xa0, 0xf3, 0x46, 0xff, 0x97, 0x83, 0xfc, 0x38, 0x88, 0x18, 0x0f, 0xfa, 0x0a, 0xc0, 0x7c,
-	0xc4, 0x47, 0xde, 0xc7, 0xed, 0xff, 0x8f, 0xad, 0x22, 0xd8, 0x16, 0xd1, 0xc5, 0x34, 0x6c, 0x35,
-	0xee, 0xb3, 0x7c, 0x0d, 0x60, 0x2e, 0x62, 0xa2, 0x28, 0x4d, 0xc7, 0xde, 0x0b, 0x2d, 0x2e, 0xa5,
-	0x4b, 0x56, 0x7c, 0xab, 0x82, 0x6f, 0x19, 0x2d, 0x26, 0xf0, 0xf9, 0x5f, 0x32, 0x2c, 0x4a, 0xc9,
-	0xd0, 0x27, 0x00, 0x9e, 0x51, 0x4e, 0x8a, 0xe6, 0x13, 0xda, 0x45, 0x0d, 0xb8, 0x78, 0xe1, 0xa4,
-	0xb4, 0x94, 0xef, 0x52, 0xf2, 0x28, 0x9b, 0x45, 0x5f, 0x02, 0x98, 0x0d, 0xf9, 0x28, 0xba, 0x98,
-	0xd0, 0x25, 0x6e, 0xc3, 0xc5, 0x4b, 0x69, 0x52, 0x53, 0xbe, 0x44, 0x09, 0x15, 0x76, 0x6e, 0xf4,
-	0x03, 0x80, 0xf9, 0x7e, 0x4f, 0x44, 0x7a, 0x42, 0xcf, 0x04, 0x37, 0x2e, 0x1a, 0xa9, 0xf3, 0x15,
-	0xe8, 0xba, 0x00, 0x7d, 0x05, 0xad, 0x25, 0x80, 0x06, 0x77, 0x25, 0x33, 0xee, 0x46, 0x6f, 0xd3,
-	0x0f, 0x0d, 0x69, 0xc9, 0xe8, 0x1b, 0x00, 0xb3, 0x21, 0xfb, 0x4c, 0x94, 0x34, 0x6e, 0xd7, 0x89,
-	0x92, 0x0e, 0x70, 0x63, 0xed, 0x35, 0x41, 0xba, 0x86, 0x5e, 0x1a, 0x82, 0xd4, 0xb7, 0x6c, 0xf4,
-	0x23, 0x80, 0xf9, 0x7e, 0xbf, 0x4a, 0x14, 0x38, 0xc1, 0xd0, 0x13, 0x05, 0x4e, 0xb2, 0x6b, 0x6d,
-	0x5b, 0x60, 0x5f, 0x43, 0x5b, 0x43, 0x60, 0xc7, 0x0c, 0x14, 0x3d, 0x00, 0x70, 0x22, 0xe6, 0xb9,
-	0x28, 0x2d, 0x54, 0xb0, 0x95, 0x5f, 0x4c, 0xff, 0x80, 0x1a, 0xe3, 0xaa, 0x18, 0xe3, 0x0a, 0xba,
-	0x7c, 0xf2, 0x18, 0x71, 0xdb, 0x47, 0xdf, 0x03, 0x98, 0x8b, 0xf8, 0x57, 0xe2, 0x05, 0x35, 0xc8,
-	0xc9, 0x13, 0x2f, 0xa8, 0x81, 0x46, 0xad, 0x5d, 0x17, 0xa8, 0x9b, 0x68, 0x3d, 0x19, 0xb5, 0x61,
-	0x9f, 0xa8, 0xb8, 0x90, 0xfb, 0x5b, 0x00, 0xc7, 0xa3, 0xbe, 0x8b, 0x52, 0xb1, 0x04, 0x42, 0x2f,
-	0xa7, 0xcc, 0x56, 0xe8, 0x6b, 0x02, 0x7d, 0x15, 0x55, 0xfe, 0x8d, 0xca, 0x52, 0xe2, 0x0f, 0xe0,
-	0xa8, 0xb4, 0x57, 0x74, 0x3e, 0xa1, 0x67, 0xc4, 0xc5, 0x8b, 0xf3, 0x27, 0x64, 0x29, 0xa2, 0x79,
-	0x41, 0x54, 0x46, 0xb3, 0x89, 0x17, 0x99, 0xb0, 0xf4, 0xeb, 0x0f, 0x0f, 0x4b, 0xe0, 0xd1, 0x61,
-	0x09, 0xfc, 0x79, 0x58, 0x02, 0x9f, 0x1d, 0x95, 0x32, 0x8f, 0x8e, 0x4a, 0x99, 0xdf, 0x8e, 0x4a,
-	0x99, 0x1b, 0x46, 0x8a, 0x9f, 0x20, 0xaa, 0x26, 0x3f, 0x68, 0x13, 0x56, 0x1f, 0x15, 0xbf, 0xbc,
-	0x57, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x32, 0x7e, 0xad, 0xd9, 0x39, 0x10, 0x00, 0x00,
+	// 1389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xdf, 0x6f, 0x14, 0x55,
+	0x14, 0xc7, 0x7b, 0xdb, 0x52, 0xe0, 0x6c, 0x5b, 0xb6, 0x97, 0xaa, 0xdb, 0x69, 0xbb, 0x5b, 0x46,
+	0x4a, 0x4a, 0x7f, 0xec, 0xd0, 0x42, 0x30, 0x20, 0x46, 0x5b, 0x0a, 0x11, 0x03, 0x5a, 0x17, 0xd2,
+	0x18, 0x5e, 0x36, 0xb7, 0xb3, 0x97, 0xd9, 0x09, 0x3b, 0x3b, 0xcb, 0xdc, 0xbb, 0x2b, 0x0d, 0xfa,
+	0x82, 0x3f, 0xe2, 0xa3, 0xc6, 0x18, 0xdf, 0x94, 0x98, 0x98, 0x18, 0x9f, 0xd1, 0x77, 0xdf, 0x78,
+	0x24, 0xfa, 0x62, 0x7c, 0x40, 0x43, 0x7d, 0xf0, 0xbf, 0xd0, 0xcc, 0xbd, 0x77, 0x67, 0x67, 0x76,
+	0x76, 0xe8, 0x50, 0xe4, 0x8d, 0x3d, 0xe7, 0xdc, 0x73, 0x3e, 0xe7, 0xdc, 0x1f, 0xf3, 0xa5, 0x30,
+	0x55, 0xb7, 0xb7, 0x6c, 0xaf, 0x69, 0xb8, 0x1e, 0x31, 0x6b, 0xd4, 0x68, 0x2d, 0x1b, 0xb7, 0x9a,
+	0xd4, 0xdb, 0x2e, 0x36, 0x3c, 0x97, 0xbb, 0x38, 0x2b, 0xbd, 0x45, 0xe9, 0x2d, 0xb6, 0x96, 0xb5,
+	0x71, 0xcb, 0xb5, 0x5c, 0xe1, 0x34, 0xfc, 0x7f, 0xc9, 0x38, 0x6d, 0xca, 0x72, 0x5d, 0xab, 0x46,
+	0x0d, 0xd2, 0xb0, 0x0d, 0x52, 0xaf, 0xbb, 0x9c, 0x70, 0xdb, 0xad, 0x33, 0xe5, 0x9d, 0x8e, 0xd5,
+	0x50, 0xf9, 0xd4, 0xe2, 0x98, 0x9b, 0x71, 0xc2, 0xdb, 0xde, 0xbc, 0xe9, 0x32, 0xc7, 0x65, 0xc6,
+	0x16, 0x61, 0xbe, 0x6f, 0x8b, 0x72, 0xb2, 0x6c, 0x98, 0xae, 0x5d, 0x57, 0xfe, 0x09, 0xe9, 0x2f,
+	0x4b, 0x26, 0xf9, 0x43, 0xba, 0xf4, 0x16, 0xe4, 0xde, 0xf5, 0x9b, 0xb9, 0x70, 0xdb, 0xac, 0x92,
+	0xba, 0x45, 0x4b, 0x84, 0xd3, 0x12, 0xbd, 0xd5, 0xa4, 0x8c, 0xe3, 0x0d, 0x18, 0x6c, 0x10, 0xdb,
+	0xcb, 0xa1, 0x19, 0x34, 0x77, 0x70, 0xed, 0xdc, 0x83, 0x47, 0x85, 0xbe, 0x3f, 0x1e, 0x15, 0x4e,
+	0x59, 0x36, 0xaf, 0x36, 0xb7, 0x8a, 0xa6, 0xeb, 0x18, 0x6f, 0x0b, 0xaa, 0xf3, 0x55, 0x62, 0xd7,
+	0x0d, 0x45, 0xd8, 0x5a, 0x31, 0x6e, 0x1b, 0xa6, 0xeb, 0x38, 0x6e, 0xdd, 0x20, 0x8c, 0x51, 0x5e,
+	0xdc, 0x20, 0xb6, 0x57, 0x12, 0x99, 0xce, 0x1e, 0xf8, 0xec, 0x5e, 0xa1, 0xef, 0x9f, 0x7b, 0x85,
+	0x3e, 0xfd, 0x8b, 0x7e, 0x98, 0xe8, 0x51, 0x98, 0x35, 0xdc, 0x3a, 0xa3, 0x78, 0x13, 0x46, 0xa8,
+	0xb2, 0x97, 0x3d, 0xc2, 0xa9, 0x42, 0x58, 0x56, 0x08, 0x93, 0xb2, 0x05, 0x56, 0xb9, 0x59, 0xb4,
+	0x5d, 0xc3, 0x21, 0xbc, 0x5a, 0xbc, 0x4c, 0x2d, 0x62, 0x6e, 0xaf, 0x53, 0xf3, 0xd7, 0xfb, 0x4b,
+	0xa0, 0x3a, 0x5c, 0xa7, 0x66, 0x69, 0x98, 0x86, 0xf2, 0xe3, 0x45, 0xc0, 0x5b, 0x35, 0xd7, 0xbc,
+	0x59, 0xe6, 0xb6, 0x43, 0x19, 0x27, 0x4e, 0xa3, 0xec, 0xb0, 0x5c, 0xff, 0x0c, 0x9a, 0x1b, 0x28,
+	0x65, 0x85, 0xe7, 0x5a, 0xdb, 0x71, 0x85, 0xe1, 0x23, 0x30, 0x2c, 0xa3, 0xab, 0xd4, 0xb6, 0xaa,
+	0x3c, 0x37, 0x30, 0x83, 0xe6, 0x06, 0x4b, 0x19, 0x61, 0x7b, 0x53, 0x98, 0xf0, 0x34, 0x00, 0xb1,
+	0x68, 0x59, 0x98, 0x58, 0x6e, 0x50, 0x04, 0x1c, 0x24, 0x16, 0x5d, 0x13, 0x06, 0x3c, 0x0e, 0xfb,
+	0x18, 0x27, 0x35, 0x9a, 0xdb, 0x37, 0x83, 0xe6, 0x0e, 0x94, 0xe4, 0x0f, 0xfc, 0x22, 0x0c, 0x55,
+	0x49, 0x8d, 0xd3, 0x4a, 0x6e, 0x48, 0x98, 0xd5, 0x2f, 0xdd, 0x85, 0xc9, 0xd8, 0x48, 0x2e, 0x38,
+	0xe4, 0xb9, 0x6d, 0x87, 0xce, 0x60, 0xaa, 0x77, 0x41, 0xb5, 0x0d, 0x57, 0x61, 0x2c, 0xb2, 0x0d,
+	0x65, 0xea, 0x10, 0x51, 0x3e, 0xb3, 0x72, 0xa4, 0xd8, 0x7d, 0xec, 0x8b, 0x5d, 0x59, 0xd6, 0x06,
+	0x7d, 0xc2, 0xd2, 0x21, 0x1a, 0x35, 0xeb, 0x93, 0x3d, 0x36, 0x9e, 0xa9, 0x1e, 0xf5, 0x8f, 0x11,
+	0x68, 0xbd, 0xbc, 0x0a, 0xe8, 0x06, 0x8c, 0x46, 0x80, 0x58, 0x0e, 0xcd, 0x0c, 0xcc, 0x65, 0x56,
+	0x5e, 0x7e, 0x32, 0xcd, 0xb5, 0x66, 0xa3, 0x46, 0xd7, 0x34, 0x9f, 0xe7, 0xc7, 0x3f, 0x0b, 0x38,
+	0xe6, 0x62, 0xa5, 0x91, 0x30, 0x25, 0xd3, 0x5f, 0x80, 0xc3, 0x82, 0x62, 0xd5, 0xe4, 0x76, 0xab,
+	0x43, 0x57, 0x87, 0xf1, 0xa8, 0x39, 0x38, 0xae, 0xfb, 0x89, 0x34, 0x09, 0x9e, 0x67, 0xdd, 0x9c,
+	0x76, 0x32, 0x7d, 0x02, 0x5e, 0x12, 0xf5, 0x36, 0x5d, 0x4e, 0xaf, 0x11, 0xcf, 0xa2, 0x3c, 0x40,
+	0xb9, 0x03, 0xb9, 0xb8, 0x4b, 0xe1, 0x94, 0x61, 0xb8, 0xe5, 0x72, 0x5a, 0xe6, 0xd2, 0xfe, 0xbf,
+	0x30, 0x65, 0x5a, 0x9d, 0x42, 0xfa, 0x3b, 0xea, 0xdc, 0x5c, 0xa4, 0xb4, 0x42, 0xbd, 0x75, 0x5a,
+	0xa3, 0x96, 0x78, 0xcc, 0xda, 0x27, 0x75, 0x16, 0x46, 0x5b, 0xa4, 0x66, 0x57, 0x08, 0x77, 0xbd,
+	0x32, 0xa9, 0x54, 0xd4, 0x99, 0x2d, 0x8d, 0x04, 0xd6, 0xd5, 0x4a, 0x25, 0xfc, 0x1a, 0xbc, 0x01,
+	0xd3, 0x09, 0x09, 0x55, 0x4b, 0x05, 0xc8, 0xdc, 0x10, 0xbe, 0x70, 0x3a, 0x90, 0x26, 0x3f, 0x97,
+	0xfe, 0x96, 0x1a, 0xd5, 0x15, 0x9b, 0xb1, 0xf3, 0x6e, 0xb3, 0xce, 0xa9, 0xb7, 0x67, 0x9a, 0xd7,
+	0x20, 0x17, 0xcf, 0xa5, 0x40, 0x8e, 0xc0, 0xb0, 0x63, 0x33, 0x56, 0x36, 0xa5, 0x5d, 0xa4, 0x1a,
+	0x2c, 0x65, 0x9c, 0x4e, 0x68, 0x30, 0x9d, 0x55, 0xcb, 0xf2, 0xfc, 0x3e, 0xe8, 0x86, 0x47, 0xfd,
+	0xe9, 0xed, 0x99, 0xe7, 0x2e, 0x82, 0xe9, 0x84, 0x8c, 0x8a, 0x8a, 0xc0, 0x18, 0x69, 0xfb, 0xca,
+	0x0d, 0xe9, 0x54, 0x17, 0xb5, 0x18, 0xbf, 0x1a, 0x41, 0x9a, 0xf0, 0x45, 0x50, 0x29, 0xd5, 0xad,
+	0xcd, 0x92, 0xae, 0x52, 0x7a, 0x21, 0x81, 0x21, 0x38, 0x91, 0x9f, 0x20, 0xc8, 0x27, 0x45, 0x28,
+	0x4c, 0x13, 0x70, 0x0c, 0xb3, 0x7d, 0x85, 0xf7, 0xc6, 0x39, 0xd6, 0xcd, 0xc9, 0xf4, 0xcb, 0xea,
+	0x7d, 0x09, 0x56, 0x6f, 0x3e, 0xcb, 0xec, 0x5b, 0xa0, 0xf5, 0xca, 0xa6, 0x1a, 0x7a, 0x0f, 0x46,
+	0x3b, 0x0d, 0x85, 0x86, 0xbe, 0x90, 0xb2, 0x99, 0xcd, 0x4e, 0x27, 0x23, 0x24, 0x5c, 0x41, 0x9f,
+	0xea, 0x55, 0x37, 0x98, 0xf5, 0x36, 0x4c, 0xf6, 0xf4, 0x2a, 0xac, 0xeb, 0x70, 0x28, 0x8a, 0xd5,
+	0x1e, 0xf2, 0x1e, 0xb8, 0x46, 0x23, 0x5c, 0x4c, 0x1f, 0x07, 0x2c, 0x4a, 0x6f, 0x10, 0x8f, 0x38,
+	0x01, 0xd0, 0x15, 0x38, 0x1c, 0xb1, 0x2a, 0x90, 0xd3, 0x30, 0xd4, 0x10, 0x16, 0x35, 0x97, 0x5c,
+	0xbc, 0xbe, 0x5c, 0xa1, 0x8a, 0xa9, 0x68, 0x5d, 0x53, 0x37, 0xd0, 0x7f, 0x7a, 0xae, 0x72, 0xc2,
+	0x9b, 0xac, 0xd3, 0x3b, 0x81, 0x89, 0x1e, 0x3e, 0x55, 0x70, 0x1d, 0x0e, 0x30, 0x65, 0x53, 0x2d,
+	0xeb, 0xbd, 0x4a, 0xb6, 0x57, 0x96, 0x28, 0x6b, 0xd6, 0xb8, 0x2a, 0x1e, 0xac, 0xd4, 0x3f, 0x42,
+	0x90, 0xed, 0x0e, 0xc2, 0x67, 0x61, 0x48, 0x06, 0xa8, 0x5e, 0xa6, 0x9e, 0x94, 0xb8, 0xdd, 0x8f,
+	0x5c, 0xd1, 0x25, 0x13, 0xfa, 0x13, 0x65, 0xc2, 0x40, 0x48, 0x26, 0xac, 0xfc, 0x3b, 0x06, 0xfb,
+	0x44, 0xa7, 0xf8, 0x2b, 0x04, 0xc3, 0xe1, 0xed, 0xc1, 0xf3, 0xf1, 0xda, 0x49, 0x2a, 0x4e, 0x5b,
+	0x48, 0x15, 0x2b, 0xe7, 0xa7, 0x2f, 0xde, 0xfd, 0xed, 0xef, 0x2f, 0xfb, 0x8f, 0xe1, 0xa3, 0x46,
+	0xb7, 0xe0, 0x94, 0xa2, 0x32, 0xf2, 0xf5, 0xc5, 0xdf, 0x20, 0xc8, 0x46, 0x3e, 0xa6, 0xef, 0x93,
+	0xc6, 0xf3, 0x63, 0x5b, 0x16, 0x6c, 0x0b, 0xf8, 0x78, 0x1a, 0xb6, 0x32, 0xf7, 0x59, 0xbe, 0x43,
+	0x70, 0xa8, 0x4b, 0x96, 0xe0, 0xa5, 0x14, 0x35, 0x3b, 0xaa, 0x4b, 0x2b, 0xa6, 0x0d, 0x57, 0x94,
+	0x27, 0x04, 0xe5, 0x3c, 0x9e, 0x4b, 0x45, 0x49, 0x1d, 0x82, 0xbf, 0x45, 0x30, 0x12, 0xce, 0xc6,
+	0x70, 0x9a, 0xb1, 0xb4, 0xef, 0x83, 0xb6, 0x98, 0x2e, 0x58, 0xe1, 0x9d, 0x14, 0x78, 0x4b, 0x78,
+	0x21, 0x01, 0xcf, 0xd7, 0x85, 0x2c, 0x0a, 0xc9, 0xf0, 0xa7, 0x08, 0xf6, 0x2b, 0xcd, 0x83, 0x67,
+	0x13, 0xca, 0x45, 0xa5, 0x92, 0x76, 0x6c, 0xb7, 0xb0, 0x94, 0x07, 0x4e, 0xf2, 0x28, 0x41, 0x84,
+	0xbf, 0x46, 0x90, 0x09, 0x29, 0x1e, 0x7c, 0x3c, 0xa1, 0x4a, 0x5c, 0x30, 0x69, 0xf3, 0x69, 0x42,
+	0x53, 0x9e, 0x34, 0x09, 0x15, 0xd6, 0x58, 0xf8, 0x67, 0x04, 0xd9, 0x6e, 0xf5, 0x82, 0x93, 0xce,
+	0x4e, 0x82, 0x6e, 0xd2, 0x8c, 0xd4, 0xf1, 0x0a, 0x74, 0x55, 0x80, 0xbe, 0x8a, 0xcf, 0x24, 0x80,
+	0x06, 0x5f, 0x35, 0x66, 0xdc, 0x89, 0x7e, 0xf7, 0x3e, 0x34, 0xa4, 0x78, 0xc2, 0xdf, 0x23, 0xc8,
+	0x84, 0x84, 0x4e, 0xe2, 0x48, 0xe3, 0xc2, 0x4a, 0x9b, 0x4f, 0x13, 0xaa, 0x48, 0x5f, 0x17, 0xa4,
+	0x67, 0xf0, 0x2b, 0x7b, 0x20, 0xf5, 0xc5, 0x15, 0xfe, 0x05, 0x41, 0xb6, 0x5b, 0x59, 0x24, 0x0e,
+	0x38, 0x41, 0x7a, 0x69, 0x46, 0xea, 0x78, 0x85, 0x7d, 0x59, 0x60, 0x5f, 0xc4, 0xeb, 0x7b, 0xc0,
+	0x8e, 0x49, 0x1d, 0x7c, 0x1f, 0xc1, 0x58, 0x77, 0x29, 0x86, 0xd3, 0x42, 0x05, 0x47, 0xf9, 0x44,
+	0xfa, 0x05, 0xaa, 0x8d, 0x73, 0xa2, 0x8d, 0xd3, 0xf8, 0xd4, 0xee, 0x6d, 0xc4, 0x05, 0x1a, 0xfe,
+	0x09, 0xc1, 0x48, 0x44, 0x69, 0x24, 0x3e, 0x50, 0xbd, 0x34, 0x97, 0xb6, 0x98, 0x2e, 0x58, 0xa1,
+	0x5e, 0x12, 0xa8, 0xe7, 0xf1, 0x6a, 0x32, 0x6a, 0xc5, 0xde, 0x75, 0xe2, 0x62, 0xdc, 0x3f, 0x20,
+	0x18, 0x8d, 0x14, 0x61, 0x38, 0x15, 0x4b, 0x30, 0xe8, 0xa5, 0x94, 0xd1, 0x0a, 0xfd, 0x8c, 0x40,
+	0x3f, 0x89, 0x97, 0x9f, 0x66, 0xca, 0x72, 0xc4, 0x1f, 0xc0, 0x90, 0x14, 0x42, 0xf8, 0x68, 0x42,
+	0xcd, 0x88, 0xde, 0xd2, 0x66, 0x77, 0x89, 0x52, 0x44, 0xb3, 0x82, 0xa8, 0x80, 0xa7, 0x13, 0x1f,
+	0x32, 0x51, 0xd3, 0xd7, 0x17, 0x61, 0x39, 0x95, 0xf8, 0x0d, 0xef, 0xa1, 0xc7, 0xb4, 0x85, 0x54,
+	0xb1, 0x4f, 0xf1, 0xdc, 0x97, 0xdb, 0x3a, 0x6c, 0xed, 0xd2, 0x83, 0xc7, 0x79, 0xf4, 0xf0, 0x71,
+	0x1e, 0xfd, 0xf5, 0x38, 0x8f, 0x3e, 0xdf, 0xc9, 0xf7, 0x3d, 0xdc, 0xc9, 0xf7, 0xfd, 0xbe, 0x93,
+	0xef, 0xbb, 0x6e, 0xa4, 0xf8, 0x4f, 0xac, 0x4a, 0xcd, 0xb7, 0x1b, 0x94, 0x6d, 0x0d, 0x89, 0x3f,
+	0x77, 0x9d, 0xfc, 0x6f, 0x00, 0x96, 0xaa, 0xba, 0x3f, 0xcc, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1102,6 +1381,9 @@ type QueryClient interface {
 	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
 	// ExchangeRateTwap returns twap exchange rate of a pair
 	ExchangeRateTwap(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// ExchangeRateEma returns the exponentially weighted moving averages of the
+	// exchange rate of a pair, one for each half-life in the params.
+	ExchangeRateEma(ctx context.Context, in *QueryExchangeRateEmaRequest, opts ...grpc.CallOption) (*QueryExchangeRateEmaResponse, error)
 	// ExchangeRates returns exchange rates of all pairs
 	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
 	// Actives returns all active pairs
@@ -1122,6 +1404,9 @@ type QueryClient interface {
 	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PairStatuses returns the circuit breaker status, last good price, and
+	// staleness of every whitelisted pair.
+	PairStatuses(ctx context.Context, in *QueryPairStatusesRequest, opts ...grpc.CallOption) (*QueryPairStatusesResponse, error)
 }
 
 type queryClient struct {
@@ -1150,6 +1435,15 @@ func (c *queryClient) ExchangeRateTwap(ctx context.Context, in *QueryExchangeRat
 	return out, nil
 }
 
+func (c *queryClient) ExchangeRateEma(ctx context.Context, in *QueryExchangeRateEmaRequest, opts ...grpc.CallOption) (*QueryExchangeRateEmaResponse, error) {
+	out := new(QueryExchangeRateEmaResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ExchangeRateEma", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
 	out := new(QueryExchangeRatesResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ExchangeRates", in, out, opts...)
@@ -1240,6 +1534,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PairStatuses(ctx context.Context, in *QueryPairStatusesRequest, opts ...grpc.CallOption) (*QueryPairStatusesResponse, error) {
+	out := new(QueryPairStatusesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/PairStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ExchangeRate returns exchange rate of a pair along with the block height and
@@ -1247,6 +1550,9 @@ type QueryServer interface {
 	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
 	// ExchangeRateTwap returns twap exchange rate of a pair
 	ExchangeRateTwap(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// ExchangeRateEma returns the exponentially weighted moving averages of the
+	// exchange rate of a pair, one for each half-life in the params.
+	ExchangeRateEma(context.Context, *QueryExchangeRateEmaRequest) (*QueryExchangeRateEmaResponse, error)
 	// ExchangeRates returns exchange rates of all pairs
 	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
 	// Actives returns all active pairs
@@ -1267,6 +1573,9 @@ type QueryServer interface {
 	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PairStatuses returns the circuit breaker status, last good price, and
+	// staleness of every whitelisted pair.
+	PairStatuses(context.Context, *QueryPairStatusesRequest) (*QueryPairStatusesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1279,6 +1588,9 @@ func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExc
 func (*UnimplementedQueryServer) ExchangeRateTwap(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateTwap not implemented")
 }
+func (*UnimplementedQueryServer) ExchangeRateEma(ctx context.Context, req *QueryExchangeRateEmaRequest) (*QueryExchangeRateEmaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateEma not implemented")
+}
 func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
 }
@@ -1309,6 +1621,9 @@ func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryA
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) PairStatuses(ctx context.Context, req *QueryPairStatusesRequest) (*QueryPairStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairStatuses not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1350,6 +1665,24 @@ func _Query_ExchangeRateTwap_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExchangeRateEma_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateEmaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateEma(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/ExchangeRateEma",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateEma(ctx, req.(*QueryExchangeRateEmaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryExchangeRatesRequest)
 	if err := dec(in); err != nil {
@@ -1530,6 +1863,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PairStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPairStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PairStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/PairStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PairStatuses(ctx, req.(*QueryPairStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.oracle.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1542,6 +1893,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExchangeRateTwap",
 			Handler:    _Query_ExchangeRateTwap_Handler,
 		},
+		{
+			MethodName: "ExchangeRateEma",
+			Handler:    _Query_ExchangeRateEma_Handler,
+		},
 		{
 			MethodName: "ExchangeRates",
 			Handler:    _Query_ExchangeRates_Handler,
@@ -1582,6 +1937,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PairStatuses",
+			Handler:    _Query_PairStatuses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/oracle/v1/query.proto",
@@ -1640,6 +1999,31 @@ func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AgeBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgeBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
 		i--
@@ -1663,7 +2047,7 @@ func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryExchangeRateEmaRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1673,20 +2057,30 @@ func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateEmaRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateEmaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryExchangeRateEmaResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1696,18 +2090,74 @@ func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateEmaResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateEmaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size, err := m.ExchangeRateEma.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
@@ -2263,6 +2713,114 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPairStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPairStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPairStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPairStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPairStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPairStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairStatusResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairStatusResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairStatusResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AgeBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgeBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2299,6 +2857,37 @@ func (m *QueryExchangeRateResponse) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovQuery(uint64(m.BlockHeight))
 	}
+	if m.AgeBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.AgeBlocks))
+	}
+	if m.Stale {
+		n += 2
+	}
+	if m.Halted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryExchangeRateEmaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExchangeRateEmaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRateEma.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -2541,6 +3130,47 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPairStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPairStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PairStatusResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Status.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AgeBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.AgeBlocks))
+	}
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2717,7 +3347,219 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.BlockHeight = 0
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeBlocks", wireType)
+			}
+			m.AgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateEmaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateEmaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateEmaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateEmaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateEmaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateEmaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateEma", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2727,11 +3569,25 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRateEma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4205,6 +5061,262 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPairStatusesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPairStatusesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPairStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPairStatusesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPairStatusesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPairStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, PairStatusResult{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairStatusResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairStatusResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairStatusResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeBlocks", wireType)
+			}
+			m.AgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
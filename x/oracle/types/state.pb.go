@@ -11,16 +11,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -76,37 +80,223 @@ func (m *PriceSnapshot) GetTimestampMs() int64 {
 	return 0
 }
 
+// PairStatus tracks the circuit breaker of a pair.
+type PairStatus struct {
+	Pair github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	// Whether the pair is halted because a tally deviated from the last good
+	// price by more than the pair's max deviation.
+	Halted bool `protobuf:"varint,2,opt,name=halted,proto3" json:"halted,omitempty"`
+	// Block height at which the pair was halted.
+	HaltedBlock uint64 `protobuf:"varint,3,opt,name=halted_block,json=haltedBlock,proto3" json:"halted_block,omitempty"`
+	// Last exchange rate of the pair that was within the deviation bound.
+	LastGoodPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=last_good_price,json=lastGoodPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"last_good_price"`
+	// Block height of the last good price.
+	LastGoodBlock uint64 `protobuf:"varint,5,opt,name=last_good_block,json=lastGoodBlock,proto3" json:"last_good_block,omitempty"`
+}
+
+func (m *PairStatus) Reset()         { *m = PairStatus{} }
+func (m *PairStatus) String() string { return proto.CompactTextString(m) }
+func (*PairStatus) ProtoMessage()    {}
+func (*PairStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_125e6c5a6e45c0d0, []int{1}
+}
+func (m *PairStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairStatus.Merge(m, src)
+}
+func (m *PairStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairStatus proto.InternalMessageInfo
+
+func (m *PairStatus) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+func (m *PairStatus) GetHaltedBlock() uint64 {
+	if m != nil {
+		return m.HaltedBlock
+	}
+	return 0
+}
+
+func (m *PairStatus) GetLastGoodBlock() uint64 {
+	if m != nil {
+		return m.LastGoodBlock
+	}
+	return 0
+}
+
+// ExchangeRateEma holds the exponentially weighted moving averages of the
+// exchange rate of a pair, one for each half-life in the params.
+type ExchangeRateEma struct {
+	Pair github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	// Block time in milliseconds of the last price included in the averages.
+	TimestampMs int64      `protobuf:"varint,2,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	Emas        []EmaValue `protobuf:"bytes,3,rep,name=emas,proto3" json:"emas"`
+}
+
+func (m *ExchangeRateEma) Reset()         { *m = ExchangeRateEma{} }
+func (m *ExchangeRateEma) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateEma) ProtoMessage()    {}
+func (*ExchangeRateEma) Descriptor() ([]byte, []int) {
+	return fileDescriptor_125e6c5a6e45c0d0, []int{2}
+}
+func (m *ExchangeRateEma) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateEma) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateEma.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateEma) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateEma.Merge(m, src)
+}
+func (m *ExchangeRateEma) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateEma) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateEma.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateEma proto.InternalMessageInfo
+
+func (m *ExchangeRateEma) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+func (m *ExchangeRateEma) GetEmas() []EmaValue {
+	if m != nil {
+		return m.Emas
+	}
+	return nil
+}
+
+// EmaValue is an exponentially weighted moving average of an exchange rate.
+type EmaValue struct {
+	HalfLife time.Duration               `protobuf:"bytes,1,opt,name=half_life,json=halfLife,proto3,stdduration" json:"half_life"`
+	Ema      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ema,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ema"`
+}
+
+func (m *EmaValue) Reset()         { *m = EmaValue{} }
+func (m *EmaValue) String() string { return proto.CompactTextString(m) }
+func (*EmaValue) ProtoMessage()    {}
+func (*EmaValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_125e6c5a6e45c0d0, []int{3}
+}
+func (m *EmaValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmaValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmaValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmaValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmaValue.Merge(m, src)
+}
+func (m *EmaValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmaValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmaValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmaValue proto.InternalMessageInfo
+
+func (m *EmaValue) GetHalfLife() time.Duration {
+	if m != nil {
+		return m.HalfLife
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PriceSnapshot)(nil), "nibiru.oracle.v1.PriceSnapshot")
+	proto.RegisterType((*PairStatus)(nil), "nibiru.oracle.v1.PairStatus")
+	proto.RegisterType((*ExchangeRateEma)(nil), "nibiru.oracle.v1.ExchangeRateEma")
+	proto.RegisterType((*EmaValue)(nil), "nibiru.oracle.v1.EmaValue")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/state.proto", fileDescriptor_125e6c5a6e45c0d0) }
 
 var fileDescriptor_125e6c5a6e45c0d0 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0x4f, 0x4e, 0xe3, 0x30,
-	0x14, 0xc6, 0x93, 0xe9, 0xcc, 0x48, 0x93, 0xce, 0x48, 0xa3, 0x88, 0x45, 0x29, 0x25, 0x2d, 0x5d,
-	0x75, 0x43, 0x9e, 0x02, 0xac, 0x58, 0x96, 0x4a, 0x08, 0xf1, 0x47, 0x55, 0xd9, 0xb1, 0xa9, 0x5e,
-	0x8c, 0x95, 0x58, 0xd4, 0x7e, 0x51, 0xec, 0x56, 0xf4, 0x16, 0x1c, 0x86, 0x43, 0x74, 0x59, 0xb1,
-	0x42, 0x08, 0x55, 0xa8, 0xbd, 0x01, 0x27, 0x40, 0x89, 0x23, 0x36, 0x6c, 0xd8, 0xd9, 0xfe, 0xd9,
-	0xdf, 0xef, 0xf3, 0xf3, 0x5a, 0x4a, 0xc4, 0x22, 0x9f, 0x02, 0xe5, 0xc8, 0x26, 0x1c, 0x66, 0x11,
-	0x68, 0x83, 0x86, 0x87, 0x59, 0x4e, 0x86, 0xfc, 0xff, 0x96, 0x86, 0x96, 0x86, 0xb3, 0xa8, 0xb9,
-	0x95, 0x50, 0x42, 0x25, 0x84, 0x62, 0x65, 0xef, 0x35, 0x5b, 0x09, 0x51, 0x32, 0xe1, 0x80, 0x99,
-	0x00, 0x54, 0x8a, 0x0c, 0x1a, 0x41, 0x4a, 0x57, 0x74, 0xf7, 0x8b, 0xa3, 0xca, 0xb3, 0x38, 0x60,
-	0xa4, 0x25, 0x69, 0x88, 0x51, 0x17, 0x30, 0xe6, 0x06, 0x23, 0x60, 0x24, 0x54, 0xc5, 0xb7, 0x2d,
-	0x1f, 0x5b, 0xab, 0xdd, 0x58, 0xd4, 0x7d, 0x75, 0xbd, 0x7f, 0xc3, 0x5c, 0x30, 0x7e, 0xad, 0x30,
-	0xd3, 0x29, 0x19, 0x7f, 0xec, 0xfd, 0xcc, 0x50, 0xe4, 0x0d, 0xb7, 0xe3, 0xf6, 0xfe, 0xf4, 0xcf,
-	0x17, 0xab, 0xb6, 0xf3, 0xb2, 0x6a, 0x1f, 0x25, 0xc2, 0xa4, 0xd3, 0x38, 0x64, 0x24, 0xe1, 0xaa,
-	0x2c, 0x73, 0x92, 0xa2, 0x50, 0x50, 0x15, 0x9b, 0x1d, 0xc0, 0x3d, 0x30, 0x92, 0x92, 0x14, 0xa0,
-	0xd6, 0xdc, 0x84, 0x43, 0x14, 0xf9, 0xfb, 0xaa, 0x5d, 0x9f, 0xa3, 0x9c, 0x1c, 0x77, 0x8b, 0xc4,
-	0xee, 0xa8, 0x0c, 0xf6, 0x4f, 0xbd, 0x5f, 0x59, 0x61, 0x6c, 0xfc, 0x28, 0x0d, 0x51, 0x65, 0xd8,
-	0xb1, 0xbd, 0xf4, 0xed, 0x5d, 0x28, 0x08, 0x24, 0x9a, 0x34, 0xbc, 0xe0, 0x09, 0xb2, 0xf9, 0x80,
-	0xb3, 0xa7, 0xc7, 0x7d, 0xaf, 0xaa, 0x3d, 0xe0, 0x6c, 0x64, 0xdf, 0xfb, 0x7b, 0xde, 0x5f, 0x23,
-	0x24, 0xd7, 0x06, 0x65, 0x36, 0x96, 0xba, 0x51, 0xeb, 0xb8, 0xbd, 0xda, 0xa8, 0xfe, 0x79, 0x76,
-	0xa9, 0xfb, 0x67, 0x8b, 0x75, 0xe0, 0x2e, 0xd7, 0x81, 0xfb, 0xb6, 0x0e, 0xdc, 0x87, 0x4d, 0xe0,
-	0x2c, 0x37, 0x81, 0xf3, 0xbc, 0x09, 0x9c, 0x1b, 0xf8, 0xc6, 0x87, 0xaa, 0x71, 0x9b, 0x79, 0xc6,
-	0x75, 0xfc, 0xbb, 0x1c, 0xd8, 0xe1, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xb4, 0xbf, 0xc4,
-	0xf0, 0x01, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xc1, 0x6a, 0xdb, 0x4c,
+	0x10, 0xc7, 0xad, 0xd8, 0x09, 0xce, 0xfa, 0x0b, 0xf9, 0x10, 0xa5, 0x24, 0x69, 0x2a, 0xa7, 0x3a,
+	0x94, 0x5c, 0xba, 0x8b, 0xd3, 0x9c, 0x7a, 0x2a, 0x4e, 0x4c, 0x28, 0x4d, 0x4b, 0x50, 0xa0, 0xd0,
+	0x5e, 0xc4, 0x48, 0x5e, 0x4b, 0x4b, 0xb4, 0x5a, 0xa1, 0x5d, 0x99, 0xf8, 0x2d, 0xda, 0x5b, 0xdf,
+	0xa1, 0xd7, 0x3e, 0x44, 0xa0, 0x97, 0xd0, 0x53, 0x29, 0xc5, 0x2d, 0xf6, 0x1b, 0xf4, 0x09, 0xca,
+	0xee, 0xca, 0xa1, 0x24, 0x97, 0x12, 0xc8, 0x4d, 0xbb, 0xff, 0x9d, 0xff, 0xfc, 0x66, 0x46, 0x83,
+	0xb6, 0x73, 0x16, 0xb1, 0xb2, 0x22, 0xa2, 0x84, 0x38, 0xa3, 0x64, 0xdc, 0x23, 0x52, 0x81, 0xa2,
+	0xb8, 0x28, 0x85, 0x12, 0xee, 0xff, 0x56, 0xc5, 0x56, 0xc5, 0xe3, 0xde, 0xd6, 0xbd, 0x44, 0x24,
+	0xc2, 0x88, 0x44, 0x7f, 0xd9, 0x77, 0x5b, 0x5e, 0x22, 0x44, 0x92, 0x51, 0x62, 0x4e, 0x51, 0x35,
+	0x22, 0xc3, 0xaa, 0x04, 0xc5, 0x44, 0x5e, 0xeb, 0xdb, 0xb5, 0x0e, 0x05, 0x23, 0x90, 0xe7, 0x42,
+	0x19, 0x51, 0xd6, 0xea, 0xc3, 0x1b, 0x0c, 0x75, 0xbe, 0xda, 0x3c, 0x16, 0x92, 0x0b, 0x49, 0x22,
+	0x90, 0x5a, 0x8c, 0xa8, 0x82, 0x1e, 0x89, 0x05, 0x5b, 0x98, 0x6f, 0x5a, 0x3d, 0xb4, 0x54, 0xf6,
+	0x60, 0x25, 0xff, 0x87, 0x83, 0xd6, 0x4e, 0x4a, 0x16, 0xd3, 0xd3, 0x1c, 0x0a, 0x99, 0x0a, 0xe5,
+	0x86, 0xa8, 0x55, 0x00, 0x2b, 0x37, 0x9c, 0x1d, 0x67, 0x77, 0xb5, 0xff, 0xf2, 0x62, 0xda, 0x6d,
+	0x7c, 0x9f, 0x76, 0xf7, 0x13, 0xa6, 0xd2, 0x2a, 0xc2, 0xb1, 0xe0, 0xe4, 0xb5, 0x81, 0x39, 0x48,
+	0x81, 0xe5, 0xa4, 0x06, 0x1b, 0xef, 0x91, 0x73, 0x12, 0x0b, 0xce, 0x45, 0x4e, 0x40, 0x4a, 0xaa,
+	0xf0, 0x09, 0xb0, 0xf2, 0xf7, 0xb4, 0xdb, 0x99, 0x00, 0xcf, 0x9e, 0xf9, 0xda, 0xd1, 0x0f, 0x8c,
+	0xb1, 0x7b, 0x84, 0x96, 0x0b, 0x9d, 0x71, 0x63, 0xc9, 0x64, 0xe8, 0xd5, 0x19, 0x1e, 0x58, 0x2e,
+	0x39, 0x3c, 0xc3, 0x4c, 0x10, 0x0e, 0x2a, 0xc5, 0xc7, 0x34, 0x81, 0x78, 0x72, 0x48, 0xe3, 0xaf,
+	0x9f, 0x9f, 0xa0, 0x1a, 0xfb, 0x90, 0xc6, 0x81, 0x8d, 0x77, 0x1f, 0xa1, 0xff, 0x14, 0xe3, 0x54,
+	0x2a, 0xe0, 0x45, 0xc8, 0xe5, 0x46, 0x73, 0xc7, 0xd9, 0x6d, 0x06, 0x9d, 0xab, 0xbb, 0x57, 0xd2,
+	0xff, 0xb4, 0x84, 0x90, 0x26, 0x39, 0x55, 0xa0, 0x2a, 0x79, 0xf7, 0xb5, 0xdd, 0x47, 0x2b, 0x29,
+	0x64, 0x8a, 0x0e, 0x4d, 0x71, 0xed, 0xa0, 0x3e, 0x69, 0x54, 0xfb, 0x15, 0x46, 0x99, 0x88, 0xcf,
+	0x0c, 0x6a, 0x2b, 0xe8, 0xd8, 0xbb, 0xbe, 0xbe, 0x72, 0xdf, 0xa2, 0xf5, 0x0c, 0xa4, 0x0a, 0x13,
+	0x21, 0x86, 0xa1, 0x6d, 0x50, 0xeb, 0xb6, 0x0d, 0x5a, 0xd3, 0x4e, 0x47, 0x42, 0x0c, 0xcd, 0x68,
+	0xdd, 0xc7, 0x7f, 0x5b, 0x5b, 0x80, 0x65, 0x03, 0x70, 0xf5, 0xce, 0x20, 0xf8, 0x5f, 0x1c, 0xb4,
+	0x3e, 0x38, 0x8f, 0x53, 0xc8, 0x13, 0x1a, 0x80, 0xa2, 0x03, 0x0e, 0x77, 0xdf, 0xb2, 0xeb, 0x53,
+	0x5c, 0xba, 0x31, 0x45, 0x77, 0x1f, 0xb5, 0x28, 0x07, 0x3d, 0xe0, 0xe6, 0x6e, 0x67, 0x6f, 0x0b,
+	0x5f, 0xdf, 0x39, 0x3c, 0xe0, 0xf0, 0x06, 0xb2, 0x8a, 0xf6, 0x5b, 0x9a, 0x2f, 0x30, 0xaf, 0xfd,
+	0x0f, 0x0e, 0x6a, 0x2f, 0x04, 0xf7, 0x39, 0x5a, 0x4d, 0x21, 0x1b, 0x85, 0x19, 0x1b, 0x51, 0x53,
+	0x4b, 0x67, 0x6f, 0x13, 0xdb, 0x9d, 0xc3, 0x8b, 0x9d, 0xc4, 0x87, 0xf5, 0x4e, 0xf6, 0xdb, 0xda,
+	0xe6, 0xe3, 0xcf, 0xae, 0x13, 0xb4, 0x75, 0xd4, 0x31, 0x1b, 0x51, 0xf7, 0x00, 0x35, 0x29, 0x87,
+	0xdb, 0xff, 0xb4, 0x3a, 0xba, 0xff, 0xe2, 0x62, 0xe6, 0x39, 0x97, 0x33, 0xcf, 0xf9, 0x35, 0xf3,
+	0x9c, 0xf7, 0x73, 0xaf, 0x71, 0x39, 0xf7, 0x1a, 0xdf, 0xe6, 0x5e, 0xe3, 0x1d, 0xf9, 0x87, 0x8e,
+	0xd6, 0xeb, 0xaf, 0x26, 0x05, 0x95, 0xd1, 0x8a, 0xc1, 0x7e, 0xfa, 0x67, 0x00, 0x07, 0x3a, 0x99,
+	0x9a, 0xa0, 0x04, 0x00, 0x00,
 }
 
 func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
@@ -157,6 +347,162 @@ func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PairStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastGoodBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.LastGoodBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.LastGoodPrice.Size()
+		i -= size
+		if _, err := m.LastGoodPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.HaltedBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.HaltedBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRateEma) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateEma) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateEma) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Emas) > 0 {
+		for iNdEx := len(m.Emas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Emas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TimestampMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EmaValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmaValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmaValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ema.Size()
+		i -= size
+		if _, err := m.Ema.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.HalfLife, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HalfLife):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintState(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	offset -= sovState(v)
 	base := offset
@@ -184,6 +530,61 @@ func (m *PriceSnapshot) Size() (n int) {
 	return n
 }
 
+func (m *PairStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Halted {
+		n += 2
+	}
+	if m.HaltedBlock != 0 {
+		n += 1 + sovState(uint64(m.HaltedBlock))
+	}
+	l = m.LastGoodPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.LastGoodBlock != 0 {
+		n += 1 + sovState(uint64(m.LastGoodBlock))
+	}
+	return n
+}
+
+func (m *ExchangeRateEma) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.TimestampMs != 0 {
+		n += 1 + sovState(uint64(m.TimestampMs))
+	}
+	if len(m.Emas) > 0 {
+		for _, e := range m.Emas {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmaValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HalfLife)
+	n += 1 + l + sovState(uint64(l))
+	l = m.Ema.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
 func sovState(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -327,6 +728,436 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PairStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedBlock", wireType)
+			}
+			m.HaltedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltedBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGoodPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastGoodPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGoodBlock", wireType)
+			}
+			m.LastGoodBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastGoodBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateEma) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateEma: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateEma: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emas = append(m.Emas, EmaValue{})
+			if err := m.Emas[len(m.Emas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmaValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmaValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmaValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfLife", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.HalfLife, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipState(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -357,6 +357,89 @@ func (m *MsgEditOracleParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditOracleParamsResponse proto.InternalMessageInfo
 
+// MsgResumePair: gRPC tx msg for resuming a pair halted by the circuit
+// breaker.
+type MsgResumePair struct {
+	Sender string                                               `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair   github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"pair"`
+}
+
+func (m *MsgResumePair) Reset()         { *m = MsgResumePair{} }
+func (m *MsgResumePair) String() string { return proto.CompactTextString(m) }
+func (*MsgResumePair) ProtoMessage()    {}
+func (*MsgResumePair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{8}
+}
+func (m *MsgResumePair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumePair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumePair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumePair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumePair.Merge(m, src)
+}
+func (m *MsgResumePair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumePair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumePair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumePair proto.InternalMessageInfo
+
+func (m *MsgResumePair) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type MsgResumePairResponse struct {
+}
+
+func (m *MsgResumePairResponse) Reset()         { *m = MsgResumePairResponse{} }
+func (m *MsgResumePairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumePairResponse) ProtoMessage()    {}
+func (*MsgResumePairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{9}
+}
+func (m *MsgResumePairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumePairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumePairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumePairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumePairResponse.Merge(m, src)
+}
+func (m *MsgResumePairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumePairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumePairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumePairResponse proto.InternalMessageInfo
+
 type OracleParamsMsg struct {
 	// VotePeriod defines the number of blocks during which voting takes place.
 	VotePeriod uint64 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
@@ -393,13 +476,22 @@ type OracleParamsMsg struct {
 	// The validator fee ratio that is given to validators every epoch.
 	ValidatorFeeRatio *cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_fee_ratio,omitempty" yaml:"validator_fee_ratio"`
 	ExpirationBlocks  uint64                       `protobuf:"varint,11,opt,name=expiration_blocks,json=expirationBlocks,proto3" json:"expiration_blocks,omitempty" yaml:"expiration_blocks"`
+	// Per-pair aggregation configs to upsert. A config without any override
+	// removes the existing config of its pair.
+	PairAggregationConfigs []PairAggregationConfig `protobuf:"bytes,12,rep,name=pair_aggregation_configs,json=pairAggregationConfigs,proto3" json:"pair_aggregation_configs"`
+	// Replaces Params.EmaHalfLives when non-empty. An empty list leaves them
+	// unchanged; use clear_ema_half_lives to remove them all.
+	EmaHalfLives []time.Duration `protobuf:"bytes,13,rep,name=ema_half_lives,json=emaHalfLives,proto3,stdduration" json:"ema_half_lives,omitempty" yaml:"ema_half_lives"`
+	// Removes every EMA half-life, which stops the moving averages. Can't be
+	// combined with ema_half_lives.
+	ClearEmaHalfLives bool `protobuf:"varint,14,opt,name=clear_ema_half_lives,json=clearEmaHalfLives,proto3" json:"clear_ema_half_lives,omitempty"`
 }
 
 func (m *OracleParamsMsg) Reset()         { *m = OracleParamsMsg{} }
 func (m *OracleParamsMsg) String() string { return proto.CompactTextString(m) }
 func (*OracleParamsMsg) ProtoMessage()    {}
 func (*OracleParamsMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11e362c65eb610f4, []int{8}
+	return fileDescriptor_11e362c65eb610f4, []int{10}
 }
 func (m *OracleParamsMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,6 +555,27 @@ func (m *OracleParamsMsg) GetExpirationBlocks() uint64 {
 	return 0
 }
 
+func (m *OracleParamsMsg) GetPairAggregationConfigs() []PairAggregationConfig {
+	if m != nil {
+		return m.PairAggregationConfigs
+	}
+	return nil
+}
+
+func (m *OracleParamsMsg) GetEmaHalfLives() []time.Duration {
+	if m != nil {
+		return m.EmaHalfLives
+	}
+	return nil
+}
+
+func (m *OracleParamsMsg) GetClearEmaHalfLives() bool {
+	if m != nil {
+		return m.ClearEmaHalfLives
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRatePrevote")
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRatePrevoteResponse")
@@ -472,84 +585,97 @@ func init() {
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "nibiru.oracle.v1.MsgDelegateFeedConsentResponse")
 	proto.RegisterType((*MsgEditOracleParams)(nil), "nibiru.oracle.v1.MsgEditOracleParams")
 	proto.RegisterType((*MsgEditOracleParamsResponse)(nil), "nibiru.oracle.v1.MsgEditOracleParamsResponse")
+	proto.RegisterType((*MsgResumePair)(nil), "nibiru.oracle.v1.MsgResumePair")
+	proto.RegisterType((*MsgResumePairResponse)(nil), "nibiru.oracle.v1.MsgResumePairResponse")
 	proto.RegisterType((*OracleParamsMsg)(nil), "nibiru.oracle.v1.OracleParamsMsg")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/tx.proto", fileDescriptor_11e362c65eb610f4) }
 
 var fileDescriptor_11e362c65eb610f4 = []byte{
-	// 1125 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0xe3, 0xc4,
-	0x1b, 0xae, 0xb7, 0xfd, 0xf5, 0xd7, 0x4c, 0xe8, 0xb6, 0x75, 0xda, 0xc5, 0x49, 0x5b, 0xbb, 0x0c,
-	0xb0, 0x74, 0x25, 0x6a, 0xd3, 0x82, 0x10, 0x14, 0x09, 0x41, 0xb6, 0x5b, 0xb4, 0xa8, 0x65, 0x23,
-	0x0b, 0x2d, 0x12, 0x97, 0x68, 0x62, 0x4f, 0x6d, 0xab, 0xb6, 0xc7, 0x78, 0xa6, 0xff, 0x4e, 0x08,
-	0x84, 0x04, 0x47, 0x24, 0x24, 0x54, 0x6e, 0xfd, 0x00, 0x48, 0x5c, 0xf8, 0x10, 0x7b, 0x5c, 0xc1,
-	0x05, 0x71, 0x08, 0xa8, 0xe5, 0xb0, 0xe2, 0xc0, 0x21, 0x77, 0x04, 0xf2, 0xcc, 0xc4, 0x49, 0x9d,
-	0xec, 0x6e, 0xca, 0x2d, 0x33, 0xcf, 0x33, 0xef, 0xfb, 0xbc, 0x8f, 0x67, 0xde, 0x37, 0xa0, 0x1a,
-	0x07, 0xad, 0x20, 0x3d, 0xb0, 0x48, 0x8a, 0x9c, 0x10, 0x5b, 0x87, 0xeb, 0x16, 0x3b, 0x36, 0x93,
-	0x94, 0x30, 0xa2, 0xce, 0x0a, 0xc8, 0x14, 0x90, 0x79, 0xb8, 0x5e, 0x9b, 0xf7, 0x88, 0x47, 0x38,
-	0x68, 0x65, 0xbf, 0x04, 0xaf, 0xb6, 0xe4, 0x11, 0xe2, 0x85, 0xd8, 0x42, 0x49, 0x60, 0xa1, 0x38,
-	0x26, 0x0c, 0xb1, 0x80, 0xc4, 0x54, 0xa2, 0xba, 0x44, 0xf9, 0xaa, 0x75, 0xb0, 0x67, 0xb9, 0x07,
-	0x29, 0x27, 0x48, 0x7c, 0x79, 0x40, 0x80, 0xcc, 0x27, 0xe0, 0xaa, 0x43, 0x68, 0x44, 0x68, 0x53,
-	0x64, 0x15, 0x0b, 0x01, 0xc1, 0x1f, 0x14, 0x60, 0xec, 0x52, 0xef, 0x5d, 0xcf, 0x4b, 0xb1, 0x87,
-	0x18, 0xbe, 0x73, 0xec, 0xf8, 0x28, 0xf6, 0xb0, 0x8d, 0x18, 0x6e, 0xa4, 0xf8, 0x90, 0x30, 0xac,
-	0x3e, 0x0f, 0x26, 0x7c, 0x44, 0x7d, 0x4d, 0x59, 0x51, 0x56, 0x4b, 0xf5, 0x99, 0x4e, 0xdb, 0x28,
-	0x9f, 0xa0, 0x28, 0xdc, 0x84, 0xd9, 0x2e, 0xb4, 0x39, 0xa8, 0xde, 0x02, 0x93, 0x7b, 0x18, 0xbb,
-	0x38, 0xd5, 0xae, 0x71, 0xda, 0x5c, 0xa7, 0x6d, 0x4c, 0x0b, 0x9a, 0xd8, 0x87, 0xb6, 0x24, 0xa8,
-	0x1b, 0xa0, 0x74, 0x88, 0xc2, 0xc0, 0x45, 0x8c, 0xa4, 0xda, 0x38, 0x67, 0xcf, 0x77, 0xda, 0xc6,
-	0xac, 0x60, 0xe7, 0x10, 0xb4, 0x7b, 0xb4, 0xcd, 0xa9, 0xaf, 0xce, 0x8c, 0xb1, 0x47, 0x67, 0xc6,
-	0x18, 0xbc, 0x05, 0x5e, 0x7a, 0x8a, 0x60, 0x1b, 0xd3, 0x84, 0xc4, 0x14, 0xc3, 0xbf, 0x14, 0xb0,
-	0xf4, 0x38, 0xee, 0x7d, 0x59, 0x19, 0x45, 0x21, 0x1b, 0xac, 0x2c, 0xdb, 0x85, 0x36, 0x07, 0xd5,
-	0x77, 0xc0, 0x75, 0x2c, 0x0f, 0x36, 0x53, 0xc4, 0x30, 0x95, 0x15, 0x56, 0x3b, 0x6d, 0x63, 0x41,
-	0xd0, 0x2f, 0xe3, 0xd0, 0x9e, 0xc6, 0x7d, 0x99, 0x68, 0x9f, 0x37, 0xe3, 0x57, 0xf2, 0x66, 0xe2,
-	0xaa, 0xde, 0xdc, 0x04, 0x2f, 0x3c, 0xa9, 0xde, 0xdc, 0x98, 0x2f, 0x14, 0x70, 0x63, 0x97, 0x7a,
-	0x5b, 0x38, 0xe4, 0xbc, 0x6d, 0x8c, 0xdd, 0xdb, 0x19, 0x10, 0x33, 0xd5, 0x02, 0x53, 0x24, 0xc1,
-	0x29, 0xcf, 0x2f, 0x6c, 0xa9, 0x74, 0xda, 0xc6, 0x8c, 0xc8, 0xdf, 0x45, 0xa0, 0x9d, 0x93, 0xb2,
-	0x03, 0xae, 0x8c, 0x23, 0x8d, 0xe9, 0x3b, 0xd0, 0x45, 0xa0, 0x9d, 0x93, 0xfa, 0xe4, 0xae, 0x00,
-	0x7d, 0xb8, 0x8a, 0x5c, 0xe8, 0xa9, 0x02, 0x2a, 0xbb, 0xd4, 0xbb, 0xe3, 0x06, 0xec, 0x1e, 0xbf,
-	0xd1, 0x0d, 0x94, 0xa2, 0x88, 0x3b, 0x4a, 0x71, 0x9c, 0x39, 0xaa, 0x14, 0x1d, 0x15, 0xfb, 0xd0,
-	0x96, 0x04, 0x75, 0x07, 0x4c, 0x26, 0xfc, 0x10, 0x57, 0x57, 0xde, 0x78, 0xce, 0x2c, 0x3e, 0x49,
-	0xb3, 0x3f, 0xf4, 0x2e, 0xf5, 0xfa, 0xa3, 0x89, 0xa3, 0xd0, 0x96, 0x31, 0xfa, 0xc4, 0x2f, 0x83,
-	0xc5, 0x21, 0xca, 0x72, 0xe5, 0xff, 0x4c, 0x81, 0x99, 0x42, 0x5c, 0xf5, 0x2d, 0x50, 0xce, 0xee,
-	0x67, 0x33, 0xc1, 0x69, 0x40, 0x5c, 0x2e, 0x7d, 0xa2, 0x5e, 0x7b, 0xd0, 0x36, 0x94, 0x4e, 0xdb,
-	0x50, 0xe5, 0x27, 0xee, 0x11, 0xa0, 0x0d, 0xb2, 0x55, 0x83, 0x2f, 0xd4, 0x4f, 0xc0, 0x75, 0x8e,
-	0x31, 0x3f, 0xc5, 0xd4, 0x27, 0xa1, 0x2b, 0xdd, 0x7e, 0x3f, 0x3b, 0xff, 0x6b, 0xdb, 0x58, 0x14,
-	0xef, 0x9a, 0xba, 0xfb, 0x66, 0x40, 0xac, 0x08, 0x31, 0xdf, 0xdc, 0xc1, 0x1e, 0x72, 0x4e, 0xb6,
-	0xb0, 0xd3, 0xbb, 0xa9, 0x97, 0x43, 0xc0, 0x9f, 0x7e, 0x5c, 0x03, 0xb2, 0x1f, 0x6c, 0x61, 0xc7,
-	0x9e, 0xce, 0xe0, 0x0f, 0xbb, 0xa8, 0xea, 0x83, 0x72, 0x8a, 0x8f, 0x50, 0xea, 0x36, 0x5b, 0x28,
-	0x76, 0xe5, 0xe5, 0x7d, 0x6f, 0xb4, 0x7c, 0xb2, 0x9c, 0xbe, 0xf3, 0xc5, 0x64, 0x40, 0x60, 0x75,
-	0x14, 0xbb, 0x6a, 0x0c, 0x4a, 0x47, 0x7e, 0xc0, 0x70, 0x18, 0x50, 0xa6, 0x4d, 0xac, 0x8c, 0xaf,
-	0x96, 0xea, 0x0d, 0x99, 0xe7, 0x35, 0x2f, 0x60, 0xfe, 0x41, 0xcb, 0x74, 0x48, 0x64, 0x7d, 0xc0,
-	0xbf, 0xdc, 0x6d, 0x1f, 0x05, 0xb1, 0x25, 0x5b, 0xde, 0xe1, 0x86, 0x75, 0x6c, 0x39, 0x24, 0x8a,
-	0x48, 0x6c, 0x21, 0x4a, 0x31, 0x33, 0x1b, 0x28, 0x48, 0x7b, 0x4f, 0x26, 0x0f, 0x0b, 0xed, 0x5e,
-	0x8a, 0xcc, 0x4c, 0x1a, 0x22, 0xea, 0x37, 0xf7, 0x52, 0xe4, 0x64, 0x8d, 0x54, 0xfb, 0xdf, 0x7f,
-	0x30, 0xf3, 0x72, 0x88, 0x01, 0x33, 0x39, 0xbc, 0x2d, 0x51, 0xf5, 0x6d, 0xf0, 0x8c, 0xe0, 0x1f,
-	0x05, 0xb1, 0x4b, 0x8e, 0xb4, 0x49, 0xfe, 0xf5, 0x17, 0xe5, 0xd7, 0xaf, 0xf4, 0x47, 0x14, 0x0c,
-	0x68, 0x97, 0xf9, 0xf2, 0x23, 0xbe, 0x52, 0x3f, 0x53, 0xc0, 0x7c, 0x14, 0xc4, 0x4d, 0xfe, 0xee,
-	0xb3, 0x1b, 0xd2, 0x0d, 0xf4, 0x7f, 0xae, 0xbc, 0x31, 0x9a, 0xf2, 0x45, 0x91, 0x67, 0x58, 0xa0,
-	0xa2, 0xfe, 0xb9, 0x28, 0x88, 0xef, 0x67, 0x9c, 0x06, 0x4e, 0xa5, 0x86, 0x6f, 0x15, 0x30, 0xcf,
-	0x8e, 0x50, 0xd2, 0x0c, 0x09, 0xd9, 0x6f, 0x21, 0x67, 0xbf, 0xab, 0x61, 0x8a, 0x3f, 0xad, 0xaa,
-	0x29, 0xe6, 0x94, 0xd9, 0x9d, 0x53, 0xe6, 0x96, 0x9c, 0x53, 0xf5, 0xbb, 0x99, 0xbc, 0x3f, 0xdb,
-	0x86, 0x3e, 0xec, 0xf8, 0xcb, 0x24, 0x0a, 0x18, 0x8e, 0x12, 0x76, 0xd2, 0x53, 0x38, 0x8c, 0x07,
-	0x4f, 0x7f, 0x33, 0x14, 0x5b, 0xcd, 0xa0, 0x1d, 0x89, 0x48, 0x61, 0x6f, 0x00, 0xc0, 0x4b, 0x22,
-	0x0c, 0xa7, 0x54, 0x2b, 0x71, 0x6b, 0xab, 0xd2, 0xda, 0xb9, 0xbe, 0x92, 0x39, 0x0e, 0xed, 0x52,
-	0x56, 0x1a, 0xff, 0xad, 0x7e, 0x0a, 0x2a, 0x79, 0x27, 0x6d, 0xee, 0x61, 0xde, 0xc2, 0x03, 0xa2,
-	0x01, 0x6e, 0xea, 0xbd, 0xd1, 0x4c, 0xad, 0x15, 0xba, 0x73, 0x2f, 0xce, 0x80, 0xa7, 0x39, 0x67,
-	0x1b, 0x67, 0x6d, 0x39, 0x20, 0xea, 0x2e, 0x98, 0xc3, 0xc7, 0x49, 0x20, 0x7c, 0x6a, 0xb6, 0x42,
-	0xe2, 0xec, 0x53, 0xad, 0xcc, 0x2b, 0x58, 0x91, 0x15, 0x68, 0xdd, 0x29, 0x53, 0xa0, 0x41, 0x7b,
-	0xb6, 0xb7, 0x57, 0xe7, 0x5b, 0x9b, 0x53, 0xa7, 0x67, 0x86, 0xf2, 0xe8, 0xcc, 0x50, 0x36, 0xfe,
-	0x9e, 0x00, 0xe3, 0x59, 0xd7, 0xf9, 0x5e, 0x01, 0x4b, 0x4f, 0x9c, 0xef, 0xeb, 0x83, 0x1d, 0xf1,
-	0x29, 0x13, 0xb6, 0xf6, 0xe6, 0x95, 0x8f, 0xe4, 0x8d, 0x51, 0xff, 0xfc, 0xe7, 0x3f, 0xbe, 0xb9,
-	0xa6, 0xc1, 0x1b, 0xd6, 0xe5, 0x3f, 0x2d, 0x89, 0x54, 0x73, 0xa6, 0x80, 0xea, 0xe3, 0x27, 0xb6,
-	0x39, 0x7a, 0xe2, 0x8c, 0x5f, 0x7b, 0xfd, 0x6a, 0xfc, 0x5c, 0xe5, 0x22, 0x57, 0xb9, 0x00, 0x2b,
-	0x05, 0x95, 0x5c, 0xe2, 0x77, 0x0a, 0xa8, 0x0c, 0x9b, 0x9d, 0xab, 0x43, 0x93, 0x0d, 0x61, 0xd6,
-	0x5e, 0x19, 0x95, 0x99, 0x0b, 0xba, 0xc9, 0x05, 0xad, 0x40, 0xbd, 0x20, 0x48, 0xfc, 0x6f, 0x58,
-	0xeb, 0x4e, 0x57, 0xf5, 0x4b, 0x05, 0xcc, 0x0e, 0x8c, 0xcb, 0x17, 0x87, 0xa6, 0x2b, 0xd2, 0x6a,
-	0x6b, 0x23, 0xd1, 0x72, 0x49, 0xcb, 0x5c, 0xd2, 0xb3, 0x70, 0xa1, 0xf8, 0x25, 0x39, 0xad, 0x7e,
-	0xf7, 0xc1, 0xb9, 0xae, 0x3c, 0x3c, 0xd7, 0x95, 0xdf, 0xcf, 0x75, 0xe5, 0xeb, 0x0b, 0x7d, 0xec,
-	0xe1, 0x85, 0x3e, 0xf6, 0xcb, 0x85, 0x3e, 0xf6, 0xb1, 0x35, 0x42, 0x4b, 0x97, 0xb1, 0xd8, 0x49,
-	0x82, 0x69, 0x6b, 0x92, 0x37, 0x94, 0x57, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x15, 0x0e, 0x3a,
-	0xaa, 0x69, 0x0b, 0x00, 0x00,
+	// 1311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x9b, 0x34, 0x24, 0xb3, 0x4d, 0x9a, 0x38, 0x1f, 0x75, 0x36, 0xe9, 0x7a, 0x19, 0xa0,
+	0x4d, 0x25, 0x6a, 0xd3, 0x80, 0x10, 0x94, 0x0f, 0xd1, 0x6d, 0x5a, 0x28, 0x4a, 0xe8, 0xca, 0x42,
+	0x45, 0xe2, 0x62, 0xcd, 0xda, 0xb3, 0x5e, 0x2b, 0xb6, 0xc7, 0x78, 0x9c, 0x2f, 0x0e, 0x20, 0x10,
+	0x12, 0x1c, 0x91, 0x90, 0x50, 0x7b, 0xcb, 0x1f, 0x80, 0xc4, 0x85, 0x3f, 0xa2, 0xc7, 0x0a, 0x2e,
+	0xa8, 0x87, 0x05, 0xb5, 0x1c, 0xaa, 0x1e, 0x38, 0xe4, 0xc6, 0x0d, 0xcd, 0xc7, 0x7a, 0xbd, 0xde,
+	0x6d, 0xb2, 0xe9, 0xcd, 0x33, 0xbf, 0xdf, 0x7b, 0xef, 0xf7, 0xde, 0xf8, 0xcd, 0x1b, 0xb0, 0x14,
+	0xf9, 0x0d, 0x3f, 0xd9, 0x36, 0x49, 0x82, 0x9c, 0x00, 0x9b, 0x3b, 0x57, 0xcc, 0x74, 0xcf, 0x88,
+	0x13, 0x92, 0x12, 0x75, 0x46, 0x40, 0x86, 0x80, 0x8c, 0x9d, 0x2b, 0xe5, 0x79, 0x8f, 0x78, 0x84,
+	0x83, 0x26, 0xfb, 0x12, 0xbc, 0xf2, 0x8a, 0x47, 0x88, 0x17, 0x60, 0x13, 0xc5, 0xbe, 0x89, 0xa2,
+	0x88, 0xa4, 0x28, 0xf5, 0x49, 0x44, 0x25, 0x5a, 0x91, 0x28, 0x5f, 0x35, 0xb6, 0x9b, 0xa6, 0xbb,
+	0x9d, 0x70, 0x82, 0xc4, 0xcf, 0xf7, 0x09, 0x90, 0xf1, 0x04, 0xbc, 0xe4, 0x10, 0x1a, 0x12, 0x6a,
+	0x8b, 0xa8, 0x62, 0x21, 0x20, 0xf8, 0xab, 0x02, 0xf4, 0x4d, 0xea, 0x5d, 0xf3, 0xbc, 0x04, 0x7b,
+	0x28, 0xc5, 0x37, 0xf6, 0x9c, 0x16, 0x8a, 0x3c, 0x6c, 0xa1, 0x14, 0xd7, 0x13, 0xbc, 0x43, 0x52,
+	0xac, 0xbe, 0x04, 0xc6, 0x5a, 0x88, 0xb6, 0x34, 0xa5, 0xaa, 0xac, 0x4e, 0xd6, 0xce, 0x1e, 0xb6,
+	0xf5, 0xd2, 0x3e, 0x0a, 0x83, 0xab, 0x90, 0xed, 0x42, 0x8b, 0x83, 0xea, 0x25, 0x30, 0xde, 0xc4,
+	0xd8, 0xc5, 0x89, 0x76, 0x8a, 0xd3, 0x66, 0x0f, 0xdb, 0xfa, 0x94, 0xa0, 0x89, 0x7d, 0x68, 0x49,
+	0x82, 0xba, 0x06, 0x26, 0x77, 0x50, 0xe0, 0xbb, 0x28, 0x25, 0x89, 0x36, 0xca, 0xd9, 0xf3, 0x87,
+	0x6d, 0x7d, 0x46, 0xb0, 0x33, 0x08, 0x5a, 0x5d, 0xda, 0xd5, 0x89, 0x1f, 0x0e, 0xf4, 0x91, 0x27,
+	0x07, 0xfa, 0x08, 0xbc, 0x04, 0x2e, 0x1e, 0x23, 0xd8, 0xc2, 0x34, 0x26, 0x11, 0xc5, 0xf0, 0x5f,
+	0x05, 0xac, 0x3c, 0x8b, 0x7b, 0x47, 0x66, 0x46, 0x51, 0x90, 0xf6, 0x67, 0xc6, 0x76, 0xa1, 0xc5,
+	0x41, 0xf5, 0x03, 0x30, 0x8d, 0xa5, 0xa1, 0x9d, 0xa0, 0x14, 0x53, 0x99, 0xe1, 0xd2, 0x61, 0x5b,
+	0x5f, 0x10, 0xf4, 0x5e, 0x1c, 0x5a, 0x53, 0x38, 0x17, 0x89, 0xe6, 0x6a, 0x33, 0x7a, 0xa2, 0xda,
+	0x8c, 0x9d, 0xb4, 0x36, 0x17, 0xc0, 0xcb, 0x47, 0xe5, 0x9b, 0x15, 0xe6, 0x3b, 0x05, 0x2c, 0x6e,
+	0x52, 0x6f, 0x1d, 0x07, 0x9c, 0x77, 0x13, 0x63, 0xf7, 0x3a, 0x03, 0xa2, 0x54, 0x35, 0xc1, 0x04,
+	0x89, 0x71, 0xc2, 0xe3, 0x8b, 0xb2, 0xcc, 0x1d, 0xb6, 0xf5, 0xb3, 0x22, 0x7e, 0x07, 0x81, 0x56,
+	0x46, 0x62, 0x06, 0xae, 0xf4, 0xa3, 0x9d, 0x2a, 0x1a, 0x74, 0x10, 0x68, 0x65, 0xa4, 0x9c, 0xdc,
+	0x2a, 0xa8, 0x0c, 0x56, 0x91, 0x09, 0xbd, 0xab, 0x80, 0xb9, 0x4d, 0xea, 0xdd, 0x70, 0xfd, 0xf4,
+	0x36, 0xff, 0xa3, 0xeb, 0x28, 0x41, 0x21, 0xaf, 0x28, 0xc5, 0x91, 0x8b, 0x3b, 0x1a, 0x73, 0x15,
+	0x15, 0xfb, 0xd0, 0x92, 0x04, 0x75, 0x03, 0x8c, 0xc7, 0xdc, 0x88, 0xab, 0x2b, 0xad, 0xbd, 0x68,
+	0x14, 0x5b, 0xd2, 0xc8, 0xbb, 0xde, 0xa4, 0x5e, 0xde, 0x9b, 0x30, 0x85, 0x96, 0xf4, 0x91, 0x13,
+	0x7f, 0x1e, 0x2c, 0x0f, 0x50, 0x96, 0x29, 0xdf, 0x07, 0x53, 0x9b, 0xd4, 0xb3, 0x30, 0xdd, 0x0e,
+	0x71, 0x1d, 0xf9, 0x89, 0xba, 0xd8, 0x2b, 0x39, 0xd3, 0x57, 0x07, 0x63, 0x31, 0xf2, 0x3b, 0x6d,
+	0xf3, 0xee, 0xfd, 0xb6, 0x3e, 0xf2, 0xb0, 0xad, 0xbf, 0xe1, 0xf9, 0x69, 0x6b, 0xbb, 0x61, 0x38,
+	0x24, 0x34, 0x3f, 0xe1, 0x7a, 0xaf, 0xb7, 0x90, 0x1f, 0x99, 0xb2, 0xd1, 0x77, 0xd6, 0xcc, 0x3d,
+	0xd3, 0x21, 0x61, 0x48, 0x22, 0x13, 0x51, 0x8a, 0x53, 0x83, 0xc5, 0xb0, 0xb8, 0x27, 0x78, 0x0e,
+	0x2c, 0xf4, 0x84, 0xce, 0x34, 0xdd, 0x2b, 0x81, 0xb3, 0x85, 0x5c, 0xd5, 0x77, 0x40, 0x89, 0xf5,
+	0x8c, 0x1d, 0xe3, 0xc4, 0x27, 0x2e, 0xd7, 0x36, 0x56, 0x2b, 0xdf, 0x6f, 0xeb, 0xca, 0x61, 0x5b,
+	0x57, 0xe5, 0x6f, 0xd7, 0x25, 0x40, 0x0b, 0xb0, 0x55, 0x9d, 0x2f, 0xd4, 0x2f, 0xc0, 0x34, 0xc7,
+	0xd2, 0x56, 0x82, 0x69, 0x8b, 0x04, 0xae, 0xcc, 0xe2, 0x63, 0x66, 0xff, 0xb0, 0xad, 0x2f, 0x8b,
+	0xbb, 0x86, 0xba, 0x5b, 0x86, 0x4f, 0xcc, 0x10, 0xa5, 0x2d, 0x63, 0x03, 0x7b, 0xc8, 0xd9, 0x5f,
+	0xc7, 0x4e, 0xb7, 0x7b, 0x7a, 0x5d, 0xc0, 0xdf, 0x7f, 0xbb, 0x0c, 0x84, 0x9d, 0xb1, 0x8e, 0x1d,
+	0x6b, 0x8a, 0xc1, 0x9f, 0x76, 0x50, 0xb5, 0x05, 0x4a, 0x09, 0xde, 0x45, 0x89, 0x6b, 0x37, 0x50,
+	0xe4, 0xca, 0x86, 0xfa, 0x70, 0xb8, 0x78, 0x32, 0x9d, 0x9c, 0x7d, 0x31, 0x18, 0x10, 0x58, 0x0d,
+	0x45, 0xae, 0x1a, 0x81, 0xc9, 0xdd, 0x96, 0x9f, 0xe2, 0xc0, 0xa7, 0xa9, 0x36, 0x56, 0x1d, 0x5d,
+	0x9d, 0xac, 0xd5, 0x65, 0x9c, 0xe7, 0x3a, 0x9d, 0x6e, 0x1b, 0x67, 0x6e, 0xa1, 0xd5, 0x0d, 0xc1,
+	0x8a, 0x49, 0x03, 0x44, 0x5b, 0x76, 0x33, 0x41, 0x0e, 0xbb, 0xdc, 0xb5, 0xd3, 0xcf, 0x51, 0xcc,
+	0x5e, 0x17, 0x7d, 0xc5, 0xe4, 0xf0, 0x4d, 0x89, 0xaa, 0xef, 0x83, 0x33, 0x82, 0xbf, 0xeb, 0x47,
+	0x2e, 0xd9, 0xd5, 0xc6, 0xf9, 0xe9, 0x2f, 0xcb, 0xd3, 0x9f, 0xcb, 0x7b, 0x14, 0x0c, 0x68, 0x95,
+	0xf8, 0xf2, 0x33, 0xbe, 0x52, 0xbf, 0x51, 0xc0, 0x7c, 0xe8, 0x47, 0x36, 0xbf, 0x8b, 0xd8, 0x1f,
+	0xd2, 0x71, 0xf4, 0x42, 0x55, 0xc9, 0x95, 0xeb, 0x18, 0xe5, 0xcb, 0x22, 0xce, 0x20, 0x47, 0x45,
+	0xfd, 0xb3, 0xa1, 0x1f, 0xdd, 0x61, 0x9c, 0x3a, 0x4e, 0xa4, 0x86, 0x9f, 0x15, 0x30, 0x9f, 0xee,
+	0xa2, 0xd8, 0x0e, 0x08, 0xd9, 0x6a, 0x20, 0x67, 0xab, 0xa3, 0x61, 0x82, 0xb7, 0xfb, 0x92, 0x21,
+	0x66, 0xa7, 0xd1, 0x99, 0x9d, 0xc6, 0xba, 0x9c, 0x9d, 0xb5, 0x5b, 0x4c, 0xde, 0xd3, 0xb6, 0x5e,
+	0x19, 0x64, 0xfe, 0x2a, 0x09, 0xfd, 0x14, 0x87, 0x71, 0xba, 0xdf, 0x55, 0x38, 0x88, 0x07, 0xef,
+	0xfe, 0xa5, 0x2b, 0x96, 0xca, 0xa0, 0x0d, 0x89, 0x48, 0x61, 0x6f, 0x01, 0xc0, 0x53, 0x22, 0x29,
+	0x4e, 0xa8, 0x36, 0xc9, 0x4b, 0xbb, 0x24, 0x4b, 0x3b, 0x9b, 0x4b, 0x99, 0xe3, 0xd0, 0x9a, 0x64,
+	0xa9, 0xf1, 0x6f, 0xf5, 0x6b, 0x30, 0x97, 0xdd, 0xee, 0x76, 0x13, 0xf3, 0xb1, 0xe2, 0x13, 0x0d,
+	0xf0, 0xa2, 0xde, 0x1e, 0xae, 0xa8, 0xe5, 0xc2, 0xc4, 0xe8, 0xfa, 0xe9, 0xab, 0x69, 0xc6, 0xb9,
+	0x89, 0xd9, 0xa8, 0xf0, 0x89, 0xba, 0x09, 0x66, 0xf1, 0x5e, 0xec, 0x8b, 0x3a, 0xd9, 0x8d, 0x80,
+	0x38, 0x5b, 0x54, 0x2b, 0xf1, 0x0c, 0xaa, 0x32, 0x03, 0xad, 0x33, 0xf9, 0x0a, 0x34, 0x68, 0xcd,
+	0x74, 0xf7, 0x6a, 0x7c, 0x4b, 0xf5, 0x80, 0xc6, 0x2e, 0x26, 0x1b, 0xc9, 0xc1, 0xc4, 0xd8, 0x0e,
+	0x89, 0x9a, 0xbe, 0x47, 0xb5, 0x33, 0xd5, 0xd1, 0xd5, 0xd2, 0xda, 0xc5, 0xfe, 0x4b, 0x99, 0xb5,
+	0xcc, 0xb5, 0xae, 0xc1, 0x75, 0xce, 0xaf, 0x8d, 0xb1, 0xfb, 0xd1, 0x5a, 0x8c, 0x07, 0x81, 0x54,
+	0xfd, 0x0a, 0x4c, 0xe3, 0x10, 0xd9, 0x2d, 0x14, 0x34, 0xed, 0xc0, 0xdf, 0xc1, 0x54, 0x9b, 0xaa,
+	0x8e, 0x1e, 0xfd, 0x13, 0xbc, 0xc7, 0x1c, 0x3e, 0x6d, 0xeb, 0x5a, 0xaf, 0x61, 0xcf, 0xf1, 0x77,
+	0xa6, 0x7c, 0x0f, 0x43, 0x1c, 0xfc, 0x19, 0x1c, 0xa2, 0x8f, 0x50, 0xd0, 0xdc, 0x60, 0x5b, 0xaa,
+	0x09, 0xe6, 0x9d, 0x00, 0xa3, 0xc4, 0x2e, 0xa8, 0x98, 0xae, 0x2a, 0xab, 0x13, 0xd6, 0x2c, 0xc7,
+	0x6e, 0xe4, 0x0c, 0xae, 0x4e, 0xdc, 0x3d, 0xd0, 0x95, 0x27, 0x07, 0xba, 0xb2, 0xf6, 0xdf, 0x69,
+	0x30, 0xca, 0xee, 0xe3, 0x5f, 0x14, 0xb0, 0x72, 0xe4, 0x6b, 0xec, 0x4a, 0x7f, 0xa9, 0x8e, 0x79,
+	0x0f, 0x95, 0xdf, 0x3e, 0xb1, 0x49, 0x36, 0x32, 0x2a, 0xdf, 0xfe, 0xf1, 0xcf, 0x4f, 0xa7, 0x34,
+	0xb8, 0x68, 0xf6, 0x3e, 0x31, 0x63, 0xa9, 0xe6, 0x40, 0x01, 0x4b, 0xcf, 0x7e, 0x5f, 0x19, 0xc3,
+	0x07, 0x66, 0xfc, 0xf2, 0x9b, 0x27, 0xe3, 0x67, 0x2a, 0x97, 0xb9, 0xca, 0x05, 0x38, 0x57, 0x50,
+	0xc9, 0x25, 0xde, 0x53, 0xc0, 0xdc, 0xa0, 0x97, 0xce, 0xea, 0xc0, 0x60, 0x03, 0x98, 0xe5, 0xd7,
+	0x86, 0x65, 0x66, 0x82, 0x2e, 0x70, 0x41, 0x55, 0x58, 0x29, 0x08, 0x12, 0xaf, 0xbc, 0xcb, 0x9d,
+	0xb7, 0x90, 0xfa, 0xbd, 0x02, 0x66, 0xfa, 0x1e, 0x37, 0xaf, 0x0c, 0x0c, 0x57, 0xa4, 0x95, 0x2f,
+	0x0f, 0x45, 0xcb, 0x24, 0x9d, 0xe7, 0x92, 0xce, 0xc1, 0x85, 0xe2, 0x49, 0x8a, 0xa0, 0x5f, 0x02,
+	0x90, 0x7b, 0xac, 0xe8, 0x03, 0x7d, 0x77, 0x09, 0xe5, 0x8b, 0xc7, 0x10, 0xb2, 0xb0, 0x90, 0x87,
+	0x5d, 0x81, 0xe5, 0x42, 0xd8, 0x84, 0x53, 0x6d, 0xd6, 0xc8, 0xb5, 0x5b, 0xf7, 0x1f, 0x55, 0x94,
+	0x07, 0x8f, 0x2a, 0xca, 0xdf, 0x8f, 0x2a, 0xca, 0x8f, 0x8f, 0x2b, 0x23, 0x0f, 0x1e, 0x57, 0x46,
+	0xfe, 0x7c, 0x5c, 0x19, 0xf9, 0xdc, 0x1c, 0x62, 0xd0, 0x4a, 0x87, 0xe9, 0x7e, 0x8c, 0x69, 0x63,
+	0x9c, 0x77, 0xf8, 0xeb, 0xff, 0x0f, 0x00, 0xc1, 0x6b, 0x6b, 0x91, 0x93, 0x0d, 0x00, 0x00,
 }
 
 func (this *OracleParamsMsg) Equal(that interface{}) bool {
@@ -635,6 +761,25 @@ func (this *OracleParamsMsg) Equal(that interface{}) bool {
 	if this.ExpirationBlocks != that1.ExpirationBlocks {
 		return false
 	}
+	if len(this.PairAggregationConfigs) != len(that1.PairAggregationConfigs) {
+		return false
+	}
+	for i := range this.PairAggregationConfigs {
+		if !this.PairAggregationConfigs[i].Equal(&that1.PairAggregationConfigs[i]) {
+			return false
+		}
+	}
+	if len(this.EmaHalfLives) != len(that1.EmaHalfLives) {
+		return false
+	}
+	for i := range this.EmaHalfLives {
+		if this.EmaHalfLives[i] != that1.EmaHalfLives[i] {
+			return false
+		}
+	}
+	if this.ClearEmaHalfLives != that1.ClearEmaHalfLives {
+		return false
+	}
 	return true
 }
 
@@ -661,6 +806,10 @@ type MsgClient interface {
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(ctx context.Context, in *MsgEditOracleParams, opts ...grpc.CallOption) (*MsgEditOracleParamsResponse, error)
+	// ResumePair lifts the circuit breaker of a halted pair and accepts its
+	// current exchange rate as the new last good price.
+	// [SUDO] Only callable by sudoers.
+	ResumePair(ctx context.Context, in *MsgResumePair, opts ...grpc.CallOption) (*MsgResumePairResponse, error)
 }
 
 type msgClient struct {
@@ -707,6 +856,15 @@ func (c *msgClient) EditOracleParams(ctx context.Context, in *MsgEditOracleParam
 	return out, nil
 }
 
+func (c *msgClient) ResumePair(ctx context.Context, in *MsgResumePair, opts ...grpc.CallOption) (*MsgResumePairResponse, error) {
+	out := new(MsgResumePairResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/ResumePair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AggregateExchangeRatePrevote defines a method for submitting
@@ -720,6 +878,10 @@ type MsgServer interface {
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(context.Context, *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error)
+	// ResumePair lifts the circuit breaker of a halted pair and accepts its
+	// current exchange rate as the new last good price.
+	// [SUDO] Only callable by sudoers.
+	ResumePair(context.Context, *MsgResumePair) (*MsgResumePairResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -738,6 +900,9 @@ func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) EditOracleParams(ctx context.Context, req *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditOracleParams not implemented")
 }
+func (*UnimplementedMsgServer) ResumePair(ctx context.Context, req *MsgResumePair) (*MsgResumePairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumePair not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -815,6 +980,24 @@ func _Msg_EditOracleParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ResumePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumePair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumePair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Msg/ResumePair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumePair(ctx, req.(*MsgResumePair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -835,6 +1018,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EditOracleParams",
 			Handler:    _Msg_EditOracleParams_Handler,
 		},
+		{
+			MethodName: "ResumePair",
+			Handler:    _Msg_ResumePair_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/oracle/v1/tx.proto",
@@ -1106,6 +1293,69 @@ func (m *MsgEditOracleParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgResumePair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumePair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumePair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumePairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumePairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumePairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *OracleParamsMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1126,6 +1376,42 @@ func (m *OracleParamsMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClearEmaHalfLives {
+		i--
+		if m.ClearEmaHalfLives {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.EmaHalfLives) > 0 {
+		for iNdEx := len(m.EmaHalfLives) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EmaHalfLives[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmaHalfLives[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintTx(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PairAggregationConfigs) > 0 {
+		for iNdEx := len(m.PairAggregationConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairAggregationConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if m.ExpirationBlocks != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationBlocks))
 		i--
@@ -1360,6 +1646,30 @@ func (m *MsgEditOracleParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgResumePair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgResumePairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *OracleParamsMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1408,6 +1718,21 @@ func (m *OracleParamsMsg) Size() (n int) {
 	if m.ExpirationBlocks != 0 {
 		n += 1 + sovTx(uint64(m.ExpirationBlocks))
 	}
+	if len(m.PairAggregationConfigs) > 0 {
+		for _, e := range m.PairAggregationConfigs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.EmaHalfLives) > 0 {
+		for _, e := range m.EmaHalfLives {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ClearEmaHalfLives {
+		n += 2
+	}
 	return n
 }
 
@@ -2173,6 +2498,172 @@ func (m *MsgEditOracleParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgResumePair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumePair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumePair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumePairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumePairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumePairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OracleParamsMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2530,6 +3021,94 @@ func (m *OracleParamsMsg) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairAggregationConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairAggregationConfigs = append(m.PairAggregationConfigs, PairAggregationConfig{})
+			if err := m.PairAggregationConfigs[len(m.PairAggregationConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaHalfLives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmaHalfLives = append(m.EmaHalfLives, time.Duration(0))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.EmaHalfLives[len(m.EmaHalfLives)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearEmaHalfLives", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearEmaHalfLives = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
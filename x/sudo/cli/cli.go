@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/NibiruChain/nibiru/v2/x/sudo/types"
@@ -29,6 +30,13 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		CmdEditSudoers(),
 		CmdChangeRoot(),
+		CmdProposeRoot(),
+		CmdApproveRoot(),
+		CmdCancelRootChange(),
+		CmdScheduleSudo(),
+		CmdCancelScheduledSudo(),
+		CmdRegisterCronJob(),
+		CmdDeleteCronJob(),
 	)
 
 	return txCmd
@@ -48,6 +56,9 @@ func GetQueryCmd() *cobra.Command {
 	// Add subcommands
 	cmds := []*cobra.Command{
 		CmdQuerySudoers(),
+		CmdQueryRootChanges(),
+		CmdQueryScheduledSudoActions(),
+		CmdQueryCronJobs(),
 	}
 	for _, cmd := range cmds {
 		moduleQueryCmd.AddCommand(cmd)
@@ -159,6 +170,373 @@ func CmdChangeRoot() *cobra.Command {
 	return cmd
 }
 
+// CmdProposeRoot is a terminal command corresponding to the ProposeRoot
+// function of the sdk.Msg handler for x/sudo.
+func CmdProposeRoot() *cobra.Command {
+	const (
+		FlagDelayBlocks  = "new-delay-blocks"
+		FlagCosigners    = "new-cosigners"
+		FlagMinApprovals = "new-min-approvals"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "propose-root [new-root-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Propose a timelocked change of the root address of the x/sudo state",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx sudo propose-root <new-root-address> --from=<key_or_address>
+			$ %s tx sudo propose-root <new-root-address> --new-delay-blocks=14400 \
+				--new-cosigners=<addr1>,<addr2>,<addr3> --new-min-approvals=2 --from=<key_or_address>
+			`, version.AppName, version.AppName)),
+		Long: strings.TrimSpace(
+			`Propose a change of the root address of the x/sudo state. The change
+			takes effect after the delay of the root change policy has passed and
+			enough co-signers approved it. Must be executed by the current root.
+
+			Passing any of the "--new-*" flags replaces the root change policy
+			along with the root.
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProposeRoot{
+				Sender:  clientCtx.GetFromAddress().String(),
+				NewRoot: args[0],
+			}
+
+			flagSet := cmd.Flags()
+			if flagSet.Changed(FlagDelayBlocks) || flagSet.Changed(FlagCosigners) ||
+				flagSet.Changed(FlagMinApprovals) {
+				policy := new(types.RootChangePolicy)
+				if policy.DelayBlocks, err = flagSet.GetUint64(FlagDelayBlocks); err != nil {
+					return err
+				}
+				if policy.Cosigners, err = flagSet.GetStringSlice(FlagCosigners); err != nil {
+					return err
+				}
+				if policy.MinApprovals, err = flagSet.GetUint64(FlagMinApprovals); err != nil {
+					return err
+				}
+				msg.NewPolicy = policy
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagDelayBlocks, 0, "blocks to wait before future root changes take effect")
+	cmd.Flags().StringSlice(FlagCosigners, []string{}, "addresses allowed to approve future root changes")
+	cmd.Flags().Uint64(FlagMinApprovals, 0, "number of cosigner approvals required for future root changes")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdApproveRoot is a terminal command corresponding to the ApproveRoot
+// function of the sdk.Msg handler for x/sudo.
+func CmdApproveRoot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-root [root-change-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Approve a pending root change as a co-signer",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx sudo approve-root 0 --from=<key_or_address>
+			`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid root change id %s: %w", args[0], err)
+			}
+
+			msg := &types.MsgApproveRoot{
+				Sender: clientCtx.GetFromAddress().String(),
+				Id:     id,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCancelRootChange is a terminal command corresponding to the
+// CancelRootChange function of the sdk.Msg handler for x/sudo.
+func CmdCancelRootChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-root-change [root-change-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a pending root change as the root or a co-signer",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx sudo cancel-root-change 0 --from=<key_or_address>
+			`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid root change id %s: %w", args[0], err)
+			}
+
+			msg := &types.MsgCancelRootChange{
+				Sender: clientCtx.GetFromAddress().String(),
+				Id:     id,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdScheduleSudo is a terminal command corresponding to the ScheduleSudo
+// function of the sdk.Msg handler for x/sudo.
+func CmdScheduleSudo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [msgs-json] [execution-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Schedule sudo messages for execution at a future block height",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx sudo schedule <path/to/msgs.json> 1000000 --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Queues sudo-gated messages, like "MsgEditOracleParams", that are executed
+			atomically in the EndBlocker of the execution height. Every message must
+			be signed by the sender of the transaction. The execution height must be
+			at least the minimum delay of the scheduled sudo policy after the
+			current block height.
+
+			The msgs.json for 'ScheduleSudo' is of the form:
+			{
+			  "messages": [
+			    {
+			      "@type": "/nibiru.oracle.v1.MsgEditOracleParams",
+			      "sender": "...",
+			      "params": { ... }
+			    }
+			  ]
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := new(types.MsgScheduleSudo)
+
+			// marshals contents into the proto.Message to which 'msg' points.
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			if err = clientCtx.Codec.UnmarshalJSON(contents, msg); err != nil {
+				return err
+			}
+
+			msg.ExecutionHeight, err = strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid execution height %s: %w", args[1], err)
+			}
+
+			// Parse the message sender
+			from := clientCtx.GetFromAddress()
+			msg.Sender = from.String()
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCancelScheduledSudo is a terminal command corresponding to the
+// CancelScheduledSudo function of the sdk.Msg handler for x/sudo.
+func CmdCancelScheduledSudo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled [action-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a scheduled sudo action as the root or its sender",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx sudo cancel-scheduled 0 --from=<key_or_address>
+			`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid action id %s: %w", args[0], err)
+			}
+
+			msg := &types.MsgCancelScheduledSudo{
+				Sender: clientCtx.GetFromAddress().String(),
+				Id:     id,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdRegisterCronJob is a terminal command corresponding to the
+// RegisterCronJob function of the sdk.Msg handler for x/sudo.
+func CmdRegisterCronJob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-cron-job [job-json]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Register messages and EVM and Wasm calls to run at the end of every epoch",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx sudo register-cron-job <path/to/job.json> --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Registers a cron job that runs at the end of every epoch with the epoch
+			identifier. The messages run first, followed by the EVM calls and then the
+			Wasm calls, which are made from the sender. The job is applied atomically within its gas limit,
+			and failures are recorded on the job without halting the chain. Every
+			message must be signed by the sender of the transaction, which must be a
+			sudoer or the governance module account.
+
+			The job.json for 'RegisterCronJob' is of the form:
+			{
+			  "epoch_identifier": "day",
+			  "messages": [
+			    {
+			      "@type": "/cosmos.bank.v1beta1.MsgSend",
+			      "from_address": "...",
+			      "to_address": "...",
+			      "amount": [ ... ]
+			    }
+			  ],
+			  "evm_calls": [
+			    { "contract": "0x...", "input": "<base64 call data>" }
+			  ],
+			  "wasm_calls": [
+			    { "contract": "nibi1...", "msg": "<base64 JSON execute msg>", "funds": [] }
+			  ],
+			  "gas_limit": "1000000"
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := new(types.MsgRegisterCronJob)
+
+			// marshals contents into the proto.Message to which 'msg' points.
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			if err = clientCtx.Codec.UnmarshalJSON(contents, msg); err != nil {
+				return err
+			}
+
+			// Parse the message sender
+			from := clientCtx.GetFromAddress()
+			msg.Sender = from.String()
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdDeleteCronJob is a terminal command corresponding to the DeleteCronJob
+// function of the sdk.Msg handler for x/sudo.
+func CmdDeleteCronJob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-cron-job [job-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delete a cron job as the root, its sender, or governance",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx sudo delete-cron-job 0 --from=<key_or_address>
+			`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid job id %s: %w", args[0], err)
+			}
+
+			msg := &types.MsgDeleteCronJob{
+				Sender: clientCtx.GetFromAddress().String(),
+				Id:     id,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQuerySudoers() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "state",
@@ -188,3 +566,92 @@ func CmdQuerySudoers() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryRootChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "root-changes",
+		Short: "displays the root change policy and pending root changes of the x/sudo module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.QueryRootChanges(
+				cmd.Context(), new(types.QueryRootChangesRequest),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryScheduledSudoActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled",
+		Short: "displays the scheduled sudo policy and the sudo actions waiting for their execution height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.QueryScheduledSudoActions(
+				cmd.Context(), new(types.QueryScheduledSudoActionsRequest),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryCronJobs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cron-jobs [epoch-identifier]",
+		Short: "displays the cron jobs, optionally only those of an epoch identifier",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := new(types.QueryCronJobsRequest)
+			if len(args) == 1 {
+				req.EpochIdentifier = args[0]
+			}
+			resp, err := queryClient.QueryCronJobs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
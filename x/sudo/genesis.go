@@ -1,6 +1,7 @@
 package sudo
 
 import (
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/v2/x/sudo/keeper"
@@ -13,6 +14,35 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		panic(err)
 	}
 	k.Sudoers.Set(ctx, genState.Sudoers)
+	k.RootChangePolicy.Set(ctx, genState.RootChangePolicy)
+	k.ScheduledSudoPolicy.Set(ctx, genState.ScheduledSudoPolicy)
+
+	var nextId uint64
+	for _, pending := range genState.PendingRootChanges {
+		k.PendingRootChanges.Insert(ctx, pending.Id, pending)
+		if pending.Id >= nextId {
+			nextId = pending.Id + 1
+		}
+	}
+	k.NextRootChangeID.Set(ctx, nextId)
+
+	nextId = 0
+	for _, action := range genState.ScheduledSudoActions {
+		k.SetScheduledSudoAction(ctx, action)
+		if action.Id >= nextId {
+			nextId = action.Id + 1
+		}
+	}
+	k.NextScheduledSudoID.Set(ctx, nextId)
+
+	nextId = 0
+	for _, job := range genState.CronJobs {
+		k.CronJobs.Insert(ctx, job.Id, job)
+		if job.Id >= nextId {
+			nextId = job.Id + 1
+		}
+	}
+	k.NextCronJobID.Set(ctx, nextId)
 }
 
 // ExportGenesis returns the module's exported genesis state.
@@ -24,7 +54,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		Sudoers: pbSudoers,
+		Sudoers:            pbSudoers,
+		RootChangePolicy:   k.GetRootChangePolicy(ctx),
+		PendingRootChanges: k.PendingRootChanges.Iterate(ctx, collections.Range[uint64]{}).Values(),
+		ScheduledSudoActions: k.ScheduledSudoActions.Iterate(
+			ctx, collections.Range[uint64]{},
+		).Values(),
+		CronJobs:            k.CronJobs.Iterate(ctx, collections.Range[uint64]{}).Values(),
+		ScheduledSudoPolicy: k.GetScheduledSudoPolicy(ctx),
 	}
 }
 
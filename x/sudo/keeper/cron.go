@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
+	sudotypes "github.com/NibiruChain/nibiru/v2/x/sudo/types"
+)
+
+// ————————————————————————————————————————————————————————————————————————————
+// Cron jobs (epoch-scheduled messages and EVM and Wasm calls)
+// ————————————————————————————————————————————————————————————————————————————
+
+// RegisterCronJob executes a MsgRegisterCronJob. The sender must be a sudoer or
+// the governance module account, and every wrapped message must be a sudo msg
+// (see [sudotypes.SudoMsgTypeURLs]) that is routable and signed by the sender. The job runs at the end of every epoch with its
+// epoch identifier, where the handler of each message performs its own
+// permission checks.
+func (k Keeper) RegisterCronJob(
+	goCtx context.Context, msg *sudotypes.MsgRegisterCronJob,
+) (*sudotypes.MsgRegisterCronJobResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkCronJobSender(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	sdkMsgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+	for idx, sdkMsg := range sdkMsgs {
+		if err := sudotypes.ValidateSudoMsgType(sdkMsg); err != nil {
+			return nil, sudotypes.ErrCronJob(fmt.Sprintf("msg %d: %s", idx, err))
+		}
+		if k.router.Handler(sdkMsg) == nil {
+			return nil, sudotypes.ErrCronJob(fmt.Sprintf(
+				"msg %d: no handler for message %T", idx, sdkMsg,
+			))
+		}
+	}
+
+	job := sudotypes.CronJob{
+		Id:              k.NextCronJobID.Next(ctx),
+		Sender:          msg.Sender,
+		EpochIdentifier: msg.EpochIdentifier,
+		Messages:        msg.Messages,
+		EvmCalls:        msg.EvmCalls,
+		WasmCalls:       msg.WasmCalls,
+		GasLimit:        msg.GasLimit,
+		CreatedHeight:   ctx.BlockHeight(),
+	}
+	k.CronJobs.Insert(ctx, job.Id, job)
+
+	return &sudotypes.MsgRegisterCronJobResponse{Id: job.Id},
+		ctx.EventManager().EmitTypedEvent(&sudotypes.EventCronJobRegistered{
+			Job: job,
+		})
+}
+
+// DeleteCronJob executes a MsgDeleteCronJob. The root, the sender of the job,
+// or the governance module account can delete it.
+func (k Keeper) DeleteCronJob(
+	goCtx context.Context, msg *sudotypes.MsgDeleteCronJob,
+) (*sudotypes.MsgDeleteCronJobResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	job, err := k.CronJobs.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, sudotypes.ErrCronJob(fmt.Sprintf("no cron job with id %d", msg.Id))
+	}
+
+	sudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sudoers: %w", err)
+	}
+	if msg.Sender != sudoers.Root && msg.Sender != job.Sender && msg.Sender != govModuleAddr.String() {
+		return nil, sdkioerrors.Wrapf(
+			sudotypes.ErrUnauthorized,
+			"cron jobs can only be deleted by the root, their sender, or governance",
+		)
+	}
+
+	if err := k.CronJobs.Delete(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &sudotypes.MsgDeleteCronJobResponse{},
+		ctx.EventManager().EmitTypedEvent(&sudotypes.EventCronJobDeleted{
+			Id:     msg.Id,
+			Sender: msg.Sender,
+		})
+}
+
+var govModuleAddr = authtypes.NewModuleAddress(govtypes.ModuleName)
+
+// checkCronJobSender returns an error unless the sender is the governance
+// module account or a sudoer.
+func (k Keeper) checkCronJobSender(ctx sdk.Context, sender sdk.AccAddress) error {
+	if sender.Equals(govModuleAddr) {
+		return nil
+	}
+	return k.CheckPermissions(sender, ctx)
+}
+
+// Hooks implements [epochstypes.EpochHooks] to run the cron jobs at the end of
+// every epoch. Hooks is meant for use with `EpochsKeeper.SetHooks`.
+type Hooks struct {
+	K Keeper
+	// EvmKeeper executes the EVM calls of cron jobs. If nil, jobs with EVM
+	// calls fail.
+	EvmKeeper sudotypes.EvmKeeper
+	// WasmKeeper executes the Wasm calls of cron jobs. If nil, jobs with Wasm
+	// calls fail.
+	WasmKeeper sudotypes.WasmKeeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks returns the epoch hooks that run the cron jobs.
+func (k Keeper) Hooks(
+	evmKeeper sudotypes.EvmKeeper, wasmKeeper sudotypes.WasmKeeper,
+) Hooks {
+	return Hooks{K: k, EvmKeeper: evmKeeper, WasmKeeper: wasmKeeper}
+}
+
+// BeforeEpochStart is a hook that runs just prior to the start of a new epoch.
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+	// Perform no operations; cron jobs run at the end of the epoch.
+	_, _, _ = ctx, epochIdentifier, epochNumber
+}
+
+// AfterEpochEnd runs every cron job registered for the epoch identifier.
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+	h.K.ExecuteCronJobs(ctx, h.EvmKeeper, h.WasmKeeper, epochIdentifier, epochNumber)
+}
+
+// ExecuteCronJobs runs the cron jobs of the epoch identifier in order of ID.
+// Each job runs atomically with its own gas limit. Failures, including running
+// out of gas, are recorded on the job and reported with an
+// EventCronJobExecuted instead of halting the chain.
+func (k Keeper) ExecuteCronJobs(
+	ctx sdk.Context,
+	evmKeeper sudotypes.EvmKeeper,
+	wasmKeeper sudotypes.WasmKeeper,
+	epochIdentifier string,
+	epochNumber uint64,
+) {
+	for _, job := range k.CronJobs.Iterate(ctx, collections.Range[uint64]{}).Values() {
+		if job.EpochIdentifier != epochIdentifier {
+			continue
+		}
+
+		result := sudotypes.CronJobResult{
+			EpochNumber: epochNumber,
+			Height:      ctx.BlockHeight(),
+			Success:     true,
+		}
+		gasUsed, err := k.executeCronJob(ctx, evmKeeper, wasmKeeper, job)
+		result.GasUsed = gasUsed
+		if err != nil {
+			result.Success = false
+			result.Error = err.Error()
+			ctx.Logger().Error("failed to execute cron job", "id", job.Id, "error", err)
+		}
+
+		// The job may have deleted itself.
+		if job, err := k.CronJobs.Get(ctx, job.Id); err == nil {
+			job.Executions++
+			if !result.Success {
+				job.Failures++
+			}
+			job.LastResult = &result
+			k.CronJobs.Insert(ctx, job.Id, job)
+		}
+		_ = ctx.EventManager().EmitTypedEvent(&sudotypes.EventCronJobExecuted{
+			Id:              job.Id,
+			EpochIdentifier: epochIdentifier,
+			Result:          result,
+		})
+	}
+}
+
+// executeCronJob checks that the sender of the job still has sudo permissions,
+// then routes the messages and makes the EVM and Wasm calls of the job in a
+// cached context with the gas limit of the job. The context is only
+// written if everything succeeds.
+func (k Keeper) executeCronJob(
+	ctx sdk.Context,
+	evmKeeper sudotypes.EvmKeeper,
+	wasmKeeper sudotypes.WasmKeeper,
+	job sudotypes.CronJob,
+) (gasUsed uint64, err error) {
+	gasMeter := sdk.NewGasMeter(job.GasLimit)
+	defer func() {
+		// Overrides the gas used of every return.
+		gasUsed = gasMeter.GasConsumedToLimit()
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = fmt.Errorf("out of gas in location: %s; gas limit %d", outOfGas.Descriptor, job.GasLimit)
+				return
+			}
+			err = fmt.Errorf("panic during execution: %v", r)
+		}
+	}()
+
+	// The sender may have lost its sudo permissions since registration.
+	sender, err := sdk.AccAddressFromBech32(job.Sender)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.checkCronJobSender(ctx, sender); err != nil {
+		return 0, err
+	}
+
+	if err := job.UnpackInterfaces(k.cdc); err != nil {
+		return 0, err
+	}
+	sdkMsgs, err := job.GetMsgs()
+	if err != nil {
+		return 0, err
+	}
+	if len(job.EvmCalls) > 0 && evmKeeper == nil {
+		return 0, fmt.Errorf("evm calls are not supported")
+	}
+	if len(job.WasmCalls) > 0 && wasmKeeper == nil {
+		return 0, fmt.Errorf("wasm calls are not supported")
+	}
+
+	cacheCtx, writeCache := ctx.WithGasMeter(gasMeter).CacheContext()
+	for idx, sdkMsg := range sdkMsgs {
+		if err := sudotypes.ValidateSudoMsgType(sdkMsg); err != nil {
+			return 0, fmt.Errorf("msg %d: %w", idx, err)
+		}
+		handler := k.router.Handler(sdkMsg)
+		if handler == nil {
+			return 0, fmt.Errorf("msg %d: no handler for message %T", idx, sdkMsg)
+		}
+		if _, err := handler(cacheCtx, sdkMsg); err != nil {
+			return 0, fmt.Errorf("msg %d (%T): %w", idx, sdkMsg, err)
+		}
+	}
+
+	if len(job.EvmCalls) > 0 {
+		fromAcc := eth.NibiruAddrToEthAddr(sender)
+		for idx, call := range job.EvmCalls {
+			_, err := evmKeeper.CallContractFromAccount(
+				cacheCtx, fromAcc, gethcommon.HexToAddress(call.Contract), call.Input,
+				gasMeter.GasRemaining(),
+			)
+			if err != nil {
+				return 0, fmt.Errorf("evm call %d (%s): %w", idx, call.Contract, err)
+			}
+		}
+	}
+
+	for idx, call := range job.WasmCalls {
+		contract, err := sdk.AccAddressFromBech32(call.Contract)
+		if err != nil {
+			return 0, fmt.Errorf("wasm call %d: %w", idx, err)
+		}
+		if _, err := wasmKeeper.Execute(
+			cacheCtx, contract, sender, call.Msg, call.Funds,
+		); err != nil {
+			return 0, fmt.Errorf("wasm call %d (%s): %w", idx, call.Contract, err)
+		}
+	}
+	writeCache()
+	return 0, nil
+}
@@ -0,0 +1,414 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile/test"
+	"github.com/NibiruChain/nibiru/v2/x/sudo/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/sudo/types"
+)
+
+func TestCronJob(t *testing.T) {
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+
+	root := testutil.AccAddress().String()
+	stranger := testutil.AccAddress().String()
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root})
+	paramsBefore, err := nibiru.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	t.Log("Only sudoers and governance can register cron jobs")
+	_, err = msgServer.RegisterCronJob(sdk.WrapSDKContext(ctx), &types.MsgRegisterCronJob{
+		Sender:          stranger,
+		EpochIdentifier: "day",
+		Messages:        []*codectypes.Any{editOracleParamsAny(t, stranger, 42)},
+		GasLimit:        1_000_000,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	rootJob, err := msgServer.RegisterCronJob(sdk.WrapSDKContext(ctx), &types.MsgRegisterCronJob{
+		Sender:          root,
+		EpochIdentifier: "day",
+		Messages:        []*codectypes.Any{editOracleParamsAny(t, root, 42)},
+		GasLimit:        1_000_000,
+	})
+	require.NoError(t, err)
+
+	// Governance isn't a sudoer, so the oracle rejects its message when the
+	// job runs.
+	govJob, err := msgServer.RegisterCronJob(sdk.WrapSDKContext(ctx), &types.MsgRegisterCronJob{
+		Sender:          gov,
+		EpochIdentifier: "week",
+		Messages:        []*codectypes.Any{editOracleParamsAny(t, gov, 43)},
+		GasLimit:        1_000_000,
+	})
+	require.NoError(t, err)
+
+	querier := keeper.NewQuerier(k)
+	queryResp, err := querier.QueryCronJobs(
+		sdk.WrapSDKContext(ctx), &types.QueryCronJobsRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, queryResp.Jobs, 2)
+	queryResp, err = querier.QueryCronJobs(
+		sdk.WrapSDKContext(ctx), &types.QueryCronJobsRequest{EpochIdentifier: "day"},
+	)
+	require.NoError(t, err)
+	require.Len(t, queryResp.Jobs, 1)
+	require.Equal(t, rootJob.Id, queryResp.Jobs[0].Id)
+
+	t.Log("Failed jobs are recorded without halting the chain")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() {
+		k.ExecuteCronJobs(ctx, nil, nil, "week", 1)
+	})
+	params, err := nibiru.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, paramsBefore.VotePeriod, params.VotePeriod)
+	job, err := k.CronJobs.Get(ctx, govJob.Id)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, job.Executions)
+	require.EqualValues(t, 1, job.Failures)
+	require.False(t, job.LastResult.Success)
+	require.Contains(t, job.LastResult.Error, "unauthorized")
+
+	t.Log("Jobs run at the end of epochs with their identifier")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.Hooks(nil, nil).AfterEpochEnd(ctx, "day", 7)
+	params, err = nibiru.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), params.VotePeriod)
+	job, err = k.CronJobs.Get(ctx, rootJob.Id)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, job.Executions)
+	require.EqualValues(t, 0, job.Failures)
+	require.True(t, job.LastResult.Success)
+	require.EqualValues(t, 7, job.LastResult.EpochNumber)
+	require.NotZero(t, job.LastResult.GasUsed)
+	testutil.RequireContainsTypedEvent(t, ctx, &types.EventCronJobExecuted{
+		Id:              rootJob.Id,
+		EpochIdentifier: "day",
+		Result:          *job.LastResult,
+	})
+
+	t.Log("Cron jobs can be deleted by the root, their sender, or governance")
+	_, err = msgServer.DeleteCronJob(sdk.WrapSDKContext(ctx), &types.MsgDeleteCronJob{
+		Sender: stranger, Id: rootJob.Id,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.DeleteCronJob(sdk.WrapSDKContext(ctx), &types.MsgDeleteCronJob{
+		Sender: gov, Id: rootJob.Id,
+	})
+	require.NoError(t, err)
+	_, err = k.CronJobs.Get(ctx, rootJob.Id)
+	require.Error(t, err)
+}
+
+func TestCronJob_OutOfGas(t *testing.T) {
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+
+	root := testutil.AccAddress().String()
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root})
+	paramsBefore, err := nibiru.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	resp, err := msgServer.RegisterCronJob(sdk.WrapSDKContext(ctx), &types.MsgRegisterCronJob{
+		Sender:          root,
+		EpochIdentifier: "day",
+		Messages:        []*codectypes.Any{editOracleParamsAny(t, root, 42)},
+		GasLimit:        100,
+	})
+	require.NoError(t, err)
+
+	require.NotPanics(t, func() {
+		k.ExecuteCronJobs(ctx, nil, nil, "day", 1)
+	})
+	params, err := nibiru.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, paramsBefore.VotePeriod, params.VotePeriod)
+
+	job, err := k.CronJobs.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, job.Failures)
+	require.Contains(t, job.LastResult.Error, "out of gas")
+	require.EqualValues(t, 100, job.LastResult.GasUsed)
+}
+
+func TestCronJob_EvmCall(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+	k := deps.App.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+
+	sender := deps.Sender.NibiruAddr.String()
+	k.Sudoers.Set(deps.Ctx, types.Sudoers{Root: sender})
+
+	deployResp, err := evmtest.DeployContract(&deps, embeds.SmartContract_TestERC20)
+	require.NoError(t, err)
+	erc20Addr := deployResp.ContractAddr
+	recipient := evmtest.NewEthPrivAcc().EthAddr
+
+	input, err := embeds.SmartContract_TestERC20.ABI.Pack(
+		"transfer", recipient, big.NewInt(1000),
+	)
+	require.NoError(t, err)
+	resp, err := msgServer.RegisterCronJob(deps.GoCtx(), &types.MsgRegisterCronJob{
+		Sender:          sender,
+		EpochIdentifier: "day",
+		EvmCalls: []types.EvmCall{
+			{Contract: erc20Addr.Hex(), Input: input},
+		},
+		GasLimit: 1_000_000,
+	})
+	require.NoError(t, err)
+
+	dayJobId := resp.Id
+
+	t.Log("The EVM calls are made from the sender every epoch")
+	for epoch := uint64(1); epoch <= 2; epoch++ {
+		k.ExecuteCronJobs(deps.Ctx, deps.EvmKeeper, nil, "day", epoch)
+		job, err := k.CronJobs.Get(deps.Ctx, resp.Id)
+		require.NoError(t, err)
+		require.Truef(t, job.LastResult.Success, "error: %s", job.LastResult.Error)
+	}
+	evmObj, _ := deps.NewEVM()
+	evmtest.AssertERC20BalanceEqualWithDescription(
+		t, deps, evmObj, erc20Addr, recipient, big.NewInt(2000), "recipient",
+	)
+
+	t.Log("Reverted EVM calls fail the job")
+	input, err = embeds.SmartContract_TestERC20.ABI.Pack(
+		"transfer", recipient, new(big.Int).Lsh(big.NewInt(1), 200),
+	)
+	require.NoError(t, err)
+	resp, err = msgServer.RegisterCronJob(deps.GoCtx(), &types.MsgRegisterCronJob{
+		Sender:          sender,
+		EpochIdentifier: "week",
+		EvmCalls: []types.EvmCall{
+			{Contract: erc20Addr.Hex(), Input: input},
+		},
+		GasLimit: 1_000_000,
+	})
+	require.NoError(t, err)
+	k.ExecuteCronJobs(deps.Ctx, deps.EvmKeeper, nil, "week", 1)
+	job, err := k.CronJobs.Get(deps.Ctx, resp.Id)
+	require.NoError(t, err)
+	require.False(t, job.LastResult.Success)
+	require.Contains(t, job.LastResult.Error, "evm call 0")
+
+	t.Log("Jobs fail once their sender is no longer a sudoer")
+	k.Sudoers.Set(deps.Ctx, types.Sudoers{Root: testutil.AccAddress().String()})
+	k.ExecuteCronJobs(deps.Ctx, deps.EvmKeeper, nil, "day", 3)
+	job, err = k.CronJobs.Get(deps.Ctx, dayJobId)
+	require.NoError(t, err)
+	require.False(t, job.LastResult.Success)
+	require.Contains(t, job.LastResult.Error, "unauthorized")
+	evmObj, _ = deps.NewEVM()
+	evmtest.AssertERC20BalanceEqualWithDescription(
+		t, deps, evmObj, erc20Addr, recipient, big.NewInt(2000), "recipient",
+	)
+}
+
+func TestCronJob_WasmCall(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+	k := deps.App.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+	wasmKeeper := wasmkeeper.NewDefaultPermissionKeeper(deps.App.WasmKeeper)
+
+	sender := deps.Sender.NibiruAddr.String()
+	k.Sudoers.Set(deps.Ctx, types.Sudoers{Root: sender})
+
+	s := &suite.Suite{}
+	s.SetT(t)
+	wasmContracts := test.SetupWasmContracts(&deps, s)
+	wasmCounter := wasmContracts[1]
+
+	resp, err := msgServer.RegisterCronJob(deps.GoCtx(), &types.MsgRegisterCronJob{
+		Sender:          sender,
+		EpochIdentifier: "day",
+		WasmCalls: []types.WasmCall{
+			{Contract: wasmCounter.String(), Msg: []byte(`{"increment": {}}`)},
+		},
+		GasLimit: 1_000_000,
+	})
+	require.NoError(t, err)
+
+	t.Log("Jobs with Wasm calls fail without a Wasm keeper")
+	k.ExecuteCronJobs(deps.Ctx, deps.EvmKeeper, nil, "day", 1)
+	job, err := k.CronJobs.Get(deps.Ctx, resp.Id)
+	require.NoError(t, err)
+	require.False(t, job.LastResult.Success)
+	require.Contains(t, job.LastResult.Error, "wasm calls are not supported")
+	test.AssertWasmCounterState(s, deps, wasmCounter, 0)
+
+	t.Log("The Wasm calls are made from the sender every epoch")
+	for epoch := uint64(2); epoch <= 3; epoch++ {
+		k.ExecuteCronJobs(deps.Ctx, deps.EvmKeeper, wasmKeeper, "day", epoch)
+		job, err := k.CronJobs.Get(deps.Ctx, resp.Id)
+		require.NoError(t, err)
+		require.Truef(t, job.LastResult.Success, "error: %s", job.LastResult.Error)
+		require.NotZero(t, job.LastResult.GasUsed)
+	}
+	test.AssertWasmCounterState(s, deps, wasmCounter, 2)
+
+	t.Log("Wasm calls share the gas limit of the job")
+	resp, err = msgServer.RegisterCronJob(deps.GoCtx(), &types.MsgRegisterCronJob{
+		Sender:          sender,
+		EpochIdentifier: "week",
+		WasmCalls: []types.WasmCall{
+			{Contract: wasmCounter.String(), Msg: []byte(`{"increment": {}}`)},
+		},
+		GasLimit: 1_000,
+	})
+	require.NoError(t, err)
+	k.ExecuteCronJobs(deps.Ctx, deps.EvmKeeper, wasmKeeper, "week", 1)
+	job, err = k.CronJobs.Get(deps.Ctx, resp.Id)
+	require.NoError(t, err)
+	require.False(t, job.LastResult.Success)
+	require.Contains(t, job.LastResult.Error, "out of gas")
+	require.EqualValues(t, 1_000, job.LastResult.GasUsed)
+	test.AssertWasmCounterState(s, deps, wasmCounter, 2)
+
+	t.Log("Jobs fail once their sender is no longer a sudoer")
+	k.Sudoers.Set(deps.Ctx, types.Sudoers{Root: testutil.AccAddress().String()})
+	k.ExecuteCronJobs(deps.Ctx, deps.EvmKeeper, wasmKeeper, "day", 4)
+	job, err = k.CronJobs.Get(deps.Ctx, resp.Id-1)
+	require.NoError(t, err)
+	require.False(t, job.LastResult.Success)
+	require.Contains(t, job.LastResult.Error, "unauthorized")
+	test.AssertWasmCounterState(s, deps, wasmCounter, 2)
+}
+
+func TestMsgRegisterCronJob_ValidateBasic(t *testing.T) {
+	sender := testutil.AccAddress().String()
+	other := testutil.AccAddress().String()
+	for _, tc := range []struct {
+		name    string
+		msg     types.MsgRegisterCronJob
+		wantErr string
+	}{
+		{
+			name: "happy",
+			msg: types.MsgRegisterCronJob{
+				Sender:          sender,
+				EpochIdentifier: "day",
+				Messages:        []*codectypes.Any{editOracleParamsAny(t, sender, 10)},
+				EvmCalls: []types.EvmCall{
+					{Contract: "0x5FbDB2315678afecb367f032d93F642f64180aa3"},
+				},
+				WasmCalls: []types.WasmCall{
+					{Contract: other, Msg: []byte(`{"increment": {}}`)},
+				},
+				GasLimit: 1_000_000,
+			},
+		},
+		{
+			name: "nothing to execute",
+			msg: types.MsgRegisterCronJob{
+				Sender:          sender,
+				EpochIdentifier: "day",
+				GasLimit:        1_000_000,
+			},
+			wantErr: "no msgs, evm calls, or wasm calls",
+		},
+		{
+			name: "msg signed by someone else",
+			msg: types.MsgRegisterCronJob{
+				Sender:          sender,
+				EpochIdentifier: "day",
+				Messages:        []*codectypes.Any{editOracleParamsAny(t, other, 10)},
+				GasLimit:        1_000_000,
+			},
+			wantErr: "must be signed by the sender",
+		},
+		{
+			name: "bank send is not a sudo msg",
+			msg: types.MsgRegisterCronJob{
+				Sender:          sender,
+				EpochIdentifier: "day",
+				Messages: []*codectypes.Any{newAny(t, &banktypes.MsgSend{
+					FromAddress: sender, ToAddress: other, Amount: sdk.NewCoins(sdk.NewInt64Coin("unibi", 1)),
+				})},
+				GasLimit: 1_000_000,
+			},
+			wantErr: "is not a sudo msg",
+		},
+		{
+			name: "invalid contract",
+			msg: types.MsgRegisterCronJob{
+				Sender:          sender,
+				EpochIdentifier: "day",
+				EvmCalls:        []types.EvmCall{{Contract: "nibi1contract"}},
+				GasLimit:        1_000_000,
+			},
+			wantErr: "invalid contract address",
+		},
+		{
+			name: "invalid wasm contract",
+			msg: types.MsgRegisterCronJob{
+				Sender:          sender,
+				EpochIdentifier: "day",
+				WasmCalls: []types.WasmCall{
+					{Contract: "0x5FbDB2315678afecb367f032d93F642f64180aa3", Msg: []byte(`{}`)},
+				},
+				GasLimit: 1_000_000,
+			},
+			wantErr: "invalid contract address",
+		},
+		{
+			name: "wasm msg is not JSON",
+			msg: types.MsgRegisterCronJob{
+				Sender:          sender,
+				EpochIdentifier: "day",
+				WasmCalls:       []types.WasmCall{{Contract: other, Msg: []byte("increment")}},
+				GasLimit:        1_000_000,
+			},
+			wantErr: "valid JSON",
+		},
+		{
+			name: "empty epoch identifier",
+			msg: types.MsgRegisterCronJob{
+				Sender:   sender,
+				Messages: []*codectypes.Any{editOracleParamsAny(t, sender, 10)},
+				GasLimit: 1_000_000,
+			},
+			wantErr: "epoch identifier",
+		},
+		{
+			name: "gas limit too high",
+			msg: types.MsgRegisterCronJob{
+				Sender:          sender,
+				EpochIdentifier: "day",
+				Messages:        []*codectypes.Any{editOracleParamsAny(t, sender, 10)},
+				GasLimit:        types.MaxCronJobGasLimit + 1,
+			},
+			wantErr: "exceeds the maximum",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
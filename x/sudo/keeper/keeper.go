@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/NibiruChain/collections"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,16 +14,74 @@ import (
 	sudotypes "github.com/NibiruChain/nibiru/v2/x/sudo/types"
 )
 
+// MessageRouter ADR 031 request type routing
+type MessageRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
 type Keeper struct {
+	cdc    codec.Codec
+	router MessageRouter
+
 	Sudoers collections.Item[sudotypes.Sudoers]
+
+	// RootChangePolicy: Safeguards that apply when changing the root.
+	RootChangePolicy collections.Item[sudotypes.RootChangePolicy]
+	// PendingRootChanges: Proposed root changes, keyed by ID.
+	PendingRootChanges collections.Map[uint64, sudotypes.PendingRootChange]
+	// NextRootChangeID: Sequence of IDs for root change proposals.
+	NextRootChangeID collections.Sequence
+
+	// ScheduledSudoActions: Sudo actions queued for execution, keyed by ID.
+	ScheduledSudoActions collections.Map[uint64, sudotypes.ScheduledSudoAction]
+	// NextScheduledSudoID: Sequence of IDs for scheduled sudo actions.
+	NextScheduledSudoID collections.Sequence
+	// ScheduledSudoQueue: Index of the scheduled sudo actions keyed by
+	// (execution height, ID), so the EndBlocker only reads the due actions.
+	ScheduledSudoQueue collections.KeySet[collections.Pair[uint64, uint64]]
+	// ScheduledSudoPolicy: Safeguards that apply to scheduled sudo actions.
+	ScheduledSudoPolicy collections.Item[sudotypes.ScheduledSudoPolicy]
+
+	// CronJobs: Jobs executed at the end of every epoch, keyed by ID.
+	CronJobs collections.Map[uint64, sudotypes.CronJob]
+	// NextCronJobID: Sequence of IDs for cron jobs.
+	NextCronJobID collections.Sequence
 }
 
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	storeKey types.StoreKey,
+	router MessageRouter,
 ) Keeper {
 	return Keeper{
+		cdc:     cdc,
+		router:  router,
 		Sudoers: collections.NewItem(storeKey, 1, SudoersValueEncoder(cdc)),
+		RootChangePolicy: collections.NewItem(
+			storeKey, 2, collections.ProtoValueEncoder[sudotypes.RootChangePolicy](cdc),
+		),
+		PendingRootChanges: collections.NewMap(
+			storeKey, 3, collections.Uint64KeyEncoder,
+			collections.ProtoValueEncoder[sudotypes.PendingRootChange](cdc),
+		),
+		NextRootChangeID: collections.NewSequence(storeKey, 4),
+		ScheduledSudoActions: collections.NewMap(
+			storeKey, 5, collections.Uint64KeyEncoder,
+			collections.ProtoValueEncoder[sudotypes.ScheduledSudoAction](cdc),
+		),
+		NextScheduledSudoID: collections.NewSequence(storeKey, 6),
+		CronJobs: collections.NewMap(
+			storeKey, 7, collections.Uint64KeyEncoder,
+			collections.ProtoValueEncoder[sudotypes.CronJob](cdc),
+		),
+		NextCronJobID: collections.NewSequence(storeKey, 8),
+		ScheduledSudoQueue: collections.NewKeySet(
+			storeKey, 9,
+			collections.PairKeyEncoder(collections.Uint64KeyEncoder, collections.Uint64KeyEncoder),
+		),
+		ScheduledSudoPolicy: collections.NewItem(
+			storeKey, 10, collections.ProtoValueEncoder[sudotypes.ScheduledSudoPolicy](cdc),
+		),
 	}
 }
 
@@ -51,12 +51,61 @@ func (m MsgServer) ChangeRoot(ctx context.Context, msg *sudotypes.MsgChangeRoot)
 		return nil, err
 	}
 
-	pbSudoers.Root = msg.NewRoot
-	m.keeper.Sudoers.Set(sdkContext, pbSudoers)
+	if m.keeper.GetRootChangePolicy(sdkContext).IsTimelocked() {
+		return nil, sudotypes.ErrRootChange(
+			"root changes are timelocked by the root change policy: use MsgProposeRoot",
+		)
+	}
+
+	if err := m.keeper.setRoot(sdkContext, msg.NewRoot); err != nil {
+		return nil, err
+	}
 
 	return &sudotypes.MsgChangeRootResponse{}, nil
 }
 
+// ProposeRoot starts a timelocked change of the root user.
+func (m MsgServer) ProposeRoot(
+	goCtx context.Context, msg *sudotypes.MsgProposeRoot,
+) (*sudotypes.MsgProposeRootResponse, error) {
+	return m.keeper.ProposeRoot(goCtx, msg)
+}
+
+// ApproveRoot records a co-signer approval for a pending root change.
+func (m MsgServer) ApproveRoot(
+	goCtx context.Context, msg *sudotypes.MsgApproveRoot,
+) (*sudotypes.MsgApproveRootResponse, error) {
+	return m.keeper.ApproveRoot(goCtx, msg)
+}
+
+// CancelRootChange discards a pending root change.
+func (m MsgServer) CancelRootChange(
+	goCtx context.Context, msg *sudotypes.MsgCancelRootChange,
+) (*sudotypes.MsgCancelRootChangeResponse, error) {
+	return m.keeper.CancelRootChange(goCtx, msg)
+}
+
+// ScheduleSudo queues sudo messages for execution at a future block height.
+func (m MsgServer) ScheduleSudo(
+	goCtx context.Context, msg *sudotypes.MsgScheduleSudo,
+) (*sudotypes.MsgScheduleSudoResponse, error) {
+	return m.keeper.ScheduleSudo(goCtx, msg)
+}
+
+// CancelScheduledSudo discards a scheduled sudo action.
+func (m MsgServer) CancelScheduledSudo(
+	goCtx context.Context, msg *sudotypes.MsgCancelScheduledSudo,
+) (*sudotypes.MsgCancelScheduledSudoResponse, error) {
+	return m.keeper.CancelScheduledSudo(goCtx, msg)
+}
+
+// UpdateScheduledSudoPolicy replaces the safeguards of scheduled sudo actions.
+func (m MsgServer) UpdateScheduledSudoPolicy(
+	goCtx context.Context, msg *sudotypes.MsgUpdateScheduledSudoPolicy,
+) (*sudotypes.MsgUpdateScheduledSudoPolicyResponse, error) {
+	return m.keeper.UpdateScheduledSudoPolicy(goCtx, msg)
+}
+
 func (m MsgServer) validateRootPermissions(pbSudoers sudotypes.Sudoers, msg *sudotypes.MsgChangeRoot) error {
 	root, err := sdk.AccAddressFromBech32(pbSudoers.Root)
 	if err != nil {
@@ -126,3 +175,18 @@ func (sudo *Sudoers) RemoveContracts(contracts []string) {
 		sudo.Contracts.Remove(contract)
 	}
 }
+
+// RegisterCronJob registers messages and EVM calls to run at the end of every
+// epoch of an epoch identifier.
+func (m MsgServer) RegisterCronJob(
+	goCtx context.Context, msg *sudotypes.MsgRegisterCronJob,
+) (*sudotypes.MsgRegisterCronJobResponse, error) {
+	return m.keeper.RegisterCronJob(goCtx, msg)
+}
+
+// DeleteCronJob removes a cron job.
+func (m MsgServer) DeleteCronJob(
+	goCtx context.Context, msg *sudotypes.MsgDeleteCronJob,
+) (*sudotypes.MsgDeleteCronJobResponse, error) {
+	return m.keeper.DeleteCronJob(goCtx, msg)
+}
@@ -45,6 +45,7 @@ func TestGenesis(t *testing.T) {
 						testutil.AccAddress().String(),
 					},
 				},
+				ScheduledSudoPolicy: types.ScheduledSudoPolicy{MinDelayBlocks: 100},
 			},
 			empty: false,
 		},
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"github.com/NibiruChain/collections"
+
 	"github.com/NibiruChain/nibiru/v2/x/sudo/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,3 +38,52 @@ func (q Querier) QuerySudoers(
 		Sudoers: sudoers,
 	}, err
 }
+
+func (q Querier) QueryRootChanges(
+	goCtx context.Context,
+	req *types.QueryRootChangesRequest,
+) (*types.QueryRootChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryRootChangesResponse{
+		Policy:  q.keeper.GetRootChangePolicy(ctx),
+		Pending: q.keeper.PendingRootChanges.Iterate(ctx, collections.Range[uint64]{}).Values(),
+	}, nil
+}
+
+func (q Querier) QueryScheduledSudoActions(
+	goCtx context.Context,
+	req *types.QueryScheduledSudoActionsRequest,
+) (*types.QueryScheduledSudoActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryScheduledSudoActionsResponse{
+		Actions: q.keeper.ScheduledSudoActions.Iterate(ctx, collections.Range[uint64]{}).Values(),
+		Policy:  q.keeper.GetScheduledSudoPolicy(ctx),
+	}, nil
+}
+
+func (q Querier) QueryCronJobs(
+	goCtx context.Context,
+	req *types.QueryCronJobsRequest,
+) (*types.QueryCronJobsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	jobs := []types.CronJob{}
+	for _, job := range q.keeper.CronJobs.Iterate(ctx, collections.Range[uint64]{}).Values() {
+		if req.EpochIdentifier != "" && job.EpochIdentifier != req.EpochIdentifier {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	return &types.QueryCronJobsResponse{Jobs: jobs}, nil
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	sudotypes "github.com/NibiruChain/nibiru/v2/x/sudo/types"
+)
+
+// ————————————————————————————————————————————————————————————————————————————
+// Timelocked root changes
+// ————————————————————————————————————————————————————————————————————————————
+
+// GetRootChangePolicy returns the safeguards that apply when changing the root.
+// An unset policy is equivalent to no delay and no co-signers.
+func (k Keeper) GetRootChangePolicy(ctx sdk.Context) sudotypes.RootChangePolicy {
+	return k.RootChangePolicy.GetOr(ctx, sudotypes.RootChangePolicy{})
+}
+
+// ProposeRoot executes a MsgProposeRoot. The change is stored as pending and
+// takes effect in the EndBlocker once the policy's delay has passed and enough
+// co-signers approved it. Only one root change can be pending at a time.
+func (k Keeper) ProposeRoot(
+	goCtx context.Context, msg *sudotypes.MsgProposeRoot,
+) (*sudotypes.MsgProposeRootResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sudoers: %w", err)
+	}
+	if msg.Sender != sudoers.Root {
+		return nil, sdkioerrors.Wrapf(
+			sudotypes.ErrUnauthorized, "root changes must be proposed by the root: %s", sudoers.Root,
+		)
+	}
+
+	pendingIds := k.PendingRootChanges.Iterate(ctx, collections.Range[uint64]{}).Keys()
+	if len(pendingIds) > 0 {
+		return nil, sudotypes.ErrRootChange(fmt.Sprintf(
+			"root change %d is already pending and must be cancelled first", pendingIds[0],
+		))
+	}
+
+	policy := k.GetRootChangePolicy(ctx)
+	pending := sudotypes.PendingRootChange{
+		Id:               k.NextRootChangeID.Next(ctx),
+		NewRoot:          msg.NewRoot,
+		Proposer:         msg.Sender,
+		ProposedHeight:   ctx.BlockHeight(),
+		ExecutableHeight: ctx.BlockHeight() + int64(policy.DelayBlocks),
+		Approvals:        []string{},
+		NewPolicy:        msg.NewPolicy,
+	}
+	k.PendingRootChanges.Insert(ctx, pending.Id, pending)
+
+	return &sudotypes.MsgProposeRootResponse{Id: pending.Id},
+		ctx.EventManager().EmitTypedEvent(&sudotypes.EventRootChangeProposed{
+			Pending: pending,
+		})
+}
+
+// ApproveRoot executes a MsgApproveRoot, recording the sender's approval of a
+// pending root change. The sender must be a co-signer of the current policy.
+func (k Keeper) ApproveRoot(
+	goCtx context.Context, msg *sudotypes.MsgApproveRoot,
+) (*sudotypes.MsgApproveRootResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pending, err := k.PendingRootChanges.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, sudotypes.ErrRootChange(fmt.Sprintf("no pending root change with id %d", msg.Id))
+	}
+
+	if !k.GetRootChangePolicy(ctx).IsCosigner(msg.Sender) {
+		return nil, sdkioerrors.Wrapf(
+			sudotypes.ErrUnauthorized, "%s is not a root change cosigner", msg.Sender,
+		)
+	}
+	if pending.HasApproval(msg.Sender) {
+		return nil, sudotypes.ErrRootChange(fmt.Sprintf(
+			"%s already approved root change %d", msg.Sender, msg.Id,
+		))
+	}
+
+	pending.Approvals = append(pending.Approvals, msg.Sender)
+	k.PendingRootChanges.Insert(ctx, pending.Id, pending)
+
+	return &sudotypes.MsgApproveRootResponse{},
+		ctx.EventManager().EmitTypedEvent(&sudotypes.EventRootChangeApproved{
+			Id:           pending.Id,
+			Approver:     msg.Sender,
+			NumApprovals: uint64(len(pending.Approvals)),
+		})
+}
+
+// CancelRootChange executes a MsgCancelRootChange. Either the root or any
+// co-signer can discard a pending root change, which lets co-signers veto a
+// change proposed by a compromised root key during the delay.
+func (k Keeper) CancelRootChange(
+	goCtx context.Context, msg *sudotypes.MsgCancelRootChange,
+) (*sudotypes.MsgCancelRootChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.PendingRootChanges.Get(ctx, msg.Id); err != nil {
+		return nil, sudotypes.ErrRootChange(fmt.Sprintf("no pending root change with id %d", msg.Id))
+	}
+
+	sudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sudoers: %w", err)
+	}
+	if msg.Sender != sudoers.Root && !k.GetRootChangePolicy(ctx).IsCosigner(msg.Sender) {
+		return nil, sdkioerrors.Wrapf(
+			sudotypes.ErrUnauthorized, "root changes can only be cancelled by the root or a cosigner",
+		)
+	}
+
+	if err := k.PendingRootChanges.Delete(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &sudotypes.MsgCancelRootChangeResponse{},
+		ctx.EventManager().EmitTypedEvent(&sudotypes.EventRootChangeCancelled{
+			Id:     msg.Id,
+			Sender: msg.Sender,
+		})
+}
+
+// ExecuteRootChanges applies every pending root change whose delay has passed
+// and that has enough co-signer approvals. It is called in the EndBlocker.
+func (k Keeper) ExecuteRootChanges(ctx sdk.Context) {
+	policy := k.GetRootChangePolicy(ctx)
+	for _, pending := range k.PendingRootChanges.Iterate(ctx, collections.Range[uint64]{}).Values() {
+		if ctx.BlockHeight() < pending.ExecutableHeight {
+			continue
+		}
+		if uint64(len(pending.Approvals)) < policy.MinApprovals {
+			continue
+		}
+
+		if err := k.setRoot(ctx, pending.NewRoot); err != nil {
+			ctx.Logger().Error("failed to execute root change", "id", pending.Id, "error", err)
+			continue
+		}
+		if pending.NewPolicy != nil {
+			k.RootChangePolicy.Set(ctx, *pending.NewPolicy)
+		}
+		_ = k.PendingRootChanges.Delete(ctx, pending.Id)
+	}
+}
+
+// setRoot replaces the root user and emits an EventRootChanged.
+func (k Keeper) setRoot(ctx sdk.Context, newRoot string) error {
+	sudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sudoers: %w", err)
+	}
+
+	oldRoot := sudoers.Root
+	sudoers.Root = newRoot
+	k.Sudoers.Set(ctx, sudoers)
+
+	return ctx.EventManager().EmitTypedEvent(&sudotypes.EventRootChanged{
+		OldRoot: oldRoot,
+		NewRoot: newRoot,
+	})
+}
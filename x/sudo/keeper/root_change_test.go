@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/sudo/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/sudo/types"
+)
+
+func TestRootChange_TimelockAndApprovals(t *testing.T) {
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+
+	root := testutil.AccAddress().String()
+	newRoot := testutil.AccAddress().String()
+	cosigners := []string{
+		testutil.AccAddress().String(),
+		testutil.AccAddress().String(),
+		testutil.AccAddress().String(),
+	}
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root})
+	k.RootChangePolicy.Set(ctx, types.RootChangePolicy{
+		DelayBlocks:  10,
+		Cosigners:    cosigners,
+		MinApprovals: 2,
+	})
+
+	t.Log("MsgChangeRoot is rejected while the policy is timelocked")
+	_, err := msgServer.ChangeRoot(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgChangeRoot{Sender: root, NewRoot: newRoot},
+	)
+	require.ErrorContains(t, err, "timelocked")
+
+	t.Log("Only the root can propose")
+	_, err = msgServer.ProposeRoot(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgProposeRoot{Sender: cosigners[0], NewRoot: newRoot},
+	)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	resp, err := msgServer.ProposeRoot(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgProposeRoot{Sender: root, NewRoot: newRoot},
+	)
+	require.NoError(t, err)
+	pending, err := k.PendingRootChanges.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+10, pending.ExecutableHeight)
+
+	t.Log("Only one root change can be pending")
+	_, err = msgServer.ProposeRoot(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgProposeRoot{Sender: root, NewRoot: newRoot},
+	)
+	require.ErrorContains(t, err, "already pending")
+
+	t.Log("Approvals are limited to distinct cosigners")
+	_, err = msgServer.ApproveRoot(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgApproveRoot{Sender: root, Id: resp.Id},
+	)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.ApproveRoot(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgApproveRoot{Sender: cosigners[0], Id: resp.Id},
+	)
+	require.NoError(t, err)
+	_, err = msgServer.ApproveRoot(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgApproveRoot{Sender: cosigners[0], Id: resp.Id},
+	)
+	require.ErrorContains(t, err, "already approved")
+
+	t.Log("Nothing happens before the delay has passed")
+	ctx = ctx.WithBlockHeight(pending.ExecutableHeight)
+	k.ExecuteRootChanges(ctx.WithBlockHeight(pending.ExecutableHeight - 1))
+	sudoers, _ := k.Sudoers.Get(ctx)
+	require.Equal(t, root, sudoers.Root)
+
+	t.Log("Nothing happens without enough approvals")
+	k.ExecuteRootChanges(ctx)
+	sudoers, _ = k.Sudoers.Get(ctx)
+	require.Equal(t, root, sudoers.Root)
+
+	_, err = msgServer.ApproveRoot(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgApproveRoot{Sender: cosigners[1], Id: resp.Id},
+	)
+	require.NoError(t, err)
+
+	t.Log("The change takes effect once delay and approvals are satisfied")
+	k.ExecuteRootChanges(ctx)
+	sudoers, _ = k.Sudoers.Get(ctx)
+	require.Equal(t, newRoot, sudoers.Root)
+	_, err = k.PendingRootChanges.Get(ctx, resp.Id)
+	require.Error(t, err)
+}
+
+func TestRootChange_Cancel(t *testing.T) {
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+
+	root := testutil.AccAddress().String()
+	cosigner := testutil.AccAddress().String()
+	stranger := testutil.AccAddress().String()
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root})
+	k.RootChangePolicy.Set(ctx, types.RootChangePolicy{
+		DelayBlocks: 5,
+		Cosigners:   []string{cosigner},
+	})
+
+	resp, err := msgServer.ProposeRoot(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgProposeRoot{Sender: root, NewRoot: stranger},
+	)
+	require.NoError(t, err)
+
+	_, err = msgServer.CancelRootChange(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgCancelRootChange{Sender: stranger, Id: resp.Id},
+	)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	t.Log("A cosigner can veto the change")
+	_, err = msgServer.CancelRootChange(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgCancelRootChange{Sender: cosigner, Id: resp.Id},
+	)
+	require.NoError(t, err)
+
+	k.ExecuteRootChanges(ctx.WithBlockHeight(ctx.BlockHeight() + 5))
+	sudoers, _ := k.Sudoers.Get(ctx)
+	require.Equal(t, root, sudoers.Root)
+
+	_, err = msgServer.CancelRootChange(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgCancelRootChange{Sender: root, Id: resp.Id},
+	)
+	require.ErrorContains(t, err, "no pending root change")
+}
+
+func TestRootChange_NewPolicy(t *testing.T) {
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+
+	root := testutil.AccAddress().String()
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root})
+
+	newPolicy := types.RootChangePolicy{
+		DelayBlocks:  100,
+		Cosigners:    []string{testutil.AccAddress().String()},
+		MinApprovals: 1,
+	}
+	_, err := msgServer.ProposeRoot(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgProposeRoot{Sender: root, NewRoot: root, NewPolicy: &newPolicy},
+	)
+	require.NoError(t, err)
+
+	t.Log("Without a policy, the change executes in the same block")
+	k.ExecuteRootChanges(ctx)
+	require.EqualValues(t, newPolicy, k.GetRootChangePolicy(ctx))
+
+	resp, err := keeper.NewQuerier(k).QueryRootChanges(
+		sdk.WrapSDKContext(ctx), &types.QueryRootChangesRequest{},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, newPolicy, resp.Policy)
+	require.Empty(t, resp.Pending)
+}
+
+func TestRootChangePolicy_Validate(t *testing.T) {
+	addr := testutil.AccAddress().String()
+	for _, tc := range []struct {
+		name    string
+		policy  types.RootChangePolicy
+		wantErr string
+	}{
+		{name: "empty", policy: types.RootChangePolicy{}},
+		{
+			name:   "happy",
+			policy: types.RootChangePolicy{DelayBlocks: 1, Cosigners: []string{addr}, MinApprovals: 1},
+		},
+		{
+			name:    "too many approvals",
+			policy:  types.RootChangePolicy{Cosigners: []string{addr}, MinApprovals: 2},
+			wantErr: "exceeds the number of cosigners",
+		},
+		{
+			name:    "duplicate cosigner",
+			policy:  types.RootChangePolicy{Cosigners: []string{addr, addr}},
+			wantErr: "duplicate cosigner",
+		},
+		{
+			name:    "invalid cosigner",
+			policy:  types.RootChangePolicy{Cosigners: []string{"cosigner"}},
+			wantErr: "cosigner addr",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	sudotypes "github.com/NibiruChain/nibiru/v2/x/sudo/types"
+)
+
+// ————————————————————————————————————————————————————————————————————————————
+// Scheduled sudo actions (sudo timelock)
+// ————————————————————————————————————————————————————————————————————————————
+
+// ScheduleSudo executes a MsgScheduleSudo. The sender must be a sudoer, the
+// execution height must be at least the minimum delay of the
+// [sudotypes.ScheduledSudoPolicy] away, and every wrapped message must be a
+// sudo msg (see [sudotypes.SudoMsgTypeURLs]) that is routable and signed by the
+// sender. The messages are stored and executed in the EndBlocker of the execution height,
+// where the handler of each message performs its own permission checks.
+func (k Keeper) ScheduleSudo(
+	goCtx context.Context, msg *sudotypes.MsgScheduleSudo,
+) (*sudotypes.MsgScheduleSudoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.CheckPermissions(sender, ctx); err != nil {
+		return nil, err
+	}
+
+	if msg.ExecutionHeight <= ctx.BlockHeight() {
+		return nil, sudotypes.ErrScheduledSudo(fmt.Sprintf(
+			"execution height %d must be after the current block height %d",
+			msg.ExecutionHeight, ctx.BlockHeight(),
+		))
+	}
+	minDelay := k.GetScheduledSudoPolicy(ctx).MinDelayBlocks
+	if delay := uint64(msg.ExecutionHeight - ctx.BlockHeight()); delay < minDelay {
+		return nil, sudotypes.ErrScheduledSudo(fmt.Sprintf(
+			"execution height %d must be at least %d blocks after the current block height %d",
+			msg.ExecutionHeight, minDelay, ctx.BlockHeight(),
+		))
+	}
+
+	sdkMsgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+	for idx, sdkMsg := range sdkMsgs {
+		if err := sudotypes.ValidateSudoMsgType(sdkMsg); err != nil {
+			return nil, sudotypes.ErrScheduledSudo(fmt.Sprintf("msg %d: %s", idx, err))
+		}
+		if k.router.Handler(sdkMsg) == nil {
+			return nil, sudotypes.ErrScheduledSudo(fmt.Sprintf(
+				"msg %d: no handler for message %T", idx, sdkMsg,
+			))
+		}
+	}
+
+	action := sudotypes.ScheduledSudoAction{
+		Id:              k.NextScheduledSudoID.Next(ctx),
+		Sender:          msg.Sender,
+		ScheduledHeight: ctx.BlockHeight(),
+		ExecutionHeight: msg.ExecutionHeight,
+		Messages:        msg.Messages,
+	}
+	k.SetScheduledSudoAction(ctx, action)
+
+	return &sudotypes.MsgScheduleSudoResponse{Id: action.Id},
+		ctx.EventManager().EmitTypedEvent(&sudotypes.EventSudoActionScheduled{
+			Action: action,
+		})
+}
+
+// CancelScheduledSudo executes a MsgCancelScheduledSudo. Either the root or the
+// sender of the scheduled action can cancel it.
+func (k Keeper) CancelScheduledSudo(
+	goCtx context.Context, msg *sudotypes.MsgCancelScheduledSudo,
+) (*sudotypes.MsgCancelScheduledSudoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	action, err := k.ScheduledSudoActions.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, sudotypes.ErrScheduledSudo(fmt.Sprintf("no scheduled sudo action with id %d", msg.Id))
+	}
+
+	sudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sudoers: %w", err)
+	}
+	if msg.Sender != sudoers.Root && msg.Sender != action.Sender {
+		return nil, sdkioerrors.Wrapf(
+			sudotypes.ErrUnauthorized, "scheduled sudo actions can only be cancelled by the root or their sender",
+		)
+	}
+
+	if err := k.deleteScheduledSudoAction(ctx, action); err != nil {
+		return nil, err
+	}
+
+	return &sudotypes.MsgCancelScheduledSudoResponse{},
+		ctx.EventManager().EmitTypedEvent(&sudotypes.EventSudoActionCancelled{
+			Id:     msg.Id,
+			Sender: msg.Sender,
+		})
+}
+
+// GetScheduledSudoPolicy returns the safeguards that apply to scheduled sudo
+// actions. It defaults to an empty policy, which only requires the execution
+// height to be in the future.
+func (k Keeper) GetScheduledSudoPolicy(ctx sdk.Context) sudotypes.ScheduledSudoPolicy {
+	return k.ScheduledSudoPolicy.GetOr(ctx, sudotypes.ScheduledSudoPolicy{})
+}
+
+// UpdateScheduledSudoPolicy executes a MsgUpdateScheduledSudoPolicy. Only the
+// governance module account can change the policy, so that sudoers cannot
+// shorten their own notice period. The new policy applies to actions scheduled
+// afterward.
+func (k Keeper) UpdateScheduledSudoPolicy(
+	goCtx context.Context, msg *sudotypes.MsgUpdateScheduledSudoPolicy,
+) (*sudotypes.MsgUpdateScheduledSudoPolicyResponse, error) {
+	if msg.Authority != govModuleAddr.String() {
+		return nil, sdkioerrors.Wrapf(
+			sudotypes.ErrUnauthorized,
+			"invalid authority, expected %s, got %s", govModuleAddr, msg.Authority,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.ScheduledSudoPolicy.Set(ctx, msg.Policy)
+	return &sudotypes.MsgUpdateScheduledSudoPolicyResponse{},
+		ctx.EventManager().EmitTypedEvent(&sudotypes.EventScheduledSudoPolicyUpdated{
+			Policy: msg.Policy,
+		})
+}
+
+// ExecuteScheduledSudoActions runs every scheduled sudo action that reached its
+// execution height. Actions are removed from the queue whether or not they
+// succeed, and failures are reported with an EventSudoActionExecuted instead of
+// halting the chain. It is called in the EndBlocker.
+func (k Keeper) ExecuteScheduledSudoActions(ctx sdk.Context) {
+	dueKeys := k.ScheduledSudoQueue.Iterate(ctx, collections.Range[collections.Pair[uint64, uint64]]{}.
+		EndInclusive(collections.Join(uint64(ctx.BlockHeight()), uint64(math.MaxUint64))),
+	).Keys()
+	for _, key := range dueKeys {
+		action, err := k.ScheduledSudoActions.Get(ctx, key.K2())
+		if err != nil {
+			// The index and the actions are written together, so this is
+			// unreachable unless the store is corrupt.
+			k.ScheduledSudoQueue.Delete(ctx, key)
+			continue
+		}
+
+		event := &sudotypes.EventSudoActionExecuted{Id: action.Id, Success: true}
+		if err := k.executeSudoAction(ctx, action); err != nil {
+			event.Success = false
+			event.Error = err.Error()
+			ctx.Logger().Error("failed to execute scheduled sudo action", "id", action.Id, "error", err)
+		}
+		_ = k.deleteScheduledSudoAction(ctx, action)
+		_ = ctx.EventManager().EmitTypedEvent(event)
+	}
+}
+
+// SetScheduledSudoAction stores the action and adds it to the execution queue.
+func (k Keeper) SetScheduledSudoAction(ctx sdk.Context, action sudotypes.ScheduledSudoAction) {
+	k.ScheduledSudoActions.Insert(ctx, action.Id, action)
+	k.ScheduledSudoQueue.Insert(ctx, scheduledSudoQueueKey(action))
+}
+
+// deleteScheduledSudoAction removes the action and its entry in the execution
+// queue.
+func (k Keeper) deleteScheduledSudoAction(ctx sdk.Context, action sudotypes.ScheduledSudoAction) error {
+	if err := k.ScheduledSudoActions.Delete(ctx, action.Id); err != nil {
+		return err
+	}
+	k.ScheduledSudoQueue.Delete(ctx, scheduledSudoQueueKey(action))
+	return nil
+}
+
+func scheduledSudoQueueKey(action sudotypes.ScheduledSudoAction) collections.Pair[uint64, uint64] {
+	return collections.Join(uint64(action.ExecutionHeight), action.Id)
+}
+
+// executeSudoAction routes the messages of the action in a cached context that
+// is only written if every message succeeds.
+func (k Keeper) executeSudoAction(
+	ctx sdk.Context, action sudotypes.ScheduledSudoAction,
+) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic during execution: %v", r)
+		}
+	}()
+
+	if err := action.UnpackInterfaces(k.cdc); err != nil {
+		return err
+	}
+	sdkMsgs, err := action.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	for idx, sdkMsg := range sdkMsgs {
+		if err := sudotypes.ValidateSudoMsgType(sdkMsg); err != nil {
+			return fmt.Errorf("msg %d: %w", idx, err)
+		}
+		handler := k.router.Handler(sdkMsg)
+		if handler == nil {
+			return fmt.Errorf("msg %d: no handler for message %T", idx, sdkMsg)
+		}
+		if _, err := handler(cacheCtx, sdkMsg); err != nil {
+			return fmt.Errorf("msg %d (%T): %w", idx, sdkMsg, err)
+		}
+	}
+	writeCache()
+	return nil
+}
@@ -0,0 +1,356 @@
+package keeper_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	oracletypes "github.com/NibiruChain/nibiru/v2/x/oracle/types"
+	"github.com/NibiruChain/nibiru/v2/x/sudo/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/sudo/types"
+)
+
+func editOracleParamsAny(t *testing.T, sender string, votePeriod uint64) *codectypes.Any {
+	anyMsg, err := codectypes.NewAnyWithValue(&oracletypes.MsgEditOracleParams{
+		Sender: sender,
+		Params: &oracletypes.OracleParamsMsg{VotePeriod: votePeriod},
+	})
+	require.NoError(t, err)
+	return anyMsg
+}
+
+func newAny(t *testing.T, msg sdk.Msg) *codectypes.Any {
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	return anyMsg
+}
+
+func TestScheduleSudo(t *testing.T) {
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+
+	root := testutil.AccAddress().String()
+	stranger := testutil.AccAddress().String()
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root})
+	executionHeight := ctx.BlockHeight() + 100
+
+	t.Log("Only sudoers can schedule")
+	_, err := msgServer.ScheduleSudo(sdk.WrapSDKContext(ctx), &types.MsgScheduleSudo{
+		Sender:          stranger,
+		Messages:        []*codectypes.Any{editOracleParamsAny(t, stranger, 42)},
+		ExecutionHeight: executionHeight,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	t.Log("The execution height must be in the future")
+	_, err = msgServer.ScheduleSudo(sdk.WrapSDKContext(ctx), &types.MsgScheduleSudo{
+		Sender:          root,
+		Messages:        []*codectypes.Any{editOracleParamsAny(t, root, 42)},
+		ExecutionHeight: ctx.BlockHeight(),
+	})
+	require.ErrorContains(t, err, "must be after the current block height")
+
+	t.Log("Only sudo msgs can be scheduled")
+	_, err = msgServer.ScheduleSudo(sdk.WrapSDKContext(ctx), &types.MsgScheduleSudo{
+		Sender: root,
+		Messages: []*codectypes.Any{newAny(t, &banktypes.MsgSend{
+			FromAddress: root, ToAddress: stranger, Amount: sdk.NewCoins(sdk.NewInt64Coin("unibi", 1)),
+		})},
+		ExecutionHeight: executionHeight,
+	})
+	require.ErrorContains(t, err, "is not a sudo msg")
+
+	resp, err := msgServer.ScheduleSudo(sdk.WrapSDKContext(ctx), &types.MsgScheduleSudo{
+		Sender:          root,
+		Messages:        []*codectypes.Any{editOracleParamsAny(t, root, 42)},
+		ExecutionHeight: executionHeight,
+	})
+	require.NoError(t, err)
+
+	queryResp, err := keeper.NewQuerier(k).QueryScheduledSudoActions(
+		sdk.WrapSDKContext(ctx), &types.QueryScheduledSudoActionsRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, queryResp.Actions, 1)
+	require.Equal(t, resp.Id, queryResp.Actions[0].Id)
+
+	t.Log("Nothing happens before the execution height")
+	k.ExecuteScheduledSudoActions(ctx.WithBlockHeight(executionHeight - 1))
+	params, err := nibiru.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, uint64(42), params.VotePeriod)
+
+	t.Log("The messages execute at the execution height")
+	ctx = ctx.WithBlockHeight(executionHeight).WithEventManager(sdk.NewEventManager())
+	k.ExecuteScheduledSudoActions(ctx)
+	params, err = nibiru.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), params.VotePeriod)
+	testutil.RequireContainsTypedEvent(t, ctx, &types.EventSudoActionExecuted{
+		Id: resp.Id, Success: true,
+	})
+
+	_, err = k.ScheduledSudoActions.Get(ctx, resp.Id)
+	require.Error(t, err)
+}
+
+func TestScheduleSudo_MinDelay(t *testing.T) {
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+
+	root := testutil.AccAddress().String()
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root})
+	schedule := func(executionHeight int64) error {
+		_, err := msgServer.ScheduleSudo(sdk.WrapSDKContext(ctx), &types.MsgScheduleSudo{
+			Sender:          root,
+			Messages:        []*codectypes.Any{editOracleParamsAny(t, root, 42)},
+			ExecutionHeight: executionHeight,
+		})
+		return err
+	}
+
+	t.Log("Only governance can change the policy")
+	policy := types.ScheduledSudoPolicy{MinDelayBlocks: 100}
+	_, err := msgServer.UpdateScheduledSudoPolicy(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgUpdateScheduledSudoPolicy{Authority: root, Policy: policy},
+	)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.NoError(t, schedule(ctx.BlockHeight()+1))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.UpdateScheduledSudoPolicy(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgUpdateScheduledSudoPolicy{Authority: gov, Policy: policy},
+	)
+	require.NoError(t, err)
+	testutil.RequireContainsTypedEvent(t, ctx, &types.EventScheduledSudoPolicyUpdated{
+		Policy: policy,
+	})
+
+	t.Log("The execution height must be at least the min delay away")
+	err = schedule(ctx.BlockHeight() + 99)
+	require.ErrorContains(t, err, "must be at least 100 blocks after")
+	require.NoError(t, schedule(ctx.BlockHeight()+100))
+
+	queryResp, err := keeper.NewQuerier(k).QueryScheduledSudoActions(
+		sdk.WrapSDKContext(ctx), &types.QueryScheduledSudoActionsRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, policy, queryResp.Policy)
+	require.Len(t, queryResp.Actions, 2)
+}
+
+func TestScheduleSudo_FailureIsAtomic(t *testing.T) {
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+
+	root := testutil.AccAddress().String()
+	contract := testutil.AccAddress().String()
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root, Contracts: []string{contract}})
+	paramsBefore, err := nibiru.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	resp, err := msgServer.ScheduleSudo(sdk.WrapSDKContext(ctx), &types.MsgScheduleSudo{
+		Sender: contract,
+		Messages: []*codectypes.Any{
+			editOracleParamsAny(t, contract, 42),
+			editOracleParamsAny(t, contract, 43),
+		},
+		ExecutionHeight: ctx.BlockHeight() + 1,
+	})
+	require.NoError(t, err)
+
+	t.Log("Revoke the sudo permissions of the sender before execution")
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root, Contracts: []string{}})
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() {
+		k.ExecuteScheduledSudoActions(ctx)
+	})
+	paramsAfter, err := nibiru.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, paramsBefore.VotePeriod, paramsAfter.VotePeriod)
+
+	_, err = k.ScheduledSudoActions.Get(ctx, resp.Id)
+	require.Error(t, err, "failed actions are removed from the queue")
+}
+
+func TestExecuteScheduledSudoActions_Queue(t *testing.T) {
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+
+	root := testutil.AccAddress().String()
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root})
+	startHeight := ctx.BlockHeight()
+
+	var ids []uint64
+	for _, heightOffset := range []int64{3, 1, 2, 1} {
+		resp, err := msgServer.ScheduleSudo(sdk.WrapSDKContext(ctx), &types.MsgScheduleSudo{
+			Sender:          root,
+			Messages:        []*codectypes.Any{editOracleParamsAny(t, root, uint64(100+heightOffset))},
+			ExecutionHeight: startHeight + heightOffset,
+		})
+		require.NoError(t, err)
+		ids = append(ids, resp.Id)
+	}
+
+	t.Log("Cancelled actions leave the queue")
+	_, err := msgServer.CancelScheduledSudo(sdk.WrapSDKContext(ctx), &types.MsgCancelScheduledSudo{
+		Sender: root, Id: ids[2],
+	})
+	require.NoError(t, err)
+
+	t.Log("Each block runs only the actions that are due")
+	for _, tc := range []struct {
+		heightOffset int64
+		pendingIds   []uint64
+	}{
+		{heightOffset: 1, pendingIds: []uint64{ids[0]}},
+		{heightOffset: 2, pendingIds: []uint64{ids[0]}},
+		{heightOffset: 3, pendingIds: nil},
+	} {
+		k.ExecuteScheduledSudoActions(ctx.WithBlockHeight(startHeight + tc.heightOffset))
+		require.Equal(t, tc.pendingIds,
+			k.ScheduledSudoActions.Iterate(ctx, collections.Range[uint64]{}).Keys(),
+			"height offset %d", tc.heightOffset,
+		)
+	}
+	params, err := nibiru.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 103, params.VotePeriod)
+
+	require.Empty(t, k.ScheduledSudoQueue.Iterate(
+		ctx, collections.Range[collections.Pair[uint64, uint64]]{},
+	).Keys())
+}
+
+func TestCancelScheduledSudo(t *testing.T) {
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+
+	root := testutil.AccAddress().String()
+	contract := testutil.AccAddress().String()
+	otherContract := testutil.AccAddress().String()
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root, Contracts: []string{contract, otherContract}})
+
+	resp, err := msgServer.ScheduleSudo(sdk.WrapSDKContext(ctx), &types.MsgScheduleSudo{
+		Sender:          contract,
+		Messages:        []*codectypes.Any{editOracleParamsAny(t, contract, 42)},
+		ExecutionHeight: ctx.BlockHeight() + 1,
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.CancelScheduledSudo(sdk.WrapSDKContext(ctx), &types.MsgCancelScheduledSudo{
+		Sender: otherContract, Id: resp.Id,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.CancelScheduledSudo(sdk.WrapSDKContext(ctx), &types.MsgCancelScheduledSudo{
+		Sender: root, Id: resp.Id,
+	})
+	require.NoError(t, err)
+	_, err = k.ScheduledSudoActions.Get(ctx, resp.Id)
+	require.Error(t, err)
+}
+
+func TestMsgScheduleSudo_ValidateBasic(t *testing.T) {
+	sender := testutil.AccAddress().String()
+	other := testutil.AccAddress().String()
+	for _, tc := range []struct {
+		name    string
+		msg     types.MsgScheduleSudo
+		wantErr string
+	}{
+		{
+			name: "happy",
+			msg: types.MsgScheduleSudo{
+				Sender:          sender,
+				Messages:        []*codectypes.Any{editOracleParamsAny(t, sender, 10)},
+				ExecutionHeight: 10,
+			},
+		},
+		{
+			name: "no msgs",
+			msg: types.MsgScheduleSudo{
+				Sender:          sender,
+				ExecutionHeight: 10,
+			},
+			wantErr: "no msgs",
+		},
+		{
+			name: "msg signed by someone else",
+			msg: types.MsgScheduleSudo{
+				Sender:          sender,
+				Messages:        []*codectypes.Any{editOracleParamsAny(t, other, 10)},
+				ExecutionHeight: 10,
+			},
+			wantErr: "must be signed by the sender",
+		},
+		{
+			name: "bank send is not a sudo msg",
+			msg: types.MsgScheduleSudo{
+				Sender: sender,
+				Messages: []*codectypes.Any{newAny(t, &banktypes.MsgSend{
+					FromAddress: sender, ToAddress: other, Amount: sdk.NewCoins(sdk.NewInt64Coin("unibi", 1)),
+				})},
+				ExecutionHeight: 10,
+			},
+			wantErr: "is not a sudo msg",
+		},
+		{
+			name: "ethereum tx is not a sudo msg",
+			msg: types.MsgScheduleSudo{
+				Sender:          sender,
+				Messages:        []*codectypes.Any{newAny(t, &evm.MsgEthereumTx{})},
+				ExecutionHeight: 10,
+			},
+			wantErr: "is not a sudo msg",
+		},
+		{
+			name: "nested schedule",
+			msg: types.MsgScheduleSudo{
+				Sender: sender,
+				Messages: []*codectypes.Any{newAny(t, &types.MsgScheduleSudo{
+					Sender:          sender,
+					Messages:        []*codectypes.Any{editOracleParamsAny(t, sender, 10)},
+					ExecutionHeight: 10,
+				})},
+				ExecutionHeight: 10,
+			},
+			wantErr: "is not a sudo msg",
+		},
+		{
+			name: "non-positive height",
+			msg: types.MsgScheduleSudo{
+				Sender:   sender,
+				Messages: []*codectypes.Any{editOracleParamsAny(t, sender, 10)},
+			},
+			wantErr: "execution height",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -162,7 +163,9 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
 // returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.ExecuteScheduledSudoActions(ctx)
+	am.keeper.ExecuteRootChanges(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -197,9 +200,10 @@ func init() {
 type SudoInputs struct {
 	depinject.In
 
-	Config *modulev1.Module
-	Key    *store.KVStoreKey
-	Cdc    codec.Codec
+	Config           *modulev1.Module
+	Key              *store.KVStoreKey
+	Cdc              codec.Codec
+	MsgServiceRouter *baseapp.MsgServiceRouter
 }
 
 type SudoOutputs struct {
@@ -211,7 +215,7 @@ type SudoOutputs struct {
 }
 
 func ProvideModule(in SudoInputs) SudoOutputs {
-	k := keeper.NewKeeper(in.Cdc, in.Key)
+	k := keeper.NewKeeper(in.Cdc, in.Key, in.MsgServiceRouter)
 
 	m := NewAppModule(in.Cdc, k)
 
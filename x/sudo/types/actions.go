@@ -1,6 +1,12 @@
 package types
 
-import "github.com/NibiruChain/nibiru/v2/x/common/set"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/set"
+)
 
 type RootAction string
 
@@ -14,3 +20,31 @@ var RootActions = set.New[RootAction](
 	AddContracts,
 	RemoveContracts,
 )
+
+// SudoMsgTypeURLs set[string]: The type URLs of the messages that a sudoer can
+// schedule with "MsgScheduleSudo" or run from a cron job. These are the
+// messages whose handlers check sudo permissions. Scheduled messages skip the
+// ante handler, so anything else, like a bank send, an Ethereum tx, or a nested
+// schedule, is rejected.
+var SudoMsgTypeURLs = set.New[string](
+	"/nibiru.sudo.v1.MsgEditSudoers",
+	"/nibiru.oracle.v1.MsgEditOracleParams",
+	"/nibiru.oracle.v1.MsgResumePair",
+	"/nibiru.inflation.v1.MsgEditInflationParams",
+	"/nibiru.inflation.v1.MsgToggleInflation",
+	"/nibiru.epochs.v1.MsgCreateEpoch",
+	"/nibiru.epochs.v1.MsgDeleteEpoch",
+	"/nibiru.tokenfactory.v1.MsgSudoSetDenomMetadata",
+	"/eth.evm.v1.MsgSyncFunTokenMetadata",
+	"/eth.evm.v1.MsgSetFunTokenDisabled",
+	"/eth.evm.v1.MsgMigrateFunToken",
+)
+
+// ValidateSudoMsgType returns an error if the msg is not in [SudoMsgTypeURLs].
+func ValidateSudoMsgType(msg sdk.Msg) error {
+	typeURL := sdk.MsgTypeURL(msg)
+	if !SudoMsgTypeURLs.Has(typeURL) {
+		return fmt.Errorf("%s is not a sudo msg", typeURL)
+	}
+	return nil
+}
@@ -9,6 +9,14 @@ import (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEditSudoers{}, "sudo/edit_sudoers", nil)
+	cdc.RegisterConcrete(&MsgProposeRoot{}, "sudo/propose_root", nil)
+	cdc.RegisterConcrete(&MsgApproveRoot{}, "sudo/approve_root", nil)
+	cdc.RegisterConcrete(&MsgCancelRootChange{}, "sudo/cancel_root_change", nil)
+	cdc.RegisterConcrete(&MsgScheduleSudo{}, "sudo/schedule_sudo", nil)
+	cdc.RegisterConcrete(&MsgCancelScheduledSudo{}, "sudo/cancel_scheduled_sudo", nil)
+	cdc.RegisterConcrete(&MsgUpdateScheduledSudoPolicy{}, "sudo/update_scheduled_sudo_policy", nil)
+	cdc.RegisterConcrete(&MsgRegisterCronJob{}, "sudo/register_cron_job", nil)
+	cdc.RegisterConcrete(&MsgDeleteCronJob{}, "sudo/delete_cron_job", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -16,6 +24,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		/* interface */ (*sdk.Msg)(nil),
 		/* implementations */
 		&MsgEditSudoers{},
+		&MsgProposeRoot{},
+		&MsgApproveRoot{},
+		&MsgCancelRootChange{},
+		&MsgScheduleSudo{},
+		&MsgCancelScheduledSudo{},
+		&MsgUpdateScheduledSudoPolicy{},
+		&MsgRegisterCronJob{},
+		&MsgDeleteCronJob{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -7,9 +7,12 @@ import (
 )
 
 var (
-	ErrUnauthorized = sdkioerrors.Register(ModuleName, 2, "unauthorized: missing sudo permissions")
-	errGenesis      = sdkioerrors.Register(ModuleName, 3, "sudo genesis error")
-	errSudoers      = sdkioerrors.Register(ModuleName, 4, "sudoers error")
+	ErrUnauthorized  = sdkioerrors.Register(ModuleName, 2, "unauthorized: missing sudo permissions")
+	errGenesis       = sdkioerrors.Register(ModuleName, 3, "sudo genesis error")
+	errSudoers       = sdkioerrors.Register(ModuleName, 4, "sudoers error")
+	errRootChange    = sdkioerrors.Register(ModuleName, 5, "root change error")
+	errScheduledSudo = sdkioerrors.Register(ModuleName, 6, "scheduled sudo error")
+	errCronJob       = sdkioerrors.Register(ModuleName, 7, "cron job error")
 )
 
 func ErrGenesis(errMsg string) error {
@@ -19,3 +22,15 @@ func ErrGenesis(errMsg string) error {
 func ErrSudoers(errMsg string) error {
 	return fmt.Errorf("%s: %s", errSudoers, errMsg)
 }
+
+func ErrRootChange(errMsg string) error {
+	return fmt.Errorf("%s: %s", errRootChange, errMsg)
+}
+
+func ErrScheduledSudo(errMsg string) error {
+	return fmt.Errorf("%s: %s", errScheduledSudo, errMsg)
+}
+
+func ErrCronJob(errMsg string) error {
+	return fmt.Errorf("%s: %s", errCronJob, errMsg)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// EvmKeeper defines the EVM functionality that cron jobs use to call
+// contracts.
+type EvmKeeper interface {
+	CallContractFromAccount(
+		ctx sdk.Context,
+		fromAcc gethcommon.Address,
+		contract gethcommon.Address,
+		contractInput []byte,
+		gasLimit uint64,
+	) (*evm.MsgEthereumTxResponse, error)
+}
+
+// WasmKeeper defines the Wasm functionality that cron jobs use to execute
+// contracts. It is satisfied by the permissioned Wasm keeper.
+type WasmKeeper interface {
+	Execute(
+		ctx sdk.Context,
+		contractAddress sdk.AccAddress,
+		caller sdk.AccAddress,
+		msg []byte,
+		coins sdk.Coins,
+	) ([]byte, error)
+}
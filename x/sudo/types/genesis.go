@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
 func (gen *GenesisState) Validate() error {
@@ -11,6 +12,38 @@ func (gen *GenesisState) Validate() error {
 		return ErrGenesis("nil contract state must be []string")
 	} else if err := gen.Sudoers.Validate(); err != nil {
 		return ErrGenesis(err.Error())
+	} else if err := gen.RootChangePolicy.Validate(); err != nil {
+		return ErrGenesis(err.Error())
+	}
+	for _, pending := range gen.PendingRootChanges {
+		if err := pending.Validate(); err != nil {
+			return ErrGenesis(err.Error())
+		}
+	}
+	for _, action := range gen.ScheduledSudoActions {
+		if err := action.Validate(); err != nil {
+			return ErrGenesis(err.Error())
+		}
+	}
+	for _, job := range gen.CronJobs {
+		if err := job.Validate(); err != nil {
+			return ErrGenesis(err.Error())
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gen GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, action := range gen.ScheduledSudoActions {
+		if err := action.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for _, job := range gen.CronJobs {
+		if err := job.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
 	}
 	return nil
 }
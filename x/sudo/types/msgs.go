@@ -3,13 +3,26 @@ package types
 import (
 	"fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
 var (
 	_ legacytx.LegacyMsg = &MsgEditSudoers{}
 	_ legacytx.LegacyMsg = &MsgChangeRoot{}
+	_ legacytx.LegacyMsg = &MsgProposeRoot{}
+	_ legacytx.LegacyMsg = &MsgApproveRoot{}
+	_ legacytx.LegacyMsg = &MsgCancelRootChange{}
+	_ legacytx.LegacyMsg = &MsgScheduleSudo{}
+	_ legacytx.LegacyMsg = &MsgCancelScheduledSudo{}
+	_ legacytx.LegacyMsg = &MsgUpdateScheduledSudoPolicy{}
+	_ legacytx.LegacyMsg = &MsgRegisterCronJob{}
+	_ legacytx.LegacyMsg = &MsgDeleteCronJob{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgScheduleSudo{}
+	_ codectypes.UnpackInterfacesMessage = &MsgRegisterCronJob{}
 )
 
 // MsgEditSudoers
@@ -91,3 +104,310 @@ func (msg MsgChangeRoot) Type() string { return "change_root" }
 func (m MsgChangeRoot) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
+
+// MsgProposeRoot
+
+func (m MsgProposeRoot) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgProposeRoot) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.NewRoot); err != nil {
+		return err
+	}
+
+	if m.NewPolicy != nil {
+		return m.NewPolicy.Validate()
+	}
+
+	return nil
+}
+
+// Route Implements Msg.
+func (msg MsgProposeRoot) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgProposeRoot) Type() string { return "propose_root" }
+
+// GetSignBytes Implements Msg.
+func (m MsgProposeRoot) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// MsgApproveRoot
+
+func (m MsgApproveRoot) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgApproveRoot) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	return err
+}
+
+// Route Implements Msg.
+func (msg MsgApproveRoot) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgApproveRoot) Type() string { return "approve_root" }
+
+// GetSignBytes Implements Msg.
+func (m MsgApproveRoot) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// MsgCancelRootChange
+
+func (m MsgCancelRootChange) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgCancelRootChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	return err
+}
+
+// Route Implements Msg.
+func (msg MsgCancelRootChange) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgCancelRootChange) Type() string { return "cancel_root_change" }
+
+// GetSignBytes Implements Msg.
+func (m MsgCancelRootChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// MsgScheduleSudo
+
+func (m MsgScheduleSudo) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgScheduleSudo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+
+	if m.ExecutionHeight <= 0 {
+		return fmt.Errorf("execution height must be positive, got %d", m.ExecutionHeight)
+	}
+
+	msgs, err := m.GetMsgs()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return ErrScheduledSudo("no msgs to execute")
+	}
+	for idx, msg := range msgs {
+		if err := ValidateSudoMsgType(msg); err != nil {
+			return ErrScheduledSudo(fmt.Sprintf("msg %d: %s", idx, err))
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || signers[0].String() != m.Sender {
+			return ErrScheduledSudo(fmt.Sprintf(
+				"msg %d (%T) must be signed by the sender %s", idx, msg, m.Sender,
+			))
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("msg %d (%T): %w", idx, msg, err)
+		}
+	}
+
+	return nil
+}
+
+// GetMsgs unpacks the messages to schedule.
+func (m MsgScheduleSudo) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "sudo.MsgScheduleSudo")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgScheduleSudo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
+// Route Implements Msg.
+func (msg MsgScheduleSudo) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgScheduleSudo) Type() string { return "schedule_sudo" }
+
+// GetSignBytes Implements Msg.
+func (m MsgScheduleSudo) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// MsgCancelScheduledSudo
+
+func (m MsgCancelScheduledSudo) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgCancelScheduledSudo) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	return err
+}
+
+// Route Implements Msg.
+func (msg MsgCancelScheduledSudo) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgCancelScheduledSudo) Type() string { return "cancel_scheduled_sudo" }
+
+// GetSignBytes Implements Msg.
+func (m MsgCancelScheduledSudo) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// MsgUpdateScheduledSudoPolicy
+
+func (m MsgUpdateScheduledSudoPolicy) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgUpdateScheduledSudoPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	return err
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateScheduledSudoPolicy) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgUpdateScheduledSudoPolicy) Type() string { return "update_scheduled_sudo_policy" }
+
+// GetSignBytes Implements Msg.
+func (m MsgUpdateScheduledSudoPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// MsgRegisterCronJob
+
+func (m MsgRegisterCronJob) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgRegisterCronJob) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+
+	if err := ValidateCronJobSchedule(m.EpochIdentifier, m.GasLimit); err != nil {
+		return err
+	}
+
+	msgs, err := m.GetMsgs()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 && len(m.EvmCalls) == 0 && len(m.WasmCalls) == 0 {
+		return ErrCronJob("no msgs, evm calls, or wasm calls to execute")
+	}
+	for idx, msg := range msgs {
+		if err := ValidateSudoMsgType(msg); err != nil {
+			return ErrCronJob(fmt.Sprintf("msg %d: %s", idx, err))
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || signers[0].String() != m.Sender {
+			return ErrCronJob(fmt.Sprintf(
+				"msg %d (%T) must be signed by the sender %s", idx, msg, m.Sender,
+			))
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("msg %d (%T): %w", idx, msg, err)
+		}
+	}
+	for idx, call := range m.EvmCalls {
+		if err := call.Validate(); err != nil {
+			return fmt.Errorf("evm call %d: %w", idx, err)
+		}
+	}
+	for idx, call := range m.WasmCalls {
+		if err := call.Validate(); err != nil {
+			return fmt.Errorf("wasm call %d: %w", idx, err)
+		}
+	}
+
+	return nil
+}
+
+// GetMsgs unpacks the messages of the cron job.
+func (m MsgRegisterCronJob) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "sudo.MsgRegisterCronJob")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgRegisterCronJob) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
+// Route Implements Msg.
+func (msg MsgRegisterCronJob) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgRegisterCronJob) Type() string { return "register_cron_job" }
+
+// GetSignBytes Implements Msg.
+func (m MsgRegisterCronJob) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// MsgDeleteCronJob
+
+func (m MsgDeleteCronJob) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgDeleteCronJob) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	return err
+}
+
+// Route Implements Msg.
+func (msg MsgDeleteCronJob) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgDeleteCronJob) Type() string { return "delete_cron_job" }
+
+// GetSignBytes Implements Msg.
+func (m MsgDeleteCronJob) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
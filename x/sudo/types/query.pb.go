@@ -110,33 +110,333 @@ func (m *QuerySudoersResponse) GetSudoers() Sudoers {
 	return Sudoers{}
 }
 
+type QueryRootChangesRequest struct {
+}
+
+func (m *QueryRootChangesRequest) Reset()         { *m = QueryRootChangesRequest{} }
+func (m *QueryRootChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRootChangesRequest) ProtoMessage()    {}
+func (*QueryRootChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{2}
+}
+func (m *QueryRootChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRootChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRootChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRootChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRootChangesRequest.Merge(m, src)
+}
+func (m *QueryRootChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRootChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRootChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRootChangesRequest proto.InternalMessageInfo
+
+// QueryRootChangesResponse: Response type for the QueryRootChanges method.
+type QueryRootChangesResponse struct {
+	// Policy: Safeguards that apply when changing the root.
+	Policy RootChangePolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	// Pending: Root changes that have not taken effect yet.
+	Pending []PendingRootChange `protobuf:"bytes,2,rep,name=pending,proto3" json:"pending"`
+}
+
+func (m *QueryRootChangesResponse) Reset()         { *m = QueryRootChangesResponse{} }
+func (m *QueryRootChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRootChangesResponse) ProtoMessage()    {}
+func (*QueryRootChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{3}
+}
+func (m *QueryRootChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRootChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRootChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRootChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRootChangesResponse.Merge(m, src)
+}
+func (m *QueryRootChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRootChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRootChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRootChangesResponse proto.InternalMessageInfo
+
+func (m *QueryRootChangesResponse) GetPolicy() RootChangePolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return RootChangePolicy{}
+}
+
+func (m *QueryRootChangesResponse) GetPending() []PendingRootChange {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+type QueryScheduledSudoActionsRequest struct {
+}
+
+func (m *QueryScheduledSudoActionsRequest) Reset()         { *m = QueryScheduledSudoActionsRequest{} }
+func (m *QueryScheduledSudoActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledSudoActionsRequest) ProtoMessage()    {}
+func (*QueryScheduledSudoActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{4}
+}
+func (m *QueryScheduledSudoActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledSudoActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledSudoActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledSudoActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledSudoActionsRequest.Merge(m, src)
+}
+func (m *QueryScheduledSudoActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledSudoActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledSudoActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledSudoActionsRequest proto.InternalMessageInfo
+
+// QueryScheduledSudoActionsResponse: Response type for the
+// QueryScheduledSudoActions method.
+type QueryScheduledSudoActionsResponse struct {
+	// Actions: Sudo actions waiting for their execution height.
+	Actions []ScheduledSudoAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions"`
+	// Policy: Safeguards that apply to scheduled sudo actions.
+	Policy ScheduledSudoPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *QueryScheduledSudoActionsResponse) Reset()         { *m = QueryScheduledSudoActionsResponse{} }
+func (m *QueryScheduledSudoActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledSudoActionsResponse) ProtoMessage()    {}
+func (*QueryScheduledSudoActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{5}
+}
+func (m *QueryScheduledSudoActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledSudoActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledSudoActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledSudoActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledSudoActionsResponse.Merge(m, src)
+}
+func (m *QueryScheduledSudoActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledSudoActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledSudoActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledSudoActionsResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledSudoActionsResponse) GetActions() []ScheduledSudoAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *QueryScheduledSudoActionsResponse) GetPolicy() ScheduledSudoPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return ScheduledSudoPolicy{}
+}
+
+// QueryCronJobsRequest: Request type for the QueryCronJobs method.
+type QueryCronJobsRequest struct {
+	// EpochIdentifier: Optional filter on the epoch identifier of the jobs.
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+}
+
+func (m *QueryCronJobsRequest) Reset()         { *m = QueryCronJobsRequest{} }
+func (m *QueryCronJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCronJobsRequest) ProtoMessage()    {}
+func (*QueryCronJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{6}
+}
+func (m *QueryCronJobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCronJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCronJobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCronJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCronJobsRequest.Merge(m, src)
+}
+func (m *QueryCronJobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCronJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCronJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCronJobsRequest proto.InternalMessageInfo
+
+func (m *QueryCronJobsRequest) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+// QueryCronJobsResponse: Response type for the QueryCronJobs method.
+type QueryCronJobsResponse struct {
+	// Jobs: Registered cron jobs.
+	Jobs []CronJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs"`
+}
+
+func (m *QueryCronJobsResponse) Reset()         { *m = QueryCronJobsResponse{} }
+func (m *QueryCronJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCronJobsResponse) ProtoMessage()    {}
+func (*QueryCronJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{7}
+}
+func (m *QueryCronJobsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCronJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCronJobsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCronJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCronJobsResponse.Merge(m, src)
+}
+func (m *QueryCronJobsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCronJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCronJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCronJobsResponse proto.InternalMessageInfo
+
+func (m *QueryCronJobsResponse) GetJobs() []CronJob {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySudoersRequest)(nil), "nibiru.sudo.v1.QuerySudoersRequest")
 	proto.RegisterType((*QuerySudoersResponse)(nil), "nibiru.sudo.v1.QuerySudoersResponse")
+	proto.RegisterType((*QueryRootChangesRequest)(nil), "nibiru.sudo.v1.QueryRootChangesRequest")
+	proto.RegisterType((*QueryRootChangesResponse)(nil), "nibiru.sudo.v1.QueryRootChangesResponse")
+	proto.RegisterType((*QueryScheduledSudoActionsRequest)(nil), "nibiru.sudo.v1.QueryScheduledSudoActionsRequest")
+	proto.RegisterType((*QueryScheduledSudoActionsResponse)(nil), "nibiru.sudo.v1.QueryScheduledSudoActionsResponse")
+	proto.RegisterType((*QueryCronJobsRequest)(nil), "nibiru.sudo.v1.QueryCronJobsRequest")
+	proto.RegisterType((*QueryCronJobsResponse)(nil), "nibiru.sudo.v1.QueryCronJobsResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/query.proto", fileDescriptor_3c5c8e03d8d77d77) }
 
 var fileDescriptor_3c5c8e03d8d77d77 = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x2f, 0x2e, 0x4d, 0xc9, 0xd7, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa,
-	0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x4c, 0x7a,
-	0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62,
-	0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x16, 0xdd, 0xfc, 0xe2, 0x92, 0xc4, 0x92, 0x54, 0x88, 0x9c,
-	0x92, 0x28, 0x97, 0x70, 0x20, 0xc8, 0xba, 0xe0, 0xd2, 0x94, 0xfc, 0xd4, 0xa2, 0xe2, 0xa0, 0xd4,
-	0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x25, 0x7f, 0x2e, 0x11, 0x54, 0xe1, 0xe2, 0x82, 0xfc, 0xbc, 0xe2,
-	0x54, 0x21, 0x73, 0x2e, 0xf6, 0x62, 0x88, 0x90, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xb8,
-	0x1e, 0xaa, 0x03, 0xf5, 0xa0, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xa9, 0x36,
-	0x6a, 0x60, 0xe4, 0x62, 0x05, 0x9b, 0x28, 0x54, 0xce, 0xc5, 0x83, 0x6c, 0xb4, 0x90, 0x32, 0xba,
-	0x09, 0x58, 0xdc, 0x23, 0xa5, 0x82, 0x5f, 0x11, 0xc4, 0x75, 0x4a, 0x32, 0x4d, 0x97, 0x9f, 0x4c,
-	0x66, 0x12, 0x13, 0x12, 0xd1, 0x47, 0xf6, 0x31, 0xd4, 0x09, 0x4e, 0xee, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9b, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0xef, 0x07, 0xd6, 0xe9, 0x9c, 0x91, 0x98, 0x99, 0x07, 0x33, 0xa5, 0xcc, 0x48, 0xbf,
-	0x02, 0x62, 0x54, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xe8, 0x8c, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x54, 0x39, 0xc9, 0x2a, 0xb8, 0x01, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3f, 0x6f, 0xd3, 0x5e,
+	0x14, 0xcd, 0xeb, 0x2f, 0xbf, 0x46, 0xbc, 0xf2, 0xa7, 0x7a, 0xa4, 0x6d, 0x62, 0x2a, 0xe3, 0x98,
+	0x7f, 0x61, 0xc0, 0x26, 0x61, 0x60, 0x43, 0x4a, 0x33, 0x20, 0x3a, 0x40, 0x09, 0x1b, 0x4b, 0xe4,
+	0xd8, 0x0f, 0xe7, 0xa1, 0xf0, 0xae, 0x6b, 0x3f, 0x07, 0x22, 0x31, 0x31, 0x31, 0x22, 0x31, 0xb3,
+	0x23, 0xb1, 0xf2, 0x21, 0x3a, 0x56, 0x62, 0x61, 0x42, 0x28, 0xe1, 0x83, 0xa0, 0x3c, 0x3f, 0xa7,
+	0xb5, 0x9d, 0x36, 0x62, 0x8b, 0xee, 0x3d, 0xe7, 0xdc, 0x73, 0xef, 0x3b, 0x31, 0xd6, 0x38, 0x1b,
+	0xb0, 0x30, 0xb6, 0xa3, 0xd8, 0x03, 0x7b, 0xdc, 0xb2, 0x0f, 0x63, 0x1a, 0x4e, 0xac, 0x20, 0x04,
+	0x01, 0xe4, 0x72, 0xd2, 0xb3, 0xe6, 0x3d, 0x6b, 0xdc, 0xd2, 0xaa, 0x3e, 0xf8, 0x20, 0x5b, 0xf6,
+	0xfc, 0x57, 0x82, 0xd2, 0x76, 0x7d, 0x00, 0x7f, 0x44, 0x6d, 0x27, 0x60, 0xb6, 0xc3, 0x39, 0x08,
+	0x47, 0x30, 0xe0, 0x91, 0xea, 0xe6, 0xf5, 0x23, 0xe1, 0x08, 0x9a, 0xf4, 0xcc, 0x2d, 0x7c, 0xf5,
+	0xf9, 0x7c, 0xdc, 0x8b, 0xd8, 0x03, 0x1a, 0x46, 0x3d, 0x7a, 0x18, 0xd3, 0x48, 0x98, 0xcf, 0x70,
+	0x35, 0x5b, 0x8e, 0x02, 0xe0, 0x11, 0x25, 0x0f, 0x71, 0x25, 0x4a, 0x4a, 0x35, 0x64, 0xa0, 0xe6,
+	0x46, 0x7b, 0xc7, 0xca, 0x1a, 0xb4, 0x14, 0x63, 0xaf, 0x7c, 0xf4, 0xeb, 0x7a, 0xa9, 0x97, 0xa2,
+	0xcd, 0x3a, 0xde, 0x91, 0x82, 0x3d, 0x00, 0xd1, 0x1d, 0x3a, 0xdc, 0xa7, 0x8b, 0x59, 0x5f, 0x10,
+	0xae, 0x15, 0x7b, 0x6a, 0xe0, 0x23, 0xbc, 0x1e, 0xc0, 0x88, 0xb9, 0x13, 0x35, 0xcf, 0xc8, 0xcf,
+	0x3b, 0x21, 0x1d, 0x48, 0x9c, 0x1a, 0xac, 0x58, 0xa4, 0x83, 0x2b, 0x01, 0xe5, 0x1e, 0xe3, 0x7e,
+	0x6d, 0xcd, 0xf8, 0xaf, 0xb9, 0xd1, 0x6e, 0xe4, 0x05, 0x0e, 0x92, 0xf6, 0x89, 0x4e, 0x6a, 0x5d,
+	0xf1, 0x4c, 0x13, 0x1b, 0xc9, 0x2d, 0xdc, 0x21, 0xf5, 0xe2, 0x11, 0xf5, 0xe6, 0x2b, 0x76, 0x5c,
+	0x79, 0xe1, 0x74, 0x87, 0x6f, 0x08, 0x37, 0xce, 0x01, 0xa9, 0x65, 0xba, 0xb8, 0xe2, 0x24, 0xa5,
+	0x1a, 0x92, 0x66, 0x6e, 0x14, 0xae, 0x57, 0xa4, 0xa7, 0x76, 0x14, 0x93, 0x74, 0x16, 0x17, 0x59,
+	0x33, 0xd0, 0x4a, 0x8d, 0x65, 0x47, 0x31, 0x3b, 0xea, 0x75, 0xbb, 0x21, 0xf0, 0x7d, 0x18, 0xa4,
+	0x5b, 0x90, 0xbb, 0x78, 0x93, 0x06, 0xe0, 0x0e, 0xfb, 0xcc, 0xa3, 0x5c, 0xb0, 0x57, 0x8c, 0x86,
+	0xf2, 0xec, 0x17, 0x7a, 0x57, 0x64, 0xfd, 0xc9, 0xa2, 0x6c, 0xee, 0xe3, 0xad, 0x9c, 0x84, 0xda,
+	0xb1, 0x85, 0xcb, 0xaf, 0x61, 0x90, 0x2e, 0x58, 0x88, 0x87, 0xc2, 0x2b, 0x43, 0x12, 0xda, 0xfe,
+	0x5e, 0xc6, 0xff, 0x4b, 0x31, 0xf2, 0x16, 0x5f, 0x3c, 0x1d, 0x3b, 0x52, 0xd8, 0x6d, 0x49, 0x56,
+	0xb5, 0x9b, 0xe7, 0x83, 0x12, 0x5f, 0xe6, 0xee, 0x87, 0x1f, 0x7f, 0x3e, 0xaf, 0x6d, 0x93, 0xaa,
+	0x7d, 0xfa, 0xdf, 0xa0, 0xe2, 0x49, 0x3e, 0x22, 0xbc, 0x99, 0xcf, 0x20, 0xb9, 0xb3, 0x54, 0xb8,
+	0x98, 0x60, 0xad, 0xb9, 0x1a, 0xa8, 0x5c, 0x34, 0xa4, 0x8b, 0x6b, 0xa4, 0x9e, 0x71, 0x11, 0x02,
+	0x88, 0xbe, 0xab, 0xa6, 0x7e, 0x45, 0xb8, 0x7e, 0x66, 0x94, 0xc8, 0xfd, 0xe5, 0xcb, 0x9e, 0x1d,
+	0x4d, 0xad, 0xf5, 0x0f, 0x0c, 0xe5, 0xf2, 0xb6, 0x74, 0x69, 0x10, 0x3d, 0x7b, 0xab, 0x94, 0xd2,
+	0x4f, 0xa3, 0xf8, 0x1e, 0x5f, 0xca, 0x84, 0x80, 0x2c, 0x7f, 0x8a, 0x5c, 0xcc, 0xb4, 0x5b, 0x2b,
+	0x50, 0xca, 0x85, 0x2e, 0x5d, 0xd4, 0xc8, 0x76, 0xc6, 0x85, 0x1b, 0x02, 0xef, 0xcf, 0x63, 0xb3,
+	0xf7, 0xf8, 0x68, 0xaa, 0xa3, 0xe3, 0xa9, 0x8e, 0x7e, 0x4f, 0x75, 0xf4, 0x69, 0xa6, 0x97, 0x8e,
+	0x67, 0x7a, 0xe9, 0xe7, 0x4c, 0x2f, 0xbd, 0xbc, 0xe7, 0x33, 0x31, 0x8c, 0x07, 0x96, 0x0b, 0x6f,
+	0xec, 0xa7, 0x92, 0xdb, 0x1d, 0x3a, 0x8c, 0xa7, 0x3a, 0xe3, 0xb6, 0xfd, 0x2e, 0x11, 0x13, 0x93,
+	0x80, 0x46, 0x83, 0x75, 0xf9, 0x29, 0x7c, 0xf0, 0x77, 0x00, 0x24, 0xdd, 0xe8, 0x49, 0x88, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -152,6 +452,14 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	QuerySudoers(ctx context.Context, in *QuerySudoersRequest, opts ...grpc.CallOption) (*QuerySudoersResponse, error)
+	// QueryRootChanges returns the root change policy and any pending root
+	// changes.
+	QueryRootChanges(ctx context.Context, in *QueryRootChangesRequest, opts ...grpc.CallOption) (*QueryRootChangesResponse, error)
+	// QueryScheduledSudoActions returns the scheduled sudo policy and the sudo
+	// actions waiting for their execution height.
+	QueryScheduledSudoActions(ctx context.Context, in *QueryScheduledSudoActionsRequest, opts ...grpc.CallOption) (*QueryScheduledSudoActionsResponse, error)
+	// QueryCronJobs returns the registered cron jobs.
+	QueryCronJobs(ctx context.Context, in *QueryCronJobsRequest, opts ...grpc.CallOption) (*QueryCronJobsResponse, error)
 }
 
 type queryClient struct {
@@ -171,9 +479,44 @@ func (c *queryClient) QuerySudoers(ctx context.Context, in *QuerySudoersRequest,
 	return out, nil
 }
 
+func (c *queryClient) QueryRootChanges(ctx context.Context, in *QueryRootChangesRequest, opts ...grpc.CallOption) (*QueryRootChangesResponse, error) {
+	out := new(QueryRootChangesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryRootChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryScheduledSudoActions(ctx context.Context, in *QueryScheduledSudoActionsRequest, opts ...grpc.CallOption) (*QueryScheduledSudoActionsResponse, error) {
+	out := new(QueryScheduledSudoActionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryScheduledSudoActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryCronJobs(ctx context.Context, in *QueryCronJobsRequest, opts ...grpc.CallOption) (*QueryCronJobsResponse, error) {
+	out := new(QueryCronJobsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryCronJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	QuerySudoers(context.Context, *QuerySudoersRequest) (*QuerySudoersResponse, error)
+	// QueryRootChanges returns the root change policy and any pending root
+	// changes.
+	QueryRootChanges(context.Context, *QueryRootChangesRequest) (*QueryRootChangesResponse, error)
+	// QueryScheduledSudoActions returns the scheduled sudo policy and the sudo
+	// actions waiting for their execution height.
+	QueryScheduledSudoActions(context.Context, *QueryScheduledSudoActionsRequest) (*QueryScheduledSudoActionsResponse, error)
+	// QueryCronJobs returns the registered cron jobs.
+	QueryCronJobs(context.Context, *QueryCronJobsRequest) (*QueryCronJobsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -183,6 +526,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) QuerySudoers(ctx context.Context, req *QuerySudoersRequest) (*QuerySudoersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuerySudoers not implemented")
 }
+func (*UnimplementedQueryServer) QueryRootChanges(ctx context.Context, req *QueryRootChangesRequest) (*QueryRootChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRootChanges not implemented")
+}
+func (*UnimplementedQueryServer) QueryScheduledSudoActions(ctx context.Context, req *QueryScheduledSudoActionsRequest) (*QueryScheduledSudoActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryScheduledSudoActions not implemented")
+}
+func (*UnimplementedQueryServer) QueryCronJobs(ctx context.Context, req *QueryCronJobsRequest) (*QueryCronJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCronJobs not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -206,6 +558,60 @@ func _Query_QuerySudoers_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryRootChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRootChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRootChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryRootChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRootChanges(ctx, req.(*QueryRootChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryScheduledSudoActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledSudoActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryScheduledSudoActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryScheduledSudoActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryScheduledSudoActions(ctx, req.(*QueryScheduledSudoActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCronJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCronJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCronJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryCronJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCronJobs(ctx, req.(*QueryCronJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.sudo.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -214,6 +620,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QuerySudoers",
 			Handler:    _Query_QuerySudoers_Handler,
 		},
+		{
+			MethodName: "QueryRootChanges",
+			Handler:    _Query_QueryRootChanges_Handler,
+		},
+		{
+			MethodName: "QueryScheduledSudoActions",
+			Handler:    _Query_QueryScheduledSudoActions_Handler,
+		},
+		{
+			MethodName: "QueryCronJobs",
+			Handler:    _Query_QueryCronJobs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/sudo/v1/query.proto",
@@ -275,39 +693,326 @@ func (m *QuerySudoersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRootChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QuerySudoersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryRootChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySudoersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRootChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Sudoers.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryRootChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRootChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRootChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledSudoActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledSudoActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledSudoActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledSudoActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledSudoActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledSudoActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCronJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCronJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCronJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCronJobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCronJobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCronJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySudoersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySudoersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sudoers.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRootChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRootChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduledSudoActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryScheduledSudoActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCronJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCronJobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -445,6 +1150,506 @@ func (m *QuerySudoersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRootChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRootChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRootChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRootChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRootChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRootChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, PendingRootChange{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledSudoActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledSudoActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledSudoActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledSudoActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledSudoActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledSudoActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, ScheduledSudoAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCronJobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCronJobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCronJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCronJobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCronJobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCronJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jobs = append(m.Jobs, CronJob{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,7 +1,16 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/set"
 )
 
 func (sudo Sudoers) Validate() error {
@@ -20,3 +29,172 @@ type SudoersJson struct {
 	Root      string   `json:"root"`
 	Contracts []string `json:"contracts"`
 }
+
+// Validate checks that the co-signers are unique, valid addresses and that the
+// required number of approvals can be reached.
+func (p RootChangePolicy) Validate() error {
+	seen := set.New[string]()
+	for _, cosigner := range p.Cosigners {
+		if _, err := sdk.AccAddressFromBech32(cosigner); err != nil {
+			return ErrRootChange("cosigner addr: " + err.Error())
+		}
+		if seen.Has(cosigner) {
+			return ErrRootChange("duplicate cosigner: " + cosigner)
+		}
+		seen.Add(cosigner)
+	}
+	if p.MinApprovals > uint64(len(p.Cosigners)) {
+		return ErrRootChange(fmt.Sprintf(
+			"min approvals (%d) exceeds the number of cosigners (%d)",
+			p.MinApprovals, len(p.Cosigners),
+		))
+	}
+	return nil
+}
+
+// IsTimelocked returns true if root changes must go through the two-step
+// "MsgProposeRoot" flow instead of taking effect with "MsgChangeRoot".
+func (p RootChangePolicy) IsTimelocked() bool {
+	return p.DelayBlocks > 0 || p.MinApprovals > 0
+}
+
+// IsCosigner returns true if the address is one of the policy's co-signers.
+func (p RootChangePolicy) IsCosigner(addr string) bool {
+	return set.New(p.Cosigners...).Has(addr)
+}
+
+func (pending PendingRootChange) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(pending.NewRoot); err != nil {
+		return ErrRootChange("new root addr: " + err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(pending.Proposer); err != nil {
+		return ErrRootChange("proposer addr: " + err.Error())
+	}
+	if pending.ExecutableHeight < pending.ProposedHeight {
+		return ErrRootChange("executable height precedes proposed height")
+	}
+	for _, approver := range pending.Approvals {
+		if _, err := sdk.AccAddressFromBech32(approver); err != nil {
+			return ErrRootChange("approver addr: " + err.Error())
+		}
+	}
+	if pending.NewPolicy != nil {
+		return pending.NewPolicy.Validate()
+	}
+	return nil
+}
+
+// HasApproval returns true if the address already approved the root change.
+func (pending PendingRootChange) HasApproval(addr string) bool {
+	return set.New(pending.Approvals...).Has(addr)
+}
+
+var _ codectypes.UnpackInterfacesMessage = ScheduledSudoAction{}
+
+func (action ScheduledSudoAction) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(action.Sender); err != nil {
+		return ErrScheduledSudo("sender addr: " + err.Error())
+	}
+	if len(action.Messages) == 0 {
+		return ErrScheduledSudo("no msgs to execute")
+	}
+	if action.ScheduledHeight < 0 {
+		return ErrScheduledSudo("scheduled height must not be negative")
+	}
+	if action.ExecutionHeight <= action.ScheduledHeight {
+		return ErrScheduledSudo("execution height must be after the scheduled height")
+	}
+	return nil
+}
+
+// GetMsgs unpacks the messages of the scheduled action. The interfaces of the
+// action must be unpacked beforehand.
+func (action ScheduledSudoAction) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(action.Messages, "sudo.ScheduledSudoAction")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (action ScheduledSudoAction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, action.Messages)
+}
+
+// MaxCronJobGasLimit is the most gas that a cron job can use per execution.
+// Cron jobs run in the BeginBlocker outside of any tx, so their gas isn't paid
+// for and must be bounded.
+const MaxCronJobGasLimit uint64 = 10_000_000
+
+// ValidateCronJobSchedule checks the epoch identifier and gas limit of a cron
+// job.
+func ValidateCronJobSchedule(epochIdentifier string, gasLimit uint64) error {
+	if strings.TrimSpace(epochIdentifier) == "" {
+		return ErrCronJob("epoch identifier cannot be empty")
+	}
+	if gasLimit == 0 {
+		return ErrCronJob("gas limit must be positive")
+	}
+	if gasLimit > MaxCronJobGasLimit {
+		return ErrCronJob(fmt.Sprintf(
+			"gas limit %d exceeds the maximum of %d", gasLimit, MaxCronJobGasLimit,
+		))
+	}
+	return nil
+}
+
+func (call EvmCall) Validate() error {
+	if !gethcommon.IsHexAddress(call.Contract) {
+		return ErrCronJob(fmt.Sprintf("invalid contract address %q", call.Contract))
+	}
+	return nil
+}
+
+func (call WasmCall) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(call.Contract); err != nil {
+		return ErrCronJob(fmt.Sprintf("invalid contract address %q: %s", call.Contract, err))
+	}
+	if !json.Valid(call.Msg) {
+		return ErrCronJob("contract msg must be valid JSON")
+	}
+	if !call.Funds.IsValid() {
+		return ErrCronJob(fmt.Sprintf("invalid funds %s", call.Funds))
+	}
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = CronJob{}
+
+func (job CronJob) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(job.Sender); err != nil {
+		return ErrCronJob("sender addr: " + err.Error())
+	}
+	if err := ValidateCronJobSchedule(job.EpochIdentifier, job.GasLimit); err != nil {
+		return err
+	}
+	if len(job.Messages) == 0 && len(job.EvmCalls) == 0 && len(job.WasmCalls) == 0 {
+		return ErrCronJob("no msgs, evm calls, or wasm calls to execute")
+	}
+	for idx, call := range job.EvmCalls {
+		if err := call.Validate(); err != nil {
+			return fmt.Errorf("evm call %d: %w", idx, err)
+		}
+	}
+	for idx, call := range job.WasmCalls {
+		if err := call.Validate(); err != nil {
+			return fmt.Errorf("wasm call %d: %w", idx, err)
+		}
+	}
+	if job.Failures > job.Executions {
+		return ErrCronJob("failures cannot exceed executions")
+	}
+	return nil
+}
+
+// GetMsgs unpacks the messages of the cron job. The interfaces of the job must
+// be unpacked beforehand.
+func (job CronJob) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(job.Messages, "sudo.CronJob")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (job CronJob) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, job.Messages)
+}
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -78,23 +81,31 @@ func (m *Sudoers) GetContracts() []string {
 	return nil
 }
 
-// GenesisState: State for migrations and genesis for the x/sudo module.
-type GenesisState struct {
-	Sudoers Sudoers `protobuf:"bytes,1,opt,name=sudoers,proto3" json:"sudoers"`
+// RootChangePolicy: Safeguards that apply when the "root" user is replaced
+// using the two-step flow of "MsgProposeRoot" and "MsgApproveRoot".
+type RootChangePolicy struct {
+	// DelayBlocks: Number of blocks that must pass after a root change is
+	// proposed before it can take effect.
+	DelayBlocks uint64 `protobuf:"varint,1,opt,name=delay_blocks,json=delayBlocks,proto3" json:"delay_blocks,omitempty"`
+	// Cosigners: Addresses allowed to approve (or veto) a pending root change.
+	Cosigners []string `protobuf:"bytes,2,rep,name=cosigners,proto3" json:"cosigners,omitempty"`
+	// MinApprovals: Number of distinct co-signer approvals required before a
+	// pending root change can take effect. This is the "M" in M-of-N.
+	MinApprovals uint64 `protobuf:"varint,3,opt,name=min_approvals,json=minApprovals,proto3" json:"min_approvals,omitempty"`
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
+func (m *RootChangePolicy) Reset()         { *m = RootChangePolicy{} }
+func (m *RootChangePolicy) String() string { return proto.CompactTextString(m) }
+func (*RootChangePolicy) ProtoMessage()    {}
+func (*RootChangePolicy) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4b462ff6aaf658cf, []int{1}
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+func (m *RootChangePolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RootChangePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RootChangePolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -104,173 +115,2999 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *RootChangePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RootChangePolicy.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *RootChangePolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *RootChangePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RootChangePolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_RootChangePolicy proto.InternalMessageInfo
 
-func (m *GenesisState) GetSudoers() Sudoers {
+func (m *RootChangePolicy) GetDelayBlocks() uint64 {
 	if m != nil {
-		return m.Sudoers
+		return m.DelayBlocks
 	}
-	return Sudoers{}
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*Sudoers)(nil), "nibiru.sudo.v1.Sudoers")
-	proto.RegisterType((*GenesisState)(nil), "nibiru.sudo.v1.GenesisState")
+func (m *RootChangePolicy) GetCosigners() []string {
+	if m != nil {
+		return m.Cosigners
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("nibiru/sudo/v1/state.proto", fileDescriptor_4b462ff6aaf658cf) }
+func (m *RootChangePolicy) GetMinApprovals() uint64 {
+	if m != nil {
+		return m.MinApprovals
+	}
+	return 0
+}
 
-var fileDescriptor_4b462ff6aaf658cf = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x18, 0x84, 0x13, 0xa8, 0xa8, 0x62, 0x10, 0x43, 0x84, 0x44, 0x15, 0x55, 0xa6, 0xea, 0xd4, 0x05,
-	0x5b, 0x2d, 0x03, 0x03, 0x5b, 0x19, 0xb2, 0x31, 0xa4, 0x1b, 0x9b, 0x93, 0x5a, 0xae, 0x25, 0xf0,
-	0x1f, 0xd9, 0x7f, 0x22, 0x78, 0x0b, 0x1e, 0xab, 0x63, 0x47, 0x26, 0x84, 0x92, 0x17, 0x41, 0xb1,
-	0x8b, 0x10, 0xdb, 0xc9, 0xdf, 0xe9, 0x7c, 0xff, 0x91, 0xcc, 0xe8, 0x52, 0xdb, 0x86, 0xbb, 0x66,
-	0x0b, 0xbc, 0x5d, 0x72, 0x87, 0x02, 0x25, 0xab, 0x2d, 0x20, 0xa4, 0x97, 0x81, 0xb1, 0x81, 0xb1,
-	0x76, 0x99, 0x5d, 0x29, 0x50, 0xe0, 0x11, 0x1f, 0x54, 0x70, 0x65, 0x53, 0x05, 0xa0, 0x5e, 0x24,
-	0x17, 0xb5, 0xe6, 0xc2, 0x18, 0x40, 0x81, 0x1a, 0x8c, 0x0b, 0x74, 0xfe, 0x40, 0xc6, 0x9b, 0x66,
-	0x0b, 0xd2, 0xba, 0x34, 0x25, 0x23, 0x0b, 0x80, 0x93, 0x78, 0x16, 0x2f, 0x92, 0xc2, 0xeb, 0x74,
-	0x4a, 0x92, 0x0a, 0x0c, 0x5a, 0x51, 0xa1, 0x9b, 0x9c, 0xcc, 0x4e, 0x17, 0x49, 0xf1, 0xf7, 0x30,
-	0xcf, 0xc9, 0x45, 0x2e, 0x8d, 0x74, 0xda, 0x6d, 0x86, 0x5a, 0xe9, 0x3d, 0x19, 0xbb, 0x10, 0xe6,
-	0x43, 0xce, 0x57, 0xd7, 0xec, 0x7f, 0x45, 0x76, 0xfc, 0x6b, 0x3d, 0xda, 0x7f, 0xdd, 0x44, 0xc5,
-	0xaf, 0x7b, 0x9d, 0xef, 0x3b, 0x1a, 0x1f, 0x3a, 0x1a, 0x7f, 0x77, 0x34, 0xfe, 0xe8, 0x69, 0x74,
-	0xe8, 0x69, 0xf4, 0xd9, 0xd3, 0xe8, 0xf9, 0x56, 0x69, 0xdc, 0x35, 0x25, 0xab, 0xe0, 0x95, 0x3f,
-	0xf9, 0xac, 0xc7, 0x9d, 0xd0, 0x86, 0x1f, 0x67, 0x69, 0x57, 0xfc, 0x2d, 0x6c, 0x83, 0xef, 0xb5,
-	0x74, 0xe5, 0x99, 0xbf, 0xea, 0xee, 0x27, 0x00, 0x00, 0xff, 0xff, 0x84, 0x4f, 0x6d, 0x13, 0x37,
-	0x01, 0x00, 0x00,
+// PendingRootChange: A proposed change of the "root" user that has not taken
+// effect yet.
+type PendingRootChange struct {
+	// Id: Unique identifier for the root change proposal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// NewRoot: Address that will become the root once the change takes effect.
+	NewRoot string `protobuf:"bytes,2,opt,name=new_root,json=newRoot,proto3" json:"new_root,omitempty"`
+	// Proposer: Root address that proposed the change.
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// ProposedHeight: Block height at which the change was proposed.
+	ProposedHeight int64 `protobuf:"varint,4,opt,name=proposed_height,json=proposedHeight,proto3" json:"proposed_height,omitempty"`
+	// ExecutableHeight: Earliest block height at which the change can take
+	// effect.
+	ExecutableHeight int64 `protobuf:"varint,5,opt,name=executable_height,json=executableHeight,proto3" json:"executable_height,omitempty"`
+	// Approvals: Co-signers that have approved the change.
+	Approvals []string `protobuf:"bytes,6,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// NewPolicy: Optional replacement for the "RootChangePolicy" that takes
+	// effect along with the new root.
+	NewPolicy *RootChangePolicy `protobuf:"bytes,7,opt,name=new_policy,json=newPolicy,proto3" json:"new_policy,omitempty"`
 }
 
-func (m *Sudoers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingRootChange) Reset()         { *m = PendingRootChange{} }
+func (m *PendingRootChange) String() string { return proto.CompactTextString(m) }
+func (*PendingRootChange) ProtoMessage()    {}
+func (*PendingRootChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{2}
+}
+func (m *PendingRootChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRootChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRootChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PendingRootChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRootChange.Merge(m, src)
+}
+func (m *PendingRootChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRootChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRootChange.DiscardUnknown(m)
 }
 
-func (m *Sudoers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PendingRootChange proto.InternalMessageInfo
+
+func (m *PendingRootChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *Sudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contracts) > 0 {
-		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Contracts[iNdEx])
-			copy(dAtA[i:], m.Contracts[iNdEx])
-			i = encodeVarintState(dAtA, i, uint64(len(m.Contracts[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *PendingRootChange) GetNewRoot() string {
+	if m != nil {
+		return m.NewRoot
+	}
+	return ""
+}
+
+func (m *PendingRootChange) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *PendingRootChange) GetProposedHeight() int64 {
+	if m != nil {
+		return m.ProposedHeight
+	}
+	return 0
+}
+
+func (m *PendingRootChange) GetExecutableHeight() int64 {
+	if m != nil {
+		return m.ExecutableHeight
+	}
+	return 0
+}
+
+func (m *PendingRootChange) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *PendingRootChange) GetNewPolicy() *RootChangePolicy {
+	if m != nil {
+		return m.NewPolicy
+	}
+	return nil
+}
+
+// ScheduledSudoPolicy: Safeguards that apply to sudo actions scheduled with
+// "MsgScheduleSudo". It can only be changed by governance.
+type ScheduledSudoPolicy struct {
+	// MinDelayBlocks: Minimum number of blocks between the block in which an
+	// action is scheduled and its execution height.
+	MinDelayBlocks uint64 `protobuf:"varint,1,opt,name=min_delay_blocks,json=minDelayBlocks,proto3" json:"min_delay_blocks,omitempty"`
+}
+
+func (m *ScheduledSudoPolicy) Reset()         { *m = ScheduledSudoPolicy{} }
+func (m *ScheduledSudoPolicy) String() string { return proto.CompactTextString(m) }
+func (*ScheduledSudoPolicy) ProtoMessage()    {}
+func (*ScheduledSudoPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{3}
+}
+func (m *ScheduledSudoPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledSudoPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledSudoPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Root)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ScheduledSudoPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledSudoPolicy.Merge(m, src)
+}
+func (m *ScheduledSudoPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledSudoPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledSudoPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledSudoPolicy proto.InternalMessageInfo
+
+func (m *ScheduledSudoPolicy) GetMinDelayBlocks() uint64 {
+	if m != nil {
+		return m.MinDelayBlocks
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// ScheduledSudoAction: Sudo messages queued with "MsgScheduleSudo" that are
+// executed in the EndBlocker once the chain reaches the execution height.
+type ScheduledSudoAction struct {
+	// Id: Unique identifier for the scheduled action.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Sender: Sudoer that scheduled the action and signer of every message.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ScheduledHeight: Block height at which the action was scheduled.
+	ScheduledHeight int64 `protobuf:"varint,3,opt,name=scheduled_height,json=scheduledHeight,proto3" json:"scheduled_height,omitempty"`
+	// ExecutionHeight: Block height at which the messages are executed.
+	ExecutionHeight int64 `protobuf:"varint,4,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	// Messages: Messages executed atomically through the message router.
+	Messages []*types.Any `protobuf:"bytes,5,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *ScheduledSudoAction) Reset()         { *m = ScheduledSudoAction{} }
+func (m *ScheduledSudoAction) String() string { return proto.CompactTextString(m) }
+func (*ScheduledSudoAction) ProtoMessage()    {}
+func (*ScheduledSudoAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{4}
+}
+func (m *ScheduledSudoAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledSudoAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledSudoAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ScheduledSudoAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledSudoAction.Merge(m, src)
+}
+func (m *ScheduledSudoAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledSudoAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledSudoAction.DiscardUnknown(m)
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ScheduledSudoAction proto.InternalMessageInfo
+
+func (m *ScheduledSudoAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Sudoers.MarshalToSizedBuffer(dAtA[:i])
+func (m *ScheduledSudoAction) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ScheduledSudoAction) GetScheduledHeight() int64 {
+	if m != nil {
+		return m.ScheduledHeight
+	}
+	return 0
+}
+
+func (m *ScheduledSudoAction) GetExecutionHeight() int64 {
+	if m != nil {
+		return m.ExecutionHeight
+	}
+	return 0
+}
+
+func (m *ScheduledSudoAction) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+// CronJob: Messages and EVM and Wasm contract calls registered with
+// "MsgRegisterCronJob" that are executed at the end of every epoch with the job's epoch identifier.
+type CronJob struct {
+	// Id: Unique identifier for the cron job.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Sender: Sudoer or governance account that registered the job. It is the
+	// signer of every message and the caller of every EVM and Wasm call.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// EpochIdentifier: Identifier of the epoch from the x/epochs module, like
+	// "day" or "week", at the end of which the job runs.
+	EpochIdentifier string `protobuf:"bytes,3,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// Messages: Messages executed through the message router.
+	Messages []*types.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	// EvmCalls: EVM contract calls executed after the messages.
+	EvmCalls []EvmCall `protobuf:"bytes,5,rep,name=evm_calls,json=evmCalls,proto3" json:"evm_calls"`
+	// GasLimit: Gas available to each execution of the job. A job that runs out
+	// of gas fails without affecting the rest of the block.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// CreatedHeight: Block height at which the job was registered.
+	CreatedHeight int64 `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// Executions: Number of times the job has run, successfully or not.
+	Executions uint64 `protobuf:"varint,8,opt,name=executions,proto3" json:"executions,omitempty"`
+	// Failures: Number of executions that failed.
+	Failures uint64 `protobuf:"varint,9,opt,name=failures,proto3" json:"failures,omitempty"`
+	// LastResult: Outcome of the most recent execution, if any.
+	LastResult *CronJobResult `protobuf:"bytes,10,opt,name=last_result,json=lastResult,proto3" json:"last_result,omitempty"`
+	// WasmCalls: Wasm contract executions made after the EVM calls.
+	WasmCalls []WasmCall `protobuf:"bytes,11,rep,name=wasm_calls,json=wasmCalls,proto3" json:"wasm_calls"`
+}
+
+func (m *CronJob) Reset()         { *m = CronJob{} }
+func (m *CronJob) String() string { return proto.CompactTextString(m) }
+func (*CronJob) ProtoMessage()    {}
+func (*CronJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{5}
+}
+func (m *CronJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *CronJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronJob.Merge(m, src)
+}
+func (m *CronJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronJob.DiscardUnknown(m)
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_CronJob proto.InternalMessageInfo
+
+func (m *CronJob) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Sudoers) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CronJob) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	var l int
-	_ = l
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *CronJob) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
 	}
-	if len(m.Contracts) > 0 {
-		for _, s := range m.Contracts {
-			l = len(s)
-			n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *CronJob) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *CronJob) GetEvmCalls() []EvmCall {
+	if m != nil {
+		return m.EvmCalls
+	}
+	return nil
+}
+
+func (m *CronJob) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *CronJob) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *CronJob) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+func (m *CronJob) GetFailures() uint64 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *CronJob) GetLastResult() *CronJobResult {
+	if m != nil {
+		return m.LastResult
+	}
+	return nil
+}
+
+func (m *CronJob) GetWasmCalls() []WasmCall {
+	if m != nil {
+		return m.WasmCalls
+	}
+	return nil
+}
+
+// EvmCall: A call of an EVM contract made by a cron job.
+type EvmCall struct {
+	// Contract: Hex address of the contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Input: ABI-encoded call data.
+	Input []byte `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (m *EvmCall) Reset()         { *m = EvmCall{} }
+func (m *EvmCall) String() string { return proto.CompactTextString(m) }
+func (*EvmCall) ProtoMessage()    {}
+func (*EvmCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{6}
+}
+func (m *EvmCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmCall.Merge(m, src)
+}
+func (m *EvmCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmCall proto.InternalMessageInfo
+
+func (m *EvmCall) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EvmCall) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+// WasmCall: An execution of a Wasm contract made by a cron job.
+type WasmCall struct {
+	// Contract: Bech32 address of the contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Msg: JSON-encoded "ExecuteMsg" of the contract.
+	Msg []byte `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Funds: Coins sent from the sender of the job to the contract.
+	Funds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
+}
+
+func (m *WasmCall) Reset()         { *m = WasmCall{} }
+func (m *WasmCall) String() string { return proto.CompactTextString(m) }
+func (*WasmCall) ProtoMessage()    {}
+func (*WasmCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{7}
+}
+func (m *WasmCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WasmCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WasmCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WasmCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmCall.Merge(m, src)
+}
+func (m *WasmCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *WasmCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmCall proto.InternalMessageInfo
+
+func (m *WasmCall) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *WasmCall) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *WasmCall) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
+}
+
+// CronJobResult: Outcome of one execution of a cron job.
+type CronJobResult struct {
+	// EpochNumber: Number of the epoch whose end triggered the execution.
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// Height: Block height of the execution.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Success: Whether every message and contract call succeeded. The job is
+	// applied atomically, so a failure reverts all of them.
+	Success bool `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Error: Reason for the failure, if any.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// GasUsed: Gas consumed by the execution.
+	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *CronJobResult) Reset()         { *m = CronJobResult{} }
+func (m *CronJobResult) String() string { return proto.CompactTextString(m) }
+func (*CronJobResult) ProtoMessage()    {}
+func (*CronJobResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{8}
+}
+func (m *CronJobResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronJobResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronJobResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CronJobResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronJobResult.Merge(m, src)
+}
+func (m *CronJobResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronJobResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronJobResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronJobResult proto.InternalMessageInfo
+
+func (m *CronJobResult) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *CronJobResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CronJobResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *CronJobResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CronJobResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+// GenesisState: State for migrations and genesis for the x/sudo module.
+type GenesisState struct {
+	Sudoers Sudoers `protobuf:"bytes,1,opt,name=sudoers,proto3" json:"sudoers"`
+	// RootChangePolicy: Safeguards that apply when changing the root.
+	RootChangePolicy RootChangePolicy `protobuf:"bytes,2,opt,name=root_change_policy,json=rootChangePolicy,proto3" json:"root_change_policy"`
+	// PendingRootChanges: Root changes that have not taken effect yet.
+	PendingRootChanges []PendingRootChange `protobuf:"bytes,3,rep,name=pending_root_changes,json=pendingRootChanges,proto3" json:"pending_root_changes"`
+	// ScheduledSudoActions: Sudo actions waiting for their execution height.
+	ScheduledSudoActions []ScheduledSudoAction `protobuf:"bytes,4,rep,name=scheduled_sudo_actions,json=scheduledSudoActions,proto3" json:"scheduled_sudo_actions"`
+	// CronJobs: Jobs executed at the end of every epoch of their identifier.
+	CronJobs []CronJob `protobuf:"bytes,5,rep,name=cron_jobs,json=cronJobs,proto3" json:"cron_jobs"`
+	// ScheduledSudoPolicy: Safeguards that apply to scheduled sudo actions.
+	ScheduledSudoPolicy ScheduledSudoPolicy `protobuf:"bytes,6,opt,name=scheduled_sudo_policy,json=scheduledSudoPolicy,proto3" json:"scheduled_sudo_policy"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{9}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetSudoers() Sudoers {
+	if m != nil {
+		return m.Sudoers
+	}
+	return Sudoers{}
+}
+
+func (m *GenesisState) GetRootChangePolicy() RootChangePolicy {
+	if m != nil {
+		return m.RootChangePolicy
+	}
+	return RootChangePolicy{}
+}
+
+func (m *GenesisState) GetPendingRootChanges() []PendingRootChange {
+	if m != nil {
+		return m.PendingRootChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledSudoActions() []ScheduledSudoAction {
+	if m != nil {
+		return m.ScheduledSudoActions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCronJobs() []CronJob {
+	if m != nil {
+		return m.CronJobs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledSudoPolicy() ScheduledSudoPolicy {
+	if m != nil {
+		return m.ScheduledSudoPolicy
+	}
+	return ScheduledSudoPolicy{}
+}
+
+func init() {
+	proto.RegisterType((*Sudoers)(nil), "nibiru.sudo.v1.Sudoers")
+	proto.RegisterType((*RootChangePolicy)(nil), "nibiru.sudo.v1.RootChangePolicy")
+	proto.RegisterType((*PendingRootChange)(nil), "nibiru.sudo.v1.PendingRootChange")
+	proto.RegisterType((*ScheduledSudoPolicy)(nil), "nibiru.sudo.v1.ScheduledSudoPolicy")
+	proto.RegisterType((*ScheduledSudoAction)(nil), "nibiru.sudo.v1.ScheduledSudoAction")
+	proto.RegisterType((*CronJob)(nil), "nibiru.sudo.v1.CronJob")
+	proto.RegisterType((*EvmCall)(nil), "nibiru.sudo.v1.EvmCall")
+	proto.RegisterType((*WasmCall)(nil), "nibiru.sudo.v1.WasmCall")
+	proto.RegisterType((*CronJobResult)(nil), "nibiru.sudo.v1.CronJobResult")
+	proto.RegisterType((*GenesisState)(nil), "nibiru.sudo.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("nibiru/sudo/v1/state.proto", fileDescriptor_4b462ff6aaf658cf) }
+
+var fileDescriptor_4b462ff6aaf658cf = []byte{
+	// 1056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6e, 0xdc, 0xc4,
+	0x17, 0x8f, 0x77, 0x37, 0xd9, 0xf5, 0xc9, 0xd7, 0x76, 0x9a, 0x7f, 0xff, 0x4e, 0x08, 0xdb, 0xed,
+	0x56, 0x88, 0xad, 0x50, 0xed, 0x26, 0x5c, 0x20, 0x81, 0xa0, 0x4a, 0x02, 0x2a, 0x20, 0x54, 0x55,
+	0x0e, 0x08, 0x81, 0x84, 0xac, 0xb1, 0x3d, 0xf1, 0x0e, 0xb5, 0x67, 0x2c, 0x8f, 0xbd, 0x49, 0x9e,
+	0x02, 0x6e, 0xb8, 0xe2, 0x0d, 0x78, 0x0f, 0xa4, 0x72, 0xd7, 0x4b, 0xae, 0x00, 0x25, 0xaf, 0xc0,
+	0x03, 0xa0, 0xf9, 0xf0, 0x7e, 0x06, 0x28, 0x57, 0x3b, 0xe7, 0x77, 0x66, 0x8e, 0xcf, 0xf9, 0x9d,
+	0x33, 0xbf, 0x59, 0xd8, 0x63, 0x34, 0xa4, 0x45, 0xe5, 0x89, 0x2a, 0xe6, 0xde, 0xf8, 0xc0, 0x13,
+	0x25, 0x2e, 0x89, 0x9b, 0x17, 0xbc, 0xe4, 0x68, 0x4b, 0xfb, 0x5c, 0xe9, 0x73, 0xc7, 0x07, 0x7b,
+	0xbd, 0x88, 0x8b, 0x8c, 0x0b, 0x2f, 0xc4, 0x82, 0x78, 0xe3, 0x83, 0x90, 0x94, 0xf8, 0xc0, 0x8b,
+	0x38, 0x65, 0x7a, 0xff, 0xde, 0x4e, 0xc2, 0x13, 0xae, 0x96, 0x9e, 0x5c, 0x19, 0x74, 0x3f, 0xe1,
+	0x3c, 0x49, 0x89, 0x87, 0x73, 0xea, 0x61, 0xc6, 0x78, 0x89, 0x4b, 0xca, 0x99, 0x30, 0xde, 0x5d,
+	0xe3, 0x55, 0x56, 0x58, 0x9d, 0x79, 0x98, 0x5d, 0x6a, 0xd7, 0xe0, 0x3d, 0x68, 0x9f, 0x56, 0x31,
+	0x27, 0x85, 0x40, 0x08, 0x5a, 0x05, 0xe7, 0xa5, 0x63, 0xf5, 0xad, 0xa1, 0xed, 0xab, 0x35, 0xda,
+	0x07, 0x3b, 0xe2, 0xac, 0x2c, 0x70, 0x54, 0x0a, 0xa7, 0xd1, 0x6f, 0x0e, 0x6d, 0x7f, 0x0a, 0x0c,
+	0x2e, 0xa0, 0xeb, 0x73, 0x5e, 0x9e, 0x8c, 0x30, 0x4b, 0xc8, 0x33, 0x9e, 0xd2, 0xe8, 0x12, 0xdd,
+	0x83, 0x8d, 0x98, 0xa4, 0xf8, 0x32, 0x08, 0x53, 0x1e, 0x3d, 0x17, 0x2a, 0x5a, 0xcb, 0x5f, 0x57,
+	0xd8, 0xb1, 0x82, 0x74, 0x50, 0x41, 0x13, 0x46, 0x8a, 0x99, 0xa0, 0x06, 0x40, 0xf7, 0x61, 0x33,
+	0xa3, 0x2c, 0xc0, 0x79, 0x5e, 0xf0, 0x31, 0x4e, 0x85, 0xd3, 0x54, 0x11, 0x36, 0x32, 0xca, 0x8e,
+	0x6a, 0x6c, 0xf0, 0x5d, 0x03, 0x6e, 0x3d, 0x23, 0x2c, 0xa6, 0x2c, 0x99, 0x66, 0x80, 0xb6, 0xa0,
+	0x41, 0x63, 0xf3, 0xc5, 0x06, 0x8d, 0xd1, 0x2e, 0x74, 0x18, 0x39, 0x0f, 0x54, 0x55, 0x0d, 0x55,
+	0x55, 0x9b, 0x91, 0x73, 0x79, 0x00, 0xed, 0x41, 0x27, 0x2f, 0x78, 0xce, 0x05, 0x29, 0xd4, 0x07,
+	0x6c, 0x7f, 0x62, 0xa3, 0x37, 0x61, 0xdb, 0xac, 0xe3, 0x60, 0x44, 0x68, 0x32, 0x2a, 0x9d, 0x56,
+	0xdf, 0x1a, 0x36, 0xfd, 0xad, 0x1a, 0xfe, 0x58, 0xa1, 0xe8, 0x2d, 0xb8, 0x45, 0x2e, 0x48, 0x54,
+	0x95, 0x38, 0x4c, 0x49, 0xbd, 0x75, 0x55, 0x6d, 0xed, 0x4e, 0x1d, 0x66, 0xf3, 0x3e, 0xd8, 0xd3,
+	0x9a, 0xd6, 0x74, 0xd5, 0x13, 0x00, 0x3d, 0x06, 0x90, 0xa9, 0xe6, 0x8a, 0x44, 0xa7, 0xdd, 0xb7,
+	0x86, 0xeb, 0x87, 0x7d, 0x77, 0x7e, 0x36, 0xdc, 0x45, 0xb2, 0x7d, 0x9b, 0x91, 0x73, 0xbd, 0x1c,
+	0x3c, 0x86, 0xdb, 0xa7, 0xd1, 0x88, 0xc4, 0x55, 0x4a, 0x62, 0xd9, 0x51, 0xd3, 0x8e, 0x21, 0x74,
+	0x25, 0x9b, 0x37, 0xb4, 0x64, 0x2b, 0xa3, 0xec, 0xc3, 0x69, 0x57, 0x06, 0x3f, 0x5b, 0x0b, 0x11,
+	0x8e, 0x22, 0x39, 0x43, 0x4b, 0xa4, 0xde, 0x81, 0x35, 0x41, 0x58, 0x4c, 0x0a, 0x43, 0xa9, 0xb1,
+	0xd0, 0x03, 0xe8, 0x8a, 0xfa, 0x78, 0xcd, 0x45, 0x53, 0x71, 0xb1, 0x3d, 0xc1, 0x0d, 0x15, 0x0f,
+	0xc0, 0xd0, 0x43, 0x39, 0x9b, 0x67, 0x78, 0x7b, 0x82, 0x9b, 0xad, 0x8f, 0xa0, 0x93, 0x11, 0x21,
+	0x70, 0x42, 0x84, 0xb3, 0xda, 0x6f, 0x0e, 0xd7, 0x0f, 0x77, 0x5c, 0x3d, 0xcd, 0x6e, 0x3d, 0xcd,
+	0xee, 0x11, 0xbb, 0xf4, 0x27, 0xbb, 0x06, 0xbf, 0x34, 0xa1, 0x7d, 0x52, 0x70, 0xf6, 0x29, 0x0f,
+	0xff, 0x4b, 0xee, 0x24, 0xe7, 0xd1, 0x28, 0xa0, 0x31, 0x61, 0x25, 0x3d, 0xa3, 0x93, 0xa9, 0xd8,
+	0x56, 0xf8, 0x27, 0x13, 0x78, 0x2e, 0xa1, 0xd6, 0xab, 0x24, 0x84, 0xde, 0x05, 0x9b, 0x8c, 0xb3,
+	0x20, 0xc2, 0x69, 0x5a, 0xd7, 0xf0, 0xff, 0xc5, 0xce, 0x7e, 0x34, 0xce, 0x4e, 0x70, 0x9a, 0x1e,
+	0xb7, 0x5e, 0xfc, 0x76, 0x77, 0xc5, 0xef, 0x10, 0x6d, 0x0a, 0xf4, 0x1a, 0xd8, 0x09, 0x16, 0x41,
+	0x4a, 0x33, 0x5a, 0x3a, 0x6b, 0xaa, 0x8e, 0x4e, 0x82, 0xc5, 0x67, 0xd2, 0x46, 0x6f, 0xc0, 0x56,
+	0x54, 0x10, 0x5c, 0x4e, 0xf9, 0x6e, 0x2b, 0x12, 0x37, 0x0d, 0x6a, 0x28, 0xec, 0x01, 0x4c, 0x58,
+	0x15, 0x4e, 0x47, 0x05, 0x99, 0x41, 0xe4, 0x55, 0x38, 0xc3, 0x34, 0xad, 0x0a, 0x22, 0x1c, 0x5b,
+	0x7f, 0xa2, 0xb6, 0xd1, 0x07, 0xb0, 0x9e, 0x62, 0x51, 0x06, 0x05, 0x11, 0x55, 0x5a, 0x3a, 0xa0,
+	0xe6, 0xf2, 0xf5, 0xc5, 0xec, 0x0d, 0xdd, 0xbe, 0xda, 0xe4, 0x83, 0x3c, 0xa1, 0xd7, 0xe8, 0x7d,
+	0x80, 0x73, 0x2c, 0xea, 0xe2, 0xd7, 0x55, 0xf1, 0xce, 0xe2, 0xf1, 0x2f, 0xb1, 0x98, 0xad, 0xde,
+	0x3e, 0x37, 0xb6, 0x90, 0xea, 0x64, 0x98, 0x91, 0x59, 0xd6, 0xc2, 0x63, 0x14, 0x6a, 0x62, 0xa3,
+	0x1d, 0x58, 0xa5, 0x2c, 0xaf, 0xf4, 0x25, 0xdf, 0xf0, 0xb5, 0x31, 0xf8, 0xd1, 0x82, 0x4e, 0x1d,
+	0xfa, 0x1f, 0x8f, 0x77, 0xa1, 0x99, 0x89, 0xc4, 0x1c, 0x96, 0x4b, 0x84, 0x61, 0xf5, 0xac, 0x62,
+	0xb1, 0xd4, 0x1e, 0x99, 0xf1, 0xae, 0xab, 0x45, 0xd9, 0x95, 0xa2, 0xec, 0x1a, 0x51, 0x76, 0x4f,
+	0x38, 0x65, 0xc7, 0x8f, 0x64, 0xca, 0x3f, 0xfd, 0x7e, 0x77, 0x98, 0xd0, 0x72, 0x54, 0x85, 0x6e,
+	0xc4, 0x33, 0xcf, 0x28, 0xb8, 0xfe, 0x79, 0x28, 0xe2, 0xe7, 0x5e, 0x79, 0x99, 0x13, 0xa1, 0x0e,
+	0x08, 0x5f, 0x47, 0x1e, 0xfc, 0x60, 0xc1, 0xe6, 0x1c, 0x6f, 0x52, 0x39, 0xf5, 0x10, 0xb2, 0x2a,
+	0x0b, 0x49, 0x51, 0x2b, 0xa7, 0xc2, 0x9e, 0x2a, 0x48, 0xce, 0xaf, 0xe9, 0x74, 0x43, 0x75, 0xda,
+	0x58, 0xc8, 0x81, 0xb6, 0xa8, 0xa2, 0x88, 0x08, 0xad, 0x96, 0x1d, 0xbf, 0x36, 0x25, 0x35, 0xa4,
+	0x28, 0x78, 0xa1, 0xee, 0x97, 0xed, 0x6b, 0x43, 0x0a, 0xa3, 0x1c, 0xab, 0x4a, 0x90, 0x58, 0xe9,
+	0x55, 0xcb, 0x6f, 0x27, 0x58, 0x7c, 0x21, 0x48, 0x3c, 0xf8, 0xb3, 0x09, 0x1b, 0x4f, 0x08, 0x23,
+	0x82, 0x8a, 0x53, 0xf9, 0x4c, 0xa1, 0x77, 0x64, 0x6c, 0xf5, 0x42, 0xa8, 0x8c, 0x6e, 0x18, 0x5e,
+	0xf3, 0x80, 0x98, 0xf6, 0xd5, 0xbb, 0xd1, 0xe7, 0x80, 0xa4, 0xf2, 0x06, 0x91, 0x52, 0xac, 0x5a,
+	0xda, 0x1a, 0xaf, 0x26, 0x6d, 0x26, 0x58, 0xb7, 0x58, 0xc0, 0xd1, 0x57, 0xb0, 0x93, 0x6b, 0xe1,
+	0x0f, 0x66, 0xa2, 0xd7, 0x9d, 0xba, 0xb7, 0x18, 0x77, 0xe9, 0x91, 0x30, 0x81, 0x51, 0xbe, 0xe8,
+	0x10, 0x28, 0x80, 0x3b, 0x53, 0x05, 0x93, 0x01, 0x02, 0x1c, 0xe9, 0x4b, 0xa3, 0x2f, 0xfa, 0xfd,
+	0xa5, 0xc2, 0x97, 0xe5, 0xd2, 0x84, 0xdf, 0x11, 0xcb, 0x2e, 0xa5, 0x04, 0x51, 0xc1, 0x59, 0xf0,
+	0x2d, 0x0f, 0xff, 0x56, 0x09, 0xcc, 0x4c, 0xd4, 0x4a, 0x10, 0x69, 0x53, 0xa0, 0x6f, 0xe0, 0x7f,
+	0x0b, 0xc9, 0x19, 0x42, 0xd7, 0xfa, 0xd6, 0xbf, 0xe6, 0x36, 0xc7, 0xe9, 0x6d, 0x71, 0x83, 0xeb,
+	0xc9, 0x8b, 0xab, 0x9e, 0xf5, 0xf2, 0xaa, 0x67, 0xfd, 0x71, 0xd5, 0xb3, 0xbe, 0xbf, 0xee, 0xad,
+	0xbc, 0xbc, 0xee, 0xad, 0xfc, 0x7a, 0xdd, 0x5b, 0xf9, 0xfa, 0xe1, 0xcc, 0x64, 0x3f, 0x55, 0xdf,
+	0x38, 0x19, 0x61, 0xca, 0x3c, 0xf3, 0x9f, 0x66, 0x7c, 0xe8, 0x5d, 0xe8, 0x3f, 0x36, 0x6a, 0xc8,
+	0xc3, 0x35, 0xa5, 0x82, 0x6f, 0xff, 0x35, 0x00, 0x0c, 0xdf, 0x24, 0xb5, 0xf4, 0x08, 0x00, 0x00,
+}
+
+func (m *Sudoers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sudoers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RootChangePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RootChangePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RootChangePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinApprovals != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MinApprovals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cosigners) > 0 {
+		for iNdEx := len(m.Cosigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cosigners[iNdEx])
+			copy(dAtA[i:], m.Cosigners[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.Cosigners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DelayBlocks != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.DelayBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingRootChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRootChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRootChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPolicy != nil {
+		{
+			size, err := m.NewPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ExecutableHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ExecutableHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ProposedHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProposedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewRoot) > 0 {
+		i -= len(m.NewRoot)
+		copy(dAtA[i:], m.NewRoot)
+		i = encodeVarintState(dAtA, i, uint64(len(m.NewRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledSudoPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledSudoPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledSudoPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinDelayBlocks != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MinDelayBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledSudoAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledSudoAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledSudoAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduledHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ScheduledHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WasmCalls) > 0 {
+		for iNdEx := len(m.WasmCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WasmCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LastResult != nil {
+		{
+			size, err := m.LastResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Failures != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Executions != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EvmCalls) > 0 {
+		for iNdEx := len(m.EvmCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintState(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Input) > 0 {
+		i -= len(m.Input)
+		copy(dAtA[i:], m.Input)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Input)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WasmCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WasmCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronJobResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronJobResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronJobResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ScheduledSudoPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.CronJobs) > 0 {
+		for iNdEx := len(m.CronJobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CronJobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ScheduledSudoActions) > 0 {
+		for iNdEx := len(m.ScheduledSudoActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledSudoActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PendingRootChanges) > 0 {
+		for iNdEx := len(m.PendingRootChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRootChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.RootChangePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Sudoers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Sudoers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RootChangePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelayBlocks != 0 {
+		n += 1 + sovState(uint64(m.DelayBlocks))
+	}
+	if len(m.Cosigners) > 0 {
+		for _, s := range m.Cosigners {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.MinApprovals != 0 {
+		n += 1 + sovState(uint64(m.MinApprovals))
+	}
+	return n
+}
+
+func (m *PendingRootChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.NewRoot)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ProposedHeight != 0 {
+		n += 1 + sovState(uint64(m.ProposedHeight))
+	}
+	if m.ExecutableHeight != 0 {
+		n += 1 + sovState(uint64(m.ExecutableHeight))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.NewPolicy != nil {
+		l = m.NewPolicy.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledSudoPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinDelayBlocks != 0 {
+		n += 1 + sovState(uint64(m.MinDelayBlocks))
+	}
+	return n
+}
+
+func (m *ScheduledSudoAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ScheduledHeight != 0 {
+		n += 1 + sovState(uint64(m.ScheduledHeight))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovState(uint64(m.ExecutionHeight))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CronJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if len(m.EvmCalls) > 0 {
+		for _, e := range m.EvmCalls {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovState(uint64(m.GasLimit))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovState(uint64(m.CreatedHeight))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovState(uint64(m.Executions))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovState(uint64(m.Failures))
+	}
+	if m.LastResult != nil {
+		l = m.LastResult.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.WasmCalls) > 0 {
+		for _, e := range m.WasmCalls {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvmCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *WasmCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CronJobResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovState(uint64(m.EpochNumber))
+	}
+	if m.Height != 0 {
+		n += 1 + sovState(uint64(m.Height))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovState(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sudoers.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.RootChangePolicy.Size()
+	n += 1 + l + sovState(uint64(l))
+	if len(m.PendingRootChanges) > 0 {
+		for _, e := range m.PendingRootChanges {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if len(m.ScheduledSudoActions) > 0 {
+		for _, e := range m.ScheduledSudoActions {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if len(m.CronJobs) > 0 {
+		for _, e := range m.CronJobs {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = m.ScheduledSudoPolicy.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Sudoers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sudoers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RootChangePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RootChangePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RootChangePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayBlocks", wireType)
+			}
+			m.DelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cosigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cosigners = append(m.Cosigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinApprovals", wireType)
+			}
+			m.MinApprovals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinApprovals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRootChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRootChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRootChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedHeight", wireType)
+			}
+			m.ProposedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableHeight", wireType)
+			}
+			m.ExecutableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPolicy == nil {
+				m.NewPolicy = &RootChangePolicy{}
+			}
+			if err := m.NewPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledSudoPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledSudoPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledSudoPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelayBlocks", wireType)
+			}
+			m.MinDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledSudoAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledSudoAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledSudoAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledHeight", wireType)
+			}
+			m.ScheduledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmCalls = append(m.EvmCalls, EvmCall{})
+			if err := m.EvmCalls[len(m.EvmCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastResult == nil {
+				m.LastResult = &CronJobResult{}
+			}
+			if err := m.LastResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WasmCalls = append(m.WasmCalls, WasmCall{})
+			if err := m.WasmCalls[len(m.WasmCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WasmCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WasmCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WasmCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types1.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Sudoers.Size()
-	n += 1 + l + sovState(uint64(l))
-	return n
-}
-
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Sudoers) Unmarshal(dAtA []byte) error {
+func (m *CronJobResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -293,17 +3130,17 @@ func (m *Sudoers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sudoers: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronJobResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronJobResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			var stringLen uint64
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -313,27 +3150,53 @@ func (m *Sudoers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.Root = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -361,8 +3224,27 @@ func (m *Sudoers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -446,6 +3328,174 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootChangePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RootChangePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRootChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRootChanges = append(m.PendingRootChanges, PendingRootChange{})
+			if err := m.PendingRootChanges[len(m.PendingRootChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledSudoActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledSudoActions = append(m.ScheduledSudoActions, ScheduledSudoAction{})
+			if err := m.ScheduledSudoActions[len(m.ScheduledSudoActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronJobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronJobs = append(m.CronJobs, CronJob{})
+			if err := m.CronJobs[len(m.CronJobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledSudoPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledSudoPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -224,366 +225,3774 @@ func (m *MsgChangeRootResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChangeRootResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgEditSudoers)(nil), "nibiru.sudo.v1.MsgEditSudoers")
-	proto.RegisterType((*MsgEditSudoersResponse)(nil), "nibiru.sudo.v1.MsgEditSudoersResponse")
-	proto.RegisterType((*MsgChangeRoot)(nil), "nibiru.sudo.v1.MsgChangeRoot")
-	proto.RegisterType((*MsgChangeRootResponse)(nil), "nibiru.sudo.v1.MsgChangeRootResponse")
+// MsgProposeRoot: Msg to propose a timelocked change of the root user. The
+// change takes effect once "RootChangePolicy.DelayBlocks" have passed and
+// "RootChangePolicy.MinApprovals" co-signers have approved it.
+type MsgProposeRoot struct {
+	// Sender: Address for the signer of the transaction. Must be the root.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// NewRoot: New root address.
+	NewRoot string `protobuf:"bytes,2,opt,name=new_root,json=newRoot,proto3" json:"new_root,omitempty"`
+	// NewPolicy: Optional replacement for the root change policy that takes
+	// effect along with the new root.
+	NewPolicy *RootChangePolicy `protobuf:"bytes,3,opt,name=new_policy,json=newPolicy,proto3" json:"new_policy,omitempty"`
 }
 
-func init() { proto.RegisterFile("nibiru/sudo/v1/tx.proto", fileDescriptor_a610e3c1609cdcbc) }
-
-var fileDescriptor_a610e3c1609cdcbc = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x4e, 0xea, 0x40,
-	0x18, 0x86, 0x29, 0x24, 0x9c, 0xc3, 0x9c, 0x1c, 0x16, 0xcd, 0x39, 0x50, 0x2a, 0x36, 0xd8, 0x44,
-	0xc3, 0xc6, 0x4e, 0xc0, 0x3b, 0x80, 0x18, 0x57, 0xb8, 0xa8, 0x3b, 0x17, 0x92, 0xa1, 0x9d, 0x0c,
-	0x93, 0xe8, 0x7c, 0x4d, 0x67, 0x0a, 0xb8, 0xf5, 0x0a, 0x4c, 0xbc, 0x29, 0x97, 0x24, 0x6e, 0x5c,
-	0x1a, 0xf0, 0x16, 0xdc, 0x9b, 0x4e, 0xf9, 0x69, 0x13, 0xc3, 0xae, 0xd3, 0xe7, 0x7b, 0x9f, 0x77,
-	0x66, 0x5a, 0xd4, 0x14, 0x7c, 0xc2, 0xe3, 0x04, 0xcb, 0x24, 0x04, 0x3c, 0xeb, 0x61, 0xb5, 0xf0,
-	0xa2, 0x18, 0x14, 0x98, 0xf5, 0x0c, 0x78, 0x29, 0xf0, 0x66, 0x3d, 0xfb, 0x1f, 0x03, 0x06, 0x1a,
-	0xe1, 0xf4, 0x29, 0x9b, 0xb2, 0xdb, 0x0c, 0x80, 0xdd, 0x53, 0x4c, 0x22, 0x8e, 0x89, 0x10, 0xa0,
-	0x88, 0xe2, 0x20, 0x64, 0x46, 0xdd, 0x3b, 0x54, 0x1f, 0x49, 0x76, 0x19, 0x72, 0x75, 0x93, 0x84,
-	0x40, 0x63, 0x69, 0x36, 0x50, 0x95, 0x04, 0xe9, 0x88, 0x65, 0x74, 0x8c, 0x6e, 0xcd, 0xdf, 0xac,
-	0xcc, 0x36, 0xaa, 0x05, 0x20, 0x54, 0x4c, 0x02, 0x25, 0xad, 0x72, 0xa7, 0xd2, 0xad, 0xf9, 0xfb,
-	0x17, 0x69, 0x4a, 0x52, 0x11, 0xd2, 0xd8, 0xaa, 0x64, 0xa9, 0x6c, 0xe5, 0x5a, 0xa8, 0x51, 0xf4,
-	0xfb, 0x54, 0x46, 0x20, 0x24, 0x75, 0x07, 0xe8, 0xef, 0x48, 0xb2, 0xe1, 0x94, 0x08, 0x46, 0x7d,
-	0x00, 0x95, 0x53, 0x18, 0x79, 0x85, 0xd9, 0x42, 0xbf, 0x05, 0x9d, 0x8f, 0x63, 0x00, 0x65, 0x95,
-	0x35, 0xf9, 0x25, 0xe8, 0x3c, 0x8d, 0xb8, 0x4d, 0xf4, 0xbf, 0xe0, 0xd8, 0xca, 0xfb, 0x5f, 0x06,
-	0xaa, 0x8c, 0x24, 0x33, 0x17, 0xe8, 0x4f, 0xfe, 0x6c, 0x8e, 0x57, 0xbc, 0x32, 0xaf, 0xb8, 0x37,
-	0xfb, 0xec, 0x30, 0xdf, 0xed, 0xfd, 0xe4, 0xe9, 0xed, 0xf3, 0xa5, 0x7c, 0xe4, 0xb6, 0x70, 0xfe,
-	0xdb, 0xd0, 0x90, 0xab, 0xb1, 0xdc, 0x54, 0x29, 0x84, 0x72, 0x67, 0x3b, 0xfe, 0x41, 0xbc, 0xc7,
-	0xf6, 0xe9, 0x41, 0xbc, 0xab, 0xed, 0xe8, 0x5a, 0xdb, 0xb5, 0x0a, 0xb5, 0x81, 0x1e, 0xd4, 0xf7,
-	0x33, 0xb8, 0x7a, 0x5d, 0x39, 0xc6, 0x72, 0xe5, 0x18, 0x1f, 0x2b, 0xc7, 0x78, 0x5e, 0x3b, 0xa5,
-	0xe5, 0xda, 0x29, 0xbd, 0xaf, 0x9d, 0xd2, 0xed, 0x39, 0xe3, 0x6a, 0x9a, 0x4c, 0xbc, 0x00, 0x1e,
-	0xf0, 0xb5, 0x4e, 0x0f, 0xa7, 0x84, 0x8b, 0xad, 0x69, 0xd6, 0xc7, 0x8b, 0x4c, 0xa7, 0x1e, 0x23,
-	0x2a, 0x27, 0x55, 0xfd, 0x7b, 0x5c, 0x7c, 0x07, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xdb, 0xcd, 0x34,
-	0x7d, 0x02, 0x00, 0x00,
+func (m *MsgProposeRoot) Reset()         { *m = MsgProposeRoot{} }
+func (m *MsgProposeRoot) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeRoot) ProtoMessage()    {}
+func (*MsgProposeRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{4}
+}
+func (m *MsgProposeRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeRoot.Merge(m, src)
+}
+func (m *MsgProposeRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeRoot.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgProposeRoot proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgProposeRoot) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// EditSudoers updates the "Sudoers" state
-	EditSudoers(ctx context.Context, in *MsgEditSudoers, opts ...grpc.CallOption) (*MsgEditSudoersResponse, error)
-	ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...grpc.CallOption) (*MsgChangeRootResponse, error)
+func (m *MsgProposeRoot) GetNewRoot() string {
+	if m != nil {
+		return m.NewRoot
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgProposeRoot) GetNewPolicy() *RootChangePolicy {
+	if m != nil {
+		return m.NewPolicy
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgProposeRootResponse indicates the successful execution of MsgProposeRoot.
+type MsgProposeRootResponse struct {
+	// Id: Identifier of the pending root change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *msgClient) EditSudoers(ctx context.Context, in *MsgEditSudoers, opts ...grpc.CallOption) (*MsgEditSudoersResponse, error) {
-	out := new(MsgEditSudoersResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/EditSudoers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeRootResponse) Reset()         { *m = MsgProposeRootResponse{} }
+func (m *MsgProposeRootResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeRootResponse) ProtoMessage()    {}
+func (*MsgProposeRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{5}
+}
+func (m *MsgProposeRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgProposeRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeRootResponse.Merge(m, src)
+}
+func (m *MsgProposeRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeRootResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...grpc.CallOption) (*MsgChangeRootResponse, error) {
-	out := new(MsgChangeRootResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/ChangeRoot", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgProposeRootResponse proto.InternalMessageInfo
+
+func (m *MsgProposeRootResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// EditSudoers updates the "Sudoers" state
-	EditSudoers(context.Context, *MsgEditSudoers) (*MsgEditSudoersResponse, error)
-	ChangeRoot(context.Context, *MsgChangeRoot) (*MsgChangeRootResponse, error)
+// MsgApproveRoot: Msg for a co-signer to approve a pending root change.
+type MsgApproveRoot struct {
+	// Sender: Address for the signer of the transaction. Must be a co-signer.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Id: Identifier of the pending root change.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgApproveRoot) Reset()         { *m = MsgApproveRoot{} }
+func (m *MsgApproveRoot) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveRoot) ProtoMessage()    {}
+func (*MsgApproveRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{6}
 }
-
-func (*UnimplementedMsgServer) EditSudoers(ctx context.Context, req *MsgEditSudoers) (*MsgEditSudoersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditSudoers not implemented")
+func (m *MsgApproveRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ChangeRoot(ctx context.Context, req *MsgChangeRoot) (*MsgChangeRootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeRoot not implemented")
+func (m *MsgApproveRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgApproveRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveRoot.Merge(m, src)
+}
+func (m *MsgApproveRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveRoot.DiscardUnknown(m)
 }
 
-func _Msg_EditSudoers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditSudoers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditSudoers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.sudo.v1.Msg/EditSudoers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditSudoers(ctx, req.(*MsgEditSudoers))
+var xxx_messageInfo_MsgApproveRoot proto.InternalMessageInfo
+
+func (m *MsgApproveRoot) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ChangeRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeRoot)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeRoot(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.sudo.v1.Msg/ChangeRoot",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeRoot(ctx, req.(*MsgChangeRoot))
+func (m *MsgApproveRoot) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nibiru.sudo.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EditSudoers",
-			Handler:    _Msg_EditSudoers_Handler,
-		},
-		{
-			MethodName: "ChangeRoot",
-			Handler:    _Msg_ChangeRoot_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "nibiru/sudo/v1/tx.proto",
+// MsgApproveRootResponse indicates the successful execution of MsgApproveRoot.
+type MsgApproveRootResponse struct {
 }
 
-func (m *MsgEditSudoers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgApproveRootResponse) Reset()         { *m = MsgApproveRootResponse{} }
+func (m *MsgApproveRootResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveRootResponse) ProtoMessage()    {}
+func (*MsgApproveRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{7}
+}
+func (m *MsgApproveRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgApproveRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveRootResponse.Merge(m, src)
+}
+func (m *MsgApproveRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveRootResponse.DiscardUnknown(m)
 }
 
-func (m *MsgEditSudoers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgApproveRootResponse proto.InternalMessageInfo
+
+// MsgCancelRootChange: Msg to discard a pending root change. Either the root
+// or any co-signer can cancel.
+type MsgCancelRootChange struct {
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Id: Identifier of the pending root change.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgEditSudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Contracts) > 0 {
-		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Contracts[iNdEx])
-			copy(dAtA[i:], m.Contracts[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Contracts[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgCancelRootChange) Reset()         { *m = MsgCancelRootChange{} }
+func (m *MsgCancelRootChange) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRootChange) ProtoMessage()    {}
+func (*MsgCancelRootChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{8}
+}
+func (m *MsgCancelRootChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRootChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRootChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Action) > 0 {
-		i -= len(m.Action)
-		copy(dAtA[i:], m.Action)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Action)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelRootChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRootChange.Merge(m, src)
+}
+func (m *MsgCancelRootChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRootChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRootChange.DiscardUnknown(m)
 }
 
-func (m *MsgEditSudoersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelRootChange proto.InternalMessageInfo
+
+func (m *MsgCancelRootChange) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgEditSudoersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelRootChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgEditSudoersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgCancelRootChangeResponse indicates the successful execution of
+// MsgCancelRootChange.
+type MsgCancelRootChangeResponse struct {
 }
 
-func (m *MsgChangeRoot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelRootChangeResponse) Reset()         { *m = MsgCancelRootChangeResponse{} }
+func (m *MsgCancelRootChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRootChangeResponse) ProtoMessage()    {}
+func (*MsgCancelRootChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{9}
+}
+func (m *MsgCancelRootChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRootChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRootChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelRootChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRootChangeResponse.Merge(m, src)
+}
+func (m *MsgCancelRootChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRootChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRootChangeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgChangeRoot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelRootChangeResponse proto.InternalMessageInfo
+
+// MsgScheduleSudo: Msg to queue sudo-gated messages (e.g.
+// "MsgEditOracleParams") for execution at a future block height. This gives
+// users a notice period before the changes land.
+type MsgScheduleSudo struct {
+	// Sender: Address for the signer of the transaction. Must be a sudoer and
+	// the signer of every message in "messages".
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Messages: Messages to execute atomically at the execution height.
+	Messages []*types.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// ExecutionHeight: Block height at which the messages are executed. Must be
+	// at least "ScheduledSudoPolicy.min_delay_blocks" after the current block
+	// height.
+	ExecutionHeight int64 `protobuf:"varint,3,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
 }
 
-func (m *MsgChangeRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewRoot) > 0 {
-		i -= len(m.NewRoot)
-		copy(dAtA[i:], m.NewRoot)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewRoot)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgScheduleSudo) Reset()         { *m = MsgScheduleSudo{} }
+func (m *MsgScheduleSudo) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleSudo) ProtoMessage()    {}
+func (*MsgScheduleSudo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{10}
+}
+func (m *MsgScheduleSudo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleSudo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleSudo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgScheduleSudo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleSudo.Merge(m, src)
+}
+func (m *MsgScheduleSudo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleSudo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleSudo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleSudo proto.InternalMessageInfo
+
+func (m *MsgScheduleSudo) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgChangeRootResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleSudo) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgChangeRootResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgScheduleSudo) GetExecutionHeight() int64 {
+	if m != nil {
+		return m.ExecutionHeight
+	}
+	return 0
 }
 
-func (m *MsgChangeRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgScheduleSudoResponse indicates the successful execution of
+// MsgScheduleSudo.
+type MsgScheduleSudoResponse struct {
+	// Id: Identifier of the scheduled action.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleSudoResponse) Reset()         { *m = MsgScheduleSudoResponse{} }
+func (m *MsgScheduleSudoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleSudoResponse) ProtoMessage()    {}
+func (*MsgScheduleSudoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{11}
+}
+func (m *MsgScheduleSudoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleSudoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleSudoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgEditSudoers) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleSudoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleSudoResponse.Merge(m, src)
+}
+func (m *MsgScheduleSudoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleSudoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleSudoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleSudoResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleSudoResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgCancelScheduledSudo: Msg to discard a scheduled sudo action. Either the
+// root or the sender of the action can cancel.
+type MsgCancelScheduledSudo struct {
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Id: Identifier of the scheduled action.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelScheduledSudo) Reset()         { *m = MsgCancelScheduledSudo{} }
+func (m *MsgCancelScheduledSudo) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledSudo) ProtoMessage()    {}
+func (*MsgCancelScheduledSudo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{12}
+}
+func (m *MsgCancelScheduledSudo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledSudo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledSudo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Contracts) > 0 {
-		for _, s := range m.Contracts {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgCancelScheduledSudo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledSudo.Merge(m, src)
+}
+func (m *MsgCancelScheduledSudo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledSudo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledSudo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledSudo proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledSudo) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCancelScheduledSudo) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelScheduledSudoResponse indicates the successful execution of
+// MsgCancelScheduledSudo.
+type MsgCancelScheduledSudoResponse struct {
+}
+
+func (m *MsgCancelScheduledSudoResponse) Reset()         { *m = MsgCancelScheduledSudoResponse{} }
+func (m *MsgCancelScheduledSudoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledSudoResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledSudoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{13}
+}
+func (m *MsgCancelScheduledSudoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledSudoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledSudoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledSudoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledSudoResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledSudoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledSudoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledSudoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledSudoResponse proto.InternalMessageInfo
+
+// MsgUpdateScheduledSudoPolicy: Msg to replace the "ScheduledSudoPolicy". The
+// authority must be the governance module account.
+type MsgUpdateScheduledSudoPolicy struct {
+	// Authority: Address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Policy: New safeguards for scheduled sudo actions.
+	Policy ScheduledSudoPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgUpdateScheduledSudoPolicy) Reset()         { *m = MsgUpdateScheduledSudoPolicy{} }
+func (m *MsgUpdateScheduledSudoPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateScheduledSudoPolicy) ProtoMessage()    {}
+func (*MsgUpdateScheduledSudoPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{14}
+}
+func (m *MsgUpdateScheduledSudoPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateScheduledSudoPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateScheduledSudoPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateScheduledSudoPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateScheduledSudoPolicy.Merge(m, src)
+}
+func (m *MsgUpdateScheduledSudoPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateScheduledSudoPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateScheduledSudoPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateScheduledSudoPolicy proto.InternalMessageInfo
+
+func (m *MsgUpdateScheduledSudoPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateScheduledSudoPolicy) GetPolicy() ScheduledSudoPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return ScheduledSudoPolicy{}
+}
+
+// MsgUpdateScheduledSudoPolicyResponse indicates the successful execution of
+// MsgUpdateScheduledSudoPolicy.
+type MsgUpdateScheduledSudoPolicyResponse struct {
+}
+
+func (m *MsgUpdateScheduledSudoPolicyResponse) Reset()         { *m = MsgUpdateScheduledSudoPolicyResponse{} }
+func (m *MsgUpdateScheduledSudoPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateScheduledSudoPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateScheduledSudoPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{15}
+}
+func (m *MsgUpdateScheduledSudoPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateScheduledSudoPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateScheduledSudoPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateScheduledSudoPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateScheduledSudoPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateScheduledSudoPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateScheduledSudoPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateScheduledSudoPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateScheduledSudoPolicyResponse proto.InternalMessageInfo
+
+// MsgRegisterCronJob: Msg to register messages and EVM and Wasm contract calls
+// that run at the end of every epoch of an epoch identifier, like periodic vault
+// rebalancing or reward distribution.
+type MsgRegisterCronJob struct {
+	// Sender: Address for the signer of the transaction. Must be a sudoer or the
+	// governance module account, and the signer of every message in "messages".
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// EpochIdentifier: Identifier of the epoch at the end of which the job runs.
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// Messages: Messages to execute through the message router.
+	Messages []*types.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	// EvmCalls: EVM contract calls to execute from the sender after the
+	// messages.
+	EvmCalls []EvmCall `protobuf:"bytes,4,rep,name=evm_calls,json=evmCalls,proto3" json:"evm_calls"`
+	// GasLimit: Gas available to each execution of the job.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// WasmCalls: Wasm contract executions to make from the sender after the EVM
+	// calls.
+	WasmCalls []WasmCall `protobuf:"bytes,6,rep,name=wasm_calls,json=wasmCalls,proto3" json:"wasm_calls"`
+}
+
+func (m *MsgRegisterCronJob) Reset()         { *m = MsgRegisterCronJob{} }
+func (m *MsgRegisterCronJob) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCronJob) ProtoMessage()    {}
+func (*MsgRegisterCronJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{16}
+}
+func (m *MsgRegisterCronJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCronJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCronJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCronJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCronJob.Merge(m, src)
+}
+func (m *MsgRegisterCronJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCronJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCronJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCronJob proto.InternalMessageInfo
+
+func (m *MsgRegisterCronJob) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterCronJob) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *MsgRegisterCronJob) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *MsgRegisterCronJob) GetEvmCalls() []EvmCall {
+	if m != nil {
+		return m.EvmCalls
+	}
+	return nil
+}
+
+func (m *MsgRegisterCronJob) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *MsgRegisterCronJob) GetWasmCalls() []WasmCall {
+	if m != nil {
+		return m.WasmCalls
+	}
+	return nil
+}
+
+// MsgRegisterCronJobResponse indicates the successful execution of
+// MsgRegisterCronJob.
+type MsgRegisterCronJobResponse struct {
+	// Id: Identifier of the cron job.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRegisterCronJobResponse) Reset()         { *m = MsgRegisterCronJobResponse{} }
+func (m *MsgRegisterCronJobResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCronJobResponse) ProtoMessage()    {}
+func (*MsgRegisterCronJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{17}
+}
+func (m *MsgRegisterCronJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCronJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCronJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCronJobResponse.Merge(m, src)
+}
+func (m *MsgRegisterCronJobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCronJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCronJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCronJobResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterCronJobResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgDeleteCronJob: Msg to remove a cron job. The root, the sender of the
+// job, or the governance module account can delete it.
+type MsgDeleteCronJob struct {
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Id: Identifier of the cron job.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgDeleteCronJob) Reset()         { *m = MsgDeleteCronJob{} }
+func (m *MsgDeleteCronJob) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteCronJob) ProtoMessage()    {}
+func (*MsgDeleteCronJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{18}
+}
+func (m *MsgDeleteCronJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteCronJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteCronJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteCronJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteCronJob.Merge(m, src)
+}
+func (m *MsgDeleteCronJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteCronJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteCronJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteCronJob proto.InternalMessageInfo
+
+func (m *MsgDeleteCronJob) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgDeleteCronJob) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgDeleteCronJobResponse indicates the successful execution of
+// MsgDeleteCronJob.
+type MsgDeleteCronJobResponse struct {
+}
+
+func (m *MsgDeleteCronJobResponse) Reset()         { *m = MsgDeleteCronJobResponse{} }
+func (m *MsgDeleteCronJobResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteCronJobResponse) ProtoMessage()    {}
+func (*MsgDeleteCronJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{19}
+}
+func (m *MsgDeleteCronJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteCronJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteCronJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteCronJobResponse.Merge(m, src)
+}
+func (m *MsgDeleteCronJobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteCronJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteCronJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteCronJobResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgEditSudoers)(nil), "nibiru.sudo.v1.MsgEditSudoers")
+	proto.RegisterType((*MsgEditSudoersResponse)(nil), "nibiru.sudo.v1.MsgEditSudoersResponse")
+	proto.RegisterType((*MsgChangeRoot)(nil), "nibiru.sudo.v1.MsgChangeRoot")
+	proto.RegisterType((*MsgChangeRootResponse)(nil), "nibiru.sudo.v1.MsgChangeRootResponse")
+	proto.RegisterType((*MsgProposeRoot)(nil), "nibiru.sudo.v1.MsgProposeRoot")
+	proto.RegisterType((*MsgProposeRootResponse)(nil), "nibiru.sudo.v1.MsgProposeRootResponse")
+	proto.RegisterType((*MsgApproveRoot)(nil), "nibiru.sudo.v1.MsgApproveRoot")
+	proto.RegisterType((*MsgApproveRootResponse)(nil), "nibiru.sudo.v1.MsgApproveRootResponse")
+	proto.RegisterType((*MsgCancelRootChange)(nil), "nibiru.sudo.v1.MsgCancelRootChange")
+	proto.RegisterType((*MsgCancelRootChangeResponse)(nil), "nibiru.sudo.v1.MsgCancelRootChangeResponse")
+	proto.RegisterType((*MsgScheduleSudo)(nil), "nibiru.sudo.v1.MsgScheduleSudo")
+	proto.RegisterType((*MsgScheduleSudoResponse)(nil), "nibiru.sudo.v1.MsgScheduleSudoResponse")
+	proto.RegisterType((*MsgCancelScheduledSudo)(nil), "nibiru.sudo.v1.MsgCancelScheduledSudo")
+	proto.RegisterType((*MsgCancelScheduledSudoResponse)(nil), "nibiru.sudo.v1.MsgCancelScheduledSudoResponse")
+	proto.RegisterType((*MsgUpdateScheduledSudoPolicy)(nil), "nibiru.sudo.v1.MsgUpdateScheduledSudoPolicy")
+	proto.RegisterType((*MsgUpdateScheduledSudoPolicyResponse)(nil), "nibiru.sudo.v1.MsgUpdateScheduledSudoPolicyResponse")
+	proto.RegisterType((*MsgRegisterCronJob)(nil), "nibiru.sudo.v1.MsgRegisterCronJob")
+	proto.RegisterType((*MsgRegisterCronJobResponse)(nil), "nibiru.sudo.v1.MsgRegisterCronJobResponse")
+	proto.RegisterType((*MsgDeleteCronJob)(nil), "nibiru.sudo.v1.MsgDeleteCronJob")
+	proto.RegisterType((*MsgDeleteCronJobResponse)(nil), "nibiru.sudo.v1.MsgDeleteCronJobResponse")
+}
+
+func init() { proto.RegisterFile("nibiru/sudo/v1/tx.proto", fileDescriptor_a610e3c1609cdcbc) }
+
+var fileDescriptor_a610e3c1609cdcbc = []byte{
+	// 1032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0xae, 0xd3, 0x52, 0x9a, 0x53, 0xd6, 0x56, 0xde, 0x68, 0x5d, 0x37, 0x73, 0xb3, 0xbb, 0x92,
+	0xb5, 0xdb, 0xb0, 0x69, 0xe1, 0x01, 0x4d, 0x9a, 0xa0, 0x2d, 0x13, 0x3f, 0x44, 0xd0, 0xe4, 0x09,
+	0x21, 0xf1, 0x40, 0x74, 0x63, 0xdf, 0x39, 0x46, 0x8e, 0xaf, 0xe5, 0x7b, 0x93, 0x34, 0x12, 0x12,
+	0x02, 0x21, 0x24, 0x1e, 0x90, 0x90, 0x78, 0xe2, 0x91, 0x7f, 0x81, 0xbf, 0x62, 0x8f, 0x93, 0x78,
+	0xd9, 0x13, 0x42, 0x2d, 0x7f, 0x08, 0xf2, 0xb5, 0x73, 0x6b, 0x7b, 0x4e, 0x52, 0x78, 0xcb, 0xbd,
+	0xdf, 0x77, 0xbe, 0xf3, 0x9d, 0x73, 0x4f, 0x4e, 0x02, 0x5b, 0xa1, 0xdf, 0xf5, 0xe3, 0x81, 0xc5,
+	0x06, 0x2e, 0xb5, 0x86, 0x87, 0x16, 0x3f, 0x33, 0xa3, 0x98, 0x72, 0xaa, 0xae, 0xa5, 0x80, 0x99,
+	0x00, 0xe6, 0xf0, 0x50, 0xbf, 0xe1, 0x51, 0x8f, 0x0a, 0xc8, 0x4a, 0x3e, 0xa5, 0x2c, 0xbd, 0xe1,
+	0x51, 0xea, 0x05, 0xc4, 0xc2, 0x91, 0x6f, 0xe1, 0x30, 0xa4, 0x1c, 0x73, 0x9f, 0x86, 0x2c, 0x43,
+	0xb7, 0x33, 0x54, 0x9c, 0xba, 0x83, 0xa7, 0x16, 0x0e, 0xc7, 0x19, 0xa4, 0x97, 0xf2, 0x32, 0x8e,
+	0x39, 0x49, 0x31, 0xf4, 0x15, 0xac, 0xb5, 0x99, 0xf7, 0xc8, 0xf5, 0xf9, 0x93, 0x81, 0x4b, 0x49,
+	0xcc, 0xd4, 0x4d, 0x58, 0xc6, 0x4e, 0xa2, 0xac, 0x29, 0x4d, 0x65, 0xbf, 0x6e, 0x67, 0x27, 0xb5,
+	0x01, 0x75, 0x87, 0x86, 0x3c, 0xc6, 0x0e, 0x67, 0x5a, 0xad, 0xb9, 0xb8, 0x5f, 0xb7, 0x2f, 0x2f,
+	0x92, 0x28, 0x46, 0x42, 0x97, 0xc4, 0xda, 0x62, 0x1a, 0x95, 0x9e, 0x90, 0x06, 0x9b, 0x45, 0x7d,
+	0x9b, 0xb0, 0x88, 0x86, 0x8c, 0xa0, 0x13, 0xb8, 0xd6, 0x66, 0xde, 0x69, 0x0f, 0x87, 0x1e, 0xb1,
+	0x29, 0xe5, 0x39, 0x09, 0x25, 0x2f, 0xa1, 0x6e, 0xc3, 0x4a, 0x48, 0x46, 0x9d, 0x98, 0x52, 0xae,
+	0xd5, 0x04, 0xf2, 0x6a, 0x48, 0x46, 0x49, 0x08, 0xda, 0x82, 0xd7, 0x0b, 0x1a, 0x52, 0xfc, 0x07,
+	0x45, 0xd4, 0xf5, 0x38, 0xa6, 0x11, 0x65, 0xff, 0x57, 0x5e, 0x7d, 0x0f, 0x20, 0x81, 0x22, 0x1a,
+	0xf8, 0xce, 0x58, 0x14, 0xb6, 0x7a, 0xd4, 0x34, 0x8b, 0x8f, 0x65, 0x26, 0xcc, 0xd4, 0xc1, 0x63,
+	0xc1, 0xb3, 0xeb, 0x21, 0x19, 0xa5, 0x1f, 0xd1, 0x3e, 0x6c, 0x16, 0x5d, 0x4c, 0x0c, 0xaa, 0x6b,
+	0x50, 0xf3, 0x5d, 0xe1, 0x64, 0xc9, 0xae, 0xf9, 0x2e, 0x7a, 0x57, 0xf8, 0x3d, 0x8e, 0xa2, 0x98,
+	0x0e, 0x67, 0xfb, 0x4d, 0x23, 0x6b, 0x32, 0x32, 0xed, 0x70, 0x2e, 0x52, 0x36, 0xe1, 0x21, 0x5c,
+	0x4f, 0xba, 0x83, 0x43, 0x87, 0x04, 0x97, 0x2e, 0xaf, 0x2c, 0x7c, 0x13, 0x76, 0x2a, 0xc2, 0xa5,
+	0xfa, 0x8f, 0x0a, 0xac, 0xb7, 0x99, 0xf7, 0xc4, 0xe9, 0x11, 0x77, 0x10, 0x90, 0xe4, 0x79, 0xa7,
+	0x4a, 0xbf, 0x05, 0x2b, 0x7d, 0xc2, 0x18, 0xf6, 0x48, 0x3a, 0x3a, 0xab, 0x47, 0x37, 0xcc, 0x74,
+	0x5e, 0xcd, 0xc9, 0xbc, 0x9a, 0xc7, 0xe1, 0xd8, 0x96, 0x2c, 0xf5, 0x00, 0x36, 0xc8, 0x19, 0x71,
+	0x06, 0xc9, 0xe8, 0x75, 0x7a, 0xc4, 0xf7, 0x7a, 0x5c, 0x3c, 0xc0, 0xa2, 0xbd, 0x2e, 0xef, 0x3f,
+	0x12, 0xd7, 0xe8, 0x00, 0xb6, 0x4a, 0x3e, 0xa6, 0x76, 0xf9, 0x7d, 0xd8, 0x94, 0x25, 0x4d, 0x02,
+	0xdc, 0x99, 0xce, 0xcb, 0x4d, 0x69, 0x82, 0x51, 0xad, 0x20, 0xfb, 0xf2, 0x2d, 0x34, 0xda, 0xcc,
+	0xfb, 0x3c, 0x72, 0x31, 0x27, 0x05, 0x46, 0x3a, 0x13, 0xc9, 0xf7, 0x08, 0x0f, 0x78, 0x8f, 0xc6,
+	0x3e, 0x1f, 0x67, 0xc9, 0x2e, 0x2f, 0xd4, 0x63, 0x58, 0xce, 0xc6, 0xad, 0x26, 0xc6, 0xed, 0x76,
+	0x79, 0xdc, 0x2a, 0x24, 0x4f, 0x96, 0x9e, 0xfd, 0xb5, 0xbb, 0x60, 0x67, 0x81, 0xa8, 0x05, 0x7b,
+	0xb3, 0x0c, 0x48, 0xa3, 0xbf, 0xd7, 0x40, 0x6d, 0x33, 0xcf, 0x26, 0x9e, 0xcf, 0x38, 0x89, 0x4f,
+	0x63, 0x1a, 0x7e, 0x42, 0xbb, 0x53, 0x3b, 0x91, 0xbc, 0x48, 0x44, 0x9d, 0x5e, 0xc7, 0x77, 0x49,
+	0xc8, 0xfd, 0xa7, 0x3e, 0x89, 0xb3, 0xef, 0xcb, 0xba, 0xb8, 0xff, 0x58, 0x5e, 0x17, 0x9e, 0x7b,
+	0xf1, 0x4a, 0xcf, 0xfd, 0x00, 0xea, 0x64, 0xd8, 0xef, 0x38, 0x38, 0x08, 0x98, 0xb6, 0x24, 0x42,
+	0xb6, 0xca, 0x95, 0x3f, 0x1a, 0xf6, 0x4f, 0x71, 0x10, 0x64, 0xd5, 0xae, 0x90, 0xf4, 0xc8, 0xd4,
+	0x1d, 0xa8, 0x7b, 0x98, 0x75, 0x02, 0xbf, 0xef, 0x73, 0xed, 0x15, 0xf1, 0x52, 0x2b, 0x1e, 0x66,
+	0x9f, 0x26, 0x67, 0xf5, 0x21, 0xc0, 0x08, 0xb3, 0x89, 0xf2, 0xb2, 0x50, 0xd6, 0xca, 0xca, 0x5f,
+	0x60, 0x96, 0x97, 0xae, 0x8f, 0xb2, 0x33, 0x43, 0xf7, 0x41, 0x7f, 0xb9, 0x45, 0x53, 0xc7, 0xeb,
+	0x01, 0x6c, 0xb4, 0x99, 0xf7, 0x01, 0x09, 0x08, 0x27, 0xf3, 0xda, 0x59, 0x1e, 0x2c, 0x1d, 0xb4,
+	0x72, 0xec, 0x24, 0xcf, 0xd1, 0x0b, 0x80, 0xc5, 0x36, 0xf3, 0xd4, 0x33, 0x58, 0xcd, 0x6f, 0x6a,
+	0xa3, 0x5c, 0x47, 0x71, 0xd3, 0xea, 0xad, 0xd9, 0xb8, 0x1c, 0x84, 0x5b, 0xdf, 0xff, 0xf9, 0xcf,
+	0xaf, 0xb5, 0x1d, 0xb4, 0x6d, 0xe5, 0x7f, 0x28, 0x88, 0xeb, 0xf3, 0x0e, 0xcb, 0x52, 0x71, 0x80,
+	0xdc, 0xa6, 0xbe, 0x59, 0x21, 0x7c, 0x09, 0xeb, 0x6f, 0xcc, 0x84, 0x65, 0xda, 0xa6, 0x48, 0xab,
+	0x23, 0xad, 0x90, 0xd6, 0x11, 0x44, 0xb1, 0x8e, 0x93, 0x7a, 0xf3, 0x1b, 0xbc, 0xaa, 0xde, 0x1c,
+	0xae, 0xb7, 0x66, 0xe3, 0x73, 0xea, 0x8d, 0x52, 0xa6, 0xcc, 0x9c, 0xdf, 0xc5, 0x55, 0x99, 0x73,
+	0xb8, 0xde, 0x9a, 0x8d, 0xcf, 0xc9, 0x8c, 0x53, 0x66, 0x9a, 0xf9, 0x67, 0x05, 0x36, 0x5e, 0x5a,
+	0xd9, 0xb7, 0xab, 0x3a, 0x5a, 0x22, 0xe9, 0xf7, 0xae, 0x40, 0x92, 0x4e, 0xee, 0x08, 0x27, 0xb7,
+	0xd0, 0x6e, 0xb1, 0xf9, 0x82, 0x2e, 0x8c, 0x74, 0xd2, 0x87, 0x50, 0xbf, 0x81, 0xd7, 0x0a, 0x2b,
+	0x7e, 0xb7, 0x22, 0x4b, 0x9e, 0xa0, 0xdf, 0x99, 0x43, 0x90, 0x16, 0x90, 0xb0, 0xd0, 0x40, 0x7a,
+	0xc1, 0x02, 0xcb, 0xa8, 0x62, 0xf4, 0xd4, 0xdf, 0x14, 0xb8, 0x5e, 0xb5, 0xae, 0x5b, 0x53, 0x6b,
+	0x2d, 0xf0, 0x74, 0xf3, 0x6a, 0x3c, 0xe9, 0xe9, 0xae, 0xf0, 0xb4, 0x87, 0x50, 0x55, 0x5b, 0x26,
+	0xd6, 0xdc, 0xd4, 0xdb, 0x1f, 0x0a, 0x6c, 0x4f, 0x5f, 0xf3, 0xf7, 0x2b, 0x32, 0x4f, 0x65, 0xeb,
+	0xef, 0xfc, 0x17, 0xb6, 0x74, 0x7b, 0x28, 0xdc, 0xde, 0x43, 0x07, 0x05, 0xb7, 0x03, 0x11, 0x57,
+	0x72, 0x9b, 0xfd, 0x89, 0x51, 0x7f, 0x52, 0x60, 0xbd, 0xbc, 0xf1, 0x51, 0x45, 0xf2, 0x12, 0x47,
+	0xbf, 0x3b, 0x9f, 0x23, 0x6d, 0xb5, 0x84, 0xad, 0x26, 0x32, 0x0a, 0xb6, 0xe2, 0x8c, 0xdd, 0x71,
+	0x62, 0x1a, 0x76, 0xbe, 0xa6, 0x5d, 0xf5, 0x3b, 0x05, 0xae, 0x15, 0x97, 0x65, 0xb3, 0x22, 0x4b,
+	0x81, 0xa1, 0xef, 0xcf, 0x63, 0x48, 0x17, 0x7b, 0xc2, 0x85, 0x81, 0x1a, 0x05, 0x17, 0xae, 0xe0,
+	0x4a, 0x0f, 0x27, 0x1f, 0x3e, 0x3b, 0x37, 0x94, 0xe7, 0xe7, 0x86, 0xf2, 0xf7, 0xb9, 0xa1, 0xfc,
+	0x72, 0x61, 0x2c, 0x3c, 0xbf, 0x30, 0x16, 0x5e, 0x5c, 0x18, 0x0b, 0x5f, 0xbe, 0xe9, 0xf9, 0xbc,
+	0x37, 0xe8, 0x9a, 0x0e, 0xed, 0x5b, 0x9f, 0x09, 0x85, 0xd3, 0x1e, 0xf6, 0xc3, 0x89, 0xda, 0xf0,
+	0xc8, 0x3a, 0x4b, 0x25, 0xf9, 0x38, 0x22, 0xac, 0xbb, 0x2c, 0x7e, 0xd9, 0xde, 0xfe, 0x77, 0x00,
+	0x03, 0x90, 0x4e, 0x0c, 0xe5, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// EditSudoers updates the "Sudoers" state
+	EditSudoers(ctx context.Context, in *MsgEditSudoers, opts ...grpc.CallOption) (*MsgEditSudoersResponse, error)
+	ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...grpc.CallOption) (*MsgChangeRootResponse, error)
+	// ProposeRoot starts a timelocked change of the root user.
+	ProposeRoot(ctx context.Context, in *MsgProposeRoot, opts ...grpc.CallOption) (*MsgProposeRootResponse, error)
+	// ApproveRoot records a co-signer approval for a pending root change.
+	ApproveRoot(ctx context.Context, in *MsgApproveRoot, opts ...grpc.CallOption) (*MsgApproveRootResponse, error)
+	// CancelRootChange discards a pending root change.
+	CancelRootChange(ctx context.Context, in *MsgCancelRootChange, opts ...grpc.CallOption) (*MsgCancelRootChangeResponse, error)
+	// ScheduleSudo queues sudo messages for execution at a future block height.
+	ScheduleSudo(ctx context.Context, in *MsgScheduleSudo, opts ...grpc.CallOption) (*MsgScheduleSudoResponse, error)
+	// CancelScheduledSudo discards a scheduled sudo action.
+	CancelScheduledSudo(ctx context.Context, in *MsgCancelScheduledSudo, opts ...grpc.CallOption) (*MsgCancelScheduledSudoResponse, error)
+	// UpdateScheduledSudoPolicy replaces the safeguards of scheduled sudo
+	// actions. Only governance can execute it.
+	UpdateScheduledSudoPolicy(ctx context.Context, in *MsgUpdateScheduledSudoPolicy, opts ...grpc.CallOption) (*MsgUpdateScheduledSudoPolicyResponse, error)
+	// RegisterCronJob registers messages and EVM and Wasm calls to run at the end of
+	// every epoch of an epoch identifier.
+	RegisterCronJob(ctx context.Context, in *MsgRegisterCronJob, opts ...grpc.CallOption) (*MsgRegisterCronJobResponse, error)
+	// DeleteCronJob removes a cron job.
+	DeleteCronJob(ctx context.Context, in *MsgDeleteCronJob, opts ...grpc.CallOption) (*MsgDeleteCronJobResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) EditSudoers(ctx context.Context, in *MsgEditSudoers, opts ...grpc.CallOption) (*MsgEditSudoersResponse, error) {
+	out := new(MsgEditSudoersResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/EditSudoers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...grpc.CallOption) (*MsgChangeRootResponse, error) {
+	out := new(MsgChangeRootResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/ChangeRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeRoot(ctx context.Context, in *MsgProposeRoot, opts ...grpc.CallOption) (*MsgProposeRootResponse, error) {
+	out := new(MsgProposeRootResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/ProposeRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveRoot(ctx context.Context, in *MsgApproveRoot, opts ...grpc.CallOption) (*MsgApproveRootResponse, error) {
+	out := new(MsgApproveRootResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/ApproveRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRootChange(ctx context.Context, in *MsgCancelRootChange, opts ...grpc.CallOption) (*MsgCancelRootChangeResponse, error) {
+	out := new(MsgCancelRootChangeResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/CancelRootChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleSudo(ctx context.Context, in *MsgScheduleSudo, opts ...grpc.CallOption) (*MsgScheduleSudoResponse, error) {
+	out := new(MsgScheduleSudoResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/ScheduleSudo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledSudo(ctx context.Context, in *MsgCancelScheduledSudo, opts ...grpc.CallOption) (*MsgCancelScheduledSudoResponse, error) {
+	out := new(MsgCancelScheduledSudoResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/CancelScheduledSudo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateScheduledSudoPolicy(ctx context.Context, in *MsgUpdateScheduledSudoPolicy, opts ...grpc.CallOption) (*MsgUpdateScheduledSudoPolicyResponse, error) {
+	out := new(MsgUpdateScheduledSudoPolicyResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/UpdateScheduledSudoPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterCronJob(ctx context.Context, in *MsgRegisterCronJob, opts ...grpc.CallOption) (*MsgRegisterCronJobResponse, error) {
+	out := new(MsgRegisterCronJobResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/RegisterCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteCronJob(ctx context.Context, in *MsgDeleteCronJob, opts ...grpc.CallOption) (*MsgDeleteCronJobResponse, error) {
+	out := new(MsgDeleteCronJobResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/DeleteCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// EditSudoers updates the "Sudoers" state
+	EditSudoers(context.Context, *MsgEditSudoers) (*MsgEditSudoersResponse, error)
+	ChangeRoot(context.Context, *MsgChangeRoot) (*MsgChangeRootResponse, error)
+	// ProposeRoot starts a timelocked change of the root user.
+	ProposeRoot(context.Context, *MsgProposeRoot) (*MsgProposeRootResponse, error)
+	// ApproveRoot records a co-signer approval for a pending root change.
+	ApproveRoot(context.Context, *MsgApproveRoot) (*MsgApproveRootResponse, error)
+	// CancelRootChange discards a pending root change.
+	CancelRootChange(context.Context, *MsgCancelRootChange) (*MsgCancelRootChangeResponse, error)
+	// ScheduleSudo queues sudo messages for execution at a future block height.
+	ScheduleSudo(context.Context, *MsgScheduleSudo) (*MsgScheduleSudoResponse, error)
+	// CancelScheduledSudo discards a scheduled sudo action.
+	CancelScheduledSudo(context.Context, *MsgCancelScheduledSudo) (*MsgCancelScheduledSudoResponse, error)
+	// UpdateScheduledSudoPolicy replaces the safeguards of scheduled sudo
+	// actions. Only governance can execute it.
+	UpdateScheduledSudoPolicy(context.Context, *MsgUpdateScheduledSudoPolicy) (*MsgUpdateScheduledSudoPolicyResponse, error)
+	// RegisterCronJob registers messages and EVM and Wasm calls to run at the end of
+	// every epoch of an epoch identifier.
+	RegisterCronJob(context.Context, *MsgRegisterCronJob) (*MsgRegisterCronJobResponse, error)
+	// DeleteCronJob removes a cron job.
+	DeleteCronJob(context.Context, *MsgDeleteCronJob) (*MsgDeleteCronJobResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) EditSudoers(ctx context.Context, req *MsgEditSudoers) (*MsgEditSudoersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditSudoers not implemented")
+}
+func (*UnimplementedMsgServer) ChangeRoot(ctx context.Context, req *MsgChangeRoot) (*MsgChangeRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeRoot not implemented")
+}
+func (*UnimplementedMsgServer) ProposeRoot(ctx context.Context, req *MsgProposeRoot) (*MsgProposeRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeRoot not implemented")
+}
+func (*UnimplementedMsgServer) ApproveRoot(ctx context.Context, req *MsgApproveRoot) (*MsgApproveRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRoot not implemented")
+}
+func (*UnimplementedMsgServer) CancelRootChange(ctx context.Context, req *MsgCancelRootChange) (*MsgCancelRootChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRootChange not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleSudo(ctx context.Context, req *MsgScheduleSudo) (*MsgScheduleSudoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSudo not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledSudo(ctx context.Context, req *MsgCancelScheduledSudo) (*MsgCancelScheduledSudoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledSudo not implemented")
+}
+func (*UnimplementedMsgServer) UpdateScheduledSudoPolicy(ctx context.Context, req *MsgUpdateScheduledSudoPolicy) (*MsgUpdateScheduledSudoPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledSudoPolicy not implemented")
+}
+func (*UnimplementedMsgServer) RegisterCronJob(ctx context.Context, req *MsgRegisterCronJob) (*MsgRegisterCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCronJob not implemented")
+}
+func (*UnimplementedMsgServer) DeleteCronJob(ctx context.Context, req *MsgDeleteCronJob) (*MsgDeleteCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCronJob not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_EditSudoers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditSudoers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditSudoers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/EditSudoers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditSudoers(ctx, req.(*MsgEditSudoers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/ChangeRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeRoot(ctx, req.(*MsgChangeRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/ProposeRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeRoot(ctx, req.(*MsgProposeRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/ApproveRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveRoot(ctx, req.(*MsgApproveRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRootChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRootChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRootChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/CancelRootChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRootChange(ctx, req.(*MsgCancelRootChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleSudo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleSudo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleSudo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/ScheduleSudo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleSudo(ctx, req.(*MsgScheduleSudo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledSudo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledSudo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledSudo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/CancelScheduledSudo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledSudo(ctx, req.(*MsgCancelScheduledSudo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateScheduledSudoPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateScheduledSudoPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateScheduledSudoPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/UpdateScheduledSudoPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateScheduledSudoPolicy(ctx, req.(*MsgUpdateScheduledSudoPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/RegisterCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCronJob(ctx, req.(*MsgRegisterCronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteCronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/DeleteCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteCronJob(ctx, req.(*MsgDeleteCronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nibiru.sudo.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EditSudoers",
+			Handler:    _Msg_EditSudoers_Handler,
+		},
+		{
+			MethodName: "ChangeRoot",
+			Handler:    _Msg_ChangeRoot_Handler,
+		},
+		{
+			MethodName: "ProposeRoot",
+			Handler:    _Msg_ProposeRoot_Handler,
+		},
+		{
+			MethodName: "ApproveRoot",
+			Handler:    _Msg_ApproveRoot_Handler,
+		},
+		{
+			MethodName: "CancelRootChange",
+			Handler:    _Msg_CancelRootChange_Handler,
+		},
+		{
+			MethodName: "ScheduleSudo",
+			Handler:    _Msg_ScheduleSudo_Handler,
+		},
+		{
+			MethodName: "CancelScheduledSudo",
+			Handler:    _Msg_CancelScheduledSudo_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledSudoPolicy",
+			Handler:    _Msg_UpdateScheduledSudoPolicy_Handler,
+		},
+		{
+			MethodName: "RegisterCronJob",
+			Handler:    _Msg_RegisterCronJob_Handler,
+		},
+		{
+			MethodName: "DeleteCronJob",
+			Handler:    _Msg_DeleteCronJob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nibiru/sudo/v1/tx.proto",
+}
+
+func (m *MsgEditSudoers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditSudoers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditSudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditSudoersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditSudoersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditSudoersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewRoot) > 0 {
+		i -= len(m.NewRoot)
+		copy(dAtA[i:], m.NewRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPolicy != nil {
+		{
+			size, err := m.NewPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewRoot) > 0 {
+		i -= len(m.NewRoot)
+		copy(dAtA[i:], m.NewRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRootChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRootChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRootChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRootChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRootChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRootChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleSudo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleSudo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleSudo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleSudoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleSudoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleSudoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledSudo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledSudo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledSudo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledSudoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledSudoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledSudoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateScheduledSudoPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateScheduledSudoPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateScheduledSudoPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateScheduledSudoPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateScheduledSudoPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateScheduledSudoPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCronJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCronJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCronJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WasmCalls) > 0 {
+		for iNdEx := len(m.WasmCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WasmCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EvmCalls) > 0 {
+		for iNdEx := len(m.EvmCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCronJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCronJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCronJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteCronJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteCronJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteCronJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteCronJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteCronJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteCronJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEditSudoers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditSudoersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewPolicy != nil {
+		l = m.NewPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgApproveRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgApproveRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRootChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelRootChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleSudo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionHeight))
+	}
+	return n
+}
+
+func (m *MsgScheduleSudoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledSudo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledSudoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateScheduledSudoPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateScheduledSudoPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCronJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.EvmCalls) > 0 {
+		for _, e := range m.EvmCalls {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	if len(m.WasmCalls) > 0 {
+		for _, e := range m.WasmCalls {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterCronJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeleteCronJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeleteCronJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditSudoers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditSudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditSudoersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditSudoersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPolicy == nil {
+				m.NewPolicy = &RootChangePolicy{}
+			}
+			if err := m.NewPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRootChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRootChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRootChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRootChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRootChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRootChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleSudo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleSudo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleSudo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleSudoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleSudoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleSudoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledSudo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledSudo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledSudo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledSudoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledSudoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledSudoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateScheduledSudoPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateScheduledSudoPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateScheduledSudoPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgEditSudoersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgChangeRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewRoot)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateScheduledSudoPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateScheduledSudoPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateScheduledSudoPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgChangeRootResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterCronJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -606,15 +4015,15 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditSudoers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterCronJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditSudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterCronJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -642,11 +4051,11 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -674,13 +4083,13 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -690,23 +4099,112 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmCalls = append(m.EvmCalls, EvmCall{})
+			if err := m.EvmCalls[len(m.EvmCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WasmCalls = append(m.WasmCalls, WasmCall{})
+			if err := m.WasmCalls[len(m.WasmCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -729,7 +4227,7 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterCronJobResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -752,12 +4250,31 @@ func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditSudoersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterCronJobResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditSudoersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterCronJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -779,7 +4296,7 @@ func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteCronJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -802,10 +4319,10 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeRoot: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteCronJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteCronJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -841,10 +4358,10 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -854,24 +4371,11 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewRoot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -893,7 +4397,7 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteCronJobResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -916,10 +4420,10 @@ func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeRootResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteCronJobResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteCronJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: